@@ -753,6 +753,7 @@ const (
 	ObjectPrivilege_PrivilegeSelectOwnership    ObjectPrivilege = 22
 	ObjectPrivilege_PrivilegeManageOwnership    ObjectPrivilege = 23
 	ObjectPrivilege_PrivilegeSelectUser         ObjectPrivilege = 24
+	ObjectPrivilege_PrivilegeBackup             ObjectPrivilege = 25
 )
 
 var ObjectPrivilege_name = map[int32]string{
@@ -781,6 +782,7 @@ var ObjectPrivilege_name = map[int32]string{
 	22: "PrivilegeSelectOwnership",
 	23: "PrivilegeManageOwnership",
 	24: "PrivilegeSelectUser",
+	25: "PrivilegeBackup",
 }
 
 var ObjectPrivilege_value = map[string]int32{
@@ -809,6 +811,7 @@ var ObjectPrivilege_value = map[string]int32{
 	"PrivilegeSelectOwnership":    22,
 	"PrivilegeManageOwnership":    23,
 	"PrivilegeSelectUser":         24,
+	"PrivilegeBackup":             25,
 }
 
 func (x ObjectPrivilege) String() string {
@@ -1395,162 +1398,162 @@ func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206
 var fileDescriptor_555bd8c177793206 = []byte{
 	// 2531 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xc9, 0x73, 0x24, 0x47,
-	0xd5, 0x57, 0xa9, 0x5b, 0x4b, 0x67, 0xb7, 0x5a, 0x4f, 0x29, 0x8d, 0xa6, 0x3d, 0x8b, 0x47, 0xd6,
-	0x67, 0x7f, 0x9f, 0xbe, 0xfe, 0x6c, 0x8d, 0x3d, 0x8e, 0xf8, 0x20, 0x88, 0x30, 0x81, 0xd4, 0x2d,
-	0x69, 0x14, 0xd6, 0x46, 0x49, 0x63, 0x08, 0x22, 0x60, 0x22, 0xbb, 0xea, 0xa9, 0x95, 0x33, 0xd5,
-	0x95, 0x45, 0x65, 0xb6, 0x46, 0xcd, 0xc9, 0x18, 0xf0, 0x85, 0x0b, 0x98, 0x7f, 0x80, 0x3f, 0x00,
-	0x08, 0x76, 0x38, 0xb2, 0x63, 0xb3, 0x9d, 0xd9, 0xe1, 0x08, 0x77, 0x16, 0xe3, 0x95, 0x78, 0x59,
-	0xd5, 0x55, 0xd5, 0x9a, 0x31, 0x1c, 0xb8, 0x75, 0xfe, 0xde, 0xcb, 0xb7, 0xe5, 0xdb, 0xaa, 0x59,
-	0xcd, 0x53, 0xbd, 0x9e, 0x0a, 0x57, 0xa3, 0x58, 0x19, 0xc5, 0xe7, 0x7b, 0x32, 0x38, 0xed, 0xeb,
-	0xe4, 0xb4, 0x9a, 0x90, 0x2e, 0x2d, 0x75, 0x95, 0xea, 0x06, 0x78, 0xdd, 0x82, 0x9d, 0xfe, 0xf1,
-	0x75, 0x1f, 0xb5, 0x17, 0xcb, 0xc8, 0xa8, 0x38, 0x61, 0x5c, 0xbe, 0xcd, 0x26, 0x0f, 0x8d, 0x30,
-	0x7d, 0xcd, 0x9f, 0x61, 0x0c, 0xe3, 0x58, 0xc5, 0xb7, 0x3d, 0xe5, 0x63, 0xc3, 0x59, 0x72, 0x56,
-	0xea, 0x37, 0x1e, 0x5e, 0x7d, 0x80, 0xd4, 0xd5, 0x0d, 0x62, 0x6b, 0x29, 0x1f, 0xdd, 0x0a, 0x0e,
-	0x7f, 0xf2, 0x45, 0x36, 0x19, 0xa3, 0xd0, 0x2a, 0x6c, 0x8c, 0x2f, 0x39, 0x2b, 0x15, 0x37, 0x3d,
-	0x2d, 0xff, 0x3f, 0xab, 0x3d, 0x8b, 0x83, 0xe7, 0x44, 0xd0, 0xc7, 0x03, 0x21, 0x63, 0x0e, 0xac,
-	0x74, 0x17, 0x07, 0x56, 0x7e, 0xc5, 0xa5, 0x9f, 0x7c, 0x81, 0x4d, 0x9c, 0x12, 0x39, 0xbd, 0x98,
-	0x1c, 0x96, 0x9f, 0x66, 0xd5, 0x67, 0x71, 0xd0, 0x16, 0x46, 0xbc, 0xc3, 0x35, 0xce, 0xca, 0xbe,
-	0x30, 0xc2, 0xde, 0xaa, 0xb9, 0xf6, 0xf7, 0xf2, 0x15, 0x56, 0x5e, 0x0f, 0x54, 0x27, 0x17, 0xe9,
-	0x58, 0x62, 0x2a, 0xf2, 0x94, 0xc1, 0x41, 0x20, 0x3c, 0x3c, 0x51, 0x81, 0x8f, 0xb1, 0x35, 0x89,
-	0xe4, 0x1a, 0xd1, 0x1d, 0xca, 0x35, 0xa2, 0xcb, 0xdf, 0xcd, 0xca, 0x66, 0x10, 0x25, 0xd6, 0xd4,
-	0x6f, 0x3c, 0xfa, 0xc0, 0x08, 0x14, 0xc4, 0x1c, 0x0d, 0x22, 0x74, 0xed, 0x0d, 0x0a, 0x81, 0x55,
-	0xa4, 0x1b, 0xa5, 0xa5, 0xd2, 0x4a, 0xcd, 0x4d, 0x4f, 0xcb, 0x1f, 0x1e, 0xd1, 0xbb, 0x15, 0xab,
-	0x7e, 0xc4, 0xb7, 0x59, 0x2d, 0xca, 0x31, 0xdd, 0x70, 0x96, 0x4a, 0x2b, 0xd5, 0x1b, 0x8f, 0xfd,
-	0x3b, 0x6d, 0xd6, 0x68, 0x77, 0xe4, 0xea, 0xf2, 0x13, 0x6c, 0x6a, 0xcd, 0xf7, 0x63, 0xd4, 0x9a,
-	0xd7, 0xd9, 0xb8, 0x8c, 0x52, 0x67, 0xc6, 0x65, 0x44, 0x31, 0x8a, 0x54, 0x6c, 0xac, 0x2f, 0x25,
-	0xd7, 0xfe, 0x5e, 0x7e, 0xc9, 0x61, 0x53, 0xbb, 0xba, 0xbb, 0x2e, 0x34, 0xf2, 0x77, 0xb1, 0xe9,
-	0x9e, 0xee, 0xde, 0xb6, 0xfe, 0x26, 0x2f, 0x7e, 0xe5, 0x81, 0x16, 0xec, 0xea, 0xae, 0xf5, 0x73,
-	0xaa, 0x97, 0xfc, 0xa0, 0x00, 0xf7, 0x74, 0x77, 0xbb, 0x9d, 0x4a, 0x4e, 0x0e, 0xfc, 0x0a, 0xab,
-	0x18, 0xd9, 0x43, 0x6d, 0x44, 0x2f, 0x6a, 0x94, 0x96, 0x9c, 0x95, 0xb2, 0x9b, 0x03, 0xfc, 0x12,
-	0x9b, 0xd6, 0xaa, 0x1f, 0x7b, 0xb8, 0xdd, 0x6e, 0x94, 0xed, 0xb5, 0xec, 0xbc, 0xfc, 0x0c, 0xab,
-	0xec, 0xea, 0xee, 0x4d, 0x14, 0x3e, 0xc6, 0xfc, 0x49, 0x56, 0xee, 0x08, 0x9d, 0x58, 0x54, 0x7d,
-	0x67, 0x8b, 0xc8, 0x03, 0xd7, 0x72, 0x2e, 0x7f, 0x84, 0xd5, 0xda, 0xbb, 0x3b, 0xff, 0x81, 0x04,
-	0x32, 0x5d, 0x9f, 0x88, 0xd8, 0xdf, 0x13, 0xbd, 0x61, 0x22, 0xe6, 0xc0, 0xf2, 0xeb, 0x0e, 0xab,
-	0x1d, 0xc4, 0xf2, 0x54, 0x06, 0xd8, 0xc5, 0x8d, 0x33, 0xc3, 0xdf, 0xc7, 0xaa, 0xaa, 0x73, 0x07,
-	0x3d, 0x53, 0x8c, 0xdd, 0xb5, 0x07, 0xea, 0xd9, 0xb7, 0x7c, 0x36, 0x7c, 0x4c, 0x65, 0xbf, 0xf9,
-	0x3e, 0x83, 0x54, 0x42, 0x34, 0x14, 0xfc, 0x2f, 0x53, 0x2e, 0x11, 0x93, 0x19, 0xe1, 0xce, 0xaa,
-	0x51, 0x80, 0x37, 0xd9, 0x5c, 0x2a, 0x30, 0x14, 0x3d, 0xbc, 0x2d, 0x43, 0x1f, 0xcf, 0xec, 0x23,
-	0x4c, 0x0c, 0x79, 0xc9, 0x95, 0x6d, 0x82, 0xf9, 0xe3, 0x8c, 0xdf, 0xc7, 0xab, 0xed, 0xa3, 0x4c,
-	0xb8, 0x70, 0x8e, 0x59, 0x37, 0x5f, 0xa8, 0xb0, 0x4a, 0x56, 0xf3, 0xbc, 0xca, 0xa6, 0x0e, 0xfb,
-	0x9e, 0x87, 0x5a, 0xc3, 0x18, 0x9f, 0x67, 0xb3, 0xb7, 0x42, 0x3c, 0x8b, 0xd0, 0x33, 0xe8, 0x5b,
-	0x1e, 0x70, 0xf8, 0x1c, 0x9b, 0x69, 0xa9, 0x30, 0x44, 0xcf, 0x6c, 0x0a, 0x19, 0xa0, 0x0f, 0xe3,
-	0x7c, 0x81, 0xc1, 0x01, 0xc6, 0x3d, 0xa9, 0xb5, 0x54, 0x61, 0x1b, 0x43, 0x89, 0x3e, 0x94, 0xf8,
-	0x45, 0x36, 0xdf, 0x52, 0x41, 0x80, 0x9e, 0x91, 0x2a, 0xdc, 0x53, 0x66, 0xe3, 0x4c, 0x6a, 0xa3,
-	0xa1, 0x4c, 0x62, 0xb7, 0x83, 0x00, 0xbb, 0x22, 0x58, 0x8b, 0xbb, 0xfd, 0x1e, 0x86, 0x06, 0x26,
-	0x48, 0x46, 0x0a, 0xb6, 0x65, 0x0f, 0x43, 0x92, 0x04, 0x53, 0x05, 0xd4, 0x5a, 0x4b, 0xb1, 0x85,
-	0x69, 0xfe, 0x10, 0xbb, 0x90, 0xa2, 0x05, 0x05, 0xa2, 0x87, 0x50, 0xe1, 0xb3, 0xac, 0x9a, 0x92,
-	0x8e, 0xf6, 0x0f, 0x9e, 0x05, 0x56, 0x90, 0xe0, 0xaa, 0x7b, 0x2e, 0x7a, 0x2a, 0xf6, 0xa1, 0x5a,
-	0x30, 0xe1, 0x39, 0xf4, 0x8c, 0x8a, 0xb7, 0xdb, 0x50, 0x23, 0x83, 0x53, 0xf0, 0x10, 0x45, 0xec,
-	0x9d, 0xb8, 0xa8, 0xfb, 0x81, 0x81, 0x19, 0x0e, 0xac, 0xb6, 0x29, 0x03, 0xdc, 0x53, 0x66, 0x53,
-	0xf5, 0x43, 0x1f, 0xea, 0xbc, 0xce, 0xd8, 0x2e, 0x1a, 0x91, 0x46, 0x60, 0x96, 0xd4, 0xb6, 0x84,
-	0x77, 0x82, 0x29, 0x00, 0x7c, 0x91, 0xf1, 0x96, 0x08, 0x43, 0x65, 0x5a, 0x31, 0x0a, 0x83, 0x9b,
-	0xb6, 0x9a, 0x61, 0x8e, 0xcc, 0x19, 0xc1, 0x65, 0x80, 0xc0, 0x73, 0xee, 0x36, 0x06, 0x98, 0x71,
-	0xcf, 0xe7, 0xdc, 0x29, 0x4e, 0xdc, 0x0b, 0x64, 0xfc, 0x7a, 0x5f, 0x06, 0xbe, 0x0d, 0x49, 0xf2,
-	0x2c, 0x17, 0xc8, 0xc6, 0xd4, 0xf8, 0xbd, 0x9d, 0xed, 0xc3, 0x23, 0x58, 0xe4, 0x17, 0xd8, 0x5c,
-	0x8a, 0xec, 0xa2, 0x89, 0xa5, 0x67, 0x83, 0x77, 0x91, 0x4c, 0xdd, 0xef, 0x9b, 0xfd, 0xe3, 0x5d,
-	0xec, 0xa9, 0x78, 0x00, 0x0d, 0x7a, 0x50, 0x2b, 0x69, 0xf8, 0x44, 0xf0, 0x10, 0x69, 0xd8, 0xe8,
-	0x45, 0x66, 0x90, 0x87, 0x17, 0x2e, 0xf1, 0xcb, 0xec, 0xe2, 0xad, 0xc8, 0x17, 0x06, 0xb7, 0x7b,
-	0xd4, 0x6a, 0x8e, 0x84, 0xbe, 0x4b, 0xee, 0xf6, 0x63, 0x84, 0xcb, 0xfc, 0x12, 0x5b, 0x1c, 0x7d,
-	0x8b, 0x2c, 0x58, 0x57, 0xe8, 0x62, 0xe2, 0x6d, 0x2b, 0x46, 0x1f, 0x43, 0x23, 0x45, 0x30, 0xbc,
-	0x78, 0x35, 0x97, 0x7a, 0x3f, 0xf1, 0x61, 0x22, 0x26, 0x9e, 0xdf, 0x4f, 0xbc, 0xc6, 0x1b, 0x6c,
-	0x61, 0x0b, 0xcd, 0xfd, 0x94, 0x25, 0xa2, 0xec, 0x48, 0x6d, 0x49, 0xb7, 0x34, 0xc6, 0x7a, 0x48,
-	0x79, 0x84, 0x73, 0x56, 0xdf, 0x42, 0x43, 0xe0, 0x10, 0x5b, 0xa6, 0x38, 0x25, 0xe6, 0xb9, 0x2a,
-	0xc0, 0x21, 0xfc, 0x5f, 0x14, 0x83, 0x76, 0xac, 0xa2, 0x22, 0xf8, 0x28, 0xb9, 0xb9, 0x1f, 0x61,
-	0x2c, 0x0c, 0x92, 0x8c, 0x22, 0xed, 0x31, 0x92, 0x73, 0x88, 0x14, 0x81, 0x22, 0xfc, 0xdf, 0x39,
-	0x5c, 0xd4, 0xfa, 0x3f, 0x94, 0xc3, 0x29, 0x37, 0x26, 0x7d, 0x72, 0x48, 0x5a, 0x21, 0xaf, 0x53,
-	0x25, 0x59, 0xfd, 0x0f, 0x89, 0xff, 0x4b, 0xa9, 0x92, 0xdc, 0xdb, 0x8a, 0x45, 0x68, 0x86, 0x78,
-	0x93, 0x3f, 0xc2, 0xae, 0xba, 0x78, 0x1c, 0xa3, 0x3e, 0x39, 0x50, 0x81, 0xf4, 0x06, 0xdb, 0xe1,
-	0xb1, 0xca, 0x52, 0x92, 0x58, 0xfe, 0x8f, 0x2c, 0xa1, 0xb0, 0x24, 0xf4, 0x21, 0xfc, 0x38, 0xc5,
-	0x64, 0x4f, 0x99, 0x43, 0x6a, 0x87, 0x3b, 0xb6, 0xc1, 0xc2, 0x13, 0xa4, 0x65, 0x4f, 0xb9, 0x18,
-	0x05, 0xd2, 0x13, 0x6b, 0xa7, 0x42, 0x06, 0xa2, 0x13, 0x20, 0xac, 0x52, 0x50, 0x0e, 0xb1, 0x4b,
-	0x25, 0x9b, 0xbd, 0xef, 0x75, 0x3e, 0xc3, 0x2a, 0x9b, 0x2a, 0xf6, 0xb0, 0x8d, 0xe1, 0x00, 0x9e,
-	0xa4, 0xa3, 0x2b, 0x0c, 0xee, 0xc8, 0x9e, 0x34, 0xf0, 0x14, 0xe5, 0x1b, 0xcd, 0xf9, 0x96, 0x52,
-	0xb1, 0xbf, 0xb7, 0x06, 0x3e, 0xe7, 0x6c, 0xa6, 0xdd, 0x76, 0xf1, 0xa3, 0x7d, 0xd4, 0xc6, 0x15,
-	0x1e, 0xc2, 0x9f, 0xa6, 0x9a, 0x1e, 0x63, 0x36, 0x07, 0x69, 0x5b, 0x41, 0xb2, 0x28, 0x3f, 0xed,
-	0xa9, 0x10, 0x61, 0x8c, 0xd7, 0xd8, 0xf4, 0xad, 0x50, 0x6a, 0xdd, 0x47, 0x1f, 0x1c, 0xaa, 0xbf,
-	0xed, 0xf0, 0x20, 0x56, 0x5d, 0x1a, 0x8c, 0x30, 0x4e, 0xd4, 0x4d, 0x19, 0x4a, 0x7d, 0x62, 0x3b,
-	0x0f, 0x63, 0x93, 0x69, 0x21, 0x96, 0x79, 0x85, 0x4d, 0xb8, 0x68, 0xe2, 0x01, 0x4c, 0x34, 0x5f,
-	0x70, 0x58, 0x2d, 0xb5, 0x3e, 0xd1, 0xb3, 0xc0, 0xa0, 0x78, 0xce, 0x35, 0x65, 0xa5, 0xe0, 0x50,
-	0x43, 0xdc, 0x8a, 0xd5, 0x3d, 0x19, 0x76, 0x61, 0x9c, 0x04, 0x1f, 0xa2, 0x08, 0xac, 0x92, 0x2a,
-	0x9b, 0xda, 0x0c, 0xfa, 0x56, 0x63, 0xd9, 0xea, 0xa7, 0x03, 0xb1, 0x4d, 0x10, 0x89, 0x52, 0x27,
-	0x42, 0x1f, 0x26, 0x29, 0x1c, 0x49, 0xc1, 0x10, 0x6d, 0xaa, 0xf9, 0x5e, 0x36, 0x7b, 0x6e, 0xbf,
-	0xe0, 0xd3, 0xac, 0x9c, 0xaa, 0x06, 0x56, 0x5b, 0x97, 0xa1, 0x88, 0x07, 0x49, 0x57, 0x02, 0x9f,
-	0xa2, 0xb7, 0x19, 0x28, 0x61, 0x52, 0x00, 0x9b, 0xaf, 0xd6, 0xec, 0x80, 0xb7, 0x17, 0x67, 0x58,
-	0xe5, 0x56, 0xe8, 0xe3, 0xb1, 0x0c, 0xd1, 0x87, 0x31, 0xdb, 0x2d, 0x92, 0x3a, 0xcb, 0xcb, 0x96,
-	0xc2, 0x5d, 0x27, 0x63, 0x0a, 0x18, 0x52, 0xc9, 0xdf, 0x14, 0xba, 0x00, 0x1d, 0xd3, 0x8b, 0xb7,
-	0xed, 0xfa, 0xd8, 0x29, 0x5e, 0xef, 0xda, 0x17, 0x3f, 0x51, 0xf7, 0x72, 0x4c, 0xc3, 0x09, 0x69,
-	0xda, 0x42, 0x73, 0x38, 0xd0, 0x06, 0x7b, 0x2d, 0x15, 0x1e, 0xcb, 0xae, 0x06, 0x49, 0x9a, 0x76,
-	0x94, 0xf0, 0x0b, 0xd7, 0xef, 0x50, 0xce, 0xb9, 0x18, 0xa0, 0xd0, 0x45, 0xa9, 0x77, 0x6d, 0xbf,
-	0xb4, 0xa6, 0xae, 0x05, 0x52, 0x68, 0x08, 0xc8, 0x15, 0xb2, 0x32, 0x39, 0xf6, 0xe8, 0x7d, 0xd7,
-	0x02, 0x83, 0x71, 0x72, 0x0e, 0xf9, 0x02, 0x9b, 0x4d, 0xf8, 0x0f, 0x44, 0x6c, 0xa4, 0x15, 0xf2,
-	0xb2, 0x63, 0x33, 0x29, 0x56, 0x51, 0x8e, 0xbd, 0x42, 0xe3, 0xa9, 0x76, 0x53, 0xe8, 0x1c, 0xfa,
-	0x89, 0xc3, 0x17, 0xd9, 0xdc, 0xd0, 0xb5, 0x1c, 0xff, 0xa9, 0xc3, 0xe7, 0x59, 0x9d, 0x5c, 0xcb,
-	0x30, 0x0d, 0x3f, 0xb3, 0x20, 0x39, 0x51, 0x00, 0x7f, 0x6e, 0x25, 0xa4, 0x5e, 0x14, 0xf0, 0x5f,
-	0x58, 0x65, 0x24, 0x21, 0x4d, 0x22, 0x0d, 0xaf, 0x39, 0x64, 0xe9, 0x50, 0x59, 0x0a, 0xc3, 0xeb,
-	0x96, 0x91, 0xa4, 0x66, 0x8c, 0x6f, 0x58, 0xc6, 0x54, 0x66, 0x86, 0xbe, 0x69, 0xd1, 0x9b, 0x22,
-	0xf4, 0xd5, 0xf1, 0x71, 0x86, 0xbe, 0xe5, 0xf0, 0x06, 0x9b, 0xa7, 0xeb, 0xeb, 0x22, 0x10, 0xa1,
-	0x97, 0xf3, 0xbf, 0xed, 0xf0, 0x0b, 0x0c, 0xce, 0xa9, 0xd3, 0xf0, 0xfc, 0x38, 0x87, 0x61, 0x7c,
-	0x6d, 0x1d, 0xc1, 0x17, 0xc6, 0x6d, 0xac, 0x52, 0xc6, 0x04, 0xfb, 0xe2, 0x38, 0xaf, 0x27, 0x41,
-	0x4f, 0xce, 0x5f, 0x1a, 0xe7, 0x55, 0x36, 0xb9, 0x1d, 0x6a, 0x8c, 0x0d, 0x7c, 0x86, 0xf2, 0x7b,
-	0x32, 0xe9, 0xbd, 0xf0, 0x59, 0xaa, 0xa8, 0x09, 0x9b, 0xdf, 0xf0, 0x12, 0xcd, 0x75, 0xee, 0xa2,
-	0xc6, 0xd0, 0x2f, 0xd4, 0x8e, 0x86, 0xcf, 0xd9, 0x1b, 0xc9, 0xe0, 0x84, 0xbf, 0x94, 0x6c, 0x68,
-	0x8a, 0x53, 0xf4, 0xaf, 0x25, 0x32, 0x61, 0x0b, 0x4d, 0x5e, 0xd9, 0xf0, 0xb7, 0x12, 0xbf, 0xc4,
-	0x2e, 0x0c, 0x31, 0x3b, 0xd3, 0xb2, 0x9a, 0xfe, 0x7b, 0x89, 0x5f, 0x61, 0x17, 0xa9, 0xc1, 0x67,
-	0x79, 0x43, 0x97, 0xa4, 0x36, 0xd2, 0xd3, 0xf0, 0x6a, 0x89, 0x5f, 0x66, 0x8b, 0x5b, 0x68, 0xb2,
-	0xf7, 0x28, 0x10, 0xff, 0x51, 0xe2, 0x33, 0x6c, 0x9a, 0xaa, 0x5e, 0xe2, 0x29, 0xc2, 0x6b, 0x25,
-	0x7a, 0xd4, 0xe1, 0x31, 0x35, 0xe7, 0xf5, 0x12, 0x85, 0xfa, 0x03, 0xc2, 0x78, 0x27, 0xed, 0x5e,
-	0xeb, 0x44, 0x84, 0x21, 0x06, 0x1a, 0xde, 0x28, 0x51, 0x40, 0x5d, 0xec, 0xa9, 0x53, 0x2c, 0xc0,
-	0x6f, 0x5a, 0xa7, 0x2d, 0xf3, 0xfb, 0xfb, 0x18, 0x0f, 0x32, 0xc2, 0x5b, 0x25, 0x7a, 0x9a, 0x84,
-	0x7f, 0x94, 0xf2, 0x76, 0x89, 0x5f, 0x65, 0x8d, 0xa4, 0x59, 0x0c, 0x1f, 0x86, 0x88, 0x5d, 0xa4,
-	0xc6, 0x0c, 0xcf, 0x97, 0x33, 0x89, 0x6d, 0x0c, 0x8c, 0xc8, 0xee, 0x7d, 0xbc, 0x4c, 0x76, 0x51,
-	0x71, 0xe5, 0xfd, 0x58, 0xc3, 0x0b, 0x65, 0x7a, 0xd1, 0x2d, 0x34, 0x69, 0x4b, 0xd6, 0xf0, 0x09,
-	0x5a, 0xa3, 0xea, 0xb7, 0x42, 0xdd, 0xef, 0x64, 0x86, 0xc2, 0x27, 0x87, 0x97, 0xdb, 0x52, 0x9b,
-	0x58, 0x76, 0xfa, 0x36, 0xd3, 0x3f, 0x55, 0x26, 0xa7, 0x0e, 0x07, 0xa1, 0x37, 0x02, 0xbf, 0x68,
-	0x65, 0xa6, 0xb6, 0x59, 0xa3, 0x7e, 0x59, 0xe6, 0xb3, 0x8c, 0x25, 0x55, 0x6d, 0x81, 0x5f, 0x0d,
-	0xe5, 0xd1, 0xde, 0x74, 0x8a, 0xb1, 0x1d, 0x2a, 0xf0, 0xeb, 0xcc, 0xc4, 0x42, 0xef, 0x84, 0xdf,
-	0x94, 0x29, 0xe8, 0x47, 0xb2, 0x87, 0x47, 0xd2, 0xbb, 0x0b, 0x5f, 0xae, 0x90, 0x7d, 0x36, 0x26,
-	0x7b, 0xca, 0xc7, 0x24, 0x47, 0xbe, 0x52, 0xa1, 0x94, 0xa3, 0x4c, 0x4e, 0x52, 0xee, 0xab, 0xf6,
-	0x9c, 0x8e, 0x82, 0xed, 0x36, 0x7c, 0x8d, 0xf6, 0x37, 0x96, 0x9e, 0x8f, 0x0e, 0xf7, 0xe1, 0xeb,
-	0x15, 0x52, 0xb5, 0x16, 0x04, 0xca, 0x13, 0x26, 0xab, 0xa7, 0x6f, 0x54, 0xa8, 0x20, 0x0b, 0xda,
-	0xd3, 0x77, 0xff, 0x66, 0xc5, 0x3a, 0x9a, 0xe0, 0x36, 0x5d, 0xdb, 0xd4, 0x56, 0xbf, 0x65, 0xa5,
-	0xd2, 0x0c, 0x22, 0x4b, 0x8e, 0x0c, 0x7c, 0xdb, 0xf2, 0x9d, 0x5f, 0x49, 0xe0, 0xb7, 0xd5, 0x34,
-	0x43, 0x0b, 0xd8, 0xef, 0xaa, 0x49, 0x85, 0x8d, 0xee, 0x20, 0xf0, 0x7b, 0x0b, 0x9f, 0xdf, 0x5b,
-	0xe0, 0x0f, 0x55, 0x32, 0xac, 0xb8, 0x7a, 0xd0, 0x02, 0xae, 0xe1, 0x8f, 0x55, 0xb2, 0x20, 0x5f,
-	0x32, 0xe0, 0x3b, 0x35, 0x0a, 0xd6, 0x70, 0xbd, 0x80, 0xef, 0xd6, 0xc8, 0xcd, 0x73, 0x8b, 0x05,
-	0x7c, 0xaf, 0x66, 0x9f, 0x23, 0x5b, 0x29, 0xe0, 0xfb, 0x05, 0x80, 0xb8, 0xe0, 0x07, 0x35, 0xdb,
-	0xc3, 0x46, 0xd6, 0x08, 0xf8, 0x61, 0x8d, 0x6c, 0x3b, 0xbf, 0x40, 0xc0, 0x8f, 0x6a, 0xc9, 0x73,
-	0x67, 0xab, 0x03, 0xfc, 0xb8, 0x46, 0x35, 0xf4, 0xe0, 0xa5, 0x01, 0x5e, 0xb6, 0xba, 0xf2, 0x75,
-	0x01, 0x5e, 0xa9, 0x35, 0x97, 0xd9, 0x54, 0x5b, 0x07, 0x76, 0xf2, 0x4c, 0xb1, 0x52, 0x5b, 0x07,
-	0x30, 0x46, 0x8d, 0x7a, 0x5d, 0xa9, 0x60, 0xe3, 0x2c, 0x8a, 0x9f, 0x7b, 0x0a, 0x9c, 0xe6, 0x3a,
-	0x9b, 0x6d, 0xa9, 0x5e, 0x24, 0xb2, 0x82, 0xb5, 0xc3, 0x26, 0x99, 0x52, 0xe8, 0x27, 0xa9, 0x32,
-	0x46, 0xdd, 0x7e, 0xe3, 0x0c, 0xbd, 0xbe, 0x9d, 0x89, 0x0e, 0x1d, 0xe9, 0x12, 0x05, 0xd9, 0x87,
-	0xf1, 0xe6, 0x07, 0x19, 0xb4, 0x54, 0xa8, 0xa5, 0x36, 0x18, 0x7a, 0x83, 0x1d, 0x3c, 0xc5, 0xc0,
-	0x4e, 0x5e, 0x13, 0xab, 0xb0, 0x0b, 0x63, 0xf6, 0x1b, 0x05, 0xed, 0xb7, 0x46, 0x32, 0x9f, 0xd7,
-	0x69, 0x0f, 0xb1, 0x1f, 0x22, 0x75, 0xc6, 0x36, 0x4e, 0x31, 0x34, 0x7d, 0x11, 0x04, 0x03, 0x28,
-	0xd1, 0xb9, 0xd5, 0xd7, 0x46, 0xf5, 0xe4, 0xc7, 0x68, 0x4c, 0x37, 0x3f, 0xed, 0xb0, 0x6a, 0x32,
-	0x8c, 0x33, 0xd3, 0x92, 0xe3, 0x01, 0x86, 0xbe, 0xb4, 0xc2, 0x69, 0x8f, 0xb6, 0x50, 0xba, 0x41,
-	0x38, 0x39, 0xd3, 0xa1, 0x11, 0xb1, 0xb5, 0xd0, 0x7e, 0x3e, 0xa4, 0xf7, 0x62, 0x6b, 0xa7, 0x0f,
-	0x13, 0x39, 0x98, 0xfb, 0x32, 0x49, 0x0b, 0x63, 0x51, 0xdc, 0x5a, 0xe8, 0xb7, 0x02, 0x14, 0x34,
-	0xaf, 0xa7, 0x9a, 0x37, 0x18, 0xcb, 0x3f, 0x1f, 0xad, 0xad, 0xf9, 0x88, 0x1c, 0x23, 0x8f, 0xb7,
-	0x02, 0xd5, 0x11, 0x01, 0x38, 0xb4, 0x21, 0xd8, 0x07, 0x1f, 0x6f, 0xbe, 0x38, 0xc1, 0x66, 0xcf,
-	0x7d, 0x2c, 0x92, 0xc9, 0xd9, 0x61, 0x2d, 0xa0, 0x57, 0xb9, 0xca, 0x1e, 0xca, 0x90, 0xfb, 0x56,
-	0x02, 0x87, 0x16, 0xcc, 0x8c, 0x7c, 0x6e, 0x37, 0x18, 0xe7, 0xd7, 0xd8, 0xe5, 0x9c, 0x78, 0xff,
-	0x46, 0x40, 0x6d, 0xb9, 0x91, 0x31, 0x9c, 0x5f, 0x0d, 0xca, 0x14, 0xad, 0x8c, 0x4a, 0x95, 0x9e,
-	0x7c, 0xda, 0xe5, 0x5f, 0xb6, 0xc9, 0xc8, 0x83, 0x49, 0xfa, 0xda, 0xca, 0x6d, 0xcc, 0x52, 0x06,
-	0xa6, 0x28, 0x8e, 0x19, 0x21, 0x1d, 0x47, 0xd3, 0x23, 0x60, 0x3a, 0x96, 0x2a, 0x14, 0xdc, 0x0c,
-	0xa4, 0x7e, 0x94, 0xb7, 0x02, 0x46, 0xdf, 0x00, 0xe7, 0x42, 0x90, 0xf4, 0x9c, 0xea, 0x08, 0xc5,
-	0x62, 0x6d, 0x34, 0x42, 0x06, 0x50, 0xa3, 0x1d, 0x68, 0x24, 0x2e, 0xc9, 0x8d, 0x99, 0x11, 0xe5,
-	0xe9, 0x84, 0xab, 0xd3, 0xb6, 0x93, 0xaf, 0xe7, 0x76, 0x36, 0xce, 0x8e, 0x60, 0xb6, 0xf7, 0x01,
-	0x8c, 0xa8, 0x2b, 0x0c, 0x71, 0x98, 0x1b, 0x75, 0xd4, 0x26, 0x09, 0xf0, 0x91, 0xe8, 0x26, 0x76,
-	0xef, 0xdf, 0x0b, 0x31, 0xd6, 0x27, 0x32, 0x82, 0xf9, 0x91, 0xa0, 0x25, 0xed, 0xc7, 0xe6, 0xc5,
-	0xc2, 0x48, 0x28, 0xc8, 0xf4, 0xfc, 0xd2, 0x85, 0xd1, 0x07, 0xb3, 0x0d, 0x20, 0xa7, 0x2e, 0x8e,
-	0x50, 0x77, 0x45, 0x28, 0xba, 0x05, 0x85, 0x17, 0x47, 0x14, 0x16, 0x3a, 0x4f, 0xe3, 0x3d, 0x8a,
-	0xcd, 0x65, 0x7f, 0x6d, 0xdc, 0xc6, 0x33, 0x73, 0x5b, 0x75, 0xee, 0xf0, 0x6b, 0xab, 0xc9, 0x5f,
-	0x92, 0xab, 0xc3, 0xbf, 0x24, 0x57, 0x77, 0x51, 0x6b, 0x12, 0x19, 0xd9, 0xfc, 0x68, 0xfc, 0x79,
-	0xca, 0xfe, 0x67, 0xf3, 0xc8, 0x83, 0xff, 0x09, 0x2b, 0xfc, 0x07, 0xe3, 0xce, 0x46, 0x85, 0xd3,
-	0x7e, 0xe7, 0xce, 0xfa, 0x0e, 0xab, 0x4b, 0x35, 0xbc, 0xd7, 0x8d, 0x23, 0x6f, 0xbd, 0xda, 0xb2,
-	0xf7, 0x0e, 0x48, 0xc6, 0x81, 0xf3, 0xa1, 0x95, 0xae, 0x34, 0x27, 0xfd, 0x0e, 0x49, 0xbb, 0x9e,
-	0xb0, 0x3d, 0x21, 0x55, 0xfa, 0xeb, 0xba, 0x88, 0xe4, 0xf5, 0x44, 0x4d, 0xd4, 0xf9, 0xbc, 0xe3,
-	0x74, 0x26, 0xad, 0xe6, 0xa7, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x05, 0x17, 0xce, 0xdd, 0x67,
-	0x15, 0x00, 0x00,
+	0xd5, 0x9f, 0x52, 0xb7, 0x96, 0xce, 0x6e, 0x49, 0x4f, 0x29, 0x8d, 0x46, 0xb3, 0x79, 0x64, 0x7d,
+	0xf6, 0x87, 0x68, 0x6c, 0x8d, 0x3d, 0x8e, 0x00, 0x82, 0x08, 0x13, 0x48, 0xdd, 0x92, 0x46, 0x61,
+	0x6d, 0x94, 0x34, 0x86, 0x20, 0x02, 0x26, 0xb2, 0xab, 0x9e, 0x5a, 0x39, 0x53, 0x5d, 0x59, 0x54,
+	0x66, 0x6b, 0xd4, 0x9c, 0x8c, 0x59, 0x2e, 0x5c, 0xc0, 0x1c, 0xb9, 0xf0, 0x07, 0x00, 0xc1, 0x0e,
+	0x47, 0x76, 0x6c, 0xb6, 0x33, 0x3b, 0x1c, 0xe1, 0xce, 0x62, 0xbc, 0x12, 0x2f, 0x6b, 0x6d, 0xcd,
+	0x18, 0x0e, 0xdc, 0x3a, 0x7f, 0x6f, 0xcd, 0x97, 0x6f, 0xab, 0x66, 0x0d, 0x4f, 0xf5, 0x7a, 0x2a,
+	0x5c, 0x89, 0x62, 0x65, 0x14, 0x9f, 0xed, 0xc9, 0xe0, 0xa4, 0xaf, 0x93, 0xd3, 0x4a, 0x42, 0xba,
+	0xb4, 0xd8, 0x55, 0xaa, 0x1b, 0xe0, 0x75, 0x0b, 0x76, 0xfa, 0x47, 0xd7, 0x7d, 0xd4, 0x5e, 0x2c,
+	0x23, 0xa3, 0xe2, 0x84, 0x71, 0xe9, 0x36, 0x1b, 0x3b, 0x30, 0xc2, 0xf4, 0x35, 0x7f, 0x9a, 0x31,
+	0x8c, 0x63, 0x15, 0xdf, 0xf6, 0x94, 0x8f, 0x0b, 0xce, 0xa2, 0xb3, 0x3c, 0x75, 0xe3, 0xa1, 0x95,
+	0x07, 0x68, 0x5d, 0x59, 0x27, 0xb6, 0x96, 0xf2, 0xd1, 0xad, 0x61, 0xf6, 0x93, 0xcf, 0xb3, 0xb1,
+	0x18, 0x85, 0x56, 0xe1, 0xc2, 0xc8, 0xa2, 0xb3, 0x5c, 0x73, 0xd3, 0xd3, 0xd2, 0x3b, 0x59, 0xe3,
+	0x19, 0x1c, 0x3c, 0x2b, 0x82, 0x3e, 0xee, 0x0b, 0x19, 0x73, 0x60, 0x95, 0xbb, 0x38, 0xb0, 0xfa,
+	0x6b, 0x2e, 0xfd, 0xe4, 0x73, 0x6c, 0xf4, 0x84, 0xc8, 0xa9, 0x60, 0x72, 0x58, 0x7a, 0x8a, 0xd5,
+	0x9f, 0xc1, 0x41, 0x5b, 0x18, 0xf1, 0x16, 0x62, 0x9c, 0x55, 0x7d, 0x61, 0x84, 0x95, 0x6a, 0xb8,
+	0xf6, 0xf7, 0xd2, 0x15, 0x56, 0x5d, 0x0b, 0x54, 0xa7, 0x50, 0xe9, 0x58, 0x62, 0xaa, 0xf2, 0x84,
+	0xc1, 0x7e, 0x20, 0x3c, 0x3c, 0x56, 0x81, 0x8f, 0xb1, 0x75, 0x89, 0xf4, 0x1a, 0xd1, 0xcd, 0xf4,
+	0x1a, 0xd1, 0xe5, 0xef, 0x66, 0x55, 0x33, 0x88, 0x12, 0x6f, 0xa6, 0x6e, 0x3c, 0xf2, 0xc0, 0x08,
+	0x94, 0xd4, 0x1c, 0x0e, 0x22, 0x74, 0xad, 0x04, 0x85, 0xc0, 0x1a, 0xd2, 0x0b, 0x95, 0xc5, 0xca,
+	0x72, 0xc3, 0x4d, 0x4f, 0x4b, 0x1f, 0x1e, 0xb2, 0xbb, 0x19, 0xab, 0x7e, 0xc4, 0xb7, 0x58, 0x23,
+	0x2a, 0x30, 0xbd, 0xe0, 0x2c, 0x56, 0x96, 0xeb, 0x37, 0x1e, 0xfd, 0x6f, 0xd6, 0xac, 0xd3, 0xee,
+	0x90, 0xe8, 0xd2, 0xe3, 0x6c, 0x7c, 0xd5, 0xf7, 0x63, 0xd4, 0x9a, 0x4f, 0xb1, 0x11, 0x19, 0xa5,
+	0x97, 0x19, 0x91, 0x11, 0xc5, 0x28, 0x52, 0xb1, 0xb1, 0x77, 0xa9, 0xb8, 0xf6, 0xf7, 0xd2, 0x0b,
+	0x0e, 0x1b, 0xdf, 0xd1, 0xdd, 0x35, 0xa1, 0x91, 0xbf, 0x8b, 0x4d, 0xf4, 0x74, 0xf7, 0xb6, 0xbd,
+	0x6f, 0xf2, 0xe2, 0x57, 0x1e, 0xe8, 0xc1, 0x8e, 0xee, 0xda, 0x7b, 0x8e, 0xf7, 0x92, 0x1f, 0x14,
+	0xe0, 0x9e, 0xee, 0x6e, 0xb5, 0x53, 0xcd, 0xc9, 0x81, 0x5f, 0x61, 0x35, 0x23, 0x7b, 0xa8, 0x8d,
+	0xe8, 0x45, 0x0b, 0x95, 0x45, 0x67, 0xb9, 0xea, 0x16, 0x00, 0xbf, 0xc4, 0x26, 0xb4, 0xea, 0xc7,
+	0x1e, 0x6e, 0xb5, 0x17, 0xaa, 0x56, 0x2c, 0x3f, 0x2f, 0x3d, 0xcd, 0x6a, 0x3b, 0xba, 0x7b, 0x13,
+	0x85, 0x8f, 0x31, 0x7f, 0x82, 0x55, 0x3b, 0x42, 0x27, 0x1e, 0xd5, 0xdf, 0xda, 0x23, 0xba, 0x81,
+	0x6b, 0x39, 0x97, 0x3e, 0xc2, 0x1a, 0xed, 0x9d, 0xed, 0xff, 0x41, 0x03, 0xb9, 0xae, 0x8f, 0x45,
+	0xec, 0xef, 0x8a, 0x5e, 0x96, 0x88, 0x05, 0xb0, 0xf4, 0xaa, 0xc3, 0x1a, 0xfb, 0xb1, 0x3c, 0x91,
+	0x01, 0x76, 0x71, 0xfd, 0xd4, 0xf0, 0xf7, 0xb1, 0xba, 0xea, 0xdc, 0x41, 0xcf, 0x94, 0x63, 0x77,
+	0xed, 0x81, 0x76, 0xf6, 0x2c, 0x9f, 0x0d, 0x1f, 0x53, 0xf9, 0x6f, 0xbe, 0xc7, 0x20, 0xd5, 0x10,
+	0x65, 0x8a, 0xff, 0x63, 0xca, 0x25, 0x6a, 0x72, 0x27, 0xdc, 0x69, 0x35, 0x0c, 0xf0, 0x26, 0x9b,
+	0x49, 0x15, 0x86, 0xa2, 0x87, 0xb7, 0x65, 0xe8, 0xe3, 0xa9, 0x7d, 0x84, 0xd1, 0x8c, 0x97, 0xae,
+	0xb2, 0x45, 0x30, 0x7f, 0x8c, 0xf1, 0xfb, 0x78, 0xb5, 0x7d, 0x94, 0x51, 0x17, 0xce, 0x30, 0xeb,
+	0xe6, 0xf3, 0x35, 0x56, 0xcb, 0x6b, 0x9e, 0xd7, 0xd9, 0xf8, 0x41, 0xdf, 0xf3, 0x50, 0x6b, 0x38,
+	0xc7, 0x67, 0xd9, 0xf4, 0xad, 0x10, 0x4f, 0x23, 0xf4, 0x0c, 0xfa, 0x96, 0x07, 0x1c, 0x3e, 0xc3,
+	0x26, 0x5b, 0x2a, 0x0c, 0xd1, 0x33, 0x1b, 0x42, 0x06, 0xe8, 0xc3, 0x08, 0x9f, 0x63, 0xb0, 0x8f,
+	0x71, 0x4f, 0x6a, 0x2d, 0x55, 0xd8, 0xc6, 0x50, 0xa2, 0x0f, 0x15, 0x7e, 0x81, 0xcd, 0xb6, 0x54,
+	0x10, 0xa0, 0x67, 0xa4, 0x0a, 0x77, 0x95, 0x59, 0x3f, 0x95, 0xda, 0x68, 0xa8, 0x92, 0xda, 0xad,
+	0x20, 0xc0, 0xae, 0x08, 0x56, 0xe3, 0x6e, 0xbf, 0x87, 0xa1, 0x81, 0x51, 0xd2, 0x91, 0x82, 0x6d,
+	0xd9, 0xc3, 0x90, 0x34, 0xc1, 0x78, 0x09, 0xb5, 0xde, 0x52, 0x6c, 0x61, 0x82, 0x5f, 0x64, 0xe7,
+	0x53, 0xb4, 0x64, 0x40, 0xf4, 0x10, 0x6a, 0x7c, 0x9a, 0xd5, 0x53, 0xd2, 0xe1, 0xde, 0xfe, 0x33,
+	0xc0, 0x4a, 0x1a, 0x5c, 0x75, 0xcf, 0x45, 0x4f, 0xc5, 0x3e, 0xd4, 0x4b, 0x2e, 0x3c, 0x8b, 0x9e,
+	0x51, 0xf1, 0x56, 0x1b, 0x1a, 0xe4, 0x70, 0x0a, 0x1e, 0xa0, 0x88, 0xbd, 0x63, 0x17, 0x75, 0x3f,
+	0x30, 0x30, 0xc9, 0x81, 0x35, 0x36, 0x64, 0x80, 0xbb, 0xca, 0x6c, 0xa8, 0x7e, 0xe8, 0xc3, 0x14,
+	0x9f, 0x62, 0x6c, 0x07, 0x8d, 0x48, 0x23, 0x30, 0x4d, 0x66, 0x5b, 0xc2, 0x3b, 0xc6, 0x14, 0x00,
+	0x3e, 0xcf, 0x78, 0x4b, 0x84, 0xa1, 0x32, 0xad, 0x18, 0x85, 0xc1, 0x0d, 0x5b, 0xcd, 0x30, 0x43,
+	0xee, 0x0c, 0xe1, 0x32, 0x40, 0xe0, 0x05, 0x77, 0x1b, 0x03, 0xcc, 0xb9, 0x67, 0x0b, 0xee, 0x14,
+	0x27, 0xee, 0x39, 0x72, 0x7e, 0xad, 0x2f, 0x03, 0xdf, 0x86, 0x24, 0x79, 0x96, 0xf3, 0xe4, 0x63,
+	0xea, 0xfc, 0xee, 0xf6, 0xd6, 0xc1, 0x21, 0xcc, 0xf3, 0xf3, 0x6c, 0x26, 0x45, 0x76, 0xd0, 0xc4,
+	0xd2, 0xb3, 0xc1, 0xbb, 0x40, 0xae, 0xee, 0xf5, 0xcd, 0xde, 0xd1, 0x0e, 0xf6, 0x54, 0x3c, 0x80,
+	0x05, 0x7a, 0x50, 0xab, 0x29, 0x7b, 0x22, 0xb8, 0x48, 0x16, 0xd6, 0x7b, 0x91, 0x19, 0x14, 0xe1,
+	0x85, 0x4b, 0xfc, 0x32, 0xbb, 0x70, 0x2b, 0xf2, 0x85, 0xc1, 0xad, 0x1e, 0xb5, 0x9a, 0x43, 0xa1,
+	0xef, 0xd2, 0x75, 0xfb, 0x31, 0xc2, 0x65, 0x7e, 0x89, 0xcd, 0x0f, 0xbf, 0x45, 0x1e, 0xac, 0x2b,
+	0x24, 0x98, 0xdc, 0xb6, 0x15, 0xa3, 0x8f, 0xa1, 0x91, 0x22, 0xc8, 0x04, 0xaf, 0x16, 0x5a, 0xef,
+	0x27, 0x3e, 0x44, 0xc4, 0xe4, 0xe6, 0xf7, 0x13, 0xaf, 0xf1, 0x05, 0x36, 0xb7, 0x89, 0xe6, 0x7e,
+	0xca, 0x22, 0x51, 0xb6, 0xa5, 0xb6, 0xa4, 0x5b, 0x1a, 0x63, 0x9d, 0x51, 0x1e, 0xe6, 0x9c, 0x4d,
+	0x6d, 0xa2, 0x21, 0x30, 0xc3, 0x96, 0x28, 0x4e, 0x89, 0x7b, 0xae, 0x0a, 0x30, 0x83, 0xff, 0x8f,
+	0x62, 0xd0, 0x8e, 0x55, 0x54, 0x06, 0x1f, 0xa1, 0x6b, 0xee, 0x45, 0x18, 0x0b, 0x83, 0xa4, 0xa3,
+	0x4c, 0x7b, 0x94, 0xf4, 0x1c, 0x20, 0x45, 0xa0, 0x0c, 0xff, 0x7f, 0x01, 0x97, 0xad, 0xbe, 0x8d,
+	0x72, 0x38, 0xe5, 0xc6, 0xa4, 0x4f, 0x66, 0xa4, 0x65, 0xba, 0x75, 0x6a, 0x24, 0xaf, 0xff, 0x8c,
+	0xf8, 0x76, 0x4a, 0x95, 0x44, 0x6e, 0x33, 0x16, 0xa1, 0xc9, 0xf0, 0x26, 0x7f, 0x98, 0x5d, 0x75,
+	0xf1, 0x28, 0x46, 0x7d, 0xbc, 0xaf, 0x02, 0xe9, 0x0d, 0xb6, 0xc2, 0x23, 0x95, 0xa7, 0x24, 0xb1,
+	0xbc, 0x83, 0x3c, 0xa1, 0xb0, 0x24, 0xf4, 0x0c, 0x7e, 0x8c, 0x62, 0xb2, 0xab, 0xcc, 0x01, 0xb5,
+	0xc3, 0x6d, 0xdb, 0x60, 0xe1, 0x71, 0xb2, 0xb2, 0xab, 0x5c, 0x8c, 0x02, 0xe9, 0x89, 0xd5, 0x13,
+	0x21, 0x03, 0xd1, 0x09, 0x10, 0x56, 0x28, 0x28, 0x07, 0xd8, 0xa5, 0x92, 0xcd, 0xdf, 0xf7, 0x3a,
+	0x9f, 0x64, 0xb5, 0x0d, 0x15, 0x7b, 0xd8, 0xc6, 0x70, 0x00, 0x4f, 0xd0, 0xd1, 0x15, 0x06, 0xb7,
+	0x65, 0x4f, 0x1a, 0x78, 0x92, 0xf2, 0x8d, 0xe6, 0x7c, 0x4b, 0xa9, 0xd8, 0xdf, 0x5d, 0x05, 0x9f,
+	0x73, 0x36, 0xd9, 0x6e, 0xbb, 0xf8, 0xd1, 0x3e, 0x6a, 0xe3, 0x0a, 0x0f, 0xe1, 0x2f, 0xe3, 0x4d,
+	0x8f, 0x31, 0x9b, 0x83, 0xb4, 0xad, 0x20, 0x79, 0x54, 0x9c, 0x76, 0x55, 0x88, 0x70, 0x8e, 0x37,
+	0xd8, 0xc4, 0xad, 0x50, 0x6a, 0xdd, 0x47, 0x1f, 0x1c, 0xaa, 0xbf, 0xad, 0x70, 0x3f, 0x56, 0x5d,
+	0x1a, 0x8c, 0x30, 0x42, 0xd4, 0x0d, 0x19, 0x4a, 0x7d, 0x6c, 0x3b, 0x0f, 0x63, 0x63, 0x69, 0x21,
+	0x56, 0x79, 0x8d, 0x8d, 0xba, 0x68, 0xe2, 0x01, 0x8c, 0x36, 0x9f, 0x77, 0x58, 0x23, 0xf5, 0x3e,
+	0xb1, 0x33, 0xc7, 0xa0, 0x7c, 0x2e, 0x2c, 0xe5, 0xa5, 0xe0, 0x50, 0x43, 0xdc, 0x8c, 0xd5, 0x3d,
+	0x19, 0x76, 0x61, 0x84, 0x14, 0x1f, 0xa0, 0x08, 0xac, 0x91, 0x3a, 0x1b, 0xdf, 0x08, 0xfa, 0xd6,
+	0x62, 0xd5, 0xda, 0xa7, 0x03, 0xb1, 0x8d, 0x12, 0x89, 0x52, 0x27, 0x42, 0x1f, 0xc6, 0x28, 0x1c,
+	0x49, 0xc1, 0x10, 0x6d, 0xbc, 0xf9, 0x5e, 0x36, 0x7d, 0x66, 0xbf, 0xe0, 0x13, 0xac, 0x9a, 0x9a,
+	0x06, 0xd6, 0x58, 0x93, 0xa1, 0x88, 0x07, 0x49, 0x57, 0x02, 0x9f, 0xa2, 0xb7, 0x11, 0x28, 0x61,
+	0x52, 0x00, 0x9b, 0x2f, 0x37, 0xec, 0x80, 0xb7, 0x82, 0x93, 0xac, 0x76, 0x2b, 0xf4, 0xf1, 0x48,
+	0x86, 0xe8, 0xc3, 0x39, 0xdb, 0x2d, 0x92, 0x3a, 0x2b, 0xca, 0x96, 0xc2, 0x3d, 0x45, 0xce, 0x94,
+	0x30, 0xa4, 0x92, 0xbf, 0x29, 0x74, 0x09, 0x3a, 0xa2, 0x17, 0x6f, 0xdb, 0xf5, 0xb1, 0x53, 0x16,
+	0xef, 0xda, 0x17, 0x3f, 0x56, 0xf7, 0x0a, 0x4c, 0xc3, 0x31, 0x59, 0xda, 0x44, 0x73, 0x30, 0xd0,
+	0x06, 0x7b, 0x2d, 0x15, 0x1e, 0xc9, 0xae, 0x06, 0x49, 0x96, 0xb6, 0x95, 0xf0, 0x4b, 0xe2, 0x77,
+	0x28, 0xe7, 0x5c, 0x0c, 0x50, 0xe8, 0xb2, 0xd6, 0xbb, 0xb6, 0x5f, 0x5a, 0x57, 0x57, 0x03, 0x29,
+	0x34, 0x04, 0x74, 0x15, 0xf2, 0x32, 0x39, 0xf6, 0xe8, 0x7d, 0x57, 0x03, 0x83, 0x71, 0x72, 0x0e,
+	0xf9, 0x1c, 0x9b, 0x4e, 0xf8, 0xf7, 0x45, 0x6c, 0xa4, 0x55, 0xf2, 0xa2, 0x63, 0x33, 0x29, 0x56,
+	0x51, 0x81, 0xbd, 0x44, 0xe3, 0xa9, 0x71, 0x53, 0xe8, 0x02, 0xfa, 0x99, 0xc3, 0xe7, 0xd9, 0x4c,
+	0x76, 0xb5, 0x02, 0xff, 0xb9, 0xc3, 0x67, 0xd9, 0x14, 0x5d, 0x2d, 0xc7, 0x34, 0xfc, 0xc2, 0x82,
+	0x74, 0x89, 0x12, 0xf8, 0x4b, 0xab, 0x21, 0xbd, 0x45, 0x09, 0xff, 0x95, 0x35, 0x46, 0x1a, 0xd2,
+	0x24, 0xd2, 0xf0, 0x8a, 0x43, 0x9e, 0x66, 0xc6, 0x52, 0x18, 0x5e, 0xb5, 0x8c, 0xa4, 0x35, 0x67,
+	0x7c, 0xcd, 0x32, 0xa6, 0x3a, 0x73, 0xf4, 0x75, 0x8b, 0xde, 0x14, 0xa1, 0xaf, 0x8e, 0x8e, 0x72,
+	0xf4, 0x0d, 0x87, 0x2f, 0xb0, 0x59, 0x12, 0x5f, 0x13, 0x81, 0x08, 0xbd, 0x82, 0xff, 0x4d, 0x87,
+	0x9f, 0x67, 0x70, 0xc6, 0x9c, 0x86, 0xe7, 0x46, 0x38, 0x64, 0xf1, 0xb5, 0x75, 0x04, 0x5f, 0x1a,
+	0xb1, 0xb1, 0x4a, 0x19, 0x13, 0xec, 0xcb, 0x23, 0x7c, 0x2a, 0x09, 0x7a, 0x72, 0xfe, 0xca, 0x08,
+	0xaf, 0xb3, 0xb1, 0xad, 0x50, 0x63, 0x6c, 0xe0, 0xb3, 0x94, 0xdf, 0x63, 0x49, 0xef, 0x85, 0xcf,
+	0x51, 0x45, 0x8d, 0xda, 0xfc, 0x86, 0x17, 0x68, 0xae, 0x73, 0x17, 0x35, 0x86, 0x7e, 0xa9, 0x76,
+	0x34, 0x7c, 0xde, 0x4a, 0x24, 0x83, 0x13, 0xfe, 0x56, 0xb1, 0xa1, 0x29, 0x4f, 0xd1, 0xbf, 0x57,
+	0xc8, 0x85, 0x4d, 0x34, 0x45, 0x65, 0xc3, 0x3f, 0x2a, 0xfc, 0x12, 0x3b, 0x9f, 0x61, 0x76, 0xa6,
+	0xe5, 0x35, 0xfd, 0xcf, 0x0a, 0xbf, 0xc2, 0x2e, 0x50, 0x83, 0xcf, 0xf3, 0x86, 0x84, 0xa4, 0x36,
+	0xd2, 0xd3, 0xf0, 0x72, 0x85, 0x5f, 0x66, 0xf3, 0x9b, 0x68, 0xf2, 0xf7, 0x28, 0x11, 0xff, 0x55,
+	0xe1, 0x93, 0x6c, 0x82, 0xaa, 0x5e, 0xe2, 0x09, 0xc2, 0x2b, 0x15, 0x7a, 0xd4, 0xec, 0x98, 0xba,
+	0xf3, 0x6a, 0x85, 0x42, 0xfd, 0x01, 0x61, 0xbc, 0xe3, 0x76, 0xaf, 0x75, 0x2c, 0xc2, 0x10, 0x03,
+	0x0d, 0xaf, 0x55, 0x28, 0xa0, 0x2e, 0xf6, 0xd4, 0x09, 0x96, 0xe0, 0xd7, 0xed, 0xa5, 0x2d, 0xf3,
+	0xfb, 0xfb, 0x18, 0x0f, 0x72, 0xc2, 0x1b, 0x15, 0x7a, 0x9a, 0x84, 0x7f, 0x98, 0xf2, 0x66, 0x85,
+	0x5f, 0x65, 0x0b, 0x49, 0xb3, 0xc8, 0x1e, 0x86, 0x88, 0x5d, 0xa4, 0xc6, 0x0c, 0xcf, 0x55, 0x73,
+	0x8d, 0x6d, 0x0c, 0x8c, 0xc8, 0xe5, 0x3e, 0x5e, 0x25, 0xbf, 0x36, 0xb1, 0xdc, 0x8f, 0x35, 0x3c,
+	0x5f, 0xa5, 0x17, 0xdd, 0x44, 0x93, 0xb6, 0x64, 0x0d, 0x9f, 0xa0, 0x35, 0x6a, 0xea, 0x56, 0xa8,
+	0xfb, 0x9d, 0xdc, 0x51, 0xf8, 0x64, 0x26, 0xdc, 0x96, 0xda, 0xc4, 0xb2, 0xd3, 0xb7, 0x99, 0xfe,
+	0xa9, 0x2a, 0x5d, 0xea, 0x60, 0x10, 0x7a, 0x43, 0xf0, 0xa7, 0xad, 0xce, 0xd4, 0x37, 0xeb, 0xd4,
+	0xaf, 0xab, 0x7c, 0x9a, 0xb1, 0xa4, 0xaa, 0x2d, 0xf0, 0x9b, 0x4c, 0x1f, 0xed, 0x4d, 0x27, 0x18,
+	0xdb, 0xa1, 0x02, 0xbf, 0xcd, 0x5d, 0x2c, 0xf5, 0x4e, 0xf8, 0x5d, 0x95, 0x82, 0x7e, 0x28, 0x7b,
+	0x78, 0x28, 0xbd, 0xbb, 0xf0, 0xd5, 0x1a, 0xf9, 0x67, 0x63, 0xb2, 0xab, 0x7c, 0x4c, 0x72, 0xe4,
+	0x6b, 0x35, 0x4a, 0x39, 0xca, 0xe4, 0x24, 0xe5, 0xbe, 0x6e, 0xcf, 0xe9, 0x28, 0xd8, 0x6a, 0xc3,
+	0x37, 0x68, 0x7f, 0x63, 0xe9, 0xf9, 0xf0, 0x60, 0x0f, 0xbe, 0x59, 0x23, 0x53, 0xab, 0x41, 0xa0,
+	0x3c, 0x61, 0xf2, 0x7a, 0xfa, 0x56, 0x8d, 0x0a, 0xb2, 0x64, 0x3d, 0x7d, 0xf7, 0x6f, 0xd7, 0xec,
+	0x45, 0x13, 0xdc, 0xa6, 0x6b, 0x9b, 0xda, 0xea, 0x77, 0xac, 0x56, 0x9a, 0x41, 0xe4, 0xc9, 0xa1,
+	0x81, 0xef, 0x5a, 0xbe, 0xb3, 0x2b, 0x09, 0xfc, 0xbe, 0x9e, 0x66, 0x68, 0x09, 0xfb, 0x43, 0x3d,
+	0xa9, 0xb0, 0xe1, 0x1d, 0x04, 0xfe, 0x68, 0xe1, 0xb3, 0x7b, 0x0b, 0xfc, 0xa9, 0xce, 0xe7, 0x93,
+	0x19, 0x9b, 0xad, 0x1e, 0xb4, 0x80, 0x6b, 0xf8, 0x73, 0x9d, 0x3c, 0x28, 0x96, 0x0c, 0xf8, 0x5e,
+	0x83, 0x82, 0x95, 0xad, 0x17, 0xf0, 0xfd, 0x06, 0x5d, 0xf3, 0xcc, 0x62, 0x01, 0x3f, 0x68, 0xd8,
+	0xe7, 0xc8, 0x57, 0x0a, 0xf8, 0x61, 0x09, 0x20, 0x2e, 0xf8, 0x51, 0xc3, 0xf6, 0xb0, 0xa1, 0x35,
+	0x02, 0x7e, 0xdc, 0x20, 0xdf, 0xce, 0x2e, 0x10, 0xf0, 0x93, 0x46, 0xf2, 0xdc, 0xf9, 0xea, 0x00,
+	0x3f, 0x6d, 0x50, 0x0d, 0x3d, 0x78, 0x69, 0x80, 0x17, 0xad, 0xad, 0x62, 0x5d, 0x80, 0x97, 0x1a,
+	0xcd, 0x25, 0x36, 0xde, 0xd6, 0x81, 0x9d, 0x3c, 0xe3, 0xac, 0xd2, 0xd6, 0x01, 0x9c, 0xa3, 0x46,
+	0xbd, 0xa6, 0x54, 0xb0, 0x7e, 0x1a, 0xc5, 0xcf, 0x3e, 0x09, 0x4e, 0x73, 0x8d, 0x4d, 0xb7, 0x54,
+	0x2f, 0x12, 0x79, 0xc1, 0xda, 0x61, 0x93, 0x4c, 0x29, 0xf4, 0x2d, 0x00, 0xe7, 0xa8, 0xdb, 0xaf,
+	0x9f, 0xa2, 0xd7, 0xb7, 0x33, 0xd1, 0xa1, 0x23, 0x09, 0x51, 0x90, 0x7d, 0x18, 0x69, 0x7e, 0x90,
+	0x41, 0x4b, 0x85, 0x5a, 0x6a, 0x83, 0xa1, 0x37, 0xd8, 0xc6, 0x13, 0x0c, 0xec, 0xe4, 0x35, 0xb1,
+	0x0a, 0xbb, 0x70, 0xce, 0x7e, 0xa3, 0xa0, 0xfd, 0xd6, 0x48, 0xe6, 0xf3, 0x1a, 0xed, 0x21, 0x24,
+	0x49, 0xde, 0xac, 0x9f, 0x60, 0x68, 0xfa, 0x22, 0x08, 0x06, 0x50, 0xa1, 0x73, 0xab, 0xaf, 0x8d,
+	0xea, 0xc9, 0x8f, 0xd1, 0x98, 0x6e, 0x7e, 0xc6, 0x61, 0xf5, 0x64, 0x18, 0xe7, 0xae, 0x25, 0xc7,
+	0x7d, 0x0c, 0x7d, 0x69, 0x95, 0xd3, 0x1e, 0x6d, 0xa1, 0x74, 0x83, 0x70, 0x0a, 0xa6, 0x03, 0x23,
+	0x62, 0xeb, 0xa1, 0xfd, 0x7c, 0x48, 0xe5, 0x62, 0xeb, 0xa7, 0x0f, 0xa3, 0x05, 0x58, 0xdc, 0x65,
+	0x8c, 0x16, 0xc6, 0xb2, 0xba, 0xd5, 0xd0, 0x6f, 0x05, 0x28, 0x68, 0x5e, 0x8f, 0x37, 0x6f, 0x30,
+	0x56, 0x7c, 0x3e, 0x5a, 0x5f, 0x8b, 0x11, 0x79, 0x8e, 0x6e, 0xbc, 0x19, 0xa8, 0x8e, 0x08, 0xc0,
+	0xa1, 0x0d, 0xc1, 0x3e, 0xf8, 0x48, 0xf3, 0x0b, 0xa3, 0x6c, 0xfa, 0xcc, 0xc7, 0x22, 0xb9, 0x9c,
+	0x1f, 0x56, 0x03, 0x7a, 0x95, 0xab, 0xec, 0x62, 0x8e, 0xdc, 0xb7, 0x12, 0x38, 0xb4, 0x60, 0xe6,
+	0xe4, 0x33, 0xbb, 0xc1, 0x08, 0xbf, 0xc6, 0x2e, 0x17, 0xc4, 0xfb, 0x37, 0x02, 0x6a, 0xcb, 0x0b,
+	0x39, 0xc3, 0xd9, 0xd5, 0xa0, 0x4a, 0xd1, 0xca, 0xa9, 0x54, 0xe9, 0xc9, 0xa7, 0x5d, 0x0e, 0xa5,
+	0x23, 0x0f, 0xc6, 0xe8, 0x6b, 0xab, 0xf0, 0x31, 0x4f, 0x19, 0x18, 0xa7, 0x38, 0xe6, 0x84, 0x74,
+	0x1c, 0x4d, 0x0c, 0x81, 0xe9, 0x58, 0xaa, 0x51, 0x70, 0x73, 0x70, 0x13, 0xcb, 0xad, 0x80, 0xd1,
+	0x37, 0xc0, 0x99, 0x10, 0x24, 0x3d, 0xa7, 0x3e, 0x44, 0xb1, 0x58, 0x1b, 0x8d, 0x90, 0x01, 0x34,
+	0x68, 0x07, 0x1a, 0x8a, 0x4b, 0x22, 0x31, 0x39, 0x64, 0x3c, 0x9d, 0x70, 0x53, 0xb4, 0xed, 0xe4,
+	0x60, 0x32, 0x1b, 0xa7, 0x87, 0x30, 0xdb, 0xfb, 0x00, 0x86, 0xcc, 0x95, 0x86, 0x38, 0xcc, 0x0c,
+	0x5f, 0xd4, 0x26, 0x09, 0xf0, 0xa1, 0xe8, 0x26, 0x7e, 0xef, 0xdd, 0x0b, 0x31, 0xd6, 0xc7, 0x32,
+	0x82, 0xd9, 0xa1, 0xa0, 0x25, 0xed, 0xc7, 0xe6, 0xc5, 0xdc, 0x50, 0x28, 0xc8, 0xf5, 0x42, 0xe8,
+	0xfc, 0xf0, 0x83, 0xd9, 0x06, 0x50, 0x50, 0xe7, 0x87, 0xa8, 0x3b, 0x22, 0x14, 0xdd, 0x92, 0xc1,
+	0x0b, 0x43, 0x06, 0x4b, 0x9d, 0x67, 0x61, 0xc8, 0xf9, 0x35, 0xe1, 0xdd, 0xed, 0x47, 0x70, 0xf1,
+	0x3d, 0x8a, 0xcd, 0xe4, 0xff, 0x77, 0xdc, 0xc6, 0x53, 0x73, 0x5b, 0x75, 0xee, 0xf0, 0x6b, 0x2b,
+	0xc9, 0xff, 0x94, 0x2b, 0xd9, 0xff, 0x94, 0x2b, 0x3b, 0xa8, 0x35, 0xd9, 0x89, 0x6c, 0xd2, 0x2c,
+	0xfc, 0x75, 0xdc, 0xfe, 0x91, 0xf3, 0xf0, 0x83, 0xff, 0x1e, 0x2b, 0xfd, 0x31, 0xe3, 0x4e, 0x47,
+	0xa5, 0xd3, 0x5e, 0xe7, 0xce, 0xda, 0x36, 0x9b, 0x92, 0x2a, 0x93, 0xeb, 0xc6, 0x91, 0xb7, 0x56,
+	0x6f, 0x59, 0xb9, 0x7d, 0xd2, 0xb1, 0xef, 0x7c, 0x68, 0xb9, 0x2b, 0xcd, 0x71, 0xbf, 0x43, 0xda,
+	0xae, 0x27, 0x6c, 0x8f, 0x4b, 0x95, 0xfe, 0xba, 0x2e, 0x22, 0x79, 0x3d, 0x31, 0x13, 0x75, 0xbe,
+	0xe8, 0x38, 0x9d, 0x31, 0x6b, 0xf9, 0xa9, 0x7f, 0x0f, 0x00, 0xd9, 0x08, 0x3f, 0x16, 0x7c, 0x15,
+	0x00, 0x00,
 }
@@ -55,6 +55,37 @@ func (ShowType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{0}
 }
 
+type BackupState int32
+
+const (
+	BackupState_BackupNone      BackupState = 0
+	BackupState_BackupExecuting BackupState = 1
+	BackupState_BackupCompleted BackupState = 2
+	BackupState_BackupFailed    BackupState = 3
+)
+
+var BackupState_name = map[int32]string{
+	0: "BackupNone",
+	1: "BackupExecuting",
+	2: "BackupCompleted",
+	3: "BackupFailed",
+}
+
+var BackupState_value = map[string]int32{
+	"BackupNone":      0,
+	"BackupExecuting": 1,
+	"BackupCompleted": 2,
+	"BackupFailed":    3,
+}
+
+func (x BackupState) String() string {
+	return proto.EnumName(BackupState_name, int32(x))
+}
+
+func (BackupState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{1}
+}
+
 type OperateUserRoleType int32
 
 const (
@@ -77,7 +108,7 @@ func (x OperateUserRoleType) String() string {
 }
 
 func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{2}
 }
 
 type OperatePrivilegeType int32
@@ -102,7 +133,7 @@ func (x OperatePrivilegeType) String() string {
 }
 
 func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{2}
+	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
 type CreateAliasRequest struct {
@@ -286,7 +317,7 @@ func (m *AlterAliasRequest) GetAlias() string {
 	return ""
 }
 
-//*
+// *
 // Create collection in milvus
 type CreateCollectionRequest struct {
 	// Not useful for now
@@ -374,7 +405,7 @@ func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLeve
 	return commonpb.ConsistencyLevel_Strong
 }
 
-//*
+// *
 // Drop collection in milvus, also will drop data in collection.
 type DropCollectionRequest struct {
 	// Not useful for now
@@ -434,7 +465,7 @@ func (m *DropCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Check collection exist in milvus or not.
 type HasCollectionRequest struct {
 	// Not useful for now
@@ -597,7 +628,7 @@ func (m *StringResponse) GetValue() string {
 	return ""
 }
 
-//*
+// *
 // Get collection meta datas like: schema, collectionID, shards number ...
 type DescribeCollectionRequest struct {
 	// Not useful for now
@@ -675,7 +706,7 @@ func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
 	return 0
 }
 
-//*
+// *
 // DescribeCollection Response
 type DescribeCollectionResponse struct {
 	// Contain error_code and reason
@@ -816,7 +847,7 @@ func (m *DescribeCollectionResponse) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Load collection data into query nodes, then you can do vector search on this collection.
 type LoadCollectionRequest struct {
 	// Not useful for now
@@ -885,7 +916,7 @@ func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
 	return 0
 }
 
-//*
+// *
 // Release collection data from query nodes, then you can't do vector search on this collection.
 type ReleaseCollectionRequest struct {
 	// Not useful for now
@@ -945,7 +976,7 @@ func (m *ReleaseCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Get statistics like row_count.
 // WARNING: This API is experimental and not useful for now.
 type GetStatisticsRequest struct {
@@ -1024,7 +1055,7 @@ func (m *GetStatisticsRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
-//*
+// *
 // Will return statistics in stats field like [{key:"row_count",value:"1"}]
 // WARNING: This API is experimental and not useful for now.
 type GetStatisticsResponse struct {
@@ -1076,7 +1107,7 @@ func (m *GetStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//*
+// *
 // Get collection statistics like row_count.
 type GetCollectionStatisticsRequest struct {
 	// Not useful for now
@@ -1136,7 +1167,7 @@ func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
 type GetCollectionStatisticsResponse struct {
 	// Contain error_code and reason
@@ -1187,7 +1218,6 @@ func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//
 // List collections
 type ShowCollectionsRequest struct {
 	// Not useful for now
@@ -1266,7 +1296,6 @@ func (m *ShowCollectionsRequest) GetCollectionNames() []string {
 	return nil
 }
 
-//
 // Return basic collection infos.
 type ShowCollectionsResponse struct {
 	// Contain error_code and reason
@@ -1363,7 +1392,6 @@ func (m *ShowCollectionsResponse) GetQueryServiceAvailable() []bool {
 	return nil
 }
 
-//
 // Create partition in created collection.
 type CreatePartitionRequest struct {
 	// Not useful for now
@@ -1432,7 +1460,6 @@ func (m *CreatePartitionRequest) GetPartitionName() string {
 	return ""
 }
 
-//
 // Drop partition in created collection.
 type DropPartitionRequest struct {
 	// Not useful for now
@@ -1501,7 +1528,6 @@ func (m *DropPartitionRequest) GetPartitionName() string {
 	return ""
 }
 
-//
 // Check if partition exist in collection or not.
 type HasPartitionRequest struct {
 	// Not useful for now
@@ -1570,7 +1596,6 @@ func (m *HasPartitionRequest) GetPartitionName() string {
 	return ""
 }
 
-//
 // Load specific partitions data of one collection into query nodes
 // Then you can get these data as result when you do vector search on this collection.
 type LoadPartitionsRequest struct {
@@ -1649,7 +1674,6 @@ func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
 	return 0
 }
 
-//
 // Release specific partitions data of one collection from query nodes.
 // Then you can not get these data as result when you do vector search on this collection.
 type ReleasePartitionsRequest struct {
@@ -1719,7 +1743,6 @@ func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
 	return nil
 }
 
-//
 // Get partition statistics like row_count.
 type GetPartitionStatisticsRequest struct {
 	// Not useful for now
@@ -1835,7 +1858,6 @@ func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//
 // List all partitions for particular collection
 type ShowPartitionsRequest struct {
 	// Not useful for now
@@ -1923,7 +1945,6 @@ func (m *ShowPartitionsRequest) GetType() ShowType {
 	return ShowType_All
 }
 
-//
 // List all partitions for particular collection response.
 // The returned datas are all rows, we can format to columns by therir index.
 type ShowPartitionsResponse struct {
@@ -2240,7 +2261,6 @@ func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
 	return nil
 }
 
-//
 // Create index for vector datas
 type CreateIndexRequest struct {
 	// Not useful for now
@@ -2327,7 +2347,6 @@ func (m *CreateIndexRequest) GetIndexName() string {
 	return ""
 }
 
-//
 // Get created index information.
 // Current release of Milvus only supports showing latest built index.
 type DescribeIndexRequest struct {
@@ -2406,7 +2425,6 @@ func (m *DescribeIndexRequest) GetIndexName() string {
 	return ""
 }
 
-//
 // Index informations
 type IndexDescription struct {
 	// Index name
@@ -2509,7 +2527,6 @@ func (m *IndexDescription) GetIndexStateFailReason() string {
 	return ""
 }
 
-//
 // Describe index response
 type DescribeIndexResponse struct {
 	// Response status
@@ -2560,8 +2577,7 @@ func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
 	return nil
 }
 
-//
-//  Get index building progress
+// Get index building progress
 type GetIndexBuildProgressRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -4622,7 +4638,6 @@ func (m *GetMetricsResponse) GetComponentName() string {
 	return ""
 }
 
-//
 // Do load balancing operation from src_nodeID to dst_nodeID.
 type LoadBalanceRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -4696,8 +4711,15 @@ func (m *LoadBalanceRequest) GetCollectionName() string {
 }
 
 type ManualCompactionRequest struct {
-	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timetravel   uint64 `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	// Only compact the segments of these partitions if not empty.
+	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// Only compact these segments if not empty, they must be flushed, indexed and not being compacted.
+	SegmentIDs []int64 `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// Merge the selected segments of each channel and partition into this number of segments, 0 means merging by size.
+	// More segments are created if the rows or number of segments to merge exceed the limits of a segment.
+	TargetSegmentNum     int64    `protobuf:"varint,5,opt,name=targetSegmentNum,proto3" json:"targetSegmentNum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4742,6 +4764,27 @@ func (m *ManualCompactionRequest) GetTimetravel() uint64 {
 	return 0
 }
 
+func (m *ManualCompactionRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionRequest) GetTargetSegmentNum() int64 {
+	if m != nil {
+		return m.TargetSegmentNum
+	}
+	return 0
+}
+
 type ManualCompactionResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
@@ -5496,245 +5539,1143 @@ func (m *ListImportTasksResponse) GetTasks() []*GetImportStateResponse {
 	return nil
 }
 
-type GetReplicasRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	WithShardNodes       bool              `protobuf:"varint,3,opt,name=with_shard_nodes,json=withShardNodes,proto3" json:"with_shard_nodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type BackupInfo struct {
+	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CollectionName       string      `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64       `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	BackupTimestamp      uint64      `protobuf:"varint,4,opt,name=backup_timestamp,json=backupTimestamp,proto3" json:"backup_timestamp,omitempty"`
+	State                BackupState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.milvus.BackupState" json:"state,omitempty"`
+	FailReason           string      `protobuf:"bytes,6,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	NumSegments          int64       `protobuf:"varint,7,opt,name=num_segments,json=numSegments,proto3" json:"num_segments,omitempty"`
+	NumRows              int64       `protobuf:"varint,8,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	Size                 int64       `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
-func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
-func (*GetReplicasRequest) ProtoMessage()    {}
-func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+func (m *BackupInfo) Reset()         { *m = BackupInfo{} }
+func (m *BackupInfo) String() string { return proto.CompactTextString(m) }
+func (*BackupInfo) ProtoMessage()    {}
+func (*BackupInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
-func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+func (m *BackupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupInfo.Unmarshal(m, b)
 }
-func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+func (m *BackupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupInfo.Marshal(b, m, deterministic)
 }
-func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+func (m *BackupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupInfo.Merge(m, src)
 }
-func (m *GetReplicasRequest) XXX_Size() int {
-	return xxx_messageInfo_GetReplicasRequest.Size(m)
+func (m *BackupInfo) XXX_Size() int {
+	return xxx_messageInfo_BackupInfo.Size(m)
 }
-func (m *GetReplicasRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+func (m *BackupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+var xxx_messageInfo_BackupInfo proto.InternalMessageInfo
 
-func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
+func (m *BackupInfo) GetName() string {
 	if m != nil {
-		return m.Base
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *GetReplicasRequest) GetCollectionID() int64 {
+func (m *BackupInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetCollectionID() int64 {
 	if m != nil {
 		return m.CollectionID
 	}
 	return 0
 }
 
-func (m *GetReplicasRequest) GetWithShardNodes() bool {
+func (m *BackupInfo) GetBackupTimestamp() uint64 {
 	if m != nil {
-		return m.WithShardNodes
+		return m.BackupTimestamp
 	}
-	return false
+	return 0
 }
 
-type GetReplicasResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *BackupInfo) GetState() BackupState {
+	if m != nil {
+		return m.State
+	}
+	return BackupState_BackupNone
 }
 
-func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
-func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
-func (*GetReplicasResponse) ProtoMessage()    {}
-func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{83}
+func (m *BackupInfo) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
 }
 
-func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
-}
-func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
-}
-func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
-}
-func (m *GetReplicasResponse) XXX_Size() int {
-	return xxx_messageInfo_GetReplicasResponse.Size(m)
-}
-func (m *GetReplicasResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+func (m *BackupInfo) GetNumSegments() int64 {
+	if m != nil {
+		return m.NumSegments
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
-
-func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+func (m *BackupInfo) GetNumRows() int64 {
 	if m != nil {
-		return m.Status
+		return m.NumRows
 	}
-	return nil
+	return 0
 }
 
-func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+func (m *BackupInfo) GetSize() int64 {
 	if m != nil {
-		return m.Replicas
+		return m.Size
 	}
-	return nil
+	return 0
 }
 
-type ReplicaInfo struct {
-	ReplicaID            int64           `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
-	CollectionID         int64           `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIds         []int64         `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
-	ShardReplicas        []*ShardReplica `protobuf:"bytes,4,rep,name=shard_replicas,json=shardReplicas,proto3" json:"shard_replicas,omitempty"`
-	NodeIds              []int64         `protobuf:"varint,5,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type CreateBackupRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	BackupName           string            `protobuf:"bytes,4,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	BackupTimestamp      uint64            `protobuf:"varint,5,opt,name=backup_timestamp,json=backupTimestamp,proto3" json:"backup_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
-func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
-func (*ReplicaInfo) ProtoMessage()    {}
-func (*ReplicaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{84}
+func (m *CreateBackupRequest) Reset()         { *m = CreateBackupRequest{} }
+func (m *CreateBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBackupRequest) ProtoMessage()    {}
+func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
-func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+func (m *CreateBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBackupRequest.Unmarshal(m, b)
 }
-func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
+func (m *CreateBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBackupRequest.Marshal(b, m, deterministic)
 }
-func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicaInfo.Merge(m, src)
+func (m *CreateBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBackupRequest.Merge(m, src)
 }
-func (m *ReplicaInfo) XXX_Size() int {
-	return xxx_messageInfo_ReplicaInfo.Size(m)
+func (m *CreateBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateBackupRequest.Size(m)
 }
-func (m *ReplicaInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
+func (m *CreateBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBackupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+var xxx_messageInfo_CreateBackupRequest proto.InternalMessageInfo
 
-func (m *ReplicaInfo) GetReplicaID() int64 {
+func (m *CreateBackupRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.ReplicaID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *ReplicaInfo) GetCollectionID() int64 {
+func (m *CreateBackupRequest) GetDbName() string {
 	if m != nil {
-		return m.CollectionID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *ReplicaInfo) GetPartitionIds() []int64 {
+func (m *CreateBackupRequest) GetCollectionName() string {
 	if m != nil {
-		return m.PartitionIds
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ReplicaInfo) GetShardReplicas() []*ShardReplica {
+func (m *CreateBackupRequest) GetBackupName() string {
 	if m != nil {
-		return m.ShardReplicas
+		return m.BackupName
 	}
-	return nil
+	return ""
 }
 
-func (m *ReplicaInfo) GetNodeIds() []int64 {
+func (m *CreateBackupRequest) GetBackupTimestamp() uint64 {
 	if m != nil {
-		return m.NodeIds
+		return m.BackupTimestamp
 	}
-	return nil
+	return 0
 }
 
-type ShardReplica struct {
-	LeaderID      int64  `protobuf:"varint,1,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
-	LeaderAddr    string `protobuf:"bytes,2,opt,name=leader_addr,json=leaderAddr,proto3" json:"leader_addr,omitempty"`
-	DmChannelName string `protobuf:"bytes,3,opt,name=dm_channel_name,json=dmChannelName,proto3" json:"dm_channel_name,omitempty"`
-	// optional, DO NOT save it in meta, set it only for GetReplicas()
-	// if with_shard_nodes is true
-	NodeIds              []int64  `protobuf:"varint,4,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateBackupResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Info                 *BackupInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ShardReplica) Reset()         { *m = ShardReplica{} }
-func (m *ShardReplica) String() string { return proto.CompactTextString(m) }
-func (*ShardReplica) ProtoMessage()    {}
-func (*ShardReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{85}
+func (m *CreateBackupResponse) Reset()         { *m = CreateBackupResponse{} }
+func (m *CreateBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateBackupResponse) ProtoMessage()    {}
+func (*CreateBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
-func (m *ShardReplica) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardReplica.Unmarshal(m, b)
+func (m *CreateBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBackupResponse.Unmarshal(m, b)
 }
-func (m *ShardReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardReplica.Marshal(b, m, deterministic)
+func (m *CreateBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBackupResponse.Marshal(b, m, deterministic)
 }
-func (m *ShardReplica) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardReplica.Merge(m, src)
+func (m *CreateBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBackupResponse.Merge(m, src)
 }
-func (m *ShardReplica) XXX_Size() int {
-	return xxx_messageInfo_ShardReplica.Size(m)
+func (m *CreateBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateBackupResponse.Size(m)
 }
-func (m *ShardReplica) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardReplica.DiscardUnknown(m)
+func (m *CreateBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBackupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardReplica proto.InternalMessageInfo
+var xxx_messageInfo_CreateBackupResponse proto.InternalMessageInfo
 
-func (m *ShardReplica) GetLeaderID() int64 {
+func (m *CreateBackupResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.LeaderID
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *ShardReplica) GetLeaderAddr() string {
+func (m *CreateBackupResponse) GetInfo() *BackupInfo {
 	if m != nil {
-		return m.LeaderAddr
+		return m.Info
 	}
-	return ""
+	return nil
 }
 
-func (m *ShardReplica) GetDmChannelName() string {
-	if m != nil {
-		return m.DmChannelName
-	}
-	return ""
+type ListBackupsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ShardReplica) GetNodeIds() []int64 {
+func (m *ListBackupsRequest) Reset()         { *m = ListBackupsRequest{} }
+func (m *ListBackupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBackupsRequest) ProtoMessage()    {}
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
+}
+
+func (m *ListBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupsRequest.Unmarshal(m, b)
+}
+func (m *ListBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupsRequest.Merge(m, src)
+}
+func (m *ListBackupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBackupsRequest.Size(m)
+}
+func (m *ListBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupsRequest proto.InternalMessageInfo
+
+func (m *ListBackupsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.NodeIds
+		return m.Base
 	}
 	return nil
 }
 
-type CreateCredentialRequest struct {
+func (m *ListBackupsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type ListBackupsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Backups              []*BackupInfo    `protobuf:"bytes,2,rep,name=backups,proto3" json:"backups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListBackupsResponse) Reset()         { *m = ListBackupsResponse{} }
+func (m *ListBackupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBackupsResponse) ProtoMessage()    {}
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
+}
+
+func (m *ListBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupsResponse.Unmarshal(m, b)
+}
+func (m *ListBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupsResponse.Merge(m, src)
+}
+func (m *ListBackupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBackupsResponse.Size(m)
+}
+func (m *ListBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupsResponse proto.InternalMessageInfo
+
+func (m *ListBackupsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListBackupsResponse) GetBackups() []*BackupInfo {
+	if m != nil {
+		return m.Backups
+	}
+	return nil
+}
+
+type RestoreBackupRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BackupName           string            `protobuf:"bytes,2,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RestoreBackupRequest) Reset()         { *m = RestoreBackupRequest{} }
+func (m *RestoreBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupRequest) ProtoMessage()    {}
+func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
+}
+
+func (m *RestoreBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupRequest.Unmarshal(m, b)
+}
+func (m *RestoreBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupRequest.Merge(m, src)
+}
+func (m *RestoreBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupRequest.Size(m)
+}
+func (m *RestoreBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBackupRequest proto.InternalMessageInfo
+
+func (m *RestoreBackupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RestoreBackupRequest) GetBackupName() string {
+	if m != nil {
+		return m.BackupName
+	}
+	return ""
+}
+
+func (m *RestoreBackupRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type RestoreBackupResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID         int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Tasks                []int64          `protobuf:"varint,3,rep,packed,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()    {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
+}
+
+func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+}
+func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupResponse.Merge(m, src)
+}
+func (m *RestoreBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+}
+func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+
+func (m *RestoreBackupResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RestoreBackupResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RestoreBackupResponse) GetTasks() []int64 {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type CloneCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	NewCollectionName    string            `protobuf:"bytes,4,opt,name=new_collection_name,json=newCollectionName,proto3" json:"new_collection_name,omitempty"`
+	Timestamp            uint64            `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CloneCollectionRequest) Reset()         { *m = CloneCollectionRequest{} }
+func (m *CloneCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneCollectionRequest) ProtoMessage()    {}
+func (*CloneCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
+}
+
+func (m *CloneCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneCollectionRequest.Unmarshal(m, b)
+}
+func (m *CloneCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneCollectionRequest.Merge(m, src)
+}
+func (m *CloneCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneCollectionRequest.Size(m)
+}
+func (m *CloneCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneCollectionRequest proto.InternalMessageInfo
+
+func (m *CloneCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CloneCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetNewCollectionName() string {
+	if m != nil {
+		return m.NewCollectionName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type CloneCollectionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID         int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CloneCollectionResponse) Reset()         { *m = CloneCollectionResponse{} }
+func (m *CloneCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*CloneCollectionResponse) ProtoMessage()    {}
+func (*CloneCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
+}
+
+func (m *CloneCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneCollectionResponse.Unmarshal(m, b)
+}
+func (m *CloneCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *CloneCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneCollectionResponse.Merge(m, src)
+}
+func (m *CloneCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_CloneCollectionResponse.Size(m)
+}
+func (m *CloneCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneCollectionResponse proto.InternalMessageInfo
+
+func (m *CloneCollectionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CloneCollectionResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CloneCollectionResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type DroppedCollectionInfo struct {
+	CollectionName       string   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DroppedTimestamp     uint64   `protobuf:"varint,3,opt,name=dropped_timestamp,json=droppedTimestamp,proto3" json:"dropped_timestamp,omitempty"`
+	DroppedUtcTimestamp  uint64   `protobuf:"varint,4,opt,name=dropped_utc_timestamp,json=droppedUtcTimestamp,proto3" json:"dropped_utc_timestamp,omitempty"`
+	ExpireUtcTimestamp   uint64   `protobuf:"varint,5,opt,name=expire_utc_timestamp,json=expireUtcTimestamp,proto3" json:"expire_utc_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DroppedCollectionInfo) Reset()         { *m = DroppedCollectionInfo{} }
+func (m *DroppedCollectionInfo) String() string { return proto.CompactTextString(m) }
+func (*DroppedCollectionInfo) ProtoMessage()    {}
+func (*DroppedCollectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
+}
+
+func (m *DroppedCollectionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DroppedCollectionInfo.Unmarshal(m, b)
+}
+func (m *DroppedCollectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DroppedCollectionInfo.Marshal(b, m, deterministic)
+}
+func (m *DroppedCollectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedCollectionInfo.Merge(m, src)
+}
+func (m *DroppedCollectionInfo) XXX_Size() int {
+	return xxx_messageInfo_DroppedCollectionInfo.Size(m)
+}
+func (m *DroppedCollectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedCollectionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedCollectionInfo proto.InternalMessageInfo
+
+func (m *DroppedCollectionInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DroppedCollectionInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DroppedCollectionInfo) GetDroppedTimestamp() uint64 {
+	if m != nil {
+		return m.DroppedTimestamp
+	}
+	return 0
+}
+
+func (m *DroppedCollectionInfo) GetDroppedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.DroppedUtcTimestamp
+	}
+	return 0
+}
+
+func (m *DroppedCollectionInfo) GetExpireUtcTimestamp() uint64 {
+	if m != nil {
+		return m.ExpireUtcTimestamp
+	}
+	return 0
+}
+
+type DroppedPartitionInfo struct {
+	CollectionName       string   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionName        string   `protobuf:"bytes,3,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	PartitionID          int64    `protobuf:"varint,4,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	DroppedTimestamp     uint64   `protobuf:"varint,5,opt,name=dropped_timestamp,json=droppedTimestamp,proto3" json:"dropped_timestamp,omitempty"`
+	DroppedUtcTimestamp  uint64   `protobuf:"varint,6,opt,name=dropped_utc_timestamp,json=droppedUtcTimestamp,proto3" json:"dropped_utc_timestamp,omitempty"`
+	ExpireUtcTimestamp   uint64   `protobuf:"varint,7,opt,name=expire_utc_timestamp,json=expireUtcTimestamp,proto3" json:"expire_utc_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DroppedPartitionInfo) Reset()         { *m = DroppedPartitionInfo{} }
+func (m *DroppedPartitionInfo) String() string { return proto.CompactTextString(m) }
+func (*DroppedPartitionInfo) ProtoMessage()    {}
+func (*DroppedPartitionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *DroppedPartitionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DroppedPartitionInfo.Unmarshal(m, b)
+}
+func (m *DroppedPartitionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DroppedPartitionInfo.Marshal(b, m, deterministic)
+}
+func (m *DroppedPartitionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedPartitionInfo.Merge(m, src)
+}
+func (m *DroppedPartitionInfo) XXX_Size() int {
+	return xxx_messageInfo_DroppedPartitionInfo.Size(m)
+}
+func (m *DroppedPartitionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedPartitionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedPartitionInfo proto.InternalMessageInfo
+
+func (m *DroppedPartitionInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DroppedPartitionInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DroppedPartitionInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetDroppedTimestamp() uint64 {
+	if m != nil {
+		return m.DroppedTimestamp
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetDroppedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.DroppedUtcTimestamp
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetExpireUtcTimestamp() uint64 {
+	if m != nil {
+		return m.ExpireUtcTimestamp
+	}
+	return 0
+}
+
+type ListDroppedCollectionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDroppedCollectionsRequest) Reset()         { *m = ListDroppedCollectionsRequest{} }
+func (m *ListDroppedCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDroppedCollectionsRequest) ProtoMessage()    {}
+func (*ListDroppedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *ListDroppedCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Unmarshal(m, b)
+}
+func (m *ListDroppedCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDroppedCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDroppedCollectionsRequest.Merge(m, src)
+}
+func (m *ListDroppedCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Size(m)
+}
+func (m *ListDroppedCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDroppedCollectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDroppedCollectionsRequest proto.InternalMessageInfo
+
+func (m *ListDroppedCollectionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListDroppedCollectionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ListDroppedCollectionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type ListDroppedCollectionsResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collections          []*DroppedCollectionInfo `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	Partitions           []*DroppedPartitionInfo  `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListDroppedCollectionsResponse) Reset()         { *m = ListDroppedCollectionsResponse{} }
+func (m *ListDroppedCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDroppedCollectionsResponse) ProtoMessage()    {}
+func (*ListDroppedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *ListDroppedCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Unmarshal(m, b)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDroppedCollectionsResponse.Merge(m, src)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Size(m)
+}
+func (m *ListDroppedCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDroppedCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDroppedCollectionsResponse proto.InternalMessageInfo
+
+func (m *ListDroppedCollectionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDroppedCollectionsResponse) GetCollections() []*DroppedCollectionInfo {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *ListDroppedCollectionsResponse) GetPartitions() []*DroppedPartitionInfo {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+type RestoreCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionName        string            `protobuf:"bytes,5,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	PartitionID          int64             `protobuf:"varint,6,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RestoreCollectionRequest) Reset()         { *m = RestoreCollectionRequest{} }
+func (m *RestoreCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreCollectionRequest) ProtoMessage()    {}
+func (*RestoreCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
+}
+
+func (m *RestoreCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreCollectionRequest.Unmarshal(m, b)
+}
+func (m *RestoreCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCollectionRequest.Merge(m, src)
+}
+func (m *RestoreCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreCollectionRequest.Size(m)
+}
+func (m *RestoreCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCollectionRequest proto.InternalMessageInfo
+
+func (m *RestoreCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RestoreCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RestoreCollectionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+type GetReplicasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	WithShardNodes       bool              `protobuf:"varint,3,opt,name=with_shard_nodes,json=withShardNodes,proto3" json:"with_shard_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
+func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasRequest) ProtoMessage()    {}
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
+}
+
+func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+}
+func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+}
+func (m *GetReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasRequest.Size(m)
+}
+func (m *GetReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+
+func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetReplicasRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetReplicasRequest) GetWithShardNodes() bool {
+	if m != nil {
+		return m.WithShardNodes
+	}
+	return false
+}
+
+type GetReplicasResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
+func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasResponse) ProtoMessage()    {}
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
+}
+
+func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+}
+func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+}
+func (m *GetReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasResponse.Size(m)
+}
+func (m *GetReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+
+func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+type ReplicaInfo struct {
+	ReplicaID            int64           `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	CollectionID         int64           `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIds         []int64         `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	ShardReplicas        []*ShardReplica `protobuf:"bytes,4,rep,name=shard_replicas,json=shardReplicas,proto3" json:"shard_replicas,omitempty"`
+	NodeIds              []int64         `protobuf:"varint,5,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{98}
+}
+
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+}
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
+}
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
+}
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+
+func (m *ReplicaInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ReplicaInfo) GetShardReplicas() []*ShardReplica {
+	if m != nil {
+		return m.ShardReplicas
+	}
+	return nil
+}
+
+func (m *ReplicaInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+type ShardReplica struct {
+	LeaderID      int64  `protobuf:"varint,1,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
+	LeaderAddr    string `protobuf:"bytes,2,opt,name=leader_addr,json=leaderAddr,proto3" json:"leader_addr,omitempty"`
+	DmChannelName string `protobuf:"bytes,3,opt,name=dm_channel_name,json=dmChannelName,proto3" json:"dm_channel_name,omitempty"`
+	// optional, DO NOT save it in meta, set it only for GetReplicas()
+	// if with_shard_nodes is true
+	NodeIds              []int64  `protobuf:"varint,4,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardReplica) Reset()         { *m = ShardReplica{} }
+func (m *ShardReplica) String() string { return proto.CompactTextString(m) }
+func (*ShardReplica) ProtoMessage()    {}
+func (*ShardReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{99}
+}
+
+func (m *ShardReplica) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplica.Unmarshal(m, b)
+}
+func (m *ShardReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplica.Marshal(b, m, deterministic)
+}
+func (m *ShardReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplica.Merge(m, src)
+}
+func (m *ShardReplica) XXX_Size() int {
+	return xxx_messageInfo_ShardReplica.Size(m)
+}
+func (m *ShardReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplica proto.InternalMessageInfo
+
+func (m *ShardReplica) GetLeaderID() int64 {
+	if m != nil {
+		return m.LeaderID
+	}
+	return 0
+}
+
+func (m *ShardReplica) GetLeaderAddr() string {
+	if m != nil {
+		return m.LeaderAddr
+	}
+	return ""
+}
+
+func (m *ShardReplica) GetDmChannelName() string {
+	if m != nil {
+		return m.DmChannelName
+	}
+	return ""
+}
+
+func (m *ShardReplica) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+type CreateCredentialRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// username
@@ -5754,7 +6695,7 @@ func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest
 func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCredentialRequest) ProtoMessage()    {}
 func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{86}
+	return fileDescriptor_02345ba45cc0e303, []int{100}
 }
 
 func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5832,7 +6773,7 @@ func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest
 func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateCredentialRequest) ProtoMessage()    {}
 func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{87}
+	return fileDescriptor_02345ba45cc0e303, []int{101}
 }
 
 func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5909,7 +6850,7 @@ func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest
 func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteCredentialRequest) ProtoMessage()    {}
 func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{88}
+	return fileDescriptor_02345ba45cc0e303, []int{102}
 }
 
 func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5958,7 +6899,7 @@ func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
 func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListCredUsersResponse) ProtoMessage()    {}
 func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{89}
+	return fileDescriptor_02345ba45cc0e303, []int{103}
 }
 
 func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -6005,7 +6946,7 @@ func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
 func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCredUsersRequest) ProtoMessage()    {}
 func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{90}
+	return fileDescriptor_02345ba45cc0e303, []int{104}
 }
 
 func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -6045,7 +6986,7 @@ func (m *RoleEntity) Reset()         { *m = RoleEntity{} }
 func (m *RoleEntity) String() string { return proto.CompactTextString(m) }
 func (*RoleEntity) ProtoMessage()    {}
 func (*RoleEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{91}
+	return fileDescriptor_02345ba45cc0e303, []int{105}
 }
 
 func (m *RoleEntity) XXX_Unmarshal(b []byte) error {
@@ -6084,7 +7025,7 @@ func (m *UserEntity) Reset()         { *m = UserEntity{} }
 func (m *UserEntity) String() string { return proto.CompactTextString(m) }
 func (*UserEntity) ProtoMessage()    {}
 func (*UserEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{92}
+	return fileDescriptor_02345ba45cc0e303, []int{106}
 }
 
 func (m *UserEntity) XXX_Unmarshal(b []byte) error {
@@ -6126,7 +7067,7 @@ func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
 func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleRequest) ProtoMessage()    {}
 func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{93}
+	return fileDescriptor_02345ba45cc0e303, []int{107}
 }
 
 func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6175,7 +7116,7 @@ func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
 func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*DropRoleRequest) ProtoMessage()    {}
 func (*DropRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{94}
+	return fileDescriptor_02345ba45cc0e303, []int{108}
 }
 
 func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6228,7 +7169,7 @@ func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{}
 func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateUserRoleRequest) ProtoMessage()    {}
 func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{95}
+	return fileDescriptor_02345ba45cc0e303, []int{109}
 }
 
 func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6293,7 +7234,7 @@ func (m *SelectRoleRequest) Reset()         { *m = SelectRoleRequest{} }
 func (m *SelectRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectRoleRequest) ProtoMessage()    {}
 func (*SelectRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{96}
+	return fileDescriptor_02345ba45cc0e303, []int{110}
 }
 
 func (m *SelectRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6347,7 +7288,7 @@ func (m *RoleResult) Reset()         { *m = RoleResult{} }
 func (m *RoleResult) String() string { return proto.CompactTextString(m) }
 func (*RoleResult) ProtoMessage()    {}
 func (*RoleResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{97}
+	return fileDescriptor_02345ba45cc0e303, []int{111}
 }
 
 func (m *RoleResult) XXX_Unmarshal(b []byte) error {
@@ -6396,7 +7337,7 @@ func (m *SelectRoleResponse) Reset()         { *m = SelectRoleResponse{} }
 func (m *SelectRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectRoleResponse) ProtoMessage()    {}
 func (*SelectRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{98}
+	return fileDescriptor_02345ba45cc0e303, []int{112}
 }
 
 func (m *SelectRoleResponse) XXX_Unmarshal(b []byte) error {
@@ -6447,7 +7388,7 @@ func (m *SelectUserRequest) Reset()         { *m = SelectUserRequest{} }
 func (m *SelectUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectUserRequest) ProtoMessage()    {}
 func (*SelectUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{99}
+	return fileDescriptor_02345ba45cc0e303, []int{113}
 }
 
 func (m *SelectUserRequest) XXX_Unmarshal(b []byte) error {
@@ -6501,7 +7442,7 @@ func (m *UserResult) Reset()         { *m = UserResult{} }
 func (m *UserResult) String() string { return proto.CompactTextString(m) }
 func (*UserResult) ProtoMessage()    {}
 func (*UserResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{100}
+	return fileDescriptor_02345ba45cc0e303, []int{114}
 }
 
 func (m *UserResult) XXX_Unmarshal(b []byte) error {
@@ -6550,7 +7491,7 @@ func (m *SelectUserResponse) Reset()         { *m = SelectUserResponse{} }
 func (m *SelectUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectUserResponse) ProtoMessage()    {}
 func (*SelectUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{101}
+	return fileDescriptor_02345ba45cc0e303, []int{115}
 }
 
 func (m *SelectUserResponse) XXX_Unmarshal(b []byte) error {
@@ -6596,7 +7537,7 @@ func (m *ObjectEntity) Reset()         { *m = ObjectEntity{} }
 func (m *ObjectEntity) String() string { return proto.CompactTextString(m) }
 func (*ObjectEntity) ProtoMessage()    {}
 func (*ObjectEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{102}
+	return fileDescriptor_02345ba45cc0e303, []int{116}
 }
 
 func (m *ObjectEntity) XXX_Unmarshal(b []byte) error {
@@ -6635,7 +7576,7 @@ func (m *PrivilegeEntity) Reset()         { *m = PrivilegeEntity{} }
 func (m *PrivilegeEntity) String() string { return proto.CompactTextString(m) }
 func (*PrivilegeEntity) ProtoMessage()    {}
 func (*PrivilegeEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{103}
+	return fileDescriptor_02345ba45cc0e303, []int{117}
 }
 
 func (m *PrivilegeEntity) XXX_Unmarshal(b []byte) error {
@@ -6675,7 +7616,7 @@ func (m *GrantorEntity) Reset()         { *m = GrantorEntity{} }
 func (m *GrantorEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantorEntity) ProtoMessage()    {}
 func (*GrantorEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{104}
+	return fileDescriptor_02345ba45cc0e303, []int{118}
 }
 
 func (m *GrantorEntity) XXX_Unmarshal(b []byte) error {
@@ -6721,7 +7662,7 @@ func (m *GrantPrivilegeEntity) Reset()         { *m = GrantPrivilegeEntity{} }
 func (m *GrantPrivilegeEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantPrivilegeEntity) ProtoMessage()    {}
 func (*GrantPrivilegeEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{105}
+	return fileDescriptor_02345ba45cc0e303, []int{119}
 }
 
 func (m *GrantPrivilegeEntity) XXX_Unmarshal(b []byte) error {
@@ -6767,7 +7708,7 @@ func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
 func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantEntity) ProtoMessage()    {}
 func (*GrantEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{106}
+	return fileDescriptor_02345ba45cc0e303, []int{120}
 }
 
 func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
@@ -6830,7 +7771,7 @@ func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
 func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectGrantRequest) ProtoMessage()    {}
 func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{107}
+	return fileDescriptor_02345ba45cc0e303, []int{121}
 }
 
 func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
@@ -6879,7 +7820,7 @@ func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
 func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectGrantResponse) ProtoMessage()    {}
 func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{108}
+	return fileDescriptor_02345ba45cc0e303, []int{122}
 }
 
 func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
@@ -6930,7 +7871,7 @@ func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest
 func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
 func (*OperatePrivilegeRequest) ProtoMessage()    {}
 func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{109}
+	return fileDescriptor_02345ba45cc0e303, []int{123}
 }
 
 func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
@@ -6986,7 +7927,7 @@ func (m *GetLoadingProgressRequest) Reset()         { *m = GetLoadingProgressReq
 func (m *GetLoadingProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLoadingProgressRequest) ProtoMessage()    {}
 func (*GetLoadingProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{110}
+	return fileDescriptor_02345ba45cc0e303, []int{124}
 }
 
 func (m *GetLoadingProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -7041,7 +7982,7 @@ func (m *GetLoadingProgressResponse) Reset()         { *m = GetLoadingProgressRe
 func (m *GetLoadingProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLoadingProgressResponse) ProtoMessage()    {}
 func (*GetLoadingProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{111}
+	return fileDescriptor_02345ba45cc0e303, []int{125}
 }
 
 func (m *GetLoadingProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -7087,7 +8028,7 @@ func (m *MilvusExt) Reset()         { *m = MilvusExt{} }
 func (m *MilvusExt) String() string { return proto.CompactTextString(m) }
 func (*MilvusExt) ProtoMessage()    {}
 func (*MilvusExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{112}
+	return fileDescriptor_02345ba45cc0e303, []int{126}
 }
 
 func (m *MilvusExt) XXX_Unmarshal(b []byte) error {
@@ -7126,6 +8067,7 @@ var E_MilvusExtObj = &proto.ExtensionDesc{
 
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.BackupState", BackupState_name, BackupState_value)
 	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
 	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
@@ -7213,6 +8155,20 @@ func init() {
 	proto.RegisterType((*GetImportStateResponse)(nil), "milvus.proto.milvus.GetImportStateResponse")
 	proto.RegisterType((*ListImportTasksRequest)(nil), "milvus.proto.milvus.ListImportTasksRequest")
 	proto.RegisterType((*ListImportTasksResponse)(nil), "milvus.proto.milvus.ListImportTasksResponse")
+	proto.RegisterType((*BackupInfo)(nil), "milvus.proto.milvus.BackupInfo")
+	proto.RegisterType((*CreateBackupRequest)(nil), "milvus.proto.milvus.CreateBackupRequest")
+	proto.RegisterType((*CreateBackupResponse)(nil), "milvus.proto.milvus.CreateBackupResponse")
+	proto.RegisterType((*ListBackupsRequest)(nil), "milvus.proto.milvus.ListBackupsRequest")
+	proto.RegisterType((*ListBackupsResponse)(nil), "milvus.proto.milvus.ListBackupsResponse")
+	proto.RegisterType((*RestoreBackupRequest)(nil), "milvus.proto.milvus.RestoreBackupRequest")
+	proto.RegisterType((*RestoreBackupResponse)(nil), "milvus.proto.milvus.RestoreBackupResponse")
+	proto.RegisterType((*CloneCollectionRequest)(nil), "milvus.proto.milvus.CloneCollectionRequest")
+	proto.RegisterType((*CloneCollectionResponse)(nil), "milvus.proto.milvus.CloneCollectionResponse")
+	proto.RegisterType((*DroppedCollectionInfo)(nil), "milvus.proto.milvus.DroppedCollectionInfo")
+	proto.RegisterType((*DroppedPartitionInfo)(nil), "milvus.proto.milvus.DroppedPartitionInfo")
+	proto.RegisterType((*ListDroppedCollectionsRequest)(nil), "milvus.proto.milvus.ListDroppedCollectionsRequest")
+	proto.RegisterType((*ListDroppedCollectionsResponse)(nil), "milvus.proto.milvus.ListDroppedCollectionsResponse")
+	proto.RegisterType((*RestoreCollectionRequest)(nil), "milvus.proto.milvus.RestoreCollectionRequest")
 	proto.RegisterType((*GetReplicasRequest)(nil), "milvus.proto.milvus.GetReplicasRequest")
 	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.milvus.GetReplicasResponse")
 	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.milvus.ReplicaInfo")
@@ -7250,345 +8206,380 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 5395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3d, 0x4d, 0x6c, 0x1c, 0xd7,
-	0x79, 0x9c, 0x5d, 0xee, 0xdf, 0xb7, 0xbb, 0xe4, 0xf2, 0xf1, 0x6f, 0xbd, 0x92, 0x2c, 0x6a, 0x6c,
-	0xd9, 0xb4, 0x14, 0x53, 0x36, 0x65, 0xd9, 0xb1, 0xec, 0xd8, 0x96, 0x44, 0x4b, 0x22, 0xac, 0x1f,
-	0x7a, 0x28, 0x3b, 0x48, 0x5d, 0x63, 0x30, 0xdc, 0x79, 0x5c, 0x8e, 0x35, 0x3b, 0xb3, 0x9a, 0x99,
-	0x25, 0x45, 0xf7, 0x12, 0x20, 0x4d, 0x90, 0xa2, 0x69, 0x8c, 0xfe, 0x06, 0x3d, 0xa4, 0x2d, 0x8a,
-	0x5c, 0x8a, 0x26, 0x45, 0xdd, 0x1e, 0x0a, 0xa4, 0x87, 0xde, 0x8d, 0x16, 0x6d, 0x0e, 0x41, 0x1b,
-	0xb4, 0xc7, 0xa0, 0x45, 0x0f, 0x05, 0x7a, 0xe8, 0xad, 0x2d, 0x5a, 0xbc, 0x9f, 0x99, 0x79, 0x33,
-	0xfb, 0x66, 0xb9, 0xd4, 0x5a, 0x16, 0x15, 0x9e, 0x76, 0xde, 0xef, 0xf7, 0xbe, 0xff, 0xf7, 0xbe,
-	0xef, 0x3d, 0x42, 0xad, 0x6b, 0xd9, 0xbb, 0x7d, 0x7f, 0xa5, 0xe7, 0xb9, 0x81, 0x8b, 0x66, 0xc5,
-	0xaf, 0x15, 0xf6, 0xd1, 0xaa, 0xb5, 0xdd, 0x6e, 0xd7, 0x75, 0x58, 0x61, 0xab, 0xe6, 0xb7, 0x77,
-	0x70, 0xd7, 0xe0, 0x5f, 0x4b, 0x1d, 0xd7, 0xed, 0xd8, 0xf8, 0x1c, 0xfd, 0xda, 0xea, 0x6f, 0x9f,
-	0x33, 0xb1, 0xdf, 0xf6, 0xac, 0x5e, 0xe0, 0x7a, 0xac, 0x85, 0xfa, 0x07, 0x0a, 0xa0, 0x2b, 0x1e,
-	0x36, 0x02, 0x7c, 0xc9, 0xb6, 0x0c, 0x5f, 0xc3, 0xf7, 0xfa, 0xd8, 0x0f, 0xd0, 0x0b, 0x30, 0xb9,
-	0x65, 0xf8, 0xb8, 0xa9, 0x2c, 0x29, 0xcb, 0xd5, 0xd5, 0xe3, 0x2b, 0x89, 0x89, 0xf9, 0x84, 0x37,
-	0xfd, 0xce, 0x65, 0xc3, 0xc7, 0x1a, 0x6d, 0x89, 0x16, 0xa1, 0x64, 0x6e, 0xe9, 0x8e, 0xd1, 0xc5,
-	0xcd, 0xdc, 0x92, 0xb2, 0x5c, 0xd1, 0x8a, 0xe6, 0xd6, 0x2d, 0xa3, 0x8b, 0xd1, 0xb3, 0x30, 0xdd,
-	0x76, 0x6d, 0x1b, 0xb7, 0x03, 0xcb, 0x75, 0x58, 0x83, 0x3c, 0x6d, 0x30, 0x15, 0x17, 0xd3, 0x86,
-	0x73, 0x50, 0x30, 0x08, 0x0c, 0xcd, 0x49, 0x5a, 0xcd, 0x3e, 0x54, 0x1f, 0x1a, 0x6b, 0x9e, 0xdb,
-	0x7b, 0x58, 0xd0, 0x45, 0x93, 0xe6, 0xc5, 0x49, 0xbf, 0xaf, 0xc0, 0xcc, 0x25, 0x3b, 0xc0, 0xde,
-	0x11, 0x45, 0xca, 0x0f, 0x73, 0xb0, 0xc8, 0xa8, 0x76, 0x25, 0x6a, 0xfe, 0x28, 0xa1, 0x5c, 0x80,
-	0x22, 0xe3, 0x3b, 0x0a, 0x66, 0x4d, 0xe3, 0x5f, 0xe8, 0x04, 0x80, 0xbf, 0x63, 0x78, 0xa6, 0xaf,
-	0x3b, 0xfd, 0x6e, 0xb3, 0xb0, 0xa4, 0x2c, 0x17, 0xb4, 0x0a, 0x2b, 0xb9, 0xd5, 0xef, 0x22, 0x0d,
-	0x66, 0xda, 0xae, 0xe3, 0x5b, 0x7e, 0x80, 0x9d, 0xf6, 0xbe, 0x6e, 0xe3, 0x5d, 0x6c, 0x37, 0x8b,
-	0x4b, 0xca, 0xf2, 0xd4, 0xea, 0x69, 0x29, 0xdc, 0x57, 0xe2, 0xd6, 0x37, 0x48, 0x63, 0xad, 0xd1,
-	0x4e, 0x95, 0x5c, 0x44, 0x9f, 0xbd, 0x31, 0x5d, 0x56, 0x1a, 0x4a, 0xf3, 0xff, 0xc2, 0x3f, 0x45,
-	0xfd, 0x43, 0x05, 0xe6, 0x09, 0x13, 0x1d, 0x09, 0x64, 0x85, 0x10, 0xe6, 0x44, 0x08, 0xff, 0x44,
-	0x81, 0xb9, 0xeb, 0x86, 0x7f, 0x34, 0xa8, 0x79, 0x02, 0x20, 0xb0, 0xba, 0x58, 0xf7, 0x03, 0xa3,
-	0xdb, 0xa3, 0x14, 0x9d, 0xd4, 0x2a, 0xa4, 0x64, 0x93, 0x14, 0xa8, 0x5f, 0x83, 0xda, 0x65, 0xd7,
-	0xb5, 0x35, 0xec, 0xf7, 0x5c, 0xc7, 0xc7, 0xe8, 0x3c, 0x14, 0xfd, 0xc0, 0x08, 0xfa, 0x3e, 0x07,
-	0xf2, 0x98, 0x14, 0xc8, 0x4d, 0xda, 0x44, 0xe3, 0x4d, 0x09, 0x5f, 0xef, 0x1a, 0x76, 0x9f, 0xc1,
-	0x58, 0xd6, 0xd8, 0x87, 0xfa, 0x01, 0x4c, 0x6d, 0x06, 0x9e, 0xe5, 0x74, 0x3e, 0xc7, 0xc1, 0x2b,
-	0xe1, 0xe0, 0xff, 0xaa, 0xc0, 0x13, 0x6b, 0x54, 0xff, 0x6d, 0x1d, 0x11, 0xb1, 0x51, 0xa1, 0x16,
-	0x97, 0xac, 0xaf, 0x51, 0x54, 0xe7, 0xb5, 0x44, 0x59, 0x8a, 0x18, 0x85, 0x14, 0x31, 0x42, 0x66,
-	0xca, 0x8b, 0xcc, 0xf4, 0xf5, 0x02, 0xb4, 0x64, 0x0b, 0x1d, 0x07, 0xa5, 0x5f, 0x89, 0x24, 0x3c,
-	0x47, 0x3b, 0xa5, 0xe4, 0x93, 0x5b, 0x9d, 0x78, 0xb6, 0x4d, 0x5a, 0x10, 0x29, 0x82, 0xf4, 0x4a,
-	0xf3, 0x92, 0x95, 0xae, 0xc2, 0xfc, 0xae, 0xe5, 0x05, 0x7d, 0xc3, 0xd6, 0xdb, 0x3b, 0x86, 0xe3,
-	0x60, 0x9b, 0xe2, 0x8e, 0xa8, 0xbe, 0xfc, 0x72, 0x45, 0x9b, 0xe5, 0x95, 0x57, 0x58, 0x1d, 0x41,
-	0xa0, 0x8f, 0x5e, 0x82, 0x85, 0xde, 0xce, 0xbe, 0x6f, 0xb5, 0x07, 0x3a, 0x15, 0x68, 0xa7, 0xb9,
-	0xb0, 0x36, 0xd1, 0xeb, 0x2c, 0xcc, 0xb4, 0xa9, 0xf6, 0x34, 0x75, 0x82, 0x49, 0x86, 0xda, 0x22,
-	0x45, 0x6d, 0x83, 0x57, 0xdc, 0x09, 0xcb, 0x09, 0x58, 0x61, 0xe3, 0x7e, 0xd0, 0x16, 0x3a, 0x94,
-	0x68, 0x87, 0x59, 0x5e, 0xf9, 0x5e, 0xd0, 0x8e, 0xfb, 0x24, 0xf5, 0x5e, 0x39, 0xad, 0xf7, 0x9a,
-	0x50, 0xa2, 0x7a, 0x1c, 0xfb, 0xcd, 0x0a, 0x05, 0x33, 0xfc, 0x44, 0xeb, 0x30, 0xed, 0x07, 0x86,
-	0x17, 0xe8, 0x3d, 0xd7, 0xb7, 0x08, 0x5e, 0xfc, 0x26, 0x2c, 0xe5, 0x97, 0xab, 0xab, 0x4b, 0x52,
-	0x22, 0xbd, 0x83, 0xf7, 0xd7, 0x8c, 0xc0, 0xd8, 0x30, 0x2c, 0x4f, 0x9b, 0xa2, 0x1d, 0x37, 0xc2,
-	0x7e, 0x72, 0xe5, 0x5a, 0x1d, 0x4b, 0xb9, 0xca, 0x38, 0xbb, 0x26, 0xe3, 0x6c, 0xf5, 0xaf, 0x15,
-	0x98, 0xbf, 0xe1, 0x1a, 0xe6, 0xd1, 0x90, 0xb3, 0xd3, 0x30, 0xe5, 0xe1, 0x9e, 0x6d, 0xb5, 0x0d,
-	0x42, 0x8f, 0x2d, 0xec, 0x51, 0x49, 0x2b, 0x68, 0x75, 0x5e, 0x7a, 0x8b, 0x16, 0x5e, 0x2c, 0x7d,
-	0xf6, 0xc6, 0x64, 0xa3, 0xd0, 0xcc, 0xab, 0xdf, 0x53, 0xa0, 0xa9, 0x61, 0x1b, 0x1b, 0xfe, 0xd1,
-	0x50, 0x14, 0x0c, 0xb2, 0x62, 0x33, 0xaf, 0xfe, 0x87, 0x02, 0x73, 0xd7, 0x70, 0x40, 0x84, 0xd3,
-	0xf2, 0x03, 0xab, 0xfd, 0x48, 0x7d, 0x93, 0x67, 0x61, 0xba, 0x67, 0x78, 0x81, 0x15, 0xb5, 0x0b,
-	0x45, 0x75, 0x2a, 0x2a, 0x66, 0xf2, 0x76, 0x0e, 0x66, 0x3b, 0x7d, 0xc3, 0x33, 0x9c, 0x00, 0x63,
-	0x41, 0x80, 0x98, 0x32, 0x43, 0x51, 0x55, 0x24, 0x3f, 0x6c, 0xbd, 0xd0, 0xcc, 0xab, 0xdf, 0x54,
-	0x60, 0x3e, 0xb5, 0xde, 0x71, 0xb4, 0xd8, 0x2b, 0x50, 0x20, 0xbf, 0xfc, 0x66, 0x8e, 0x0a, 0xd5,
-	0xa9, 0x2c, 0xa1, 0x7a, 0x9f, 0x18, 0x0c, 0x2a, 0x55, 0xac, 0x3d, 0x71, 0x08, 0x9f, 0xbc, 0x86,
-	0x03, 0x41, 0xbf, 0x1d, 0x05, 0x0a, 0xc4, 0x78, 0xfa, 0x44, 0x81, 0x93, 0x99, 0xf0, 0x3d, 0x12,
-	0x8c, 0xfd, 0x97, 0x02, 0x0b, 0x9b, 0x3b, 0xee, 0x5e, 0x0c, 0xd2, 0xc3, 0xc0, 0x54, 0xd2, 0x3a,
-	0xe6, 0x53, 0xd6, 0x11, 0xbd, 0x08, 0x93, 0xc1, 0x7e, 0x0f, 0x53, 0x71, 0x9f, 0x5a, 0x3d, 0xb1,
-	0x22, 0xd9, 0x3f, 0xad, 0x10, 0x20, 0xef, 0xec, 0xf7, 0xb0, 0x46, 0x9b, 0xa2, 0xe7, 0xa0, 0x91,
-	0xc2, 0x7d, 0x68, 0x4b, 0xa6, 0x93, 0xc8, 0xf7, 0x43, 0xdb, 0x3b, 0x29, 0xda, 0xde, 0xff, 0xcc,
-	0xc1, 0xe2, 0xc0, 0xb2, 0xc7, 0x21, 0x80, 0x0c, 0x9e, 0x9c, 0x14, 0x1e, 0xa2, 0xe6, 0x84, 0xa6,
-	0x96, 0x49, 0x36, 0x35, 0xf9, 0xe5, 0xbc, 0x56, 0x17, 0xcc, 0xac, 0xe9, 0xa3, 0xe7, 0x01, 0x0d,
-	0x58, 0x3f, 0x26, 0xb9, 0x93, 0xda, 0x4c, 0xda, 0xfc, 0x51, 0x13, 0x2b, 0xb5, 0x7f, 0x0c, 0x2d,
-	0x93, 0xda, 0x9c, 0xc4, 0x00, 0xfa, 0xe8, 0x45, 0x98, 0xb3, 0x9c, 0x9b, 0xb8, 0xeb, 0x7a, 0xfb,
-	0x7a, 0x0f, 0x7b, 0x6d, 0xec, 0x04, 0x46, 0x07, 0xfb, 0xcd, 0x22, 0x85, 0x68, 0x36, 0xac, 0xdb,
-	0x88, 0xab, 0xd0, 0xcb, 0xb0, 0x78, 0xaf, 0x8f, 0xbd, 0x7d, 0xdd, 0xc7, 0xde, 0xae, 0xd5, 0xc6,
-	0xba, 0xb1, 0x6b, 0x58, 0xb6, 0xb1, 0x65, 0xe3, 0x66, 0x69, 0x29, 0xbf, 0x5c, 0xd6, 0xe6, 0x69,
-	0xf5, 0x26, 0xab, 0xbd, 0x14, 0x56, 0xaa, 0x7f, 0xa9, 0xc0, 0x02, 0xdb, 0x0c, 0x6d, 0x84, 0x6a,
-	0xe7, 0x11, 0x1b, 0x9b, 0xa4, 0x56, 0xe4, 0x5b, 0xb7, 0x7a, 0x42, 0x29, 0xaa, 0x9f, 0x2a, 0x30,
-	0x47, 0xf6, 0x24, 0x8f, 0x13, 0xcc, 0x7f, 0xae, 0xc0, 0xec, 0x75, 0xc3, 0x7f, 0x9c, 0x40, 0xfe,
-	0x67, 0xee, 0x88, 0x44, 0x30, 0x3f, 0x1e, 0x16, 0x73, 0xd0, 0x63, 0x29, 0x48, 0x3c, 0x16, 0xf5,
-	0xaf, 0x62, 0x47, 0xe5, 0xf1, 0x5a, 0xa0, 0xfa, 0x63, 0x05, 0x4e, 0x5c, 0xc3, 0x41, 0x04, 0xf5,
-	0xd1, 0xf0, 0x68, 0x46, 0x64, 0xaa, 0xef, 0x32, 0x6f, 0x40, 0x0a, 0xfc, 0x23, 0x31, 0xb6, 0xbf,
-	0x9e, 0x83, 0x79, 0x62, 0x75, 0x8e, 0x06, 0x13, 0x8c, 0xb2, 0xad, 0x95, 0x30, 0x4a, 0x41, 0x2a,
-	0x09, 0xa1, 0x09, 0x2f, 0x8e, 0x6c, 0xc2, 0xd5, 0xbf, 0xc8, 0x31, 0xd7, 0x43, 0xc4, 0xc6, 0x38,
-	0x64, 0x91, 0xc0, 0x9a, 0x93, 0xc2, 0xaa, 0x42, 0x2d, 0x2a, 0x59, 0x5f, 0x0b, 0xcd, 0x6f, 0xa2,
-	0xec, 0xa8, 0x5a, 0x5f, 0xf5, 0x3b, 0x0a, 0x2c, 0x84, 0x87, 0x06, 0x9b, 0xb8, 0xd3, 0xc5, 0x4e,
-	0xf0, 0xe0, 0x3c, 0x94, 0xe6, 0x80, 0x9c, 0x84, 0x03, 0x8e, 0x43, 0xc5, 0x67, 0xf3, 0x44, 0xe7,
-	0x01, 0x71, 0x81, 0xfa, 0x37, 0x0a, 0x2c, 0x0e, 0x80, 0x33, 0x0e, 0x11, 0x9b, 0x50, 0xb2, 0x1c,
-	0x13, 0xdf, 0x8f, 0xa0, 0x09, 0x3f, 0x49, 0xcd, 0x56, 0xdf, 0xb2, 0xcd, 0x08, 0x8c, 0xf0, 0x13,
-	0x9d, 0x82, 0x1a, 0x76, 0x88, 0x8f, 0xa1, 0xd3, 0xb6, 0x94, 0x91, 0xcb, 0x5a, 0x95, 0x95, 0xad,
-	0x93, 0x22, 0xd2, 0x79, 0xdb, 0xc2, 0xb4, 0x73, 0x81, 0x75, 0xe6, 0x9f, 0xea, 0x6f, 0x28, 0x30,
-	0x4b, 0xb8, 0x90, 0x43, 0xef, 0x3f, 0x5c, 0x6c, 0x2e, 0x41, 0x55, 0x60, 0x33, 0xbe, 0x10, 0xb1,
-	0x48, 0xbd, 0x0b, 0x73, 0x49, 0x70, 0xc6, 0xc1, 0xe6, 0x93, 0x00, 0x11, 0xad, 0x98, 0x34, 0xe4,
-	0x35, 0xa1, 0x44, 0xfd, 0xdd, 0x5c, 0x18, 0x56, 0xa0, 0x68, 0x7a, 0xc4, 0xa7, 0x99, 0x94, 0x24,
-	0xa2, 0x3e, 0xaf, 0xd0, 0x12, 0x5a, 0xbd, 0x06, 0x35, 0x7c, 0x3f, 0xf0, 0x0c, 0xbd, 0x67, 0x78,
-	0x46, 0x97, 0x89, 0xd5, 0x48, 0xaa, 0xb7, 0x4a, 0xbb, 0x6d, 0xd0, 0x5e, 0x64, 0x12, 0xca, 0x22,
-	0x6c, 0x92, 0x22, 0x9b, 0x84, 0x96, 0xc4, 0xfb, 0xb4, 0x6a, 0x33, 0xaf, 0xfe, 0x84, 0x78, 0x7d,
-	0x9c, 0xad, 0x8f, 0x3a, 0x66, 0x92, 0x6b, 0x2a, 0x48, 0xd7, 0x54, 0x6b, 0xe6, 0xd5, 0x9f, 0xe6,
-	0xa0, 0x41, 0xd7, 0xb2, 0xc6, 0x83, 0x4b, 0x96, 0xeb, 0xa4, 0x3a, 0x2b, 0xa9, 0xce, 0x43, 0xa4,
-	0xf1, 0x55, 0x28, 0x72, 0x4a, 0xe4, 0x47, 0xa5, 0x04, 0xef, 0x70, 0xd0, 0x7a, 0x4e, 0x41, 0x8d,
-	0x4e, 0x82, 0x4d, 0xdd, 0x73, 0xf7, 0x7c, 0x2e, 0xaf, 0x55, 0x5e, 0xa6, 0xb9, 0x7b, 0x74, 0x84,
-	0xc0, 0x0d, 0x0c, 0x9b, 0x35, 0x28, 0x32, 0xa5, 0x44, 0x4b, 0x68, 0xf5, 0x05, 0x66, 0x9f, 0x31,
-	0x3d, 0xfa, 0x9b, 0x5a, 0x3d, 0x29, 0x05, 0x8d, 0xa2, 0x82, 0x88, 0x0b, 0x66, 0xd6, 0x19, 0xa3,
-	0x0b, 0xb0, 0xc8, 0x70, 0x41, 0x3f, 0xf5, 0x6d, 0xc3, 0xb2, 0x75, 0x0f, 0x1b, 0xbe, 0xeb, 0xd0,
-	0xa3, 0xc1, 0x8a, 0x36, 0x67, 0x45, 0x7d, 0xae, 0x1a, 0x96, 0xad, 0xd1, 0x3a, 0xf5, 0x8f, 0x15,
-	0x98, 0x4f, 0xf1, 0xca, 0x38, 0x22, 0x7b, 0x07, 0x10, 0x83, 0xc2, 0x8c, 0xc9, 0x14, 0x7a, 0x1a,
-	0xa7, 0xa5, 0x66, 0x35, 0x4d, 0x54, 0x6d, 0xc6, 0x4a, 0x95, 0xf8, 0xea, 0xcf, 0x14, 0x38, 0x7e,
-	0x0d, 0x07, 0xb4, 0xe9, 0x65, 0xa2, 0x36, 0x37, 0x3c, 0xb7, 0xe3, 0x61, 0xdf, 0xff, 0x05, 0x60,
-	0xec, 0xdf, 0x63, 0x3e, 0xaa, 0x6c, 0x6d, 0xe3, 0x10, 0x22, 0xcd, 0x87, 0xb9, 0x83, 0xf8, 0x30,
-	0x9f, 0xe2, 0x43, 0xaa, 0x45, 0x42, 0xc0, 0x18, 0xa7, 0x3d, 0xfe, 0xc8, 0xfe, 0x01, 0x3b, 0xe9,
-	0x13, 0xd7, 0x34, 0x0e, 0x92, 0x23, 0x51, 0xcd, 0x1d, 0x4a, 0x54, 0x4f, 0x42, 0x55, 0x14, 0x4f,
-	0xb6, 0x62, 0xd8, 0x8e, 0x85, 0xf2, 0xef, 0x14, 0x16, 0x8f, 0xfe, 0x45, 0x50, 0xde, 0xf5, 0x66,
-	0x5e, 0xfd, 0x61, 0x0e, 0xea, 0xeb, 0x8e, 0x8f, 0xbd, 0xe0, 0xe8, 0xef, 0xbb, 0xd0, 0x9b, 0x50,
-	0xa5, 0x2b, 0xf4, 0x75, 0xd3, 0x08, 0x0c, 0x6e, 0xaa, 0x9f, 0x94, 0x46, 0xa2, 0xae, 0x92, 0x76,
-	0x6b, 0x46, 0x60, 0x68, 0x0c, 0x4d, 0x3e, 0xf9, 0x8d, 0x8e, 0x41, 0x65, 0xc7, 0xf0, 0x77, 0xf4,
-	0xbb, 0x78, 0x9f, 0x39, 0xc3, 0x75, 0xad, 0x4c, 0x0a, 0xde, 0xc1, 0xfb, 0x3e, 0x7a, 0x02, 0xca,
-	0x4e, 0xbf, 0xcb, 0x44, 0x8e, 0x28, 0xf8, 0xba, 0x56, 0x72, 0xfa, 0x5d, 0x22, 0x70, 0x0c, 0x5d,
-	0xe5, 0x66, 0x5e, 0xfd, 0xdb, 0x1c, 0x4c, 0xdd, 0xec, 0x93, 0xed, 0x1e, 0x0d, 0xa8, 0xf5, 0xed,
-	0xe0, 0xc1, 0xd8, 0xf3, 0x0c, 0xe4, 0x99, 0xe3, 0x44, 0x7a, 0x34, 0xa5, 0x2b, 0x58, 0x5f, 0xf3,
-	0x35, 0xd2, 0x88, 0x06, 0x93, 0xfa, 0xed, 0x36, 0xf7, 0x41, 0xf3, 0x14, 0xea, 0x0a, 0x29, 0x61,
-	0x1e, 0xe8, 0x31, 0xa8, 0x60, 0xcf, 0x8b, 0x3c, 0x54, 0xba, 0x26, 0xec, 0x79, 0xac, 0x52, 0x85,
-	0x9a, 0xd1, 0xbe, 0xeb, 0xb8, 0x7b, 0x36, 0x36, 0x3b, 0xd8, 0xa4, 0x8c, 0x50, 0xd6, 0x12, 0x65,
-	0x8c, 0x55, 0x08, 0x07, 0xe8, 0x6d, 0x27, 0x08, 0x8d, 0x1e, 0x2b, 0xb9, 0xe2, 0x04, 0xa4, 0xda,
-	0xc4, 0x36, 0x0e, 0x30, 0xad, 0x2e, 0xb1, 0x6a, 0x56, 0xc2, 0xab, 0xfb, 0xbd, 0xa8, 0x77, 0x99,
-	0x55, 0xb3, 0x12, 0x52, 0x7d, 0x1c, 0x2a, 0xf1, 0x81, 0x7f, 0x25, 0x3e, 0x9f, 0xa5, 0x05, 0xea,
-	0xcf, 0x15, 0xa8, 0xaf, 0xd1, 0xa1, 0x1e, 0x03, 0xee, 0x43, 0x30, 0x89, 0xef, 0xf7, 0x3c, 0x2e,
-	0x4c, 0xf4, 0xf7, 0x50, 0x86, 0x62, 0x5c, 0x53, 0x69, 0xe6, 0xd5, 0x6f, 0x4d, 0x42, 0x7d, 0x13,
-	0x1b, 0x5e, 0x7b, 0xe7, 0xb1, 0x38, 0x7c, 0x6a, 0x40, 0xde, 0xf4, 0x6d, 0xbe, 0x4e, 0xf2, 0x13,
-	0x9d, 0x85, 0x99, 0x9e, 0x6d, 0xb4, 0xf1, 0x8e, 0x6b, 0x9b, 0xd8, 0xd3, 0x3b, 0x9e, 0xdb, 0x67,
-	0x01, 0xd3, 0x9a, 0xd6, 0x10, 0x2a, 0xae, 0x91, 0x72, 0xf4, 0x0a, 0x94, 0x4d, 0xdf, 0xd6, 0xe9,
-	0xae, 0x9d, 0x39, 0x4a, 0xf2, 0xf5, 0xad, 0xf9, 0x36, 0xdd, 0xb4, 0x97, 0x4c, 0xf6, 0x03, 0x3d,
-	0x05, 0x75, 0xb7, 0x1f, 0xf4, 0xfa, 0x81, 0xce, 0x44, 0xb6, 0x59, 0xa6, 0xe0, 0xd5, 0x58, 0x21,
-	0x95, 0x68, 0x1f, 0x5d, 0x85, 0xba, 0x4f, 0x51, 0x19, 0x3a, 0xec, 0x95, 0x51, 0xdd, 0xc4, 0x1a,
-	0xeb, 0xc7, 0x3d, 0xf6, 0xe7, 0xa0, 0x11, 0x78, 0xc6, 0x2e, 0xb6, 0x85, 0x80, 0x14, 0x50, 0xfe,
-	0x9c, 0x66, 0xe5, 0x71, 0x34, 0x37, 0x23, 0x7c, 0x55, 0xcd, 0x0a, 0x5f, 0xa1, 0x29, 0xc8, 0x39,
-	0xf7, 0x68, 0x64, 0x34, 0xaf, 0xe5, 0x9c, 0x7b, 0x8c, 0x11, 0xa6, 0x9a, 0x79, 0xf5, 0x1d, 0x98,
-	0xbc, 0x6e, 0x05, 0x14, 0xc3, 0x44, 0xfc, 0x15, 0xba, 0x6f, 0xa2, 0x42, 0xfe, 0x04, 0x94, 0x3d,
-	0x77, 0x8f, 0xe9, 0x35, 0xe2, 0x93, 0xd5, 0xb4, 0x92, 0xe7, 0xee, 0x51, 0xa5, 0x45, 0x93, 0x6b,
-	0x5c, 0x0f, 0x33, 0x8f, 0x38, 0xa7, 0xf1, 0x2f, 0xf5, 0xcf, 0x94, 0x98, 0xab, 0x88, 0x26, 0xf2,
-	0x1f, 0x4c, 0x15, 0xbd, 0x09, 0x25, 0x8f, 0xf5, 0x1f, 0x1a, 0xda, 0x17, 0x67, 0xa2, 0x7a, 0x35,
-	0xec, 0x35, 0x32, 0x03, 0x92, 0x1d, 0x71, 0xed, 0xaa, 0xdd, 0xf7, 0x1f, 0x86, 0x14, 0xc8, 0xc2,
-	0x24, 0x79, 0x79, 0xd8, 0x86, 0x52, 0x63, 0x7a, 0x29, 0xaf, 0xfe, 0xf7, 0x24, 0xd4, 0x39, 0x3c,
-	0xe3, 0xb8, 0x1a, 0x99, 0x30, 0x6d, 0x42, 0x95, 0xcc, 0xad, 0xfb, 0xb8, 0x13, 0x9e, 0x06, 0x55,
-	0x57, 0x57, 0xa5, 0xae, 0x76, 0x02, 0x0c, 0x9a, 0x46, 0xb1, 0x49, 0x3b, 0xbd, 0xed, 0x04, 0xde,
-	0xbe, 0x06, 0xed, 0xa8, 0x00, 0xb5, 0x61, 0x66, 0x9b, 0x34, 0xd6, 0xc5, 0xa1, 0x27, 0xe9, 0xd0,
-	0xaf, 0x8c, 0x30, 0x34, 0xfd, 0x4a, 0x8f, 0x3f, 0xbd, 0x9d, 0x2c, 0x45, 0x1f, 0x32, 0x92, 0xea,
-	0x3e, 0x36, 0xb8, 0x7c, 0x70, 0x63, 0x7b, 0x61, 0x64, 0xe8, 0x0d, 0x26, 0x40, 0x6c, 0x82, 0x7a,
-	0x5b, 0x2c, 0x6b, 0x7d, 0x08, 0xd3, 0x29, 0x10, 0x88, 0x44, 0xdc, 0xc5, 0xfb, 0x7c, 0xa3, 0x48,
-	0x7e, 0xa2, 0x97, 0xc4, 0x24, 0x9e, 0x2c, 0x33, 0x7f, 0xc3, 0x75, 0x3a, 0x97, 0x3c, 0xcf, 0xd8,
-	0xe7, 0x49, 0x3e, 0x17, 0x73, 0x5f, 0x56, 0x5a, 0x5b, 0x30, 0x27, 0x5b, 0xe6, 0xe7, 0x3a, 0xc7,
-	0x5b, 0x80, 0x06, 0xd7, 0x29, 0x99, 0x21, 0x91, 0x8a, 0x94, 0x17, 0x46, 0x50, 0x3f, 0xc9, 0x43,
-	0xed, 0xdd, 0x3e, 0xf6, 0xf6, 0x1f, 0xa5, 0x4d, 0x08, 0x6d, 0xda, 0xa4, 0x60, 0xd3, 0x06, 0xd4,
-	0x70, 0x41, 0xa2, 0x86, 0x25, 0xc6, 0xa4, 0x28, 0x35, 0x26, 0x32, 0x3d, 0x5b, 0x3a, 0x94, 0x9e,
-	0x2d, 0x67, 0xea, 0xd9, 0x35, 0xa8, 0xb1, 0x88, 0xe1, 0x61, 0x4d, 0x41, 0x95, 0x76, 0x63, 0x96,
-	0x80, 0xe9, 0x83, 0x46, 0x33, 0xaf, 0xfe, 0x48, 0x89, 0x28, 0x32, 0x96, 0x3e, 0x4d, 0x38, 0xa9,
-	0xb9, 0x43, 0x3b, 0xa9, 0x23, 0xeb, 0xd3, 0x4f, 0x15, 0xa8, 0xbc, 0x8f, 0xdb, 0x81, 0xeb, 0x11,
-	0x99, 0x95, 0x74, 0x53, 0x46, 0xd8, 0x39, 0xe4, 0xd2, 0x3b, 0x87, 0xf3, 0x50, 0xb6, 0x4c, 0xdd,
-	0x20, 0x0c, 0x4f, 0xe7, 0x1d, 0xe6, 0x9f, 0x96, 0x2c, 0x93, 0x4a, 0xc6, 0xe8, 0x71, 0x9f, 0xef,
-	0x29, 0x50, 0x63, 0x30, 0xfb, 0xac, 0xe7, 0x6b, 0xc2, 0x74, 0x8a, 0x4c, 0x0a, 0xf9, 0x47, 0xb4,
-	0xd0, 0xeb, 0x13, 0xf1, 0xb4, 0x97, 0x00, 0x08, 0x92, 0x79, 0x77, 0x26, 0xc4, 0x4b, 0x52, 0x68,
-	0x59, 0x77, 0x8a, 0xf0, 0xeb, 0x13, 0x5a, 0x85, 0xf4, 0xa2, 0x43, 0x5c, 0x2e, 0x41, 0x81, 0xf6,
-	0x56, 0xff, 0x47, 0x81, 0xd9, 0x2b, 0x86, 0xdd, 0x5e, 0xb3, 0xfc, 0xc0, 0x70, 0xda, 0x63, 0x78,
-	0xa4, 0x17, 0xa1, 0xe4, 0xf6, 0x74, 0x1b, 0x6f, 0x07, 0x1c, 0xa4, 0x53, 0x43, 0x56, 0xc4, 0xd0,
-	0xa0, 0x15, 0xdd, 0xde, 0x0d, 0xbc, 0x1d, 0xa0, 0xd7, 0xa1, 0xec, 0xf6, 0x74, 0xcf, 0xea, 0xec,
-	0x04, 0x1c, 0xfb, 0x23, 0x74, 0x2e, 0xb9, 0x3d, 0x8d, 0xf4, 0x10, 0x0e, 0xcf, 0x26, 0x0f, 0x79,
-	0x78, 0xa6, 0xfe, 0x64, 0x60, 0xf9, 0x63, 0xc8, 0xc0, 0x45, 0x28, 0x5b, 0x4e, 0xa0, 0x9b, 0x96,
-	0x1f, 0xa2, 0xe0, 0x84, 0x9c, 0x87, 0x9c, 0x80, 0xae, 0x80, 0xd2, 0xd4, 0x09, 0xc8, 0xdc, 0xe8,
-	0x2d, 0x80, 0x6d, 0xdb, 0x35, 0x78, 0x6f, 0x86, 0x83, 0x93, 0x72, 0xf1, 0x21, 0xcd, 0xc2, 0xfe,
-	0x15, 0xda, 0x89, 0x8c, 0x10, 0x93, 0xf4, 0xef, 0x15, 0x98, 0xdf, 0xc0, 0x1e, 0xcb, 0x61, 0x0b,
-	0xf8, 0xc9, 0xf7, 0xba, 0xb3, 0xed, 0x26, 0x83, 0x0f, 0x4a, 0x2a, 0xf8, 0xf0, 0xf9, 0x1c, 0xb8,
-	0x27, 0xf6, 0x93, 0x2c, 0x04, 0x16, 0xee, 0x27, 0xc3, 0x40, 0x1f, 0xdb, 0x98, 0x4f, 0x65, 0x90,
-	0x89, 0xc3, 0x2b, 0x9e, 0x4f, 0xa8, 0xbf, 0xcd, 0xf2, 0x7c, 0xa4, 0x8b, 0x7a, 0x70, 0x86, 0x5d,
-	0x00, 0x6e, 0x38, 0x52, 0x66, 0xe4, 0x19, 0x48, 0xe9, 0x8e, 0x0c, 0x45, 0xf4, 0xfb, 0x0a, 0x2c,
-	0x65, 0x43, 0x35, 0x8e, 0x6f, 0xf5, 0x16, 0x14, 0x2c, 0x67, 0xdb, 0x0d, 0xcf, 0x29, 0xcf, 0x48,
-	0x65, 0x41, 0x3e, 0x2f, 0xeb, 0xa8, 0xfe, 0x43, 0x0e, 0x1a, 0xef, 0xb2, 0xbc, 0x91, 0x2f, 0x9c,
-	0xfc, 0x5d, 0xdc, 0xd5, 0x7d, 0xeb, 0x63, 0x1c, 0x92, 0xbf, 0x8b, 0xbb, 0x9b, 0xd6, 0xc7, 0x38,
-	0xc1, 0x19, 0x85, 0x24, 0x67, 0x0c, 0x0f, 0x24, 0x88, 0xe7, 0xe6, 0xa5, 0xe4, 0xb9, 0xf9, 0x02,
-	0x14, 0x1d, 0xd7, 0xc4, 0xeb, 0x6b, 0x7c, 0x0f, 0xce, 0xbf, 0x62, 0x56, 0xab, 0x1c, 0x8e, 0xd5,
-	0xc8, 0x54, 0x74, 0x08, 0x93, 0xa5, 0xa0, 0x12, 0x18, 0xd9, 0xa7, 0xfa, 0x5d, 0x05, 0x5a, 0xd7,
-	0x70, 0x90, 0xc6, 0xea, 0xa3, 0xe3, 0xbf, 0x4f, 0x14, 0x38, 0x26, 0x05, 0x68, 0x1c, 0xd6, 0x7b,
-	0x2d, 0xc9, 0x7a, 0xf2, 0x23, 0xf2, 0x81, 0x29, 0x39, 0xd7, 0xbd, 0x08, 0xb5, 0xb5, 0x7e, 0xb7,
-	0x1b, 0xf9, 0x76, 0xa7, 0xa0, 0xe6, 0xb1, 0x9f, 0x6c, 0x5f, 0xcc, 0x2c, 0x73, 0x95, 0x97, 0x91,
-	0xdd, 0xaf, 0x7a, 0x16, 0xea, 0xbc, 0x0b, 0x87, 0xba, 0x05, 0x65, 0x8f, 0xff, 0xe6, 0xed, 0xa3,
-	0x6f, 0x75, 0x1e, 0x66, 0x35, 0xdc, 0x21, 0x4c, 0xef, 0xdd, 0xb0, 0x9c, 0xbb, 0x7c, 0x1a, 0xf5,
-	0x1b, 0x0a, 0xcc, 0x25, 0xcb, 0xf9, 0x58, 0x2f, 0x43, 0xc9, 0x30, 0x4d, 0x0f, 0xfb, 0xfe, 0x50,
-	0xb2, 0x5c, 0x62, 0x6d, 0xb4, 0xb0, 0xb1, 0x80, 0xb9, 0xdc, 0xc8, 0x98, 0x53, 0x75, 0x98, 0xb9,
-	0x86, 0x83, 0x9b, 0x38, 0xf0, 0xc6, 0x4a, 0xe7, 0x68, 0x92, 0x8d, 0x29, 0xed, 0xcc, 0xd9, 0x22,
-	0xfc, 0x54, 0xbf, 0xa3, 0x00, 0x12, 0x67, 0x18, 0x87, 0xcc, 0x22, 0x96, 0x73, 0x49, 0x2c, 0xb3,
-	0x84, 0xba, 0x6e, 0xcf, 0x75, 0xb0, 0x13, 0x88, 0x8e, 0x58, 0x3d, 0x2a, 0xa5, 0xec, 0xf7, 0x73,
-	0x05, 0xd0, 0x0d, 0xd7, 0x30, 0x2f, 0x1b, 0xf6, 0x78, 0x8e, 0xc3, 0x09, 0x00, 0xdf, 0x6b, 0xeb,
-	0x5c, 0x8e, 0x73, 0x5c, 0x2f, 0x79, 0xed, 0x5b, 0x4c, 0x94, 0x4f, 0x42, 0xd5, 0xf4, 0x03, 0x5e,
-	0x1d, 0x66, 0x17, 0x80, 0xe9, 0x07, 0xac, 0x9e, 0xe6, 0xb5, 0x93, 0x1d, 0x1b, 0x36, 0x75, 0x21,
-	0x38, 0x3b, 0x49, 0x9b, 0x35, 0x58, 0xc5, 0x66, 0x54, 0x2e, 0x11, 0xae, 0x42, 0x76, 0x8e, 0xe9,
-	0x4c, 0xb3, 0xa0, 0x6e, 0xc3, 0xe2, 0x4d, 0xc3, 0xe9, 0x1b, 0xf6, 0x15, 0xb7, 0xdb, 0x33, 0x12,
-	0x39, 0xd1, 0x69, 0x8d, 0xa9, 0x48, 0x34, 0xe6, 0x93, 0x2c, 0x55, 0x93, 0x39, 0xfd, 0x74, 0x71,
-	0x93, 0x9a, 0x50, 0xc2, 0xe6, 0x29, 0x35, 0x15, 0xd5, 0x87, 0xe6, 0xe0, 0x3c, 0xe3, 0x90, 0x98,
-	0x42, 0x17, 0x0e, 0x25, 0xea, 0xf3, 0xb8, 0x4c, 0x7d, 0x13, 0x9e, 0xa0, 0xf9, 0xb3, 0x61, 0x51,
-	0x22, 0xac, 0x92, 0x1e, 0x40, 0x91, 0x0c, 0xf0, 0xa7, 0x39, 0xaa, 0x14, 0x07, 0x46, 0x18, 0x07,
-	0xf0, 0x8b, 0xc9, 0x20, 0xc6, 0xd3, 0x19, 0x69, 0xfb, 0xc9, 0x19, 0xb9, 0xfa, 0x5e, 0x86, 0x69,
-	0x7c, 0x1f, 0xb7, 0xfb, 0x81, 0xe5, 0x74, 0x36, 0x6c, 0xc3, 0xb9, 0xe5, 0x72, 0x23, 0x95, 0x2e,
-	0x46, 0x4f, 0x43, 0x9d, 0x90, 0xc1, 0xed, 0x07, 0xbc, 0x1d, 0xb3, 0x56, 0xc9, 0x42, 0x32, 0x1e,
-	0x59, 0xaf, 0x8d, 0x03, 0x6c, 0xf2, 0x76, 0xcc, 0x74, 0xa5, 0x8b, 0x09, 0xb6, 0xb6, 0x0d, 0xcb,
-	0x8e, 0x9a, 0xb1, 0x13, 0xe5, 0x44, 0xd9, 0x00, 0xba, 0x49, 0xb1, 0x7f, 0x18, 0x74, 0xff, 0xa3,
-	0x92, 0x42, 0x37, 0x1f, 0xe1, 0x51, 0xa1, 0xfb, 0x3a, 0x40, 0x17, 0x7b, 0x1d, 0xbc, 0x4e, 0x4d,
-	0x06, 0x3b, 0xea, 0x59, 0x96, 0x9a, 0x8c, 0x78, 0x80, 0x9b, 0x61, 0x07, 0x4d, 0xe8, 0xab, 0x5e,
-	0x83, 0x59, 0x49, 0x13, 0xa2, 0x0d, 0x7d, 0xb7, 0xef, 0xb5, 0x71, 0x78, 0x6c, 0x18, 0x7e, 0x12,
-	0xeb, 0x19, 0x18, 0x5e, 0x07, 0x07, 0x9c, 0xb1, 0xf9, 0x97, 0xfa, 0x32, 0x0d, 0x12, 0xd2, 0x93,
-	0x90, 0x04, 0x37, 0x27, 0x73, 0x37, 0x94, 0x81, 0xdc, 0x8d, 0x6d, 0x1a, 0x88, 0x13, 0xfb, 0x8d,
-	0x99, 0x77, 0x43, 0x4f, 0x97, 0xb0, 0xc9, 0xaf, 0x7a, 0x85, 0x9f, 0xea, 0xff, 0x2a, 0x50, 0x5f,
-	0xef, 0xf6, 0xdc, 0x38, 0xf4, 0x34, 0xf2, 0x16, 0x76, 0xf0, 0xc4, 0x3e, 0x27, 0x3b, 0xb1, 0x7f,
-	0x0a, 0xea, 0xc9, 0x4b, 0x41, 0xec, 0x44, 0xb0, 0xd6, 0x16, 0x2f, 0x03, 0x1d, 0x83, 0x8a, 0xe7,
-	0xee, 0xe9, 0x44, 0x01, 0x9b, 0x3c, 0xc3, 0xa7, 0xec, 0xb9, 0x7b, 0x44, 0x2d, 0x9b, 0x68, 0x0e,
-	0x0a, 0xdb, 0x96, 0x1d, 0x25, 0xa7, 0xb1, 0x0f, 0xf4, 0x1a, 0xd9, 0xe0, 0xb1, 0xf8, 0x79, 0x71,
-	0xd4, 0x7d, 0x56, 0xd8, 0x83, 0xe9, 0x39, 0xd4, 0x54, 0xd4, 0x0f, 0x60, 0x2a, 0x5c, 0xfe, 0x98,
-	0x97, 0xdd, 0x02, 0xc3, 0xbf, 0x1b, 0x66, 0xe1, 0xb0, 0x0f, 0xf5, 0x2c, 0x8b, 0xa6, 0xd2, 0xf1,
-	0x13, 0xd4, 0x47, 0x30, 0x49, 0x5a, 0x70, 0xa1, 0xa2, 0xbf, 0xd5, 0x7f, 0xcb, 0xc1, 0x42, 0xba,
-	0xf5, 0x38, 0x20, 0xbd, 0x9c, 0x14, 0x24, 0xf9, 0xdd, 0x25, 0x71, 0x36, 0x2e, 0x44, 0x9c, 0x14,
-	0x6d, 0xb7, 0xef, 0x04, 0x5c, 0x5b, 0x11, 0x52, 0x5c, 0x21, 0xdf, 0x68, 0x11, 0x4a, 0x96, 0xa9,
-	0xdb, 0x64, 0x53, 0xc8, 0x4c, 0x5a, 0xd1, 0x32, 0x6f, 0x90, 0x0d, 0xe3, 0x2b, 0xa1, 0xa3, 0x36,
-	0x72, 0xea, 0x0e, 0x6b, 0x8f, 0xa6, 0x20, 0x67, 0x99, 0x5c, 0x3d, 0xe5, 0x2c, 0x93, 0xb2, 0x8b,
-	0x98, 0x3f, 0xcf, 0x5d, 0x6c, 0xd1, 0x8c, 0x99, 0xc4, 0x08, 0x73, 0x59, 0xa1, 0x19, 0xf6, 0xe5,
-	0xa4, 0xf8, 0x98, 0x94, 0x9f, 0x58, 0x4e, 0x9e, 0x1e, 0xf8, 0xd4, 0xe9, 0xce, 0x6b, 0x65, 0x56,
-	0x70, 0xc7, 0x57, 0xbf, 0x0a, 0x0b, 0x04, 0x66, 0xb6, 0xf6, 0x3b, 0x84, 0x52, 0x87, 0xe6, 0xfd,
-	0x39, 0x28, 0xd8, 0x56, 0xd7, 0x0a, 0xa5, 0x9d, 0x7d, 0xa8, 0xbf, 0xa9, 0xc0, 0xe2, 0xc0, 0xc8,
-	0xe3, 0xd0, 0xf0, 0x92, 0xc8, 0x56, 0xd5, 0xd5, 0xb3, 0x52, 0x5d, 0x26, 0x67, 0x9a, 0x90, 0x07,
-	0x7f, 0x87, 0xb9, 0x69, 0x1a, 0x4b, 0x59, 0x7e, 0xc8, 0x09, 0x70, 0xcb, 0xd0, 0xd8, 0xb3, 0x82,
-	0x1d, 0x9d, 0xde, 0xb2, 0xa3, 0x3e, 0x12, 0x4b, 0x9c, 0x28, 0x6b, 0x53, 0xa4, 0x7c, 0x93, 0x14,
-	0x13, 0x3f, 0xc9, 0x57, 0xbf, 0xad, 0xc0, 0x6c, 0x02, 0xac, 0x71, 0xd0, 0xf4, 0x3a, 0x71, 0x1f,
-	0xd9, 0x40, 0x1c, 0x53, 0x4b, 0x52, 0x4c, 0xf1, 0xd9, 0xa8, 0xb6, 0x8f, 0x7a, 0xa8, 0x3f, 0x53,
-	0xa0, 0x2a, 0xd4, 0x90, 0x7d, 0x29, 0xaf, 0x8b, 0xf7, 0xa5, 0x51, 0xc1, 0x48, 0x68, 0x78, 0x0a,
-	0x62, 0x1d, 0x28, 0x5c, 0x01, 0x11, 0x72, 0x50, 0x4d, 0x1f, 0x5d, 0x87, 0x29, 0x86, 0xa6, 0x08,
-	0x74, 0xe9, 0x71, 0x51, 0x94, 0x5d, 0x6b, 0x78, 0x26, 0x87, 0x52, 0xab, 0xfb, 0xc2, 0x17, 0x8b,
-	0x99, 0xbb, 0x26, 0xa6, 0x33, 0x15, 0x06, 0x76, 0x89, 0x35, 0xb1, 0x2b, 0xf1, 0xb4, 0x6d, 0x6c,
-	0x98, 0xd8, 0x8b, 0xd6, 0x16, 0x7d, 0x13, 0xa9, 0x62, 0xbf, 0x75, 0xb2, 0xf3, 0xe0, 0xda, 0x1c,
-	0x58, 0x11, 0xd9, 0x94, 0xa0, 0x67, 0x60, 0xda, 0xec, 0x26, 0xae, 0x78, 0x86, 0xbe, 0xb8, 0xd9,
-	0x15, 0xee, 0x76, 0x26, 0x00, 0x9a, 0x4c, 0x02, 0xf4, 0xcd, 0xf8, 0xd2, 0xbc, 0x87, 0x4d, 0xec,
-	0x04, 0x96, 0x61, 0x3f, 0x38, 0x4f, 0xb6, 0xa0, 0xdc, 0xf7, 0xb1, 0x27, 0x18, 0x9f, 0xe8, 0x9b,
-	0xd4, 0xf5, 0x0c, 0xdf, 0xdf, 0x73, 0x3d, 0x93, 0x43, 0x19, 0x7d, 0x0f, 0x49, 0xe8, 0x65, 0x17,
-	0xad, 0xe5, 0x09, 0xbd, 0x2f, 0xc3, 0x62, 0xd7, 0x35, 0xad, 0x6d, 0x4b, 0x96, 0x07, 0x4c, 0xba,
-	0xcd, 0x87, 0xd5, 0x89, 0x7e, 0xe1, 0x15, 0xa5, 0x59, 0xf1, 0x8a, 0xd2, 0x0f, 0x72, 0xb0, 0xf8,
-	0x5e, 0xcf, 0xfc, 0x02, 0xf0, 0xb0, 0x04, 0x55, 0xd7, 0x36, 0x37, 0x92, 0xa8, 0x10, 0x8b, 0x48,
-	0x0b, 0x07, 0xef, 0x45, 0x2d, 0x58, 0x18, 0x42, 0x2c, 0x1a, 0x9a, 0x00, 0xfd, 0x40, 0xf8, 0x2a,
-	0x0e, 0xc3, 0x57, 0xe5, 0xb3, 0x37, 0x8a, 0xe5, 0x5c, 0x63, 0xae, 0x99, 0x53, 0x7f, 0x05, 0x16,
-	0x59, 0x6a, 0xc2, 0x43, 0xc6, 0x52, 0x48, 0xa3, 0x79, 0x91, 0x46, 0x1f, 0xc1, 0x3c, 0xd1, 0xe6,
-	0x64, 0xea, 0xf7, 0x7c, 0xec, 0x8d, 0xa9, 0xa4, 0x8e, 0x43, 0x25, 0x9c, 0x2d, 0x4c, 0x5d, 0x8f,
-	0x0b, 0xd4, 0x5f, 0x86, 0xb9, 0xd4, 0x5c, 0x0f, 0xb8, 0xca, 0x70, 0x25, 0x0b, 0xe2, 0x4a, 0x96,
-	0x00, 0x34, 0xd7, 0xc6, 0x6f, 0x3b, 0x81, 0x15, 0xec, 0x13, 0xef, 0x43, 0x30, 0x6d, 0xf4, 0x37,
-	0x69, 0x41, 0xe6, 0x1d, 0xd2, 0xe2, 0xb7, 0x14, 0x98, 0x61, 0x92, 0x4b, 0x86, 0x7a, 0x70, 0x2a,
-	0xbc, 0x02, 0x45, 0x4c, 0x67, 0xe1, 0xa7, 0x19, 0x27, 0xe5, 0xaa, 0x3a, 0x02, 0x57, 0xe3, 0xcd,
-	0xa5, 0x62, 0x14, 0xc0, 0xf4, 0x9a, 0xe7, 0xf6, 0xc6, 0x83, 0x88, 0x7a, 0x3c, 0x36, 0x16, 0x7d,
-	0xd8, 0x32, 0x29, 0xb8, 0x95, 0xc5, 0x18, 0x3f, 0x55, 0x60, 0xe1, 0x76, 0x0f, 0x7b, 0x46, 0x80,
-	0x09, 0xd2, 0xc6, 0x9b, 0x7d, 0x98, 0xec, 0x26, 0x20, 0xcb, 0x27, 0x21, 0x43, 0xaf, 0x27, 0xee,
-	0x55, 0xca, 0xf7, 0x39, 0x29, 0x28, 0xe3, 0xfb, 0x19, 0xe1, 0xba, 0x16, 0xc5, 0x75, 0xfd, 0x58,
-	0x81, 0x99, 0x4d, 0x4c, 0xec, 0xd8, 0x78, 0x4b, 0x3a, 0x0f, 0x93, 0x04, 0xca, 0x51, 0x09, 0x4c,
-	0x1b, 0xa3, 0x33, 0x30, 0x63, 0x39, 0x6d, 0xbb, 0x6f, 0x62, 0x9d, 0xac, 0x5f, 0x27, 0xee, 0x21,
-	0x77, 0x1e, 0xa6, 0x79, 0x05, 0x59, 0x06, 0x31, 0xd1, 0x52, 0x1e, 0xbf, 0xcf, 0x78, 0x3c, 0x4a,
-	0x08, 0x63, 0x20, 0x28, 0x87, 0x01, 0xe1, 0x02, 0x14, 0xc8, 0xd4, 0xa1, 0x13, 0x21, 0xef, 0x15,
-	0x8b, 0x89, 0xc6, 0x5a, 0xab, 0xbf, 0xaa, 0x00, 0x12, 0xd1, 0x36, 0x8e, 0x96, 0x78, 0x55, 0x4c,
-	0x04, 0xc9, 0x0f, 0x05, 0x9d, 0xad, 0x34, 0x4a, 0x01, 0x51, 0x3f, 0x8d, 0xa8, 0x47, 0xc9, 0x3d,
-	0x0e, 0xf5, 0xc8, 0xba, 0x86, 0x52, 0x4f, 0x40, 0x02, 0x6d, 0x2c, 0x52, 0x8f, 0x72, 0xac, 0x84,
-	0x7a, 0x04, 0x66, 0x4a, 0x3d, 0xae, 0xdf, 0x9b, 0xcd, 0x1c, 0x21, 0x1a, 0x03, 0x36, 0x24, 0x1a,
-	0x9d, 0x59, 0x39, 0xcc, 0xcc, 0x17, 0xa0, 0x40, 0x66, 0x3c, 0x18, 0x5f, 0x21, 0xd1, 0x68, 0x6b,
-	0x81, 0x68, 0x1c, 0x80, 0x87, 0x4f, 0xb4, 0x78, 0xa5, 0x31, 0xd1, 0x54, 0xa8, 0xdd, 0xde, 0xfa,
-	0x08, 0xb7, 0x83, 0x21, 0x9a, 0xf7, 0x34, 0x4c, 0x6f, 0x78, 0xd6, 0xae, 0x65, 0xe3, 0xce, 0x30,
-	0x15, 0xfe, 0x6d, 0x05, 0xea, 0xd7, 0x3c, 0xc3, 0x09, 0xdc, 0x50, 0x8d, 0x3f, 0x10, 0x3e, 0x2f,
-	0x43, 0xa5, 0x17, 0xce, 0xc6, 0x79, 0xe0, 0x69, 0x79, 0xc4, 0x27, 0x09, 0x93, 0x16, 0x77, 0x53,
-	0xdf, 0x87, 0x39, 0x0a, 0x49, 0x1a, 0xec, 0x37, 0xa0, 0x4c, 0x95, 0xb9, 0xc5, 0x0f, 0x50, 0xaa,
-	0xab, 0xaa, 0x7c, 0x4b, 0x23, 0x2e, 0x43, 0x8b, 0xfa, 0xa8, 0xff, 0xa2, 0x40, 0x95, 0xd6, 0xc5,
-	0x0b, 0x3c, 0xbc, 0x94, 0xbf, 0x0a, 0x45, 0x97, 0xa2, 0x7c, 0x68, 0x60, 0x58, 0xa4, 0x8a, 0xc6,
-	0x3b, 0x10, 0x0f, 0x99, 0xfd, 0x12, 0x35, 0x32, 0xb0, 0x22, 0xae, 0x93, 0x4b, 0x1d, 0x06, 0x3b,
-	0x55, 0xcb, 0xa3, 0xad, 0x2f, 0xec, 0x42, 0xf7, 0x6a, 0x8c, 0x27, 0x69, 0x83, 0x07, 0x17, 0xe1,
-	0x2f, 0xa7, 0x6c, 0xec, 0x52, 0x36, 0x14, 0x72, 0x23, 0x9b, 0xd0, 0xac, 0x64, 0xaf, 0x96, 0x00,
-	0x6b, 0xcc, 0xbd, 0x5a, 0xc4, 0x02, 0xc3, 0xf6, 0x6a, 0x22, 0x70, 0x31, 0x03, 0xfc, 0x93, 0x02,
-	0x8b, 0xdc, 0xa6, 0x45, 0xbc, 0xf5, 0x08, 0xd0, 0x84, 0xbe, 0xc2, 0x6d, 0x6f, 0x9e, 0xda, 0xde,
-	0xe7, 0x86, 0xd9, 0xde, 0x08, 0xce, 0x03, 0x8c, 0xef, 0xf7, 0x15, 0x7a, 0x1c, 0x7b, 0xc3, 0x35,
-	0x4c, 0xcb, 0xe9, 0x8c, 0x7f, 0x83, 0x63, 0x30, 0xb4, 0x90, 0x93, 0x1e, 0x65, 0x3c, 0x03, 0xa9,
-	0xf4, 0x10, 0x7e, 0x40, 0x97, 0x4e, 0x1a, 0xe9, 0xd2, 0xb3, 0xde, 0x01, 0xf0, 0xc6, 0x0c, 0xfb,
-	0xf4, 0xf8, 0x40, 0x7c, 0x1f, 0x1d, 0x7d, 0xab, 0xa7, 0xa1, 0x72, 0x93, 0x8e, 0xf0, 0xf6, 0xfd,
-	0x00, 0x35, 0xa1, 0xb4, 0x8b, 0x3d, 0xdf, 0x72, 0x1d, 0xae, 0xf1, 0xc2, 0xcf, 0x33, 0xa7, 0xa0,
-	0x1c, 0x5e, 0x3c, 0x45, 0x25, 0xc8, 0x5f, 0xb2, 0xed, 0xc6, 0x04, 0xaa, 0x41, 0x79, 0x9d, 0xdf,
-	0xae, 0x6c, 0x28, 0x67, 0xde, 0x82, 0x59, 0x89, 0x1b, 0x84, 0x66, 0xa0, 0x7e, 0xc9, 0xa4, 0xce,
-	0xf6, 0x1d, 0x97, 0x14, 0x36, 0x26, 0xd0, 0x02, 0x20, 0x0d, 0x77, 0xdd, 0x5d, 0xda, 0xf0, 0xaa,
-	0xe7, 0x76, 0x69, 0xb9, 0x72, 0xe6, 0x79, 0x98, 0x93, 0x11, 0x13, 0x55, 0xa0, 0x40, 0x99, 0xa3,
-	0x31, 0x81, 0x00, 0x8a, 0x1a, 0xde, 0x75, 0xef, 0xe2, 0x86, 0xb2, 0xfa, 0xa3, 0xb3, 0x50, 0x67,
-	0xb0, 0xf3, 0x67, 0x12, 0x90, 0x0e, 0x8d, 0xf4, 0x4b, 0x71, 0xe8, 0x4b, 0xf2, 0x83, 0x69, 0xf9,
-	0x83, 0x72, 0xad, 0x61, 0xf8, 0x54, 0x27, 0xd0, 0x07, 0x30, 0x95, 0x7c, 0x5b, 0x0d, 0xc9, 0xa3,
-	0xf4, 0xd2, 0x07, 0xd8, 0x0e, 0x1a, 0x5c, 0x87, 0x7a, 0xe2, 0x59, 0x34, 0x24, 0xe7, 0x77, 0xd9,
-	0xd3, 0x69, 0x2d, 0xb9, 0x72, 0x15, 0x9f, 0x2e, 0x63, 0xd0, 0x27, 0xdf, 0x29, 0xca, 0x80, 0x5e,
-	0xfa, 0x98, 0xd1, 0x41, 0xd0, 0x1b, 0x30, 0x33, 0xf0, 0x8c, 0x10, 0x7a, 0x3e, 0xe3, 0x7c, 0x48,
-	0xfe, 0xdc, 0xd0, 0x41, 0x53, 0xec, 0x01, 0x1a, 0x7c, 0xea, 0x0b, 0xad, 0xc8, 0x29, 0x90, 0xf5,
-	0xf8, 0x59, 0xeb, 0xdc, 0xc8, 0xed, 0x23, 0xc4, 0x7d, 0x4b, 0x81, 0xc5, 0x8c, 0x17, 0x67, 0xd0,
-	0xf9, 0xac, 0xc3, 0xc2, 0x21, 0xef, 0xe7, 0xb4, 0x5e, 0x3a, 0x5c, 0xa7, 0x08, 0x10, 0x07, 0xa6,
-	0x53, 0x0f, 0xae, 0xa0, 0xb3, 0x99, 0xb7, 0xc4, 0x07, 0x5f, 0xa3, 0x69, 0x7d, 0x69, 0xb4, 0xc6,
-	0xd1, 0x7c, 0x1f, 0xc2, 0x74, 0xea, 0xb5, 0x91, 0x8c, 0xf9, 0xe4, 0x6f, 0x92, 0x1c, 0x44, 0xd0,
-	0xaf, 0x41, 0x3d, 0xf1, 0x2c, 0x48, 0x06, 0xc7, 0xcb, 0x9e, 0x0e, 0x39, 0x68, 0xe8, 0x0f, 0xa1,
-	0x26, 0xbe, 0xde, 0x81, 0x96, 0xb3, 0x64, 0x69, 0x60, 0xe0, 0xc3, 0x88, 0x52, 0x7c, 0xeb, 0x7e,
-	0x88, 0x28, 0x0d, 0x3c, 0x54, 0x30, 0xba, 0x28, 0x09, 0xe3, 0x0f, 0x15, 0xa5, 0x43, 0x4f, 0xf1,
-	0x0d, 0x85, 0x46, 0x41, 0x24, 0xaf, 0x3a, 0xa0, 0xd5, 0x2c, 0xde, 0xcc, 0x7e, 0xbf, 0xa2, 0x75,
-	0xfe, 0x50, 0x7d, 0x22, 0x2c, 0xde, 0x85, 0xa9, 0xe4, 0xdb, 0x05, 0x19, 0x58, 0x94, 0x3e, 0xf7,
-	0xd0, 0x3a, 0x3b, 0x52, 0xdb, 0x68, 0xb2, 0x3d, 0x7a, 0x40, 0x9f, 0x32, 0xac, 0x19, 0xda, 0x23,
-	0xd3, 0x41, 0xc8, 0xd0, 0x1e, 0xd9, 0x16, 0x5b, 0x9d, 0x40, 0xef, 0x41, 0x55, 0x78, 0x75, 0x16,
-	0x3d, 0x3b, 0x44, 0x80, 0xc4, 0x27, 0x58, 0x0f, 0x22, 0xe1, 0xbb, 0x50, 0x89, 0x1e, 0x8b, 0x45,
-	0xa7, 0x33, 0x05, 0xe7, 0x30, 0x43, 0x6e, 0x02, 0xc4, 0x2f, 0xc1, 0xa2, 0x67, 0xa4, 0x63, 0x0e,
-	0x3c, 0x15, 0x7b, 0xd0, 0xa0, 0xd1, 0xf2, 0xd9, 0x2d, 0xad, 0x61, 0xcb, 0x17, 0x2f, 0x1a, 0x1e,
-	0x34, 0xec, 0x0e, 0xd4, 0x13, 0x17, 0x86, 0xb3, 0x74, 0x87, 0xe4, 0x02, 0x7a, 0xeb, 0xcc, 0x28,
-	0x4d, 0x23, 0xfa, 0xed, 0x40, 0x3d, 0x71, 0x59, 0x33, 0x63, 0x26, 0xd9, 0x25, 0xd5, 0x8c, 0x99,
-	0xa4, 0x77, 0x3f, 0xd5, 0x09, 0xf4, 0x75, 0xe1, 0x5e, 0x68, 0xe2, 0x12, 0x2e, 0x7a, 0x71, 0xe8,
-	0x38, 0xb2, 0xcb, 0xc8, 0xad, 0xd5, 0xc3, 0x74, 0x89, 0x40, 0xe0, 0x5c, 0xc5, 0x50, 0x9a, 0xcd,
-	0x55, 0x87, 0xa1, 0xd4, 0x26, 0x14, 0xd9, 0xad, 0x4b, 0xa4, 0x66, 0x5c, 0xbd, 0x16, 0xae, 0x64,
-	0xb6, 0x9e, 0x92, 0xb6, 0x49, 0xde, 0x43, 0x64, 0x83, 0xb2, 0x13, 0xeb, 0x8c, 0x41, 0x13, 0x37,
-	0xed, 0x46, 0x1d, 0x54, 0x83, 0x22, 0xbb, 0xfa, 0x93, 0x31, 0x68, 0xe2, 0x5e, 0x5b, 0x6b, 0x78,
-	0x1b, 0x76, 0xee, 0x30, 0x81, 0x36, 0xa0, 0x40, 0xd3, 0x0b, 0xd0, 0xa9, 0x61, 0xd7, 0x49, 0x86,
-	0x8d, 0x98, 0xb8, 0x71, 0xa2, 0x4e, 0xa0, 0xdb, 0x50, 0xa0, 0xa9, 0x78, 0x19, 0x23, 0x8a, 0xf7,
-	0x2c, 0x5a, 0x43, 0x9b, 0x84, 0x20, 0x9a, 0x50, 0x13, 0xb3, 0xa1, 0x33, 0x6c, 0xa5, 0x24, 0x5f,
-	0xbc, 0x35, 0x4a, 0xcb, 0x70, 0x16, 0x26, 0x46, 0x71, 0xaa, 0x45, 0xb6, 0x18, 0x0d, 0xa4, 0x71,
-	0x64, 0x8b, 0xd1, 0x60, 0xe6, 0x86, 0x3a, 0x81, 0x7e, 0x4d, 0x81, 0x66, 0x56, 0x8a, 0x2e, 0xca,
-	0x74, 0xbd, 0x86, 0xe5, 0x19, 0xb7, 0x2e, 0x1c, 0xb2, 0x57, 0x04, 0xcb, 0xc7, 0x34, 0xfe, 0x3a,
-	0x90, 0x94, 0x9b, 0x69, 0x46, 0x32, 0x12, 0x4d, 0x5b, 0x2f, 0x8c, 0xde, 0x21, 0x9a, 0x7b, 0x0b,
-	0xaa, 0x42, 0xec, 0x37, 0x43, 0xf3, 0x0e, 0x06, 0xad, 0x33, 0xa8, 0x2a, 0x09, 0x23, 0x33, 0xf6,
-	0xa6, 0x99, 0x9c, 0x19, 0xcc, 0x28, 0x26, 0x86, 0x66, 0xb0, 0x77, 0x22, 0x11, 0x54, 0x9d, 0x40,
-	0x18, 0x6a, 0x62, 0x5a, 0x67, 0x06, 0x37, 0x4a, 0x32, 0x42, 0x5b, 0xcf, 0x8d, 0xd0, 0x32, 0x9a,
-	0x46, 0x07, 0x88, 0xd3, 0x2a, 0x33, 0x6c, 0xdd, 0x40, 0x66, 0x67, 0xeb, 0xd9, 0x03, 0xdb, 0x89,
-	0x66, 0x5f, 0x48, 0x94, 0xcc, 0xc0, 0xfe, 0x60, 0x2a, 0xe5, 0x08, 0x9b, 0xa0, 0xc1, 0xd4, 0xbb,
-	0x6c, 0x37, 0x46, 0x9e, 0xe5, 0x97, 0xed, 0xc6, 0x64, 0xe4, 0xf4, 0xa9, 0x13, 0xe8, 0x1e, 0x34,
-	0xd2, 0xa9, 0x8a, 0x19, 0x9b, 0xeb, 0x8c, 0xcc, 0xc9, 0xd6, 0xf3, 0x23, 0xb6, 0x16, 0xed, 0xe1,
-	0xb1, 0x41, 0x98, 0xbe, 0x6a, 0x05, 0x3b, 0x34, 0x03, 0x6e, 0x94, 0x55, 0x8b, 0xc9, 0x76, 0xa3,
-	0xac, 0x3a, 0x91, 0x5a, 0xc7, 0x8d, 0x17, 0xcd, 0xfa, 0xc8, 0x32, 0x5e, 0x62, 0x52, 0x57, 0x86,
-	0x9d, 0x49, 0x66, 0x3e, 0x31, 0xbf, 0x37, 0x99, 0x4d, 0x82, 0xce, 0x8c, 0x94, 0x72, 0x32, 0xcc,
-	0xef, 0x95, 0xa7, 0xa7, 0xb0, 0x3d, 0x63, 0x2a, 0x59, 0x26, 0x63, 0x0f, 0x27, 0x4f, 0xd6, 0xc9,
-	0xd8, 0x33, 0x66, 0xe4, 0xdf, 0x50, 0xc1, 0x6a, 0xa4, 0x33, 0x0f, 0x86, 0x1f, 0xc2, 0xa4, 0x43,
-	0xce, 0x07, 0x9f, 0x93, 0x34, 0xd2, 0x21, 0xfd, 0x8c, 0x09, 0x32, 0x22, 0xff, 0x23, 0x4c, 0x90,
-	0x8e, 0x86, 0x67, 0x4c, 0x90, 0x11, 0x34, 0x1f, 0xc1, 0x77, 0x4d, 0x44, 0xa1, 0x33, 0x4c, 0xa1,
-	0x2c, 0x52, 0x9d, 0x61, 0x0a, 0xa5, 0x01, 0x74, 0xe6, 0xd1, 0xc7, 0xc1, 0xe4, 0x0c, 0x2d, 0x37,
-	0x10, 0x6d, 0x3e, 0x08, 0xfc, 0xdb, 0x50, 0x0e, 0xa3, 0xc1, 0xe8, 0xe9, 0x4c, 0x17, 0xf1, 0x10,
-	0x03, 0x7e, 0x08, 0xd3, 0xa9, 0xa3, 0xc3, 0x0c, 0x16, 0x95, 0x47, 0x83, 0x0f, 0xa6, 0x27, 0xc4,
-	0x71, 0xc3, 0x0c, 0x24, 0x0c, 0xc4, 0x63, 0x33, 0x54, 0xfd, 0x60, 0x00, 0x52, 0x9c, 0x80, 0x00,
-	0x36, 0x74, 0x02, 0x21, 0x64, 0x38, 0x74, 0x02, 0x31, 0x58, 0xc6, 0x38, 0x32, 0x7d, 0x32, 0x9a,
-	0xc1, 0x91, 0x19, 0xa7, 0xf6, 0x07, 0xa1, 0x68, 0x0b, 0xaa, 0x42, 0xe8, 0x01, 0x0d, 0x03, 0x4d,
-	0x8c, 0x99, 0x64, 0xb8, 0x0a, 0x92, 0x28, 0x86, 0x3a, 0xb1, 0xda, 0x87, 0xda, 0x86, 0xe7, 0xde,
-	0x0f, 0x1f, 0xb5, 0xfd, 0x82, 0x0c, 0xfd, 0xc5, 0x36, 0x4c, 0xb1, 0x06, 0x3a, 0xbe, 0x1f, 0xe8,
-	0xee, 0xd6, 0x47, 0xe8, 0xf8, 0x0a, 0xfb, 0x57, 0x31, 0x2b, 0xe1, 0xbf, 0x8a, 0x59, 0xb9, 0x6a,
-	0xd9, 0xf8, 0x36, 0xcf, 0x72, 0xfd, 0xf7, 0xd2, 0x90, 0x9b, 0x99, 0xd1, 0x59, 0xb9, 0xc6, 0xff,
-	0x5b, 0xcd, 0xdb, 0xf7, 0x83, 0xdb, 0x5b, 0x1f, 0x5d, 0x7e, 0xff, 0xb3, 0x37, 0x4a, 0x50, 0x58,
-	0x5d, 0x79, 0x71, 0xe5, 0x05, 0x98, 0xb2, 0xa2, 0xe6, 0x1d, 0xaf, 0xd7, 0xbe, 0x5c, 0x65, 0x9d,
-	0x36, 0xc8, 0x38, 0x1b, 0xca, 0x2f, 0x2d, 0x77, 0xac, 0x60, 0xa7, 0xbf, 0x45, 0x48, 0x70, 0x8e,
-	0x35, 0x7b, 0xde, 0x72, 0xf9, 0xaf, 0x73, 0x46, 0xcf, 0xe2, 0x3f, 0x7b, 0x5b, 0x7f, 0xa4, 0x28,
-	0x5b, 0x45, 0x3a, 0xfb, 0xf9, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xa8, 0x67, 0x1f, 0x1c,
-	0x67, 0x00, 0x00,
+	// 5964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7d, 0x4b, 0x8c, 0xdc, 0x46,
+	0x7a, 0xb0, 0xd8, 0xef, 0xfe, 0xba, 0x7b, 0xa6, 0x87, 0xf3, 0x6a, 0x53, 0x92, 0x3d, 0xa2, 0x2d,
+	0x6b, 0x34, 0xb2, 0x25, 0x7b, 0xb4, 0x92, 0xd7, 0xb2, 0xd7, 0xb6, 0xa4, 0xb1, 0xa4, 0x81, 0xf5,
+	0x18, 0x73, 0x64, 0x2f, 0xfc, 0xef, 0x6f, 0x34, 0x38, 0xcd, 0x9a, 0x19, 0x5a, 0x6c, 0xb2, 0x4d,
+	0xb2, 0x35, 0x1a, 0xe7, 0x62, 0x60, 0x63, 0x63, 0x83, 0x3c, 0x8c, 0x6c, 0x36, 0x59, 0xe4, 0xb0,
+	0x49, 0x10, 0x2c, 0x12, 0x04, 0x79, 0x20, 0x4e, 0x0e, 0x01, 0x76, 0x0f, 0x7b, 0xc8, 0xcd, 0x48,
+	0x90, 0xec, 0x61, 0xf3, 0x40, 0x82, 0x9c, 0x16, 0x09, 0x72, 0xc8, 0x22, 0x87, 0x00, 0x39, 0x24,
+	0x41, 0x82, 0x7a, 0x90, 0x2c, 0xb2, 0x8b, 0xdd, 0x6c, 0xb5, 0x65, 0x8d, 0x76, 0x4e, 0xcd, 0xaf,
+	0xbe, 0xaa, 0xfa, 0xea, 0x7b, 0xd6, 0xe3, 0xab, 0x1a, 0xa8, 0x77, 0x4d, 0xeb, 0x6e, 0xdf, 0x3b,
+	0xdd, 0x73, 0x1d, 0xdf, 0x91, 0x67, 0xf9, 0xaf, 0xd3, 0xf4, 0x43, 0xa9, 0x77, 0x9c, 0x6e, 0xd7,
+	0xb1, 0x29, 0x50, 0xa9, 0x7b, 0x9d, 0x5d, 0xd4, 0xd5, 0xd9, 0xd7, 0xd2, 0x8e, 0xe3, 0xec, 0x58,
+	0xe8, 0x0c, 0xf9, 0xda, 0xea, 0x6f, 0x9f, 0x31, 0x90, 0xd7, 0x71, 0xcd, 0x9e, 0xef, 0xb8, 0x14,
+	0x43, 0xfd, 0x0d, 0x09, 0xe4, 0xcb, 0x2e, 0xd2, 0x7d, 0x74, 0xd1, 0x32, 0x75, 0x4f, 0x43, 0xef,
+	0xf7, 0x91, 0xe7, 0xcb, 0xcf, 0x41, 0x61, 0x4b, 0xf7, 0x50, 0x4b, 0x5a, 0x92, 0x96, 0x6b, 0xab,
+	0x47, 0x4e, 0xc7, 0x3a, 0x66, 0x1d, 0xde, 0xf0, 0x76, 0x2e, 0xe9, 0x1e, 0xd2, 0x08, 0xa6, 0xbc,
+	0x08, 0x65, 0x63, 0xab, 0x6d, 0xeb, 0x5d, 0xd4, 0xca, 0x2d, 0x49, 0xcb, 0x55, 0xad, 0x64, 0x6c,
+	0xdd, 0xd4, 0xbb, 0x48, 0x3e, 0x01, 0xd3, 0x1d, 0xc7, 0xb2, 0x50, 0xc7, 0x37, 0x1d, 0x9b, 0x22,
+	0xe4, 0x09, 0xc2, 0x54, 0x04, 0x26, 0x88, 0x73, 0x50, 0xd4, 0x31, 0x0d, 0xad, 0x02, 0x29, 0xa6,
+	0x1f, 0xaa, 0x07, 0xcd, 0x35, 0xd7, 0xe9, 0x3d, 0x28, 0xea, 0xc2, 0x4e, 0xf3, 0x7c, 0xa7, 0xdf,
+	0x91, 0x60, 0xe6, 0xa2, 0xe5, 0x23, 0xf7, 0x80, 0x32, 0xe5, 0x0f, 0x72, 0xb0, 0x48, 0xa5, 0x76,
+	0x39, 0x44, 0x7f, 0x98, 0x54, 0x2e, 0x40, 0x89, 0xea, 0x1d, 0x21, 0xb3, 0xae, 0xb1, 0x2f, 0xf9,
+	0x28, 0x80, 0xb7, 0xab, 0xbb, 0x86, 0xd7, 0xb6, 0xfb, 0xdd, 0x56, 0x71, 0x49, 0x5a, 0x2e, 0x6a,
+	0x55, 0x0a, 0xb9, 0xd9, 0xef, 0xca, 0x1a, 0xcc, 0x74, 0x1c, 0xdb, 0x33, 0x3d, 0x1f, 0xd9, 0x9d,
+	0xfd, 0xb6, 0x85, 0xee, 0x22, 0xab, 0x55, 0x5a, 0x92, 0x96, 0xa7, 0x56, 0x8f, 0x0b, 0xe9, 0xbe,
+	0x1c, 0x61, 0x5f, 0xc7, 0xc8, 0x5a, 0xb3, 0x93, 0x80, 0x5c, 0x90, 0x3f, 0x7b, 0x65, 0xba, 0x22,
+	0x35, 0xa5, 0xd6, 0xff, 0x06, 0x7f, 0x92, 0xfa, 0x9b, 0x12, 0xcc, 0x63, 0x25, 0x3a, 0x10, 0xcc,
+	0x0a, 0x28, 0xcc, 0xf1, 0x14, 0xfe, 0x9e, 0x04, 0x73, 0xd7, 0x74, 0xef, 0x60, 0x48, 0xf3, 0x28,
+	0x80, 0x6f, 0x76, 0x51, 0xdb, 0xf3, 0xf5, 0x6e, 0x8f, 0x48, 0xb4, 0xa0, 0x55, 0x31, 0x64, 0x13,
+	0x03, 0xd4, 0x77, 0xa0, 0x7e, 0xc9, 0x71, 0x2c, 0x0d, 0x79, 0x3d, 0xc7, 0xf6, 0x90, 0x7c, 0x16,
+	0x4a, 0x9e, 0xaf, 0xfb, 0x7d, 0x8f, 0x11, 0x79, 0x58, 0x48, 0xe4, 0x26, 0x41, 0xd1, 0x18, 0x2a,
+	0xd6, 0xeb, 0xbb, 0xba, 0xd5, 0xa7, 0x34, 0x56, 0x34, 0xfa, 0xa1, 0x7e, 0x0d, 0xa6, 0x36, 0x7d,
+	0xd7, 0xb4, 0x77, 0x3e, 0xc7, 0xc6, 0xab, 0x41, 0xe3, 0xff, 0x2c, 0xc1, 0x63, 0x6b, 0xc4, 0xff,
+	0x6d, 0x1d, 0x10, 0xb3, 0x51, 0xa1, 0x1e, 0x41, 0xd6, 0xd7, 0x08, 0xab, 0xf3, 0x5a, 0x0c, 0x96,
+	0x10, 0x46, 0x31, 0x21, 0x8c, 0x40, 0x99, 0xf2, 0xbc, 0x32, 0x7d, 0x58, 0x04, 0x45, 0x34, 0xd0,
+	0x49, 0x58, 0xfa, 0x95, 0xd0, 0xc2, 0x73, 0xa4, 0x52, 0xc2, 0x3e, 0x69, 0xd9, 0xe9, 0xa8, 0xb7,
+	0x4d, 0x02, 0x08, 0x1d, 0x41, 0x72, 0xa4, 0x79, 0xc1, 0x48, 0x57, 0x61, 0xfe, 0xae, 0xe9, 0xfa,
+	0x7d, 0xdd, 0x6a, 0x77, 0x76, 0x75, 0xdb, 0x46, 0x16, 0xe1, 0x1d, 0x76, 0x7d, 0xf9, 0xe5, 0xaa,
+	0x36, 0xcb, 0x0a, 0x2f, 0xd3, 0x32, 0xcc, 0x40, 0x4f, 0xfe, 0x12, 0x2c, 0xf4, 0x76, 0xf7, 0x3d,
+	0xb3, 0x33, 0x50, 0xa9, 0x48, 0x2a, 0xcd, 0x05, 0xa5, 0xb1, 0x5a, 0xa7, 0x60, 0xa6, 0x43, 0xbc,
+	0xa7, 0xd1, 0xc6, 0x9c, 0xa4, 0xac, 0x2d, 0x11, 0xd6, 0x36, 0x59, 0xc1, 0xed, 0x00, 0x8e, 0xc9,
+	0x0a, 0x90, 0xfb, 0x7e, 0x87, 0xab, 0x50, 0x26, 0x15, 0x66, 0x59, 0xe1, 0x5b, 0x7e, 0x27, 0xaa,
+	0x13, 0xf7, 0x7b, 0x95, 0xa4, 0xdf, 0x6b, 0x41, 0x99, 0xf8, 0x71, 0xe4, 0xb5, 0xaa, 0x84, 0xcc,
+	0xe0, 0x53, 0x5e, 0x87, 0x69, 0xcf, 0xd7, 0x5d, 0xbf, 0xdd, 0x73, 0x3c, 0x13, 0xf3, 0xc5, 0x6b,
+	0xc1, 0x52, 0x7e, 0xb9, 0xb6, 0xba, 0x24, 0x14, 0xd2, 0x1b, 0x68, 0x7f, 0x4d, 0xf7, 0xf5, 0x0d,
+	0xdd, 0x74, 0xb5, 0x29, 0x52, 0x71, 0x23, 0xa8, 0x27, 0x76, 0xae, 0xb5, 0x89, 0x9c, 0xab, 0x48,
+	0xb3, 0xeb, 0x22, 0xcd, 0x56, 0xbf, 0x2f, 0xc1, 0xfc, 0x75, 0x47, 0x37, 0x0e, 0x86, 0x9d, 0x1d,
+	0x87, 0x29, 0x17, 0xf5, 0x2c, 0xb3, 0xa3, 0x63, 0x79, 0x6c, 0x21, 0x97, 0x58, 0x5a, 0x51, 0x6b,
+	0x30, 0xe8, 0x4d, 0x02, 0xbc, 0x50, 0xfe, 0xec, 0x95, 0x42, 0xb3, 0xd8, 0xca, 0xab, 0xdf, 0x96,
+	0xa0, 0xa5, 0x21, 0x0b, 0xe9, 0xde, 0xc1, 0x70, 0x14, 0x94, 0xb2, 0x52, 0x2b, 0xaf, 0xfe, 0x9b,
+	0x04, 0x73, 0x57, 0x91, 0x8f, 0x8d, 0xd3, 0xf4, 0x7c, 0xb3, 0xf3, 0x50, 0xe7, 0x26, 0x27, 0x60,
+	0xba, 0xa7, 0xbb, 0xbe, 0x19, 0xe2, 0x05, 0xa6, 0x3a, 0x15, 0x82, 0xa9, 0xbd, 0x9d, 0x81, 0xd9,
+	0x9d, 0xbe, 0xee, 0xea, 0xb6, 0x8f, 0x10, 0x67, 0x40, 0xd4, 0x99, 0xc9, 0x61, 0x51, 0x68, 0x3f,
+	0x74, 0xbc, 0xd0, 0xca, 0xab, 0x1f, 0x49, 0x30, 0x9f, 0x18, 0xef, 0x24, 0x5e, 0xec, 0x05, 0x28,
+	0xe2, 0x5f, 0x5e, 0x2b, 0x47, 0x8c, 0xea, 0x58, 0x9a, 0x51, 0xbd, 0x8d, 0x03, 0x06, 0xb1, 0x2a,
+	0x8a, 0x8f, 0x27, 0x84, 0x8f, 0x5f, 0x45, 0x3e, 0xe7, 0xdf, 0x0e, 0x82, 0x04, 0x22, 0x3e, 0x7d,
+	0x22, 0xc1, 0x13, 0xa9, 0xf4, 0x3d, 0x14, 0x8e, 0xfd, 0x87, 0x04, 0x0b, 0x9b, 0xbb, 0xce, 0x5e,
+	0x44, 0xd2, 0x83, 0xe0, 0x54, 0x3c, 0x3a, 0xe6, 0x13, 0xd1, 0x51, 0x7e, 0x1e, 0x0a, 0xfe, 0x7e,
+	0x0f, 0x11, 0x73, 0x9f, 0x5a, 0x3d, 0x7a, 0x5a, 0xb0, 0x7e, 0x3a, 0x8d, 0x89, 0xbc, 0xbd, 0xdf,
+	0x43, 0x1a, 0x41, 0x95, 0x4f, 0x42, 0x33, 0xc1, 0xfb, 0x20, 0x96, 0x4c, 0xc7, 0x99, 0xef, 0x05,
+	0xb1, 0xb7, 0xc0, 0xc7, 0xde, 0x7f, 0xcf, 0xc1, 0xe2, 0xc0, 0xb0, 0x27, 0x11, 0x80, 0x88, 0x9e,
+	0x9c, 0x90, 0x1e, 0xec, 0xe6, 0x38, 0x54, 0xd3, 0xc0, 0x8b, 0x9a, 0xfc, 0x72, 0x5e, 0x6b, 0x44,
+	0xd0, 0x75, 0xc3, 0x93, 0x9f, 0x05, 0x79, 0x20, 0xfa, 0x51, 0xcb, 0x2d, 0x68, 0x33, 0xc9, 0xf0,
+	0x47, 0x42, 0xac, 0x30, 0xfe, 0x51, 0xb6, 0x14, 0xb4, 0x39, 0x41, 0x00, 0xf4, 0xe4, 0xe7, 0x61,
+	0xce, 0xb4, 0x6f, 0xa0, 0xae, 0xe3, 0xee, 0xb7, 0x7b, 0xc8, 0xed, 0x20, 0xdb, 0xd7, 0x77, 0x90,
+	0xd7, 0x2a, 0x11, 0x8a, 0x66, 0x83, 0xb2, 0x8d, 0xa8, 0x48, 0x3e, 0x0f, 0x8b, 0xef, 0xf7, 0x91,
+	0xbb, 0xdf, 0xf6, 0x90, 0x7b, 0xd7, 0xec, 0xa0, 0xb6, 0x7e, 0x57, 0x37, 0x2d, 0x7d, 0xcb, 0x42,
+	0xad, 0xf2, 0x52, 0x7e, 0xb9, 0xa2, 0xcd, 0x93, 0xe2, 0x4d, 0x5a, 0x7a, 0x31, 0x28, 0x54, 0xff,
+	0x54, 0x82, 0x05, 0xba, 0x18, 0xda, 0x08, 0xdc, 0xce, 0x43, 0x0e, 0x36, 0x71, 0xaf, 0xc8, 0x96,
+	0x6e, 0x8d, 0x98, 0x53, 0x54, 0x3f, 0x95, 0x60, 0x0e, 0xaf, 0x49, 0x1e, 0x25, 0x9a, 0xff, 0x58,
+	0x82, 0xd9, 0x6b, 0xba, 0xf7, 0x28, 0x91, 0xfc, 0x0f, 0x6c, 0x22, 0x12, 0xd2, 0xfc, 0x68, 0x44,
+	0xcc, 0xc1, 0x19, 0x4b, 0x51, 0x30, 0x63, 0x51, 0xff, 0x2c, 0x9a, 0xa8, 0x3c, 0x5a, 0x03, 0x54,
+	0xbf, 0x27, 0xc1, 0xd1, 0xab, 0xc8, 0x0f, 0xa9, 0x3e, 0x18, 0x33, 0x9a, 0x8c, 0x4a, 0xf5, 0x4b,
+	0x74, 0x36, 0x20, 0x24, 0xfe, 0xa1, 0x04, 0xdb, 0x9f, 0xcf, 0xc1, 0x3c, 0x8e, 0x3a, 0x07, 0x43,
+	0x09, 0xb2, 0x2c, 0x6b, 0x05, 0x8a, 0x52, 0x14, 0x5a, 0x42, 0x10, 0xc2, 0x4b, 0x99, 0x43, 0xb8,
+	0xfa, 0x27, 0x39, 0x58, 0x48, 0x72, 0x63, 0x12, 0xb1, 0x08, 0x68, 0xcd, 0x09, 0x69, 0x55, 0xa1,
+	0x1e, 0x42, 0xd6, 0xd7, 0x82, 0xf0, 0x1b, 0x83, 0x1d, 0xd4, 0xe8, 0xab, 0xfe, 0x82, 0x04, 0x0b,
+	0xc1, 0xa6, 0xc1, 0x26, 0xda, 0xe9, 0x22, 0xdb, 0xbf, 0x7f, 0x1d, 0x4a, 0x6a, 0x40, 0x4e, 0xa0,
+	0x01, 0x47, 0xa0, 0xea, 0xd1, 0x7e, 0xc2, 0xfd, 0x80, 0x08, 0xa0, 0xfe, 0x40, 0x82, 0xc5, 0x01,
+	0x72, 0x26, 0x11, 0x62, 0x0b, 0xca, 0xa6, 0x6d, 0xa0, 0x7b, 0x21, 0x35, 0xc1, 0x27, 0x2e, 0xd9,
+	0xea, 0x9b, 0x96, 0x11, 0x92, 0x11, 0x7c, 0xca, 0xc7, 0xa0, 0x8e, 0x6c, 0x3c, 0xc7, 0x68, 0x13,
+	0x5c, 0xa2, 0xc8, 0x15, 0xad, 0x46, 0x61, 0xeb, 0x18, 0x84, 0x2b, 0x6f, 0x9b, 0x88, 0x54, 0x2e,
+	0xd2, 0xca, 0xec, 0x53, 0xfd, 0x45, 0x09, 0x66, 0xb1, 0x16, 0x32, 0xea, 0xbd, 0x07, 0xcb, 0xcd,
+	0x25, 0xa8, 0x71, 0x6a, 0xc6, 0x06, 0xc2, 0x83, 0xd4, 0x3b, 0x30, 0x17, 0x27, 0x67, 0x12, 0x6e,
+	0x3e, 0x0e, 0x10, 0xca, 0x8a, 0x5a, 0x43, 0x5e, 0xe3, 0x20, 0xea, 0xaf, 0xe6, 0x82, 0x63, 0x05,
+	0xc2, 0xa6, 0x87, 0xbc, 0x9b, 0x49, 0x44, 0xc2, 0xfb, 0xf3, 0x2a, 0x81, 0x90, 0xe2, 0x35, 0xa8,
+	0xa3, 0x7b, 0xbe, 0xab, 0xb7, 0x7b, 0xba, 0xab, 0x77, 0xa9, 0x59, 0x65, 0x72, 0xbd, 0x35, 0x52,
+	0x6d, 0x83, 0xd4, 0xc2, 0x9d, 0x10, 0x15, 0xa1, 0x9d, 0x94, 0x68, 0x27, 0x04, 0x12, 0xad, 0xd3,
+	0x6a, 0xad, 0xbc, 0xfa, 0x43, 0x3c, 0xeb, 0x63, 0x6a, 0x7d, 0xd0, 0x39, 0x13, 0x1f, 0x53, 0x51,
+	0x38, 0xa6, 0x7a, 0x2b, 0xaf, 0xfe, 0x28, 0x07, 0x4d, 0x32, 0x96, 0x35, 0x76, 0xb8, 0x64, 0x3a,
+	0x76, 0xa2, 0xb2, 0x94, 0xa8, 0x3c, 0xc4, 0x1a, 0x5f, 0x84, 0x12, 0x93, 0x44, 0x3e, 0xab, 0x24,
+	0x58, 0x85, 0x51, 0xe3, 0x39, 0x06, 0x75, 0xd2, 0x09, 0x32, 0xda, 0xae, 0xb3, 0xe7, 0x31, 0x7b,
+	0xad, 0x31, 0x98, 0xe6, 0xec, 0x91, 0x16, 0x7c, 0xc7, 0xd7, 0x2d, 0x8a, 0x50, 0xa2, 0x4e, 0x89,
+	0x40, 0x48, 0xf1, 0x39, 0x1a, 0x9f, 0x11, 0xd9, 0xfa, 0x9b, 0x5a, 0x7d, 0x42, 0x48, 0x1a, 0x61,
+	0x05, 0x36, 0x17, 0x44, 0xa3, 0x33, 0x92, 0xcf, 0xc1, 0x22, 0xe5, 0x05, 0xf9, 0x6c, 0x6f, 0xeb,
+	0xa6, 0xd5, 0x76, 0x91, 0xee, 0x39, 0x36, 0xd9, 0x1a, 0xac, 0x6a, 0x73, 0x66, 0x58, 0xe7, 0x8a,
+	0x6e, 0x5a, 0x1a, 0x29, 0x53, 0x7f, 0x1b, 0x9f, 0x5a, 0xc4, 0x75, 0x65, 0x12, 0x93, 0xbd, 0x0d,
+	0x32, 0xa5, 0xc2, 0x88, 0xc4, 0x14, 0xcc, 0x34, 0x8e, 0x0b, 0xc3, 0x6a, 0x52, 0xa8, 0xda, 0x8c,
+	0x99, 0x80, 0x78, 0xea, 0xdf, 0x4b, 0x70, 0xe4, 0x2a, 0xf2, 0x09, 0xea, 0x25, 0xec, 0x36, 0x37,
+	0x5c, 0x67, 0xc7, 0x45, 0x9e, 0xf7, 0x53, 0xa0, 0xd8, 0xbf, 0x46, 0xe7, 0xa8, 0xa2, 0xb1, 0x4d,
+	0x22, 0x88, 0xa4, 0x1e, 0xe6, 0x46, 0xe9, 0x61, 0x3e, 0xa1, 0x87, 0xc4, 0x8b, 0x04, 0x84, 0x51,
+	0x4d, 0x7b, 0xf4, 0x99, 0xfd, 0x5d, 0xba, 0xd3, 0xc7, 0x8f, 0x69, 0x12, 0x26, 0x87, 0xa6, 0x9a,
+	0x1b, 0xcb, 0x54, 0x9f, 0x80, 0x1a, 0x6f, 0x9e, 0x74, 0xc4, 0xb0, 0x1d, 0x19, 0xe5, 0x5f, 0x4a,
+	0xf4, 0x3c, 0xfa, 0xa7, 0xc1, 0x79, 0x37, 0x5a, 0x79, 0x7c, 0x92, 0xdc, 0x58, 0xb7, 0x3d, 0xe4,
+	0xfa, 0x07, 0x7f, 0xdd, 0x25, 0xbf, 0x0a, 0x35, 0x32, 0x42, 0xaf, 0x6d, 0xe8, 0xbe, 0xce, 0x42,
+	0xf5, 0xe3, 0xc2, 0x93, 0xa8, 0x2b, 0x18, 0x0f, 0x9f, 0x8d, 0x68, 0x94, 0x4d, 0x1e, 0xfe, 0x2d,
+	0x1f, 0x86, 0xea, 0xae, 0xee, 0xed, 0xb6, 0xef, 0xa0, 0x7d, 0x3a, 0x19, 0x6e, 0x68, 0x15, 0x0c,
+	0x78, 0x03, 0xed, 0x7b, 0xf2, 0x63, 0x50, 0xb1, 0xfb, 0x5d, 0x6a, 0x72, 0xd8, 0xc1, 0x37, 0xb4,
+	0xb2, 0xdd, 0xef, 0x62, 0x83, 0xa3, 0xec, 0xaa, 0xb4, 0xf2, 0xea, 0x5f, 0xe4, 0x60, 0xea, 0x46,
+	0xdf, 0xd7, 0xe9, 0xee, 0x87, 0xd7, 0xb7, 0xfc, 0xfb, 0x53, 0xcf, 0x15, 0xc8, 0xd3, 0x89, 0x13,
+	0xae, 0xd1, 0x12, 0x8e, 0x60, 0x7d, 0xcd, 0xd3, 0x30, 0x12, 0x16, 0xa5, 0xd7, 0xef, 0x74, 0xd8,
+	0x1c, 0x34, 0x4f, 0xa8, 0xae, 0x62, 0x08, 0x9d, 0x81, 0x1e, 0x86, 0x2a, 0x72, 0xdd, 0x70, 0x86,
+	0x4a, 0xc6, 0x84, 0x5c, 0x97, 0x16, 0xaa, 0x50, 0xd7, 0x3b, 0x77, 0x6c, 0x67, 0xcf, 0x42, 0xc6,
+	0x0e, 0x32, 0x88, 0x22, 0x54, 0xb4, 0x18, 0x8c, 0xaa, 0x0a, 0xd6, 0x80, 0x76, 0xc7, 0xf6, 0x83,
+	0xa0, 0x47, 0x21, 0x97, 0x6d, 0x1f, 0x17, 0x1b, 0xc8, 0x42, 0x3e, 0x22, 0xc5, 0x65, 0x5a, 0x4c,
+	0x21, 0xac, 0xb8, 0xdf, 0x0b, 0x6b, 0x57, 0x68, 0x31, 0x85, 0xe0, 0xe2, 0x23, 0x50, 0x8d, 0x36,
+	0xfc, 0xab, 0xd1, 0xfe, 0x2c, 0x01, 0xa8, 0x3f, 0x96, 0xa0, 0xb1, 0x46, 0x9a, 0x7a, 0x04, 0xb4,
+	0x4f, 0x86, 0x02, 0xba, 0xd7, 0x73, 0x99, 0x31, 0x91, 0xdf, 0x43, 0x15, 0x8a, 0x6a, 0x4d, 0xb5,
+	0x95, 0x57, 0x3f, 0x2e, 0x40, 0x63, 0x13, 0xe9, 0x6e, 0x67, 0xf7, 0x91, 0xd8, 0x7c, 0x6a, 0x42,
+	0xde, 0xf0, 0x2c, 0x36, 0x4e, 0xfc, 0x13, 0x1f, 0x98, 0xf6, 0x2c, 0xbd, 0x83, 0x76, 0x1d, 0xcb,
+	0x40, 0x6e, 0x7b, 0xc7, 0x75, 0xfa, 0xf4, 0xc0, 0xb4, 0xae, 0x35, 0xb9, 0x82, 0xab, 0x18, 0x2e,
+	0xbf, 0x00, 0x15, 0xc3, 0xb3, 0xda, 0x64, 0xd5, 0x4e, 0x27, 0x4a, 0xe2, 0xf1, 0xad, 0x79, 0x16,
+	0x59, 0xb4, 0x97, 0x0d, 0xfa, 0x43, 0x7e, 0x12, 0x1a, 0x4e, 0xdf, 0xef, 0xf5, 0xfd, 0x36, 0x35,
+	0xd9, 0x56, 0x85, 0x90, 0x57, 0xa7, 0x40, 0x62, 0xd1, 0x9e, 0x7c, 0x05, 0x1a, 0x1e, 0x61, 0x65,
+	0x30, 0x61, 0xaf, 0x66, 0x9d, 0x26, 0xd6, 0x69, 0x3d, 0x36, 0x63, 0x3f, 0x09, 0x4d, 0xdf, 0xd5,
+	0xef, 0x22, 0x8b, 0x3b, 0x90, 0x02, 0xa2, 0x9f, 0xd3, 0x14, 0x1e, 0x9d, 0xe6, 0xa6, 0x1c, 0x5f,
+	0xd5, 0xd2, 0x8e, 0xaf, 0xe4, 0x29, 0xc8, 0xd9, 0xef, 0x93, 0x93, 0xd1, 0xbc, 0x96, 0xb3, 0xdf,
+	0xa7, 0x8a, 0x30, 0xd5, 0xca, 0xab, 0x6f, 0x40, 0xe1, 0x9a, 0xe9, 0x13, 0x0e, 0x63, 0xf3, 0x97,
+	0xc8, 0xba, 0x09, 0xff, 0xc4, 0xce, 0xc7, 0x75, 0xf6, 0xa8, 0x5f, 0xc3, 0x73, 0xb2, 0xba, 0x56,
+	0x76, 0x9d, 0x3d, 0xe2, 0xb4, 0x48, 0x72, 0x8d, 0xe3, 0x22, 0x3a, 0x23, 0xce, 0x69, 0xec, 0x4b,
+	0xfd, 0x23, 0x29, 0xd2, 0x2a, 0xec, 0x89, 0xbc, 0xfb, 0x73, 0x45, 0xaf, 0x42, 0xd9, 0xa5, 0xf5,
+	0x87, 0x1e, 0xed, 0xf3, 0x3d, 0x11, 0xbf, 0x1a, 0xd4, 0xca, 0xac, 0x80, 0x78, 0x45, 0x5c, 0xbf,
+	0x62, 0xf5, 0xbd, 0x07, 0x61, 0x05, 0xa2, 0x63, 0x92, 0xbc, 0xf8, 0xd8, 0x86, 0x48, 0x63, 0x7a,
+	0x29, 0xaf, 0xfe, 0x57, 0x01, 0x1a, 0x8c, 0x9e, 0x49, 0xa6, 0x1a, 0xa9, 0x34, 0x6d, 0x42, 0x0d,
+	0xf7, 0xdd, 0xf6, 0xd0, 0x4e, 0xb0, 0x1b, 0x54, 0x5b, 0x5d, 0x15, 0x4e, 0xb5, 0x63, 0x64, 0x90,
+	0x34, 0x8a, 0x4d, 0x52, 0xe9, 0x75, 0xdb, 0x77, 0xf7, 0x35, 0xe8, 0x84, 0x00, 0xb9, 0x03, 0x33,
+	0xdb, 0x18, 0xb9, 0xcd, 0x37, 0x5d, 0x20, 0x4d, 0xbf, 0x90, 0xa1, 0x69, 0xf2, 0x95, 0x6c, 0x7f,
+	0x7a, 0x3b, 0x0e, 0x95, 0xdf, 0xa5, 0x22, 0x6d, 0x7b, 0x48, 0x67, 0xf6, 0xc1, 0x82, 0xed, 0xb9,
+	0xcc, 0xd4, 0xeb, 0xd4, 0x80, 0x68, 0x07, 0x8d, 0x0e, 0x0f, 0x53, 0xde, 0x85, 0xe9, 0x04, 0x09,
+	0xd8, 0x22, 0xee, 0xa0, 0x7d, 0xb6, 0x50, 0xc4, 0x3f, 0xe5, 0x2f, 0xf1, 0x49, 0x3c, 0x69, 0x61,
+	0xfe, 0xba, 0x63, 0xef, 0x5c, 0x74, 0x5d, 0x7d, 0x9f, 0x25, 0xf9, 0x5c, 0xc8, 0x7d, 0x59, 0x52,
+	0xb6, 0x60, 0x4e, 0x34, 0xcc, 0xcf, 0xb5, 0x8f, 0xd7, 0x40, 0x1e, 0x1c, 0xa7, 0xa0, 0x87, 0x58,
+	0x2a, 0x52, 0x9e, 0x6b, 0x41, 0xfd, 0x24, 0x0f, 0xf5, 0x37, 0xf1, 0x81, 0xd6, 0xc3, 0x8c, 0x09,
+	0x41, 0x4c, 0x2b, 0x70, 0x31, 0x6d, 0xc0, 0x0d, 0x17, 0x05, 0x6e, 0x58, 0x10, 0x4c, 0x4a, 0xc2,
+	0x60, 0x22, 0xf2, 0xb3, 0xe5, 0xb1, 0xfc, 0x6c, 0x25, 0xd5, 0xcf, 0xae, 0x41, 0x9d, 0x9e, 0x18,
+	0x8e, 0x1b, 0x0a, 0x6a, 0xa4, 0x1a, 0x8d, 0x04, 0xd4, 0x1f, 0x34, 0x5b, 0x79, 0xf5, 0x0f, 0xa5,
+	0x50, 0x22, 0x13, 0xf9, 0xd3, 0xd8, 0x24, 0x35, 0x37, 0xf6, 0x24, 0x35, 0xb3, 0x3f, 0xfd, 0x54,
+	0x82, 0xea, 0xdb, 0xa8, 0xe3, 0x3b, 0x2e, 0xb6, 0x59, 0x41, 0x35, 0x29, 0xc3, 0xca, 0x21, 0x97,
+	0x5c, 0x39, 0x9c, 0x85, 0x8a, 0x69, 0xb4, 0x75, 0xac, 0xf0, 0xad, 0xfc, 0x88, 0xf9, 0x69, 0xd9,
+	0x34, 0x88, 0x65, 0x64, 0x3f, 0xf7, 0xf9, 0xb6, 0x04, 0x75, 0x4a, 0xb3, 0x47, 0x6b, 0xbe, 0xc4,
+	0x75, 0x27, 0x89, 0xac, 0x90, 0x7d, 0x84, 0x03, 0xbd, 0x76, 0x28, 0xea, 0xf6, 0x22, 0x00, 0x66,
+	0x32, 0xab, 0x4e, 0x8d, 0x78, 0x49, 0x48, 0x2d, 0xad, 0x4e, 0x18, 0x7e, 0xed, 0x90, 0x56, 0xc5,
+	0xb5, 0x48, 0x13, 0x97, 0xca, 0x50, 0x24, 0xb5, 0xd5, 0xff, 0x96, 0x60, 0xf6, 0xb2, 0x6e, 0x75,
+	0xd6, 0x4c, 0xcf, 0xd7, 0xed, 0xce, 0x04, 0x33, 0xd2, 0x0b, 0x50, 0x76, 0x7a, 0x6d, 0x0b, 0x6d,
+	0xfb, 0x8c, 0xa4, 0x63, 0x43, 0x46, 0x44, 0xd9, 0xa0, 0x95, 0x9c, 0xde, 0x75, 0xb4, 0xed, 0xcb,
+	0x2f, 0x43, 0xc5, 0xe9, 0xb5, 0x5d, 0x73, 0x67, 0xd7, 0x6f, 0xe5, 0xb3, 0x56, 0x2e, 0x3b, 0x3d,
+	0x0d, 0xd7, 0xe0, 0x36, 0xcf, 0x0a, 0x63, 0x6e, 0x9e, 0xa9, 0x3f, 0x1c, 0x18, 0xfe, 0x04, 0x36,
+	0x70, 0x01, 0x2a, 0xa6, 0xed, 0xb7, 0x0d, 0xd3, 0x0b, 0x58, 0x70, 0x54, 0xac, 0x43, 0xb6, 0x4f,
+	0x46, 0x40, 0x64, 0x6a, 0xfb, 0xb8, 0x6f, 0xf9, 0x35, 0x80, 0x6d, 0xcb, 0xd1, 0x59, 0x6d, 0xca,
+	0x83, 0x27, 0xc4, 0xe6, 0x83, 0xd1, 0x82, 0xfa, 0x55, 0x52, 0x09, 0xb7, 0x10, 0x89, 0xf4, 0xaf,
+	0x24, 0x98, 0xdf, 0x40, 0x2e, 0xcd, 0x61, 0xf3, 0xd9, 0xce, 0xf7, 0xba, 0xbd, 0xed, 0xc4, 0x0f,
+	0x1f, 0xa4, 0xc4, 0xe1, 0xc3, 0xe7, 0xb3, 0xe1, 0x1e, 0x5b, 0x4f, 0xd2, 0x23, 0xb0, 0x60, 0x3d,
+	0x19, 0x1c, 0xf4, 0xd1, 0x85, 0xf9, 0x54, 0x8a, 0x98, 0x18, 0xbd, 0xfc, 0xfe, 0x84, 0xfa, 0x2b,
+	0x34, 0xcf, 0x47, 0x38, 0xa8, 0xfb, 0x57, 0xd8, 0x05, 0x60, 0x81, 0x23, 0x11, 0x46, 0x9e, 0x86,
+	0x84, 0xef, 0x48, 0x71, 0x44, 0xbf, 0x2e, 0xc1, 0x52, 0x3a, 0x55, 0x93, 0xcc, 0xad, 0x5e, 0x83,
+	0xa2, 0x69, 0x6f, 0x3b, 0xc1, 0x3e, 0xe5, 0x8a, 0xd0, 0x16, 0xc4, 0xfd, 0xd2, 0x8a, 0xea, 0x5f,
+	0xe7, 0xa0, 0xf9, 0x26, 0xcd, 0x1b, 0xf9, 0xc2, 0xc5, 0xdf, 0x45, 0xdd, 0xb6, 0x67, 0x7e, 0x80,
+	0x02, 0xf1, 0x77, 0x51, 0x77, 0xd3, 0xfc, 0x00, 0xc5, 0x34, 0xa3, 0x18, 0xd7, 0x8c, 0xe1, 0x07,
+	0x09, 0xfc, 0xbe, 0x79, 0x39, 0xbe, 0x6f, 0xbe, 0x00, 0x25, 0xdb, 0x31, 0xd0, 0xfa, 0x1a, 0x5b,
+	0x83, 0xb3, 0xaf, 0x48, 0xd5, 0xaa, 0xe3, 0xa9, 0x1a, 0xee, 0x8a, 0x34, 0x61, 0xd0, 0x14, 0xd4,
+	0xbc, 0x16, 0x7c, 0xe2, 0xe3, 0x6f, 0xe5, 0x2a, 0xf2, 0x93, 0x5c, 0x7d, 0x78, 0xfa, 0xf7, 0x89,
+	0x04, 0x87, 0x85, 0x04, 0x4d, 0xa2, 0x7a, 0x2f, 0xc5, 0x55, 0x4f, 0xbc, 0x45, 0x3e, 0xd0, 0x25,
+	0xd3, 0xba, 0xe7, 0xa1, 0xbe, 0xd6, 0xef, 0x76, 0xc3, 0xb9, 0xdd, 0x31, 0xa8, 0xbb, 0xf4, 0x27,
+	0x5d, 0x17, 0xd3, 0xc8, 0x5c, 0x63, 0x30, 0xbc, 0xfa, 0x55, 0x4f, 0x41, 0x83, 0x55, 0x61, 0x54,
+	0x2b, 0x50, 0x71, 0xd9, 0x6f, 0x86, 0x1f, 0x7e, 0xab, 0xf3, 0x30, 0xab, 0xa1, 0x1d, 0xac, 0xf4,
+	0xee, 0x75, 0xd3, 0xbe, 0xc3, 0xba, 0x51, 0xbf, 0x2e, 0xc1, 0x5c, 0x1c, 0xce, 0xda, 0x3a, 0x0f,
+	0x65, 0xdd, 0x30, 0x5c, 0xe4, 0x79, 0x43, 0xc5, 0x72, 0x91, 0xe2, 0x68, 0x01, 0x32, 0xc7, 0xb9,
+	0x5c, 0x66, 0xce, 0xa9, 0x6d, 0x98, 0xb9, 0x8a, 0xfc, 0x1b, 0xc8, 0x77, 0x27, 0x4a, 0xe7, 0x68,
+	0xe1, 0x85, 0x29, 0xa9, 0xcc, 0xd4, 0x22, 0xf8, 0xc4, 0x67, 0xd5, 0x32, 0xdf, 0xc3, 0x24, 0x62,
+	0xe6, 0xb9, 0x9c, 0x8b, 0x73, 0x99, 0x26, 0xd4, 0x75, 0x7b, 0x8e, 0x8d, 0x6c, 0x9f, 0x9f, 0x88,
+	0x35, 0x42, 0x28, 0x51, 0xbf, 0x1f, 0x4b, 0x20, 0xe3, 0x1c, 0xa3, 0x4b, 0xba, 0x35, 0xd9, 0xc4,
+	0x01, 0xef, 0xf4, 0xb9, 0x9d, 0x36, 0xb3, 0xe3, 0x1c, 0xf3, 0x4b, 0x6e, 0xe7, 0x26, 0x01, 0xe0,
+	0xcd, 0x69, 0xc3, 0xf3, 0x59, 0x71, 0x90, 0x5d, 0x00, 0x86, 0xe7, 0xd3, 0x72, 0x92, 0xd7, 0x8e,
+	0x57, 0x6c, 0xc8, 0x68, 0x73, 0x87, 0xb3, 0x05, 0x82, 0xd6, 0xa4, 0x05, 0x9b, 0x21, 0x5c, 0x60,
+	0x5c, 0xc5, 0xf4, 0x1c, 0xd3, 0x99, 0x56, 0x51, 0xfd, 0x1b, 0x09, 0x16, 0x6f, 0xe8, 0x36, 0x4e,
+	0xc1, 0x77, 0xba, 0x3d, 0x3d, 0x96, 0x14, 0x9d, 0x74, 0x99, 0x92, 0xc0, 0x65, 0x3e, 0x4e, 0x73,
+	0x35, 0xe9, 0xac, 0x9f, 0x8c, 0xae, 0xa0, 0x71, 0x90, 0x4c, 0xd9, 0x13, 0xf1, 0x73, 0xe7, 0x42,
+	0xf2, 0xdc, 0x59, 0x5e, 0x81, 0xa6, 0xaf, 0xbb, 0x3b, 0x28, 0x88, 0x04, 0x37, 0xd9, 0xb5, 0xa3,
+	0xbc, 0x36, 0x00, 0xa7, 0x03, 0x2b, 0xb7, 0x24, 0xd5, 0x83, 0xd6, 0xe0, 0xb8, 0x26, 0xd1, 0x29,
+	0xc2, 0x8d, 0xa0, 0x29, 0x3e, 0x80, 0x44, 0x30, 0xf5, 0x55, 0x78, 0x8c, 0x24, 0xec, 0x06, 0xa0,
+	0xd8, 0x39, 0x4e, 0xb2, 0x01, 0x49, 0xd0, 0xc0, 0xef, 0xe7, 0x40, 0x11, 0xb5, 0x30, 0x09, 0xe1,
+	0x17, 0xe2, 0xa7, 0x26, 0x4f, 0xa5, 0xdc, 0x13, 0x88, 0xf7, 0x48, 0xab, 0xc8, 0xcb, 0x30, 0x8d,
+	0xee, 0xa1, 0x4e, 0xdf, 0x37, 0xed, 0x9d, 0x0d, 0x4b, 0xb7, 0x6f, 0x3a, 0x2c, 0x2a, 0x26, 0xc1,
+	0xf2, 0x53, 0xd0, 0xc0, 0x62, 0x77, 0xfa, 0x3e, 0xc3, 0xa3, 0xe1, 0x31, 0x0e, 0xc4, 0xed, 0xe1,
+	0xf1, 0x5a, 0xc8, 0x47, 0x06, 0xc3, 0xa3, 0x92, 0x4c, 0x82, 0x31, 0xb7, 0xf0, 0x09, 0x4d, 0x88,
+	0x46, 0xb7, 0xb0, 0x63, 0xb0, 0x01, 0x76, 0x63, 0xb0, 0x37, 0x0e, 0xbb, 0xff, 0x56, 0x02, 0x45,
+	0xd4, 0xc2, 0xc3, 0x62, 0xf7, 0x35, 0x80, 0x2e, 0x72, 0x77, 0xd0, 0x3a, 0x89, 0x51, 0x74, 0x6f,
+	0x69, 0x59, 0x18, 0xa3, 0xa2, 0x06, 0x6e, 0x04, 0x15, 0x34, 0xae, 0xae, 0x7a, 0x15, 0x66, 0x05,
+	0x28, 0xd8, 0xfd, 0x7a, 0x4e, 0xdf, 0xed, 0xa0, 0x60, 0x9f, 0x32, 0xf8, 0xc4, 0xe1, 0x9a, 0x1a,
+	0x13, 0x53, 0x6c, 0xf6, 0xa5, 0x9e, 0x27, 0xa7, 0x92, 0x64, 0xeb, 0x25, 0xa6, 0xcd, 0x71, 0xa3,
+	0x95, 0x06, 0x92, 0x45, 0xb6, 0x61, 0x3e, 0x51, 0x6f, 0xc2, 0x44, 0x1f, 0xb2, 0x9d, 0x85, 0x0c,
+	0x76, 0xb7, 0x2c, 0xf8, 0x54, 0xff, 0x47, 0x82, 0xc6, 0x7a, 0xb7, 0xe7, 0x44, 0x67, 0x5d, 0x99,
+	0xd7, 0xcc, 0x83, 0x47, 0x04, 0x39, 0xd1, 0x11, 0xc1, 0x93, 0xd0, 0x88, 0xdf, 0x42, 0xa2, 0x5b,
+	0x90, 0xf5, 0x0e, 0x7f, 0xfb, 0xe8, 0x30, 0x54, 0xf1, 0x56, 0x2f, 0xf6, 0xf8, 0x06, 0x4b, 0x29,
+	0xc2, 0x7b, 0xbf, 0x38, 0x0e, 0x18, 0x78, 0xbf, 0x68, 0xdb, 0xb4, 0xc2, 0x6c, 0x38, 0xfa, 0x21,
+	0xbf, 0x84, 0x57, 0x94, 0xf4, 0xc0, 0xbe, 0x94, 0x75, 0x61, 0x17, 0xd4, 0xa0, 0x7e, 0x4e, 0x6e,
+	0x49, 0xf8, 0x76, 0x5d, 0x30, 0xfc, 0x09, 0x6f, 0xd7, 0xf9, 0xba, 0x77, 0x27, 0x48, 0xfb, 0xa1,
+	0x1f, 0xea, 0x29, 0x7a, 0x7c, 0x4b, 0xda, 0x8f, 0x49, 0x5f, 0x86, 0x02, 0xc6, 0x60, 0x46, 0x45,
+	0x7e, 0xab, 0xff, 0x92, 0x83, 0x85, 0x24, 0xf6, 0x24, 0x24, 0x9d, 0x8f, 0x1b, 0x92, 0xf8, 0xb2,
+	0x14, 0xdf, 0x1b, 0x33, 0x22, 0x26, 0x8a, 0x8e, 0xd3, 0xb7, 0x7d, 0xe6, 0xad, 0xb0, 0x28, 0x2e,
+	0xe3, 0x6f, 0xbc, 0xbb, 0x66, 0x1a, 0x6d, 0x0b, 0xaf, 0x42, 0x69, 0xa0, 0x29, 0x99, 0xc6, 0x75,
+	0xbc, 0x42, 0x7d, 0x21, 0x98, 0x19, 0x66, 0xce, 0x15, 0xa2, 0xf8, 0xf8, 0x5c, 0xc0, 0x34, 0x98,
+	0x7b, 0xca, 0x99, 0x06, 0x51, 0x17, 0x3e, 0x61, 0xbf, 0x55, 0x1e, 0x08, 0x9b, 0x06, 0x8e, 0xfa,
+	0xcc, 0x56, 0xda, 0x26, 0x3b, 0x13, 0xe1, 0xcc, 0xc7, 0x20, 0xfa, 0x44, 0x93, 0x00, 0xdb, 0xbe,
+	0x47, 0x66, 0xf9, 0x79, 0xad, 0x42, 0x01, 0xb7, 0x3d, 0xf5, 0xab, 0xb0, 0x80, 0x69, 0xa6, 0x63,
+	0xbf, 0x8d, 0x25, 0x35, 0xb6, 0xee, 0xcf, 0x41, 0xd1, 0x32, 0xbb, 0x66, 0x60, 0xed, 0xf4, 0x43,
+	0xfd, 0x65, 0x09, 0x16, 0x07, 0x5a, 0x9e, 0x44, 0x86, 0x17, 0x79, 0xb5, 0xaa, 0xad, 0x9e, 0x12,
+	0xfa, 0x32, 0xb1, 0xd2, 0x04, 0x3a, 0xf8, 0xe7, 0x39, 0x80, 0x4b, 0x7a, 0xe7, 0x4e, 0xbf, 0x47,
+	0x3c, 0x98, 0x0c, 0x05, 0x6e, 0x58, 0xe4, 0xb7, 0x68, 0xd4, 0xb9, 0x4c, 0x49, 0xac, 0xa2, 0x1b,
+	0x8b, 0x27, 0xa1, 0xb9, 0x45, 0xba, 0xe3, 0x76, 0x2b, 0xe9, 0x75, 0xd9, 0x69, 0x0a, 0x8f, 0xb6,
+	0x2a, 0xcf, 0xc7, 0x57, 0xfc, 0x4b, 0xc2, 0xd1, 0x51, 0xda, 0x87, 0x25, 0x24, 0x94, 0x92, 0x09,
+	0x09, 0x78, 0x65, 0x81, 0xd7, 0x92, 0x4c, 0x1f, 0x3c, 0xa6, 0x42, 0x35, 0xbb, 0xdf, 0x0d, 0x32,
+	0xfd, 0x62, 0xcb, 0xcd, 0x4a, 0x7c, 0xb9, 0x29, 0x43, 0x81, 0x2c, 0x50, 0xa9, 0xda, 0x90, 0xdf,
+	0xea, 0x3f, 0xe1, 0x9d, 0x20, 0xa2, 0x3f, 0x94, 0x9e, 0x87, 0xb9, 0x3f, 0xfd, 0x04, 0xd4, 0x18,
+	0x87, 0xb9, 0x73, 0x59, 0xa0, 0xa0, 0xe0, 0xdc, 0x67, 0x40, 0x04, 0x45, 0xa1, 0x08, 0xa8, 0x1f,
+	0x7c, 0xac, 0x95, 0x57, 0x3f, 0x94, 0x60, 0x2e, 0x3e, 0xc0, 0x49, 0xf4, 0xf6, 0x2c, 0x14, 0xb0,
+	0x75, 0xb7, 0x72, 0xa2, 0x9d, 0xaa, 0x98, 0x60, 0x49, 0xe4, 0x25, 0xc8, 0x78, 0xa1, 0x26, 0x63,
+	0xeb, 0xa1, 0x05, 0x13, 0x2c, 0x92, 0xb2, 0xea, 0xb3, 0xf0, 0xb2, 0xd2, 0x47, 0x12, 0xcc, 0xc6,
+	0xa8, 0x98, 0x84, 0x0f, 0x2f, 0x42, 0x99, 0x72, 0x3c, 0xb0, 0xe0, 0x91, 0xac, 0x08, 0xf0, 0xd5,
+	0xdf, 0x21, 0xcb, 0x56, 0xcf, 0x77, 0xdc, 0x89, 0x55, 0x2e, 0xa1, 0x30, 0xb9, 0x01, 0x85, 0x19,
+	0xf7, 0x9a, 0x7e, 0xec, 0x21, 0x81, 0x8f, 0x25, 0x98, 0x4f, 0x10, 0x3a, 0xf1, 0x3a, 0x61, 0xc4,
+	0x46, 0x53, 0x18, 0x6d, 0xf3, 0x7c, 0xb4, 0xfd, 0x09, 0xbe, 0xf3, 0x64, 0x39, 0xf6, 0x01, 0xb9,
+	0xc8, 0x7e, 0x1a, 0x66, 0x6d, 0xb4, 0xd7, 0x4e, 0x22, 0x53, 0x73, 0x9d, 0xb1, 0xd1, 0xde, 0xe5,
+	0x38, 0x7e, 0x2c, 0x2b, 0xa4, 0x98, 0xc8, 0x0a, 0x11, 0x72, 0xfe, 0x9b, 0x12, 0x2c, 0x0e, 0x0c,
+	0xf8, 0x41, 0xf3, 0x3e, 0x3e, 0x71, 0xcd, 0x0f, 0x4c, 0x5c, 0xff, 0x93, 0xbd, 0x2b, 0xd1, 0x43,
+	0xdc, 0x45, 0x67, 0x12, 0x7a, 0x32, 0x07, 0xd7, 0x2c, 0x64, 0x9c, 0x82, 0x19, 0x83, 0xf6, 0xc2,
+	0x39, 0x39, 0x7a, 0xd7, 0xb1, 0xc9, 0x0a, 0x62, 0xd7, 0xd5, 0x03, 0xe4, 0xf8, 0x75, 0x75, 0x1a,
+	0x98, 0x66, 0x59, 0x61, 0xec, 0xba, 0xfa, 0x73, 0x30, 0x87, 0xee, 0xf5, 0x4c, 0x17, 0x25, 0xaa,
+	0x50, 0xc9, 0xc8, 0xb4, 0x8c, 0xaf, 0xa1, 0xfe, 0x20, 0x47, 0x6f, 0xaf, 0xf5, 0x50, 0x74, 0xb3,
+	0xea, 0xf3, 0x1f, 0xf8, 0xe0, 0xac, 0x3b, 0x2f, 0x9a, 0x75, 0x27, 0xf6, 0x62, 0x0b, 0x83, 0x7b,
+	0xb1, 0x42, 0x0e, 0x16, 0xc7, 0xe5, 0x60, 0x69, 0x7c, 0x0e, 0x96, 0x53, 0x39, 0xf8, 0xbb, 0x12,
+	0x1c, 0xc5, 0xbe, 0x77, 0x40, 0x7f, 0xbc, 0x03, 0xf0, 0x36, 0x49, 0x2c, 0x4a, 0xfc, 0x44, 0x82,
+	0xc7, 0xd3, 0x28, 0x9d, 0xc4, 0x02, 0xaf, 0xd3, 0xf4, 0x08, 0xd6, 0xd6, 0xd0, 0x1d, 0x7e, 0xa1,
+	0x91, 0x69, 0x7c, 0x75, 0x79, 0x1d, 0x20, 0x94, 0x78, 0xb0, 0x1e, 0x3e, 0x39, 0xac, 0xb1, 0x98,
+	0xde, 0x6a, 0x5c, 0x65, 0xf5, 0x93, 0x1c, 0xb4, 0x98, 0x97, 0x7f, 0x74, 0xde, 0x09, 0x19, 0xb4,
+	0x95, 0x62, 0x06, 0x5b, 0x29, 0x0d, 0xd8, 0x8a, 0xd0, 0xfb, 0x7e, 0x8b, 0x6e, 0xb8, 0x6a, 0xf4,
+	0xf2, 0xe1, 0x03, 0xbe, 0xca, 0xb2, 0x0c, 0xcd, 0x3d, 0xd3, 0xdf, 0x6d, 0x93, 0xf7, 0x32, 0xc8,
+	0x6e, 0x27, 0x4d, 0x81, 0xae, 0x68, 0x53, 0x18, 0xbe, 0x89, 0xc1, 0x78, 0xc7, 0xd3, 0x53, 0xbf,
+	0x21, 0xc1, 0x6c, 0x8c, 0xac, 0x49, 0xd4, 0xf1, 0x65, 0xbc, 0x11, 0x4c, 0x1b, 0x62, 0xba, 0x28,
+	0x9e, 0xa4, 0xb3, 0xde, 0x88, 0xd6, 0x84, 0x35, 0x70, 0x1e, 0x7c, 0x8d, 0x2b, 0xc1, 0x11, 0x8e,
+	0x95, 0x45, 0x27, 0x4c, 0x21, 0x20, 0x13, 0x1b, 0x9e, 0x84, 0x48, 0x74, 0xdc, 0x65, 0x6e, 0x6e,
+	0x3f, 0xd4, 0xf0, 0xe4, 0x6b, 0x30, 0x45, 0xd9, 0x14, 0x92, 0x2e, 0x3c, 0xf8, 0x0d, 0xef, 0xc9,
+	0xe9, 0xae, 0xc1, 0xa8, 0xd4, 0x1a, 0x1e, 0xf7, 0x45, 0x17, 0x09, 0x8e, 0x81, 0x48, 0x4f, 0xc5,
+	0x81, 0xf3, 0x9e, 0x3a, 0x5f, 0x15, 0xef, 0x99, 0x5b, 0x48, 0x37, 0x90, 0x1b, 0x8e, 0x2d, 0xfc,
+	0xc6, 0x13, 0x30, 0xfa, 0xbb, 0x8d, 0xcf, 0x10, 0x82, 0x09, 0x18, 0x05, 0xe1, 0xe3, 0x05, 0xf9,
+	0x69, 0x98, 0x36, 0xba, 0xb1, 0xc7, 0x5a, 0x02, 0xaf, 0x6e, 0x74, 0xb9, 0x57, 0x5a, 0x62, 0x04,
+	0x15, 0xe2, 0x04, 0x7d, 0x14, 0x3d, 0x7f, 0xe5, 0x22, 0x03, 0xd9, 0xbe, 0xa9, 0x5b, 0xf7, 0xaf,
+	0x93, 0x0a, 0x54, 0xfa, 0x1e, 0x72, 0x39, 0x03, 0x0d, 0xbf, 0x71, 0x59, 0x4f, 0xf7, 0xbc, 0x3d,
+	0xc7, 0x35, 0x18, 0x95, 0xe1, 0xf7, 0x90, 0xab, 0x79, 0x34, 0xd4, 0x8a, 0xaf, 0xe6, 0x9d, 0x87,
+	0xc5, 0xae, 0x63, 0x98, 0xdb, 0xa6, 0xe8, 0x46, 0x1f, 0xae, 0x36, 0x1f, 0x14, 0xc7, 0xea, 0x05,
+	0x66, 0x39, 0xcb, 0x9b, 0xe5, 0x77, 0x73, 0xb0, 0xf8, 0x56, 0xcf, 0xf8, 0x02, 0xf8, 0xb0, 0x04,
+	0x35, 0xc7, 0x32, 0x36, 0xe2, 0xac, 0xe0, 0x41, 0x18, 0xc3, 0x46, 0x7b, 0x21, 0x06, 0x9d, 0xfa,
+	0xf1, 0xa0, 0xa1, 0x57, 0x19, 0xef, 0x8b, 0x5f, 0xa5, 0x61, 0xfc, 0xaa, 0x7e, 0xf6, 0x4a, 0xa9,
+	0x92, 0x6b, 0xce, 0xb5, 0x72, 0xea, 0xcf, 0xe0, 0xab, 0x84, 0x16, 0x7a, 0xe0, 0x5c, 0x0a, 0x64,
+	0x34, 0xcf, 0xcb, 0xe8, 0x3d, 0x98, 0xc7, 0xc1, 0x13, 0x77, 0xfd, 0x96, 0x87, 0xdc, 0x09, 0x9d,
+	0xd4, 0x11, 0xa8, 0x06, 0xbd, 0x05, 0x97, 0x50, 0x23, 0x80, 0xfa, 0xff, 0x61, 0x2e, 0xd1, 0xd7,
+	0x7d, 0x8e, 0x32, 0x18, 0xc9, 0x02, 0x3f, 0x92, 0x25, 0x00, 0xcd, 0xb1, 0xd0, 0xeb, 0xb6, 0x6f,
+	0xfa, 0xfb, 0xa2, 0xcd, 0x15, 0x8c, 0x81, 0xfb, 0x1d, 0x82, 0xf1, 0x4d, 0x09, 0x66, 0xa8, 0xe5,
+	0xe2, 0xa6, 0xee, 0x5f, 0x0a, 0x2f, 0x40, 0x09, 0x91, 0x5e, 0x86, 0x2e, 0xbb, 0x23, 0x72, 0x35,
+	0x86, 0x2e, 0x34, 0x23, 0x1f, 0xa6, 0xf1, 0x9c, 0x60, 0x32, 0x8a, 0xc8, 0x56, 0xa2, 0x85, 0xf8,
+	0x38, 0x5f, 0xc1, 0x80, 0x9b, 0x69, 0x8a, 0xf1, 0x23, 0x09, 0x16, 0x6e, 0xf5, 0x90, 0xab, 0xfb,
+	0x08, 0x33, 0x6d, 0xb2, 0xde, 0x87, 0xd9, 0x6e, 0x8c, 0xb2, 0x7c, 0x9c, 0x32, 0xf9, 0xe5, 0xd8,
+	0x0b, 0x29, 0xe2, 0x03, 0x84, 0x04, 0x95, 0xd1, 0x4d, 0xeb, 0x60, 0x5c, 0x8b, 0xfc, 0xb8, 0xbe,
+	0x27, 0xc1, 0xcc, 0x26, 0xc2, 0x71, 0x6c, 0xb2, 0x21, 0x9d, 0x85, 0x02, 0xa6, 0x32, 0xab, 0x80,
+	0x09, 0xb2, 0xbc, 0x02, 0x33, 0xa6, 0xdd, 0xb1, 0xfa, 0x06, 0x6a, 0xe3, 0xf1, 0xb7, 0xc9, 0xce,
+	0x0c, 0x9d, 0x3c, 0x4c, 0xb3, 0x02, 0x3c, 0x0c, 0x1c, 0xa2, 0x85, 0x3a, 0x7e, 0x8f, 0xea, 0x78,
+	0x78, 0xb5, 0x83, 0x92, 0x20, 0x8d, 0x43, 0xc2, 0x39, 0x28, 0xe2, 0xae, 0x87, 0xef, 0x82, 0x44,
+	0x66, 0xa2, 0x51, 0x6c, 0xf5, 0x67, 0x25, 0x90, 0x79, 0xb6, 0x4d, 0xb8, 0x15, 0x13, 0xa5, 0x74,
+	0xe7, 0x87, 0x92, 0x4e, 0x47, 0x1a, 0x26, 0x73, 0xab, 0x9f, 0x86, 0xd2, 0x23, 0xe2, 0x9e, 0x44,
+	0x7a, 0x78, 0x5c, 0x43, 0xa5, 0xc7, 0x31, 0x81, 0x20, 0xf3, 0xd2, 0x23, 0x1a, 0x2b, 0x90, 0x1e,
+	0xa6, 0x99, 0x48, 0x8f, 0xf9, 0xf7, 0x56, 0x2b, 0x87, 0x85, 0x46, 0x89, 0x0d, 0x84, 0x46, 0x7a,
+	0x96, 0xc6, 0xe9, 0xf9, 0x1c, 0x14, 0x71, 0x8f, 0xa3, 0xf9, 0x15, 0x08, 0x8d, 0x60, 0x73, 0x42,
+	0x63, 0x04, 0x3c, 0x78, 0xa1, 0x45, 0x23, 0x8d, 0x84, 0xa6, 0x42, 0xfd, 0xd6, 0xd6, 0x7b, 0xa8,
+	0xe3, 0x0f, 0xf1, 0xbc, 0xc7, 0x61, 0x7a, 0xc3, 0x35, 0xef, 0x9a, 0x16, 0xda, 0x19, 0xe6, 0xc2,
+	0xbf, 0x21, 0x41, 0xe3, 0xaa, 0xab, 0xdb, 0xbe, 0x13, 0xb8, 0xf1, 0xfb, 0xe2, 0xe7, 0x25, 0xa8,
+	0xf6, 0x82, 0xde, 0x98, 0x0e, 0x3c, 0x25, 0xac, 0x99, 0xa0, 0x49, 0x8b, 0xaa, 0xa9, 0x6f, 0xc3,
+	0x1c, 0xa1, 0x24, 0x49, 0xf6, 0x2b, 0x50, 0x21, 0xce, 0xdc, 0x64, 0x27, 0x93, 0xb5, 0x55, 0x55,
+	0x7c, 0x56, 0xc0, 0x0f, 0x43, 0x0b, 0xeb, 0xa8, 0xff, 0x28, 0x41, 0x8d, 0x94, 0x45, 0x03, 0x1c,
+	0xdf, 0xca, 0x5f, 0x84, 0x92, 0x43, 0x58, 0x3e, 0x34, 0xc5, 0x93, 0x97, 0x8a, 0xc6, 0x2a, 0xe0,
+	0x19, 0x32, 0xfd, 0xc5, 0x7b, 0x64, 0xa0, 0x20, 0xe6, 0x93, 0xcb, 0x3b, 0x94, 0x76, 0xe2, 0x96,
+	0xb3, 0x8d, 0x2f, 0xa8, 0xa2, 0x7e, 0x2b, 0xd4, 0x49, 0x82, 0x70, 0xff, 0x26, 0xfc, 0xe5, 0x44,
+	0x8c, 0x5d, 0x4a, 0xa7, 0x42, 0x1c, 0x64, 0x63, 0x9e, 0x15, 0xaf, 0xd5, 0x62, 0x64, 0x4d, 0xb8,
+	0x56, 0x0b, 0x55, 0x60, 0xd8, 0x5a, 0x8d, 0x27, 0x2e, 0x52, 0x80, 0xbf, 0x93, 0x60, 0x91, 0xc5,
+	0xb4, 0x50, 0xb7, 0x1e, 0x02, 0x9b, 0xe4, 0xaf, 0xb0, 0xd8, 0x9b, 0x27, 0xb1, 0xf7, 0xe4, 0xb0,
+	0xd8, 0x1b, 0xd2, 0x39, 0x22, 0xf8, 0x7e, 0x47, 0x22, 0x79, 0x0e, 0x38, 0x1b, 0x09, 0xe7, 0x5b,
+	0x4c, 0x7c, 0x17, 0x7b, 0x30, 0x49, 0x48, 0x7c, 0x5a, 0xf6, 0x34, 0x24, 0x12, 0xbd, 0xd9, 0xc9,
+	0x77, 0x02, 0xaa, 0x76, 0x41, 0x11, 0x91, 0x37, 0x61, 0x02, 0x57, 0x8f, 0x35, 0xc4, 0xd6, 0xd1,
+	0xe1, 0xb7, 0x7a, 0x1c, 0xaa, 0x37, 0x48, 0x0b, 0xaf, 0xdf, 0xf3, 0x71, 0x62, 0xc0, 0x5d, 0xe4,
+	0x7a, 0xa6, 0x63, 0x33, 0x8f, 0x17, 0x7c, 0xae, 0x1c, 0x83, 0x4a, 0xf0, 0x84, 0x8c, 0x5c, 0x86,
+	0xfc, 0x45, 0xcb, 0x6a, 0x1e, 0x92, 0xeb, 0x50, 0x59, 0x67, 0xef, 0xa4, 0x34, 0xa5, 0x95, 0x77,
+	0xa0, 0xc6, 0x9d, 0xce, 0xc9, 0x53, 0xc1, 0x41, 0xe3, 0x4d, 0xc7, 0x46, 0xcd, 0x43, 0xf2, 0x2c,
+	0x4c, 0xd3, 0xef, 0xd7, 0x83, 0x5c, 0x97, 0xa6, 0x14, 0x01, 0x2f, 0x07, 0x09, 0x2b, 0xcd, 0x9c,
+	0xdc, 0x84, 0x3a, 0x05, 0x5e, 0x21, 0xe9, 0x29, 0xcd, 0xfc, 0xca, 0x6b, 0x30, 0x2b, 0x98, 0x61,
+	0xc9, 0x33, 0xd0, 0xb8, 0x68, 0x90, 0x79, 0xfc, 0x6d, 0x07, 0x03, 0x9b, 0x87, 0xe4, 0x05, 0x90,
+	0x35, 0xd4, 0x75, 0xee, 0x12, 0xc4, 0x2b, 0xae, 0xd3, 0x25, 0x70, 0x69, 0xe5, 0x59, 0x98, 0x13,
+	0xe9, 0x89, 0x5c, 0x85, 0x22, 0xd1, 0xbb, 0xe6, 0x21, 0x19, 0xa0, 0xa4, 0xa1, 0xbb, 0xce, 0x1d,
+	0xd4, 0x94, 0x56, 0xbf, 0xff, 0x1c, 0x34, 0x28, 0x5b, 0xd8, 0x5b, 0x6a, 0x72, 0x1b, 0x9a, 0xc9,
+	0xe7, 0xa4, 0xe5, 0x67, 0xc4, 0xc9, 0x24, 0xe2, 0x57, 0xa7, 0x95, 0x61, 0xa2, 0x52, 0x0f, 0xc9,
+	0x5f, 0x83, 0xa9, 0xf8, 0x03, 0xcc, 0x72, 0xfa, 0x46, 0xdf, 0xd8, 0x8d, 0xb7, 0xa1, 0x11, 0x7b,
+	0x3b, 0x59, 0x16, 0x9b, 0x92, 0xe8, 0x7d, 0x65, 0x45, 0xec, 0xb7, 0xf9, 0xf7, 0x8d, 0x29, 0xf5,
+	0xf1, 0xc7, 0x4c, 0x53, 0xa8, 0x17, 0xbe, 0x78, 0x3a, 0x8a, 0x7a, 0x1d, 0x66, 0x06, 0xde, 0x1a,
+	0x95, 0x9f, 0x4d, 0xd9, 0x7a, 0x12, 0xbf, 0x49, 0x3a, 0xaa, 0x8b, 0x3d, 0x90, 0x07, 0xdf, 0x03,
+	0x96, 0x4f, 0x8b, 0x25, 0x90, 0xf6, 0x42, 0xb2, 0x72, 0x26, 0x33, 0x7e, 0xc8, 0xb8, 0x8f, 0x25,
+	0x58, 0x4c, 0x79, 0x96, 0x52, 0x3e, 0x9b, 0x76, 0xc0, 0x3f, 0xe4, 0x91, 0x4d, 0xe5, 0x4b, 0xe3,
+	0x55, 0x0a, 0x09, 0xb1, 0x61, 0x3a, 0xf1, 0x2a, 0xa3, 0x7c, 0x2a, 0xf5, 0x29, 0xa9, 0xc1, 0xbd,
+	0x78, 0xe5, 0x99, 0x6c, 0xc8, 0x61, 0x7f, 0xf8, 0x86, 0x5b, 0xfc, 0x49, 0xc2, 0x94, 0xfe, 0xc4,
+	0x0f, 0x17, 0x8e, 0x12, 0xe8, 0x3b, 0xd0, 0x88, 0xbd, 0x1d, 0x28, 0xa7, 0xef, 0x74, 0x8f, 0xdb,
+	0xf4, 0xbb, 0x50, 0xe7, 0x9f, 0xf8, 0x93, 0x97, 0xd3, 0x6c, 0x69, 0xa0, 0xe1, 0x71, 0x4c, 0x29,
+	0xac, 0xec, 0x0d, 0x31, 0xa5, 0x81, 0xd7, 0xcc, 0xb2, 0x9b, 0x12, 0xd7, 0xfe, 0x50, 0x53, 0x1a,
+	0xbb, 0x8b, 0xaf, 0x4b, 0x24, 0x73, 0x49, 0xf0, 0xf4, 0x9b, 0xbc, 0x9a, 0xa6, 0x9b, 0xe9, 0x8f,
+	0xdc, 0x29, 0x67, 0xc7, 0xaa, 0x13, 0x72, 0xf1, 0x0e, 0x4c, 0xc5, 0x1f, 0x38, 0x4b, 0xe1, 0xa2,
+	0xf0, 0x4d, 0x38, 0xe5, 0x54, 0x26, 0xdc, 0xb0, 0xb3, 0x3d, 0xb2, 0xf7, 0x9f, 0x88, 0xd9, 0x29,
+	0xde, 0x23, 0x75, 0xee, 0xa1, 0x9c, 0xc9, 0x8c, 0x1f, 0x76, 0xfc, 0x16, 0xd4, 0xb8, 0x7f, 0x4d,
+	0x21, 0x9f, 0x18, 0x62, 0x40, 0xfc, 0xff, 0x69, 0x18, 0x25, 0xc2, 0x37, 0xa1, 0x1a, 0xfe, 0x47,
+	0x09, 0xf9, 0x78, 0xaa, 0xe1, 0x8c, 0xd3, 0xe4, 0x26, 0x40, 0xf4, 0xef, 0x22, 0xe4, 0xa7, 0x85,
+	0x6d, 0x0e, 0xfc, 0x3f, 0x89, 0x51, 0x8d, 0x86, 0xc3, 0xa7, 0x4f, 0x39, 0x0c, 0x1b, 0x3e, 0xff,
+	0x1a, 0xc9, 0xa8, 0x66, 0x77, 0xa1, 0x11, 0xf8, 0x6c, 0xda, 0xf0, 0xc9, 0xa1, 0x7e, 0x3d, 0xd6,
+	0xf4, 0x4a, 0x16, 0xd4, 0x50, 0x7e, 0xbb, 0xd0, 0x88, 0xbd, 0xe8, 0x92, 0xd2, 0x93, 0xe8, 0x25,
+	0x1b, 0x65, 0x25, 0x0b, 0x6a, 0xd8, 0xd3, 0x87, 0xdc, 0xe3, 0x31, 0xb1, 0x97, 0x7a, 0xe4, 0xe7,
+	0x87, 0xb6, 0x23, 0x7a, 0xb1, 0x48, 0x59, 0x1d, 0xa7, 0x4a, 0x48, 0x02, 0xd3, 0x2a, 0xca, 0xd2,
+	0x74, 0xad, 0x1a, 0x47, 0x52, 0x9b, 0x50, 0xa2, 0x4f, 0xb3, 0xc8, 0x6a, 0xca, 0xfb, 0x4c, 0xdc,
+	0xbb, 0x2d, 0xca, 0x93, 0x42, 0x9c, 0xf8, 0x63, 0x25, 0xb4, 0x51, 0xba, 0x19, 0x9e, 0xd2, 0x68,
+	0xec, 0x39, 0x8e, 0xac, 0x8d, 0x6a, 0x50, 0xa2, 0xef, 0x03, 0xa4, 0x34, 0x1a, 0x7b, 0xfc, 0x42,
+	0x19, 0x8e, 0x43, 0xb7, 0x34, 0x0e, 0xc9, 0x1b, 0x50, 0x24, 0x29, 0xc1, 0xf2, 0xb1, 0x61, 0x77,
+	0xce, 0x87, 0xb5, 0x18, 0xbb, 0x96, 0xae, 0x1e, 0x92, 0x6f, 0x41, 0x91, 0xdc, 0xd7, 0x49, 0x69,
+	0x91, 0xbf, 0x8c, 0xad, 0x0c, 0x45, 0x09, 0x48, 0x34, 0xa0, 0xce, 0x5f, 0x99, 0x4c, 0x89, 0x95,
+	0x82, 0x4b, 0xa5, 0x4a, 0x16, 0xcc, 0xa0, 0x17, 0x6a, 0x46, 0x51, 0x7a, 0x74, 0xba, 0x19, 0x0d,
+	0xa4, 0x5e, 0x2b, 0x2b, 0x59, 0x50, 0x43, 0x06, 0xfd, 0x9c, 0x04, 0xad, 0xb4, 0x7b, 0x7c, 0x72,
+	0xea, 0xd4, 0x6b, 0xd8, 0x65, 0x44, 0xe5, 0xdc, 0x98, 0xb5, 0x42, 0x5a, 0x3e, 0x20, 0x47, 0xbb,
+	0x03, 0x37, 0xf7, 0x52, 0xc3, 0x48, 0xca, 0x6d, 0x34, 0xe5, 0xb9, 0xec, 0x15, 0xc2, 0xbe, 0xb7,
+	0xa0, 0xc6, 0x1d, 0x2b, 0xa7, 0x78, 0xde, 0xc1, 0xf3, 0x70, 0x65, 0x79, 0x34, 0x62, 0xd8, 0xc7,
+	0x06, 0x14, 0xc9, 0x75, 0xaf, 0x14, 0x65, 0xe4, 0x6f, 0x8f, 0x29, 0xea, 0x30, 0x94, 0xb0, 0x45,
+	0x04, 0x75, 0xfe, 0xee, 0x57, 0x8a, 0x36, 0x0a, 0xae, 0x8d, 0x29, 0x27, 0x33, 0x60, 0x86, 0xdd,
+	0xb4, 0x01, 0xa2, 0xbb, 0x57, 0x29, 0xb1, 0x6e, 0xe0, 0xfa, 0x97, 0x72, 0x62, 0x24, 0x1e, 0x1f,
+	0xf6, 0xb9, 0xdb, 0x54, 0x29, 0xdc, 0x1f, 0xbc, 0x6f, 0x95, 0x61, 0x11, 0x34, 0x78, 0x5d, 0x26,
+	0x7d, 0x1a, 0x23, 0xbe, 0x99, 0xa3, 0x9c, 0xc9, 0x8c, 0x1f, 0x8e, 0xe7, 0x7d, 0x68, 0x26, 0xaf,
+	0x17, 0xa5, 0x2c, 0xae, 0x53, 0x6e, 0x57, 0x29, 0xcf, 0x66, 0xc4, 0xe6, 0xe3, 0xe1, 0xe1, 0x41,
+	0x9a, 0xbe, 0x6a, 0xfa, 0xbb, 0xe4, 0xd6, 0x4a, 0x96, 0x51, 0xf3, 0x17, 0x64, 0x94, 0x33, 0x99,
+	0xf1, 0x43, 0x12, 0x70, 0xf0, 0x22, 0x99, 0xda, 0x69, 0xc1, 0x8b, 0xbf, 0x88, 0xa1, 0x3c, 0x39,
+	0x14, 0x87, 0x9f, 0xf7, 0xc6, 0x33, 0xc0, 0xe5, 0x95, 0x4c, 0x69, 0xe2, 0xc3, 0xe6, 0xbd, 0xe2,
+	0x94, 0x72, 0xba, 0x66, 0x4c, 0x24, 0xb8, 0xa7, 0xac, 0xe1, 0xc4, 0x09, 0xf6, 0xca, 0x33, 0xd9,
+	0x90, 0x79, 0xfb, 0xe5, 0xb3, 0x92, 0xd3, 0xa2, 0xc9, 0x60, 0x66, 0xb6, 0x72, 0x32, 0x03, 0x26,
+	0xef, 0xdc, 0xb8, 0x9c, 0xdf, 0x34, 0xf3, 0x1a, 0xc8, 0x4d, 0x56, 0x96, 0x47, 0x23, 0xf2, 0x33,
+	0xbf, 0x58, 0x9a, 0xac, 0x9c, 0xe6, 0x61, 0x06, 0x73, 0x7e, 0x95, 0x95, 0x2c, 0xa8, 0xbc, 0x90,
+	0x12, 0x69, 0xa1, 0x69, 0x0b, 0x6d, 0x61, 0xb6, 0xac, 0xf2, 0x4c, 0x36, 0xe4, 0xb0, 0x3f, 0xbc,
+	0xfe, 0x13, 0x27, 0xc3, 0xa5, 0xac, 0xff, 0x86, 0xe6, 0xf8, 0x29, 0x67, 0xc7, 0xaa, 0x13, 0x52,
+	0x41, 0x16, 0xba, 0x89, 0x04, 0xb5, 0xd4, 0x85, 0xae, 0x38, 0x91, 0x6d, 0xf4, 0xa6, 0x5a, 0x33,
+	0x99, 0x62, 0x33, 0x7c, 0x4b, 0x30, 0x99, 0x5b, 0x91, 0xa1, 0x83, 0x64, 0xee, 0x4a, 0x4a, 0x07,
+	0x29, 0x29, 0x2e, 0x19, 0x3a, 0x48, 0xa6, 0x7d, 0xa4, 0x74, 0x90, 0x92, 0x1d, 0x92, 0x61, 0x25,
+	0x15, 0x4b, 0xb7, 0x48, 0xd1, 0x72, 0x51, 0x4a, 0x86, 0xb2, 0x92, 0x05, 0x95, 0x73, 0xa6, 0x10,
+	0x65, 0x4d, 0xa4, 0xc4, 0xdc, 0x81, 0xb4, 0x8a, 0x51, 0xe4, 0xdf, 0x82, 0x4a, 0x90, 0xf6, 0x20,
+	0x3f, 0x95, 0xba, 0x60, 0x19, 0xa3, 0xc1, 0x77, 0x61, 0x3a, 0xb1, 0x91, 0x9d, 0x62, 0x8b, 0xe2,
+	0xb4, 0x87, 0xd1, 0xf2, 0x84, 0xe8, 0x80, 0x3c, 0x85, 0x09, 0x03, 0x89, 0x07, 0xca, 0x89, 0x91,
+	0x78, 0xfc, 0xcc, 0x26, 0x3a, 0xcc, 0x1d, 0xda, 0x01, 0x77, 0x36, 0xae, 0x9c, 0x18, 0x89, 0xc7,
+	0x75, 0xd0, 0x4c, 0xee, 0xd3, 0xa7, 0x68, 0x64, 0xca, 0xf1, 0xd4, 0x28, 0x16, 0x6d, 0x41, 0x8d,
+	0x3b, 0x63, 0x93, 0x87, 0x91, 0xc6, 0x1f, 0x0e, 0x2a, 0xcb, 0xa3, 0x11, 0x83, 0x41, 0xac, 0xf6,
+	0xa1, 0xbe, 0xe1, 0x3a, 0xf7, 0x82, 0xff, 0xc3, 0xf2, 0x05, 0x4d, 0x3b, 0x2f, 0x74, 0x60, 0x8a,
+	0x22, 0xb4, 0xd1, 0x3d, 0xbf, 0xed, 0x6c, 0xbd, 0x27, 0x1f, 0x39, 0x4d, 0xff, 0xbb, 0xe9, 0xe9,
+	0xe0, 0xbf, 0x9b, 0x9e, 0xbe, 0x62, 0x5a, 0xe8, 0x16, 0xbb, 0x27, 0xf9, 0xaf, 0xe5, 0x21, 0x8f,
+	0x09, 0x85, 0x87, 0x42, 0x1a, 0xfb, 0x07, 0xab, 0xaf, 0xdf, 0xf3, 0x6f, 0x6d, 0xbd, 0x77, 0xe9,
+	0x3a, 0x4c, 0x99, 0x21, 0xd2, 0x8e, 0xdb, 0xeb, 0x5c, 0xaa, 0x51, 0xd4, 0x0d, 0x5c, 0x7b, 0x43,
+	0xfa, 0x7f, 0xcb, 0x3b, 0xa6, 0xbf, 0xdb, 0xdf, 0xc2, 0x8c, 0x3f, 0x43, 0xd1, 0x9e, 0x35, 0x1d,
+	0xf6, 0xeb, 0x8c, 0xde, 0x33, 0xd9, 0xcf, 0xde, 0xd6, 0x6f, 0x49, 0xd2, 0x56, 0x89, 0xf4, 0x79,
+	0xf6, 0xff, 0x06, 0x00, 0x67, 0x1c, 0x83, 0x99, 0xc5, 0x75, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7652,6 +8643,15 @@ type MilvusServiceClient interface {
 	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
 	ListImportTasks(ctx context.Context, in *ListImportTasksRequest, opts ...grpc.CallOption) (*ListImportTasksResponse, error)
+	// Point-in-time backup of a collection, and restore of a backup into a new collection
+	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error)
+	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+	// Zero-copy clone of a collection, the new collection shares the flushed binlogs of the source
+	CloneCollection(ctx context.Context, in *CloneCollectionRequest, opts ...grpc.CallOption) (*CloneCollectionResponse, error)
+	// Dropped collections are kept in the recycle bin until the retention expires, and can be restored before that
+	ListDroppedCollections(ctx context.Context, in *ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*ListDroppedCollectionsResponse, error)
+	RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -8071,6 +9071,60 @@ func (c *milvusServiceClient) ListImportTasks(ctx context.Context, in *ListImpor
 	return out, nil
 }
 
+func (c *milvusServiceClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error) {
+	out := new(CreateBackupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error) {
+	out := new(ListBackupsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RestoreBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CloneCollection(ctx context.Context, in *CloneCollectionRequest, opts ...grpc.CallOption) (*CloneCollectionResponse, error) {
+	out := new(CloneCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CloneCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDroppedCollections(ctx context.Context, in *ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*ListDroppedCollectionsResponse, error) {
+	out := new(ListDroppedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDroppedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RestoreCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
@@ -8221,6 +9275,15 @@ type MilvusServiceServer interface {
 	Import(context.Context, *ImportRequest) (*ImportResponse, error)
 	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
 	ListImportTasks(context.Context, *ListImportTasksRequest) (*ListImportTasksResponse, error)
+	// Point-in-time backup of a collection, and restore of a backup into a new collection
+	CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupResponse, error)
+	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
+	// Zero-copy clone of a collection, the new collection shares the flushed binlogs of the source
+	CloneCollection(context.Context, *CloneCollectionRequest) (*CloneCollectionResponse, error)
+	// Dropped collections are kept in the recycle bin until the retention expires, and can be restored before that
+	ListDroppedCollections(context.Context, *ListDroppedCollectionsRequest) (*ListDroppedCollectionsResponse, error)
+	RestoreCollection(context.Context, *RestoreCollectionRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
 	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
@@ -8372,6 +9435,24 @@ func (*UnimplementedMilvusServiceServer) GetImportState(ctx context.Context, req
 func (*UnimplementedMilvusServiceServer) ListImportTasks(ctx context.Context, req *ListImportTasksRequest) (*ListImportTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImportTasks not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateBackup(ctx context.Context, req *CreateBackupRequest) (*CreateBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListBackups(ctx context.Context, req *ListBackupsRequest) (*ListBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RestoreBackup(ctx context.Context, req *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CloneCollection(ctx context.Context, req *CloneCollectionRequest) (*CloneCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDroppedCollections(ctx context.Context, req *ListDroppedCollectionsRequest) (*ListDroppedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDroppedCollections not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RestoreCollection(ctx context.Context, req *RestoreCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
@@ -9202,6 +10283,114 @@ func _MilvusService_ListImportTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateBackup(ctx, req.(*CreateBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListBackups(ctx, req.(*ListBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RestoreBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CloneCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CloneCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CloneCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CloneCollection(ctx, req.(*CloneCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDroppedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDroppedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDroppedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDroppedCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDroppedCollections(ctx, req.(*ListDroppedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RestoreCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RestoreCollection(ctx, req.(*RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCredentialRequest)
 	if err := dec(in); err != nil {
@@ -9580,6 +10769,30 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListImportTasks",
 			Handler:    _MilvusService_ListImportTasks_Handler,
 		},
+		{
+			MethodName: "CreateBackup",
+			Handler:    _MilvusService_CreateBackup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _MilvusService_ListBackups_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _MilvusService_RestoreBackup_Handler,
+		},
+		{
+			MethodName: "CloneCollection",
+			Handler:    _MilvusService_CloneCollection_Handler,
+		},
+		{
+			MethodName: "ListDroppedCollections",
+			Handler:    _MilvusService_ListDroppedCollections_Handler,
+		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _MilvusService_RestoreCollection_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _MilvusService_CreateCredential_Handler,
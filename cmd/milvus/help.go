@@ -9,7 +9,7 @@ import (
 
 var (
 	usageLine = fmt.Sprintf("Usage:\n"+
-		"%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, serverTypeLine)
+		"%s\n%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, migrateMetaLine, serverTypeLine)
 
 	serverTypeLine = `
 [server type]
@@ -59,8 +59,35 @@ milvus mck run [flags]
 	-minioBucketName ''
 		The bucket to operate the data in it
 
+milvus mck check [flags]
+	Check the meta of rootcoord, datacoord, indexcoord and querycoord against each other and the object store.
+	Tips: The flags of the 'milvus mck [flags]' can be used too.
+[flags]
+	-repair 'false'
+		Move the inconsistent keys to the trash, the files missing in the object store are only reported.
+	-reportFile ''
+		The file to write the json report, 'mck-report-[time].json' by default.
+
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
+`
+	migrateMetaLine = `
+milvus migrate-meta [flags]
+	Migrate the rootcoord and indexcoord meta between the etcd and the database meta stores.
+	Tips: The entity counts and checksums are printed before and verified after the migration.
+[flags]
+	-source 'etcd'
+		The meta store to read from, one of etcd, mysql and sqlite.
+	-target 'mysql'
+		The meta store to write to, one of etcd, mysql and sqlite.
+	-dryRun 'false'
+		Only print the counts and checksums of the source meta, nothing is written.
+	-resume 'false'
+		Skip the meta already in the target, to continue an interrupted migration.
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-etcdRootPath ''
+		The root path of operating the etcd data.
 `
 )
@@ -16,6 +16,7 @@ import (
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -37,6 +38,7 @@ const (
 	MckCmd       = "mck"
 	MckTypeRun   = "run"
 	MckTypeClean = "cleanTrash"
+	MckTypeCheck = "check"
 
 	segmentPrefix     = "datacoord-meta/s"
 	collectionPrefix  = "snapshots/root-coord/collection"
@@ -66,6 +68,8 @@ type mck struct {
 	minioPassword   string
 	minioUseSSL     string
 	minioBucketName string
+	repair          bool
+	reportFile      string
 
 	flagStartIndex int
 }
@@ -102,6 +106,8 @@ func (c *mck) execute(args []string, flags *flag.FlagSet) {
 	case MckTypeClean:
 		c.cleanTrash()
 		return
+	case MckTypeCheck:
+		c.check()
 	default:
 		fmt.Fprintln(os.Stderr, mckLine)
 		return
@@ -188,6 +194,7 @@ func (c *mck) run() {
 }
 
 func (c *mck) initParam() {
+	c.params = &paramtable.ComponentParam{}
 	c.taskKeyMap = make(map[int64]string)
 	c.taskNameMap = make(map[int64]string)
 	c.allTaskInfo = make(map[string]string)
@@ -206,8 +213,10 @@ func (c *mck) formatFlags(args []string, flags *flag.FlagSet) {
 	flags.StringVar(&c.minioPassword, "minioPassword", "", "Minio password")
 	flags.StringVar(&c.minioUseSSL, "minioUseSSL", "", "Minio to use ssl")
 	flags.StringVar(&c.minioBucketName, "minioBucketName", "", "Minio bucket name")
+	flags.BoolVar(&c.repair, "repair", false, "Move the inconsistent keys to the trash")
+	flags.StringVar(&c.reportFile, "reportFile", "", "File to write the json report")
 
-	if err := flags.Parse(os.Args[2:]); err != nil {
+	if err := flags.Parse(args[3:]); err != nil {
 		log.Fatal("failed to parse flags", zap.Error(err))
 	}
 	log.Info("args", zap.Strings("args", args))
@@ -231,6 +240,35 @@ func (c *mck) connectEctd() {
 	log.Info("Etcd root path", zap.String("root_path", rootPath))
 }
 
+// check checks the meta of all the coordinators and the object store, the inconsistent keys are moved to the trash in the repair mode.
+func (c *mck) check() {
+	// the checker reads the kv catalogs, with a database meta store all the meta it reads would look orphaned.
+	if metaStoreType := c.params.MetaStoreCfg.MetaStoreType; metaStoreType != util.MetaStoreTypeEtcd {
+		log.Fatal("mck check only supports the etcd meta store", zap.String("metastore.type", metaStoreType))
+	}
+	c.connectMinio()
+
+	checker := newMetaChecker(c.etcdKV, c.etcdKV.GetPath(""), c.minioChunkManager, c.params.MinioCfg.RootPath,
+		c.params.DataCoordCfg.ChannelWatchSubPath)
+	report, err := checker.check(context.Background())
+	if err != nil {
+		log.Fatal("failed to check the meta", zap.Error(err))
+	}
+	if c.repair {
+		checker.repair()
+	}
+	printMckReport(report)
+
+	reportFile := c.reportFile
+	if reportFile == "" {
+		reportFile = fmt.Sprintf("mck-report-%s.json", time.Now().Format("20060102150405"))
+	}
+	if err := writeMckReport(report, reportFile); err != nil {
+		log.Fatal("failed to write the report", zap.String("file", reportFile), zap.Error(err))
+	}
+	fmt.Printf("Report is written to %s\n", reportFile)
+}
+
 func (c *mck) connectMinio() {
 	chunkManagerFactory := storage.NewChunkManagerFactoryWithParam(c.params)
 
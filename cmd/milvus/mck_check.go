@@ -0,0 +1,523 @@
+package milvus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	kvdatacoord "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvrootcoord "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	mckComponentRootCoord  = "rootcoord"
+	mckComponentDataCoord  = "datacoord"
+	mckComponentIndexCoord = "indexcoord"
+	mckComponentQueryCoord = "querycoord"
+
+	// the inconsistencies found by the meta checker.
+	mckOrphanAlias              = "orphan-alias"
+	mckOrphanSegment            = "orphan-segment"
+	mckOrphanChannel            = "orphan-channel"
+	mckMissingBinlog            = "missing-binlog"
+	mckOrphanIndex              = "orphan-index"
+	mckOrphanSegmentIndex       = "orphan-segment-index"
+	mckMissingIndexFile         = "missing-index-file"
+	mckOrphanCollectionLoadInfo = "orphan-collection-load-info"
+	mckOrphanPartitionLoadInfo  = "orphan-partition-load-info"
+	mckOrphanReplica            = "orphan-replica"
+	mckOrphanHandoffEvent       = "orphan-handoff-event"
+)
+
+// mckFinding is an inconsistency found in the meta.
+type mckFinding struct {
+	Component string `json:"component"`
+	Kind      string `json:"kind"`
+	Key       string `json:"key"`
+	Detail    string `json:"detail"`
+	// Repairable findings have their keys moved to the trash in the repair mode,
+	// the others, like the files missing in the object store, need to be handled manually.
+	Repairable bool `json:"repairable"`
+	Repaired   bool `json:"repaired"`
+
+	keys     []string
+	prefixes []string
+	// files are removed from the object store before the keys are moved to the trash,
+	// nothing would collect them once the meta referring to them is gone.
+	files []string
+}
+
+// mckReport is the result of a meta check.
+type mckReport struct {
+	Findings []*mckFinding `json:"findings"`
+	// Summary is the number of findings of each kind.
+	Summary map[string]int `json:"summary"`
+}
+
+// metaChecker checks the meta of all the coordinators against each other and against the object store.
+type metaChecker struct {
+	metaKV   *etcdkv.EtcdKV
+	rootPath string
+	// chunkManager is the object store holding the binlogs and index files, the files are not checked if it's nil.
+	chunkManager         storage.ChunkManager
+	chunkManagerRootPath string
+	channelWatchPrefix   string
+
+	report *mckReport
+
+	collections map[typeutil.UniqueID]*model.Collection
+	segments    map[typeutil.UniqueID]*datapb.SegmentInfo
+}
+
+func newMetaChecker(metaKV *etcdkv.EtcdKV, rootPath string, chunkManager storage.ChunkManager, chunkManagerRootPath string, channelWatchPrefix string) *metaChecker {
+	return &metaChecker{
+		metaKV:               metaKV,
+		rootPath:             rootPath,
+		chunkManager:         chunkManager,
+		chunkManagerRootPath: chunkManagerRootPath,
+		channelWatchPrefix:   channelWatchPrefix,
+		report:               &mckReport{Summary: make(map[string]int)},
+		collections:          make(map[typeutil.UniqueID]*model.Collection),
+		segments:             make(map[typeutil.UniqueID]*datapb.SegmentInfo),
+	}
+}
+
+func (mc *metaChecker) addFinding(finding *mckFinding) {
+	mc.report.Findings = append(mc.report.Findings, finding)
+	mc.report.Summary[finding.Kind]++
+}
+
+// loadWithPrefix returns the keys relative to the root path, the prefix is matched exactly as the kv trims the trailing slash.
+func (mc *metaChecker) loadWithPrefix(prefix string) ([]string, []string, error) {
+	keys, values, err := mc.metaKV.LoadWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	relKeys := make([]string, 0, len(keys))
+	relValues := make([]string, 0, len(values))
+	for i, key := range keys {
+		key = strings.TrimPrefix(key, mc.rootPath+"/")
+		if strings.HasPrefix(key, prefix) {
+			relKeys = append(relKeys, key)
+			relValues = append(relValues, values[i])
+		}
+	}
+	return relKeys, relValues, nil
+}
+
+func (mc *metaChecker) hasPartition(collectionID, partitionID typeutil.UniqueID) bool {
+	coll, ok := mc.collections[collectionID]
+	if !ok {
+		return false
+	}
+	for _, partition := range coll.Partitions {
+		if partition.PartitionID == partitionID {
+			return true
+		}
+	}
+	return false
+}
+
+// check runs all the checks, the rootcoord and datacoord meta are loaded first as the others refer to them.
+func (mc *metaChecker) check(ctx context.Context) (*mckReport, error) {
+	checks := []func(ctx context.Context) error{
+		mc.checkRootCoord,
+		mc.checkDataCoord,
+		mc.checkIndexCoord,
+		mc.checkQueryCoord,
+	}
+	for _, check := range checks {
+		if err := check(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return mc.report, nil
+}
+
+func (mc *metaChecker) checkRootCoord(ctx context.Context) error {
+	catalog, err := newKvMetaCatalog(mc.metaKV, mc.rootPath)
+	if err != nil {
+		return err
+	}
+	colls, err := catalog.rootCoord.ListCollections(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return fmt.Errorf("failed to list collections: %w", err)
+	}
+	for _, coll := range colls {
+		mc.collections[coll.CollectionID] = coll
+	}
+
+	aliases, err := catalog.rootCoord.ListAliases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return fmt.Errorf("failed to list aliases: %w", err)
+	}
+	for _, alias := range aliases {
+		if _, ok := mc.collections[alias.CollectionID]; ok {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", kvrootcoord.AliasMetaPrefix, alias.Name)
+		mc.addFinding(&mckFinding{
+			Component:  mckComponentRootCoord,
+			Kind:       mckOrphanAlias,
+			Key:        key,
+			Detail:     fmt.Sprintf("alias %s refers to collection %d which doesn't exist", alias.Name, alias.CollectionID),
+			Repairable: true,
+			keys:       []string{key},
+			prefixes:   []string{path.Join(migrateSnapshotPrefix, key) + migrateSnapshotsSep},
+		})
+	}
+	return nil
+}
+
+func (mc *metaChecker) checkDataCoord(ctx context.Context) error {
+	catalog := &kvdatacoord.Catalog{Txn: mc.metaKV, ChunkManagerRootPath: mc.chunkManagerRootPath}
+	segments, err := catalog.ListSegments(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list segments: %w", err)
+	}
+	for _, segment := range segments {
+		mc.segments[segment.GetID()] = segment
+		if !mc.hasPartition(segment.GetCollectionID(), segment.GetPartitionID()) {
+			key := fmt.Sprintf("%s/%d/%d/%d", kvdatacoord.SegmentPrefix, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+			binlogPath := fmt.Sprintf("%d/%d/%d/", segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+			// the meta is kept if the binlogs can't be removed without the object store
+			mc.addFinding(&mckFinding{
+				Component:  mckComponentDataCoord,
+				Kind:       mckOrphanSegment,
+				Key:        key,
+				Detail:     fmt.Sprintf("%s segment %d refers to partition %d of collection %d which doesn't exist", segment.GetState(), segment.GetID(), segment.GetPartitionID(), segment.GetCollectionID()),
+				Repairable: mc.chunkManager != nil,
+				keys:       []string{key},
+				prefixes: []string{
+					path.Join(kvdatacoord.SegmentBinlogPathPrefix, binlogPath) + "/",
+					path.Join(kvdatacoord.SegmentDeltalogPathPrefix, binlogPath) + "/",
+					path.Join(kvdatacoord.SegmentStatslogPathPrefix, binlogPath) + "/",
+				},
+				files: mc.ownedLogPaths(segment),
+			})
+			continue
+		}
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					if err := mc.checkFile(ctx, mckComponentDataCoord, mckMissingBinlog, binlog.GetLogPath(),
+						fmt.Sprintf("binlog of segment %d field %d", segment.GetID(), fieldBinlog.GetFieldID())); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	keys, values, err := mc.loadWithPrefix(mc.channelWatchPrefix)
+	if err != nil {
+		return fmt.Errorf("failed to list channel watch infos: %w", err)
+	}
+	for i, key := range keys {
+		info := &datapb.ChannelWatchInfo{}
+		if err := proto.Unmarshal([]byte(values[i]), info); err != nil {
+			log.Warn("fail to unmarshal the channel watch info", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if _, ok := mc.collections[info.GetVchan().GetCollectionID()]; ok {
+			continue
+		}
+		mc.addFinding(&mckFinding{
+			Component:  mckComponentDataCoord,
+			Kind:       mckOrphanChannel,
+			Key:        key,
+			Detail:     fmt.Sprintf("channel %s refers to collection %d which doesn't exist", info.GetVchan().GetChannelName(), info.GetVchan().GetCollectionID()),
+			Repairable: true,
+			keys:       []string{key},
+		})
+	}
+	return nil
+}
+
+func (mc *metaChecker) checkIndexCoord(ctx context.Context) error {
+	catalog, err := newKvMetaCatalog(mc.metaKV, mc.rootPath)
+	if err != nil {
+		return err
+	}
+	indexes, err := catalog.indexCoord.ListIndexes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list indexes: %w", err)
+	}
+	for _, index := range indexes {
+		if _, ok := mc.collections[index.CollectionID]; ok {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d/%d", util.FieldIndexPrefix, index.CollectionID, index.IndexID)
+		mc.addFinding(&mckFinding{
+			Component:  mckComponentIndexCoord,
+			Kind:       mckOrphanIndex,
+			Key:        key,
+			Detail:     fmt.Sprintf("index %d refers to collection %d which doesn't exist", index.IndexID, index.CollectionID),
+			Repairable: true,
+			keys:       []string{key},
+		})
+	}
+
+	segIndexes, err := catalog.indexCoord.ListSegmentIndexes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list segment indexes: %w", err)
+	}
+	for _, segIdx := range segIndexes {
+		if _, ok := mc.segments[segIdx.SegmentID]; !ok {
+			key := fmt.Sprintf("%s/%d/%d/%d/%d", util.SegmentIndexPrefix, segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.BuildID)
+			mc.addFinding(&mckFinding{
+				Component:  mckComponentIndexCoord,
+				Kind:       mckOrphanSegmentIndex,
+				Key:        key,
+				Detail:     fmt.Sprintf("segment index %d refers to segment %d which doesn't exist", segIdx.BuildID, segIdx.SegmentID),
+				Repairable: true,
+				keys:       []string{key},
+			})
+			continue
+		}
+		if segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_Finished {
+			continue
+		}
+		for _, filePath := range segIdx.IndexFilePaths {
+			if err := mc.checkFile(ctx, mckComponentIndexCoord, mckMissingIndexFile, filePath,
+				fmt.Sprintf("index file of segment %d build %d", segIdx.SegmentID, segIdx.BuildID)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (mc *metaChecker) checkQueryCoord(ctx context.Context) error {
+	store := meta.NewMetaStore(mc.metaKV)
+	collections, err := store.GetCollections()
+	if err != nil {
+		return fmt.Errorf("failed to list collection load infos: %w", err)
+	}
+	for _, info := range collections {
+		if _, ok := mc.collections[info.GetCollectionID()]; ok {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", meta.CollectionLoadInfoPrefix, info.GetCollectionID())
+		mc.addFinding(&mckFinding{
+			Component:  mckComponentQueryCoord,
+			Kind:       mckOrphanCollectionLoadInfo,
+			Key:        key,
+			Detail:     fmt.Sprintf("load info of collection %d which doesn't exist", info.GetCollectionID()),
+			Repairable: true,
+			keys:       []string{key},
+		})
+	}
+
+	partitions, err := store.GetPartitions()
+	if err != nil {
+		return fmt.Errorf("failed to list partition load infos: %w", err)
+	}
+	for collectionID, infos := range partitions {
+		for _, info := range infos {
+			if mc.hasPartition(collectionID, info.GetPartitionID()) {
+				continue
+			}
+			key := fmt.Sprintf("%s/%d/%d", meta.PartitionLoadInfoPrefix, collectionID, info.GetPartitionID())
+			mc.addFinding(&mckFinding{
+				Component:  mckComponentQueryCoord,
+				Kind:       mckOrphanPartitionLoadInfo,
+				Key:        key,
+				Detail:     fmt.Sprintf("load info of partition %d of collection %d which doesn't exist", info.GetPartitionID(), collectionID),
+				Repairable: true,
+				keys:       []string{key},
+			})
+		}
+	}
+
+	replicas, err := store.GetReplicas()
+	if err != nil {
+		return fmt.Errorf("failed to list replicas: %w", err)
+	}
+	for _, replica := range replicas {
+		if _, ok := mc.collections[replica.GetCollectionID()]; ok {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d/%d", meta.ReplicaPrefix, replica.GetCollectionID(), replica.GetID())
+		mc.addFinding(&mckFinding{
+			Component:  mckComponentQueryCoord,
+			Kind:       mckOrphanReplica,
+			Key:        key,
+			Detail:     fmt.Sprintf("replica %d of collection %d which doesn't exist", replica.GetID(), replica.GetCollectionID()),
+			Repairable: true,
+			keys:       []string{key},
+		})
+	}
+
+	keys, values, err := mc.loadWithPrefix(util.HandoffSegmentPrefix)
+	if err != nil {
+		return fmt.Errorf("failed to list handoff events: %w", err)
+	}
+	for i, key := range keys {
+		info := &querypb.SegmentInfo{}
+		if err := proto.Unmarshal([]byte(values[i]), info); err != nil {
+			log.Warn("fail to unmarshal the handoff event", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if _, ok := mc.segments[info.GetSegmentID()]; ok {
+			continue
+		}
+		mc.addFinding(&mckFinding{
+			Component:  mckComponentQueryCoord,
+			Kind:       mckOrphanHandoffEvent,
+			Key:        key,
+			Detail:     fmt.Sprintf("handoff event of segment %d which doesn't exist", info.GetSegmentID()),
+			Repairable: true,
+			keys:       []string{key},
+		})
+	}
+	return nil
+}
+
+func (mc *metaChecker) checkFile(ctx context.Context, component, kind, filePath, detail string) error {
+	if mc.chunkManager == nil {
+		return nil
+	}
+	ok, err := mc.chunkManager.Exist(ctx, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to check file %s: %w", filePath, err)
+	}
+	if !ok {
+		mc.addFinding(&mckFinding{
+			Component: component,
+			Kind:      kind,
+			Key:       filePath,
+			Detail:    fmt.Sprintf("%s doesn't exist in the object store", detail),
+		})
+	}
+	return nil
+}
+
+// ownedLogPaths returns the log paths of the segment, the logs shared from other segments are left to their owners.
+func (mc *metaChecker) ownedLogPaths(segment *datapb.SegmentInfo) []string {
+	var paths []string
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				owner, err := storage.ParseSegmentIDByBinlog(mc.chunkManagerRootPath, binlog.GetLogPath())
+				if err == nil && owner == segment.GetID() {
+					paths = append(paths, binlog.GetLogPath())
+				}
+			}
+		}
+	}
+	return lo.Uniq(paths)
+}
+
+// loadFindingKvs loads the existing keys of the finding.
+func (mc *metaChecker) loadFindingKvs(finding *mckFinding) (map[string]string, error) {
+	kvs := make(map[string]string)
+	for _, key := range finding.keys {
+		value, err := mc.metaKV.Load(key)
+		if common.IsKeyNotExistError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		kvs[key] = value
+	}
+	for _, prefix := range finding.prefixes {
+		keys, values, err := mc.loadWithPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		for i := range keys {
+			kvs[keys[i]] = values[i]
+		}
+	}
+	return kvs, nil
+}
+
+// repair moves the keys of the repairable findings to the trash, they can be cleaned by 'milvus mck cleanTrash'.
+func (mc *metaChecker) repair() {
+	for _, finding := range mc.report.Findings {
+		if !finding.Repairable {
+			continue
+		}
+		kvs, err := mc.loadFindingKvs(finding)
+		if err != nil {
+			log.Warn("failed to load the inconsistent keys", zap.String("key", finding.Key), zap.Error(err))
+			continue
+		}
+		if len(finding.files) > 0 {
+			if err := mc.chunkManager.MultiRemove(context.Background(), finding.files); err != nil {
+				log.Warn("failed to remove the files of the inconsistent keys", zap.String("key", finding.Key), zap.Error(err))
+				continue
+			}
+		}
+		trash := make(map[string]string, len(kvs))
+		removals := make([]string, 0, len(kvs))
+		for key, value := range kvs {
+			trash[getTrashKey(finding.Kind, key)] = value
+			removals = append(removals, key)
+		}
+		if err := mc.metaKV.MultiSave(trash); err != nil {
+			log.Warn("failed to back up the inconsistent keys", zap.String("key", finding.Key), zap.Error(err))
+			continue
+		}
+		if err := mc.metaKV.MultiRemove(removals); err != nil {
+			log.Warn("failed to remove the inconsistent keys", zap.String("key", finding.Key), zap.Error(err))
+			continue
+		}
+		finding.Repaired = true
+	}
+}
+
+func printMckReport(report *mckReport) {
+	line()
+	if len(report.Findings) == 0 {
+		fmt.Println("No inconsistency found")
+		return
+	}
+	kinds := make([]string, 0, len(report.Summary))
+	for kind := range report.Summary {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		fmt.Printf("%-28s %d\n", kind, report.Summary[kind])
+	}
+	line2()
+	for _, finding := range report.Findings {
+		status := ""
+		if finding.Repaired {
+			status = " [repaired]"
+		}
+		fmt.Printf("[%s] %s: %s%s\n", finding.Component, finding.Kind, finding.Detail, status)
+	}
+}
+
+func writeMckReport(report *mckReport, file string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0644)
+}
@@ -0,0 +1,178 @@
+package milvus
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/api/commonpb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	kvdatacoord "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mckTestChunkRoot    = "files"
+	mckTestChannelWatch = "channelwatch"
+)
+
+func prepareMckMeta(t *testing.T, metaKV *etcdkv.EtcdKV, rootPath string, chunkManager storage.ChunkManager) {
+	ctx := context.TODO()
+	catalog, err := newKvMetaCatalog(metaKV, rootPath)
+	require.NoError(t, err)
+
+	// collection 1 exists, collection 2 is gone.
+	coll := &model.Collection{
+		CollectionID: 1,
+		Name:         "coll",
+		State:        pb.CollectionState_CollectionCreating,
+		Partitions:   []*model.Partition{{PartitionID: 10, PartitionName: "_default", CollectionID: 1, State: pb.PartitionState_PartitionCreated}},
+	}
+	require.NoError(t, catalog.rootCoord.CreateCollection(ctx, coll, 100))
+	require.NoError(t, catalog.rootCoord.CreateAlias(ctx, &model.Alias{Name: "alias", CollectionID: 1, State: pb.AliasState_AliasCreated}, 101))
+	require.NoError(t, catalog.rootCoord.CreateAlias(ctx, &model.Alias{Name: "orphan", CollectionID: 2, State: pb.AliasState_AliasCreated}, 101))
+
+	dataCatalog := &kvdatacoord.Catalog{Txn: metaKV, ChunkManagerRootPath: mckTestChunkRoot}
+	newSegment := func(segmentID, collectionID, partitionID typeutil.UniqueID) *datapb.SegmentInfo {
+		logPath := metautil.BuildInsertLogPath(mckTestChunkRoot, collectionID, partitionID, segmentID, 100, 1)
+		return &datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: logPath}}}},
+		}
+	}
+	healthy := newSegment(100, 1, 10)
+	require.NoError(t, dataCatalog.AddSegment(ctx, healthy))
+	require.NoError(t, chunkManager.Write(ctx, metautil.BuildInsertLogPath(mckTestChunkRoot, 1, 10, 100, 100, 1), []byte("binlog")))
+	// the binlog of segment 101 is missing.
+	require.NoError(t, dataCatalog.AddSegment(ctx, newSegment(101, 1, 10)))
+	// the orphan segment 102 owns its binlog and shares the binlog of segment 100.
+	orphan := newSegment(102, 2, 20)
+	orphan.Binlogs[0].Binlogs = append(orphan.Binlogs[0].Binlogs, healthy.Binlogs[0].Binlogs[0])
+	require.NoError(t, dataCatalog.AddSegment(ctx, orphan))
+	require.NoError(t, chunkManager.Write(ctx, metautil.BuildInsertLogPath(mckTestChunkRoot, 2, 20, 102, 100, 1), []byte("binlog")))
+
+	for collectionID, channel := range map[int64]string{1: "dml_0_1v0", 2: "dml_0_2v0"} {
+		info, err := proto.Marshal(&datapb.ChannelWatchInfo{Vchan: &datapb.VchannelInfo{CollectionID: collectionID, ChannelName: channel}})
+		require.NoError(t, err)
+		require.NoError(t, metaKV.Save(path.Join(mckTestChannelWatch, "1", channel), string(info)))
+	}
+
+	require.NoError(t, catalog.indexCoord.CreateIndex(ctx, &model.Index{CollectionID: 1, FieldID: 101, IndexID: 1000}))
+	require.NoError(t, catalog.indexCoord.CreateIndex(ctx, &model.Index{CollectionID: 2, FieldID: 101, IndexID: 2000}))
+	require.NoError(t, catalog.indexCoord.CreateSegmentIndex(ctx, &model.SegmentIndex{
+		SegmentID: 100, CollectionID: 1, PartitionID: 10, IndexID: 1000, BuildID: 3000,
+		IndexState: commonpb.IndexState_Finished, IndexFilePaths: []string{"files/index/3000/1/file"},
+	}))
+	require.NoError(t, catalog.indexCoord.CreateSegmentIndex(ctx, &model.SegmentIndex{
+		SegmentID: 999, CollectionID: 1, PartitionID: 10, IndexID: 1000, BuildID: 3001,
+	}))
+
+	store := meta.NewMetaStore(metaKV)
+	require.NoError(t, store.SaveCollection(&querypb.CollectionLoadInfo{CollectionID: 1}))
+	require.NoError(t, store.SaveCollection(&querypb.CollectionLoadInfo{CollectionID: 2}))
+	require.NoError(t, store.SavePartition(&querypb.PartitionLoadInfo{CollectionID: 1, PartitionID: 11}))
+	require.NoError(t, store.SaveReplica(&querypb.Replica{CollectionID: 2, ID: 5}))
+	handoff, err := proto.Marshal(&querypb.SegmentInfo{SegmentID: 999, CollectionID: 1, PartitionID: 10})
+	require.NoError(t, err)
+	require.NoError(t, metaKV.Save(fmt.Sprintf("%s/1/10/999", util.HandoffSegmentPrefix), string(handoff)))
+}
+
+func TestMetaChecker(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	require.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := fmt.Sprintf("/test/mck/%d", rand.Int())
+	metaKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	defer metaKV.RemoveWithPrefix("")
+	chunkManager := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	prepareMckMeta(t, metaKV, rootPath, chunkManager)
+
+	checker := newMetaChecker(metaKV, rootPath, chunkManager, mckTestChunkRoot, mckTestChannelWatch)
+	report, err := checker.check(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		mckOrphanAlias:              1,
+		mckOrphanSegment:            1,
+		mckOrphanChannel:            1,
+		mckMissingBinlog:            1,
+		mckOrphanIndex:              1,
+		mckOrphanSegmentIndex:       1,
+		mckMissingIndexFile:         1,
+		mckOrphanCollectionLoadInfo: 1,
+		mckOrphanPartitionLoadInfo:  1,
+		mckOrphanReplica:            1,
+		mckOrphanHandoffEvent:       1,
+	}, report.Summary)
+
+	reportFile := path.Join(t.TempDir(), "report.json")
+	require.NoError(t, writeMckReport(report, reportFile))
+
+	checker.repair()
+	for _, finding := range report.Findings {
+		assert.Equal(t, finding.Repairable, finding.Repaired, finding.Key)
+	}
+	trashKeys, _, err := metaKV.LoadWithPrefix(MckTrash)
+	require.NoError(t, err)
+	assert.Contains(t, trashKeys, metaKV.GetPath(getTrashKey(mckOrphanSegment, fmt.Sprintf("%s/2/20/102", kvdatacoord.SegmentPrefix))))
+	assert.Contains(t, trashKeys, metaKV.GetPath(getTrashKey(mckOrphanSegment, fmt.Sprintf("%s/2/20/102/100", kvdatacoord.SegmentBinlogPathPrefix))))
+
+	// the binlogs of the orphan segment are removed along with its meta, the shared ones are kept.
+	exist, err := chunkManager.Exist(context.TODO(), metautil.BuildInsertLogPath(mckTestChunkRoot, 2, 20, 102, 100, 1))
+	require.NoError(t, err)
+	assert.False(t, exist)
+	exist, err = chunkManager.Exist(context.TODO(), metautil.BuildInsertLogPath(mckTestChunkRoot, 1, 10, 100, 100, 1))
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	// only the findings which can't be repaired are left.
+	report, err = newMetaChecker(metaKV, rootPath, chunkManager, mckTestChunkRoot, mckTestChannelWatch).check(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{mckMissingBinlog: 1, mckMissingIndexFile: 1}, report.Summary)
+}
+
+func TestMetaChecker_OrphanSegmentWithoutChunkManager(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	require.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := fmt.Sprintf("/test/mck/%d", rand.Int())
+	metaKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	defer metaKV.RemoveWithPrefix("")
+	prepareMckMeta(t, metaKV, rootPath, storage.NewLocalChunkManager(storage.RootPath(t.TempDir())))
+
+	// the binlogs of the orphan segments can't be removed, so their meta is kept.
+	checker := newMetaChecker(metaKV, rootPath, nil, mckTestChunkRoot, mckTestChannelWatch)
+	report, err := checker.check(context.TODO())
+	require.NoError(t, err)
+	checker.repair()
+	for _, finding := range report.Findings {
+		if finding.Kind == mckOrphanSegment {
+			assert.False(t, finding.Repaired, finding.Key)
+		}
+	}
+	_, err = metaKV.Load(fmt.Sprintf("%s/2/20/102", kvdatacoord.SegmentPrefix))
+	assert.NoError(t, err)
+}
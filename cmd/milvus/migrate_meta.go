@@ -0,0 +1,504 @@
+package milvus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/api/milvuspb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	dbindexcoord "github.com/milvus-io/milvus/internal/metastore/db/indexcoord"
+	dbrootcoord "github.com/milvus-io/milvus/internal/metastore/db/rootcoord"
+	kvindexcoord "github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	kvrootcoord "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	MigrateMetaCmd = "migrate-meta"
+
+	// the same layout as the rootcoord snapshot kv.
+	migrateSnapshotsSep   = "_ts"
+	migrateSnapshotPrefix = "snapshots"
+)
+
+// the kinds of meta migrated, in the order they're written.
+const (
+	metaKindCollection   = "collection"
+	metaKindPartition    = "partition"
+	metaKindField        = "field"
+	metaKindAlias        = "alias"
+	metaKindIndex        = "index"
+	metaKindSegmentIndex = "segment-index"
+	metaKindCredential   = "credential"
+	metaKindRole         = "role"
+	metaKindUserRole     = "user-role"
+	metaKindGrant        = "grant"
+)
+
+var metaKinds = []string{
+	metaKindCollection, metaKindPartition, metaKindField, metaKindAlias, metaKindIndex,
+	metaKindSegmentIndex, metaKindCredential, metaKindRole, metaKindUserRole, metaKindGrant,
+}
+
+type migrateMeta struct {
+	params paramtable.ComponentParam
+
+	source       string
+	target       string
+	dryRun       bool
+	resume       bool
+	etcdIP       string
+	etcdRootPath string
+}
+
+func (c *migrateMeta) execute(args []string, flags *flag.FlagSet) {
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, migrateMetaLine)
+	}
+	logutil.SetupLogger(&log.Config{
+		Level: "info",
+		File: log.FileLogConfig{
+			Filename: "migrate-meta.log",
+		},
+	})
+
+	c.formatFlags(args, flags)
+	c.params.Init()
+
+	source, err := c.openCatalog(c.source)
+	if err != nil {
+		log.Fatal("failed to open the source meta store", zap.String("type", c.source), zap.Error(err))
+	}
+	target, err := c.openCatalog(c.target)
+	if err != nil {
+		log.Fatal("failed to open the target meta store", zap.String("type", c.target), zap.Error(err))
+	}
+
+	m := &metaMigrator{
+		source: source,
+		target: target,
+		dryRun: c.dryRun,
+		resume: c.resume,
+		out:    os.Stdout,
+	}
+	if err := m.run(context.Background()); err != nil {
+		log.Fatal("failed to migrate meta", zap.Error(err))
+	}
+}
+
+func (c *migrateMeta) formatFlags(args []string, flags *flag.FlagSet) {
+	flags.StringVar(&c.source, "source", util.MetaStoreTypeEtcd, "Meta store to read from")
+	flags.StringVar(&c.target, "target", util.MetaStoreTypeMysql, "Meta store to write to")
+	flags.BoolVar(&c.dryRun, "dryRun", false, "Only read and verify the source meta")
+	flags.BoolVar(&c.resume, "resume", false, "Skip the meta already migrated to the target")
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.etcdRootPath, "etcdRootPath", "", "Etcd root path")
+
+	if err := flags.Parse(args[2:]); err != nil {
+		log.Fatal("failed to parse flags", zap.Error(err))
+	}
+	if c.source == c.target {
+		log.Fatal("the source and the target meta store are the same", zap.String("type", c.source))
+	}
+	if c.source != util.MetaStoreTypeEtcd && c.target != util.MetaStoreTypeEtcd {
+		log.Fatal("migrating between two database meta stores is not supported",
+			zap.String("source", c.source), zap.String("target", c.target))
+	}
+	log.Info("args", zap.Strings("args", args))
+}
+
+func (c *migrateMeta) openCatalog(storeType string) (*metaCatalog, error) {
+	switch storeType {
+	case util.MetaStoreTypeEtcd:
+		var etcdCli *clientv3.Client
+		var err error
+		if c.etcdIP != "" {
+			etcdCli, err = etcd.GetRemoteEtcdClient([]string{c.etcdIP})
+		} else {
+			etcdCli, err = etcd.GetEtcdClient(&c.params.EtcdCfg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rootPath := getConfigValue(c.etcdRootPath, c.params.EtcdCfg.MetaRootPath, "etcd_root_path")
+		return newKvMetaCatalog(etcdkv.NewEtcdKV(etcdCli, rootPath), rootPath)
+	case util.MetaStoreTypeMysql, util.MetaStoreTypeSqlite:
+		// the database config is loaded by the meta store type.
+		if err := c.params.DBCfg.Base.Save("metastore.type", storeType); err != nil {
+			return nil, err
+		}
+		c.params.DBCfg.LoadCfgToMemory()
+		if err := dbcore.Connect(&c.params.DBCfg); err != nil {
+			return nil, err
+		}
+		return newDBMetaCatalog(), nil
+	default:
+		return nil, fmt.Errorf("not supported meta store: %s", storeType)
+	}
+}
+
+// metaCatalog is the pair of catalogs holding the meta to migrate.
+type metaCatalog struct {
+	rootCoord  metastore.RootCoordCatalog
+	indexCoord metastore.IndexCoordCatalog
+}
+
+func newKvMetaCatalog(metaKV *etcdkv.EtcdKV, rootPath string) (*metaCatalog, error) {
+	ss, err := kvrootcoord.NewSuffixSnapshot(metaKV, migrateSnapshotsSep, rootPath, migrateSnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &metaCatalog{
+		rootCoord:  &kvrootcoord.Catalog{Txn: metaKV, Snapshot: ss},
+		indexCoord: &kvindexcoord.Catalog{Txn: metaKV},
+	}, nil
+}
+
+func newDBMetaCatalog() *metaCatalog {
+	return &metaCatalog{
+		rootCoord:  dbrootcoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain()),
+		indexCoord: dbindexcoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain()),
+	}
+}
+
+type userRole struct {
+	user string
+	role string
+}
+
+// metaSnapshot is all the meta read from a catalog.
+type metaSnapshot struct {
+	collections    []*model.Collection
+	aliases        []*model.Alias
+	indexes        []*model.Index
+	segmentIndexes []*model.SegmentIndex
+	credentials    []*model.Credential
+	roles          []string
+	userRoles      []userRole
+	grants         []*milvuspb.GrantEntity
+}
+
+func readMeta(ctx context.Context, catalog *metaCatalog) (*metaSnapshot, error) {
+	var err error
+	s := &metaSnapshot{}
+
+	if s.collections, err = catalog.rootCoord.ListCollections(ctx, typeutil.MaxTimestamp); err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	if s.aliases, err = catalog.rootCoord.ListAliases(ctx, typeutil.MaxTimestamp); err != nil {
+		return nil, fmt.Errorf("failed to list aliases: %w", err)
+	}
+	if s.indexes, err = catalog.indexCoord.ListIndexes(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	if s.segmentIndexes, err = catalog.indexCoord.ListSegmentIndexes(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list segment indexes: %w", err)
+	}
+
+	usernames, err := catalog.rootCoord.ListCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	for _, username := range usernames {
+		credential, err := catalog.rootCoord.GetCredential(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the credential of %s: %w", username, err)
+		}
+		s.credentials = append(s.credentials, credential)
+	}
+
+	roles, err := catalog.rootCoord.ListRole(ctx, util.DefaultTenant, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	for _, role := range roles {
+		roleName := role.GetRole().GetName()
+		s.roles = append(s.roles, roleName)
+		for _, user := range role.GetUsers() {
+			s.userRoles = append(s.userRoles, userRole{user: user.GetName(), role: roleName})
+		}
+		grants, err := catalog.rootCoord.ListGrant(ctx, util.DefaultTenant, &milvuspb.GrantEntity{Role: role.GetRole()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the grants of %s: %w", roleName, err)
+		}
+		s.grants = append(s.grants, grants...)
+	}
+	return s, nil
+}
+
+// metaDigest is a line per entity of each kind, sorted. The key of an entity comes before the first '|',
+// the rest of the line are the attributes kept by all the catalogs.
+type metaDigest map[string][]string
+
+func (s *metaSnapshot) digest() metaDigest {
+	d := metaDigest{}
+	add := func(kind string, format string, args ...interface{}) {
+		d[kind] = append(d[kind], fmt.Sprintf(format, args...))
+	}
+	for _, coll := range s.collections {
+		add(metaKindCollection, "%d|%s|%s|%t|%d|%d|%s|%v|%v",
+			coll.CollectionID, coll.Name, coll.Description, coll.AutoID, coll.ShardsNum, coll.ConsistencyLevel,
+			coll.State, coll.VirtualChannelNames, coll.PhysicalChannelNames)
+		for _, partition := range coll.Partitions {
+			add(metaKindPartition, "%d/%d|%s|%d|%s",
+				coll.CollectionID, partition.PartitionID, partition.PartitionName, partition.PartitionCreatedTimestamp, partition.State)
+		}
+		for _, field := range coll.Fields {
+			add(metaKindField, "%d/%d|%s|%t|%s|%s|%t|%s|%s",
+				coll.CollectionID, field.FieldID, field.Name, field.IsPrimaryKey, field.Description, field.DataType,
+				field.AutoID, funcutil.KeyValuePair2Map(field.TypeParams), funcutil.KeyValuePair2Map(field.IndexParams))
+		}
+	}
+	for _, alias := range s.aliases {
+		add(metaKindAlias, "%s|%d|%s", alias.Name, alias.CollectionID, alias.State)
+	}
+	for _, index := range s.indexes {
+		add(metaKindIndex, "%d/%d|%d|%s|%t|%d|%s|%s",
+			index.CollectionID, index.IndexID, index.FieldID, index.IndexName, index.IsDeleted, index.CreateTime,
+			funcutil.KeyValuePair2Map(index.TypeParams), funcutil.KeyValuePair2Map(index.IndexParams))
+	}
+	for _, segIdx := range s.segmentIndexes {
+		add(metaKindSegmentIndex, "%d|%d/%d/%d/%d|%d|%d|%d|%s|%s|%t|%d|%v|%d",
+			segIdx.BuildID, segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexID, segIdx.NumRows,
+			segIdx.NodeID, segIdx.IndexVersion, segIdx.IndexState, segIdx.FailReason, segIdx.IsDeleted, segIdx.CreateTime,
+			segIdx.IndexFilePaths, segIdx.IndexSize)
+	}
+	for _, credential := range s.credentials {
+		add(metaKindCredential, "%s|%s", credential.Username, credential.EncryptedPassword)
+	}
+	for _, role := range s.roles {
+		add(metaKindRole, "%s|", role)
+	}
+	for _, ur := range s.userRoles {
+		add(metaKindUserRole, "%s/%s|", ur.user, ur.role)
+	}
+	for _, grant := range s.grants {
+		add(metaKindGrant, "%s/%s/%s/%s|%s",
+			grant.GetRole().GetName(), grant.GetObject().GetName(), grant.GetObjectName(),
+			grant.GetGrantor().GetPrivilege().GetName(), grant.GetGrantor().GetUser().GetName())
+	}
+	for _, lines := range d {
+		sort.Strings(lines)
+	}
+	return d
+}
+
+func digestKey(line string) string {
+	return line[:strings.Index(line, "|")]
+}
+
+func (d metaDigest) keys(kind string) map[string]struct{} {
+	keys := make(map[string]struct{}, len(d[kind]))
+	for _, line := range d[kind] {
+		keys[digestKey(line)] = struct{}{}
+	}
+	return keys
+}
+
+// filter returns the lines of the kind whose keys are in the given set.
+func (d metaDigest) filter(kind string, keys map[string]struct{}) []string {
+	var lines []string
+	for _, line := range d[kind] {
+		if _, ok := keys[digestKey(line)]; ok {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func checksum(lines []string) string {
+	h := sha256.New()
+	for _, line := range lines {
+		h.Write([]byte(line))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// metaMigrator copies the meta from the source catalog to the target catalog.
+type metaMigrator struct {
+	source *metaCatalog
+	target *metaCatalog
+	dryRun bool
+	// resume skips the entities already in the target, so that an interrupted migration can be continued.
+	resume bool
+	out    io.Writer
+}
+
+func (m *metaMigrator) run(ctx context.Context) error {
+	src, err := readMeta(ctx, m.source)
+	if err != nil {
+		return fmt.Errorf("failed to read the source meta: %w", err)
+	}
+	dst, err := readMeta(ctx, m.target)
+	if err != nil {
+		return fmt.Errorf("failed to read the target meta: %w", err)
+	}
+	srcDigest, dstDigest := src.digest(), dst.digest()
+
+	fmt.Fprintf(m.out, "%-14s %8s %8s  %s\n", "kind", "source", "existing", "checksum")
+	conflicts := 0
+	for _, kind := range metaKinds {
+		existing := len(dstDigest.filter(kind, srcDigest.keys(kind)))
+		conflicts += existing
+		fmt.Fprintf(m.out, "%-14s %8d %8d  %s\n", kind, len(srcDigest[kind]), existing, checksum(srcDigest[kind]))
+	}
+	if m.dryRun {
+		return nil
+	}
+	if conflicts > 0 && !m.resume {
+		return fmt.Errorf("the target already holds %d of the entities to migrate, use -resume to skip them", conflicts)
+	}
+
+	if err := m.write(ctx, src, dstDigest); err != nil {
+		return err
+	}
+	return m.verify(ctx, srcDigest)
+}
+
+func (m *metaMigrator) write(ctx context.Context, src *metaSnapshot, existing metaDigest) error {
+	keys := make(map[string]map[string]struct{}, len(metaKinds))
+	for _, kind := range metaKinds {
+		keys[kind] = existing.keys(kind)
+	}
+	exists := func(kind string, key string) bool {
+		_, ok := keys[kind][key]
+		return ok
+	}
+
+	for _, coll := range src.collections {
+		if exists(metaKindCollection, fmt.Sprint(coll.CollectionID)) {
+			continue
+		}
+		// collections can only be created in the creating state, then altered to their actual state.
+		creating := coll.Clone()
+		creating.State = pb.CollectionState_CollectionCreating
+		if err := m.target.rootCoord.CreateCollection(ctx, creating, coll.CreateTime); err != nil {
+			return fmt.Errorf("failed to create collection %d: %w", coll.CollectionID, err)
+		}
+		if coll.State != creating.State {
+			if err := m.target.rootCoord.AlterCollection(ctx, creating, coll, metastore.MODIFY, coll.CreateTime); err != nil {
+				return fmt.Errorf("failed to alter the state of collection %d: %w", coll.CollectionID, err)
+			}
+		}
+	}
+	for _, alias := range src.aliases {
+		if exists(metaKindAlias, alias.Name) {
+			continue
+		}
+		if err := m.target.rootCoord.CreateAlias(ctx, alias, alias.CreatedTime); err != nil {
+			return fmt.Errorf("failed to create alias %s: %w", alias.Name, err)
+		}
+	}
+	for _, index := range src.indexes {
+		if exists(metaKindIndex, fmt.Sprintf("%d/%d", index.CollectionID, index.IndexID)) {
+			continue
+		}
+		if err := m.target.indexCoord.CreateIndex(ctx, index); err != nil {
+			return fmt.Errorf("failed to create index %d: %w", index.IndexID, err)
+		}
+	}
+	for _, segIdx := range src.segmentIndexes {
+		if exists(metaKindSegmentIndex, fmt.Sprint(segIdx.BuildID)) {
+			continue
+		}
+		if err := m.target.indexCoord.CreateSegmentIndex(ctx, segIdx); err != nil {
+			return fmt.Errorf("failed to create segment index %d: %w", segIdx.BuildID, err)
+		}
+	}
+	for _, credential := range src.credentials {
+		if exists(metaKindCredential, credential.Username) {
+			continue
+		}
+		if err := m.target.rootCoord.CreateCredential(ctx, credential); err != nil {
+			return fmt.Errorf("failed to create the credential of %s: %w", credential.Username, err)
+		}
+	}
+	for _, role := range src.roles {
+		if exists(metaKindRole, role) {
+			continue
+		}
+		if err := m.target.rootCoord.CreateRole(ctx, util.DefaultTenant, &milvuspb.RoleEntity{Name: role}); err != nil {
+			return fmt.Errorf("failed to create role %s: %w", role, err)
+		}
+	}
+	for _, ur := range src.userRoles {
+		if exists(metaKindUserRole, fmt.Sprintf("%s/%s", ur.user, ur.role)) {
+			continue
+		}
+		err := m.target.rootCoord.AlterUserRole(ctx, util.DefaultTenant, &milvuspb.UserEntity{Name: ur.user},
+			&milvuspb.RoleEntity{Name: ur.role}, milvuspb.OperateUserRoleType_AddUserToRole)
+		if err != nil {
+			return fmt.Errorf("failed to add user %s to role %s: %w", ur.user, ur.role, err)
+		}
+	}
+	for _, grant := range src.grants {
+		privilege := grant.GetGrantor().GetPrivilege().GetName()
+		if exists(metaKindGrant, fmt.Sprintf("%s/%s/%s/%s", grant.GetRole().GetName(), grant.GetObject().GetName(), grant.GetObjectName(), privilege)) {
+			continue
+		}
+		// the catalogs list the privileges by their api names, but store them by their meta names.
+		entity := &milvuspb.GrantEntity{
+			Role:       grant.GetRole(),
+			Object:     grant.GetObject(),
+			ObjectName: grant.GetObjectName(),
+			Grantor: &milvuspb.GrantorEntity{
+				User:      grant.GetGrantor().GetUser(),
+				Privilege: &milvuspb.PrivilegeEntity{Name: privilege},
+			},
+		}
+		if privilege != util.AnyWord {
+			entity.Grantor.Privilege.Name = util.PrivilegeNameForMetastore(privilege)
+		}
+		if err := m.target.rootCoord.AlterGrant(ctx, util.DefaultTenant, entity, milvuspb.OperatePrivilegeType_Grant); err != nil {
+			return fmt.Errorf("failed to grant %s on %s/%s to role %s: %w", privilege, grant.GetObject().GetName(),
+				grant.GetObjectName(), grant.GetRole().GetName(), err)
+		}
+	}
+	return nil
+}
+
+// verify checks that every entity of the source is in the target with the same attributes.
+func (m *metaMigrator) verify(ctx context.Context, srcDigest metaDigest) error {
+	dst, err := readMeta(ctx, m.target)
+	if err != nil {
+		return fmt.Errorf("failed to read the migrated meta: %w", err)
+	}
+	dstDigest := dst.digest()
+
+	var mismatched []string
+	fmt.Fprintf(m.out, "%-14s %8s %8s  %s\n", "kind", "source", "target", "checksum")
+	for _, kind := range metaKinds {
+		migrated := dstDigest.filter(kind, srcDigest.keys(kind))
+		srcChecksum, dstChecksum := checksum(srcDigest[kind]), checksum(migrated)
+		fmt.Fprintf(m.out, "%-14s %8d %8d  %s\n", kind, len(srcDigest[kind]), len(migrated), dstChecksum)
+		if len(migrated) != len(srcDigest[kind]) || srcChecksum != dstChecksum {
+			mismatched = append(mismatched, kind)
+		}
+	}
+	if len(mismatched) > 0 {
+		return fmt.Errorf("the migrated meta mismatches the source: %s", strings.Join(mismatched, ", "))
+	}
+	fmt.Fprintln(m.out, "meta migrated and verified")
+	return nil
+}
@@ -0,0 +1,161 @@
+package milvus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prepareSourceMeta(t *testing.T, catalog *metaCatalog) {
+	ctx := context.TODO()
+	coll := &model.Collection{
+		CollectionID:         1,
+		Name:                 "coll",
+		ShardsNum:            2,
+		VirtualChannelNames:  []string{"dml_0_1v0", "dml_1_1v1"},
+		PhysicalChannelNames: []string{"dml_0", "dml_1"},
+		CreateTime:           100,
+		ConsistencyLevel:     commonpb.ConsistencyLevel_Bounded,
+		State:                pb.CollectionState_CollectionCreating,
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default", PartitionCreatedTimestamp: 100, CollectionID: 1, State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 11, PartitionName: "p1", PartitionCreatedTimestamp: 101, CollectionID: 1, State: pb.PartitionState_PartitionCreated},
+		},
+	}
+	require.NoError(t, catalog.rootCoord.CreateCollection(ctx, coll, coll.CreateTime))
+	created := coll.Clone()
+	created.State = pb.CollectionState_CollectionCreated
+	require.NoError(t, catalog.rootCoord.AlterCollection(ctx, coll, created, metastore.MODIFY, 101))
+	require.NoError(t, catalog.rootCoord.CreateAlias(ctx, &model.Alias{Name: "alias", CollectionID: 1, CreatedTime: 102, State: pb.AliasState_AliasCreated}, 102))
+
+	require.NoError(t, catalog.indexCoord.CreateIndex(ctx, &model.Index{
+		CollectionID: 1,
+		FieldID:      101,
+		IndexID:      1000,
+		IndexName:    "vec_index",
+		CreateTime:   103,
+		IndexParams:  []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	}))
+	require.NoError(t, catalog.indexCoord.CreateSegmentIndex(ctx, &model.SegmentIndex{
+		SegmentID:      2000,
+		CollectionID:   1,
+		PartitionID:    10,
+		NumRows:        1024,
+		IndexID:        1000,
+		BuildID:        3000,
+		IndexVersion:   1,
+		IndexState:     commonpb.IndexState_Finished,
+		CreateTime:     104,
+		IndexFilePaths: []string{"index/3000/1/file"},
+		IndexSize:      4096,
+	}))
+
+	require.NoError(t, catalog.rootCoord.CreateCredential(ctx, &model.Credential{Username: "root", EncryptedPassword: "encrypted"}))
+	require.NoError(t, catalog.rootCoord.CreateRole(ctx, util.DefaultTenant, &milvuspb.RoleEntity{Name: "reader"}))
+	require.NoError(t, catalog.rootCoord.AlterUserRole(ctx, util.DefaultTenant, &milvuspb.UserEntity{Name: "root"},
+		&milvuspb.RoleEntity{Name: "reader"}, milvuspb.OperateUserRoleType_AddUserToRole))
+	require.NoError(t, catalog.rootCoord.AlterGrant(ctx, util.DefaultTenant, &milvuspb.GrantEntity{
+		Role:       &milvuspb.RoleEntity{Name: "reader"},
+		Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+		ObjectName: "coll",
+		Grantor: &milvuspb.GrantorEntity{
+			User:      &milvuspb.UserEntity{Name: "root"},
+			Privilege: &milvuspb.PrivilegeEntity{Name: commonpb.ObjectPrivilege_PrivilegeQuery.String()},
+		},
+	}, milvuspb.OperatePrivilegeType_Grant))
+}
+
+func TestMetaMigrator(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	require.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := fmt.Sprintf("/test/migrate-meta/%d", rand.Int())
+	metaKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	defer metaKV.RemoveWithPrefix("")
+	source, err := newKvMetaCatalog(metaKV, rootPath)
+	require.NoError(t, err)
+	prepareSourceMeta(t, source)
+
+	dbcore.SetGlobalDB(nil)
+	require.NoError(t, dbcore.Connect(&paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: dbcore.SqliteMemoryPath}))
+	target := newDBMetaCatalog()
+	ctx := context.TODO()
+
+	t.Run("dry run", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		m := &metaMigrator{source: source, target: target, dryRun: true, out: out}
+		require.NoError(t, m.run(ctx))
+		assert.Contains(t, out.String(), metaKindSegmentIndex)
+
+		colls, err := target.rootCoord.ListCollections(ctx, typeutil.MaxTimestamp)
+		require.NoError(t, err)
+		assert.Empty(t, colls)
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		m := &metaMigrator{source: source, target: target, out: out}
+		require.NoError(t, m.run(ctx))
+		assert.Contains(t, out.String(), "meta migrated and verified")
+
+		coll, err := target.rootCoord.GetCollectionByName(ctx, "coll", typeutil.MaxTimestamp)
+		require.NoError(t, err)
+		assert.Equal(t, pb.CollectionState_CollectionCreated, coll.State)
+		assert.Len(t, coll.Partitions, 2)
+		assert.Len(t, coll.Fields, 2)
+
+		policies, err := target.rootCoord.ListPolicy(ctx, util.DefaultTenant)
+		require.NoError(t, err)
+		assert.Len(t, policies, 1)
+	})
+
+	t.Run("target not empty", func(t *testing.T) {
+		m := &metaMigrator{source: source, target: target, out: &bytes.Buffer{}}
+		assert.Error(t, m.run(ctx))
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		require.NoError(t, source.rootCoord.CreateRole(ctx, util.DefaultTenant, &milvuspb.RoleEntity{Name: "writer"}))
+
+		out := &bytes.Buffer{}
+		m := &metaMigrator{source: source, target: target, resume: true, out: out}
+		require.NoError(t, m.run(ctx))
+		assert.Contains(t, out.String(), "meta migrated and verified")
+
+		roles, err := target.rootCoord.ListRole(ctx, util.DefaultTenant, nil, false)
+		require.NoError(t, err)
+		assert.Len(t, roles, 2)
+	})
+
+	t.Run("mismatched", func(t *testing.T) {
+		require.NoError(t, target.rootCoord.AlterCredential(ctx, &model.Credential{Username: "root", EncryptedPassword: "changed"}))
+
+		m := &metaMigrator{source: source, target: target, resume: true, out: &bytes.Buffer{}}
+		assert.Error(t, m.run(ctx))
+	})
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode"
 	"github.com/milvus-io/milvus/internal/indexcoord"
 	"github.com/milvus-io/milvus/internal/indexnode"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -50,6 +51,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -353,6 +355,25 @@ func (mr *MilvusRoles) runIndexNode(ctx context.Context, localMsg bool, alias st
 	return in
 }
 
+// initRocksdbMetaKV keeps the meta, id allocators, watches and sessions of all the components in the rocksdb at path
+// instead of etcd, the returned function closes the rocksdb after the components stop.
+func initRocksdbMetaKV(path string) func() {
+	rocksdbKV, err := rocksdbkv.NewRocksdbKV(path)
+	if err != nil {
+		panic(err)
+	}
+	if err := rocksdbkv.SetLocalMetaKV(rocksdbKV); err != nil {
+		panic(err)
+	}
+	sessionutil.SetSessionKV(rocksdbkv.NewLocalMetaKV(""))
+	log.Info("meta is kept in rocksdb", zap.String("path", path))
+	return func() {
+		sessionutil.SetSessionKV(nil)
+		rocksdbkv.SetLocalMetaKV(nil)
+		rocksdbKV.Close()
+	}
+}
+
 // Run Milvus components.
 func (mr *MilvusRoles) Run(local bool, alias string) {
 	log.Info("starting running Milvus components")
@@ -383,6 +404,11 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 			etcd.InitEtcdServer(&Params.EtcdCfg)
 			defer etcd.StopEtcdServer()
 		}
+
+		if Params.EtcdCfg.UseRocksdbMeta {
+			stopMetaKV := initRocksdbMetaKV(Params.EtcdCfg.RocksdbMetaPath)
+			defer stopMetaKV()
+		}
 	} else {
 		if err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode); err != nil {
 			log.Error("Failed to set deploy mode: ", zap.Error(err))
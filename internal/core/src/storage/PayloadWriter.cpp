@@ -66,6 +66,13 @@ PayloadWriter::add_payload(const Payload& raw_data) {
     rows_.fetch_add(raw_data.rows);
 }
 
+void
+PayloadWriter::set_compression(arrow::Compression::type compression, int level) {
+    AssertInfo(output_ == nullptr, "payload writer has been finished");
+    compression_ = compression;
+    compression_level_ = level;
+}
+
 void
 PayloadWriter::finish() {
     AssertInfo(output_ == nullptr, "payload writer has been finished");
@@ -76,9 +83,13 @@ PayloadWriter::finish() {
     auto table = arrow::Table::Make(schema_, {array});
     output_ = std::make_shared<storage::PayloadOutputStream>();
     auto mem_pool = arrow::default_memory_pool();
-    ast = parquet::arrow::WriteTable(
-        *table, mem_pool, output_, 1024 * 1024 * 1024,
-        parquet::WriterProperties::Builder().compression(arrow::Compression::ZSTD)->compression_level(3)->build());
+    parquet::WriterProperties::Builder properties;
+    properties.compression(compression_);
+    if (compression_ == arrow::Compression::ZSTD) {
+        // the other codecs of parquet don't take a compression level
+        properties.compression_level(compression_level_);
+    }
+    ast = parquet::arrow::WriteTable(*table, mem_pool, output_, 1024 * 1024 * 1024, properties.build());
     AssertInfo(ast.ok(), "write data to output stream failed");
 }
 
@@ -49,6 +49,9 @@ class PayloadWriter {
         return rows_;
     }
 
+    void
+    set_compression(arrow::Compression::type compression, int level);
+
  private:
     void
     init_dimension(int dim);
@@ -60,5 +63,7 @@ class PayloadWriter {
     std::shared_ptr<PayloadOutputStream> output_;
     std::atomic<int> rows_ = 0;
     std::optional<int> dimension_;  // binary vector, float vector
+    arrow::Compression::type compression_ = arrow::Compression::ZSTD;
+    int compression_level_ = 3;
 };
 }  // namespace milvus::storage
@@ -138,6 +138,32 @@ AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimensi
     }
 }
 
+extern "C" CStatus
+SetPayloadCompression(CPayloadWriter payloadWriter, PayloadCompression compression, int level) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        switch (compression) {
+            case PayloadUncompressed:
+                p->set_compression(arrow::Compression::UNCOMPRESSED, level);
+                break;
+            case PayloadSnappy:
+                p->set_compression(arrow::Compression::SNAPPY, level);
+                break;
+            case PayloadZstd:
+                p->set_compression(arrow::Compression::ZSTD, level);
+                break;
+            case PayloadLz4:
+                p->set_compression(arrow::Compression::LZ4, level);
+                break;
+            default:
+                return milvus::FailureCStatus(UnexpectedError, "unsupported payload compression");
+        }
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 extern "C" CStatus
 FinishPayloadWriter(CPayloadWriter payloadWriter) {
     try {
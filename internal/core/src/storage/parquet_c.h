@@ -32,6 +32,17 @@ typedef struct CBuffer {
 
 //============= payload writer ======================
 typedef void* CPayloadWriter;
+
+// the codecs to compress the payload
+enum PayloadCompression {
+    PayloadUncompressed = 0,
+    PayloadSnappy = 1,
+    PayloadZstd = 2,
+    PayloadLz4 = 3,
+};
+
+typedef enum PayloadCompression PayloadCompression;
+
 CPayloadWriter
 NewPayloadWriter(int columnType);
 CPayloadWriter
@@ -57,6 +68,8 @@ AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dime
 CStatus
 AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimension, int length);
 
+CStatus
+SetPayloadCompression(CPayloadWriter payloadWriter, PayloadCompression compression, int level);
 CStatus
 FinishPayloadWriter(CPayloadWriter payloadWriter);
 CBuffer
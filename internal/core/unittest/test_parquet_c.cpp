@@ -160,6 +160,39 @@ NUMERIC_TEST(float32, int(milvus::DataType::FLOAT), float, AddFloatToPayload, Ge
 NUMERIC_TEST(
     float64, int(milvus::DataType::DOUBLE), double, AddDoubleToPayload, GetDoubleFromPayload, arrow::DoubleArray)
 
+TEST(storage, compression) {
+    for (auto compression : {PayloadUncompressed, PayloadSnappy, PayloadZstd, PayloadLz4}) {
+        auto payload = NewPayloadWriter(int(milvus::DataType::INT64));
+        int64_t data[] = {1, 2, 3, 4, 5};
+
+        auto st = SetPayloadCompression(payload, compression, 1);
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        st = AddInt64ToPayload(payload, data, 5);
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        st = FinishPayloadWriter(payload);
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        auto cb = GetPayloadBufferFromWriter(payload);
+        ASSERT_GT(cb.length, 0);
+
+        auto reader = NewPayloadReader(int(milvus::DataType::INT64), (uint8_t*)cb.data, cb.length);
+        int64_t* values;
+        int length;
+        st = GetInt64FromPayload(reader, &values, &length);
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        ASSERT_EQ(length, 5);
+        for (int i = 0; i < length; i++) {
+            ASSERT_EQ(data[i], values[i]);
+        }
+
+        // the compression can't be changed after finished
+        st = SetPayloadCompression(payload, PayloadZstd, 3);
+        ASSERT_NE(st.error_code, ErrorCode::Success);
+
+        ReleasePayloadWriter(payload);
+        ReleasePayloadReader(reader);
+    }
+}
+
 TEST(storage, stringarray) {
     auto payload = NewPayloadWriter(int(milvus::DataType::VARCHAR));
     auto st = AddOneStringToPayload(payload, (char*)"1234", 4);
@@ -17,8 +17,11 @@
 package datacoord
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -130,6 +133,16 @@ func (c *channelStateTimer) removeTimers(channels []string) {
 	}
 }
 
+// hasRunningTimers returns whether there are channels waiting for watch or release acks.
+func (c *channelStateTimer) hasRunningTimers() bool {
+	running := false
+	c.runningTimers.Range(func(_, _ interface{}) bool {
+		running = true
+		return false
+	})
+	return running
+}
+
 func (c *channelStateTimer) stopIfExist(e *ackEvent) {
 	stop, ok := c.runningTimers.LoadAndDelete(e.channelName)
 	if ok && e.ackType != watchTimeoutAck && e.ackType != releaseTimeoutAck {
@@ -180,3 +193,179 @@ func getAckType(state datapb.ChannelWatchState) ackType {
 		return invalidAck
 	}
 }
+
+// channelLoads holds the insert throughput of channels and the capacity of datanodes
+// reported by the datanode metrics, which are used to weight channel assignment.
+type channelLoads struct {
+	mu             sync.RWMutex
+	channelRates   map[string]float64
+	nodeCapacities map[int64]float64
+}
+
+func newChannelLoads() *channelLoads {
+	return &channelLoads{
+		channelRates:   make(map[string]float64),
+		nodeCapacities: make(map[int64]float64),
+	}
+}
+
+// update replaces the loads with the latest ones reported by datanodes
+func (l *channelLoads) update(nodeCapacities map[int64]float64, channelRates map[string]float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.nodeCapacities = nodeCapacities
+	l.channelRates = channelRates
+}
+
+// channelWeight returns the insert throughput of the channel. The channels not reported yet,
+// e.g. the new ones, weigh the average of the reported ones, or 1 if there are none.
+func (l *channelLoads) channelWeight(channel string) float64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if rate, ok := l.channelRates[channel]; ok {
+		return rate
+	}
+	if len(l.channelRates) == 0 {
+		return 1
+	}
+	total := float64(0)
+	for _, rate := range l.channelRates {
+		total += rate
+	}
+	if total == 0 {
+		return 1
+	}
+	return total / float64(len(l.channelRates))
+}
+
+// nodeCapacity returns the capacity of the datanode, 1 if it's not reported.
+func (l *channelLoads) nodeCapacity(nodeID int64) float64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if capacity, ok := l.nodeCapacities[nodeID]; ok && capacity > 0 {
+		return capacity
+	}
+	return 1
+}
+
+// channelLoadFetcher fetches the capacity of datanodes and the insert throughput of channels
+type channelLoadFetcher func(ctx context.Context) (map[int64]float64, map[string]float64, error)
+
+// channelBalanceChecker periodically moves a channel from the most loaded datanode, relative to its capacity,
+// when the node exceeds the average load by the tolerance. The channel is released through the ToRelease state
+// and then reassigned by the reassign policy of the channel manager once the datanode acks the release,
+// so at most one channel is moving at a time.
+type channelBalanceChecker struct {
+	cm        *ChannelManager
+	loads     *channelLoads
+	fetch     channelLoadFetcher
+	interval  time.Duration
+	tolerance float64
+}
+
+func newChannelBalanceChecker(cm *ChannelManager, loads *channelLoads, fetch channelLoadFetcher,
+	interval time.Duration, tolerance float64) *channelBalanceChecker {
+	return &channelBalanceChecker{
+		cm:        cm,
+		loads:     loads,
+		fetch:     fetch,
+		interval:  interval,
+		tolerance: tolerance,
+	}
+}
+
+func (b *channelBalanceChecker) run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("channel balance checker quit")
+			return
+		case <-ticker.C:
+			b.check(ctx)
+		}
+	}
+}
+
+func (b *channelBalanceChecker) check(ctx context.Context) {
+	capacities, rates, err := b.fetch(ctx)
+	if err != nil {
+		log.Warn("failed to fetch channel loads", zap.Error(err))
+		return
+	}
+	b.loads.update(capacities, rates)
+
+	if b.cm.stateTimer.hasRunningTimers() {
+		log.Info("channels are being watched or released, skip balancing")
+		return
+	}
+	nodeID, channelName, ok := pickChannelToBalance(b.loads, b.cm.GetChannels(), b.tolerance)
+	if !ok {
+		return
+	}
+	log.Info("balance channel", zap.Int64("nodeID", nodeID), zap.String("channel name", channelName))
+	if err := b.cm.Release(nodeID, channelName); err != nil {
+		log.Warn("failed to release channel for balance", zap.Int64("nodeID", nodeID),
+			zap.String("channel name", channelName), zap.Error(err))
+	}
+}
+
+// pickChannelToBalance picks a channel of the most loaded node to move. The channel is the one that lowers
+// the maximum load of the source and the target node the most, where the target is chosen the same way
+// as WeightedReassignPolicy does.
+func pickChannelToBalance(loads *channelLoads, nodes []*NodeChannelInfo, tolerance float64) (int64, string, bool) {
+	if len(nodes) < 2 {
+		return 0, "", false
+	}
+
+	nodeLoads := make(map[int64]float64, len(nodes))
+	nodeIDs := make([]int64, 0, len(nodes))
+	var totalWeight, totalCapacity float64
+	for _, info := range nodes {
+		nodeIDs = append(nodeIDs, info.NodeID)
+		nodeLoads[info.NodeID] = 0
+		for _, ch := range info.Channels {
+			nodeLoads[info.NodeID] += loads.channelWeight(ch.Name)
+		}
+		totalWeight += nodeLoads[info.NodeID]
+		totalCapacity += loads.nodeCapacity(info.NodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	var source *NodeChannelInfo
+	maxLoad := float64(0)
+	for _, info := range nodes {
+		load := nodeLoads[info.NodeID] / loads.nodeCapacity(info.NodeID)
+		if source == nil || load > maxLoad || (load == maxLoad && info.NodeID < source.NodeID) {
+			source, maxLoad = info, load
+		}
+	}
+	if maxLoad <= totalWeight/totalCapacity*(1+tolerance) {
+		return 0, "", false
+	}
+
+	targets := make([]int64, 0, len(nodeIDs)-1)
+	for _, id := range nodeIDs {
+		if id != source.NodeID {
+			targets = append(targets, id)
+		}
+	}
+
+	picked := ""
+	bestLoad := maxLoad
+	for _, ch := range source.Channels {
+		w := loads.channelWeight(ch.Name)
+		target := lightestNode(loads, targets, nodeLoads, w)
+		sourceLoad := (nodeLoads[source.NodeID] - w) / loads.nodeCapacity(source.NodeID)
+		targetLoad := (nodeLoads[target] + w) / loads.nodeCapacity(target)
+		load := math.Max(sourceLoad, targetLoad)
+		if load < bestLoad || (load == bestLoad && picked != "" && ch.Name < picked) {
+			picked, bestLoad = ch.Name, load
+		}
+	}
+	if picked == "" {
+		return 0, "", false
+	}
+	return source.NodeID, picked, true
+}
@@ -243,3 +243,66 @@ func TestChannelStateTimer_parses(t *testing.T) {
 
 	})
 }
+
+func TestChannelStateTimer_hasRunningTimers(t *testing.T) {
+	timer := newChannelStateTimer(nil)
+	assert.False(t, timer.hasRunningTimers())
+
+	timer.runningTimers.Store("channel-1", make(chan struct{}))
+	assert.True(t, timer.hasRunningTimers())
+
+	timer.removeTimers([]string{"channel-1"})
+	assert.False(t, timer.hasRunningTimers())
+}
+
+func TestPickChannelToBalance(t *testing.T) {
+	loads := newChannelLoads()
+	loads.update(map[int64]float64{}, map[string]float64{"chan1": 30, "chan2": 10, "chan3": 20, "chan4": 5})
+
+	t.Run("test single node", func(t *testing.T) {
+		_, _, ok := pickChannelToBalance(loads, []*NodeChannelInfo{
+			{1, []*channel{{Name: "chan1"}, {Name: "chan2"}}},
+		}, 0.2)
+		assert.False(t, ok)
+	})
+
+	t.Run("test within tolerance", func(t *testing.T) {
+		_, _, ok := pickChannelToBalance(loads, []*NodeChannelInfo{
+			{1, []*channel{{Name: "chan1"}}},
+			{2, []*channel{{Name: "chan3"}, {Name: "chan4"}}},
+		}, 0.2)
+		assert.False(t, ok)
+	})
+
+	t.Run("test move the channel lowering the max load most", func(t *testing.T) {
+		nodeID, channelName, ok := pickChannelToBalance(loads, []*NodeChannelInfo{
+			{1, []*channel{{Name: "chan1"}, {Name: "chan2"}, {Name: "chan3"}}},
+			{2, []*channel{{Name: "chan4"}}},
+		}, 0.2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), nodeID)
+		assert.Equal(t, "chan1", channelName)
+	})
+
+	t.Run("test no move lowers the max load", func(t *testing.T) {
+		loads := newChannelLoads()
+		loads.update(map[int64]float64{1: 2, 2: 1}, map[string]float64{"chan1": 30, "chan2": 10, "chan3": 20})
+		_, _, ok := pickChannelToBalance(loads, []*NodeChannelInfo{
+			{1, []*channel{{Name: "chan1"}, {Name: "chan3"}}},
+			{2, []*channel{{Name: "chan2"}}},
+		}, 0.2)
+		assert.False(t, ok)
+	})
+
+	t.Run("test capacity aware", func(t *testing.T) {
+		loads := newChannelLoads()
+		loads.update(map[int64]float64{1: 1, 2: 4}, map[string]float64{"chan1": 30, "chan2": 10, "chan3": 20})
+		nodeID, channelName, ok := pickChannelToBalance(loads, []*NodeChannelInfo{
+			{1, []*channel{{Name: "chan1"}, {Name: "chan3"}}},
+			{2, []*channel{{Name: "chan2"}}},
+		}, 0.2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), nodeID)
+		assert.Equal(t, "chan1", channelName)
+	})
+}
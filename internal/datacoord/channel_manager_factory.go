@@ -21,6 +21,13 @@ import (
 	"stathat.com/c/consistent"
 )
 
+const (
+	// AverageChannelBalancePolicy assigns the same number of channels to each datanode
+	AverageChannelBalancePolicy = "average"
+	// WeightedChannelBalancePolicy assigns channels by their insert throughput and the capacity of datanodes
+	WeightedChannelBalancePolicy = "weighted"
+)
+
 // ChannelPolicyFactory is the abstract factory that creates policies for channel manager.
 type ChannelPolicyFactory interface {
 	// NewRegisterPolicy creates a new register policy.
@@ -106,3 +113,40 @@ func (f *ConsistentHashChannelPolicyFactory) NewReassignPolicy() ChannelReassign
 func (f *ConsistentHashChannelPolicyFactory) NewBgChecker() ChannelBGChecker {
 	return EmptyBgChecker
 }
+
+// WeightedChannelPolicyFactory assigns channels by their insert throughput and the capacity of datanodes
+type WeightedChannelPolicyFactory struct {
+	kv    kv.TxnKV
+	loads *channelLoads
+}
+
+// NewWeightedChannelPolicyFactory creates a weighted channel policy factory from kv and the channel loads.
+func NewWeightedChannelPolicyFactory(kv kv.TxnKV, loads *channelLoads) *WeightedChannelPolicyFactory {
+	return &WeightedChannelPolicyFactory{kv: kv, loads: loads}
+}
+
+// NewRegisterPolicy implementing ChannelPolicyFactory returns BufferChannelAssignPolicy,
+// channels are moved to the new node by the channel balance checker.
+func (f *WeightedChannelPolicyFactory) NewRegisterPolicy() RegisterPolicy {
+	return BufferChannelAssignPolicy
+}
+
+// NewDeregisterPolicy implementing ChannelPolicyFactory returns WeightedDeregisterPolicy.
+func (f *WeightedChannelPolicyFactory) NewDeregisterPolicy() DeregisterPolicy {
+	return WeightedDeregisterPolicy(f.loads)
+}
+
+// NewAssignPolicy implementing ChannelPolicyFactory returns WeightedAssignPolicy.
+func (f *WeightedChannelPolicyFactory) NewAssignPolicy() ChannelAssignPolicy {
+	return WeightedAssignPolicy(f.loads)
+}
+
+// NewReassignPolicy implementing ChannelPolicyFactory returns WeightedReassignPolicy.
+func (f *WeightedChannelPolicyFactory) NewReassignPolicy() ChannelReassignPolicy {
+	return WeightedReassignPolicy(f.loads)
+}
+
+// NewBgChecker implementing ChannelPolicyFactory
+func (f *WeightedChannelPolicyFactory) NewBgChecker() ChannelBGChecker {
+	return BgCheckWithMaxWatchDuration(f.kv)
+}
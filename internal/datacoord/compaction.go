@@ -229,6 +229,10 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
@@ -238,6 +242,11 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
 		c.flushCh <- result.GetSegmentID()
 	}
+	if c.plans[planID].plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		for _, segment := range result.GetClusteredSegments() {
+			c.flushCh <- segment.GetSegmentID()
+		}
+	}
 	// TODO: when to clean task list
 
 	nodeID := c.plans[planID].dataNodeID
@@ -281,6 +290,64 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return nil
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	oldSegments, modSegments, newSegments := c.meta.GetCompleteClusteringCompactionMeta(plan.GetSegmentBinlogs(), result)
+	log := log.With(zap.Int64("planID", plan.GetPlanID()))
+
+	modInfos := make([]*datapb.SegmentInfo, len(modSegments))
+	for i := range modSegments {
+		modInfos[i] = modSegments[i].SegmentInfo
+	}
+	newInfos := make([]*datapb.SegmentInfo, len(newSegments))
+	for i := range newSegments {
+		newInfos[i] = newSegments[i].SegmentInfo
+	}
+
+	// reference the shared deltalogs before the segments are visible, so that GC never sees them unreferenced
+	for _, segment := range newInfos {
+		c.segRefer.AddSharedReference(segment.GetSharedFrom()...)
+	}
+	releaseSharedReference := func() {
+		for _, segment := range newInfos {
+			c.segRefer.ReleaseSharedReference(segment.GetSharedFrom()...)
+		}
+	}
+
+	log.Debug("handleClusteringCompactionResult: altering metastore after compaction")
+	if err := c.meta.alterMetaStoreAfterClusteringCompaction(modInfos, newInfos); err != nil {
+		log.Warn("handleClusteringCompactionResult: fail to alter metastore after compaction", zap.Error(err))
+		releaseSharedReference()
+		// the segments are altered in batches, revert the batches already saved
+		if err := c.meta.revertAlterMetaStoreAfterClusteringCompaction(oldSegments, newInfos); err != nil {
+			log.Warn("handleClusteringCompactionResult: fail to revert metastore", zap.Error(err))
+		}
+		return fmt.Errorf("fail to alter metastore after compaction, err=%w", err)
+	}
+
+	var nodeID = c.plans[plan.GetPlanID()].dataNodeID
+	compactedFrom := make([]int64, 0, len(modSegments))
+	for _, s := range modSegments {
+		compactedFrom = append(compactedFrom, s.GetID())
+	}
+	req := &datapb.SyncSegmentsRequest{
+		PlanID:            plan.PlanID,
+		CompactedFrom:     compactedFrom,
+		ClusteredSegments: result.GetClusteredSegments(),
+	}
+
+	log.Debug("handleClusteringCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID))
+	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+		log.Warn("handleClusteringCompactionResult: fail to sync segments with node, reverting metastore",
+			zap.Int64("nodeID", nodeID), zap.String("reason", err.Error()))
+		releaseSharedReference()
+		return c.meta.revertAlterMetaStoreAfterClusteringCompaction(oldSegments, newInfos)
+	}
+
+	c.meta.alterInMemoryMetaAfterClusteringCompaction(newSegments, modSegments)
+	log.Info("handleClusteringCompactionResult: success to handle clustering compaction result")
+	return nil
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
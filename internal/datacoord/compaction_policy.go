@@ -0,0 +1,273 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// GreedyCompactionPolicy merges segments greedily by size, it's the default policy
+	GreedyCompactionPolicy = "greedy"
+	// TimeWindowCompactionPolicy only merges segments whose timestamps fall in the same time window
+	TimeWindowCompactionPolicy = "timeWindow"
+)
+
+// compactionPolicy decides which flushed segments of a channel-partition are compacted together
+type compactionPolicy interface {
+	// generatePlans returns the compaction plans of the segments, plan ID and timeout are filled by the trigger
+	generatePlans(segments []*SegmentInfo, force bool, compactTime *compactTime) []*datapb.CompactionPlan
+}
+
+// getCompactionPolicy returns the compaction policy configured for the collection, the default policy is used
+// if the collection has no specific one.
+func (t *compactionTrigger) getCompactionPolicy(collectionID UniqueID) compactionPolicy {
+	policy := Params.DataCoordCfg.CompactionPolicy
+	if collMeta := t.meta.GetCollection(collectionID); collMeta != nil {
+		if p, ok := Params.DataCoordCfg.CollectionCompactionPolicies[collMeta.GetSchema().GetName()]; ok {
+			policy = p
+		}
+	}
+
+	switch policy {
+	case TimeWindowCompactionPolicy:
+		if Params.DataCoordCfg.CompactionTimeWindow > 0 {
+			return newTimeWindowCompactionPolicy(t, Params.DataCoordCfg.CompactionTimeWindow)
+		}
+		log.Warn("invalid compaction time window, use greedy compaction policy", zap.Int64("collectionID", collectionID),
+			zap.Duration("window", Params.DataCoordCfg.CompactionTimeWindow))
+		return newGreedyCompactionPolicy(t)
+	default:
+		return newGreedyCompactionPolicy(t)
+	}
+}
+
+// greedyCompactionPolicy merges the segments to compact and the small segments to fill each target segment
+type greedyCompactionPolicy struct {
+	trigger *compactionTrigger
+}
+
+var _ compactionPolicy = (*greedyCompactionPolicy)(nil)
+
+func newGreedyCompactionPolicy(trigger *compactionTrigger) *greedyCompactionPolicy {
+	return &greedyCompactionPolicy{trigger: trigger}
+}
+
+func (p *greedyCompactionPolicy) generatePlans(segments []*SegmentInfo, force bool, compactTime *compactTime) []*datapb.CompactionPlan {
+	// find segments need internal compaction
+	// TODO add low priority candidates, for example if the segment is smaller than full 0.9 * max segment size but larger than small segment boundary, we only execute compaction when there are no compaction running actively
+	var prioritizedCandidates []*SegmentInfo
+	var smallCandidates []*SegmentInfo
+
+	// TODO, currently we lack of the measurement of data distribution, there should be another compaction help on redistributing segment based on scalar/vector field distribution
+	for _, segment := range segments {
+		segment := segment.ShadowClone()
+		// TODO should we trigger compaction periodically even if the segment has no obvious reason to be compacted?
+		if force || p.trigger.ShouldDoSingleCompaction(segment, compactTime) {
+			prioritizedCandidates = append(prioritizedCandidates, segment)
+		} else if p.trigger.isSmallSegment(segment) {
+			smallCandidates = append(smallCandidates, segment)
+		}
+	}
+
+	var plans []*datapb.CompactionPlan
+	// sort segment from large to small
+	sort.Slice(prioritizedCandidates, func(i, j int) bool {
+		if prioritizedCandidates[i].GetNumOfRows() != prioritizedCandidates[j].GetNumOfRows() {
+			return prioritizedCandidates[i].GetNumOfRows() > prioritizedCandidates[j].GetNumOfRows()
+		}
+		return prioritizedCandidates[i].GetID() < prioritizedCandidates[j].GetID()
+	})
+
+	sort.Slice(smallCandidates, func(i, j int) bool {
+		if smallCandidates[i].GetNumOfRows() != smallCandidates[j].GetNumOfRows() {
+			return smallCandidates[i].GetNumOfRows() > smallCandidates[j].GetNumOfRows()
+		}
+		return smallCandidates[i].GetID() < smallCandidates[j].GetID()
+	})
+
+	// greedy pick from large segment to small, the goal is to fill each segment to reach 512M
+	// we must ensure all prioritized candidates is in a plan
+	//TODO the compaction policy should consider segment with similar timestamp together so timetravel and data expiration could work better.
+	//TODO the compaction selection policy should consider if compaction workload is high
+	for len(prioritizedCandidates) > 0 {
+		var bucket []*SegmentInfo
+		// pop out the first element
+		segment := prioritizedCandidates[0]
+		bucket = append(bucket, segment)
+		prioritizedCandidates = prioritizedCandidates[1:]
+
+		// only do single file compaction if segment is already large enough
+		if segment.GetNumOfRows() < segment.GetMaxRowNum() {
+			var result []*SegmentInfo
+			free := segment.GetMaxRowNum() - segment.GetNumOfRows()
+			maxNum := Params.DataCoordCfg.MaxSegmentToMerge - 1
+			prioritizedCandidates, result, free = greedySelect(prioritizedCandidates, free, maxNum)
+			bucket = append(bucket, result...)
+			maxNum -= len(result)
+			if maxNum > 0 {
+				smallCandidates, result, _ = greedySelect(smallCandidates, free, maxNum)
+				bucket = append(bucket, result...)
+			}
+		}
+		// since this is priority compaction, we will execute even if there is only segment
+		plan := segmentsToPlan(bucket, compactTime)
+		var size int64
+		var row int64
+		for _, s := range bucket {
+			size += s.getSegmentSize()
+			row += s.GetNumOfRows()
+		}
+		log.Info("generate a plan for priority candidates", zap.Any("plan", plan),
+			zap.Int64("target segment row", row), zap.Int64("target segment size", size))
+		plans = append(plans, plan)
+	}
+
+	// check if there are small candidates left can be merged into large segments
+	for len(smallCandidates) > 0 {
+		var bucket []*SegmentInfo
+		// pop out the first element
+		segment := smallCandidates[0]
+		bucket = append(bucket, segment)
+		smallCandidates = smallCandidates[1:]
+
+		var result []*SegmentInfo
+		free := segment.GetMaxRowNum() - segment.GetNumOfRows()
+		// for small segment merge, we pick one largest segment and merge as much as small segment together with it
+		// Why reverse?	 try to merge as many segments as expected.
+		// for instance, if a 255M and 255M is the largest small candidates, they will never be merged because of the MinSegmentToMerge limit.
+		smallCandidates, result, _ = reverseGreedySelect(smallCandidates, free, Params.DataCoordCfg.MaxSegmentToMerge-1)
+		bucket = append(bucket, result...)
+
+		var size int64
+		var targetRow int64
+		for _, s := range bucket {
+			size += s.getSegmentSize()
+			targetRow += s.GetNumOfRows()
+		}
+		// only merge if candidate number is large than MinSegmentToMerge or if target row is large enough
+		if len(bucket) >= Params.DataCoordCfg.MinSegmentToMerge || targetRow > int64(float64(segment.GetMaxRowNum())*Params.DataCoordCfg.SegmentSmallProportion) {
+			plan := segmentsToPlan(bucket, compactTime)
+			log.Info("generate a plan for small candidates", zap.Any("plan", plan),
+				zap.Int64("target segment row", targetRow), zap.Int64("target segment size", size))
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans
+}
+
+// timeWindowCompactionPolicy groups segments by the time window their timestamps fall in, and merges the segments
+// of each window with the greedy policy. Segments are never merged across windows, so that the data of an
+// append-only time series collection stays sorted by time and expires segment by segment.
+type timeWindowCompactionPolicy struct {
+	greedy *greedyCompactionPolicy
+	window time.Duration
+}
+
+var _ compactionPolicy = (*timeWindowCompactionPolicy)(nil)
+
+func newTimeWindowCompactionPolicy(trigger *compactionTrigger, window time.Duration) *timeWindowCompactionPolicy {
+	return &timeWindowCompactionPolicy{
+		greedy: newGreedyCompactionPolicy(trigger),
+		window: window,
+	}
+}
+
+func (p *timeWindowCompactionPolicy) generatePlans(segments []*SegmentInfo, force bool, compactTime *compactTime) []*datapb.CompactionPlan {
+	windows, crossWindowSegments := p.splitByWindow(segments)
+
+	var plans []*datapb.CompactionPlan
+	for _, window := range windows {
+		plans = append(plans, p.greedy.generatePlans(window, force, compactTime)...)
+	}
+
+	// segments spanning several windows can't be merged with any others, but they still need single compaction
+	// to clear the deleted and expired entities
+	for _, segment := range crossWindowSegments {
+		segment := segment.ShadowClone()
+		if force || p.greedy.trigger.ShouldDoSingleCompaction(segment, compactTime) {
+			plan := segmentsToPlan([]*SegmentInfo{segment}, compactTime)
+			log.Info("generate a plan for cross time window segment", zap.Any("plan", plan))
+			plans = append(plans, plan)
+		}
+	}
+	return plans
+}
+
+// splitByWindow groups the segments by time window in the order of the windows,
+// and returns the segments spanning several windows separately.
+func (p *timeWindowCompactionPolicy) splitByWindow(segments []*SegmentInfo) ([][]*SegmentInfo, []*SegmentInfo) {
+	windows := make(map[int64][]*SegmentInfo)
+	var crossWindowSegments []*SegmentInfo
+	for _, segment := range segments {
+		window, ok := p.getWindow(segment)
+		if !ok {
+			crossWindowSegments = append(crossWindowSegments, segment)
+			continue
+		}
+		windows[window] = append(windows[window], segment)
+	}
+
+	keys := make([]int64, 0, len(windows))
+	for window := range windows {
+		keys = append(keys, window)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	groups := make([][]*SegmentInfo, 0, len(keys))
+	for _, window := range keys {
+		groups = append(groups, windows[window])
+	}
+	return groups, crossWindowSegments
+}
+
+// getWindow returns the index of the time window the timestamps of the segment's binlogs fall in,
+// returns false if the timestamps span several windows or are unknown.
+func (p *timeWindowCompactionPolicy) getWindow(segment *SegmentInfo) (int64, bool) {
+	var minTs, maxTs Timestamp
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampFrom() == 0 || binlog.GetTimestampTo() == 0 {
+				return 0, false
+			}
+			if minTs == 0 || binlog.GetTimestampFrom() < minTs {
+				minTs = binlog.GetTimestampFrom()
+			}
+			if binlog.GetTimestampTo() > maxTs {
+				maxTs = binlog.GetTimestampTo()
+			}
+		}
+	}
+	if minTs == 0 {
+		return 0, false
+	}
+
+	minPhysical, _ := tsoutil.ParseHybridTs(minTs)
+	maxPhysical, _ := tsoutil.ParseHybridTs(maxTs)
+	windowMs := p.window.Milliseconds()
+	if minPhysical/windowMs != maxPhysical/windowMs {
+		return 0, false
+	}
+	return minPhysical / windowMs, true
+}
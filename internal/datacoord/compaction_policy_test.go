@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getCompactionPolicy(t *testing.T) {
+	Params.Init()
+	defer func() {
+		Params.DataCoordCfg.CollectionCompactionPolicies = map[string]string{}
+	}()
+
+	tr := &compactionTrigger{
+		meta: &meta{
+			collections: map[int64]*datapb.CollectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{Name: "metrics"}},
+				2: {ID: 2, Schema: &schemapb.CollectionSchema{Name: "logs"}},
+			},
+		},
+	}
+	Params.DataCoordCfg.CollectionCompactionPolicies = map[string]string{"metrics": TimeWindowCompactionPolicy}
+
+	assert.IsType(t, &timeWindowCompactionPolicy{}, tr.getCompactionPolicy(1))
+	assert.IsType(t, &greedyCompactionPolicy{}, tr.getCompactionPolicy(2))
+	assert.IsType(t, &greedyCompactionPolicy{}, tr.getCompactionPolicy(3))
+}
+
+func Test_timeWindowCompactionPolicy(t *testing.T) {
+	Params.Init()
+
+	window := time.Hour
+	base := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+	newSegment := func(id int64, from, to time.Time) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  1,
+				PartitionID:   1,
+				NumOfRows:     100,
+				MaxRowNum:     10000,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Flushed,
+				Binlogs: []*datapb.FieldBinlog{
+					{
+						Binlogs: []*datapb.Binlog{
+							{
+								EntriesNum:    100,
+								LogPath:       fmt.Sprintf("log%d", id),
+								LogSize:       100,
+								TimestampFrom: tsoutil.ComposeTSByTime(from, 0),
+								TimestampTo:   tsoutil.ComposeTSByTime(to, 0),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	segments := []*SegmentInfo{
+		newSegment(1, base, base.Add(10*time.Minute)),
+		newSegment(2, base.Add(10*time.Minute), base.Add(20*time.Minute)),
+		newSegment(3, base.Add(20*time.Minute), base.Add(30*time.Minute)),
+		newSegment(4, base.Add(time.Hour), base.Add(time.Hour+10*time.Minute)),
+		newSegment(5, base.Add(time.Hour+10*time.Minute), base.Add(time.Hour+20*time.Minute)),
+		// spans two windows
+		newSegment(6, base.Add(50*time.Minute), base.Add(70*time.Minute)),
+	}
+
+	policy := newTimeWindowCompactionPolicy(&compactionTrigger{}, window)
+	t.Run("get window", func(t *testing.T) {
+		w1, ok := policy.getWindow(segments[0])
+		assert.True(t, ok)
+		w2, ok := policy.getWindow(segments[3])
+		assert.True(t, ok)
+		assert.Equal(t, w1+1, w2)
+
+		_, ok = policy.getWindow(segments[5])
+		assert.False(t, ok)
+		_, ok = policy.getWindow(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{}})
+		assert.False(t, ok)
+	})
+
+	t.Run("never merge across windows", func(t *testing.T) {
+		plans := policy.generatePlans(segments, true, &compactTime{travelTime: 200})
+		assert.Equal(t, 3, len(plans))
+
+		groups := make([][]int64, 0, len(plans))
+		for _, plan := range plans {
+			var ids []int64
+			for _, s := range plan.GetSegmentBinlogs() {
+				ids = append(ids, s.GetSegmentID())
+			}
+			groups = append(groups, ids)
+		}
+		assert.ElementsMatch(t, []int64{1, 2, 3}, groups[0])
+		assert.ElementsMatch(t, []int64{4, 5}, groups[1])
+		assert.ElementsMatch(t, []int64{6}, groups[2])
+	})
+
+	t.Run("compact the compacted segment again", func(t *testing.T) {
+		m := &meta{segments: NewSegmentsInfo()}
+		for _, segment := range segments {
+			m.segments.SetSegment(segment.GetID(), segment)
+		}
+		plans := policy.generatePlans(segments, true, &compactTime{travelTime: 200})
+		require.Equal(t, 3, len(plans))
+
+		// the compacted binlogs keep the time range of the rows like the datanode uploads them
+		_, _, compacted := m.GetCompleteCompactionMeta(plans[0].GetSegmentBinlogs(), &datapb.CompactionResult{
+			SegmentID: 10,
+			NumOfRows: 300,
+			InsertLogs: []*datapb.FieldBinlog{
+				{
+					Binlogs: []*datapb.Binlog{
+						{
+							EntriesNum:    300,
+							LogPath:       "log10",
+							LogSize:       300,
+							TimestampFrom: tsoutil.ComposeTSByTime(base, 0),
+							TimestampTo:   tsoutil.ComposeTSByTime(base.Add(30*time.Minute), 0),
+						},
+					},
+				},
+			},
+		})
+		compacted.State = commonpb.SegmentState_Flushed
+		w, ok := policy.getWindow(compacted)
+		assert.True(t, ok)
+		w1, _ := policy.getWindow(segments[0])
+		assert.Equal(t, w1, w)
+
+		secondRound := []*SegmentInfo{
+			compacted,
+			segments[3],
+			segments[4],
+			segments[5],
+			newSegment(7, base.Add(40*time.Minute), base.Add(45*time.Minute)),
+			newSegment(8, base.Add(45*time.Minute), base.Add(50*time.Minute)),
+		}
+		plans = policy.generatePlans(secondRound, true, &compactTime{travelTime: 200})
+		assert.Equal(t, 3, len(plans))
+		var ids []int64
+		for _, s := range plans[0].GetSegmentBinlogs() {
+			ids = append(ids, s.GetSegmentID())
+		}
+		assert.ElementsMatch(t, []int64{10, 7, 8}, ids)
+	})
+
+	t.Run("greedy merges across windows", func(t *testing.T) {
+		plans := newGreedyCompactionPolicy(&compactionTrigger{}).generatePlans(segments, true, &compactTime{travelTime: 200})
+		assert.Equal(t, 1, len(plans))
+		assert.Equal(t, len(segments), len(plans[0].GetSegmentBinlogs()))
+	})
+}
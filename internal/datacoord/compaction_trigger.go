@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,10 +28,12 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -48,27 +51,63 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, compactTime *compactTime) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64, compactTime *compactTime) (UniqueID, error)
+	// forceTriggerTargetedCompaction force to start a compaction on the target segments
+	forceTriggerTargetedCompaction(collectionID int64, target *compactionTarget, compactTime *compactTime) (UniqueID, error)
 }
 
 type compactionSignal struct {
 	id           UniqueID
 	isForce      bool
 	isGlobal     bool
+	isClustering bool
 	collectionID UniqueID
 	partitionID  UniqueID
 	segmentID    UniqueID
 	channel      string
 	compactTime  *compactTime
+	target       *compactionTarget // nil means all the segments of the collection
 }
 
 var _ trigger = (*compactionTrigger)(nil)
 
+// compactionTarget narrows down the segments of a manual compaction
+type compactionTarget struct {
+	partitionIDs     typeutil.UniqueSet
+	segmentIDs       typeutil.UniqueSet
+	targetSegmentNum int64
+}
+
+func newCompactionTarget(partitionIDs, segmentIDs []UniqueID, targetSegmentNum int64) *compactionTarget {
+	return &compactionTarget{
+		partitionIDs:     typeutil.NewUniqueSet(partitionIDs...),
+		segmentIDs:       typeutil.NewUniqueSet(segmentIDs...),
+		targetSegmentNum: targetSegmentNum,
+	}
+}
+
+// contain returns whether the segment is selected, a nil target selects all the segments
+func (t *compactionTarget) contain(segment *SegmentInfo) bool {
+	if t == nil {
+		return true
+	}
+	return (t.partitionIDs.Len() == 0 || t.partitionIDs.Contain(segment.GetPartitionID())) &&
+		(t.segmentIDs.Len() == 0 || t.segmentIDs.Contain(segment.GetID()))
+}
+
+func (t *compactionTarget) getTargetSegmentNum() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.targetSegmentNum
+}
+
 type compactionTrigger struct {
 	meta                      *meta
 	allocator                 allocator
 	signals                   chan *compactionSignal
 	compactionHandler         compactionPlanContext
 	globalTrigger             *time.Ticker
+	clusteringTrigger         *time.Ticker
 	forceMu                   sync.Mutex
 	quit                      chan struct{}
 	wg                        sync.WaitGroup
@@ -98,7 +137,8 @@ func newCompactionTrigger(
 func (t *compactionTrigger) start() {
 	t.quit = make(chan struct{})
 	t.globalTrigger = time.NewTicker(Params.DataCoordCfg.GlobalCompactionInterval)
-	t.wg.Add(2)
+	t.clusteringTrigger = time.NewTicker(Params.DataCoordCfg.ClusteringCompactionInterval)
+	t.wg.Add(3)
 	go func() {
 		defer logutil.LogPanic()
 		defer t.wg.Done()
@@ -110,6 +150,8 @@ func (t *compactionTrigger) start() {
 				return
 			case signal := <-t.signals:
 				switch {
+				case signal.isClustering:
+					t.handleClusteringSignal(signal)
 				case signal.isGlobal:
 					t.handleGlobalSignal(signal)
 				default:
@@ -122,6 +164,7 @@ func (t *compactionTrigger) start() {
 	}()
 
 	go t.startGlobalCompactionLoop()
+	go t.startClusteringCompactionLoop()
 }
 
 func (t *compactionTrigger) startGlobalCompactionLoop() {
@@ -156,6 +199,38 @@ func (t *compactionTrigger) startGlobalCompactionLoop() {
 	}
 }
 
+func (t *compactionTrigger) startClusteringCompactionLoop() {
+	defer logutil.LogPanic()
+	defer t.wg.Done()
+
+	// If AutoCompaction disabled or no clustering field configured, clustering loop will not start
+	if !Params.DataCoordCfg.GetEnableAutoCompaction() || len(Params.DataCoordCfg.ClusteringCompactionFields) == 0 {
+		return
+	}
+
+	for {
+		select {
+		case <-t.quit:
+			t.clusteringTrigger.Stop()
+			log.Info("clustering compaction loop exit")
+			return
+		case <-t.clusteringTrigger.C:
+			cctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			ct, err := GetCompactTime(cctx, t.allocator)
+			if err != nil {
+				log.Warn("unbale to get compaction time", zap.Error(err))
+				cancel()
+				continue
+			}
+			cancel()
+			err = t.triggerClusteringCompaction(ct)
+			if err != nil {
+				log.Warn("unable to triggerClusteringCompaction", zap.Error(err))
+			}
+		}
+	}
+}
+
 func (t *compactionTrigger) stop() {
 	close(t.quit)
 	t.wg.Wait()
@@ -177,6 +252,23 @@ func (t *compactionTrigger) triggerCompaction(compactTime *compactTime) error {
 	return nil
 }
 
+// triggerClusteringCompaction triggers a clustering compaction on the collections with a clustering field.
+func (t *compactionTrigger) triggerClusteringCompaction(compactTime *compactTime) error {
+	id, err := t.allocSignalID()
+	if err != nil {
+		return err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      false,
+		isGlobal:     true,
+		isClustering: true,
+		compactTime:  compactTime,
+	}
+	t.signals <- signal
+	return nil
+}
+
 // triggerSingleCompaction triger a compaction bundled with collection-partiiton-channel-segment
 func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, compactTime *compactTime) error {
 	// If AutoCompaction diabled, flush request will not trigger compaction
@@ -220,6 +312,79 @@ func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, compactTi
 	return id, nil
 }
 
+// forceTriggerTargetedCompaction force to start a compaction on the segments selected by @target
+// invoked by user `ManualCompaction` operation with partitions or segments specified
+func (t *compactionTrigger) forceTriggerTargetedCompaction(collectionID int64, target *compactionTarget, compactTime *compactTime) (UniqueID, error) {
+	if err := t.validateCompactionTarget(collectionID, target); err != nil {
+		return -1, err
+	}
+	if err := t.validateTargetIndexed(target); err != nil {
+		return -1, err
+	}
+	id, err := t.allocSignalID()
+	if err != nil {
+		return -1, err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      true,
+		isGlobal:     true,
+		collectionID: collectionID,
+		compactTime:  compactTime,
+		target:       target,
+	}
+	t.handleGlobalSignal(signal)
+	return id, nil
+}
+
+// validateCompactionTarget checks all the target segments could be compacted now
+func (t *compactionTrigger) validateCompactionTarget(collectionID int64, target *compactionTarget) error {
+	if target.targetSegmentNum < 0 {
+		return fmt.Errorf("invalid target segment number %d", target.targetSegmentNum)
+	}
+	for segmentID := range target.segmentIDs {
+		segment := t.meta.GetSegment(segmentID)
+		switch {
+		case segment == nil:
+			return fmt.Errorf("segment %d not found", segmentID)
+		case segment.GetCollectionID() != collectionID:
+			return fmt.Errorf("segment %d doesn't belong to collection %d", segmentID, collectionID)
+		case target.partitionIDs.Len() > 0 && !target.partitionIDs.Contain(segment.GetPartitionID()):
+			return fmt.Errorf("segment %d doesn't belong to the target partitions", segmentID)
+		case !isFlush(segment):
+			return fmt.Errorf("segment %d is not flushed, state: %s", segmentID, segment.GetState().String())
+		case segment.isCompacting:
+			return fmt.Errorf("segment %d is being compacted", segmentID)
+		case segment.GetIsImporting():
+			return fmt.Errorf("segment %d is being imported", segmentID)
+		case segment.GetCorrupted():
+			return fmt.Errorf("segment %d is corrupted", segmentID)
+		}
+	}
+	return nil
+}
+
+// validateTargetIndexed checks the target segments are indexed, the segments without index are never compacted
+func (t *compactionTrigger) validateTargetIndexed(target *compactionTarget) error {
+	if target.segmentIDs.Len() == 0 {
+		return nil
+	}
+	segments := make([]*SegmentInfo, 0, target.segmentIDs.Len())
+	for segmentID := range target.segmentIDs {
+		segments = append(segments, t.meta.GetSegment(segmentID))
+	}
+	indexed := typeutil.NewUniqueSet()
+	for _, segment := range FilterInIndexedSegments(t.meta, t.indexCoord, segments...) {
+		indexed.Insert(segment.GetID())
+	}
+	for segmentID := range target.segmentIDs {
+		if !indexed.Contain(segmentID) {
+			return fmt.Errorf("segment %d is not indexed yet", segmentID)
+		}
+	}
+	return nil
+}
+
 func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -282,10 +447,12 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 
 	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
 		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
+			signal.target.contain(segment) &&
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() // not importing now
+			!segment.GetIsImporting() && // not importing now
+			!segment.GetCorrupted() // not corrupted
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 
 	for _, group := range m {
@@ -301,7 +468,12 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			continue
 		}
 
-		plans := t.generatePlans(group.segments, signal.isForce, signal.compactTime)
+		var plans []*datapb.CompactionPlan
+		if signal.target.getTargetSegmentNum() > 0 {
+			plans = segmentsToTargetNumPlans(group.segments, int(signal.target.getTargetSegmentNum()), signal.compactTime)
+		} else {
+			plans = t.generatePlans(group.segments, signal.isForce, signal.compactTime)
+		}
 		for _, plan := range plans {
 			if !signal.isForce && t.compactionHandler.isFull() {
 				log.Warn("compaction plan skipped due to handler full", zap.Int64("collection", signal.collectionID), zap.Int64("planID", plan.PlanID))
@@ -329,6 +501,189 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 	}
 }
 
+// handleClusteringSignal processes clustering compaction signal, the flushed segments of a channel-partition
+// are sorted and split by the clustering field of the collection into segments with disjoint value ranges.
+func (t *compactionTrigger) handleClusteringSignal(signal *compactionSignal) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
+		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
+			isSegmentHealthy(segment) &&
+			isFlush(segment) &&
+			!segment.isCompacting && // not compacting now
+			!segment.GetIsImporting() && // not importing now
+			!segment.GetCorrupted() // not corrupted
+	}) // m is list of chanPartSegments, which is channel-partition organized segments
+
+	for _, group := range m {
+		if t.compactionHandler.isFull() {
+			break
+		}
+
+		fieldID, ok := t.getClusteringFieldID(group.collectionID)
+		if !ok {
+			continue
+		}
+
+		group.segments = FilterInIndexedSegments(t.meta, t.indexCoord, group.segments...)
+		if len(group.segments) == 0 {
+			continue
+		}
+
+		err := t.updateSegmentMaxSize(group.segments)
+		if err != nil {
+			log.Warn("failed to update segment max size,", zap.Error(err))
+			continue
+		}
+
+		plans := t.generateClusteringPlans(group.segments, fieldID, signal.compactTime)
+		for _, plan := range plans {
+			if t.compactionHandler.isFull() {
+				log.Warn("clustering compaction plan skipped due to handler full", zap.Int64("collection", group.collectionID), zap.Int64("planID", plan.PlanID))
+				break
+			}
+			start := time.Now()
+			if err := t.fillOriginPlan(plan); err != nil {
+				log.Warn("failed to fill plan", zap.Error(err))
+				continue
+			}
+			err = t.compactionHandler.execCompactionPlan(signal, plan)
+			if err != nil {
+				log.Warn("failed to execute clustering compaction plan", zap.Int64("collection", group.collectionID), zap.Int64("planID", plan.PlanID), zap.Error(err))
+				continue
+			}
+
+			log.Info("time cost of generating clustering compaction", zap.Int64("planID", plan.PlanID), zap.Any("time cost", time.Since(start).Milliseconds()),
+				zap.Int64("collectionID", group.collectionID), zap.String("channel", group.channelName), zap.Int64("partitionID", group.partitionID),
+				zap.Int64("clustering fieldID", fieldID), zap.Int("segment number", len(plan.GetSegmentBinlogs())))
+		}
+	}
+}
+
+// generateClusteringPlans generates the clustering compaction plans for the segments of a channel-partition.
+// Segments are never clustered across time windows if the collection uses the time window compaction policy.
+func (t *compactionTrigger) generateClusteringPlans(segments []*SegmentInfo, fieldID UniqueID, compactTime *compactTime) []*datapb.CompactionPlan {
+	windows := [][]*SegmentInfo{segments}
+	if policy, ok := t.getCompactionPolicy(segments[0].GetCollectionID()).(*timeWindowCompactionPolicy); ok {
+		// the segments spanning several windows are left unclustered
+		windows, _ = policy.splitByWindow(segments)
+	}
+
+	var plans []*datapb.CompactionPlan
+	for _, window := range windows {
+		for _, bucket := range selectClusteringSegments(window, fieldID, Params.DataCoordCfg.MaxSegmentToMerge) {
+			plans = append(plans, segmentsToClusteringPlan(bucket, fieldID, compactTime))
+		}
+	}
+	return plans
+}
+
+// getClusteringFieldID returns the first configured clustering field the collection has.
+func (t *compactionTrigger) getClusteringFieldID(collectionID UniqueID) (UniqueID, bool) {
+	collMeta := t.meta.GetCollection(collectionID)
+	if collMeta == nil {
+		return 0, false
+	}
+
+	for _, name := range Params.DataCoordCfg.ClusteringCompactionFields {
+		for _, field := range collMeta.GetSchema().GetFields() {
+			if field.GetName() == name && typeutil.IsClusteringFieldType(field.GetDataType()) {
+				return field.GetFieldID(), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// selectClusteringSegments selects the segments to cluster together, at most maxSegments segments a bucket.
+// The segments not clustered on the field yet, e.g. the segments newly flushed or created by mix compaction,
+// are clustered among themselves. The clustered segments are re-clustered only if their value ranges overlap.
+func selectClusteringSegments(segments []*SegmentInfo, fieldID UniqueID, maxSegments int) [][]*SegmentInfo {
+	var unclustered, clustered []*SegmentInfo
+	for _, segment := range segments {
+		clusteringRange := segment.GetClusteringRange()
+		if clusteringRange.GetFieldID() != fieldID {
+			unclustered = append(unclustered, segment)
+		} else if clusteringRange.GetMin() != nil && clusteringRange.GetMax() != nil {
+			clustered = append(clustered, segment)
+		}
+	}
+
+	sort.Slice(unclustered, func(i, j int) bool { return unclustered[i].GetID() < unclustered[j].GetID() })
+	buckets := splitSegments(unclustered, maxSegments)
+
+	sort.Slice(clustered, func(i, j int) bool {
+		if c := compareClusteringValue(clustered[i].GetClusteringRange().GetMin(), clustered[j].GetClusteringRange().GetMin()); c != 0 {
+			return c < 0
+		}
+		return clustered[i].GetID() < clustered[j].GetID()
+	})
+	// sweep the segments sorted by min value, a segment overlaps the previous ones if its min value
+	// is not greater than their max value
+	var overlapping []*SegmentInfo
+	var overlappingMax *planpb.GenericValue
+	appendOverlapping := func() {
+		for _, bucket := range splitSegments(overlapping, maxSegments) {
+			// a single segment has nothing to re-cluster with
+			if len(bucket) > 1 {
+				buckets = append(buckets, bucket)
+			}
+		}
+	}
+	for _, segment := range clustered {
+		clusteringRange := segment.GetClusteringRange()
+		if len(overlapping) > 0 && compareClusteringValue(clusteringRange.GetMin(), overlappingMax) <= 0 {
+			overlapping = append(overlapping, segment)
+			if compareClusteringValue(clusteringRange.GetMax(), overlappingMax) > 0 {
+				overlappingMax = clusteringRange.GetMax()
+			}
+			continue
+		}
+		appendOverlapping()
+		overlapping = []*SegmentInfo{segment}
+		overlappingMax = clusteringRange.GetMax()
+	}
+	appendOverlapping()
+	return buckets
+}
+
+// splitSegments splits the segments into buckets of at most size segments in order.
+func splitSegments(segments []*SegmentInfo, size int) [][]*SegmentInfo {
+	var buckets [][]*SegmentInfo
+	for len(segments) > size {
+		buckets = append(buckets, segments[:size])
+		segments = segments[size:]
+	}
+	if len(segments) > 0 {
+		buckets = append(buckets, segments)
+	}
+	return buckets
+}
+
+// compareClusteringValue compares the values of the clustering field, a and b are of the same type.
+func compareClusteringValue(a, b *planpb.GenericValue) int {
+	switch a.GetVal().(type) {
+	case *planpb.GenericValue_StringVal:
+		return strings.Compare(a.GetStringVal(), b.GetStringVal())
+	case *planpb.GenericValue_FloatVal:
+		switch {
+		case a.GetFloatVal() < b.GetFloatVal():
+			return -1
+		case a.GetFloatVal() > b.GetFloatVal():
+			return 1
+		}
+	default:
+		switch {
+		case a.GetInt64Val() < b.GetInt64Val():
+			return -1
+		case a.GetInt64Val() > b.GetInt64Val():
+			return 1
+		}
+	}
+	return 0
+}
+
 // handleSignal processes segment flush caused partition-chan level compaction signal
 func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	t.forceMu.Lock()
@@ -372,108 +727,12 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	}
 }
 
+// generatePlans generates compaction plans for the segments of a channel-partition with the policy of the collection
 func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, compactTime *compactTime) []*datapb.CompactionPlan {
-	// find segments need internal compaction
-	// TODO add low priority candidates, for example if the segment is smaller than full 0.9 * max segment size but larger than small segment boundary, we only execute compaction when there are no compaction running actively
-	var prioritizedCandidates []*SegmentInfo
-	var smallCandidates []*SegmentInfo
-
-	// TODO, currently we lack of the measurement of data distribution, there should be another compaction help on redistributing segment based on scalar/vector field distribution
-	for _, segment := range segments {
-		segment := segment.ShadowClone()
-		// TODO should we trigger compaction periodically even if the segment has no obvious reason to be compacted?
-		if force || t.ShouldDoSingleCompaction(segment, compactTime) {
-			prioritizedCandidates = append(prioritizedCandidates, segment)
-		} else if t.isSmallSegment(segment) {
-			smallCandidates = append(smallCandidates, segment)
-		}
-	}
-
-	var plans []*datapb.CompactionPlan
-	// sort segment from large to small
-	sort.Slice(prioritizedCandidates, func(i, j int) bool {
-		if prioritizedCandidates[i].GetNumOfRows() != prioritizedCandidates[j].GetNumOfRows() {
-			return prioritizedCandidates[i].GetNumOfRows() > prioritizedCandidates[j].GetNumOfRows()
-		}
-		return prioritizedCandidates[i].GetID() < prioritizedCandidates[j].GetID()
-	})
-
-	sort.Slice(smallCandidates, func(i, j int) bool {
-		if smallCandidates[i].GetNumOfRows() != smallCandidates[j].GetNumOfRows() {
-			return smallCandidates[i].GetNumOfRows() > smallCandidates[j].GetNumOfRows()
-		}
-		return smallCandidates[i].GetID() < smallCandidates[j].GetID()
-	})
-
-	// greedy pick from large segment to small, the goal is to fill each segment to reach 512M
-	// we must ensure all prioritized candidates is in a plan
-	//TODO the compaction policy should consider segment with similar timestamp together so timetravel and data expiration could work better.
-	//TODO the compaction selection policy should consider if compaction workload is high
-	for len(prioritizedCandidates) > 0 {
-		var bucket []*SegmentInfo
-		// pop out the first element
-		segment := prioritizedCandidates[0]
-		bucket = append(bucket, segment)
-		prioritizedCandidates = prioritizedCandidates[1:]
-
-		// only do single file compaction if segment is already large enough
-		if segment.GetNumOfRows() < segment.GetMaxRowNum() {
-			var result []*SegmentInfo
-			free := segment.GetMaxRowNum() - segment.GetNumOfRows()
-			maxNum := Params.DataCoordCfg.MaxSegmentToMerge - 1
-			prioritizedCandidates, result, free = greedySelect(prioritizedCandidates, free, maxNum)
-			bucket = append(bucket, result...)
-			maxNum -= len(result)
-			if maxNum > 0 {
-				smallCandidates, result, _ = greedySelect(smallCandidates, free, maxNum)
-				bucket = append(bucket, result...)
-			}
-		}
-		// since this is priority compaction, we will execute even if there is only segment
-		plan := segmentsToPlan(bucket, compactTime)
-		var size int64
-		var row int64
-		for _, s := range bucket {
-			size += s.getSegmentSize()
-			row += s.GetNumOfRows()
-		}
-		log.Info("generate a plan for priority candidates", zap.Any("plan", plan),
-			zap.Int64("target segment row", row), zap.Int64("target segment size", size))
-		plans = append(plans, plan)
-	}
-
-	// check if there are small candidates left can be merged into large segments
-	for len(smallCandidates) > 0 {
-		var bucket []*SegmentInfo
-		// pop out the first element
-		segment := smallCandidates[0]
-		bucket = append(bucket, segment)
-		smallCandidates = smallCandidates[1:]
-
-		var result []*SegmentInfo
-		free := segment.GetMaxRowNum() - segment.GetNumOfRows()
-		// for small segment merge, we pick one largest segment and merge as much as small segment together with it
-		// Why reverse?	 try to merge as many segments as expected.
-		// for instance, if a 255M and 255M is the largest small candidates, they will never be merged because of the MinSegmentToMerge limit.
-		smallCandidates, result, _ = reverseGreedySelect(smallCandidates, free, Params.DataCoordCfg.MaxSegmentToMerge-1)
-		bucket = append(bucket, result...)
-
-		var size int64
-		var targetRow int64
-		for _, s := range bucket {
-			size += s.getSegmentSize()
-			targetRow += s.GetNumOfRows()
-		}
-		// only merge if candidate number is large than MinSegmentToMerge or if target row is large enough
-		if len(bucket) >= Params.DataCoordCfg.MinSegmentToMerge || targetRow > int64(float64(segment.GetMaxRowNum())*Params.DataCoordCfg.SegmentSmallProportion) {
-			plan := segmentsToPlan(bucket, compactTime)
-			log.Info("generate a plan for small candidates", zap.Any("plan", plan),
-				zap.Int64("target segment row", targetRow), zap.Int64("target segment size", size))
-			plans = append(plans, plan)
-		}
+	if len(segments) == 0 {
+		return nil
 	}
-
-	return plans
+	return t.getCompactionPolicy(segments[0].GetCollectionID()).generatePlans(segments, force, compactTime)
 }
 
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
@@ -496,6 +755,64 @@ func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.C
 	return plan
 }
 
+// segmentsToTargetNumPlans splits the segments into @num plans with balanced number of rows,
+// each plan merges its segments into one segment. A plan never merges more than MaxSegmentToMerge segments
+// or more rows than a segment could hold, more plans are generated than @num if the segments can't fit in.
+func segmentsToTargetNumPlans(segments []*SegmentInfo, num int, compactTime *compactTime) []*datapb.CompactionPlan {
+	if num > len(segments) {
+		num = len(segments)
+	}
+	sorted := make([]*SegmentInfo, len(segments))
+	copy(sorted, segments)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].GetNumOfRows() != sorted[j].GetNumOfRows() {
+			return sorted[i].GetNumOfRows() > sorted[j].GetNumOfRows()
+		}
+		return sorted[i].GetID() < sorted[j].GetID()
+	})
+
+	// put the largest segment left into the bucket with the fewest rows it fits in
+	buckets := make([][]*SegmentInfo, num)
+	rows := make([]int64, num)
+	for _, segment := range sorted {
+		min := -1
+		for i := range buckets {
+			fit := len(buckets[i]) == 0 || (len(buckets[i]) < Params.DataCoordCfg.MaxSegmentToMerge &&
+				rows[i]+segment.GetNumOfRows() <= segment.GetMaxRowNum())
+			if fit && (min < 0 || rows[i] < rows[min]) {
+				min = i
+			}
+		}
+		if min < 0 {
+			buckets = append(buckets, nil)
+			rows = append(rows, 0)
+			min = len(buckets) - 1
+		}
+		buckets[min] = append(buckets[min], segment)
+		rows[min] += segment.GetNumOfRows()
+	}
+	if len(buckets) > num {
+		log.Warn("the segments can't be merged into the target segment number", zap.Int("target segment number", num),
+			zap.Int("segment number", len(buckets)))
+	}
+
+	plans := make([]*datapb.CompactionPlan, 0, len(buckets))
+	for i, bucket := range buckets {
+		plan := segmentsToPlan(bucket, compactTime)
+		log.Info("generate a plan for target segment number", zap.Any("plan", plan), zap.Int64("target segment row", rows[i]))
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+func segmentsToClusteringPlan(segments []*SegmentInfo, fieldID UniqueID, compactTime *compactTime) *datapb.CompactionPlan {
+	plan := segmentsToPlan(segments, compactTime)
+	plan.Type = datapb.CompactionType_ClusteringCompaction
+	plan.ClusteringFieldID = fieldID
+	plan.MaxSegmentRows = segments[0].GetMaxRowNum()
+	return plan
+}
+
 func greedySelect(candidates []*SegmentInfo, free int64, maxSegment int) ([]*SegmentInfo, []*SegmentInfo, int64) {
 	var result []*SegmentInfo
 
@@ -537,7 +854,8 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 			s.GetInsertChannel() != channel ||
 			s.GetPartitionID() != partitionID ||
 			s.isCompacting ||
-			s.GetIsImporting() {
+			s.GetIsImporting() ||
+			s.GetCorrupted() {
 			continue
 		}
 		res = append(res, s)
@@ -25,7 +25,10 @@ import (
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type spyCompactionHandler struct {
@@ -1309,3 +1312,389 @@ func Test_handleSignal(t *testing.T) {
 		got.handleSignal(signal)
 	})
 }
+
+func Test_handleClusteringSignal(t *testing.T) {
+	Params.Init()
+	Params.DataCoordCfg.ClusteringCompactionFields = []string{"created_day", "region"}
+	defer func() {
+		Params.DataCoordCfg.ClusteringCompactionFields = nil
+	}()
+
+	newSegment := func(id int64, clusteringRange *datapb.ClusteringRange) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:              id,
+				CollectionID:    2,
+				PartitionID:     1,
+				NumOfRows:       100,
+				MaxRowNum:       300,
+				InsertChannel:   "ch1",
+				State:           commonpb.SegmentState_Flushed,
+				ClusteringRange: clusteringRange,
+				Binlogs: []*datapb.FieldBinlog{
+					{
+						Binlogs: []*datapb.Binlog{
+							{EntriesNum: 100, LogPath: fmt.Sprintf("log%d", id), LogSize: 100},
+						},
+					},
+				},
+			},
+		}
+	}
+	newRegionRange := func(min, max string) *datapb.ClusteringRange {
+		return &datapb.ClusteringRange{
+			FieldID: 101,
+			Min:     &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: min}},
+			Max:     &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: max}},
+		}
+	}
+	newTrigger := func(segments ...*SegmentInfo) (*compactionTrigger, *spyCompactionHandler) {
+		m := &meta{
+			segments: NewSegmentsInfo(),
+			collections: map[int64]*datapb.CollectionInfo{
+				2: {
+					ID: 2,
+					Schema: &schemapb.CollectionSchema{
+						Fields: []*schemapb.FieldSchema{
+							{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+							{FieldID: 101, Name: "region", DataType: schemapb.DataType_VarChar},
+							{FieldID: 201, Name: "vec", DataType: schemapb.DataType_FloatVector},
+						},
+					},
+				},
+			},
+		}
+		for _, s := range segments {
+			m.segments.SetSegment(s.GetID(), s)
+		}
+		handler := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 1)}
+		tr := newCompactionTrigger(m, handler, newMockAllocator(),
+			&SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}}, newMockIndexCoord())
+		return tr, handler
+	}
+
+	t.Run("cluster unclustered segments", func(t *testing.T) {
+		tr, handler := newTrigger(newSegment(1, nil), newSegment(2, newRegionRange("apac", "east")), newSegment(3, nil))
+		tr.handleClusteringSignal(&compactionSignal{isGlobal: true, isClustering: true, compactTime: &compactTime{travelTime: 200}})
+
+		select {
+		case plan := <-handler.spyChan:
+			assert.Equal(t, datapb.CompactionType_ClusteringCompaction, plan.GetType())
+			assert.Equal(t, int64(101), plan.GetClusteringFieldID())
+			assert.Equal(t, int64(300), plan.GetMaxSegmentRows())
+			assert.Equal(t, "ch1", plan.GetChannel())
+			// the clustered segment is left alone
+			assert.Equal(t, 2, len(plan.GetSegmentBinlogs()))
+			assert.Equal(t, int64(1), plan.GetSegmentBinlogs()[0].GetSegmentID())
+			assert.Equal(t, int64(3), plan.GetSegmentBinlogs()[1].GetSegmentID())
+		default:
+			assert.Fail(t, "we expect a clustering compaction plan")
+		}
+	})
+
+	t.Run("re-cluster overlapping segments", func(t *testing.T) {
+		tr, handler := newTrigger(newSegment(1, newRegionRange("apac", "east")), newSegment(2, newRegionRange("north", "west")),
+			newSegment(3, newRegionRange("east", "north")))
+		tr.handleClusteringSignal(&compactionSignal{isGlobal: true, isClustering: true, compactTime: &compactTime{travelTime: 200}})
+
+		select {
+		case plan := <-handler.spyChan:
+			assert.Equal(t, datapb.CompactionType_ClusteringCompaction, plan.GetType())
+			assert.Equal(t, 3, len(plan.GetSegmentBinlogs()))
+		default:
+			assert.Fail(t, "we expect a clustering compaction plan")
+		}
+	})
+
+	t.Run("segments already clustered", func(t *testing.T) {
+		tr, handler := newTrigger(newSegment(1, newRegionRange("apac", "east")), newSegment(2, newRegionRange("north", "west")),
+			newSegment(3, &datapb.ClusteringRange{FieldID: 101}))
+		tr.handleClusteringSignal(&compactionSignal{isGlobal: true, isClustering: true, compactTime: &compactTime{travelTime: 200}})
+
+		select {
+		case plan := <-handler.spyChan:
+			assert.Fail(t, "we expect no compaction generated", plan)
+		default:
+		}
+	})
+
+	t.Run("no clustering field", func(t *testing.T) {
+		Params.DataCoordCfg.ClusteringCompactionFields = []string{"created_day"}
+		tr, handler := newTrigger(newSegment(1, nil))
+		tr.handleClusteringSignal(&compactionSignal{isGlobal: true, isClustering: true, compactTime: &compactTime{travelTime: 200}})
+
+		select {
+		case plan := <-handler.spyChan:
+			assert.Fail(t, "we expect no compaction generated", plan)
+		default:
+		}
+	})
+}
+
+func Test_selectClusteringSegments(t *testing.T) {
+	newSegment := func(id int64, min, max int64) *SegmentInfo {
+		segment := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: id}}
+		if min <= max {
+			segment.ClusteringRange = &datapb.ClusteringRange{
+				FieldID: 101,
+				Min:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: min}},
+				Max:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: max}},
+			}
+		}
+		return segment
+	}
+	bucketIDs := func(buckets [][]*SegmentInfo) [][]int64 {
+		ids := make([][]int64, 0, len(buckets))
+		for _, bucket := range buckets {
+			var bucketIDs []int64
+			for _, segment := range bucket {
+				bucketIDs = append(bucketIDs, segment.GetID())
+			}
+			ids = append(ids, bucketIDs)
+		}
+		return ids
+	}
+
+	t.Run("cap the unclustered segments", func(t *testing.T) {
+		segments := []*SegmentInfo{newSegment(5, 1, 0), newSegment(1, 1, 0), newSegment(3, 1, 0), newSegment(2, 1, 0), newSegment(4, 1, 0)}
+		buckets := selectClusteringSegments(segments, 101, 2)
+		assert.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, bucketIDs(buckets))
+	})
+
+	t.Run("only overlapping clustered segments", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			newSegment(1, 0, 10),
+			newSegment(2, 11, 20),
+			newSegment(3, 15, 30),
+			newSegment(4, 30, 40),
+			newSegment(5, 50, 60),
+			newSegment(6, 100, 200),
+			newSegment(7, 110, 120),
+		}
+		buckets := selectClusteringSegments(segments, 101, 10)
+		assert.Equal(t, [][]int64{{2, 3, 4}, {6, 7}}, bucketIDs(buckets))
+
+		// a single segment left by the cap has nothing to re-cluster with
+		buckets = selectClusteringSegments(segments, 101, 2)
+		assert.Equal(t, [][]int64{{2, 3}, {6, 7}}, bucketIDs(buckets))
+	})
+
+	t.Run("segments clustered on another field", func(t *testing.T) {
+		segments := []*SegmentInfo{newSegment(1, 0, 10), newSegment(2, 20, 30)}
+		buckets := selectClusteringSegments(segments, 102, 10)
+		assert.Equal(t, [][]int64{{1, 2}}, bucketIDs(buckets))
+	})
+}
+
+func Test_generateClusteringPlans(t *testing.T) {
+	Params.Init()
+	Params.DataCoordCfg.CollectionCompactionPolicies = map[string]string{"metrics": TimeWindowCompactionPolicy}
+	defer func() {
+		Params.DataCoordCfg.CollectionCompactionPolicies = map[string]string{}
+		Params.DataCoordCfg.CompactionTimeWindow = 0
+	}()
+
+	base := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+	newSegment := func(id int64, from, to time.Time) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:           id,
+				CollectionID: 1,
+				MaxRowNum:    300,
+				Binlogs: []*datapb.FieldBinlog{
+					{
+						Binlogs: []*datapb.Binlog{
+							{
+								TimestampFrom: tsoutil.ComposeTSByTime(from, 0),
+								TimestampTo:   tsoutil.ComposeTSByTime(to, 0),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	segments := []*SegmentInfo{
+		newSegment(1, base, base.Add(10*time.Minute)),
+		newSegment(2, base.Add(time.Hour), base.Add(time.Hour+10*time.Minute)),
+		newSegment(3, base.Add(10*time.Minute), base.Add(20*time.Minute)),
+		// spans two windows
+		newSegment(4, base.Add(50*time.Minute), base.Add(70*time.Minute)),
+	}
+	tr := &compactionTrigger{
+		meta: &meta{
+			collections: map[int64]*datapb.CollectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{Name: "metrics"}},
+			},
+		},
+	}
+
+	Params.DataCoordCfg.CompactionTimeWindow = 0
+	plans := tr.generateClusteringPlans(segments, 101, &compactTime{travelTime: 200})
+	require.Equal(t, 1, len(plans))
+	assert.Equal(t, 4, len(plans[0].GetSegmentBinlogs()))
+
+	Params.DataCoordCfg.CompactionTimeWindow = time.Hour
+	plans = tr.generateClusteringPlans(segments, 101, &compactTime{travelTime: 200})
+	require.Equal(t, 2, len(plans))
+	require.Equal(t, 2, len(plans[0].GetSegmentBinlogs()))
+	assert.Equal(t, int64(1), plans[0].GetSegmentBinlogs()[0].GetSegmentID())
+	assert.Equal(t, int64(3), plans[0].GetSegmentBinlogs()[1].GetSegmentID())
+	require.Equal(t, 1, len(plans[1].GetSegmentBinlogs()))
+	assert.Equal(t, int64(2), plans[1].GetSegmentBinlogs()[0].GetSegmentID())
+}
+
+func Test_forceTriggerTargetedCompaction(t *testing.T) {
+	Params.Init()
+
+	newSegment := func(id, partitionID, rows int64, state commonpb.SegmentState) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  2,
+				PartitionID:   partitionID,
+				NumOfRows:     rows,
+				MaxRowNum:     1000,
+				InsertChannel: "ch1",
+				State:         state,
+				Binlogs: []*datapb.FieldBinlog{
+					{
+						Binlogs: []*datapb.Binlog{
+							{EntriesNum: rows, LogPath: fmt.Sprintf("log%d", id), LogSize: 100},
+						},
+					},
+				},
+			},
+		}
+	}
+	newTrigger := func() (*compactionTrigger, *spyCompactionHandler) {
+		m := &meta{
+			segments: NewSegmentsInfo(),
+			collections: map[int64]*datapb.CollectionInfo{
+				2: {
+					ID: 2,
+					Schema: &schemapb.CollectionSchema{
+						Fields: []*schemapb.FieldSchema{
+							{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+							{FieldID: 201, Name: "vec", DataType: schemapb.DataType_FloatVector},
+						},
+					},
+				},
+			},
+		}
+		for _, s := range []*SegmentInfo{
+			newSegment(1, 1, 100, commonpb.SegmentState_Flushed),
+			newSegment(2, 1, 200, commonpb.SegmentState_Flushed),
+			newSegment(3, 1, 300, commonpb.SegmentState_Flushed),
+			newSegment(4, 2, 100, commonpb.SegmentState_Flushed),
+			newSegment(5, 1, 100, commonpb.SegmentState_Growing),
+		} {
+			m.segments.SetSegment(s.GetID(), s)
+		}
+		handler := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 10)}
+		tr := newCompactionTrigger(m, handler, newMockAllocator(),
+			&SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}}, newMockIndexCoord())
+		return tr, handler
+	}
+	collectPlans := func(handler *spyCompactionHandler) [][]int64 {
+		var groups [][]int64
+		for {
+			select {
+			case plan := <-handler.spyChan:
+				var ids []int64
+				for _, s := range plan.GetSegmentBinlogs() {
+					ids = append(ids, s.GetSegmentID())
+				}
+				groups = append(groups, ids)
+			default:
+				return groups
+			}
+		}
+	}
+
+	t.Run("invalid target", func(t *testing.T) {
+		tr, _ := newTrigger()
+		targets := []*compactionTarget{
+			newCompactionTarget(nil, []int64{99}, 0),
+			newCompactionTarget(nil, []int64{5}, 0),
+			newCompactionTarget([]int64{1}, []int64{4}, 0),
+			newCompactionTarget(nil, []int64{1}, -1),
+		}
+		for _, target := range targets {
+			_, err := tr.forceTriggerTargetedCompaction(2, target, &compactTime{travelTime: 200})
+			assert.Error(t, err)
+		}
+		_, err := tr.forceTriggerTargetedCompaction(3, newCompactionTarget(nil, []int64{1}, 0), &compactTime{travelTime: 200})
+		assert.Error(t, err)
+	})
+
+	t.Run("target segment not indexed", func(t *testing.T) {
+		tr, handler := newTrigger()
+		// the mocked index coord only indexes field 201
+		tr.meta.collections[2].Schema.Fields[1].FieldID = 202
+		_, err := tr.forceTriggerTargetedCompaction(2, newCompactionTarget(nil, []int64{1, 2}, 0), &compactTime{travelTime: 200})
+		assert.Error(t, err)
+		assert.Empty(t, collectPlans(handler))
+	})
+
+	t.Run("merge into target segment number", func(t *testing.T) {
+		tr, handler := newTrigger()
+		_, err := tr.forceTriggerTargetedCompaction(2, newCompactionTarget(nil, []int64{1, 2, 3}, 2), &compactTime{travelTime: 200})
+		assert.NoError(t, err)
+
+		groups := collectPlans(handler)
+		assert.Equal(t, 2, len(groups))
+		assert.ElementsMatch(t, [][]int64{{3}, {2, 1}}, groups)
+	})
+
+	t.Run("target partitions", func(t *testing.T) {
+		tr, handler := newTrigger()
+		_, err := tr.forceTriggerTargetedCompaction(2, newCompactionTarget([]int64{2}, nil, 0), &compactTime{travelTime: 200})
+		assert.NoError(t, err)
+
+		groups := collectPlans(handler)
+		assert.Equal(t, [][]int64{{4}}, groups)
+	})
+}
+
+func Test_segmentsToTargetNumPlans(t *testing.T) {
+	Params.Init()
+
+	var segments []*SegmentInfo
+	for i, rows := range []int64{10, 50, 20, 40, 30} {
+		segments = append(segments, &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: int64(i + 1), NumOfRows: rows, MaxRowNum: 100}})
+	}
+
+	plans := segmentsToTargetNumPlans(segments, 2, &compactTime{})
+	assert.Equal(t, 2, len(plans))
+	var total int
+	for _, plan := range plans {
+		total += len(plan.GetSegmentBinlogs())
+	}
+	assert.Equal(t, len(segments), total)
+
+	plans = segmentsToTargetNumPlans(segments, 10, &compactTime{})
+	assert.Equal(t, len(segments), len(plans))
+
+	// 150 rows don't fit in one segment
+	plans = segmentsToTargetNumPlans(segments, 1, &compactTime{})
+	assert.Equal(t, 2, len(plans))
+	for _, plan := range plans {
+		var rows int64
+		for _, s := range plan.GetSegmentBinlogs() {
+			rows += map[int64]int64{1: 10, 2: 50, 3: 20, 4: 40, 5: 30}[s.GetSegmentID()]
+		}
+		assert.LessOrEqual(t, rows, int64(100))
+	}
+
+	maxSegmentToMerge := Params.DataCoordCfg.MaxSegmentToMerge
+	Params.DataCoordCfg.MaxSegmentToMerge = 2
+	defer func() {
+		Params.DataCoordCfg.MaxSegmentToMerge = maxSegmentToMerge
+	}()
+	plans = segmentsToTargetNumPlans(segments, 1, &compactTime{})
+	assert.Equal(t, 3, len(plans))
+	for _, plan := range plans {
+		assert.LessOrEqual(t, len(plan.GetSegmentBinlogs()), 2)
+	}
+}
@@ -32,6 +32,12 @@ var errNilResponse = errors.New("response is nil")
 var errNilStatusResponse = errors.New("response has nil status")
 var errUnknownResponseType = errors.New("unknown response type")
 
+// errSegmentNotFlushed is returned when a segment is expected to be flushed but it's not, e.g. it's dropped
+var errSegmentNotFlushed = errors.New("segment is not flushed")
+
+// ErrManualGCDisabled is returned when the garbage is collected manually but dataCoord.gc.enableManualCollect is off
+var ErrManualGCDisabled = errors.New("manual garbage collection is disabled by dataCoord.gc.enableManualCollect")
+
 func msgDataCoordIsUnhealthy(coordID UniqueID) string {
 	return fmt.Sprintf("DataCoord %d is not ready", coordID)
 }
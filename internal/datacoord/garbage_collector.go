@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"path"
 	"sync"
 	"time"
@@ -47,6 +48,48 @@ type GcOption struct {
 	checkInterval    time.Duration        // each interval
 	missingTolerance time.Duration        // key missing in meta tolerance time
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
+	dryRun           bool                 // only report the garbage files instead of removing them
+	manualCollect    bool                 // allow collecting the garbage files on demand
+}
+
+// GarbageFile is a file found by the garbage collector
+type GarbageFile struct {
+	Path         string    `json:"path"`
+	Size         int64     `json:"size"`
+	SegmentID    int64     `json:"segmentID"`
+	ModifiedTime time.Time `json:"modifiedTime,omitempty"`
+	// Removable is true if the file is beyond the tolerance and could be removed by now
+	Removable bool `json:"removable"`
+}
+
+// GarbageReport lists the garbage files in object storage
+type GarbageReport struct {
+	// OrphanFiles are the files not referenced by any segment, e.g. left by datanode failures
+	OrphanFiles []*GarbageFile `json:"orphanFiles"`
+	// DroppedSegmentFiles are the files of the dropped segments pending removal
+	DroppedSegmentFiles []*GarbageFile `json:"droppedSegmentFiles"`
+	OrphanSize          int64          `json:"orphanSize"`
+	DroppedSegmentSize  int64          `json:"droppedSegmentSize"`
+	TotalSize           int64          `json:"totalSize"`
+}
+
+func (r *GarbageReport) addOrphanFile(f *GarbageFile) {
+	r.OrphanFiles = append(r.OrphanFiles, f)
+	r.OrphanSize += f.Size
+	r.TotalSize += f.Size
+}
+
+func (r *GarbageReport) addDroppedSegmentFile(f *GarbageFile) {
+	r.DroppedSegmentFiles = append(r.DroppedSegmentFiles, f)
+	r.DroppedSegmentSize += f.Size
+	r.TotalSize += f.Size
+}
+
+// droppedSegment is a dropped segment with the binlogs to remove along with it
+type droppedSegment struct {
+	segment   *SegmentInfo
+	logs      []*datapb.Binlog
+	removable bool
 }
 
 // garbageCollector handles garbage files in object storage
@@ -57,6 +100,8 @@ type garbageCollector struct {
 	segRefer   *SegmentReferenceManager
 	indexCoord types.IndexCoord
 
+	// collectMu serializes the periodic collection and the manual one
+	collectMu sync.Mutex
 	startOnce sync.Once
 	stopOnce  sync.Once
 	wg        sync.WaitGroup
@@ -66,7 +111,8 @@ type garbageCollector struct {
 // newGarbageCollector create garbage collector with meta and option
 func newGarbageCollector(meta *meta, segRefer *SegmentReferenceManager, indexCoord types.IndexCoord, opt GcOption) *garbageCollector {
 	log.Info("GC with option", zap.Bool("enabled", opt.enabled), zap.Duration("interval", opt.checkInterval),
-		zap.Duration("missingTolerance", opt.missingTolerance), zap.Duration("dropTolerance", opt.dropTolerance))
+		zap.Duration("missingTolerance", opt.missingTolerance), zap.Duration("dropTolerance", opt.dropTolerance),
+		zap.Bool("dryRun", opt.dryRun))
 	return &garbageCollector{
 		meta:       meta,
 		segRefer:   segRefer,
@@ -97,8 +143,10 @@ func (gc *garbageCollector) work() {
 	for {
 		select {
 		case <-ticker:
+			gc.collectMu.Lock()
 			gc.clearEtcd()
 			gc.scan()
+			gc.collectMu.Unlock()
 		case <-gc.closeCh:
 			log.Warn("garbage collector quit")
 			return
@@ -118,7 +166,40 @@ func (gc *garbageCollector) close() {
 func (gc *garbageCollector) scan() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	var total, valid, missing int
+	gc.clearOrphanFiles(ctx, gc.option.dryRun, false)
+}
+
+// clearOrphanFiles removes the orphan files beyond the missing tolerance, returns the removed files,
+// the sizes of the files are fetched only if withSize is true.
+func (gc *garbageCollector) clearOrphanFiles(ctx context.Context, dryRun bool, withSize bool) []*GarbageFile {
+	files, total, valid := gc.listOrphanFiles(ctx, withSize)
+	var removed []*GarbageFile
+	var removedKeys []string
+	for _, f := range files {
+		if !f.Removable {
+			continue
+		}
+		removed = append(removed, f)
+		removedKeys = append(removedKeys, f.Path)
+		if dryRun {
+			continue
+		}
+		// ignore error since it could be cleaned up next time
+		err := gc.option.cli.Remove(ctx, f.Path)
+		if err != nil {
+			log.Error("failed to remove object", zap.String("infoKey", f.Path), zap.Error(err))
+		}
+	}
+	log.Info("scan file to do garbage collection", zap.Int("total", total),
+		zap.Int("valid", valid), zap.Int("missing", len(files)), zap.Bool("dryRun", dryRun), zap.Strings("removed keys", removedKeys))
+	return removed
+}
+
+// listOrphanFiles walks the binlog prefixes and returns the files not referenced by any segment,
+// as well as the number of all the files and the referenced ones.
+// Fetching the size takes a request per file, so it's done only if withSize is true.
+func (gc *garbageCollector) listOrphanFiles(ctx context.Context, withSize bool) ([]*GarbageFile, int, int) {
+	var total, valid int
 	segmentFiles := gc.meta.ListSegmentFiles()
 	filesMap := make(map[string]struct{})
 	for _, k := range segmentFiles {
@@ -130,7 +211,7 @@ func (gc *garbageCollector) scan() {
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), insertLogPrefix))
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), statsLogPrefix))
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), deltaLogPrefix))
-	var removedKeys []string
+	var files []*GarbageFile
 
 	for _, prefix := range prefixes {
 		infoKeys, modTimes, err := gc.option.cli.ListWithPrefix(ctx, prefix, true)
@@ -154,32 +235,63 @@ func (gc *garbageCollector) scan() {
 				valid++
 				continue
 			}
-			missing++
 			// not found in meta, check last modified time exceeds tolerance duration
-			if err != nil {
-				log.Error("get modified time error", zap.String("infoKey", infoKey))
-				continue
+			f := &GarbageFile{
+				Path:         infoKey,
+				SegmentID:    segmentID,
+				ModifiedTime: modTimes[i],
+				Removable:    time.Since(modTimes[i]) > gc.option.missingTolerance,
 			}
-			if time.Since(modTimes[i]) > gc.option.missingTolerance {
-				// ignore error since it could be cleaned up next time
-				removedKeys = append(removedKeys, infoKey)
-				err = gc.option.cli.Remove(ctx, infoKey)
+			if withSize {
+				f.Size, err = gc.option.cli.Size(ctx, infoKey)
 				if err != nil {
-					log.Error("failed to remove object", zap.String("infoKey", infoKey), zap.Error(err))
+					log.Warn("failed to get object size", zap.String("infoKey", infoKey), zap.Error(err))
 				}
 			}
+			files = append(files, f)
 		}
 	}
-	log.Info("scan file to do garbage collection", zap.Int("total", total),
-		zap.Int("valid", valid), zap.Int("missing", missing), zap.Strings("removed keys", removedKeys))
+	return files, total, valid
 }
 
 func (gc *garbageCollector) clearEtcd() {
+	gc.clearDroppedSegments(gc.option.dryRun)
+}
+
+// clearDroppedSegments removes the files and meta of the dropped segments beyond the drop tolerance,
+// returns the removed files
+func (gc *garbageCollector) clearDroppedSegments(dryRun bool) []*GarbageFile {
+	var removed []*GarbageFile
+	for _, dropped := range gc.listDroppedSegments() {
+		if !dropped.removable {
+			continue
+		}
+		if dryRun {
+			log.Info("dry run, skip removing dropped segment", zap.Int64("segmentID", dropped.segment.GetID()),
+				zap.Int("logs", len(dropped.logs)))
+			removed = append(removed, droppedSegmentFiles(dropped)...)
+			continue
+		}
+		if gc.removeLogs(dropped.logs) {
+			removed = append(removed, droppedSegmentFiles(dropped)...)
+			if err := gc.meta.DropSegment(dropped.segment.GetID()); err == nil {
+				gc.segRefer.ReleaseSharedReference(dropped.segment.GetSharedFrom()...)
+			}
+		}
+	}
+	return removed
+}
+
+// listDroppedSegments returns the dropped segments not referenced by others, a segment is removable
+// if it's beyond the drop tolerance and the segment compacted from it is indexed.
+func (gc *garbageCollector) listDroppedSegments() []*droppedSegment {
 	all := gc.meta.SelectSegments(func(si *SegmentInfo) bool { return true })
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
 	for _, segment := range all {
-		if segment.GetState() == commonpb.SegmentState_Dropped && !gc.segRefer.HasSegmentLock(segment.ID) {
+		// binlogs shared by cloned segments are kept until all the cloned segments are removed
+		if segment.GetState() == commonpb.SegmentState_Dropped && !gc.segRefer.HasSegmentLock(segment.ID) &&
+			!gc.segRefer.HasSharedReference(segment.ID) {
 			drops[segment.GetID()] = segment
 			continue
 		}
@@ -200,20 +312,74 @@ func (gc *garbageCollector) clearEtcd() {
 		indexedSet.Insert(segment.GetID())
 	}
 
+	results := make([]*droppedSegment, 0, len(drops))
 	for _, sinfo := range drops {
-		if !gc.isExpire(sinfo.GetDroppedAt()) {
-			continue
-		}
+		removable := gc.isExpire(sinfo.GetDroppedAt())
 		// For compact A, B -> C, don't GC A or B if C is not indexed,
 		// guarantee replacing A, B with C won't downgrade performance
 		if to, ok := compactTo[sinfo.GetID()]; ok && !indexedSet.Contain(to.GetID()) {
-			continue
+			removable = false
 		}
 		logs := getLogs(sinfo)
-		if gc.removeLogs(logs) {
-			_ = gc.meta.DropSegment(sinfo.GetID())
+		if len(sinfo.GetSharedFrom()) > 0 {
+			logs = gc.ownedLogs(sinfo.GetID(), logs)
+		}
+		results = append(results, &droppedSegment{segment: sinfo, logs: logs, removable: removable})
+	}
+	return results
+}
+
+func droppedSegmentFiles(dropped *droppedSegment) []*GarbageFile {
+	files := make([]*GarbageFile, 0, len(dropped.logs))
+	for _, l := range dropped.logs {
+		files = append(files, &GarbageFile{
+			Path:      l.GetLogPath(),
+			Size:      l.GetLogSize(),
+			SegmentID: dropped.segment.GetID(),
+			Removable: dropped.removable,
+		})
+	}
+	return files
+}
+
+// Report scans the object storage and lists the garbage files without removing anything
+func (gc *garbageCollector) Report(ctx context.Context) (*GarbageReport, error) {
+	if gc.option.cli == nil {
+		return nil, errors.New("garbage collector has no storage client")
+	}
+	report := &GarbageReport{}
+	orphans, _, _ := gc.listOrphanFiles(ctx, true)
+	for _, f := range orphans {
+		report.addOrphanFile(f)
+	}
+	for _, dropped := range gc.listDroppedSegments() {
+		for _, f := range droppedSegmentFiles(dropped) {
+			report.addDroppedSegmentFile(f)
 		}
 	}
+	return report, nil
+}
+
+// Collect removes the garbage files beyond the tolerance right now even in dry run mode,
+// returns the report of the removed files. It waits for the running periodic collection if any.
+func (gc *garbageCollector) Collect(ctx context.Context) (*GarbageReport, error) {
+	if !gc.option.manualCollect {
+		return nil, ErrManualGCDisabled
+	}
+	if gc.option.cli == nil {
+		return nil, errors.New("garbage collector has no storage client")
+	}
+	gc.collectMu.Lock()
+	defer gc.collectMu.Unlock()
+
+	report := &GarbageReport{}
+	for _, f := range gc.clearDroppedSegments(false) {
+		report.addDroppedSegmentFile(f)
+	}
+	for _, f := range gc.clearOrphanFiles(ctx, false, true) {
+		report.addOrphanFile(f)
+	}
+	return report, nil
 }
 
 func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
@@ -237,10 +403,20 @@ func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
 	return logs
 }
 
+// ownedLogs filters out the binlogs shared from other segments, they are removed along with the owner segments.
+func (gc *garbageCollector) ownedLogs(segmentID UniqueID, logs []*datapb.Binlog) []*datapb.Binlog {
+	return lo.Filter(logs, func(l *datapb.Binlog, _ int) bool {
+		owner, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), l.GetLogPath())
+		return err == nil && owner == segmentID
+	})
+}
+
 func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	delFlag := true
+	// the fields of a storage v2 segment file share the same path
+	logs = lo.UniqBy(logs, func(l *datapb.Binlog) string { return l.GetLogPath() })
 	for _, l := range logs {
 		err := gc.option.cli.Remove(ctx, l.GetLogPath())
 		if err != nil {
@@ -257,3 +433,25 @@ func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
 	}
 	return delFlag
 }
+
+// ReportGarbage lists the orphan files and the files of the dropped segments in object storage without removing them.
+func (s *Server) ReportGarbage(ctx context.Context) (*GarbageReport, error) {
+	if s.isClosed() {
+		return nil, errDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+	}
+	return s.garbageCollector.Report(ctx)
+}
+
+// CollectGarbage removes the garbage files beyond the tolerance immediately, even if gc runs in dry run mode.
+func (s *Server) CollectGarbage(ctx context.Context) (*GarbageReport, error) {
+	if s.isClosed() {
+		return nil, errDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+	}
+	report, err := s.garbageCollector.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("garbage collected manually", zap.Int("orphan files", len(report.OrphanFiles)),
+		zap.Int("dropped segment files", len(report.DroppedSegmentFiles)), zap.Int64("total size", report.TotalSize))
+	return report, nil
+}
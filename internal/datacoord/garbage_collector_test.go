@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/stretchr/testify/assert"
@@ -270,6 +271,216 @@ func Test_garbageCollector_scan(t *testing.T) {
 	cleanupOSS(cli.Client, bucketName, rootPath)
 }
 
+func Test_garbageCollector_clearEtcdSharedBinlogs(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cli := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	sourceInsert := metautil.BuildInsertLogPath(rootPath, 10, 100, 1, 101, 1000)
+	sourceDelta := metautil.BuildDeltaLogPath(rootPath, 10, 100, 1, 1001)
+	clonedDelta := metautil.BuildDeltaLogPath(rootPath, 20, 200, 2, 1002)
+	for _, p := range []string{sourceInsert, sourceDelta, clonedDelta} {
+		require.NoError(t, cli.Write(ctx, p, []byte("test")))
+	}
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	droppedAt := uint64(time.Now().Add(-time.Hour).UnixNano())
+	source := buildSegment(10, 100, 1, "ch1", false)
+	source.State = commonpb.SegmentState_Dropped
+	source.DroppedAt = droppedAt
+	source.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(101, sourceInsert)}
+	source.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, sourceDelta)}
+	require.NoError(t, meta.AddSegment(source))
+
+	cloned := buildSegment(20, 200, 2, "ch2", false)
+	cloned.State = commonpb.SegmentState_Dropped
+	cloned.DroppedAt = droppedAt
+	cloned.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(101, sourceInsert)}
+	cloned.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, sourceDelta, clonedDelta)}
+	cloned.SharedFrom = []UniqueID{1}
+	require.NoError(t, meta.AddSegment(cloned))
+
+	segRefer := &SegmentReferenceManager{
+		segmentsLock:    map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{},
+		segmentReferCnt: map[UniqueID]int{},
+		sharedReferCnt:  map[UniqueID]int{},
+	}
+	segRefer.AddSharedReference(cloned.GetSharedFrom()...)
+
+	gc := newGarbageCollector(meta, segRefer, mocks.NewMockIndexCoord(t), GcOption{
+		cli:              cli,
+		enabled:          true,
+		checkInterval:    time.Minute * 30,
+		missingTolerance: time.Hour * 24,
+		dropTolerance:    0,
+	})
+	exist := func(p string) bool {
+		ok, err := cli.Exist(ctx, p)
+		require.NoError(t, err)
+		return ok
+	}
+
+	// the cloned segment only removes its own binlogs, the source is kept since its binlogs are shared
+	gc.clearEtcd()
+	assert.NotNil(t, meta.GetAllSegment(1))
+	assert.Nil(t, meta.GetAllSegment(2))
+	assert.True(t, exist(sourceInsert))
+	assert.True(t, exist(sourceDelta))
+	assert.False(t, exist(clonedDelta))
+	assert.False(t, segRefer.HasSharedReference(1))
+
+	// no reference left, the source is removed
+	gc.clearEtcd()
+	assert.Nil(t, meta.GetAllSegment(1))
+	assert.False(t, exist(sourceInsert))
+	assert.False(t, exist(sourceDelta))
+}
+
+// memChunkManager keeps the files in memory, every file is 4 bytes
+type memChunkManager struct {
+	storage.ChunkManager
+	root      string
+	files     map[string]time.Time
+	sizeCalls int
+}
+
+func (m *memChunkManager) RootPath() string {
+	return m.root
+}
+
+func (m *memChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	for key, modTime := range m.files {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			modTimes = append(modTimes, modTime)
+		}
+	}
+	return keys, modTimes, nil
+}
+
+func (m *memChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	m.sizeCalls++
+	return 4, nil
+}
+
+func (m *memChunkManager) Remove(ctx context.Context, filePath string) error {
+	delete(m.files, filePath)
+	return nil
+}
+
+func Test_garbageCollector_dryRunAndReport(t *testing.T) {
+	ctx := context.Background()
+	rootPath := "files"
+	validInsert := metautil.BuildInsertLogPath(rootPath, 10, 100, 1, 101, 1000)
+	droppedDelta := metautil.BuildDeltaLogPath(rootPath, 10, 100, 2, 1001)
+	orphanInsert := metautil.BuildInsertLogPath(rootPath, 10, 100, 3, 101, 1002)
+	newOrphanStats := metautil.BuildStatsLogPath(rootPath, 10, 100, 4, 101, 1003)
+	cli := &memChunkManager{
+		root: rootPath,
+		files: map[string]time.Time{
+			validInsert:    time.Now().Add(-time.Hour),
+			droppedDelta:   time.Now().Add(-time.Hour),
+			orphanInsert:   time.Now().Add(-time.Hour),
+			newOrphanStats: time.Now(),
+		},
+	}
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	valid := buildSegment(10, 100, 1, "ch1", false)
+	valid.State = commonpb.SegmentState_Flushed
+	valid.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(101, validInsert)}
+	require.NoError(t, meta.AddSegment(valid))
+
+	dropped := buildSegment(10, 100, 2, "ch1", false)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.DroppedAt = uint64(time.Now().Add(-time.Hour).UnixNano())
+	deltalogs := getFieldBinlogPaths(0, droppedDelta)
+	deltalogs.Binlogs[0].LogSize = 4
+	dropped.Deltalogs = []*datapb.FieldBinlog{deltalogs}
+	require.NoError(t, meta.AddSegment(dropped))
+
+	segRefer := &SegmentReferenceManager{
+		segmentsLock:    map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{},
+		segmentReferCnt: map[UniqueID]int{},
+		sharedReferCnt:  map[UniqueID]int{},
+	}
+	gc := newGarbageCollector(meta, segRefer, mocks.NewMockIndexCoord(t), GcOption{
+		cli:              cli,
+		enabled:          true,
+		checkInterval:    time.Minute * 30,
+		missingTolerance: time.Minute,
+		dropTolerance:    0,
+		dryRun:           true,
+	})
+
+	// nothing is removed in dry run mode
+	gc.clearEtcd()
+	gc.scan()
+	assert.NotNil(t, meta.GetAllSegment(2))
+	assert.Equal(t, 4, len(cli.files))
+	// the sizes are only fetched for the reports
+	assert.Equal(t, 0, cli.sizeCalls)
+
+	report, err := gc.Report(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(report.OrphanFiles))
+	orphans := make(map[string]*GarbageFile)
+	for _, f := range report.OrphanFiles {
+		orphans[f.Path] = f
+	}
+	require.Contains(t, orphans, orphanInsert)
+	assert.Equal(t, int64(3), orphans[orphanInsert].SegmentID)
+	assert.True(t, orphans[orphanInsert].Removable)
+	require.Contains(t, orphans, newOrphanStats)
+	assert.False(t, orphans[newOrphanStats].Removable)
+	require.Equal(t, 1, len(report.DroppedSegmentFiles))
+	assert.Equal(t, droppedDelta, report.DroppedSegmentFiles[0].Path)
+	assert.True(t, report.DroppedSegmentFiles[0].Removable)
+	assert.Equal(t, int64(8), report.OrphanSize)
+	assert.Equal(t, int64(4), report.DroppedSegmentSize)
+	assert.Equal(t, int64(12), report.TotalSize)
+
+	// manual collection is disabled by default
+	_, err = gc.Collect(ctx)
+	assert.ErrorIs(t, err, ErrManualGCDisabled)
+	assert.Equal(t, 4, len(cli.files))
+	gc.option.manualCollect = true
+
+	// explicit collection waits for the running periodic collection
+	gc.collectMu.Lock()
+	collected := make(chan struct{})
+	go func() {
+		defer close(collected)
+		report, err = gc.Collect(ctx)
+	}()
+	select {
+	case <-collected:
+		t.Fatal("collected while the periodic collection is running")
+	case <-time.After(50 * time.Millisecond):
+	}
+	gc.collectMu.Unlock()
+	<-collected
+
+	// explicit collection removes the garbage beyond the tolerance even in dry run mode
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.OrphanFiles))
+	assert.Equal(t, orphanInsert, report.OrphanFiles[0].Path)
+	assert.Equal(t, 1, len(report.DroppedSegmentFiles))
+	assert.Equal(t, int64(8), report.TotalSize)
+	assert.Nil(t, meta.GetAllSegment(2))
+	assert.Contains(t, cli.files, validInsert)
+	assert.Contains(t, cli.files, newOrphanStats)
+	assert.NotContains(t, cli.files, droppedDelta)
+	assert.NotContains(t, cli.files, orphanInsert)
+
+	_, err = newGarbageCollector(meta, segRefer, mocks.NewMockIndexCoord(t), GcOption{}).Report(ctx)
+	assert.Error(t, err)
+}
+
 // initialize unit test sso env
 func initUtOSSEnv(bucket, root string, n int) (mcm *storage.MinioChunkManager, inserts []string, stats []string, delta []string, other []string, err error) {
 	Params.Init()
@@ -140,6 +140,13 @@ func (h *ServerHandler) GetVChanPositions(channel *channel, partitionID UniqueID
 		}
 	}
 
+	// all the data consumed before the channel checkpoint is durable,
+	// seek from it if it's ahead to skip replaying the persisted messages
+	channelCP := h.s.meta.GetChannelCheckpoint(channel.Name)
+	if channelCP != nil && (seekPosition == nil || channelCP.GetTimestamp() > seekPosition.GetTimestamp()) {
+		seekPosition = channelCP
+	}
+
 	return &datapb.VchannelInfo{
 		CollectionID:        channel.CollectionID,
 		ChannelName:         channel.Name,
@@ -221,4 +228,7 @@ func (h *ServerHandler) CheckShouldDropChannel(channel string) bool {
 // this function is a wrapper of server.meta.FinishDropChannel
 func (h *ServerHandler) FinishDropChannel(channel string) {
 	h.s.meta.catalog.DropChannel(h.s.ctx, channel)
+	if err := h.s.meta.DropChannelCheckpoint(channel); err != nil {
+		log.Warn("failed to drop channel checkpoint", zap.String("channel", channel), zap.Error(err))
+	}
 }
@@ -20,19 +20,27 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	dbdatacoord "github.com/milvus-io/milvus/internal/metastore/db/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 type meta struct {
@@ -41,15 +49,22 @@ type meta struct {
 	catalog     metastore.DataCoordCatalog
 	collections map[UniqueID]*datapb.CollectionInfo // collection id to collection info
 	segments    *SegmentsInfo                       // segment id to segment info
+	channelCPs  map[string]*internalpb.MsgPosition  // vChannel -> channel checkpoint
 }
 
 // NewMeta creates meta from provided `kv.TxnKV`
 func newMeta(ctx context.Context, kv kv.TxnKV, chunkManagerRootPath string) (*meta, error) {
+	return newMetaWithCatalog(ctx, &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath})
+}
+
+// newMetaWithCatalog creates meta from provided catalog
+func newMetaWithCatalog(ctx context.Context, catalog metastore.DataCoordCatalog) (*meta, error) {
 	mt := &meta{
 		ctx:         ctx,
-		catalog:     &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath},
+		catalog:     catalog,
 		collections: make(map[UniqueID]*datapb.CollectionInfo),
 		segments:    NewSegmentsInfo(),
+		channelCPs:  make(map[string]*internalpb.MsgPosition),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -58,6 +73,22 @@ func newMeta(ctx context.Context, kv kv.TxnKV, chunkManagerRootPath string) (*me
 	return mt, nil
 }
 
+// newCatalog creates the catalog of the meta store selected by `metastore.type`,
+// flushed segments are always notified to IndexCoord through the provided `kv.TxnKV`.
+func newCatalog(kv kv.TxnKV, chunkManagerRootPath string) (metastore.DataCoordCatalog, error) {
+	switch Params.MetaStoreCfg.MetaStoreType {
+	case util.MetaStoreTypeEtcd:
+		return &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath}, nil
+	case util.MetaStoreTypeMysql, util.MetaStoreTypeSqlite:
+		if err := dbcore.Connect(&Params.DBCfg); err != nil {
+			return nil, err
+		}
+		return dbdatacoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), kv), nil
+	default:
+		return nil, retry.Unrecoverable(fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType))
+	}
+}
+
 // reloadFromKV loads meta from KV storage
 func (m *meta) reloadFromKV() error {
 	segments, err := m.catalog.ListSegments(m.ctx)
@@ -81,6 +112,14 @@ func (m *meta) reloadFromKV() error {
 	}
 	metrics.DataCoordNumStoredRows.WithLabelValues().Set(float64(numStoredRows))
 	metrics.DataCoordNumStoredRowsCounter.WithLabelValues().Add(float64(numStoredRows))
+
+	channelCPs, err := m.catalog.ListChannelCheckpoint(m.ctx)
+	if err != nil {
+		return err
+	}
+	for vChannel, pos := range channelCPs {
+		m.channelCPs[vChannel] = pos
+	}
 	return nil
 }
 
@@ -308,14 +347,50 @@ func (m *meta) UnsetIsImporting(segmentID UniqueID) error {
 	return nil
 }
 
+// SetSegmentCorrupted marks a flushed segment corrupted, which is excluded from compaction since then.
+// Returns errSegmentNotFlushed if the segment is no longer flushed and healthy, e.g. it's compacted and dropped
+// while being verified, as its binlogs may be removed by then.
+func (m *meta) SetSegmentCorrupted(segmentID UniqueID) error {
+	log.Info("meta update: marking segment corrupted",
+		zap.Int64("segment ID", segmentID))
+	m.Lock()
+	defer m.Unlock()
+	curSegInfo := m.segments.GetSegment(segmentID)
+	if curSegInfo == nil {
+		return fmt.Errorf("segment not found %d", segmentID)
+	}
+	if curSegInfo.GetCorrupted() {
+		return nil
+	}
+	if !isSegmentHealthy(curSegInfo) || curSegInfo.GetState() != commonpb.SegmentState_Flushed {
+		return fmt.Errorf("%w: segment %d is %s", errSegmentNotFlushed, segmentID, curSegInfo.GetState().String())
+	}
+	// Persist segment updates first.
+	clonedSegment := curSegInfo.Clone(SetCorrupted(true))
+	if err := m.catalog.AlterSegments(m.ctx, []*datapb.SegmentInfo{clonedSegment.SegmentInfo}); err != nil {
+		log.Error("meta update: marking segment corrupted - failed to alter segments",
+			zap.Int64("segment ID", segmentID),
+			zap.Error(err))
+		return err
+	}
+	// Update in-memory meta.
+	m.segments.SetCorrupted(segmentID, true)
+	metrics.DataCoordNumCorruptedSegments.WithLabelValues(strconv.FormatInt(curSegInfo.GetCollectionID(), 10)).Inc()
+	log.Info("meta update: marking segment corrupted - complete",
+		zap.Int64("segment ID", segmentID))
+	return nil
+}
+
 // UpdateFlushSegmentsInfo update segment partial/completed flush info
 // `flushed` parameter indicating whether segment is flushed completely or partially
+// `sorted` parameter indicating whether `binlogs` are sorted by primary key
 // `binlogs`, `checkpoints` and `statPositions` are persistence data for segment
 func (m *meta) UpdateFlushSegmentsInfo(
 	segmentID UniqueID,
 	flushed bool,
 	dropped bool,
 	importing bool,
+	sorted bool,
 	binlogs, statslogs, deltalogs []*datapb.FieldBinlog,
 	checkpoints []*datapb.CheckPoint,
 	startPositions []*datapb.SegmentStartPosition,
@@ -329,7 +404,8 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		zap.Bool("dropped", dropped),
 		zap.Any("check points", checkpoints),
 		zap.Any("start position", startPositions),
-		zap.Bool("importing", importing))
+		zap.Bool("importing", importing),
+		zap.Bool("sorted", sorted))
 	m.Lock()
 	defer m.Unlock()
 
@@ -357,6 +433,10 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		clonedSegment.DroppedAt = uint64(time.Now().UnixNano())
 		modSegments[segmentID] = clonedSegment
 	}
+	// the segment is sorted only if all of its insert binlogs are sorted
+	if getBinlogNum(binlogs) > 0 {
+		clonedSegment.Sorted = sorted && (getBinlogNum(clonedSegment.GetBinlogs()) == 0 || clonedSegment.GetSorted())
+	}
 	// TODO add diff encoding and compression
 	currBinlogs := clonedSegment.GetBinlogs()
 	var getFieldBinlogs = func(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBinlog {
@@ -846,6 +926,106 @@ func (m *meta) GetCompleteCompactionMeta(compactionLogs []*datapb.CompactionSegm
 	m.Lock()
 	defer m.Unlock()
 
+	oldSegments, modSegments, startPosition, dmlPosition, newAddedDeltalogs := m.getCompactedSegments(compactionLogs)
+	deltalogs := append(result.GetDeltalogs(), newAddedDeltalogs...)
+
+	compactionFrom := make([]UniqueID, 0, len(modSegments))
+	for _, s := range modSegments {
+		compactionFrom = append(compactionFrom, s.GetID())
+	}
+
+	segmentInfo := &datapb.SegmentInfo{
+		ID:                  result.GetSegmentID(),
+		CollectionID:        modSegments[0].CollectionID,
+		PartitionID:         modSegments[0].PartitionID,
+		InsertChannel:       modSegments[0].InsertChannel,
+		NumOfRows:           result.NumOfRows,
+		State:               commonpb.SegmentState_Flushing,
+		MaxRowNum:           modSegments[0].MaxRowNum,
+		Binlogs:             result.GetInsertLogs(),
+		Statslogs:           result.GetField2StatslogPaths(),
+		Deltalogs:           deltalogs,
+		StartPosition:       startPosition,
+		DmlPosition:         dmlPosition,
+		CreatedByCompaction: true,
+		CompactionFrom:      compactionFrom,
+		Sorted:              result.GetSorted(),
+	}
+	segment := NewSegmentInfo(segmentInfo)
+
+	log.Info("meta update: get complete compaction meta - complete",
+		zap.Int64("segmentID", segmentInfo.ID),
+		zap.Int64("collectionID", segmentInfo.CollectionID),
+		zap.Int64("partitionID", segmentInfo.PartitionID),
+		zap.Int64("NumOfRows", segmentInfo.NumOfRows),
+		zap.Any("compactionFrom", segmentInfo.CompactionFrom))
+
+	return oldSegments, modSegments, segment
+}
+
+// GetCompleteClusteringCompactionMeta returns the old segments, the dropped compacted segments and
+// the segments created by clustering compaction.
+func (m *meta) GetCompleteClusteringCompactionMeta(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) ([]*datapb.SegmentInfo, []*SegmentInfo, []*SegmentInfo) {
+	log.Info("meta update: get complete clustering compaction meta")
+	m.Lock()
+	defer m.Unlock()
+
+	oldSegments, modSegments, startPosition, dmlPosition, newAddedDeltalogs := m.getCompactedSegments(compactionLogs)
+
+	compactionFrom := make([]UniqueID, 0, len(modSegments))
+	for _, s := range modSegments {
+		compactionFrom = append(compactionFrom, s.GetID())
+	}
+
+	// the deletions applied to the compacted segments during compaction may hit any of the clustered segments,
+	// so their deltalogs are shared by all the clustered segments like the binlogs of cloned segments,
+	// the compacted segments keep the files until all the clustered segments are removed.
+	var sharedFrom []UniqueID
+	if len(newAddedDeltalogs) > 0 {
+		sharedFrom = compactionFrom
+	}
+
+	newSegments := make([]*SegmentInfo, 0, len(result.GetClusteredSegments()))
+	for _, clustered := range result.GetClusteredSegments() {
+		deltalogs := clustered.GetDeltalogs()
+		for _, l := range newAddedDeltalogs {
+			deltalogs = append(deltalogs, proto.Clone(l).(*datapb.FieldBinlog))
+		}
+		segmentInfo := &datapb.SegmentInfo{
+			ID:                  clustered.GetSegmentID(),
+			CollectionID:        modSegments[0].CollectionID,
+			PartitionID:         modSegments[0].PartitionID,
+			InsertChannel:       modSegments[0].InsertChannel,
+			NumOfRows:           clustered.GetNumOfRows(),
+			State:               commonpb.SegmentState_Flushing,
+			MaxRowNum:           modSegments[0].MaxRowNum,
+			Binlogs:             clustered.GetInsertLogs(),
+			Statslogs:           clustered.GetField2StatslogPaths(),
+			Deltalogs:           deltalogs,
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+			ClusteringRange:     clustered.GetClusteringRange(),
+			Sorted:              result.GetSorted(),
+			SharedFrom:          sharedFrom,
+		}
+		newSegments = append(newSegments, NewSegmentInfo(segmentInfo))
+	}
+
+	log.Info("meta update: get complete clustering compaction meta - complete",
+		zap.Int64("collectionID", modSegments[0].CollectionID),
+		zap.Int64("partitionID", modSegments[0].PartitionID),
+		zap.Int("clustered segment number", len(newSegments)),
+		zap.Any("compactionFrom", compactionFrom))
+
+	return oldSegments, modSegments, newSegments
+}
+
+// getCompactedSegments returns the compacted segments and the segments marked as dropped, with the earliest positions of them,
+// and the deltalogs added to them during compaction.
+// not threadsafe, the caller should hold the lock
+func (m *meta) getCompactedSegments(compactionLogs []*datapb.CompactionSegmentBinlogs) ([]*datapb.SegmentInfo, []*SegmentInfo, *internalpb.MsgPosition, *internalpb.MsgPosition, []*datapb.FieldBinlog) {
 	var (
 		oldSegments = make([]*datapb.SegmentInfo, 0, len(compactionLogs))
 		modSegments = make([]*SegmentInfo, 0, len(compactionLogs))
@@ -887,39 +1067,7 @@ func (m *meta) GetCompleteCompactionMeta(compactionLogs []*datapb.CompactionSegm
 	}
 
 	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
-	deltalogs := append(result.GetDeltalogs(), newAddedDeltalogs...)
-
-	compactionFrom := make([]UniqueID, 0, len(modSegments))
-	for _, s := range modSegments {
-		compactionFrom = append(compactionFrom, s.GetID())
-	}
-
-	segmentInfo := &datapb.SegmentInfo{
-		ID:                  result.GetSegmentID(),
-		CollectionID:        modSegments[0].CollectionID,
-		PartitionID:         modSegments[0].PartitionID,
-		InsertChannel:       modSegments[0].InsertChannel,
-		NumOfRows:           result.NumOfRows,
-		State:               commonpb.SegmentState_Flushing,
-		MaxRowNum:           modSegments[0].MaxRowNum,
-		Binlogs:             result.GetInsertLogs(),
-		Statslogs:           result.GetField2StatslogPaths(),
-		Deltalogs:           deltalogs,
-		StartPosition:       startPosition,
-		DmlPosition:         dmlPosition,
-		CreatedByCompaction: true,
-		CompactionFrom:      compactionFrom,
-	}
-	segment := NewSegmentInfo(segmentInfo)
-
-	log.Info("meta update: get complete compaction meta - complete",
-		zap.Int64("segmentID", segmentInfo.ID),
-		zap.Int64("collectionID", segmentInfo.CollectionID),
-		zap.Int64("partitionID", segmentInfo.PartitionID),
-		zap.Int64("NumOfRows", segmentInfo.NumOfRows),
-		zap.Any("compactionFrom", segmentInfo.CompactionFrom))
-
-	return oldSegments, modSegments, segment
+	return oldSegments, modSegments, startPosition, dmlPosition, newAddedDeltalogs
 }
 
 func (m *meta) alterMetaStoreAfterCompaction(modSegments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
@@ -936,6 +1084,48 @@ func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*datapb.Segment
 	return m.catalog.RevertAlterSegmentsAndAddNewSegment(m.ctx, oldSegments, removalSegment)
 }
 
+// alterMetaStoreAfterClusteringCompaction saves the clustered segments before dropping the segments compacted from,
+// a clustering compaction may produce more segments than an etcd txn could save, so the segments are saved in batches
+// and a failure in the middle leaves data duplicated rather than lost, which is reverted by the caller.
+func (m *meta) alterMetaStoreAfterClusteringCompaction(modSegments []*datapb.SegmentInfo, newSegments []*datapb.SegmentInfo) error {
+	if err := m.catalog.AlterSegmentsInBatch(m.ctx, newSegments); err != nil {
+		return err
+	}
+	return m.catalog.AlterSegmentsInBatch(m.ctx, modSegments)
+}
+
+func (m *meta) revertAlterMetaStoreAfterClusteringCompaction(oldSegments []*datapb.SegmentInfo, removalSegments []*datapb.SegmentInfo) error {
+	log.Info("revert metastore after clustering compaction failure", zap.Int("clustered segment number", len(removalSegments)))
+	for _, segment := range oldSegments {
+		if err := m.catalog.RevertAlterSegmentsAndAddNewSegment(m.ctx, []*datapb.SegmentInfo{segment}, nil); err != nil {
+			return err
+		}
+	}
+	for _, segment := range removalSegments {
+		if err := m.catalog.DropSegment(m.ctx, segment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *meta) alterInMemoryMetaAfterClusteringCompaction(segmentsClusteredTo []*SegmentInfo, segmentsCompactFrom []*SegmentInfo) {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, s := range segmentsCompactFrom {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+
+	for _, s := range segmentsClusteredTo {
+		if s.GetNumOfRows() > 0 {
+			m.segments.SetSegment(s.GetID(), s)
+		}
+	}
+	log.Info("meta update: alter in memory meta after clustering compaction - complete",
+		zap.Int("clustered segment number", len(segmentsClusteredTo)))
+}
+
 func (m *meta) alterInMemoryMetaAfterCompaction(segmentCompactTo *SegmentInfo, segmentsCompactFrom []*SegmentInfo) {
 	var compactFromIDs []int64
 	for _, v := range segmentsCompactFrom {
@@ -1070,3 +1260,64 @@ func (m *meta) HasSegments(segIDs []UniqueID) (bool, error) {
 	}
 	return true, nil
 }
+
+// UpdateChannelCheckpoint updates and saves the checkpoint of the vChannel, the checkpoint never moves backward.
+func (m *meta) UpdateChannelCheckpoint(vChannel string, pos *internalpb.MsgPosition) error {
+	if pos == nil {
+		return fmt.Errorf("channel checkpoint is nil, vChannel=%s", vChannel)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	oldPosition, ok := m.channelCPs[vChannel]
+	if ok && oldPosition.GetTimestamp() >= pos.GetTimestamp() {
+		return nil
+	}
+	if err := m.catalog.SaveChannelCheckpoint(m.ctx, vChannel, pos); err != nil {
+		return err
+	}
+	m.channelCPs[vChannel] = pos
+	ts, _ := tsoutil.ParseTS(pos.GetTimestamp())
+	log.Debug("meta update: update channel checkpoint",
+		zap.String("vChannel", vChannel),
+		zap.Uint64("ts", pos.GetTimestamp()),
+		zap.Time("time", ts))
+	return nil
+}
+
+// GetChannelCheckpoint returns the checkpoint of the vChannel, nil if not reported yet.
+func (m *meta) GetChannelCheckpoint(vChannel string) *internalpb.MsgPosition {
+	m.RLock()
+	defer m.RUnlock()
+	pos, ok := m.channelCPs[vChannel]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(pos).(*internalpb.MsgPosition)
+}
+
+// ListChannelCheckpoints returns the checkpoints of all the vChannels.
+func (m *meta) ListChannelCheckpoints() map[string]*internalpb.MsgPosition {
+	m.RLock()
+	defer m.RUnlock()
+	result := make(map[string]*internalpb.MsgPosition, len(m.channelCPs))
+	for vChannel, pos := range m.channelCPs {
+		result[vChannel] = proto.Clone(pos).(*internalpb.MsgPosition)
+	}
+	return result
+}
+
+// DropChannelCheckpoint removes the checkpoint of the dropped vChannel.
+func (m *meta) DropChannelCheckpoint(vChannel string) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.channelCPs[vChannel]; !ok {
+		return nil
+	}
+	if err := m.catalog.DropChannelCheckpoint(m.ctx, vChannel); err != nil {
+		return err
+	}
+	delete(m.channelCPs, vChannel)
+	log.Info("meta update: drop channel checkpoint", zap.String("vChannel", vChannel))
+	return nil
+}
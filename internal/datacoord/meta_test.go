@@ -28,9 +28,12 @@ import (
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	dbdatacoord "github.com/milvus-io/milvus/internal/metastore/db/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +46,10 @@ type mockEtcdKv struct {
 func (mek *mockEtcdKv) LoadWithPrefix(key string) ([]string, []string, error) {
 	var val []byte
 	switch {
+	case strings.Contains(key, datacoord.ChannelCheckpointPrefix):
+		channelCP := &internalpb.MsgPosition{ChannelName: "ch", Timestamp: 1000}
+		val, _ = proto.Marshal(channelCP)
+		return []string{datacoord.ChannelCheckpointPrefix + "/ch"}, []string{string(val)}, nil
 	case strings.Contains(key, datacoord.SegmentPrefix):
 		segInfo := &datapb.SegmentInfo{ID: 1, Binlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")}}
 		val, _ = proto.Marshal(segInfo)
@@ -180,8 +187,9 @@ func (mek *mockKvIllegalStatslog) LoadWithPrefix(key string) ([]string, []string
 func TestMetaReloadFromKV(t *testing.T) {
 	t.Run("Test ReloadFromKV success", func(t *testing.T) {
 		fkv := &mockEtcdKv{}
-		_, err := newMeta(context.TODO(), fkv, "")
+		meta, err := newMeta(context.TODO(), fkv, "")
 		assert.Nil(t, err)
+		assert.Equal(t, uint64(1000), meta.GetChannelCheckpoint("ch").GetTimestamp())
 	})
 
 	// load segment error
@@ -233,6 +241,43 @@ func TestMetaReloadFromKV(t *testing.T) {
 	})
 }
 
+func TestNewCatalog(t *testing.T) {
+	metaStoreType := Params.MetaStoreCfg.MetaStoreType
+	dbCfg := Params.DBCfg
+	defer func() {
+		Params.MetaStoreCfg.MetaStoreType = metaStoreType
+		Params.DBCfg = dbCfg
+	}()
+
+	t.Run("etcd", func(t *testing.T) {
+		Params.MetaStoreCfg.MetaStoreType = util.MetaStoreTypeEtcd
+		catalog, err := newCatalog(memkv.NewMemoryKV(), "")
+		assert.NoError(t, err)
+		assert.IsType(t, &datacoord.Catalog{}, catalog)
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		Params.MetaStoreCfg.MetaStoreType = util.MetaStoreTypeSqlite
+		Params.DBCfg.Driver = util.MetaStoreTypeSqlite
+		Params.DBCfg.SqlitePath = dbcore.SqliteMemoryPath
+		catalog, err := newCatalog(memkv.NewMemoryKV(), "")
+		assert.NoError(t, err)
+		assert.IsType(t, &dbdatacoord.Catalog{}, catalog)
+
+		segment := &datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 1, State: commonpb.SegmentState_Growing}
+		assert.NoError(t, catalog.AddSegment(context.TODO(), segment))
+		m, err := newMetaWithCatalog(context.TODO(), catalog)
+		assert.NoError(t, err)
+		assert.NotNil(t, m.GetSegment(1))
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		Params.MetaStoreCfg.MetaStoreType = "unknown"
+		_, err := newCatalog(memkv.NewMemoryKV(), "")
+		assert.Error(t, err)
+	})
+}
+
 func TestMeta_Basic(t *testing.T) {
 	const collID = UniqueID(0)
 	const partID0 = UniqueID(100)
@@ -465,7 +510,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, true, false, true, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog1")},
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, true, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog1")},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, "statslog1")},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000}}}},
 			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
@@ -486,10 +531,47 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "")
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil)
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, false, nil, nil, nil, nil, nil)
 		assert.Nil(t, err)
 	})
 
+	t.Run("update sorted", func(t *testing.T) {
+		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "")
+		assert.Nil(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}}
+		err = meta.AddSegment(segment1)
+		assert.Nil(t, err)
+
+		// the first binlogs of a segment decide whether it is sorted
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, true, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog0")},
+			nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.True(t, meta.GetSegment(1).GetSorted())
+
+		// no new binlogs keep the flag
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, false, nil, nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.True(t, meta.GetSegment(1).GetSorted())
+
+		// sorted binlogs appended to a sorted segment are sorted only within each binlog
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, true, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog1")},
+			nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.True(t, meta.GetSegment(1).GetSorted())
+
+		// unsorted binlogs make the segment unsorted
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog2")},
+			nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.False(t, meta.GetSegment(1).GetSorted())
+
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, true, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog3")},
+			nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.False(t, meta.GetSegment(1).GetSorted())
+	})
+
 	t.Run("update checkpoints and start position of non existed segment", func(t *testing.T) {
 		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "")
 		assert.Nil(t, err)
@@ -498,7 +580,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, false, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
 
 			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
 		assert.Nil(t, err)
@@ -520,7 +602,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		}
 		meta.segments.SetSegment(1, segmentInfo)
 
-		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog")},
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog")},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, "statslog")},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000}}}},
 			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
@@ -606,6 +688,49 @@ func TestMeta_alterMetaStore(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMeta_alterMetaStoreAfterClusteringCompaction(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m := &meta{
+		catalog:  &datacoord.Catalog{Txn: kv},
+		segments: NewSegmentsInfo(),
+	}
+
+	newSegments := func(start, num int64, state commonpb.SegmentState) []*datapb.SegmentInfo {
+		segments := make([]*datapb.SegmentInfo, 0, num)
+		for id := start; id < start+num; id++ {
+			segments = append(segments, &datapb.SegmentInfo{
+				ID:           id,
+				CollectionID: 100,
+				PartitionID:  10,
+				NumOfRows:    10,
+				State:        state,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(101, fmt.Sprintf("log%d", id))},
+			})
+		}
+		return segments
+	}
+	// more segments than an etcd txn could save
+	oldSegments := newSegments(1, 100, commonpb.SegmentState_Flushed)
+	modSegments := newSegments(1, 100, commonpb.SegmentState_Dropped)
+	clustered := newSegments(1000, 100, commonpb.SegmentState_Flushed)
+
+	err := m.alterMetaStoreAfterClusteringCompaction(modSegments, clustered)
+	assert.NoError(t, err)
+	segments, err := m.catalog.ListSegments(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 200, len(segments))
+
+	err = m.revertAlterMetaStoreAfterClusteringCompaction(oldSegments, clustered)
+	assert.NoError(t, err)
+	segments, err = m.catalog.ListSegments(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 100, len(segments))
+	for _, segment := range segments {
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.Less(t, segment.GetID(), int64(1000))
+	}
+}
+
 func TestMeta_alterInMemoryMetaAfterCompaction(t *testing.T) {
 	m := &meta{
 		catalog:  &datacoord.Catalog{Txn: memkv.NewMemoryKV()},
@@ -723,6 +848,111 @@ func TestMeta_GetCompleteCompactionMeta(t *testing.T) {
 	assert.NotZero(t, newSegment.lastFlushTime)
 }
 
+func TestMeta_GetCompleteClusteringCompactionMeta(t *testing.T) {
+	prepareSegments := &SegmentsInfo{
+		map[UniqueID]*SegmentInfo{
+			1: {SegmentInfo: &datapb.SegmentInfo{
+				ID:           1,
+				CollectionID: 100,
+				PartitionID:  10,
+				State:        commonpb.SegmentState_Flushed,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
+				Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")},
+			}},
+			2: {SegmentInfo: &datapb.SegmentInfo{
+				ID:           2,
+				CollectionID: 100,
+				PartitionID:  10,
+				State:        commonpb.SegmentState_Flushed,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")},
+			}},
+		},
+	}
+
+	m := &meta{
+		catalog:  &datacoord.Catalog{Txn: memkv.NewMemoryKV()},
+		segments: prepareSegments,
+	}
+
+	inCompactionLogs := []*datapb.CompactionSegmentBinlogs{
+		{
+			SegmentID:    1,
+			FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
+			Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")},
+		},
+		{
+			SegmentID:    2,
+			FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")},
+		},
+	}
+
+	clusteringRange := func(min, max string) *datapb.ClusteringRange {
+		return &datapb.ClusteringRange{
+			FieldID: 101,
+			Min:     &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: min}},
+			Max:     &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: max}},
+		}
+	}
+	inCompactionResult := &datapb.CompactionResult{
+		ClusteredSegments: []*datapb.ClusteredSegment{
+			{
+				SegmentID:       3,
+				NumOfRows:       2,
+				InsertLogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log5")},
+				ClusteringRange: clusteringRange("apac", "east"),
+			},
+			{
+				SegmentID:       4,
+				NumOfRows:       3,
+				InsertLogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log6")},
+				Deltalogs:       []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog6")},
+				ClusteringRange: clusteringRange("north", "west"),
+			},
+		},
+	}
+	beforeCompact, afterCompact, newSegments := m.GetCompleteClusteringCompactionMeta(inCompactionLogs, inCompactionResult)
+
+	require.Equal(t, 2, len(beforeCompact))
+	assert.Equal(t, commonpb.SegmentState_Flushed, beforeCompact[0].GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushed, beforeCompact[1].GetState())
+
+	require.Equal(t, 2, len(afterCompact))
+	assert.Equal(t, commonpb.SegmentState_Dropped, afterCompact[0].GetState())
+	assert.Equal(t, commonpb.SegmentState_Dropped, afterCompact[1].GetState())
+
+	require.Equal(t, 2, len(newSegments))
+	for i, segment := range newSegments {
+		clustered := inCompactionResult.GetClusteredSegments()[i]
+		assert.Equal(t, clustered.GetSegmentID(), segment.GetID())
+		assert.Equal(t, UniqueID(100), segment.GetCollectionID())
+		assert.Equal(t, UniqueID(10), segment.GetPartitionID())
+		assert.Equal(t, clustered.GetNumOfRows(), segment.GetNumOfRows())
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.True(t, segment.GetCreatedByCompaction())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.EqualValues(t, clustered.GetInsertLogs(), segment.GetBinlogs())
+		assert.EqualValues(t, clustered.GetClusteringRange(), segment.GetClusteringRange())
+	}
+	assert.Equal(t, 0, len(newSegments[0].GetDeltalogs()))
+	assert.Equal(t, 1, len(newSegments[1].GetDeltalogs()))
+	// no deltalogs are added during compaction, nothing is shared
+	assert.Equal(t, 0, len(newSegments[0].GetSharedFrom()))
+
+	t.Run("deltalogs added during compaction", func(t *testing.T) {
+		// segment 2 gets a deltalog while compacting
+		m.segments.SetSegment(2, m.segments.GetSegment(2).Clone(func(segment *SegmentInfo) {
+			segment.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog7")}
+		}))
+		_, _, newSegments := m.GetCompleteClusteringCompactionMeta(inCompactionLogs, inCompactionResult)
+		require.Equal(t, 2, len(newSegments))
+		for _, segment := range newSegments {
+			assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetSharedFrom())
+		}
+		assert.Equal(t, "deltalog7", newSegments[0].GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, 2, len(newSegments[1].GetDeltalogs()))
+	})
+}
+
 func Test_meta_SetSegmentCompacting(t *testing.T) {
 	type fields struct {
 		client   kv.TxnKV
@@ -953,3 +1183,33 @@ func TestMeta_isSegmentHealthy_issue17823_panic(t *testing.T) {
 
 	assert.False(t, isSegmentHealthy(seg))
 }
+
+func TestMeta_ChannelCheckpoint(t *testing.T) {
+	meta, err := newMemoryMeta(nil)
+	assert.NoError(t, err)
+
+	vChannel := "dml_0_100v0"
+	assert.Nil(t, meta.GetChannelCheckpoint(vChannel))
+	assert.Error(t, meta.UpdateChannelCheckpoint(vChannel, nil))
+
+	pos := &internalpb.MsgPosition{ChannelName: vChannel, MsgID: []byte{1}, Timestamp: 100}
+	assert.NoError(t, meta.UpdateChannelCheckpoint(vChannel, pos))
+	assert.Equal(t, uint64(100), meta.GetChannelCheckpoint(vChannel).GetTimestamp())
+
+	// the checkpoint never moves backward
+	assert.NoError(t, meta.UpdateChannelCheckpoint(vChannel, &internalpb.MsgPosition{ChannelName: vChannel, Timestamp: 50}))
+	assert.Equal(t, uint64(100), meta.GetChannelCheckpoint(vChannel).GetTimestamp())
+
+	assert.NoError(t, meta.UpdateChannelCheckpoint(vChannel, &internalpb.MsgPosition{ChannelName: vChannel, Timestamp: 200}))
+	assert.Equal(t, 1, len(meta.ListChannelCheckpoints()))
+
+	// reload from the catalog
+	reloaded, err := newMetaWithCatalog(context.TODO(), meta.catalog)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(200), reloaded.GetChannelCheckpoint(vChannel).GetTimestamp())
+
+	assert.NoError(t, meta.DropChannelCheckpoint(vChannel))
+	assert.Nil(t, meta.GetChannelCheckpoint(vChannel))
+	assert.Empty(t, meta.ListChannelCheckpoints())
+	assert.NoError(t, meta.DropChannelCheckpoint(vChannel))
+}
@@ -182,3 +182,64 @@ func (s *Server) getDataNodeMetrics(ctx context.Context, req *milvuspb.GetMetric
 	infos.BaseComponentInfos.HasError = false
 	return infos, nil
 }
+
+// fetchChannelLoads fetches the capacity of datanodes and the insert throughput of channels from datanode metrics.
+func (s *Server) fetchChannelLoads(ctx context.Context) (map[int64]float64, map[string]float64, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, nil, err
+	}
+	capacities := make(map[int64]float64)
+	rates := make(map[string]float64)
+	for _, node := range s.cluster.GetSessions() {
+		infos, err := s.getDataNodeMetrics(ctx, req, node)
+		if err != nil || infos.HasError {
+			log.Warn("fails to get DataNode metrics for channel loads", zap.Int64("nodeID", node.info.NodeID),
+				zap.String("reason", infos.ErrorReason), zap.Error(err))
+			continue
+		}
+		capacities[node.info.NodeID] = infos.SystemConfigurations.Capacity
+		if infos.QuotaMetrics == nil {
+			continue
+		}
+		for _, crm := range infos.QuotaMetrics.Crms {
+			if crm.Label == metricsinfo.InsertConsumeThroughput {
+				rates[crm.Channel] = crm.Rate
+			}
+		}
+	}
+	return capacities, rates, nil
+}
+
+// fetchGrowingSegmentsSize gets the memory size in bytes of the growing segments on query nodes through querycoord,
+// a segment loaded by several replicas takes the largest size reported.
+func (s *Server) fetchGrowingSegmentsSize(ctx context.Context) (map[UniqueID]int64, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.queryCoord.GetMetrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	topology := &metricsinfo.QueryCoordTopology{}
+	if err := metricsinfo.UnmarshalTopology(resp.GetResponse(), topology); err != nil {
+		return nil, err
+	}
+
+	sizes := make(map[UniqueID]int64)
+	for _, node := range topology.Cluster.ConnectedNodes {
+		if node.QuotaMetrics == nil {
+			continue
+		}
+		for segmentID, size := range node.QuotaMetrics.GrowingSegmentsSize {
+			if size > sizes[segmentID] {
+				sizes[segmentID] = size
+			}
+		}
+	}
+	return sizes, nil
+}
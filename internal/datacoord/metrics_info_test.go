@@ -112,3 +112,51 @@ func TestGetDataNodeMetrics(t *testing.T) {
 	assert.True(t, info.HasError)
 
 }
+
+type mockMetricQueryCoordClient struct {
+	types.QueryCoord
+	mock func() (*milvuspb.GetMetricsResponse, error)
+}
+
+func (c *mockMetricQueryCoordClient) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return c.mock()
+}
+
+func TestSyncGrowingSegmentsSize(t *testing.T) {
+	Params.Init()
+
+	topology := metricsinfo.QueryCoordTopology{
+		Cluster: metricsinfo.QueryClusterTopology{
+			ConnectedNodes: []metricsinfo.QueryNodeInfos{
+				{QuotaMetrics: &metricsinfo.QueryNodeQuotaMetrics{GrowingSegmentsSize: map[int64]int64{1: 100, 2: 200}}},
+				// another replica of segment 2
+				{QuotaMetrics: &metricsinfo.QueryNodeQuotaMetrics{GrowingSegmentsSize: map[int64]int64{2: 300}}},
+				{},
+			},
+		},
+	}
+	resp, err := metricsinfo.MarshalTopology(topology)
+	assert.NoError(t, err)
+
+	svr := &Server{
+		queryCoord: &mockMetricQueryCoordClient{mock: func() (*milvuspb.GetMetricsResponse, error) {
+			return &milvuspb.GetMetricsResponse{Status: &commonpb.Status{}, Response: resp}, nil
+		}},
+		segmentManager: &SegmentManager{},
+	}
+	sizes, err := svr.fetchGrowingSegmentsSize(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID]int64{1: 100, 2: 300}, sizes)
+
+	svr.syncGrowingSegmentsSize(context.Background())
+	assert.Equal(t, map[UniqueID]int64{1: 100, 2: 300}, svr.segmentManager.(*SegmentManager).growingSizes)
+
+	// the sizes are kept if querycoord fails
+	svr.queryCoord = &mockMetricQueryCoordClient{mock: func() (*milvuspb.GetMetricsResponse, error) {
+		return &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mocked error"}}, nil
+	}}
+	_, err = svr.fetchGrowingSegmentsSize(context.Background())
+	assert.Error(t, err)
+	svr.syncGrowingSegmentsSize(context.Background())
+	assert.Equal(t, map[UniqueID]int64{1: 100, 2: 300}, svr.segmentManager.(*SegmentManager).growingSizes)
+}
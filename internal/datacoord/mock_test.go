@@ -518,6 +518,38 @@ func (m *mockRootCoordService) ListImportTasks(ctx context.Context, in *milvuspb
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoordService) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ListDDLJobs(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) DescribeDDLJob(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordService) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -642,6 +674,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64, ct *c
 	panic("not implemented")
 }
 
+// forceTriggerTargetedCompaction force to start a compaction on the target segments
+func (t *mockCompactionTrigger) forceTriggerTargetedCompaction(collectionID int64, target *compactionTarget, ct *compactTime) (UniqueID, error) {
+	if f, ok := t.methods["forceTriggerTargetedCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64, target *compactionTarget, ct *compactTime) (UniqueID, error)); ok {
+			return ff(collectionID, target, ct)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -17,6 +17,7 @@
 package datacoord
 
 import (
+	"math"
 	"sort"
 	"strconv"
 	"time"
@@ -423,6 +424,143 @@ func AverageReassignPolicy(store ROChannelStore, reassigns []*NodeChannelInfo) C
 	return ret
 }
 
+// WeightedAssignPolicy returns a ChannelAssignPolicy that assigns the heaviest channels first,
+// each to the node with the lowest load relative to its capacity
+func WeightedAssignPolicy(loads *channelLoads) ChannelAssignPolicy {
+	return func(store ROChannelStore, channels []*channel) ChannelOpSet {
+		filteredChannels := filterChannels(store, channels)
+		if len(filteredChannels) == 0 {
+			return nil
+		}
+
+		opSet := ChannelOpSet{}
+		dataNodesChannels := store.GetNodesChannels()
+
+		// If no datanode alive, save channels in buffer
+		if len(dataNodesChannels) == 0 {
+			opSet.Add(bufferID, channels)
+			return opSet
+		}
+
+		for id, chs := range assignByWeight(loads, dataNodesChannels, filteredChannels) {
+			opSet.Add(id, chs)
+		}
+		return opSet
+	}
+}
+
+// WeightedReassignPolicy returns a ChannelReassignPolicy that reassigns channels to the remaining nodes
+// with the lowest load relative to their capacity
+func WeightedReassignPolicy(loads *channelLoads) ChannelReassignPolicy {
+	return func(store ROChannelStore, reassigns []*NodeChannelInfo) ChannelOpSet {
+		filterMap := make(map[int64]struct{})
+		for _, reassign := range reassigns {
+			filterMap[reassign.NodeID] = struct{}{}
+		}
+		remains := make([]*NodeChannelInfo, 0)
+		for _, c := range store.GetNodesChannels() {
+			if _, ok := filterMap[c.NodeID]; ok {
+				continue
+			}
+			remains = append(remains, c)
+		}
+
+		if len(remains) == 0 {
+			// if no node is left, do not reassign
+			return nil
+		}
+
+		opSet := ChannelOpSet{}
+		toAssign := make([]*channel, 0)
+		for _, reassign := range reassigns {
+			opSet.Delete(reassign.NodeID, reassign.Channels)
+			toAssign = append(toAssign, reassign.Channels...)
+		}
+		for id, chs := range assignByWeight(loads, remains, toAssign) {
+			opSet.Add(id, chs)
+		}
+		return opSet
+	}
+}
+
+// WeightedDeregisterPolicy returns a DeregisterPolicy that assigns the channels of the unregistered node
+// the same way as WeightedReassignPolicy
+func WeightedDeregisterPolicy(loads *channelLoads) DeregisterPolicy {
+	return func(store ROChannelStore, nodeID int64) ChannelOpSet {
+		opSet := ChannelOpSet{}
+		remains := make([]*NodeChannelInfo, 0)
+		unregisteredChannels := make([]*channel, 0)
+		for _, c := range store.GetNodesChannels() {
+			if c.NodeID == nodeID {
+				opSet.Delete(nodeID, c.Channels)
+				unregisteredChannels = append(unregisteredChannels, c.Channels...)
+				continue
+			}
+			remains = append(remains, c)
+		}
+
+		if len(remains) == 0 {
+			opSet.Add(bufferID, unregisteredChannels)
+			return opSet
+		}
+
+		for id, chs := range assignByWeight(loads, remains, unregisteredChannels) {
+			opSet.Add(id, chs)
+		}
+		return opSet
+	}
+}
+
+// assignByWeight assigns the channels to the nodes in descending order of channel weight,
+// each channel goes to the node with the lowest load relative to its capacity after taking it.
+func assignByWeight(loads *channelLoads, nodes []*NodeChannelInfo, channels []*channel) map[int64][]*channel {
+	nodeLoads := make(map[int64]float64, len(nodes))
+	nodeIDs := make([]int64, 0, len(nodes))
+	for _, info := range nodes {
+		nodeIDs = append(nodeIDs, info.NodeID)
+		nodeLoads[info.NodeID] = 0
+		for _, ch := range info.Channels {
+			nodeLoads[info.NodeID] += loads.channelWeight(ch.Name)
+		}
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	weights := make(map[string]float64, len(channels))
+	sorted := make([]*channel, 0, len(channels))
+	for _, ch := range channels {
+		weights[ch.Name] = loads.channelWeight(ch.Name)
+		sorted = append(sorted, ch)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if weights[sorted[i].Name] != weights[sorted[j].Name] {
+			return weights[sorted[i].Name] > weights[sorted[j].Name]
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	updates := make(map[int64][]*channel)
+	for _, ch := range sorted {
+		w := weights[ch.Name]
+		target := lightestNode(loads, nodeIDs, nodeLoads, w)
+		nodeLoads[target] += w
+		updates[target] = append(updates[target], ch)
+	}
+	return updates
+}
+
+// lightestNode returns the node with the lowest load relative to its capacity after taking the weight
+func lightestNode(loads *channelLoads, nodeIDs []int64, nodeLoads map[int64]float64, weight float64) int64 {
+	target := nodeIDs[0]
+	minLoad := math.MaxFloat64
+	for _, id := range nodeIDs {
+		load := (nodeLoads[id] + weight) / loads.nodeCapacity(id)
+		if load < minLoad {
+			target, minLoad = id, load
+		}
+	}
+	return target
+}
+
 // ChannelBGChecker check nodes' channels and return the channels needed to be reallocated.
 type ChannelBGChecker func(channels []*NodeChannelInfo, ts time.Time) ([]*NodeChannelInfo, error)
 
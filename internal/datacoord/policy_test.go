@@ -534,3 +534,101 @@ func TestAvgAssignRegisterPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedAssignPolicy(t *testing.T) {
+	loads := newChannelLoads()
+	loads.update(map[int64]float64{1: 1, 2: 2}, map[string]float64{"chan1": 30, "chan2": 10, "chan3": 20})
+
+	t.Run("test assign empty cluster", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{}}
+		got := WeightedAssignPolicy(loads)(store, []*channel{{Name: "chan1", CollectionID: 1}})
+		assert.EqualValues(t, ChannelOpSet{{Add, bufferID, []*channel{{Name: "chan1", CollectionID: 1}}, nil}}, got)
+	})
+
+	t.Run("test watch same channel", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+		}}
+		got := WeightedAssignPolicy(loads)(store, []*channel{{Name: "chan1", CollectionID: 1}})
+		assert.Nil(t, got)
+	})
+
+	t.Run("test heaviest channels first by capacity", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{
+			1: {1, []*channel{}},
+			2: {2, []*channel{}},
+		}}
+		got := WeightedAssignPolicy(loads)(store, []*channel{
+			{Name: "chan1", CollectionID: 1},
+			{Name: "chan2", CollectionID: 1},
+			{Name: "chan3", CollectionID: 1},
+		})
+		// chan1 -> 2 (15), chan3 -> 1 (20), chan2 -> 2 (20)
+		assert.ElementsMatch(t, ChannelOpSet{
+			{Add, 1, []*channel{{Name: "chan3", CollectionID: 1}}, nil},
+			{Add, 2, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan2", CollectionID: 1}}, nil},
+		}, got)
+	})
+
+	t.Run("test unknown channel weighs the average", func(t *testing.T) {
+		assert.Equal(t, float64(20), loads.channelWeight("chan4"))
+		assert.Equal(t, float64(1), newChannelLoads().channelWeight("chan4"))
+		assert.Equal(t, float64(1), loads.nodeCapacity(3))
+	})
+}
+
+func TestWeightedReassignPolicy(t *testing.T) {
+	loads := newChannelLoads()
+	loads.update(map[int64]float64{}, map[string]float64{"chan1": 30, "chan2": 10, "chan3": 20, "chan4": 5})
+
+	t.Run("test no node left", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+		}}
+		got := WeightedReassignPolicy(loads)(store, []*NodeChannelInfo{{1, []*channel{{Name: "chan1", CollectionID: 1}}}})
+		assert.Nil(t, got)
+	})
+
+	t.Run("test reassign to the lightest node", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan4", CollectionID: 1}}},
+			2: {2, []*channel{{Name: "chan3", CollectionID: 1}}},
+			3: {3, []*channel{{Name: "chan2", CollectionID: 1}}},
+		}}
+		got := WeightedReassignPolicy(loads)(store, []*NodeChannelInfo{{1, []*channel{{Name: "chan4", CollectionID: 1}}}})
+		assert.ElementsMatch(t, ChannelOpSet{
+			{Delete, 1, []*channel{{Name: "chan4", CollectionID: 1}}, nil},
+			{Add, 3, []*channel{{Name: "chan4", CollectionID: 1}}, nil},
+		}, got)
+	})
+}
+
+func TestWeightedDeregisterPolicy(t *testing.T) {
+	loads := newChannelLoads()
+	loads.update(map[int64]float64{}, map[string]float64{"chan1": 30, "chan2": 10, "chan3": 20})
+
+	t.Run("test deregister the last node", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+		}}
+		got := WeightedDeregisterPolicy(loads)(store, 1)
+		assert.EqualValues(t, ChannelOpSet{
+			{Delete, 1, []*channel{{Name: "chan1", CollectionID: 1}}, nil},
+			{Add, bufferID, []*channel{{Name: "chan1", CollectionID: 1}}, nil},
+		}, got)
+	})
+
+	t.Run("test deregister", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan2", CollectionID: 1}}},
+			2: {2, []*channel{{Name: "chan3", CollectionID: 1}}},
+			3: {3, []*channel{}},
+		}}
+		got := WeightedDeregisterPolicy(loads)(store, 1)
+		assert.ElementsMatch(t, ChannelOpSet{
+			{Delete, 1, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan2", CollectionID: 1}}, nil},
+			{Add, 3, []*channel{{Name: "chan1", CollectionID: 1}}, nil},
+			{Add, 2, []*channel{{Name: "chan2", CollectionID: 1}}, nil},
+		}, got)
+	})
+}
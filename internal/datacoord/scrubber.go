@@ -0,0 +1,293 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"go.uber.org/zap"
+)
+
+// ScrubberOption scrubber options
+type ScrubberOption struct {
+	cli              storage.ChunkManager // client
+	enabled          bool                 // enable switch
+	checkInterval    time.Duration        // each interval
+	maxFilesPerRound int                  // max number of files verified each round
+}
+
+// scrubber periodically re-verifies the binlogs of the flushed segments in object storage,
+// the segments whose binlogs are missing or fail the verification are marked corrupted.
+// The index files of the segments are only checked to exist, their format is owned by the index engine.
+type scrubber struct {
+	option     ScrubberOption
+	meta       *meta
+	indexCoord types.IndexCoord
+
+	// the last segment verified, the next round continues from the segment after it
+	lastSegmentID UniqueID
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+}
+
+// newScrubber create scrubber with meta and option, the index files are not checked if indexCoord is nil
+func newScrubber(meta *meta, indexCoord types.IndexCoord, opt ScrubberOption) *scrubber {
+	log.Info("scrubber with option", zap.Bool("enabled", opt.enabled), zap.Duration("interval", opt.checkInterval),
+		zap.Int("maxFilesPerRound", opt.maxFilesPerRound))
+	ctx, cancel := context.WithCancel(context.Background())
+	return &scrubber{
+		meta:       meta,
+		indexCoord: indexCoord,
+		option:     opt,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// start a goroutine and perform scrub every `checkInterval`
+func (s *scrubber) start() {
+	if s.option.enabled {
+		if s.option.cli == nil {
+			log.Warn("DataCoord scrubber enabled, but SSO client is not provided")
+			return
+		}
+		s.startOnce.Do(func() {
+			s.wg.Add(1)
+			go s.work()
+		})
+	}
+}
+
+func (s *scrubber) work() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.option.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.scrub(s.ctx)
+		case <-s.ctx.Done():
+			log.Warn("scrubber quit")
+			return
+		}
+	}
+}
+
+func (s *scrubber) close() {
+	s.stopOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+	})
+}
+
+// scrub verifies the segments after the last verified one until `maxFilesPerRound` files are verified,
+// returns the segments marked corrupted in this round
+func (s *scrubber) scrub(ctx context.Context) []UniqueID {
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() &&
+			!segment.GetCorrupted()
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+
+	var verified int
+	var corrupted []UniqueID
+	idx := sort.Search(len(segments), func(i int) bool { return segments[i].GetID() > s.lastSegmentID })
+	for ; idx < len(segments) && verified < s.option.maxFilesPerRound; idx++ {
+		if ctx.Err() != nil {
+			return corrupted
+		}
+		segment := segments[idx]
+		n, err := s.verifySegment(ctx, segment)
+		verified += n
+		if errors.Is(err, storage.ErrBinlogCorrupted) {
+			log.Error("segment is corrupted", zap.Int64("collectionID", segment.GetCollectionID()),
+				zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			// the segment may be compacted and dropped while being verified, then its binlogs are expected to be removed
+			err := s.meta.SetSegmentCorrupted(segment.GetID())
+			switch {
+			case errors.Is(err, errSegmentNotFlushed):
+				log.Info("segment is no longer flushed, skip marking it corrupted", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			case err != nil:
+				log.Warn("failed to mark segment corrupted", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+				// verify it again in the next round
+				return corrupted
+			default:
+				corrupted = append(corrupted, segment.GetID())
+			}
+		} else if err != nil {
+			// the error may be transient, e.g. object storage unavailable, verify it again in the next round
+			log.Warn("failed to verify segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return corrupted
+		}
+		s.lastSegmentID = segment.GetID()
+	}
+	if idx >= len(segments) {
+		// start over in the next round
+		s.lastSegmentID = 0
+	}
+	log.Info("scrub segment files", zap.Int("verified", verified), zap.Int64s("corrupted", corrupted))
+	return corrupted
+}
+
+// verifySegment verifies all the binlogs and index files of a segment, returns the number of verified files
+// and an ErrBinlogCorrupted error if any file is missing or corrupted
+func (s *scrubber) verifySegment(ctx context.Context, segment *SegmentInfo) (int, error) {
+	var verified int
+	// the binlogs of storage v2 segment files are shared by all the fields
+	visited := make(map[string]struct{})
+	verify := func(fieldBinlogs []*datapb.FieldBinlog, isBinlog bool) error {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if _, ok := visited[binlog.GetLogPath()]; ok {
+					continue
+				}
+				visited[binlog.GetLogPath()] = struct{}{}
+				err := s.verifyFile(ctx, binlog, isBinlog)
+				if err != nil && !errors.Is(err, storage.ErrBinlogCorrupted) {
+					return err
+				}
+				verified++
+				if err != nil {
+					metrics.DataCoordNumScrubbedFiles.WithLabelValues(metrics.FailLabel).Inc()
+					return fmt.Errorf("binlog %s: %w", binlog.GetLogPath(), err)
+				}
+				metrics.DataCoordNumScrubbedFiles.WithLabelValues(metrics.SuccessLabel).Inc()
+			}
+		}
+		return nil
+	}
+	if err := verify(segment.GetBinlogs(), true); err != nil {
+		return verified, err
+	}
+	if err := verify(segment.GetDeltalogs(), true); err != nil {
+		return verified, err
+	}
+	// the stats logs are not binlogs, they are only verified if the checksums are recorded
+	if err := verify(segment.GetStatslogs(), false); err != nil {
+		return verified, err
+	}
+	n, err := s.verifyIndexFiles(ctx, segment)
+	return verified + n, err
+}
+
+// verifyIndexFiles checks the index files of the segment exist, returns the number of checked files
+// and an ErrBinlogCorrupted error if any index file is missing
+func (s *scrubber) verifyIndexFiles(ctx context.Context, segment *SegmentInfo) (int, error) {
+	if s.indexCoord == nil {
+		return 0, nil
+	}
+	indexInfos, err := s.getIndexInfos(ctx, segment)
+	if err != nil {
+		return 0, err
+	}
+	var verified int
+	for _, indexInfo := range indexInfos {
+		for _, filePath := range indexInfo.GetIndexFilePaths() {
+			exist, err := s.option.cli.Exist(ctx, filePath)
+			if err != nil {
+				return verified, err
+			}
+			verified++
+			if exist {
+				metrics.DataCoordNumScrubbedFiles.WithLabelValues(metrics.SuccessLabel).Inc()
+				continue
+			}
+			// the index may be dropped and its files removed while being checked
+			dropped, err := s.isIndexDropped(ctx, segment, indexInfo.GetBuildID())
+			if err != nil {
+				return verified, err
+			}
+			if dropped {
+				log.Info("index is dropped while checking its files", zap.Int64("segmentID", segment.GetID()),
+					zap.Int64("buildID", indexInfo.GetBuildID()))
+				break
+			}
+			metrics.DataCoordNumScrubbedFiles.WithLabelValues(metrics.FailLabel).Inc()
+			return verified, fmt.Errorf("index file %s: %w: file not found", filePath, storage.ErrBinlogCorrupted)
+		}
+	}
+	return verified, nil
+}
+
+// getIndexInfos returns the built indexes of the segment
+func (s *scrubber) getIndexInfos(ctx context.Context, segment *SegmentInfo) ([]*indexpb.IndexFilePathInfo, error) {
+	resp, err := s.indexCoord.GetIndexInfos(ctx, &indexpb.GetIndexInfoRequest{
+		CollectionID: segment.GetCollectionID(),
+		SegmentIDs:   []int64{segment.GetID()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetSegmentInfo()[segment.GetID()].GetIndexInfos(), nil
+}
+
+// isIndexDropped returns true if the index build is no longer an index of the segment
+func (s *scrubber) isIndexDropped(ctx context.Context, segment *SegmentInfo, buildID UniqueID) (bool, error) {
+	indexInfos, err := s.getIndexInfos(ctx, segment)
+	if err != nil {
+		return false, err
+	}
+	for _, indexInfo := range indexInfos {
+		if indexInfo.GetBuildID() == buildID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *scrubber) verifyFile(ctx context.Context, binlog *datapb.Binlog, isBinlog bool) error {
+	if !isBinlog && binlog.GetChecksum() == 0 {
+		exist, err := s.option.cli.Exist(ctx, binlog.GetLogPath())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return fmt.Errorf("%w: file not found", storage.ErrBinlogCorrupted)
+		}
+		return nil
+	}
+	data, err := s.option.cli.Read(ctx, binlog.GetLogPath())
+	if err != nil {
+		exist, existErr := s.option.cli.Exist(ctx, binlog.GetLogPath())
+		if existErr == nil && !exist {
+			return fmt.Errorf("%w: file not found", storage.ErrBinlogCorrupted)
+		}
+		return err
+	}
+	return storage.VerifyBinlog(data, binlog.GetChecksum())
+}
@@ -0,0 +1,264 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scrubber(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cli := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	deleteData := &storage.DeleteData{}
+	for i := int64(0); i < 10; i++ {
+		deleteData.Append(storage.NewInt64PrimaryKey(i), uint64(i+100))
+	}
+	blob, err := storage.NewDeleteCodec().Serialize(10, 100, 1, deleteData)
+	require.NoError(t, err)
+	value := blob.GetValue()
+	corruptedValue := append([]byte{}, value...)
+	corruptedValue[len(corruptedValue)-10] ^= 0xff
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	addSegment := func(segmentID UniqueID, deltaChecksum uint32, deltaValue []byte, statsValue []byte) {
+		deltaPath := metautil.BuildDeltaLogPath(rootPath, 10, 100, segmentID, 1000)
+		if deltaValue != nil {
+			require.NoError(t, cli.Write(ctx, deltaPath, deltaValue))
+		}
+		statsPath := metautil.BuildStatsLogPath(rootPath, 10, 100, segmentID, 101, 1001)
+		if statsValue != nil {
+			require.NoError(t, cli.Write(ctx, statsPath, statsValue))
+		}
+		segment := buildSegment(10, 100, segmentID, "ch1", false)
+		segment.State = commonpb.SegmentState_Flushed
+		segment.Deltalogs = []*datapb.FieldBinlog{{
+			FieldID: 0,
+			Binlogs: []*datapb.Binlog{{LogPath: deltaPath, Checksum: deltaChecksum}},
+		}}
+		segment.Statslogs = []*datapb.FieldBinlog{getFieldBinlogPaths(101, statsPath)}
+		require.NoError(t, meta.AddSegment(segment))
+	}
+	stats := []byte("stats")
+	// valid with checksum
+	addSegment(1, storage.Checksum(value), value, stats)
+	// valid without checksum, i.e. written by the old version
+	addSegment(2, 0, value, stats)
+	// corrupted payload without checksum
+	addSegment(3, 0, corruptedValue, stats)
+	// checksum mismatch
+	addSegment(4, storage.Checksum(value), corruptedValue, stats)
+	// missing delta log
+	addSegment(5, storage.Checksum(value), nil, stats)
+	// missing stats log
+	addSegment(6, storage.Checksum(value), value, nil)
+
+	t.Run("scrub", func(t *testing.T) {
+		s := newScrubber(meta, nil, ScrubberOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Hour,
+			maxFilesPerRound: 100,
+		})
+		corrupted := s.scrub(ctx)
+		assert.ElementsMatch(t, []UniqueID{3, 4, 5, 6}, corrupted)
+		for _, segmentID := range []UniqueID{1, 2} {
+			assert.False(t, meta.GetSegment(segmentID).GetCorrupted())
+		}
+		for _, segmentID := range corrupted {
+			assert.True(t, meta.GetSegment(segmentID).GetCorrupted())
+		}
+		assert.Equal(t, UniqueID(0), s.lastSegmentID)
+
+		// the corrupted segments are not verified again
+		assert.Empty(t, s.scrub(ctx))
+	})
+
+	t.Run("max files per round", func(t *testing.T) {
+		s := newScrubber(meta, nil, ScrubberOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Hour,
+			maxFilesPerRound: 1,
+		})
+		// each segment has two files, one segment is verified each round
+		assert.Empty(t, s.scrub(ctx))
+		assert.Equal(t, UniqueID(1), s.lastSegmentID)
+		assert.Empty(t, s.scrub(ctx))
+		assert.Equal(t, UniqueID(0), s.lastSegmentID)
+	})
+
+	t.Run("corrupted segment excluded from compaction", func(t *testing.T) {
+		trigger := &compactionTrigger{meta: meta}
+		assert.NoError(t, trigger.validateCompactionTarget(10, newCompactionTarget(nil, []UniqueID{1}, 0)))
+		assert.Error(t, trigger.validateCompactionTarget(10, newCompactionTarget(nil, []UniqueID{3}, 0)))
+	})
+
+	t.Run("dropped while verified", func(t *testing.T) {
+		// the delta log is removed by gc after the segment is compacted and dropped
+		addSegment(7, storage.Checksum(value), nil, stats)
+		s := newScrubber(meta, nil, ScrubberOption{
+			cli: &droppingChunkManager{ChunkManager: cli, onRead: func() {
+				require.NoError(t, meta.SetState(7, commonpb.SegmentState_Dropped))
+			}},
+			enabled:          true,
+			checkInterval:    time.Hour,
+			maxFilesPerRound: 100,
+		})
+		assert.Empty(t, s.scrub(ctx))
+		assert.False(t, meta.GetAllSegment(7).GetCorrupted())
+		assert.Equal(t, UniqueID(0), s.lastSegmentID)
+	})
+
+	t.Run("start and close", func(t *testing.T) {
+		s := newScrubber(meta, nil, ScrubberOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Millisecond * 10,
+			maxFilesPerRound: 100,
+		})
+		s.start()
+		time.Sleep(time.Millisecond * 20)
+		assert.NotPanics(t, func() {
+			s.close()
+		})
+	})
+
+	t.Run("with nil cli", func(t *testing.T) {
+		s := newScrubber(meta, nil, ScrubberOption{
+			enabled:       true,
+			checkInterval: time.Millisecond * 10,
+		})
+		assert.NotPanics(t, func() {
+			s.start()
+		})
+		s.close()
+	})
+}
+
+func Test_scrubberIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cli := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	indexFiles := make(map[UniqueID][]string)
+	for segmentID := UniqueID(1); segmentID <= 4; segmentID++ {
+		segment := buildSegment(10, 100, segmentID, "ch1", false)
+		segment.State = commonpb.SegmentState_Flushed
+		require.NoError(t, meta.AddSegment(segment))
+		indexFile := metautil.JoinIDPath(segmentID, 1000)
+		indexFiles[segmentID] = []string{path.Join(rootPath, "index_files", indexFile)}
+	}
+	// the index files of segment 1 exist
+	require.NoError(t, cli.Write(ctx, indexFiles[1][0], []byte("index")))
+	// segment 4 has no index
+	delete(indexFiles, 4)
+
+	var droppedCalls int
+	indexCoord := mocks.NewMockIndexCoord(t)
+	indexCoord.On("GetIndexInfos", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, req *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse {
+			segmentID := req.GetSegmentIDs()[0]
+			resp := &indexpb.GetIndexInfoResponse{
+				Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				SegmentInfo: map[int64]*indexpb.SegmentInfo{},
+			}
+			if segmentID == 3 {
+				// the index of segment 3 is dropped after the first call
+				droppedCalls++
+				if droppedCalls > 1 {
+					return resp
+				}
+			}
+			if paths, ok := indexFiles[segmentID]; ok {
+				resp.SegmentInfo[segmentID] = &indexpb.SegmentInfo{
+					CollectionID: 10,
+					SegmentID:    segmentID,
+					EnableIndex:  true,
+					IndexInfos:   []*indexpb.IndexFilePathInfo{{SegmentID: segmentID, BuildID: segmentID, IndexFilePaths: paths}},
+				}
+			}
+			return resp
+		}, nil)
+
+	s := newScrubber(meta, indexCoord, ScrubberOption{
+		cli:              cli,
+		enabled:          true,
+		checkInterval:    time.Hour,
+		maxFilesPerRound: 100,
+	})
+	assert.ElementsMatch(t, []UniqueID{2}, s.scrub(ctx))
+	for _, segmentID := range []UniqueID{1, 3, 4} {
+		assert.False(t, meta.GetSegment(segmentID).GetCorrupted())
+	}
+	assert.True(t, meta.GetSegment(2).GetCorrupted())
+}
+
+func Test_scrubberGetIndexInfosFailed(t *testing.T) {
+	ctx := context.Background()
+	cli := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	segment := buildSegment(10, 100, 1, "ch1", false)
+	segment.State = commonpb.SegmentState_Flushed
+	require.NoError(t, meta.AddSegment(segment))
+
+	indexCoord := mocks.NewMockIndexCoord(t)
+	indexCoord.EXPECT().GetIndexInfos(mock.Anything, mock.Anything).Return(&indexpb.GetIndexInfoResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock error"},
+	}, nil)
+
+	s := newScrubber(meta, indexCoord, ScrubberOption{
+		cli:              cli,
+		enabled:          true,
+		checkInterval:    time.Hour,
+		maxFilesPerRound: 100,
+	})
+	// verified again in the next round
+	assert.Empty(t, s.scrub(ctx))
+	assert.False(t, meta.GetSegment(1).GetCorrupted())
+	assert.Equal(t, UniqueID(0), s.lastSegmentID)
+}
+
+// droppingChunkManager calls onRead before reading a file, to change the meta while the files are verified
+type droppingChunkManager struct {
+	storage.ChunkManager
+	onRead func()
+}
+
+func (cm *droppingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.onRead()
+	return cm.ChunkManager.Read(ctx, filePath)
+}
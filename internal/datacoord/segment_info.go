@@ -116,6 +116,13 @@ func (s *SegmentsInfo) SetIsImporting(segmentID UniqueID, isImporting bool) {
 	}
 }
 
+// SetCorrupted sets the corrupted flag for a segment.
+func (s *SegmentsInfo) SetCorrupted(segmentID UniqueID, corrupted bool) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.Clone(SetCorrupted(corrupted))
+	}
+}
+
 // SetDmlPosition sets DmlPosition info (checkpoint for recovery) for SegmentInfo with provided segmentID
 // if SegmentInfo not found, do nothing
 func (s *SegmentsInfo) SetDmlPosition(segmentID UniqueID, pos *internalpb.MsgPosition) {
@@ -260,6 +267,13 @@ func SetIsImporting(isImporting bool) SegmentInfoOption {
 	}
 }
 
+// SetCorrupted is the option to set corrupted flag for segment info.
+func SetCorrupted(corrupted bool) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.Corrupted = corrupted
+	}
+}
+
 // SetDmlPosition is the option to set dml position for segment info
 func SetDmlPosition(pos *internalpb.MsgPosition) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
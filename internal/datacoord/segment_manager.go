@@ -20,11 +20,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -82,6 +84,8 @@ type Manager interface {
 	ExpireAllocations(channel string, ts Timestamp) error
 	// DropSegmentsOfChannel drops all segments in a channel
 	DropSegmentsOfChannel(ctx context.Context, channel string)
+	// SetGrowingSegmentsSize updates the memory size in bytes of the growing segments measured on query nodes
+	SetGrowingSegmentsSize(sizes map[UniqueID]int64)
 }
 
 // Allocation records the allocation info
@@ -107,6 +111,8 @@ type SegmentManager struct {
 	channelSealPolicies []channelSealPolicy
 	flushPolicy         flushPolicy
 	rcc                 types.RootCoord
+	ingestRates         map[string]*ingestRate
+	growingSizes        map[UniqueID]int64 // bytes of the growing segments measured on query nodes
 }
 
 type allocHelper struct {
@@ -202,6 +208,7 @@ func newSegmentManager(meta *meta, allocator allocator, rcc types.RootCoord, opt
 		channelSealPolicies: []channelSealPolicy{},      // no default channel seal policy
 		flushPolicy:         defaultFlushPolicy(),
 		rcc:                 rcc,
+		ingestRates:         make(map[string]*ingestRate),
 	}
 	for _, opt := range opts {
 		opt.apply(manager)
@@ -275,9 +282,39 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	}
 
 	allocations := append(newSegmentAllocations, existedSegmentAllocations...)
+	s.addIngestRows(channelName, requestRows)
 	return allocations, nil
 }
 
+// addIngestRows records the rows allocated on the channel to measure its ingest rate
+func (s *SegmentManager) addIngestRows(channel string, rows int64) {
+	rate, ok := s.ingestRates[channel]
+	if !ok {
+		rate = newIngestRate(time.Now())
+		s.ingestRates[channel] = rate
+	}
+	rate.add(rows, time.Now())
+}
+
+// SetGrowingSegmentsSize updates the memory size in bytes of the growing segments measured on query nodes,
+// which the adaptive seal policy prefers to the size estimated by the schema.
+func (s *SegmentManager) SetGrowingSegmentsSize(sizes map[UniqueID]int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.growingSizes = sizes
+}
+
+// getIngestRate returns the rows allocated per second of the channel
+func (s *SegmentManager) getIngestRate(channel string) float64 {
+	rate, ok := s.ingestRates[channel]
+	if !ok {
+		return 0
+	}
+	r := rate.get(time.Now())
+	metrics.DataCoordChannelIngestRate.WithLabelValues(channel).Set(r)
+	return r
+}
+
 // allocSegmentForImport allocates one segment allocation for bulk load.
 func (s *SegmentManager) allocSegmentForImport(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64, importTaskID int64) (*Allocation, error) {
@@ -431,6 +468,7 @@ func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID Uniqu
 		if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
 			return nil, err
 		}
+		metrics.DataCoordNumSealedSegments.WithLabelValues(strconv.FormatInt(collectionID, 10), sealReasonFlush).Inc()
 		ret = append(ret, id)
 	}
 	return ret, nil
@@ -509,39 +547,24 @@ func isEmptySealedSegment(segment *SegmentInfo, ts Timestamp) bool {
 	return segment.GetState() == commonpb.SegmentState_Sealed && segment.GetLastExpireTime() <= ts && segment.currRows == 0
 }
 
-// tryToSealSegment applies segment & channel seal policies
+// tryToSealSegment applies the seal policy of each collection to the segments of the channel
 func (s *SegmentManager) tryToSealSegment(ts Timestamp, channel string) error {
-	channelInfo := make(map[string][]*SegmentInfo)
+	collectionSegments := make(map[UniqueID][]*SegmentInfo)
 	for _, id := range s.segments {
 		info := s.meta.GetSegment(id)
 		if info == nil || info.InsertChannel != channel {
 			continue
 		}
-		channelInfo[info.InsertChannel] = append(channelInfo[info.InsertChannel], info)
-		if info.State == commonpb.SegmentState_Sealed {
-			continue
-		}
-		// change shouldSeal to segment seal policy logic
-		for _, policy := range s.segmentSealPolicies {
-			if policy(info, ts) {
-				if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
-					return err
-				}
-				break
-			}
-		}
+		collectionSegments[info.CollectionID] = append(collectionSegments[info.CollectionID], info)
 	}
-	for channel, segmentInfos := range channelInfo {
-		for _, policy := range s.channelSealPolicies {
-			vs := policy(channel, segmentInfos, ts)
-			for _, info := range vs {
-				if info.State == commonpb.SegmentState_Sealed {
-					continue
-				}
-				if err := s.meta.SetState(info.GetID(), commonpb.SegmentState_Sealed); err != nil {
-					return err
-				}
+	for collectionID, segments := range collectionSegments {
+		policy := s.getSealPolicy(collectionID, channel)
+		for id, reason := range policy.sealSegments(channel, segments, ts) {
+			if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
+				return err
 			}
+			metrics.DataCoordNumSealedSegments.WithLabelValues(strconv.FormatInt(collectionID, 10), reason).Inc()
+			log.Info("seal segment", zap.Int64("segmentID", id), zap.String("channel", channel), zap.String("reason", reason))
 		}
 	}
 	return nil
@@ -569,4 +592,6 @@ func (s *SegmentManager) DropSegmentsOfChannel(ctx context.Context, channel stri
 	}
 
 	s.segments = validSegments
+	delete(s.ingestRates, channel)
+	metrics.DataCoordChannelIngestRate.DeleteLabelValues(channel)
 }
@@ -34,7 +34,9 @@ type SegmentReferenceManager struct {
 	// taskID -> (nodeID -> segmentReferenceLock), taskID must be globally unique in a component
 	segmentsLock    map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock
 	segmentReferCnt map[UniqueID]int
-	lock            sync.RWMutex
+	// segmentID -> number of cloned segments sharing its binlogs, rebuilt from meta on start
+	sharedReferCnt map[UniqueID]int
+	lock           sync.RWMutex
 }
 
 func NewSegmentReferenceManager(etcdKV kv.BaseKV, onlineIDs []UniqueID) (*SegmentReferenceManager, error) {
@@ -43,6 +45,7 @@ func NewSegmentReferenceManager(etcdKV kv.BaseKV, onlineIDs []UniqueID) (*Segmen
 		etcdKV:          etcdKV,
 		segmentsLock:    make(map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock),
 		segmentReferCnt: map[UniqueID]int{},
+		sharedReferCnt:  map[UniqueID]int{},
 		lock:            sync.RWMutex{},
 	}
 	_, values, err := segReferManager.etcdKV.LoadWithPrefix(segmentReferPrefix)
@@ -215,3 +218,36 @@ func (srm *SegmentReferenceManager) HasSegmentLock(segID UniqueID) bool {
 	}
 	return true
 }
+
+// AddSharedReference records that a cloned segment shares the binlogs of the given segments,
+// the binlogs must not be removed until all the references are released.
+func (srm *SegmentReferenceManager) AddSharedReference(segIDs ...UniqueID) {
+	srm.lock.Lock()
+	defer srm.lock.Unlock()
+
+	for _, segID := range segIDs {
+		srm.sharedReferCnt[segID]++
+	}
+}
+
+// ReleaseSharedReference releases the references added by AddSharedReference.
+func (srm *SegmentReferenceManager) ReleaseSharedReference(segIDs ...UniqueID) {
+	srm.lock.Lock()
+	defer srm.lock.Unlock()
+
+	for _, segID := range segIDs {
+		srm.sharedReferCnt[segID]--
+		if srm.sharedReferCnt[segID] <= 0 {
+			delete(srm.sharedReferCnt, segID)
+		}
+	}
+}
+
+// HasSharedReference returns whether the binlogs of the segment are shared by any cloned segment.
+func (srm *SegmentReferenceManager) HasSharedReference(segID UniqueID) bool {
+	srm.lock.RLock()
+	defer srm.lock.RUnlock()
+
+	_, ok := srm.sharedReferCnt[segID]
+	return ok
+}
@@ -334,3 +334,30 @@ func TestSegmentReferenceManager_Error(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestSegmentReferenceManager_SharedReference(t *testing.T) {
+	segRefer := &SegmentReferenceManager{
+		segmentsLock:    map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{},
+		segmentReferCnt: map[UniqueID]int{},
+		sharedReferCnt:  map[UniqueID]int{},
+	}
+	assert.False(t, segRefer.HasSharedReference(1))
+
+	segRefer.AddSharedReference(1, 2)
+	segRefer.AddSharedReference(1)
+	assert.True(t, segRefer.HasSharedReference(1))
+	assert.True(t, segRefer.HasSharedReference(2))
+	// shared references do not block compaction of the segment
+	assert.False(t, segRefer.HasSegmentLock(1))
+
+	segRefer.ReleaseSharedReference(1, 2)
+	assert.True(t, segRefer.HasSharedReference(1))
+	assert.False(t, segRefer.HasSharedReference(2))
+
+	segRefer.ReleaseSharedReference(1)
+	assert.False(t, segRefer.HasSharedReference(1))
+
+	// release without reference is ignored
+	segRefer.ReleaseSharedReference(3)
+	assert.False(t, segRefer.HasSharedReference(3))
+}
@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// StaticSegmentSealPolicy seals segments by the segment and channel seal policies of SegmentManager
+	StaticSegmentSealPolicy = "static"
+	// AdaptiveSegmentSealPolicy seals segments by the ingest rate of the channel,
+	// the estimated size of the growing segments and the target index size
+	AdaptiveSegmentSealPolicy = "adaptive"
+)
+
+// the reasons why segments are sealed, reported with the sealed segment metrics
+const (
+	sealReasonStatic          = "static"
+	sealReasonFlush           = "flush"
+	sealReasonTargetIndexSize = "targetIndexSize"
+	sealReasonGrowingSize     = "growingSize"
+	sealReasonLifetime        = "lifetime"
+	sealReasonIdle            = "idle"
+)
+
+// ingestRateWindow is the window to measure the ingest rate of channels
+const ingestRateWindow = time.Minute
+
+// collectionSealPolicy decides the segments of a channel to seal, along with the reason to seal each of them.
+// It's configured per collection, see SegmentManager.getSealPolicy.
+type collectionSealPolicy interface {
+	sealSegments(channel string, segments []*SegmentInfo, ts Timestamp) map[UniqueID]string
+}
+
+// getSealPolicy returns the seal policy of the collection, the collection level configuration overrides the default one.
+func (s *SegmentManager) getSealPolicy(collectionID UniqueID, channel string) collectionSealPolicy {
+	static := &staticSealPolicy{
+		segmentPolicies: s.segmentSealPolicies,
+		channelPolicies: s.channelSealPolicies,
+	}
+
+	policy := Params.DataCoordCfg.SegmentSealPolicy
+	collMeta := s.meta.GetCollection(collectionID)
+	if collMeta != nil {
+		if p, ok := Params.DataCoordCfg.CollectionSegmentSealPolicies[collMeta.GetSchema().GetName()]; ok {
+			policy = p
+		}
+	}
+	if policy != AdaptiveSegmentSealPolicy || collMeta == nil {
+		return static
+	}
+
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(collMeta.GetSchema())
+	if err != nil || sizePerRecord <= 0 {
+		log.Warn("failed to estimate record size, use static seal policy", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return static
+	}
+	return &adaptiveSealPolicy{
+		ingestRate:     s.getIngestRate(channel),
+		sizePerRecord:  sizePerRecord,
+		targetSize:     Params.DataCoordCfg.SegmentTargetIndexSize,
+		maxGrowingSize: Params.DataCoordCfg.SegmentMaxGrowingSize,
+		minSealSize:    Params.DataCoordCfg.SegmentMinSizeFromIdleToSealed,
+		lifetime:       Params.DataCoordCfg.SegmentMaxLifetime,
+		maxIdleTime:    Params.DataCoordCfg.SegmentMaxIdleTime,
+		lookahead:      time.Duration(Params.DataCoordCfg.SegAssignmentExpiration) * time.Millisecond,
+		growingSizes:   s.growingSizes,
+	}
+}
+
+// staticSealPolicy applies the segment seal policies to each growing segment and then the channel seal policies
+type staticSealPolicy struct {
+	segmentPolicies []segmentSealPolicy
+	channelPolicies []channelSealPolicy
+}
+
+func (p *staticSealPolicy) sealSegments(channel string, segments []*SegmentInfo, ts Timestamp) map[UniqueID]string {
+	result := make(map[UniqueID]string)
+	for _, segment := range segments {
+		if segment.GetState() == commonpb.SegmentState_Sealed {
+			continue
+		}
+		for _, policy := range p.segmentPolicies {
+			if policy(segment, ts) {
+				result[segment.GetID()] = sealReasonStatic
+				break
+			}
+		}
+	}
+	for _, policy := range p.channelPolicies {
+		for _, segment := range policy(channel, segments, ts) {
+			if segment.GetState() == commonpb.SegmentState_Sealed {
+				continue
+			}
+			result[segment.GetID()] = sealReasonStatic
+		}
+	}
+	return result
+}
+
+// adaptiveSealPolicy seals the growing segments of a channel by:
+//  1. targetIndexSize: the segment reaches the target index size, counting the rows the channel may write within
+//     the allocation lookahead, so segments on hot channels don't outgrow the target.
+//  2. lifetime: the segment has not been allocated for a lifetime. Small segments on slow channels are kept growing
+//     to avoid tiny sealed segments, unless the channel stops ingesting.
+//  3. idle: the segment has not been written for the max idle time and is larger than the min seal size.
+//  4. growingSize: the growing segments of the channel exceed the max growing size, the largest ones are sealed
+//     until the rest fit in. The size of a segment is the memory measured on query nodes if reported, otherwise
+//     it's estimated by the allocated rows and the schema.
+type adaptiveSealPolicy struct {
+	ingestRate     float64 // rows per second of the channel
+	sizePerRecord  int
+	targetSize     float64 // MB
+	maxGrowingSize float64 // MB
+	minSealSize    float64 // MB
+	lifetime       time.Duration
+	maxIdleTime    time.Duration
+	lookahead      time.Duration
+	growingSizes   map[UniqueID]int64 // bytes of the growing segments measured on query nodes
+}
+
+// segmentSize returns the estimated size in MB of the rows
+func (p *adaptiveSealPolicy) segmentSize(rows int64) float64 {
+	return float64(rows) * float64(p.sizePerRecord) / 1024 / 1024
+}
+
+// growingSize returns the size in MB of the growing segment, the memory measured on query nodes is preferred
+func (p *adaptiveSealPolicy) growingSize(segment *SegmentInfo) float64 {
+	if size, ok := p.growingSizes[segment.GetID()]; ok {
+		return float64(size) / 1024 / 1024
+	}
+	return p.segmentSize(segment.currRows)
+}
+
+func (p *adaptiveSealPolicy) sealSegments(channel string, segments []*SegmentInfo, ts Timestamp) map[UniqueID]string {
+	result := make(map[UniqueID]string)
+	now, _ := tsoutil.ParseTS(ts)
+	var growing []*SegmentInfo
+	var growingSize float64
+	for _, segment := range segments {
+		if !isGrowing(segment) {
+			continue
+		}
+		if reason, ok := p.shouldSeal(segment, now); ok {
+			result[segment.GetID()] = reason
+			continue
+		}
+		growing = append(growing, segment)
+		growingSize += p.growingSize(segment)
+	}
+
+	sort.Slice(growing, func(i, j int) bool {
+		si, sj := p.growingSize(growing[i]), p.growingSize(growing[j])
+		if si != sj {
+			return si > sj
+		}
+		return growing[i].GetID() < growing[j].GetID()
+	})
+	for _, segment := range growing {
+		if growingSize <= p.maxGrowingSize || segment.currRows == 0 {
+			break
+		}
+		result[segment.GetID()] = sealReasonGrowingSize
+		growingSize -= p.growingSize(segment)
+	}
+	return result
+}
+
+func (p *adaptiveSealPolicy) shouldSeal(segment *SegmentInfo, now time.Time) (string, bool) {
+	size := p.segmentSize(segment.currRows)
+	// the channel rate is an upper bound of the rate of each segment
+	projected := size + p.segmentSize(int64(p.ingestRate*p.lookahead.Seconds()))
+	targetSize := math.Min(p.targetSize, p.segmentSize(segment.GetMaxRowNum()))
+	if segment.currRows > 0 && projected >= targetSize {
+		return sealReasonTargetIndexSize, true
+	}
+
+	small := size < p.minSealSize
+	lastExpire, _ := tsoutil.ParseTS(segment.GetLastExpireTime())
+	if now.Sub(lastExpire) >= p.lifetime && (!small || p.ingestRate == 0) {
+		return sealReasonLifetime, true
+	}
+	if !small && time.Since(segment.lastWrittenTime) > p.maxIdleTime {
+		return sealReasonIdle, true
+	}
+	return "", false
+}
+
+// ingestRate measures the rows allocated per second of a channel in fixed windows
+type ingestRate struct {
+	windowStart time.Time
+	rows        int64
+	rate        float64 // rows per second of the last window
+}
+
+func newIngestRate(now time.Time) *ingestRate {
+	return &ingestRate{windowStart: now}
+}
+
+func (r *ingestRate) add(rows int64, now time.Time) {
+	r.roll(now)
+	r.rows += rows
+}
+
+func (r *ingestRate) get(now time.Time) float64 {
+	r.roll(now)
+	return r.rate
+}
+
+// roll starts a new window if the current one is over
+func (r *ingestRate) roll(now time.Time) {
+	elapsed := now.Sub(r.windowStart)
+	if elapsed < ingestRateWindow {
+		return
+	}
+	r.rate = float64(r.rows) / elapsed.Seconds()
+	r.rows = 0
+	r.windowStart = now
+}
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func Test_getSealPolicy(t *testing.T) {
+	Params.Init()
+	defer func() {
+		Params.DataCoordCfg.CollectionSegmentSealPolicies = map[string]string{}
+	}()
+
+	s := &SegmentManager{
+		meta: &meta{
+			collections: map[int64]*datapb.CollectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{Name: "metrics", Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, DataType: schemapb.DataType_Int64},
+				}}},
+				2: {ID: 2, Schema: &schemapb.CollectionSchema{Name: "logs", Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, DataType: schemapb.DataType_Int64},
+				}}},
+			},
+		},
+		ingestRates: map[string]*ingestRate{},
+	}
+	Params.DataCoordCfg.CollectionSegmentSealPolicies = map[string]string{"metrics": AdaptiveSegmentSealPolicy}
+
+	assert.IsType(t, &adaptiveSealPolicy{}, s.getSealPolicy(1, "ch1"))
+	assert.IsType(t, &staticSealPolicy{}, s.getSealPolicy(2, "ch1"))
+	assert.IsType(t, &staticSealPolicy{}, s.getSealPolicy(3, "ch1"))
+}
+
+func Test_staticSealPolicy(t *testing.T) {
+	policy := &staticSealPolicy{
+		segmentPolicies: []segmentSealPolicy{getSegmentCapacityPolicy(0.5)},
+		channelPolicies: []channelSealPolicy{getChannelOpenSegCapacityPolicy(2)},
+	}
+	newSegment := func(id int64, rows int64, state commonpb.SegmentState, lastExpire Timestamp) *SegmentInfo {
+		segment := NewSegmentInfo(&datapb.SegmentInfo{ID: id, MaxRowNum: 100, State: state, LastExpireTime: lastExpire})
+		segment.currRows = rows
+		return segment
+	}
+	segments := []*SegmentInfo{
+		newSegment(1, 60, commonpb.SegmentState_Growing, 4),
+		newSegment(2, 10, commonpb.SegmentState_Growing, 1),
+		newSegment(3, 10, commonpb.SegmentState_Sealed, 2),
+		newSegment(4, 10, commonpb.SegmentState_Growing, 3),
+	}
+	result := policy.sealSegments("ch1", segments, 0)
+	assert.Equal(t, map[UniqueID]string{1: sealReasonStatic, 2: sealReasonStatic}, result)
+}
+
+func Test_adaptiveSealPolicy(t *testing.T) {
+	now := time.Now()
+	ts := tsoutil.ComposeTSByTime(now, 0)
+	newPolicy := func(rate float64) *adaptiveSealPolicy {
+		return &adaptiveSealPolicy{
+			ingestRate:     rate,
+			sizePerRecord:  1024 * 1024, // 1MB per row
+			targetSize:     10,
+			maxGrowingSize: 20,
+			minSealSize:    3,
+			lifetime:       time.Hour,
+			maxIdleTime:    10 * time.Minute,
+			lookahead:      2 * time.Second,
+		}
+	}
+	newSegment := func(id int64, rows int64, lastExpire time.Time, lastWritten time.Time) *SegmentInfo {
+		segment := NewSegmentInfo(&datapb.SegmentInfo{
+			ID:             id,
+			MaxRowNum:      100,
+			State:          commonpb.SegmentState_Growing,
+			LastExpireTime: tsoutil.ComposeTSByTime(lastExpire, 0),
+		})
+		segment.currRows = rows
+		segment.lastWrittenTime = lastWritten
+		return segment
+	}
+
+	t.Run("target index size", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			newSegment(1, 10, now, now),
+			newSegment(2, 9, now, now),
+			newSegment(3, 0, now, now),
+		}
+		assert.Equal(t, map[UniqueID]string{1: sealReasonTargetIndexSize}, newPolicy(0).sealSegments("ch1", segments, ts))
+		// rows to come on the hot channel are counted
+		assert.Equal(t, map[UniqueID]string{1: sealReasonTargetIndexSize, 2: sealReasonTargetIndexSize},
+			newPolicy(1).sealSegments("ch1", segments, ts))
+	})
+
+	t.Run("lifetime", func(t *testing.T) {
+		expired := now.Add(-2 * time.Hour)
+		segments := []*SegmentInfo{
+			newSegment(1, 5, expired, now),
+			newSegment(2, 1, expired, now),
+		}
+		// small segments are kept growing while the channel is still ingesting
+		assert.Equal(t, map[UniqueID]string{1: sealReasonLifetime}, newPolicy(0.1).sealSegments("ch1", segments, ts))
+		assert.Equal(t, map[UniqueID]string{1: sealReasonLifetime, 2: sealReasonLifetime}, newPolicy(0).sealSegments("ch1", segments, ts))
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		idle := now.Add(-time.Hour)
+		segments := []*SegmentInfo{
+			newSegment(1, 5, now, idle),
+			newSegment(2, 1, now, idle),
+			newSegment(3, 5, now, now),
+		}
+		assert.Equal(t, map[UniqueID]string{1: sealReasonIdle}, newPolicy(0).sealSegments("ch1", segments, ts))
+	})
+
+	t.Run("growing size", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			newSegment(1, 8, now, now),
+			newSegment(2, 8, now, now),
+			newSegment(3, 7, now, now),
+			newSegment(4, 8, now, now),
+		}
+		// 31MB in total, the largest two are sealed to fit in 20MB
+		assert.Equal(t, map[UniqueID]string{1: sealReasonGrowingSize, 2: sealReasonGrowingSize},
+			newPolicy(0).sealSegments("ch1", segments, ts))
+	})
+
+	t.Run("growing size measured on query nodes", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			newSegment(1, 8, now, now),
+			newSegment(2, 8, now, now),
+			newSegment(3, 7, now, now),
+		}
+		policy := newPolicy(0)
+		// 23MB estimated, but the segment 3 takes 15MB on query nodes
+		policy.growingSizes = map[UniqueID]int64{3: 15 * 1024 * 1024, 2: 4 * 1024 * 1024}
+		assert.Equal(t, map[UniqueID]string{3: sealReasonGrowingSize}, policy.sealSegments("ch1", segments, ts))
+
+		policy.growingSizes = map[UniqueID]int64{1: 1024 * 1024, 2: 1024 * 1024, 3: 1024 * 1024}
+		assert.Empty(t, policy.sealSegments("ch1", segments, ts))
+	})
+
+	t.Run("skip non growing", func(t *testing.T) {
+		segment := newSegment(1, 50, now, now)
+		segment.State = commonpb.SegmentState_Sealed
+		assert.Empty(t, newPolicy(0).sealSegments("ch1", []*SegmentInfo{segment}, ts))
+	})
+}
+
+func Test_ingestRate(t *testing.T) {
+	now := time.Now()
+	rate := newIngestRate(now)
+	rate.add(600, now)
+	rate.add(600, now.Add(30*time.Second))
+	// the first window is not over yet
+	assert.Equal(t, 0.0, rate.get(now.Add(30*time.Second)))
+	assert.Equal(t, 20.0, rate.get(now.Add(time.Minute)))
+	// nothing ingested in the last window
+	assert.Equal(t, 0.0, rate.get(now.Add(3*time.Minute)))
+}
@@ -36,7 +36,9 @@ import (
 	"github.com/milvus-io/milvus/api/milvuspb"
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -111,17 +113,20 @@ type Server struct {
 	helper           ServerHelper
 
 	etcdCli          *clientv3.Client
-	kvClient         *etcdkv.EtcdKV
+	kvClient         kv.MetaKv
 	meta             *meta
 	segmentManager   Manager
 	allocator        allocator
 	cluster          *Cluster
 	sessionManager   *SessionManager
 	channelManager   *ChannelManager
+	channelLoads     *channelLoads
 	rootCoordClient  types.RootCoord
 	garbageCollector *garbageCollector
 	gcOpt            GcOption
+	scrubber         *scrubber
 	handler          Handler
+	chunkManager     storage.ChunkManager
 
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
@@ -142,6 +147,7 @@ type Server struct {
 	dataNodeCreator        dataNodeCreatorFunc
 	rootCoordClientCreator rootCoordCreatorFunc
 	indexCoord             types.IndexCoord
+	queryCoord             types.QueryCoord
 
 	segReferManager *SegmentReferenceManager
 }
@@ -276,6 +282,7 @@ func (s *Server) Init() error {
 	if err != nil {
 		return err
 	}
+	s.chunkManager = storageCli
 
 	if err = s.initMeta(storageCli.RootPath()); err != nil {
 		return err
@@ -296,6 +303,7 @@ func (s *Server) Init() error {
 	if err = s.initServiceDiscovery(); err != nil {
 		return err
 	}
+	s.initSharedReference()
 
 	if Params.DataCoordCfg.EnableCompaction {
 		s.createCompactionHandler()
@@ -304,6 +312,7 @@ func (s *Server) Init() error {
 	s.initSegmentManager()
 
 	s.initGarbageCollection(storageCli)
+	s.initScrubber(storageCli)
 
 	return nil
 }
@@ -354,7 +363,12 @@ func (s *Server) initCluster() error {
 	}
 
 	var err error
-	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, withMsgstreamFactory(s.factory), withStateChecker())
+	opts := []ChannelManagerOpt{withMsgstreamFactory(s.factory), withStateChecker()}
+	if Params.DataCoordCfg.ChannelBalancePolicy == WeightedChannelBalancePolicy {
+		s.channelLoads = newChannelLoads()
+		opts = append(opts, withFactory(NewWeightedChannelPolicyFactory(s.kvClient, s.channelLoads)))
+	}
+	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, opts...)
 	if err != nil {
 		return err
 	}
@@ -372,6 +386,11 @@ func (s *Server) SetIndexCoord(indexCoord types.IndexCoord) {
 	s.indexCoord = indexCoord
 }
 
+// SetQueryCoord sets the querycoord client to sync the memory of growing segments on query nodes.
+func (s *Server) SetQueryCoord(queryCoord types.QueryCoord) {
+	s.queryCoord = queryCoord
+}
+
 func (s *Server) createCompactionHandler() {
 	s.compactionHandler = newCompactionPlanHandler(s.sessionManager, s.channelManager, s.meta, s.allocator, s.flushCh, s.segReferManager)
 }
@@ -405,6 +424,17 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		checkInterval:    Params.DataCoordCfg.GCInterval,
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance,
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance,
+		dryRun:           Params.DataCoordCfg.GCDryRun,
+		manualCollect:    Params.DataCoordCfg.EnableManualGC,
+	})
+}
+
+func (s *Server) initScrubber(cli storage.ChunkManager) {
+	s.scrubber = newScrubber(s.meta, s.indexCoord, ScrubberOption{
+		cli:              cli,
+		enabled:          Params.DataCoordCfg.EnableScrubber,
+		checkInterval:    Params.DataCoordCfg.ScrubberInterval,
+		maxFilesPerRound: Params.DataCoordCfg.ScrubberMaxFilesPerRound,
 	})
 }
 
@@ -474,6 +504,16 @@ func (s *Server) initServiceDiscovery() error {
 	return err
 }
 
+// initSharedReference rebuilds the references on the binlogs shared by cloned segments
+func (s *Server) initSharedReference() {
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return len(segment.GetSharedFrom()) > 0
+	})
+	for _, segment := range segments {
+		s.segReferManager.AddSharedReference(segment.GetSharedFrom()...)
+	}
+}
+
 func (s *Server) initSegmentManager() {
 	if s.segmentManager == nil {
 		s.segmentManager = newSegmentManager(s.meta, s.allocator, s.rootCoordClient)
@@ -481,11 +521,17 @@ func (s *Server) initSegmentManager() {
 }
 
 func (s *Server) initMeta(chunkManagerRootPath string) error {
-	etcdKV := etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath)
-	s.kvClient = etcdKV
+	if metaKV := rocksdbkv.NewLocalMetaKV(Params.EtcdCfg.MetaRootPath); metaKV != nil {
+		s.kvClient = metaKV
+	} else {
+		s.kvClient = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath)
+	}
 	reloadEtcdFn := func() error {
-		var err error
-		s.meta, err = newMeta(s.ctx, s.kvClient, chunkManagerRootPath)
+		catalog, err := newCatalog(s.kvClient, chunkManagerRootPath)
+		if err != nil {
+			return err
+		}
+		s.meta, err = newMetaWithCatalog(s.ctx, catalog)
 		if err != nil {
 			return err
 		}
@@ -500,7 +546,61 @@ func (s *Server) startServerLoop() {
 	s.startDataNodeTtLoop(s.serverLoopCtx)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
+	s.startChannelBalanceLoop(s.serverLoopCtx)
+	s.startGrowingSizeSyncLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
+	s.scrubber.start()
+}
+
+// startGrowingSizeSyncLoop starts a goroutine to sync the memory size of the growing segments on query nodes,
+// which the adaptive seal policy seals segments by
+func (s *Server) startGrowingSizeSyncLoop(ctx context.Context) {
+	if s.queryCoord == nil {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(Params.DataCoordCfg.GrowingSizeSyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("growing size sync loop quit")
+				return
+			case <-ticker.C:
+				s.syncGrowingSegmentsSize(ctx)
+			}
+		}
+	}()
+}
+
+func (s *Server) syncGrowingSegmentsSize(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.GrowingSizeSyncInterval)
+	defer cancel()
+	sizes, err := s.fetchGrowingSegmentsSize(ctx)
+	if err != nil {
+		log.Warn("failed to sync the memory size of growing segments from querycoord", zap.Error(err))
+		return
+	}
+	s.segmentManager.SetGrowingSegmentsSize(sizes)
+}
+
+// startChannelBalanceLoop starts a goroutine to balance channels between datanodes by their loads,
+// only for the weighted channel balance policy
+func (s *Server) startChannelBalanceLoop(ctx context.Context) {
+	if s.channelLoads == nil {
+		return
+	}
+	checker := newChannelBalanceChecker(s.channelManager, s.channelLoads, s.fetchChannelLoads,
+		Params.DataCoordCfg.ChannelBalanceInterval, Params.DataCoordCfg.ChannelBalanceTolerance)
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		checker.run(ctx)
+	}()
 }
 
 // startDataNodeTtLoop start a goroutine to recv data node tt msg from msgstream
@@ -873,6 +973,7 @@ func (s *Server) Stop() error {
 	logutil.Logger(s.ctx).Debug("server shutdown")
 	s.cluster.Close()
 	s.garbageCollector.close()
+	s.scrubber.close()
 	s.stopServerLoop()
 	s.session.Revoke(time.Second)
 
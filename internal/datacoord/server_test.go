@@ -50,6 +50,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
@@ -998,6 +999,10 @@ func (s *spySegmentManager) DropSegmentsOfChannel(ctx context.Context, channel s
 	s.spyCh <- struct{}{}
 }
 
+// SetGrowingSegmentsSize updates the memory size in bytes of the growing segments measured on query nodes
+func (s *spySegmentManager) SetGrowingSegmentsSize(sizes map[UniqueID]int64) {
+}
+
 func TestSaveBinlogPaths(t *testing.T) {
 	t.Run("Normal SaveRequest", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -1682,6 +1687,23 @@ func TestGetVChannelPos(t *testing.T) {
 		assert.EqualValues(t, vchannel, infos.ChannelName)
 		assert.EqualValues(t, []byte{14, 15, 16}, infos.SeekPosition.MsgID)
 	})
+
+	t.Run("seek from channel checkpoint", func(t *testing.T) {
+		err := svr.meta.UpdateChannelCheckpoint("ch1", &internalpb.MsgPosition{
+			ChannelName: "ch1",
+			MsgID:       []byte{20, 21, 22},
+			Timestamp:   10,
+		})
+		assert.NoError(t, err)
+		vchan := svr.handler.GetVChanPositions(&channel{Name: "ch1", CollectionID: 0}, allPartitionID)
+		assert.EqualValues(t, 2, len(vchan.UnflushedSegmentIds))
+		assert.EqualValues(t, []byte{20, 21, 22}, vchan.GetSeekPosition().GetMsgID())
+
+		svr.handler.FinishDropChannel("ch1")
+		assert.Nil(t, svr.meta.GetChannelCheckpoint("ch1"))
+		vchan = svr.handler.GetVChanPositions(&channel{Name: "ch1", CollectionID: 0}, allPartitionID)
+		assert.EqualValues(t, []byte{1, 2, 3}, vchan.GetSeekPosition().GetMsgID())
+	})
 }
 
 func TestShouldDropChannel(t *testing.T) {
@@ -2211,6 +2233,32 @@ func TestManualCompaction(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("test targeted manual compaction", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.isServing = ServerStateHealthy
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"forceTriggerTargetedCompaction": func(collectionID int64, target *compactionTarget, ct *compactTime) (UniqueID, error) {
+					assert.True(t, target.partitionIDs.Contain(2))
+					assert.True(t, target.segmentIDs.Contain(3, 4))
+					assert.EqualValues(t, 1, target.targetSegmentNum)
+					return 1, nil
+				},
+			},
+		}
+
+		resp, err := svr.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{
+			CollectionID:     1,
+			Timetravel:       1,
+			PartitionIDs:     []int64{2},
+			SegmentIDs:       []int64{3, 4},
+			TargetSegmentNum: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.EqualValues(t, 1, resp.GetCompactionID())
+	})
+
 	t.Run("test manual compaction with closed server", func(t *testing.T) {
 		svr := &Server{}
 		svr.isServing = ServerStateStopped
@@ -2871,6 +2919,223 @@ func TestDataCoord_SaveImportSegment(t *testing.T) {
 	})
 }
 
+func TestDataCoord_CloneSegments(t *testing.T) {
+	t.Run("test clone segments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		flushed := buildSegment(100, 100, 1, "ch1", false)
+		flushed.State = commonpb.SegmentState_Flushed
+		flushed.NumOfRows = 10
+		flushed.StartPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 10}
+		flushed.DmlPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 100}
+		flushed.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(101, "insert_log/100/100/1/101/1")}
+		// the deltalog spanning the clone timestamp is rewritten with the deletes before it
+		spanning := &storage.DeleteData{}
+		spanning.Append(storage.NewInt64PrimaryKey(1), 80)
+		spanning.Append(storage.NewInt64PrimaryKey(2), 150)
+		blob, err := storage.NewDeleteCodec().Serialize(100, 100, 1, spanning)
+		require.NoError(t, err)
+		spanningPath := path.Join(svr.chunkManager.RootPath(), "delta_log/100/100/1/3")
+		require.NoError(t, svr.chunkManager.Write(context.TODO(), spanningPath, blob.GetValue()))
+		defer svr.chunkManager.RemoveWithPrefix(context.TODO(), path.Join(svr.chunkManager.RootPath(), "delta_log/200"))
+		defer svr.chunkManager.Remove(context.TODO(), spanningPath)
+		flushed.Deltalogs = []*datapb.FieldBinlog{{
+			FieldID: 0,
+			Binlogs: []*datapb.Binlog{
+				{LogPath: "delta_log/100/100/1/2", TimestampFrom: 10, TimestampTo: 50},
+				{LogPath: spanningPath, TimestampFrom: 80, TimestampTo: 150, EntriesNum: 2},
+				{LogPath: "delta_log/100/100/1/4", TimestampFrom: 150, TimestampTo: 180},
+			},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(flushed))
+		late := buildSegment(100, 100, 2, "ch1", false)
+		late.State = commonpb.SegmentState_Flushed
+		late.StartPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 150}
+		late.DmlPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 200}
+		assert.NoError(t, svr.meta.AddSegment(late))
+		growing := buildSegment(100, 100, 3, "ch1", false)
+		assert.NoError(t, svr.meta.AddSegment(growing))
+
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  110,
+			Address: "localhost:8080",
+		})
+		err = svr.channelManager.AddNode(110)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{Name: "ch2", CollectionID: 200})
+		assert.Nil(t, err)
+
+		resp, err := svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 300},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionMapping:   map[int64]int64{100: 200},
+			ChannelMapping:     map[string]string{"ch1": "ch2"},
+			Timestamp:          100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetSegmentIDs()))
+
+		cloned := svr.meta.GetSegment(resp.GetSegmentIDs()[0])
+		assert.NotNil(t, cloned)
+		assert.Equal(t, int64(200), cloned.GetCollectionID())
+		assert.Equal(t, int64(200), cloned.GetPartitionID())
+		assert.Equal(t, "ch2", cloned.GetInsertChannel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, cloned.GetState())
+		assert.Equal(t, int64(10), cloned.GetNumOfRows())
+		assert.Equal(t, []UniqueID{1}, cloned.GetSharedFrom())
+		assert.Equal(t, uint64(300), cloned.GetDmlPosition().GetTimestamp())
+		assert.Equal(t, "insert_log/100/100/1/101/1", cloned.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		// delete logs written after the clone timestamp are not shared
+		deltalogs := cloned.GetDeltalogs()[0].GetBinlogs()
+		require.Equal(t, 2, len(deltalogs))
+		assert.Equal(t, "delta_log/100/100/1/2", deltalogs[0].GetLogPath())
+		assert.Equal(t, int64(1), deltalogs[1].GetEntriesNum())
+		assert.Equal(t, uint64(80), deltalogs[1].GetTimestampTo())
+		value, err := svr.chunkManager.Read(context.TODO(), deltalogs[1].GetLogPath())
+		require.NoError(t, err)
+		assert.Equal(t, storage.Checksum(value), deltalogs[1].GetChecksum())
+		_, segmentID, trimmed, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Value: value}})
+		require.NoError(t, err)
+		assert.Equal(t, cloned.GetID(), segmentID)
+		assert.Equal(t, []storage.PrimaryKey{storage.NewInt64PrimaryKey(1)}, trimmed.Pks)
+		assert.True(t, svr.segReferManager.HasSharedReference(1))
+	})
+
+	t.Run("test clone segments not flushed up to the timestamp", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		flushed := buildSegment(100, 100, 1, "ch1", false)
+		flushed.State = commonpb.SegmentState_Flushed
+		flushed.StartPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 10}
+		flushed.DmlPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 200}
+		assert.NoError(t, svr.meta.AddSegment(flushed))
+
+		resp, err := svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionMapping:   map[int64]int64{100: 200},
+			ChannelMapping:     map[string]string{"ch1": "ch2"},
+			Timestamp:          100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, "segment 1 is not flushed up to the clone timestamp 100, its state is Flushed and its flushed position timestamp is 200, "+
+			"flush the collection and clone with a timestamp not earlier than the flushed position", resp.GetStatus().GetReason())
+		assert.False(t, svr.segReferManager.HasSharedReference(1))
+
+		// rows of a growing segment are not flushed yet
+		growing := buildSegment(100, 100, 2, "ch1", false)
+		growing.NumOfRows = 10
+		assert.NoError(t, svr.meta.AddSegment(growing))
+		resp, err = svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionMapping:   map[int64]int64{100: 200},
+			ChannelMapping:     map[string]string{"ch1": "ch2"},
+			Timestamp:          300,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("test clone segments w/o target channel", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		flushed := buildSegment(100, 100, 1, "ch1", false)
+		flushed.State = commonpb.SegmentState_Flushed
+		flushed.StartPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 10}
+		assert.NoError(t, svr.meta.AddSegment(flushed))
+
+		resp, err := svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionMapping:   map[int64]int64{100: 200},
+			Timestamp:          100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.False(t, svr.segReferManager.HasSharedReference(1))
+	})
+
+	t.Run("test clone segments w/ closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestDataCoord_ChannelCheckpoint(t *testing.T) {
+	t.Run("test update and get channel checkpoints", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  110,
+			Address: "localhost:8080",
+		})
+		err := svr.channelManager.AddNode(110)
+		assert.NoError(t, err)
+		err = svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 100})
+		assert.NoError(t, err)
+
+		ts := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+		status, err := svr.UpdateChannelCheckpoint(context.TODO(), &datapb.UpdateChannelCheckpointRequest{
+			Base:     &commonpb.MsgBase{SourceID: 110},
+			VChannel: "ch1",
+			Position: &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: ts},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		// not watched on the node
+		status, err = svr.UpdateChannelCheckpoint(context.TODO(), &datapb.UpdateChannelCheckpointRequest{
+			Base:     &commonpb.MsgBase{SourceID: 111},
+			VChannel: "ch1",
+			Position: &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: ts + 1},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_MetaFailed, status.GetErrorCode())
+
+		// nil position
+		status, err = svr.UpdateChannelCheckpoint(context.TODO(), &datapb.UpdateChannelCheckpointRequest{
+			Base:     &commonpb.MsgBase{SourceID: 110},
+			VChannel: "ch1",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		resp, err := svr.GetChannelCheckpoints(context.TODO(), &datapb.GetChannelCheckpointsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetCheckpoints()))
+		assert.Equal(t, "ch1", resp.GetCheckpoints()[0].GetVChannel())
+		assert.Equal(t, ts, resp.GetCheckpoints()[0].GetPosition().GetTimestamp())
+		assert.GreaterOrEqual(t, resp.GetCheckpoints()[0].GetLagMs(), time.Minute.Milliseconds())
+
+		resp, err = svr.GetChannelCheckpoints(context.TODO(), &datapb.GetChannelCheckpointsRequest{VChannels: []string{"ch2"}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetCheckpoints())
+	})
+
+	t.Run("test channel checkpoint w/ closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		status, err := svr.UpdateChannelCheckpoint(context.TODO(), &datapb.UpdateChannelCheckpointRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		resp, err := svr.GetChannelCheckpoints(context.TODO(), &datapb.GetChannelCheckpointsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestDataCoord_UnsetIsImportingState(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -19,18 +19,23 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/api/milvuspb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -419,6 +424,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		req.GetFlushed(),
 		req.GetDropped(),
 		req.GetImporting(),
+		req.GetSorted(),
 		req.GetField2BinlogPaths(),
 		req.GetField2StatslogPaths(),
 		req.GetDeltalogs(),
@@ -855,7 +861,9 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 
 // ManualCompaction triggers a compaction for a collection
 func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	log.Info("received manual compaction", zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("received manual compaction", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()), zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+		zap.Int64("targetSegmentNum", req.GetTargetSegmentNum()))
 
 	resp := &milvuspb.ManualCompactionResponse{
 		Status: &commonpb.Status{
@@ -882,7 +890,13 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		return resp, nil
 	}
 
-	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID, ct)
+	var id UniqueID
+	if len(req.GetPartitionIDs()) > 0 || len(req.GetSegmentIDs()) > 0 || req.GetTargetSegmentNum() != 0 {
+		target := newCompactionTarget(req.GetPartitionIDs(), req.GetSegmentIDs(), req.GetTargetSegmentNum())
+		id, err = s.compactionTrigger.forceTriggerTargetedCompaction(req.GetCollectionID(), target, ct)
+	} else {
+		id, err = s.compactionTrigger.forceTriggerCompaction(req.GetCollectionID(), ct)
+	}
 	if err != nil {
 		log.Error("failed to trigger manual compaction", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
 		resp.Status.Reason = err.Error()
@@ -1332,3 +1346,304 @@ func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+
+// CloneSegments creates flushed segments in the target collection, each of them shares the binlogs of a flushed segment
+// of the source collection, so no data is copied. Segments having data before the given timestamp are cloned, they must
+// be flushed up to the timestamp, otherwise the request fails.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	log.Info("receive clone segments request",
+		zap.Int64("collection ID", req.GetCollectionID()),
+		zap.Int64("target collection ID", req.GetTargetCollectionID()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+	resp := &datapb.CloneSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		log.Warn("failed to clone segments for closed server")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+		return resp, nil
+	}
+
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		_, ok := req.GetPartitionMapping()[segment.GetPartitionID()]
+		return ok && segment.GetCollectionID() == req.GetCollectionID() &&
+			isSegmentHealthy(segment) && !segment.GetIsImporting() && hasDataBefore(segment, req.GetTimestamp())
+	})
+	// The clone is a point-in-time view, rows inserted before the timestamp must all be flushed.
+	for _, segment := range segments {
+		if segment.GetState() != commonpb.SegmentState_Flushed ||
+			segment.GetDmlPosition().GetTimestamp() > req.GetTimestamp() {
+			log.Warn("segment is not flushed up to the clone timestamp",
+				zap.Int64("segment ID", segment.GetID()),
+				zap.String("state", segment.GetState().String()),
+				zap.Uint64("dml position", segment.GetDmlPosition().GetTimestamp()))
+			resp.Status.Reason = fmt.Sprintf("segment %d is not flushed up to the clone timestamp %d, "+
+				"its state is %s and its flushed position timestamp is %d, "+
+				"flush the collection and clone with a timestamp not earlier than the flushed position",
+				segment.GetID(), req.GetTimestamp(), segment.GetState().String(), segment.GetDmlPosition().GetTimestamp())
+			return resp, nil
+		}
+	}
+	for _, segment := range segments {
+		segmentID, err := s.cloneSegment(ctx, segment, req)
+		if err != nil {
+			log.Warn("failed to clone segment", zap.Int64("segment ID", segment.GetID()), zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		resp.SegmentIDs = append(resp.SegmentIDs, segmentID)
+	}
+	log.Info("clone segments successfully",
+		zap.Int64("target collection ID", req.GetTargetCollectionID()),
+		zap.Int64s("segment IDs", resp.GetSegmentIDs()))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// cloneSegment adds a flushed segment sharing the binlogs of the given segment to the target collection.
+// Like imported segments, the DataNode watching the target channel is asked to add the segment first.
+func (s *Server) cloneSegment(ctx context.Context, segment *SegmentInfo, req *datapb.CloneSegmentsRequest) (UniqueID, error) {
+	channel, ok := req.GetChannelMapping()[segment.GetInsertChannel()]
+	if !ok {
+		return 0, fmt.Errorf("no target channel for channel %s", segment.GetInsertChannel())
+	}
+	partitionID := req.GetPartitionMapping()[segment.GetPartitionID()]
+	ok, nodeID := s.channelManager.getNodeIDByChannelName(channel)
+	if !ok {
+		return 0, fmt.Errorf("no DataNode found for channel %s", channel)
+	}
+	cli, err := s.sessionManager.getClient(ctx, nodeID)
+	if err != nil {
+		return 0, err
+	}
+	segmentID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deltalogs, err := s.cloneDeltalogs(ctx, segment, req.GetTargetCollectionID(), partitionID, segmentID, req.GetTimestamp())
+	if err != nil {
+		return 0, err
+	}
+	statslogs := cloneFieldBinlogs(segment.GetStatslogs())
+	resp, err := cli.AddImportSegment(ctx, &datapb.AddImportSegmentRequest{
+		Base: &commonpb.MsgBase{
+			SourceID:  Params.DataCoordCfg.GetNodeID(),
+			Timestamp: req.GetBase().GetTimestamp(),
+		},
+		SegmentId:    segmentID,
+		ChannelName:  channel,
+		CollectionId: req.GetTargetCollectionID(),
+		PartitionId:  partitionID,
+		RowNum:       segment.GetNumOfRows(),
+		StatsLog:     statslogs,
+	})
+	if err := VerifyResponse(resp.GetStatus(), err); err != nil {
+		return 0, err
+	}
+
+	position := &internalpb.MsgPosition{
+		ChannelName: channel,
+		MsgID:       resp.GetChannelPos(),
+		Timestamp:   req.GetBase().GetTimestamp(),
+	}
+	sharedFrom := lo.Uniq(append([]UniqueID{segment.GetID()}, segment.GetSharedFrom()...))
+	cloned := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:             segmentID,
+		CollectionID:   req.GetTargetCollectionID(),
+		PartitionID:    partitionID,
+		InsertChannel:  channel,
+		NumOfRows:      segment.GetNumOfRows(),
+		State:          commonpb.SegmentState_Flushed,
+		MaxRowNum:      segment.GetMaxRowNum(),
+		LastExpireTime: segment.GetLastExpireTime(),
+		StartPosition:  position,
+		DmlPosition:    position,
+		Binlogs:        cloneFieldBinlogs(segment.GetBinlogs()),
+		Statslogs:      statslogs,
+		Deltalogs:      deltalogs,
+		SharedFrom:     sharedFrom,
+		Sorted:         segment.GetSorted(),
+	})
+	// reference the shared binlogs before the segment is visible, so that GC never sees them unreferenced
+	s.segReferManager.AddSharedReference(sharedFrom...)
+	if err := s.meta.AddSegment(cloned); err != nil {
+		s.segReferManager.ReleaseSharedReference(sharedFrom...)
+		return 0, err
+	}
+	return segmentID, nil
+}
+
+// cloneFieldBinlogs deep copies the field binlogs.
+func cloneFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		ret = append(ret, proto.Clone(fieldBinlog).(*datapb.FieldBinlog))
+	}
+	return ret
+}
+
+// hasDataBefore returns true if the segment may have rows inserted before `ts`.
+func hasDataBefore(segment *SegmentInfo, ts Timestamp) bool {
+	if segment.GetStartPosition() == nil {
+		// the start position is unknown until the segment is flushed
+		return segment.currRows > 0
+	}
+	return segment.GetStartPosition().GetTimestamp() <= ts
+}
+
+// cloneDeltalogs shares the deltalogs of the segment ending before `ts` with the cloned segment. A deltalog spanning `ts`
+// is rewritten with its deletes before `ts` into a new deltalog owned by the cloned segment, deltalogs after `ts` are skipped.
+func (s *Server) cloneDeltalogs(ctx context.Context, segment *SegmentInfo, collectionID, partitionID, segmentID UniqueID,
+	ts Timestamp) ([]*datapb.FieldBinlog, error) {
+	ret := make([]*datapb.FieldBinlog, 0, len(segment.GetDeltalogs()))
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			switch {
+			case binlog.GetTimestampTo() <= ts:
+				binlogs = append(binlogs, proto.Clone(binlog).(*datapb.Binlog))
+			case binlog.GetTimestampFrom() <= ts:
+				trimmed, err := s.trimDeltalog(ctx, binlog, collectionID, partitionID, segmentID, ts)
+				if err != nil {
+					return nil, err
+				}
+				if trimmed != nil {
+					binlogs = append(binlogs, trimmed)
+				}
+			}
+		}
+		if len(binlogs) == 0 {
+			continue
+		}
+		ret = append(ret, &datapb.FieldBinlog{
+			FieldID: fieldBinlog.GetFieldID(),
+			Binlogs: binlogs,
+		})
+	}
+	return ret, nil
+}
+
+// trimDeltalog writes the deletes of the deltalog before `ts` into a new deltalog of the given segment,
+// returns nil if there is no such delete.
+func (s *Server) trimDeltalog(ctx context.Context, binlog *datapb.Binlog, collectionID, partitionID, segmentID UniqueID,
+	ts Timestamp) (*datapb.Binlog, error) {
+	value, err := s.chunkManager.Read(ctx, binlog.GetLogPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read deltalog %s: %w", binlog.GetLogPath(), err)
+	}
+	_, _, deleteData, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Key: binlog.GetLogPath(), Value: value}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize deltalog %s: %w", binlog.GetLogPath(), err)
+	}
+	trimmed := &storage.DeleteData{}
+	tsFrom, tsTo := Timestamp(math.MaxUint64), Timestamp(0)
+	for i, deleteTs := range deleteData.Tss {
+		if deleteTs > ts {
+			continue
+		}
+		trimmed.Append(deleteData.Pks[i], deleteTs)
+		if deleteTs < tsFrom {
+			tsFrom = deleteTs
+		}
+		if deleteTs > tsTo {
+			tsTo = deleteTs
+		}
+	}
+	if trimmed.RowCount == 0 {
+		return nil, nil
+	}
+	blob, err := storage.NewDeleteCodec().Serialize(collectionID, partitionID, segmentID, trimmed)
+	if err != nil {
+		return nil, err
+	}
+	logID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logPath := metautil.BuildDeltaLogPath(s.chunkManager.RootPath(), collectionID, partitionID, segmentID, logID)
+	if err := s.chunkManager.Write(ctx, logPath, blob.GetValue()); err != nil {
+		return nil, err
+	}
+	return &datapb.Binlog{
+		EntriesNum:    trimmed.RowCount,
+		TimestampFrom: tsFrom,
+		TimestampTo:   tsTo,
+		LogPath:       logPath,
+		LogSize:       int64(len(blob.GetValue())),
+		LogID:         logID,
+		Checksum:      storage.Checksum(blob.GetValue()),
+	}, nil
+}
+
+// UpdateChannelCheckpoint updates the checkpoint of the vChannel reported by the DataNode watching it,
+// all the data consumed before the checkpoint is durable.
+func (s *Server) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		log.Warn("failed to update channel checkpoint for closed server")
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+		return resp, nil
+	}
+
+	channel := req.GetVChannel()
+	nodeID := req.GetBase().GetSourceID()
+	if !s.channelManager.Match(nodeID, channel) {
+		failResponseWithCode(resp, commonpb.ErrorCode_MetaFailed, fmt.Sprintf("channel %s is not watched on node %d", channel, nodeID))
+		log.Warn("node is not matched with channel", zap.String("channel", channel), zap.Int64("nodeID", nodeID))
+		return resp, nil
+	}
+
+	if err := s.meta.UpdateChannelCheckpoint(channel, req.GetPosition()); err != nil {
+		log.Warn("failed to update channel checkpoint", zap.String("channel", channel), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetChannelCheckpoints returns the checkpoints of the vChannels and how far they lag behind now,
+// the checkpoints of all the vChannels are returned if no vChannel is specified.
+func (s *Server) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	resp := &datapb.GetChannelCheckpointsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		log.Warn("failed to get channel checkpoints for closed server")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+		return resp, nil
+	}
+
+	channelCPs := s.meta.ListChannelCheckpoints()
+	channels := req.GetVChannels()
+	if len(channels) == 0 {
+		channels = make([]string, 0, len(channelCPs))
+		for channel := range channelCPs {
+			channels = append(channels, channel)
+		}
+		sort.Strings(channels)
+	}
+
+	now := time.Now()
+	for _, channel := range channels {
+		pos, ok := channelCPs[channel]
+		if !ok {
+			continue
+		}
+		ts, _ := tsoutil.ParseTS(pos.GetTimestamp())
+		resp.Checkpoints = append(resp.Checkpoints, &datapb.ChannelCheckpoint{
+			VChannel: channel,
+			Position: pos,
+			LagMs:    now.Sub(ts).Milliseconds(),
+		})
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -190,3 +191,12 @@ func getZeroTime() time.Time {
 	var t time.Time
 	return t
 }
+
+// getBinlogNum returns the number of binlogs of all the fields
+func getBinlogNum(fieldBinlogs []*datapb.FieldBinlog) int {
+	num := 0
+	for _, fieldBinlog := range fieldBinlogs {
+		num += len(fieldBinlog.GetBinlogs())
+	}
+	return num
+}
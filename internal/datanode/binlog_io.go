@@ -19,12 +19,14 @@ package datanode
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -55,6 +57,7 @@ type uploader interface {
 	upload(ctx context.Context, segID, partID UniqueID, iData []*InsertData, segStats []byte, dData *DeleteData, meta *etcdpb.CollectionMeta) (*segPaths, error)
 	uploadInsertLog(ctx context.Context, segID, partID UniqueID, iData *InsertData, meta *etcdpb.CollectionMeta) (map[UniqueID]*datapb.FieldBinlog, error)
 	uploadStatsLog(ctx context.Context, segID, partID UniqueID, segStats []byte, meta *etcdpb.CollectionMeta) ([]*datapb.FieldBinlog, error)
+	uploadFieldStatsLog(ctx context.Context, segID, partID UniqueID, fieldStats []*storage.FieldStats, meta *etcdpb.CollectionMeta) ([]*datapb.FieldBinlog, error)
 	uploadDeltaLog(ctx context.Context, segID, partID UniqueID, dData *DeleteData, meta *etcdpb.CollectionMeta) ([]*datapb.FieldBinlog, error)
 }
 
@@ -203,7 +206,7 @@ func (b *binlogIO) upload(
 	kvs[key] = segStats
 	statsField2Path[pkID] = &datapb.FieldBinlog{
 		FieldID: pkID,
-		Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key}},
+		Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, Checksum: storage.Checksum(segStats)}},
 	}
 
 	for _, path := range insertField2Path {
@@ -232,6 +235,7 @@ func (b *binlogIO) upload(
 				EntriesNum: dData.RowCount,
 				LogPath:    k,
 				LogSize:    int64(len(v)),
+				Checksum:   storage.Checksum(v),
 			}},
 		})
 	}
@@ -265,7 +269,8 @@ func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueI
 // genInsertBlobs returns kvs, insert-paths, stats-paths
 func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string][]byte, map[UniqueID]*datapb.FieldBinlog, error) {
 	inCodec := storage.NewInsertCodec(meta)
-	inlogs, _, err := inCodec.Serialize(partID, segID, data)
+	inCodec.SortByPK = Params.DataNodeCfg.SortByPK
+	inlogs, err := serializeInsertData(inCodec, partID, segID, data)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -274,6 +279,12 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		kvs     = make(map[string][]byte, len(inlogs)+1)
 		inpaths = make(map[UniqueID]*datapb.FieldBinlog)
 	)
+	// the time range of the rows is recorded like the flushed binlogs, the compaction policies rely on it
+	tsFrom, tsTo := getTimestampRange(data)
+	var entriesNum int64
+	if tf, ok := data.Data[common.TimeStampField]; ok {
+		entriesNum = int64(tf.RowNum())
+	}
 
 	notifyGenIdx := make(chan struct{})
 	defer close(notifyGenIdx)
@@ -284,6 +295,27 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 	}
 
 	for _, blob := range inlogs {
+		if blob.GetKey() == storage.SegmentFileDir {
+			// all the fields share the segment file
+			key := segmentFileKey(b.ChunkManager.RootPath(), meta.GetID(), partID, segID, <-generator)
+			kvs[key] = blob.GetValue()
+			checksum := storage.Checksum(blob.GetValue())
+			for fID, fieldData := range data.Data {
+				inpaths[fID] = &datapb.FieldBinlog{
+					FieldID: fID,
+					Binlogs: []*datapb.Binlog{{
+						EntriesNum:    entriesNum,
+						TimestampFrom: tsFrom,
+						TimestampTo:   tsTo,
+						LogSize:       int64(fieldData.GetMemorySize()),
+						LogPath:       key,
+						Checksum:      checksum,
+					}},
+				}
+			}
+			continue
+		}
+
 		// Blob Key is generated by Serialize from int64 fieldID in collection schema, which won't raise error in ParseInt
 		fID, _ := strconv.ParseInt(blob.GetKey(), 10, 64)
 		k := metautil.JoinIDPath(meta.GetID(), partID, segID, fID, <-generator)
@@ -295,13 +327,92 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		kvs[key] = value
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key}},
+			Binlogs: []*datapb.Binlog{{
+				EntriesNum:    entriesNum,
+				TimestampFrom: tsFrom,
+				TimestampTo:   tsTo,
+				LogSize:       int64(fileLen),
+				LogPath:       key,
+				Checksum:      storage.Checksum(value),
+			}},
 		}
 	}
 
 	return kvs, inpaths, nil
 }
 
+// getTimestampRange returns the minimum and maximum timestamp of the rows in the insert data.
+func getTimestampRange(data *InsertData) (Timestamp, Timestamp) {
+	tf, ok := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok || len(tf.Data) == 0 {
+		return 0, 0
+	}
+	tsFrom, tsTo := Timestamp(tf.Data[0]), Timestamp(tf.Data[0])
+	for _, ts := range tf.Data[1:] {
+		if Timestamp(ts) < tsFrom {
+			tsFrom = Timestamp(ts)
+		}
+		if Timestamp(ts) > tsTo {
+			tsTo = Timestamp(ts)
+		}
+	}
+	return tsFrom, tsTo
+}
+
+// serializeInsertData serializes the insert data in the storage version of the datanode,
+// the blobs of v1 are keyed by field id and v2 returns a single segment file keyed by storage.SegmentFileDir.
+// The payloads are compressed by the binlog compression of the collection.
+func serializeInsertData(inCodec *storage.InsertCodec, partID, segID UniqueID, data *InsertData) ([]*storage.Blob, error) {
+	inCodec.Compression = getBinlogCompression(inCodec.Schema.GetSchema().GetName())
+
+	var blobs []*storage.Blob
+	if Params.DataNodeCfg.StorageVersion == storage.StorageV2 {
+		blob, err := inCodec.SerializeSegmentFile(partID, segID, data)
+		if err != nil {
+			return nil, err
+		}
+		blobs = []*storage.Blob{blob}
+	} else {
+		var err error
+		if blobs, _, err = inCodec.Serialize(partID, segID, data); err != nil {
+			return nil, err
+		}
+	}
+
+	var memorySize, binlogSize int
+	for _, fieldData := range data.Data {
+		memorySize += fieldData.GetMemorySize()
+	}
+	for _, blob := range blobs {
+		binlogSize += len(blob.GetValue())
+	}
+	if binlogSize > 0 {
+		metrics.DataNodeBinlogCompressionRatio.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID()), string(inCodec.Compression.Type)).
+			Observe(float64(memorySize) / float64(binlogSize))
+	}
+	return blobs, nil
+}
+
+// getBinlogCompression returns the compression of the insert binlogs of the collection,
+// the default compression is used if the configured one is invalid.
+func getBinlogCompression(collectionName string) storage.Compression {
+	value := Params.DataNodeCfg.GetBinlogCompression(collectionName)
+	compression, err := storage.ParseCompression(value)
+	if err != nil {
+		log.Warn("invalid binlog compression, use the default compression",
+			zap.String("collection", collectionName), zap.String("compression", value), zap.Error(err))
+		return storage.DefaultCompression
+	}
+	return compression
+}
+
+// segmentFileKey returns the key of a segment file of storage v2:
+// [rootPath]/[insert_log]/collID/partID/segID/segment/logID
+func segmentFileKey(rootPath string, collID, partID, segID, logID UniqueID) string {
+	return path.Join(rootPath, common.SegmentInsertLogPath, metautil.JoinIDPath(collID, partID, segID),
+		storage.SegmentFileDir, strconv.FormatInt(logID, 10))
+}
+
 func (b *binlogIO) idxGenerator(n int, done <-chan struct{}) (<-chan UniqueID, error) {
 
 	idStart, _, err := b.allocIDBatch(uint32(n))
@@ -405,8 +516,9 @@ func (b *binlogIO) uploadStatsLog(
 	statsInfo = append(statsInfo, &datapb.FieldBinlog{
 		FieldID: pkID,
 		Binlogs: []*datapb.Binlog{{
-			LogPath: key,
-			LogSize: int64(fileLen),
+			LogPath:  key,
+			LogSize:  int64(fileLen),
+			Checksum: storage.Checksum(segStats),
 		}},
 	})
 
@@ -418,6 +530,60 @@ func (b *binlogIO) uploadStatsLog(
 	return statsInfo, nil
 }
 
+// uploadFieldStatsLog uploads the min/max statistics of the scalar fields, one statslog for each field.
+func (b *binlogIO) uploadFieldStatsLog(
+	ctx context.Context,
+	segID UniqueID,
+	partID UniqueID,
+	fieldStats []*storage.FieldStats,
+	meta *etcdpb.CollectionMeta) ([]*datapb.FieldBinlog, error) {
+	if len(fieldStats) == 0 {
+		return nil, nil
+	}
+
+	blobs, err := storage.SerializeFieldStats(fieldStats)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		statsInfo = make([]*datapb.FieldBinlog, 0, len(blobs))
+		kvs       = make(map[string][]byte, len(blobs))
+	)
+
+	notifyGenIdx := make(chan struct{})
+	defer close(notifyGenIdx)
+
+	generator, err := b.idxGenerator(len(blobs), notifyGenIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, blob := range blobs {
+		// Blob Key is generated by SerializeFieldStats from int64 fieldID in collection schema, which won't raise error in ParseInt
+		fID, _ := strconv.ParseInt(blob.GetKey(), 10, 64)
+		k := metautil.JoinIDPath(meta.GetID(), partID, segID, fID, <-generator)
+		key := path.Join(b.ChunkManager.RootPath(), common.SegmentStatslogPath, k)
+
+		kvs[key] = blob.GetValue()
+		statsInfo = append(statsInfo, &datapb.FieldBinlog{
+			FieldID: fID,
+			Binlogs: []*datapb.Binlog{{
+				LogPath:  key,
+				LogSize:  int64(len(blob.GetValue())),
+				Checksum: storage.Checksum(blob.GetValue()),
+			}},
+		})
+	}
+
+	err = b.uploadSegmentFiles(ctx, meta.GetID(), segID, kvs)
+	if err != nil {
+		return nil, err
+	}
+
+	return statsInfo, nil
+}
+
 func (b *binlogIO) uploadDeltaLog(
 	ctx context.Context,
 	segID UniqueID,
@@ -446,6 +612,7 @@ func (b *binlogIO) uploadDeltaLog(
 				EntriesNum: dData.RowCount,
 				LogPath:    k,
 				LogSize:    int64(len(v)),
+				Checksum:   storage.Checksum(v),
 			}},
 		})
 	} else {
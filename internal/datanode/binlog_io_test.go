@@ -77,12 +77,32 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 12, len(in))
 		assert.Equal(t, 1, len(in[0].GetBinlogs()))
+		for _, fieldBinlog := range in {
+			// the time range of the rows is recorded in the insert binlogs
+			assert.EqualValues(t, 3, fieldBinlog.GetBinlogs()[0].GetTimestampFrom())
+			assert.EqualValues(t, 4, fieldBinlog.GetBinlogs()[0].GetTimestampTo())
+			assert.EqualValues(t, 2, fieldBinlog.GetBinlogs()[0].GetEntriesNum())
+		}
 
 		stats, err := b.uploadStatsLog(ctx, 1, 10, []byte{}, meta)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(stats))
 		assert.Equal(t, 1, len(stats[0].GetBinlogs()))
 
+		fieldStats := storage.GenerateFieldStats(meta.GetSchema(), iData)
+		assert.NotEmpty(t, fieldStats)
+		fieldStatsLogs, err := b.uploadFieldStatsLog(ctx, 1, 10, fieldStats, meta)
+		assert.NoError(t, err)
+		assert.Equal(t, len(fieldStats), len(fieldStatsLogs))
+		for i, l := range fieldStatsLogs {
+			assert.Equal(t, fieldStats[i].FieldID, l.GetFieldID())
+			assert.Equal(t, 1, len(l.GetBinlogs()))
+		}
+
+		fieldStatsLogs, err = b.uploadFieldStatsLog(ctx, 1, 10, nil, meta)
+		assert.NoError(t, err)
+		assert.Empty(t, fieldStatsLogs)
+
 		deltas, err := b.uploadDeltaLog(ctx, 1, 10, dData, meta)
 		assert.NoError(t, err)
 		assert.NotNil(t, deltas)
@@ -102,6 +122,10 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		assert.EqualError(t, err, errUploadToBlobStorage.Error())
 		assert.Nil(t, stats)
 
+		fieldStatsLogs, err = b.uploadFieldStatsLog(ctx, 1, 10, fieldStats, meta)
+		assert.EqualError(t, err, errUploadToBlobStorage.Error())
+		assert.Nil(t, fieldStatsLogs)
+
 		deltas, err = b.uploadDeltaLog(ctx, 1, 10, dData, meta)
 		assert.EqualError(t, err, errUploadToBlobStorage.Error())
 		assert.Nil(t, deltas)
@@ -367,6 +391,66 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("Test genInsertBlobs storage v2", func(t *testing.T) {
+		defer func(version int64) { Params.DataNodeCfg.StorageVersion = version }(Params.DataNodeCfg.StorageVersion)
+		Params.DataNodeCfg.StorageVersion = storage.StorageV2
+
+		f := &MetaFactory{}
+		meta := f.GetCollectionMeta(UniqueID(10001), "test_gen_blobs", schemapb.DataType_Int64)
+		kvs, pin, err := b.genInsertBlobs(genInsertData(), 10, 1, meta)
+		assert.NoError(t, err)
+		assert.Equal(t, 12, len(pin))
+		// all the fields share a single segment file
+		require.Equal(t, 1, len(kvs))
+		for key, value := range kvs {
+			assert.True(t, storage.IsSegmentFilePath(key))
+			assert.True(t, storage.IsSegmentFile(value))
+			for _, fieldBinlog := range pin {
+				assert.Equal(t, key, fieldBinlog.GetBinlogs()[0].GetLogPath())
+				assert.Equal(t, storage.Checksum(value), fieldBinlog.GetBinlogs()[0].GetChecksum())
+			}
+		}
+	})
+
+	t.Run("Test genInsertBlobs checksum", func(t *testing.T) {
+		f := &MetaFactory{}
+		meta := f.GetCollectionMeta(UniqueID(10001), "test_gen_blobs", schemapb.DataType_Int64)
+		kvs, pin, err := b.genInsertBlobs(genInsertData(), 10, 1, meta)
+		require.NoError(t, err)
+		for _, fieldBinlog := range pin {
+			binlog := fieldBinlog.GetBinlogs()[0]
+			value, ok := kvs[binlog.GetLogPath()]
+			require.True(t, ok)
+			assert.Equal(t, storage.Checksum(value), binlog.GetChecksum())
+			assert.NoError(t, storage.VerifyBinlog(value, binlog.GetChecksum()))
+		}
+	})
+
+	t.Run("Test genInsertBlobs compression", func(t *testing.T) {
+		defer func(compressions map[string]string) {
+			Params.DataNodeCfg.CollectionBinlogCompressions = compressions
+		}(Params.DataNodeCfg.CollectionBinlogCompressions)
+		Params.DataNodeCfg.CollectionBinlogCompressions = map[string]string{
+			"test_gen_blobs_lz4":     "lz4",
+			"test_gen_blobs_invalid": "gzip",
+		}
+
+		f := &MetaFactory{}
+		for name, expected := range map[string]storage.Compression{
+			"test_gen_blobs_lz4":     {Type: storage.CompressionLz4},
+			"test_gen_blobs_invalid": storage.DefaultCompression,
+		} {
+			meta := f.GetCollectionMeta(UniqueID(10001), name, schemapb.DataType_Int64)
+			kvs, _, err := b.genInsertBlobs(genInsertData(), 10, 1, meta)
+			require.NoError(t, err)
+			for _, value := range kvs {
+				compression, err := storage.GetBinlogCompression(value)
+				require.NoError(t, err)
+				assert.Equal(t, expected, compression)
+			}
+		}
+	})
+
 	t.Run("Test genInsertBlobs error", func(t *testing.T) {
 		kvs, pin, err := b.genInsertBlobs(&InsertData{}, 1, 1, nil)
 		assert.Error(t, err)
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/concurrency"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+var (
+	binlogUploaderOnce   sync.Once
+	sharedBinlogUploader *binlogUploader
+)
+
+// getBinlogUploader returns the binlog uploader shared by all the flush managers of the datanode
+func getBinlogUploader() *binlogUploader {
+	binlogUploaderOnce.Do(func() {
+		sharedBinlogUploader = newBinlogUploader(Params.DataNodeCfg.UploadConcurrency,
+			Params.DataNodeCfg.MultipartUploadThreshold,
+			Params.DataNodeCfg.MultipartUploadPartSize,
+			retry.Attempts(Params.DataNodeCfg.UploadRetryAttempts))
+	})
+	return sharedBinlogUploader
+}
+
+// binlogUploader uploads binlogs to the storage with a bounded worker pool,
+// each binlog is retried independently and large ones are uploaded by multipart upload.
+// The binlogs are serialized in memory before the flush task runs, so multipart upload only splits
+// the request into parts, it doesn't lower the memory held by the flush.
+type binlogUploader struct {
+	pool               *concurrency.Pool
+	multipartThreshold int64 // binlogs not smaller than it are uploaded by multipart, 0 to disable
+	partSize           uint64
+	retryOpts          []retry.Option
+}
+
+func newBinlogUploader(workers int, multipartThreshold int64, partSize int64, opts ...retry.Option) *binlogUploader {
+	if workers <= 0 {
+		workers = 1
+	}
+	pool, err := concurrency.NewPool(workers)
+	if err != nil {
+		// only fails with invalid options
+		panic(err)
+	}
+	return &binlogUploader{
+		pool:               pool,
+		multipartThreshold: multipartThreshold,
+		partSize:           uint64(partSize),
+		retryOpts:          opts,
+	}
+}
+
+// upload uploads the binlogs in @contents keyed by path, the uploaded ones are removed from @contents,
+// so that only the failed ones are uploaded again when the flush task is retried.
+func (u *binlogUploader) upload(ctx context.Context, cm storage.ChunkManager, contents map[string][]byte, msgType string) error {
+	futures := make([]*concurrency.Future, 0, len(contents))
+	for key, value := range contents {
+		key, value := key, value
+		futures = append(futures, u.pool.Submit(func() (interface{}, error) {
+			return key, u.uploadOne(ctx, cm, key, value, msgType)
+		}))
+	}
+
+	var firstErr error
+	for _, future := range futures {
+		key, err := future.Await()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		delete(contents, key.(string))
+	}
+	return firstErr
+}
+
+func (u *binlogUploader) uploadOne(ctx context.Context, cm storage.ChunkManager, key string, value []byte, msgType string) error {
+	nodeID := fmt.Sprint(Params.DataNodeCfg.GetNodeID())
+	size := float64(len(value))
+	metrics.DataNodeUploadBytesInFlight.WithLabelValues(nodeID).Add(size)
+	defer metrics.DataNodeUploadBytesInFlight.WithLabelValues(nodeID).Sub(size)
+
+	tr := timerecord.NewTimeRecorder("uploadBinlog")
+	mw, multipart := cm.(storage.MultipartWriter)
+	multipart = multipart && u.multipartThreshold > 0 && int64(len(value)) >= u.multipartThreshold
+	err := retry.Do(ctx, func() error {
+		var err error
+		if multipart {
+			err = mw.MultipartWrite(ctx, key, bytes.NewReader(value), int64(len(value)), u.partSize)
+		} else {
+			err = cm.Write(ctx, key, value)
+		}
+		if err != nil {
+			log.Warn("failed to upload binlog", zap.String("path", key), zap.Int("size", len(value)),
+				zap.Bool("multipart", multipart), zap.Error(err))
+		}
+		return err
+	}, u.retryOpts...)
+	metrics.DataNodeUploadLatency.WithLabelValues(nodeID, msgType).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	if err != nil {
+		return err
+	}
+	metrics.DataNodeFlushedSize.WithLabelValues(nodeID, msgType).Add(size)
+	return nil
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// flakyCm fails the first writes of each path
+type flakyCm struct {
+	storage.ChunkManager
+	mu        sync.Mutex
+	failures  int
+	attempts  map[string]int
+	written   map[string][]byte
+	multipart map[string]bool
+}
+
+func newFlakyCm(failures int) *flakyCm {
+	return &flakyCm{
+		failures:  failures,
+		attempts:  make(map[string]int),
+		written:   make(map[string][]byte),
+		multipart: make(map[string]bool),
+	}
+}
+
+func (cm *flakyCm) write(filePath string, content []byte, multipart bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.attempts[filePath]++
+	if cm.attempts[filePath] <= cm.failures {
+		return errors.New("mock write error")
+	}
+	cm.written[filePath] = content
+	cm.multipart[filePath] = multipart
+	return nil
+}
+
+func (cm *flakyCm) Write(ctx context.Context, filePath string, content []byte) error {
+	return cm.write(filePath, content, false)
+}
+
+func (cm *flakyCm) MultipartWrite(ctx context.Context, filePath string, reader io.Reader, size int64, partSize uint64) error {
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return cm.write(filePath, content, true)
+}
+
+func TestBinlogUploader(t *testing.T) {
+	ctx := context.Background()
+	opts := []retry.Option{retry.Attempts(3), retry.Sleep(time.Millisecond)}
+
+	t.Run("upload with retry", func(t *testing.T) {
+		u := newBinlogUploader(2, 4, 5*1024*1024, opts...)
+		cm := newFlakyCm(2)
+		contents := map[string][]byte{
+			"a": []byte("1"),
+			"b": []byte("22"),
+			"c": []byte("4444"),
+		}
+		err := u.upload(ctx, cm, contents, metrics.InsertLabel)
+		assert.NoError(t, err)
+		assert.Empty(t, contents)
+		assert.Equal(t, []byte("1"), cm.written["a"])
+		assert.Equal(t, []byte("22"), cm.written["b"])
+		assert.Equal(t, []byte("4444"), cm.written["c"])
+		assert.False(t, cm.multipart["a"])
+		assert.False(t, cm.multipart["b"])
+		assert.True(t, cm.multipart["c"])
+		for _, attempts := range cm.attempts {
+			assert.Equal(t, 3, attempts)
+		}
+	})
+
+	t.Run("multipart disabled", func(t *testing.T) {
+		u := newBinlogUploader(2, 0, 5*1024*1024, opts...)
+		cm := newFlakyCm(0)
+		err := u.upload(ctx, cm, map[string][]byte{"a": []byte("4444")}, metrics.DeleteLabel)
+		assert.NoError(t, err)
+		assert.False(t, cm.multipart["a"])
+	})
+
+	t.Run("upload failed", func(t *testing.T) {
+		u := newBinlogUploader(2, 0, 5*1024*1024, opts...)
+		cm := newFlakyCm(3)
+		contents := map[string][]byte{
+			"a": []byte("1"),
+			"b": []byte("22"),
+		}
+		err := u.upload(ctx, cm, contents, metrics.InsertLabel)
+		assert.Error(t, err)
+		assert.Len(t, contents, 2)
+
+		// the retried flush task only uploads the failed binlogs
+		cm.mu.Lock()
+		cm.failures = 6
+		cm.attempts["a"] = 6
+		cm.mu.Unlock()
+		err = u.upload(ctx, cm, contents, metrics.InsertLabel)
+		assert.Error(t, err)
+		assert.Equal(t, map[string][]byte{"b": []byte("22")}, contents)
+		assert.Equal(t, []byte("1"), cm.written["a"])
+	})
+}
@@ -0,0 +1,219 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// spilledChunk is a part of the insert buffer of a segment spilled to local disk,
+// a binlog file per field serialized by InsertCodec
+type spilledChunk struct {
+	paths      []string
+	memorySize int64
+}
+
+// bufferSpiller spills the insert buffers of the largest segments of a channel to local disk
+// when the memory usage of the datanode crosses the watermark, and reloads them at flush time,
+// so that the buffers don't have to be flushed early into tiny binlogs.
+type bufferSpiller struct {
+	ctx         context.Context
+	channelName string
+	replica     Replica
+	cm          storage.ChunkManager
+
+	watermark   float64 // ratio of the used memory to the total memory
+	minSize     int64   // bytes, smaller buffers are not worth spilling
+	memoryUsage func() float64
+
+	nextChunk int64
+}
+
+func newBufferSpiller(ctx context.Context, channelName string, replica Replica) *bufferSpiller {
+	s := &bufferSpiller{
+		ctx:         ctx,
+		channelName: channelName,
+		replica:     replica,
+		cm: storage.NewLocalChunkManager(storage.RootPath(
+			path.Join(Params.DataNodeCfg.SpillPath, strconv.FormatInt(Params.DataNodeCfg.GetNodeID(), 10)))),
+		watermark:   Params.DataNodeCfg.SpillMemoryWatermark,
+		minSize:     Params.DataNodeCfg.SpillMinSize,
+		memoryUsage: usedMemoryRatio,
+	}
+	// the buffers spilled before restarting are consumed from the channel again
+	if err := s.cm.Remove(ctx, channelName); err != nil {
+		log.Warn("failed to remove stale spilled buffers", zap.String("vChannelName", channelName), zap.Error(err))
+	}
+	return s
+}
+
+func usedMemoryRatio() float64 {
+	total := metricsinfo.GetMemoryCount()
+	if total == 0 {
+		return 0
+	}
+	return float64(metricsinfo.GetUsedMemoryCount()) / float64(total)
+}
+
+// trySpill spills the largest buffers in memory if the memory usage crosses the watermark,
+// until half of the buffered memory of the channel is released.
+func (s *bufferSpiller) trySpill(buffers *sync.Map) {
+	type candidate struct {
+		segmentID UniqueID
+		buffer    *BufferData
+	}
+	var candidates []candidate
+	var total int64
+	buffers.Range(func(k, v interface{}) bool {
+		bd := v.(*BufferData)
+		total += bd.memorySize
+		if bd.memorySize >= s.minSize && bd.memorySize > 0 {
+			candidates = append(candidates, candidate{k.(UniqueID), bd})
+		}
+		return true
+	})
+	if len(candidates) == 0 || s.memoryUsage() < s.watermark {
+		return
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].buffer.memorySize > candidates[j].buffer.memorySize
+	})
+	var released int64
+	for _, c := range candidates {
+		if released >= total/2 {
+			break
+		}
+		size := c.buffer.memorySize
+		if err := s.spill(c.segmentID, c.buffer); err != nil {
+			log.Warn("failed to spill insert buffer", zap.Int64("segmentID", c.segmentID),
+				zap.String("vChannelName", s.channelName), zap.Error(err))
+			return
+		}
+		released += size
+		log.Info("insert buffer spilled", zap.Int64("segmentID", c.segmentID),
+			zap.String("vChannelName", s.channelName), zap.Int64("size", size))
+	}
+}
+
+// spill serializes the buffer in memory of the segment to local disk.
+func (s *bufferSpiller) spill(segmentID UniqueID, bd *BufferData) error {
+	codec, partID, err := s.getCodec(segmentID)
+	if err != nil {
+		return err
+	}
+	blobs, _, err := codec.Serialize(partID, segmentID, bd.buffer)
+	if err != nil {
+		return err
+	}
+
+	dir := path.Join(s.channelName, strconv.FormatInt(segmentID, 10), strconv.FormatInt(s.nextChunk, 10))
+	s.nextChunk++
+	kvs := make(map[string][]byte, len(blobs))
+	chunk := &spilledChunk{memorySize: bd.memorySize}
+	for _, blob := range blobs {
+		p := path.Join(dir, blob.GetKey())
+		kvs[p] = blob.GetValue()
+		chunk.paths = append(chunk.paths, p)
+	}
+	if err := s.cm.MultiWrite(s.ctx, kvs); err != nil {
+		return err
+	}
+
+	bd.spilled = append(bd.spilled, chunk)
+	bd.spilledSize += bd.memorySize
+	bd.buffer = &InsertData{Data: make(map[UniqueID]storage.FieldData)}
+	bd.memorySize = 0
+	metrics.DataNodeSpillBufferCount.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID())).Inc()
+	return nil
+}
+
+// reload merges the spilled chunks of the segment back into the buffer in order and removes them from disk.
+func (s *bufferSpiller) reload(segmentID UniqueID, bd *BufferData) error {
+	if bd == nil || len(bd.spilled) == 0 {
+		return nil
+	}
+	codec, _, err := s.getCodec(segmentID)
+	if err != nil {
+		return err
+	}
+
+	datas := make([]*InsertData, 0, len(bd.spilled)+1)
+	for _, chunk := range bd.spilled {
+		values, err := s.cm.MultiRead(s.ctx, chunk.paths)
+		if err != nil {
+			return err
+		}
+		blobs := make([]*Blob, 0, len(values))
+		for i, value := range values {
+			blobs = append(blobs, &Blob{Key: path.Base(chunk.paths[i]), Value: value})
+		}
+		_, _, _, data, err := codec.DeserializeAll(blobs)
+		if err != nil {
+			return err
+		}
+		datas = append(datas, data)
+	}
+	datas = append(datas, bd.buffer)
+
+	bd.buffer = storage.MergeInsertData(datas...)
+	bd.memorySize += bd.spilledSize
+	bd.spilled = nil
+	bd.spilledSize = 0
+	s.remove(segmentID)
+	return nil
+}
+
+// remove removes the spilled chunks of the segment.
+func (s *bufferSpiller) remove(segmentID UniqueID) {
+	if err := s.cm.Remove(s.ctx, path.Join(s.channelName, strconv.FormatInt(segmentID, 10))); err != nil {
+		log.Warn("failed to remove spilled buffers", zap.Int64("segmentID", segmentID),
+			zap.String("vChannelName", s.channelName), zap.Error(err))
+	}
+}
+
+// close removes all the spilled chunks of the channel.
+func (s *bufferSpiller) close() {
+	if err := s.cm.Remove(s.ctx, s.channelName); err != nil {
+		log.Warn("failed to remove spilled buffers", zap.String("vChannelName", s.channelName), zap.Error(err))
+	}
+}
+
+func (s *bufferSpiller) getCodec(segmentID UniqueID) (*storage.InsertCodec, UniqueID, error) {
+	collID, partID, err := s.replica.getCollectionAndPartitionID(segmentID)
+	if err != nil {
+		return nil, 0, err
+	}
+	schema, err := s.replica.getCollectionSchema(collID, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	return storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: collID, Schema: schema}), partID, nil
+}
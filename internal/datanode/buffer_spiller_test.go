@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestBufferSpiller(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	spillPath := Params.DataNodeCfg.SpillPath
+	defer func() {
+		Params.DataNodeCfg.SpillPath = spillPath
+	}()
+	Params.DataNodeCfg.SpillPath = t.TempDir()
+
+	replica, err := newReplica(ctx, &RootCoordFactory{pkType: schemapb.DataType_Int64}, nil, 1)
+	require.NoError(t, err)
+	err = replica.addSegment(addSegmentReq{
+		segType:     datapb.SegmentType_New,
+		segID:       100,
+		collID:      1,
+		partitionID: 10,
+		channelName: "spill-channel",
+		startPos:    new(internalpb.MsgPosition),
+	})
+	require.NoError(t, err)
+
+	spiller := newBufferSpiller(ctx, "spill-channel", replica)
+	spiller.minSize = 0
+	defer spiller.close()
+
+	bd, err := newBufferData(2)
+	require.NoError(t, err)
+	bd.buffer = genInsertData()
+	bd.updateSize(2)
+	bd.updateMemorySize(bd.buffer)
+	memorySize := bd.memorySize
+	assert.True(t, memorySize > 0)
+
+	buffers := &sync.Map{}
+	buffers.Store(UniqueID(100), bd)
+
+	t.Run("test below watermark", func(t *testing.T) {
+		spiller.memoryUsage = func() float64 { return spiller.watermark - 0.1 }
+		spiller.trySpill(buffers)
+		assert.Empty(t, bd.spilled)
+		assert.Equal(t, memorySize, bd.memorySize)
+	})
+
+	t.Run("test spill", func(t *testing.T) {
+		spiller.memoryUsage = func() float64 { return spiller.watermark }
+		spiller.trySpill(buffers)
+		require.Equal(t, 1, len(bd.spilled))
+		assert.Equal(t, int64(0), bd.memorySize)
+		assert.Equal(t, memorySize, bd.spilledSize)
+		assert.Equal(t, int64(2), bd.size)
+
+		for _, p := range bd.spilled[0].paths {
+			exist, err := spiller.cm.Exist(ctx, p)
+			assert.NoError(t, err)
+			assert.True(t, exist)
+		}
+	})
+
+	t.Run("test reload", func(t *testing.T) {
+		paths := bd.spilled[0].paths
+		bd.buffer = storage.MergeInsertData(bd.buffer, genInsertData())
+		bd.updateSize(2)
+		bd.updateMemorySize(genInsertData())
+
+		err := spiller.reload(100, bd)
+		require.NoError(t, err)
+		assert.Empty(t, bd.spilled)
+		assert.Equal(t, int64(0), bd.spilledSize)
+		assert.Equal(t, 2*memorySize, bd.memorySize)
+		for _, fieldData := range bd.buffer.Data {
+			assert.Equal(t, 4, fieldData.RowNum())
+		}
+
+		for _, p := range paths {
+			exist, err := spiller.cm.Exist(ctx, p)
+			assert.NoError(t, err)
+			assert.False(t, exist)
+		}
+	})
+
+	t.Run("test reload missing segment", func(t *testing.T) {
+		bd := &BufferData{spilled: []*spilledChunk{{paths: []string{"missing"}}}}
+		assert.Error(t, spiller.reload(200, bd))
+	})
+}
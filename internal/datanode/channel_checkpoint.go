@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// bufferKind is the kind of the buffers of a segment, the insert and delete buffers are filled and flushed
+// by different flowgraph nodes which run pipelined, so they are tracked separately.
+type bufferKind int
+
+const (
+	insertBuffer bufferKind = iota
+	deleteBuffer
+)
+
+// bufferKey identifies the buffers of a kind of a segment
+type bufferKey struct {
+	segmentID UniqueID
+	kind      bufferKind
+}
+
+// flushingPosition is the start position of the buffers of a segment handed to the flush manager
+type flushingPosition struct {
+	startPos *internalpb.MsgPosition // start position of the earliest message in the buffers
+	flushPos *internalpb.MsgPosition // position of the flush task
+}
+
+// checkpointTracker tracks the checkpoint of a vchannel, the position before which all the consumed data is durable.
+// The insert and delete buffers of a segment are tracked from the start position of the first message buffered
+// until the flush task of the buffers is saved by datacoord. If nothing is buffered or flushing,
+// the checkpoint is the end position consumed by the whole flowgraph.
+// All the methods are no-op for a nil tracker.
+type checkpointTracker struct {
+	mu          sync.Mutex
+	channelName string
+	buffered    map[bufferKey]*internalpb.MsgPosition // buffers -> start position of the buffers
+	flushing    map[UniqueID][]flushingPosition       // segment id -> buffers being flushed
+	consumed    *internalpb.MsgPosition
+}
+
+func newCheckpointTracker(channelName string) *checkpointTracker {
+	return &checkpointTracker{
+		channelName: channelName,
+		buffered:    make(map[bufferKey]*internalpb.MsgPosition),
+		flushing:    make(map[UniqueID][]flushingPosition),
+	}
+}
+
+// buffer records that data of the kind starting from the position is buffered for the segment.
+func (t *checkpointTracker) buffer(kind bufferKind, segmentID UniqueID, startPos *internalpb.MsgPosition) {
+	if t == nil || startPos == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := bufferKey{segmentID: segmentID, kind: kind}
+	if _, ok := t.buffered[key]; !ok {
+		t.buffered[key] = t.clone(startPos)
+	}
+}
+
+// flush records that the buffers of the kind of the segment are handed to the flush manager at the flush position,
+// it must be called before the flush task is submitted to not miss the notification.
+func (t *checkpointTracker) flush(kind bufferKind, segmentID UniqueID, flushPos *internalpb.MsgPosition) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := bufferKey{segmentID: segmentID, kind: kind}
+	startPos, ok := t.buffered[key]
+	if !ok {
+		return
+	}
+	delete(t.buffered, key)
+	t.flushing[segmentID] = append(t.flushing[segmentID], flushingPosition{startPos: startPos, flushPos: flushPos})
+}
+
+// flushed removes the buffers of the segment flushed at or before the position,
+// the flush manager notifies once both the insert and delete data of the position are saved.
+func (t *checkpointTracker) flushed(segmentID UniqueID, pos *internalpb.MsgPosition) {
+	if t == nil || pos == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var remain []flushingPosition
+	for _, fp := range t.flushing[segmentID] {
+		if fp.flushPos.GetTimestamp() > pos.GetTimestamp() {
+			remain = append(remain, fp)
+		}
+	}
+	if len(remain) == 0 {
+		delete(t.flushing, segmentID)
+		return
+	}
+	t.flushing[segmentID] = remain
+}
+
+// consume records that all the messages before the position are processed by the flowgraph.
+func (t *checkpointTracker) consume(endPos *internalpb.MsgPosition) {
+	if t == nil || endPos == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.consumed = t.clone(endPos)
+}
+
+// get returns the checkpoint of the channel, nil if nothing is consumed yet.
+func (t *checkpointTracker) get() *internalpb.MsgPosition {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.consumed == nil {
+		return nil
+	}
+	checkpoint := t.consumed
+	for _, pos := range t.buffered {
+		if pos.GetTimestamp() < checkpoint.GetTimestamp() {
+			checkpoint = pos
+		}
+	}
+	for _, fps := range t.flushing {
+		for _, fp := range fps {
+			if fp.startPos.GetTimestamp() < checkpoint.GetTimestamp() {
+				checkpoint = fp.startPos
+			}
+		}
+	}
+	return t.clone(checkpoint)
+}
+
+// clone copies the position with the vchannel name
+func (t *checkpointTracker) clone(pos *internalpb.MsgPosition) *internalpb.MsgPosition {
+	cloned := proto.Clone(pos).(*internalpb.MsgPosition)
+	cloned.ChannelName = t.channelName
+	return cloned
+}
+
+// checkpointReporter reports the checkpoint of a vchannel to datacoord periodically when it advances.
+type checkpointReporter struct {
+	tracker   *checkpointTracker
+	dataCoord types.DataCoord
+	interval  time.Duration
+	reported  Timestamp
+}
+
+func (r *checkpointReporter) run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.report(ctx)
+		}
+	}
+}
+
+func (r *checkpointReporter) report(ctx context.Context) {
+	checkpoint := r.tracker.get()
+	if checkpoint == nil || checkpoint.GetTimestamp() <= r.reported {
+		return
+	}
+	status, err := r.dataCoord.UpdateChannelCheckpoint(ctx, &datapb.UpdateChannelCheckpointRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.DataNodeCfg.GetNodeID(),
+		},
+		VChannel: r.tracker.channelName,
+		Position: checkpoint,
+	})
+	if err != nil || status.GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("failed to update channel checkpoint", zap.String("vChannelName", r.tracker.channelName),
+			zap.Uint64("ts", checkpoint.GetTimestamp()), zap.Error(err), zap.String("reason", status.GetReason()))
+		return
+	}
+	r.reported = checkpoint.GetTimestamp()
+}
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestCheckpointTracker(t *testing.T) {
+	pos := func(ts Timestamp) *internalpb.MsgPosition {
+		return &internalpb.MsgPosition{ChannelName: "pchannel", Timestamp: ts}
+	}
+
+	t.Run("nil tracker", func(t *testing.T) {
+		var tracker *checkpointTracker
+		tracker.buffer(insertBuffer, 1, pos(1))
+		tracker.flush(insertBuffer, 1, pos(2))
+		tracker.flushed(1, pos(2))
+		tracker.consume(pos(3))
+		assert.Nil(t, tracker.get())
+	})
+
+	t.Run("nothing consumed", func(t *testing.T) {
+		tracker := newCheckpointTracker("vchan")
+		tracker.buffer(insertBuffer, 1, pos(1))
+		assert.Nil(t, tracker.get())
+	})
+
+	t.Run("buffer flush and flushed", func(t *testing.T) {
+		tracker := newCheckpointTracker("vchan")
+		tracker.consume(pos(10))
+		cp := tracker.get()
+		assert.Equal(t, Timestamp(10), cp.GetTimestamp())
+		assert.Equal(t, "vchan", cp.GetChannelName())
+
+		tracker.buffer(insertBuffer, 1, pos(5))
+		// the first buffered position is kept
+		tracker.buffer(insertBuffer, 1, pos(8))
+		tracker.buffer(insertBuffer, 2, pos(7))
+		tracker.consume(pos(20))
+		assert.Equal(t, Timestamp(5), tracker.get().GetTimestamp())
+
+		tracker.flush(insertBuffer, 1, pos(20))
+		// flush of segment without buffers is ignored
+		tracker.flush(insertBuffer, 3, pos(20))
+		tracker.buffer(insertBuffer, 1, pos(25))
+		tracker.consume(pos(30))
+		assert.Equal(t, Timestamp(5), tracker.get().GetTimestamp())
+
+		// flushed before the flush position does not release the buffers
+		tracker.flushed(1, pos(15))
+		assert.Equal(t, Timestamp(5), tracker.get().GetTimestamp())
+
+		tracker.flushed(1, pos(20))
+		assert.Equal(t, Timestamp(7), tracker.get().GetTimestamp())
+
+		tracker.flush(insertBuffer, 2, pos(30))
+		tracker.flushed(2, pos(30))
+		assert.Equal(t, Timestamp(25), tracker.get().GetTimestamp())
+
+		tracker.flush(insertBuffer, 1, pos(30))
+		tracker.flushed(1, pos(30))
+		assert.Equal(t, Timestamp(30), tracker.get().GetTimestamp())
+	})
+
+	t.Run("insert and delete nodes interleaved", func(t *testing.T) {
+		tracker := newCheckpointTracker("vchan")
+		// the delete node lags behind the insert node
+		tracker.buffer(deleteBuffer, 1, pos(10))
+		tracker.buffer(insertBuffer, 1, pos(30))
+		tracker.flush(insertBuffer, 1, pos(40))
+		tracker.buffer(insertBuffer, 1, pos(50))
+		tracker.consume(pos(20))
+		assert.Equal(t, Timestamp(10), tracker.get().GetTimestamp())
+
+		// the insert flush does not take the delete buffers
+		tracker.buffer(deleteBuffer, 1, pos(20))
+		tracker.consume(pos(40))
+		assert.Equal(t, Timestamp(10), tracker.get().GetTimestamp())
+
+		tracker.flush(deleteBuffer, 1, pos(40))
+		tracker.buffer(deleteBuffer, 1, pos(45))
+		tracker.consume(pos(60))
+		assert.Equal(t, Timestamp(10), tracker.get().GetTimestamp())
+
+		// both the insert and delete data of the flush position are saved
+		tracker.flushed(1, pos(40))
+		assert.Equal(t, Timestamp(45), tracker.get().GetTimestamp())
+
+		tracker.flush(deleteBuffer, 1, pos(60))
+		tracker.flush(insertBuffer, 1, pos(60))
+		tracker.flushed(1, pos(60))
+		assert.Equal(t, Timestamp(60), tracker.get().GetTimestamp())
+	})
+}
+
+func TestCheckpointReporter(t *testing.T) {
+	ctx := context.Background()
+	dc := &DataCoordFactory{
+		ChannelCheckpoints: make(map[string]*internalpb.MsgPosition),
+	}
+	tracker := newCheckpointTracker("vchan")
+	reporter := &checkpointReporter{tracker: tracker, dataCoord: dc}
+
+	// nothing to report
+	reporter.report(ctx)
+	assert.Empty(t, dc.ChannelCheckpoints)
+
+	tracker.consume(&internalpb.MsgPosition{Timestamp: 100})
+	reporter.report(ctx)
+	assert.Equal(t, Timestamp(100), dc.ChannelCheckpoints["vchan"].GetTimestamp())
+	assert.Equal(t, Timestamp(100), reporter.reported)
+
+	// report failure keeps the reported checkpoint
+	dc.UpdateChannelCheckpointError = true
+	tracker.consume(&internalpb.MsgPosition{Timestamp: 200})
+	reporter.report(ctx)
+	assert.Equal(t, Timestamp(100), reporter.reported)
+
+	dc.UpdateChannelCheckpointError = false
+	reporter.report(ctx)
+	assert.Equal(t, Timestamp(200), dc.ChannelCheckpoints["vchan"].GetTimestamp())
+	assert.Equal(t, Timestamp(200), reporter.reported)
+}
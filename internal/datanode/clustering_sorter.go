@@ -0,0 +1,341 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"path"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// clusteringMergeFanIn is the maximum number of runs merged at once, more runs are merged in passes
+const clusteringMergeFanIn = 16
+
+// clusteringRow is a row of the compacted segments, with the value of the clustering field as the key.
+type clusteringRow struct {
+	key interface{}
+	row map[UniqueID]interface{}
+}
+
+// clusteringRun is a sorted run spilled to local disk, each chunk is a binlog file per field serialized by InsertCodec
+type clusteringRun struct {
+	chunks [][]string
+}
+
+// clusteringSorter sorts the rows of the compacted segments by the clustering field with an external merge sort.
+// The rows are buffered up to runRows, sorted and spilled to local disk as runs, then the runs are merged
+// with one chunk of each run in memory, so the memory used is bounded by runRows rows in both phases.
+type clusteringSorter struct {
+	ctx          context.Context
+	cm           storage.ChunkManager
+	codec        *storage.InsertCodec
+	partID       UniqueID
+	pkID         UniqueID
+	pkType       schemapb.DataType
+	clusteringID UniqueID
+	fID2Type     map[UniqueID]schemapb.DataType
+
+	runRows   int
+	chunkRows int
+
+	buffer      []*clusteringRow
+	runs        []*clusteringRun
+	nextChunk   int64
+	numRows     int64
+	spilledRuns int
+}
+
+func newClusteringSorter(ctx context.Context, planID UniqueID, partID UniqueID, meta *etcdpb.CollectionMeta,
+	pkID UniqueID, pkType schemapb.DataType, clusteringID UniqueID, fID2Type map[UniqueID]schemapb.DataType, runRows int) *clusteringSorter {
+	if runRows < 1 {
+		runRows = 1
+	}
+	chunkRows := runRows / clusteringMergeFanIn
+	if chunkRows < 1 {
+		chunkRows = 1
+	}
+	s := &clusteringSorter{
+		ctx: ctx,
+		cm: storage.NewLocalChunkManager(storage.RootPath(path.Join(Params.DataNodeCfg.SpillPath,
+			strconv.FormatInt(Params.DataNodeCfg.GetNodeID(), 10), "clustering", strconv.FormatInt(planID, 10)))),
+		codec:        storage.NewInsertCodec(meta),
+		partID:       partID,
+		pkID:         pkID,
+		pkType:       pkType,
+		clusteringID: clusteringID,
+		fID2Type:     fID2Type,
+		runRows:      runRows,
+		chunkRows:    chunkRows,
+	}
+	// the runs left by an earlier attempt of the plan are useless
+	s.close()
+	return s
+}
+
+// add buffers the row, the buffered rows are spilled as a sorted run once there are runRows of them.
+func (s *clusteringSorter) add(row map[UniqueID]interface{}) error {
+	s.buffer = append(s.buffer, &clusteringRow{key: row[s.clusteringID], row: row})
+	s.numRows++
+	if len(s.buffer) < s.runRows {
+		return nil
+	}
+	return s.spillBuffer()
+}
+
+func (s *clusteringSorter) spillBuffer() error {
+	if len(s.buffer) == 0 {
+		return nil
+	}
+	sort.SliceStable(s.buffer, func(i, j int) bool {
+		return compareClusteringKey(s.buffer[i].key, s.buffer[j].key) < 0
+	})
+	run := &clusteringRun{}
+	for start := 0; start < len(s.buffer); start += s.chunkRows {
+		end := start + s.chunkRows
+		if end > len(s.buffer) {
+			end = len(s.buffer)
+		}
+		if err := s.writeChunk(run, s.buffer[start:end]); err != nil {
+			return err
+		}
+	}
+	s.runs = append(s.runs, run)
+	s.spilledRuns++
+	s.buffer = nil
+	return nil
+}
+
+func (s *clusteringSorter) writeChunk(run *clusteringRun, rows []*clusteringRow) error {
+	fID2Content := make(map[UniqueID][]interface{})
+	for _, r := range rows {
+		for fID, v := range r.row {
+			fID2Content[fID] = append(fID2Content[fID], v)
+		}
+	}
+	iData := &InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	for fID, content := range fID2Content {
+		tp, ok := s.fID2Type[fID]
+		if !ok {
+			log.Warn("no field ID in this schema", zap.Int64("fieldID", fID))
+			return errors.New("Unexpected error")
+		}
+		fData, err := interface2FieldData(tp, content, int64(len(content)))
+		if err != nil {
+			return err
+		}
+		iData.Data[fID] = fData
+	}
+	blobs, _, err := s.codec.Serialize(s.partID, 0, iData)
+	if err != nil {
+		return err
+	}
+
+	dir := strconv.FormatInt(s.nextChunk, 10)
+	s.nextChunk++
+	kvs := make(map[string][]byte, len(blobs))
+	paths := make([]string, 0, len(blobs))
+	for _, blob := range blobs {
+		p := path.Join(dir, blob.GetKey())
+		kvs[p] = blob.GetValue()
+		paths = append(paths, p)
+	}
+	if err := s.cm.MultiWrite(s.ctx, kvs); err != nil {
+		return err
+	}
+	run.chunks = append(run.chunks, paths)
+	return nil
+}
+
+// readChunk loads a chunk into memory and removes it from disk.
+func (s *clusteringSorter) readChunk(paths []string) ([]*clusteringRow, error) {
+	values, err := s.cm.MultiRead(s.ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*Blob, 0, len(values))
+	for i, value := range values {
+		blobs = append(blobs, &Blob{Key: path.Base(paths[i]), Value: value})
+	}
+	iter, err := storage.NewInsertBinlogIterator(blobs, s.pkID, s.pkType)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Dispose()
+	var rows []*clusteringRow
+	for iter.HasNext() {
+		vInter, _ := iter.Next()
+		v, ok := vInter.(*storage.Value)
+		if !ok {
+			return nil, errTransferType
+		}
+		row, ok := v.Value.(map[UniqueID]interface{})
+		if !ok {
+			return nil, errTransferType
+		}
+		rows = append(rows, &clusteringRow{key: row[s.clusteringID], row: row})
+	}
+	if err := s.cm.MultiRemove(s.ctx, paths); err != nil {
+		log.Warn("failed to remove the merged chunk", zap.Strings("paths", paths), zap.Error(err))
+	}
+	return rows, nil
+}
+
+// forEachSorted merges the spilled runs and calls fn with the rows in the order of the clustering field,
+// the rows of the same clustering value are in the order they are added.
+func (s *clusteringSorter) forEachSorted(fn func(*clusteringRow) error) error {
+	if len(s.runs) == 0 {
+		// all the rows fit in memory
+		sort.SliceStable(s.buffer, func(i, j int) bool {
+			return compareClusteringKey(s.buffer[i].key, s.buffer[j].key) < 0
+		})
+		for _, r := range s.buffer {
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+		s.buffer = nil
+		return nil
+	}
+	if err := s.spillBuffer(); err != nil {
+		return err
+	}
+
+	// merge in passes until the runs could be merged at once
+	for len(s.runs) > clusteringMergeFanIn {
+		merged := &clusteringRun{}
+		var chunk []*clusteringRow
+		err := s.mergeRuns(s.runs[:clusteringMergeFanIn], func(r *clusteringRow) error {
+			chunk = append(chunk, r)
+			if len(chunk) < s.chunkRows {
+				return nil
+			}
+			err := s.writeChunk(merged, chunk)
+			chunk = nil
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if len(chunk) > 0 {
+			if err := s.writeChunk(merged, chunk); err != nil {
+				return err
+			}
+		}
+		// the merged run holds the earliest rows
+		s.runs = append([]*clusteringRun{merged}, s.runs[clusteringMergeFanIn:]...)
+	}
+	err := s.mergeRuns(s.runs, fn)
+	s.runs = nil
+	return err
+}
+
+// mergeRuns merges the runs with a heap of their heads, a run is read chunk by chunk.
+func (s *clusteringSorter) mergeRuns(runs []*clusteringRun, fn func(*clusteringRow) error) error {
+	h := &clusteringCursorHeap{}
+	for i, run := range runs {
+		c := &clusteringCursor{run: run, order: i}
+		ok, err := c.advance(s)
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Push(h, c)
+		}
+	}
+	for h.Len() > 0 {
+		c := (*h)[0]
+		if err := fn(c.rows[c.pos]); err != nil {
+			return err
+		}
+		ok, err := c.advance(s)
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return nil
+}
+
+// close removes all the spilled runs.
+func (s *clusteringSorter) close() {
+	if err := s.cm.RemoveWithPrefix(s.ctx, ""); err != nil {
+		log.Warn("failed to remove the spilled clustering runs", zap.Error(err))
+	}
+}
+
+// clusteringCursor is the position in a run being merged, with the current chunk in memory
+type clusteringCursor struct {
+	run   *clusteringRun
+	order int
+	rows  []*clusteringRow
+	pos   int
+}
+
+// advance moves to the next row, the next chunk is loaded when the current one is consumed,
+// returns false if the run is consumed.
+func (c *clusteringCursor) advance(s *clusteringSorter) (bool, error) {
+	if c.rows != nil {
+		c.pos++
+	}
+	for c.pos >= len(c.rows) {
+		if len(c.run.chunks) == 0 {
+			c.rows = nil
+			return false, nil
+		}
+		rows, err := s.readChunk(c.run.chunks[0])
+		if err != nil {
+			return false, err
+		}
+		c.run.chunks = c.run.chunks[1:]
+		c.rows, c.pos = rows, 0
+	}
+	return true, nil
+}
+
+type clusteringCursorHeap []*clusteringCursor
+
+func (h clusteringCursorHeap) Len() int { return len(h) }
+func (h clusteringCursorHeap) Less(i, j int) bool {
+	if cmp := compareClusteringKey(h[i].rows[h[i].pos].key, h[j].rows[h[j].pos].key); cmp != 0 {
+		return cmp < 0
+	}
+	// the earlier runs hold the rows added earlier
+	return h[i].order < h[j].order
+}
+func (h clusteringCursorHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *clusteringCursorHeap) Push(x interface{}) { *h = append(*h, x.(*clusteringCursor)) }
+func (h *clusteringCursorHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func TestClusteringSorter(t *testing.T) {
+	spillPath := Params.DataNodeCfg.SpillPath
+	defer func() {
+		Params.DataNodeCfg.SpillPath = spillPath
+	}()
+	Params.DataNodeCfg.SpillPath = t.TempDir()
+
+	meta := &etcdpb.CollectionMeta{ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+		{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+		{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int32},
+	}}}
+	fID2Type := map[UniqueID]schemapb.DataType{
+		common.RowIDField:     schemapb.DataType_Int64,
+		common.TimeStampField: schemapb.DataType_Int64,
+		100:                   schemapb.DataType_Int64,
+		101:                   schemapb.DataType_Int32,
+	}
+	newRow := func(pk int64, key int32) map[UniqueID]interface{} {
+		return map[UniqueID]interface{}{
+			common.RowIDField:     pk,
+			common.TimeStampField: int64(1000),
+			100:                   pk,
+			101:                   key,
+		}
+	}
+
+	sortRows := func(t *testing.T, numRows int, runRows int) ([]*clusteringRow, *clusteringSorter) {
+		sorter := newClusteringSorter(context.Background(), 1, 10, meta, 100, schemapb.DataType_Int64, 101, fID2Type, runRows)
+		defer sorter.close()
+		for i := 0; i < numRows; i++ {
+			// keys repeat so that the order of the same key is checked
+			require.NoError(t, sorter.add(newRow(int64(i), int32((i*7)%13))))
+		}
+		var rows []*clusteringRow
+		require.NoError(t, sorter.forEachSorted(func(r *clusteringRow) error {
+			rows = append(rows, r)
+			return nil
+		}))
+		return rows, sorter
+	}
+	checkSorted := func(t *testing.T, rows []*clusteringRow, numRows int) {
+		require.Equal(t, numRows, len(rows))
+		for i := 1; i < len(rows); i++ {
+			cmp := compareClusteringKey(rows[i-1].key, rows[i].key)
+			require.LessOrEqual(t, cmp, 0)
+			if cmp == 0 {
+				// the rows of the same key keep the order they are added
+				assert.Less(t, rows[i-1].row[100].(int64), rows[i].row[100].(int64))
+			}
+		}
+	}
+
+	t.Run("in memory", func(t *testing.T) {
+		rows, sorter := sortRows(t, 50, 100)
+		checkSorted(t, rows, 50)
+		assert.Equal(t, 0, sorter.spilledRuns)
+	})
+
+	t.Run("merge runs", func(t *testing.T) {
+		rows, sorter := sortRows(t, 100, 32)
+		checkSorted(t, rows, 100)
+		assert.Equal(t, 4, sorter.spilledRuns)
+	})
+
+	t.Run("merge in passes", func(t *testing.T) {
+		// more runs than the fan-in are merged in passes
+		rows, sorter := sortRows(t, 200, 5)
+		checkSorted(t, rows, 200)
+		assert.Equal(t, 40, sorter.spilledRuns)
+	})
+}
@@ -22,20 +22,24 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -218,6 +222,8 @@ func (t *compactionTask) uploadSingleInsertLog(
 				log.Warn("update pk range failed", zap.Error(err))
 				return nil, err
 			}
+		} else if fID >= common.StartOfUserFieldID && storage.SupportFieldStats(tp) {
+			segment.updateFieldStats(fID, tp, fData)
 		}
 
 		iData.Data[fID] = fData
@@ -309,24 +315,43 @@ func (t *compactionTask) merge(
 	numBinlogs = 0
 	currentTs := t.GetCurrentTime()
 	maxRowsPerBinlog = int(Params.DataNodeCfg.FlushInsertBufferSize / (int64(dim) * 4))
+	// half of the memory budget is for prefetching the insert binlogs, the other half for the merged rows
+	budget := Params.DataNodeCfg.CompactionMemoryBudget / 2
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(meta.GetSchema())
+	if err != nil {
+		log.Warn("failed to estimate the size per record", zap.Error(err))
+		sizePerRecord = 0
+	}
+	if sizePerRecord > 0 {
+		budgetRows := int(budget / int64(sizePerRecord))
+		if budgetRows < 1 {
+			budgetRows = 1
+		}
+		if budgetRows < maxRowsPerBinlog {
+			maxRowsPerBinlog = budgetRows
+		}
+	}
 	currentRows := 0
 	downloadTimeCost := time.Duration(0)
 	uploadInsertTimeCost := time.Duration(0)
 	uploadStatsTimeCost := time.Duration(0)
-	for _, path := range unMergedInsertlogs {
+
+	prefetchCtx, cancelPrefetch := context.WithCancel(ctxTimeout)
+	defer cancelPrefetch()
+	prefetcher := newInsertlogPrefetcher(prefetchCtx, t.downloader, unMergedInsertlogs, pkID, pkType, int64(sizePerRecord), budget)
+	for {
 		downloadStart := time.Now()
-		data, err := t.download(ctxTimeout, path)
-		if err != nil {
-			log.Warn("download insertlogs wrong")
-			return nil, nil, nil, 0, err
+		group, ok := prefetcher.next()
+		if !ok {
+			break
 		}
 		downloadTimeCost += time.Since(downloadStart)
-
-		iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
-		if err != nil {
-			log.Warn("new insert binlogs Itr wrong")
-			return nil, nil, nil, 0, err
+		if group.err != nil {
+			log.Warn("prefetch insertlogs wrong", zap.Error(group.err))
+			return nil, nil, nil, 0, group.err
 		}
+
+		iter := group.iter
 		for iter.HasNext() {
 			vInter, _ := iter.Next()
 			v, ok := vInter.(*storage.Value)
@@ -376,6 +401,12 @@ func (t *compactionTask) merge(
 				numBinlogs++
 			}
 		}
+		prefetcher.release(group)
+	}
+	// the prefetcher stops early only if the context is done
+	if err := prefetchCtx.Err(); err != nil {
+		log.Warn("download insertlogs wrong", zap.Error(err))
+		return nil, nil, nil, 0, err
 	}
 	if currentRows != 0 {
 		uploadInsertStart := time.Now()
@@ -407,6 +438,11 @@ func (t *compactionTask) merge(
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
+	fieldStatsPaths, err := t.uploadFieldStatsLog(ctxTimeout, targetSegID, partID, segment.getFieldStats(), meta)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	statsPaths = append(statsPaths, fieldStatsPaths...)
 	uploadStatsTimeCost += time.Since(uploadStatsStart)
 
 	log.Debug("merge end", zap.Int64("remaining insert numRows", numRows),
@@ -419,6 +455,328 @@ func (t *compactionTask) merge(
 	return insertPaths, statsPaths, segment, numRows, nil
 }
 
+// cluster sorts the rows of the compacted segments by the clustering field, and splits them into segments
+// with disjoint value ranges of the clustering field, each segment has at most plan.MaxSegmentRows rows
+// unless the rows of the same clustering value exceed it.
+// The rows are sorted by clusteringSorter within the compaction memory budget, half of which is for
+// prefetching the insert binlogs, the other half for the sorted runs and the binlogs being written.
+func (t *compactionTask) cluster(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	delta map[interface{}]Timestamp,
+	deltaBuf *DelDataBuf) ([]*datapb.ClusteredSegment, error) {
+	log := log.With(zap.Int64("planID", t.getPlanID()))
+	clusterStart := time.Now()
+
+	var (
+		dim          int
+		pkID         UniqueID
+		pkType       schemapb.DataType
+		clusteringID = t.plan.GetClusteringFieldID()
+		hasField     bool
+		expired      int64
+		err          error
+
+		fID2Type = make(map[UniqueID]schemapb.DataType)
+	)
+
+	for _, fs := range meta.GetSchema().GetFields() {
+		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
+			pkID = fs.GetFieldID()
+			pkType = fs.GetDataType()
+		}
+		if fs.GetFieldID() == clusteringID {
+			hasField = typeutil.IsClusteringFieldType(fs.GetDataType())
+		}
+		if fs.GetDataType() == schemapb.DataType_FloatVector ||
+			fs.GetDataType() == schemapb.DataType_BinaryVector {
+			for _, t := range fs.GetTypeParams() {
+				if t.Key == "dim" {
+					if dim, err = strconv.Atoi(t.Value); err != nil {
+						log.Warn("strconv wrong on get dim", zap.Error(err))
+						return nil, err
+					}
+					break
+				}
+			}
+		}
+	}
+	if !hasField {
+		log.Warn("invalid clustering field", zap.Int64("fieldID", clusteringID))
+		return nil, fmt.Errorf("invalid clustering field %d", clusteringID)
+	}
+
+	budget := Params.DataNodeCfg.CompactionMemoryBudget / 2
+	maxRowsPerBinlog := int(Params.DataNodeCfg.FlushInsertBufferSize / (int64(dim) * 4))
+	runRows := maxRowsPerBinlog
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(meta.GetSchema())
+	if err != nil {
+		log.Warn("failed to estimate the size per record", zap.Error(err))
+		sizePerRecord = 0
+	}
+	if sizePerRecord > 0 {
+		// the sorted runs and the binlog being written share the budget
+		budgetRows := int(budget / 2 / int64(sizePerRecord))
+		if budgetRows < 1 {
+			budgetRows = 1
+		}
+		runRows = budgetRows
+		if budgetRows < maxRowsPerBinlog {
+			maxRowsPerBinlog = budgetRows
+		}
+	}
+
+	sorter := newClusteringSorter(ctxTimeout, t.getPlanID(), partID, meta, pkID, pkType, clusteringID, fID2Type, runRows)
+	defer sorter.close()
+
+	currentTs := t.GetCurrentTime()
+	prefetchCtx, cancelPrefetch := context.WithCancel(ctxTimeout)
+	defer cancelPrefetch()
+	prefetcher := newInsertlogPrefetcher(prefetchCtx, t.downloader, unMergedInsertlogs, pkID, pkType, int64(sizePerRecord), budget)
+	for {
+		group, ok := prefetcher.next()
+		if !ok {
+			break
+		}
+		if group.err != nil {
+			log.Warn("prefetch insertlogs wrong", zap.Error(group.err))
+			return nil, group.err
+		}
+
+		iter := group.iter
+		for iter.HasNext() {
+			vInter, _ := iter.Next()
+			v, ok := vInter.(*storage.Value)
+			if !ok {
+				log.Warn("transfer interface to Value wrong")
+				return nil, errors.New("unexpected error")
+			}
+
+			if ts, ok := delta[v.PK.GetValue()]; ok && uint64(v.Timestamp) <= ts {
+				continue
+			}
+
+			// Filtering expired entity
+			if t.isExpiredEntity(Timestamp(v.Timestamp), currentTs) {
+				expired++
+				continue
+			}
+
+			row, ok := v.Value.(map[UniqueID]interface{})
+			if !ok {
+				log.Warn("transfer interface to map wrong")
+				return nil, errors.New("unexpected error")
+			}
+			if err := sorter.add(row); err != nil {
+				log.Warn("failed to spill the sorted rows", zap.Error(err))
+				return nil, err
+			}
+		}
+		prefetcher.release(group)
+	}
+	// the prefetcher stops early only if the context is done
+	if err := prefetchCtx.Err(); err != nil {
+		log.Warn("download insertlogs wrong", zap.Error(err))
+		return nil, err
+	}
+
+	maxSegmentRows := t.plan.GetMaxSegmentRows()
+	if maxSegmentRows <= 0 {
+		maxSegmentRows = sorter.numRows
+	}
+
+	var (
+		segments []*datapb.ClusteredSegment
+		writer   *clusteredSegmentWriter
+	)
+	finish := func() error {
+		segment, err := writer.finish(deltaBuf)
+		if err != nil {
+			return err
+		}
+		segments = append(segments, segment)
+		writer = nil
+		return nil
+	}
+	err = sorter.forEachSorted(func(r *clusteringRow) error {
+		// rows of the same clustering value are kept in one segment to make the value ranges disjoint
+		if writer != nil && writer.numRows >= maxSegmentRows && compareClusteringKey(r.key, writer.max) != 0 {
+			if err := finish(); err != nil {
+				return err
+			}
+		}
+		if writer == nil {
+			targetSegID, err := t.allocID()
+			if err != nil {
+				return err
+			}
+			writer = newClusteredSegmentWriter(ctxTimeout, t, targetSegID, partID, meta, pkID, pkType, clusteringID, maxRowsPerBinlog, fID2Type)
+		}
+		return writer.write(r)
+	})
+	if err == nil && writer != nil {
+		err = finish()
+	}
+	if err != nil {
+		log.Warn("failed to write the clustered segments", zap.Error(err))
+		return nil, err
+	}
+
+	log.Debug("cluster end", zap.Int64("remaining insert numRows", sorter.numRows),
+		zap.Int64("expired entities", expired), zap.Int("segment number", len(segments)),
+		zap.Int("spilled runs", sorter.spilledRuns),
+		zap.Float64("cluster elapse in ms", nano2Milli(time.Since(clusterStart))))
+
+	return segments, nil
+}
+
+// clusteredSegmentWriter writes the sorted rows of a clustered segment as binlogs of at most maxRowsPerBinlog rows.
+type clusteredSegmentWriter struct {
+	ctx              context.Context
+	t                *compactionTask
+	segID            UniqueID
+	partID           UniqueID
+	meta             *etcdpb.CollectionMeta
+	pkID             UniqueID
+	pkType           schemapb.DataType
+	clusteringID     UniqueID
+	maxRowsPerBinlog int
+	fID2Type         map[UniqueID]schemapb.DataType
+
+	segment          *Segment // empty segment used for bf generation
+	fID2Content      map[UniqueID][]interface{}
+	bufferedRows     int
+	insertField2Path map[UniqueID]*datapb.FieldBinlog
+	numRows          int64
+	min, max         interface{}
+}
+
+func newClusteredSegmentWriter(ctx context.Context, t *compactionTask, segID, partID UniqueID, meta *etcdpb.CollectionMeta,
+	pkID UniqueID, pkType schemapb.DataType, clusteringID UniqueID, maxRowsPerBinlog int, fID2Type map[UniqueID]schemapb.DataType) *clusteredSegmentWriter {
+	w := &clusteredSegmentWriter{
+		ctx:              ctx,
+		t:                t,
+		segID:            segID,
+		partID:           partID,
+		meta:             meta,
+		pkID:             pkID,
+		pkType:           pkType,
+		clusteringID:     clusteringID,
+		maxRowsPerBinlog: maxRowsPerBinlog,
+		fID2Type:         fID2Type,
+		segment:          &Segment{},
+		fID2Content:      make(map[UniqueID][]interface{}),
+		insertField2Path: make(map[UniqueID]*datapb.FieldBinlog),
+	}
+	t.Replica.initSegmentBloomFilter(w.segment)
+	return w
+}
+
+// write appends the row, the rows are written in the order of the clustering field.
+func (w *clusteredSegmentWriter) write(r *clusteringRow) error {
+	if w.numRows == 0 {
+		w.min = r.key
+	}
+	w.max = r.key
+	for fID, v := range r.row {
+		w.fID2Content[fID] = append(w.fID2Content[fID], v)
+	}
+	w.bufferedRows++
+	w.numRows++
+	if w.bufferedRows >= w.maxRowsPerBinlog {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *clusteredSegmentWriter) flush() error {
+	if w.bufferedRows == 0 {
+		return nil
+	}
+	inPaths, err := w.t.uploadSingleInsertLog(w.ctx, w.segID, w.partID, w.meta, w.segment, w.pkID, w.fID2Content, w.fID2Type)
+	if err != nil {
+		return err
+	}
+	for fID, path := range inPaths {
+		if binlog, ok := w.insertField2Path[fID]; ok {
+			binlog.Binlogs = append(binlog.Binlogs, path.GetBinlogs()...)
+		} else {
+			w.insertField2Path[fID] = path
+		}
+	}
+	w.fID2Content = make(map[UniqueID][]interface{})
+	w.bufferedRows = 0
+	return nil
+}
+
+// finish uploads the rest rows and the statslogs, with the deletions of deltaBuf which may hit the segment.
+func (w *clusteredSegmentWriter) finish(deltaBuf *DelDataBuf) (*datapb.ClusteredSegment, error) {
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+	insertPaths := make([]*datapb.FieldBinlog, 0, len(w.insertField2Path))
+	for _, path := range w.insertField2Path {
+		insertPaths = append(insertPaths, path)
+	}
+
+	segStats, err := w.segment.getSegmentStatslog(w.pkID, w.pkType)
+	if err != nil {
+		log.Warn("failed to generate segment statslog", zap.Int64("pkID", w.pkID), zap.Error(err))
+		return nil, err
+	}
+	statsPaths, err := w.t.uploadStatsLog(w.ctx, w.segID, w.partID, segStats, w.meta)
+	if err != nil {
+		return nil, err
+	}
+	fieldStatsPaths, err := w.t.uploadFieldStatsLog(w.ctx, w.segID, w.partID, w.segment.getFieldStats(), w.meta)
+	if err != nil {
+		return nil, err
+	}
+	statsPaths = append(statsPaths, fieldStatsPaths...)
+
+	// only the deletions which may hit the segment are kept
+	segDeltaBuf := newDelDataBuf()
+	for i, pk := range deltaBuf.delData.Pks {
+		if w.segment.isPKExist(pk) {
+			ts := deltaBuf.delData.Tss[i]
+			segDeltaBuf.delData.Append(pk, ts)
+			segDeltaBuf.updateSize(1)
+			segDeltaBuf.updateTimeRange(TimeRange{timestampMin: ts, timestampMax: ts})
+		}
+	}
+	var deltaInfo []*datapb.FieldBinlog
+	if segDeltaBuf.delData.RowCount > 0 {
+		deltaInfo, err = w.t.uploadDeltaLog(w.ctx, w.segID, w.partID, segDeltaBuf.delData, w.meta)
+		if err != nil {
+			return nil, err
+		}
+		for _, fbl := range deltaInfo {
+			for _, deltaLogInfo := range fbl.GetBinlogs() {
+				deltaLogInfo.LogSize = segDeltaBuf.GetLogSize()
+				deltaLogInfo.TimestampFrom = segDeltaBuf.GetTimestampFrom()
+				deltaLogInfo.TimestampTo = segDeltaBuf.GetTimestampTo()
+				deltaLogInfo.EntriesNum = segDeltaBuf.GetEntriesNum()
+			}
+		}
+	}
+
+	return &datapb.ClusteredSegment{
+		SegmentID:           w.segID,
+		NumOfRows:           w.numRows,
+		InsertLogs:          insertPaths,
+		Field2StatslogPaths: statsPaths,
+		Deltalogs:           deltaInfo,
+		ClusteringRange: &datapb.ClusteringRange{
+			FieldID: w.clusteringID,
+			Min:     clusteringKey2GenericValue(w.min),
+			Max:     clusteringKey2GenericValue(w.max),
+		},
+	}, nil
+}
+
 func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	compactStart := time.Now()
 	if ok := funcutil.CheckCtxValid(t.ctx); !ok {
@@ -447,6 +805,9 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 			log.Error("compact wrong", zap.Error(err))
 			return nil, err
 		}
+
+	case t.plan.GetType() == datapb.CompactionType_ClusteringCompaction:
+		// the target segments are allocated while clustering
 	}
 
 	log.Debug("compaction start", zap.Int64("planID", t.plan.GetPlanID()), zap.Int32("timeout in seconds", t.plan.GetTimeoutInSeconds()))
@@ -463,9 +824,14 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 
 	// Inject to stop flush
 	injectStart := time.Now()
-	ti := newTaskInjection(len(segIDs), func(pack *segmentFlushPack) {
+	postInjection := func(pack *segmentFlushPack) {
 		pack.segmentID = targetSegID
-	})
+	}
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		// the flush packs keep their segments, DataCoord carries the deltalogs over to the clustered segments
+		postInjection = nil
+	}
+	ti := newTaskInjection(len(segIDs), postInjection)
 	defer close(ti.injectOver)
 
 	t.injectFlush(ti, segIDs...)
@@ -506,7 +872,8 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 			for _, f := range s.GetFieldBinlogs() {
 				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
 			}
-			allPs = append(allPs, ps)
+			// the fields of a storage v2 segment file share the same path
+			allPs = append(allPs, lo.Uniq(ps))
 		}
 
 		segID := s.GetSegmentID()
@@ -546,6 +913,27 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		return nil, err
 	}
 
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		segments, err := t.cluster(ctxTimeout, allPs, partID, meta, deltaPk2Ts, deltaBuf)
+		if err != nil {
+			log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
+		ti.injectDone(true)
+
+		log.Info("clustering compaction done",
+			zap.Int64("planID", t.plan.GetPlanID()),
+			zap.Int("num of clustered segments", len(segments)),
+			zap.Float64("elapse in ms", nano2Milli(time.Since(compactStart))))
+		metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+
+		return &datapb.CompactionResult{
+			PlanID:            t.plan.GetPlanID(),
+			ClusteredSegments: segments,
+			Sorted:            Params.DataNodeCfg.SortByPK,
+		}, nil
+	}
+
 	inPaths, statsPaths, _, numRows, err := t.merge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
 	if err != nil {
 		log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
@@ -576,6 +964,7 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		Field2StatslogPaths: statsPaths,
 		Deltalogs:           deltaInfo,
 		NumOfRows:           numRows,
+		Sorted:              Params.DataNodeCfg.SortByPK,
 	}
 
 	uninjectStart := time.Now()
@@ -599,6 +988,59 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	return pack, nil
 }
 
+// compareClusteringKey compares the values of the clustering field, a and b are of the same type.
+func compareClusteringKey(a, b interface{}) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case float32:
+		return compareOrdered(float64(a), float64(b.(float32)))
+	case float64:
+		return compareOrdered(a, b.(float64))
+	default:
+		return compareOrdered(clusteringKey2Int64(a), clusteringKey2Int64(b))
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func clusteringKey2Int64(key interface{}) int64 {
+	switch v := key.(type) {
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	default:
+		return 0
+	}
+}
+
+func clusteringKey2GenericValue(key interface{}) *planpb.GenericValue {
+	switch v := key.(type) {
+	case string:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+	case float32:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: float64(v)}}
+	case float64:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+	default:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: clusteringKey2Int64(v)}}
+	}
+}
+
 // TODO copy maybe expensive, but this seems to be the only convinent way.
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
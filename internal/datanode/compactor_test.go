@@ -451,6 +451,18 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		})
 	})
 
+	t.Run("Test clustering key", func(t *testing.T) {
+		assert.Equal(t, -1, compareClusteringKey(int8(1), int8(2)))
+		assert.Equal(t, 0, compareClusteringKey(int64(2), int64(2)))
+		assert.Equal(t, 1, compareClusteringKey(float32(2.5), float32(1.5)))
+		assert.Equal(t, -1, compareClusteringKey(float64(-1), float64(0)))
+		assert.Equal(t, 1, compareClusteringKey("east", "apac"))
+
+		assert.Equal(t, int64(16), clusteringKey2GenericValue(int16(16)).GetInt64Val())
+		assert.Equal(t, float64(1.5), clusteringKey2GenericValue(float32(1.5)).GetFloatVal())
+		assert.Equal(t, "east", clusteringKey2GenericValue("east").GetStringVal())
+	})
+
 	t.Run("Test isExpiredEntity", func(t *testing.T) {
 		t.Run("When CompactionEntityExpiration is set math.MaxInt64", func(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = math.MaxInt64
@@ -687,6 +699,13 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 			assert.Equal(t, int64(2), result.GetNumOfRows())
 			assert.NotEmpty(t, result.InsertLogs)
 			assert.NotEmpty(t, result.Field2StatslogPaths)
+			// the time range of the rows is kept so that the compacted segment could be compacted again
+			for _, fieldBinlog := range result.GetInsertLogs() {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					assert.NotZero(t, binlog.GetTimestampFrom())
+					assert.LessOrEqual(t, binlog.GetTimestampFrom(), binlog.GetTimestampTo())
+				}
+			}
 
 			// New test, remove all the binlogs in memkv
 			//  Deltas in timetravel range
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -250,6 +251,7 @@ func (node *DataNode) Init() error {
 	node.idAllocator = idAllocator
 
 	node.factory.Init(&Params)
+	storage.EnablePayloadChecksum = Params.CommonCfg.EnablePayloadChecksum
 	log.Info("DataNode Init successfully",
 		zap.String("MsgChannelSubName", Params.CommonCfg.DataNodeSubName))
 
@@ -486,8 +488,11 @@ func (node *DataNode) Start() error {
 	}
 
 	connectEtcdFn := func() error {
-		etcdKV := etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath)
-		node.watchKv = etcdKV
+		if metaKV := rocksdbkv.NewLocalMetaKV(Params.EtcdCfg.MetaRootPath); metaKV != nil {
+			node.watchKv = metaKV
+			return nil
+		}
+		node.watchKv = etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath)
 		return nil
 	}
 	err = retry.Do(node.ctx, connectEtcdFn, retry.Attempts(ConnectEtcdMaxRetryTime))
@@ -920,6 +925,30 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 	// oneSegment is definitely in the replica, guaranteed by the check before.
 	collID, partID, _ := replica.getCollectionAndPartitionID(oneSegment)
 	chanName, _ := replica.getChannelName(oneSegment)
+
+	if len(req.GetClusteredSegments()) > 0 {
+		targetSegs := make([]*Segment, 0, len(req.GetClusteredSegments()))
+		for _, clustered := range req.GetClusteredSegments() {
+			targetSeg := &Segment{
+				collectionID: collID,
+				partitionID:  partID,
+				channelName:  chanName,
+				segmentID:    clustered.GetSegmentID(),
+				numRows:      clustered.GetNumOfRows(),
+			}
+			replica.(*SegmentReplica).initPKBloomFilter(ctx, targetSeg, clustered.GetField2StatslogPaths(), tsoutil.GetCurrentTime())
+			targetSegs = append(targetSegs, targetSeg)
+		}
+
+		if err := replica.clusterFlushedSegments(targetSegs, req.GetPlanID(), req.GetCompactedFrom()); err != nil {
+			status.Reason = err.Error()
+			return status, nil
+		}
+
+		status.ErrorCode = commonpb.ErrorCode_Success
+		return status, nil
+	}
+
 	targetSeg := &Segment{
 		collectionID: collID,
 		partitionID:  partID,
@@ -1044,6 +1073,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	segmentSize := int64(Params.DataCoordCfg.SegmentMaxSize) * 1024 * 1024
 	importWrapper := importutil.NewImportWrapper(ctx, colInfo.GetSchema(), colInfo.GetShardsNum(), segmentSize, node.idAllocator, node.chunkManager,
 		importFlushReqFunc(node, req, importResult, colInfo.GetSchema(), ts), importResult, reportFunc)
+	importWrapper.SetTsEndPoint(getImportEndTs(req.GetImportTask()))
 	err = importWrapper.Import(req.GetImportTask().GetFiles(), req.GetImportTask().GetRowBased(), false)
 	if err != nil {
 		importResult.State = commonpb.ImportState_ImportFailed
@@ -1154,6 +1184,21 @@ func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImpor
 	}, nil
 }
 
+// getImportEndTs returns the timestamp end point passed by rootcoord, 0 if there is none
+func getImportEndTs(task *datapb.ImportTask) Timestamp {
+	for _, kv := range task.GetInfos() {
+		if kv.GetKey() == importutil.EndTs {
+			ts, err := strconv.ParseUint(kv.GetValue(), 10, 64)
+			if err != nil {
+				log.Warn("invalid import end timestamp", zap.String("value", kv.GetValue()), zap.Error(err))
+				return 0
+			}
+			return ts
+		}
+	}
+	return 0
+}
+
 func importFlushReqFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoordpb.ImportResult, schema *schemapb.CollectionSchema, ts Timestamp) importutil.ImportFlushFunc {
 	return func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
 		chNames := req.GetImportTask().GetChannelNames()
@@ -1320,6 +1365,11 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 	if err != nil {
 		return nil, nil, err
 	}
+	fieldStatsBinLogs, err := storage.SerializeFieldStats(storage.GenerateFieldStats(schema, data.buffer))
+	if err != nil {
+		return nil, nil, err
+	}
+	statsBinLogs = append(statsBinLogs, fieldStatsBinLogs...)
 
 	var alloc allocatorInterface = newAllocator(node.rootCoord)
 	start, _, err := alloc.allocIDBatch(uint32(len(binLogs)))
@@ -1350,6 +1400,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 			TimestampTo:   ts,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
+			Checksum:      storage.Checksum(blob.Value),
 		}
 		field2Logidx[fieldID] = logidx
 	}
@@ -1376,6 +1427,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 			TimestampTo:   ts,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
+			Checksum:      storage.Checksum(blob.Value),
 		}
 	}
 
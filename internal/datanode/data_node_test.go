@@ -1159,3 +1159,13 @@ func TestDataNode_ResendSegmentStats(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	assert.ElementsMatch(t, []UniqueID{0, 1, 2}, resp.GetSegResent())
 }
+
+func TestGetImportEndTs(t *testing.T) {
+	assert.Equal(t, Timestamp(0), getImportEndTs(&datapb.ImportTask{}))
+	assert.Equal(t, Timestamp(0), getImportEndTs(&datapb.ImportTask{
+		Infos: []*commonpb.KeyValuePair{{Key: "end_ts", Value: "invalid"}},
+	}))
+	assert.Equal(t, Timestamp(1000), getImportEndTs(&datapb.ImportTask{
+		Infos: []*commonpb.KeyValuePair{{Key: "bucket", Value: "b"}, {Key: "end_ts", Value: "1000"}},
+	}))
+}
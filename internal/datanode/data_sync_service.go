@@ -52,6 +52,7 @@ type dataSyncService struct {
 
 	flushingSegCache *Cache       // a guarding cache stores currently flushing segment ids
 	flushManager     flushManager // flush manager handles flush process
+	checkpoints      *checkpointTracker
 	chunkManager     storage.ChunkManager
 	compactor        *compactionExecutor // reference to compaction executor
 
@@ -104,6 +105,7 @@ func newDataSyncService(ctx context.Context,
 		chunkManager:     chunkManager,
 		compactor:        compactor,
 		ioPool:           ioPool,
+		checkpoints:      newCheckpointTracker(vchan.GetChannelName()),
 	}
 
 	if err := service.initNodes(vchan); err != nil {
@@ -123,6 +125,7 @@ type nodeConfig struct {
 	vChannelName string
 	replica      Replica // Segment replica
 	allocator    allocatorInterface
+	checkpoints  *checkpointTracker // tracks the channel checkpoint, nil to disable
 
 	// defaults
 	parallelConfig
@@ -138,6 +141,14 @@ func (dsService *dataSyncService) start() {
 		log.Info("dataSyncService starting flow graph", zap.Int64("collectionID", dsService.collectionID),
 			zap.String("vChanName", dsService.vchannelName))
 		dsService.fg.Start()
+		if Params.DataNodeCfg.ChannelCheckpointInterval > 0 {
+			reporter := &checkpointReporter{
+				tracker:   dsService.checkpoints,
+				dataCoord: dsService.dataCoord,
+				interval:  Params.DataNodeCfg.ChannelCheckpointInterval,
+			}
+			go reporter.run(dsService.ctx)
+		}
 	} else {
 		log.Warn("dataSyncService starting flow graph is nil", zap.Int64("collectionID", dsService.collectionID),
 			zap.String("vChanName", dsService.vchannelName))
@@ -275,6 +286,7 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		vChannelName: vchanInfo.GetChannelName(),
 		replica:      dsService.replica,
 		allocator:    dsService.idAllocator,
+		checkpoints:  dsService.checkpoints,
 
 		parallelConfig: newParallelConfig(),
 	}
@@ -166,6 +166,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 			}
 
 			rateCol.Add(metricsinfo.InsertConsumeThroughput, float64(proto.Size(&imsg.InsertRequest)))
+			rateCol.addChannelInsert(ddn.vChannelName, float64(proto.Size(&imsg.InsertRequest)))
 			metrics.DataNodeConsumeCounter.WithLabelValues(strconv.FormatInt(Params.DataNodeCfg.GetNodeID(), 10), metrics.InsertLabel).Add(float64(proto.Size(&imsg.InsertRequest)))
 
 			log.Debug("DDNode receive insert messages",
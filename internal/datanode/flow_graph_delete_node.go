@@ -26,8 +26,6 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/api/schemapb"
-	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -52,6 +50,7 @@ type deleteNode struct {
 	replica      Replica
 	idAllocator  allocatorInterface
 	flushManager flushManager
+	checkpoints  *checkpointTracker
 
 	clearSignal chan<- string
 }
@@ -162,6 +161,9 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 			log.Error(err.Error())
 			panic(err)
 		}
+		for _, segID := range tmpSegIDs {
+			dn.checkpoints.buffer(deleteBuffer, segID, fgMsg.startPositions[0])
+		}
 		segIDs = append(segIDs, tmpSegIDs...)
 	}
 
@@ -176,6 +178,7 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 			zap.Int64s("segIDs", fgMsg.segmentsToFlush),
 			zap.String("vChannelName", dn.channelName))
 		for _, segmentToFlush := range fgMsg.segmentsToFlush {
+			dn.checkpoints.flush(deleteBuffer, segmentToFlush, fgMsg.endPositions[0])
 			buf, ok := dn.delBuf.Load(segmentToFlush)
 			if !ok {
 				// no related delta data to flush, send empty buf to complete flush life-cycle
@@ -202,6 +205,11 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		dn.clearSignal <- dn.channelName
 	}
 
+	// all the messages before the end position are either buffered or flushing
+	if len(fgMsg.endPositions) > 0 {
+		dn.checkpoints.consume(fgMsg.endPositions[0])
+	}
+
 	for _, sp := range spans {
 		sp.Finish()
 	}
@@ -246,6 +254,41 @@ func (dn *deleteNode) updateCompactedSegments() {
 		)
 		dn.replica.removeSegments(compactedFrom...)
 	}
+
+	// the deletions of the segments compacted by clustering compaction are dispatched to
+	// the clustered segments which may contain the pks
+	for clusteredFrom, clusteredTo := range dn.replica.listClusteredSegmentIDs() {
+		if value, loaded := dn.delBuf.LoadAndDelete(clusteredFrom); loaded {
+			buf := value.(*DelDataBuf)
+			// the clustered segments are all in the partition of the compacted segments
+			_, partitionID, err := dn.replica.getCollectionAndPartitionID(clusteredTo[0])
+			if err == nil {
+				segIDToPks, segIDToTss := dn.filterSegmentByPK(partitionID, buf.delData.Pks, buf.delData.Tss)
+				for _, segID := range clusteredTo {
+					pks, tss := segIDToPks[segID], segIDToTss[segID]
+					if len(pks) == 0 {
+						continue
+					}
+					var segDelBuf *DelDataBuf
+					if value, loaded := dn.delBuf.Load(segID); loaded {
+						segDelBuf = value.(*DelDataBuf)
+					} else {
+						segDelBuf = newDelDataBuf()
+					}
+					segDelBuf.delData.Pks = append(segDelBuf.delData.Pks, pks...)
+					segDelBuf.delData.Tss = append(segDelBuf.delData.Tss, tss...)
+					segDelBuf.updateSize(int64(len(pks)))
+					segDelBuf.updateTimeRange(TimeRange{timestampMin: buf.TimestampFrom, timestampMax: buf.TimestampTo})
+					dn.delBuf.Store(segID, segDelBuf)
+				}
+			}
+		}
+		log.Debug("update delBuf for clustered segments",
+			zap.Int64("clusteredFrom segmentID", clusteredFrom),
+			zap.Int64s("clusteredTo segmentIDs", clusteredTo),
+		)
+		dn.replica.removeSegments(clusteredFrom)
+	}
 }
 
 func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) ([]UniqueID, error) {
@@ -301,24 +344,11 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) ([
 func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []primaryKey, tss []Timestamp) (map[UniqueID][]primaryKey, map[UniqueID][]uint64) {
 	segID2Pks := make(map[UniqueID][]primaryKey)
 	segID2Tss := make(map[UniqueID][]uint64)
-	buf := make([]byte, 8)
 	segments := dn.replica.filterSegments(dn.channelName, partID)
 	for index, pk := range pks {
 		for _, segment := range segments {
 			segmentID := segment.segmentID
-			exist := false
-			switch pk.Type() {
-			case schemapb.DataType_Int64:
-				int64Pk := pk.(*int64PrimaryKey)
-				common.Endian.PutUint64(buf, uint64(int64Pk.Value))
-				exist = segment.pkFilter.Test(buf)
-			case schemapb.DataType_VarChar:
-				varCharPk := pk.(*varCharPrimaryKey)
-				exist = segment.pkFilter.TestString(varCharPk.Value)
-			default:
-				//TODO::
-			}
-			if exist {
+			if segment.isPKExist(pk) {
 				segID2Pks[segmentID] = append(segID2Pks[segmentID], pk)
 				segID2Tss[segmentID] = append(segID2Tss[segmentID], tss[index])
 			}
@@ -342,6 +372,7 @@ func newDeleteNode(ctx context.Context, fm flushManager, sig chan<- string, conf
 		idAllocator:  config.allocator,
 		channelName:  config.vChannelName,
 		flushManager: fm,
+		checkpoints:  config.checkpoints,
 		clearSignal:  sig,
 	}, nil
 }
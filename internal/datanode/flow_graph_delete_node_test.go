@@ -60,6 +60,10 @@ func (replica *mockReplica) listCompactedSegmentIDs() map[UniqueID][]UniqueID {
 	return make(map[UniqueID][]UniqueID)
 }
 
+func (replica *mockReplica) listClusteredSegmentIDs() map[UniqueID][]UniqueID {
+	return make(map[UniqueID][]UniqueID)
+}
+
 func (replica *mockReplica) removeSegments(segIDs ...UniqueID) {}
 
 func (replica *mockReplica) filterSegments(channelName string, partitionID UniqueID) []*Segment {
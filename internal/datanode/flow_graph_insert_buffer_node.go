@@ -65,6 +65,8 @@ type insertBufferNode struct {
 	resendTTChan     <-chan resendTTMsg
 	flushingSegCache *Cache
 	flushManager     flushManager
+	spiller          *bufferSpiller
+	checkpoints      *checkpointTracker
 
 	timeTickStream msgstream.MsgStream
 	ttLogger       *timeTickLogger
@@ -104,13 +106,17 @@ type segmentCheckPoint struct {
 }
 
 // BufferData buffers insert data, monitoring buffer size and limit
-// size and limit both indicate numOfRows
+// size and limit both indicate numOfRows, including the rows spilled to disk
 type BufferData struct {
 	buffer *InsertData
 	size   int64
 	limit  int64
 	tsFrom Timestamp
 	tsTo   Timestamp
+
+	memorySize  int64 // bytes of the buffer in memory
+	spilled     []*spilledChunk
+	spilledSize int64 // bytes of the buffer spilled to disk
 }
 
 // newBufferData needs an input dimension to calculate the limit of this buffer
@@ -152,6 +158,13 @@ func (bd *BufferData) updateSize(no int64) {
 	bd.size += no
 }
 
+// updateMemorySize adds the memory size of the data appended to the buffer
+func (bd *BufferData) updateMemorySize(data *InsertData) {
+	for _, field := range data.Data {
+		bd.memorySize += int64(field.GetMemorySize())
+	}
+}
+
 // updateTimeRange update BufferData tsFrom, tsTo range according to input time range
 func (bd *BufferData) updateTimeRange(tr TimeRange) {
 	if tr.timestampMin < bd.tsFrom {
@@ -169,6 +182,13 @@ func (ibNode *insertBufferNode) Name() string {
 func (ibNode *insertBufferNode) Close() {
 	ibNode.ttMerger.close()
 
+	if ibNode.spiller != nil {
+		ibNode.spiller.close()
+	}
+	nodeID := fmt.Sprint(Params.DataNodeCfg.GetNodeID())
+	metrics.DataNodeInsertBufferSize.DeleteLabelValues(nodeID, ibNode.channelName)
+	metrics.DataNodeSpilledBufferSize.DeleteLabelValues(nodeID, ibNode.channelName)
+
 	if ibNode.timeTickStream != nil {
 		ibNode.timeTickStream.Close()
 	}
@@ -245,6 +265,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			log.Error(err.Error())
 			panic(err)
 		}
+		ibNode.checkpoints.buffer(insertBuffer, msg.GetSegmentID(), startPositions[0])
 	}
 
 	// Find and return the smaller input
@@ -409,6 +430,18 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			panic(err)
 		}
 
+		if ibNode.spiller != nil {
+			err = retry.Do(ibNode.ctx, func() error {
+				return ibNode.spiller.reload(task.segmentID, task.buffer)
+			}, getFlowGraphRetryOpt())
+			if err != nil {
+				err = fmt.Errorf("insertBufferNode reload spilled buffer failed, err = %s", err)
+				log.Error(err.Error())
+				panic(err)
+			}
+		}
+
+		ibNode.checkpoints.flush(insertBuffer, task.segmentID, endPositions[0])
 		err = retry.Do(ibNode.ctx, func() error {
 			return ibNode.flushManager.flushBufferData(task.buffer,
 				segStats,
@@ -438,6 +471,8 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		}
 	}
 
+	ibNode.spillBuffers()
+
 	select {
 	case resendTTMsg := <-ibNode.resendTTChan:
 		log.Info("resend TT msg received in insertBufferNode",
@@ -578,6 +613,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 	// update buffer size
 	buffer.updateSize(int64(msg.NRows()))
+	buffer.updateMemorySize(addedBuffer)
 	// update timestamp range
 	buffer.updateTimeRange(ibNode.getTimestampRange(tsData))
 
@@ -609,6 +645,24 @@ func (ibNode *insertBufferNode) getTimestampRange(tsData *storage.Int64FieldData
 	return tr
 }
 
+// spillBuffers spills the largest buffers to disk under memory pressure if spill is enabled,
+// and reports the size of the buffers in memory and on disk.
+func (ibNode *insertBufferNode) spillBuffers() {
+	if ibNode.spiller != nil {
+		ibNode.spiller.trySpill(&ibNode.insertBuffer)
+	}
+
+	var memorySize, spilledSize int64
+	ibNode.insertBuffer.Range(func(_, v interface{}) bool {
+		memorySize += v.(*BufferData).memorySize
+		spilledSize += v.(*BufferData).spilledSize
+		return true
+	})
+	nodeID := fmt.Sprint(Params.DataNodeCfg.GetNodeID())
+	metrics.DataNodeInsertBufferSize.WithLabelValues(nodeID, ibNode.channelName).Set(float64(memorySize))
+	metrics.DataNodeSpilledBufferSize.WithLabelValues(nodeID, ibNode.channelName).Set(float64(spilledSize))
+}
+
 // writeHardTimeTick writes timetick once insertBufferNode operates.
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp, segmentIDs []int64) {
 	ibNode.ttLogger.LogTs(ts)
@@ -673,6 +727,11 @@ func newInsertBufferNode(ctx context.Context, collID UniqueID, flushCh <-chan fl
 		return wTtMsgStream.Produce(&msgPack)
 	})
 
+	var spiller *bufferSpiller
+	if Params.DataNodeCfg.SpillEnabled {
+		spiller = newBufferSpiller(ctx, config.vChannelName, config.replica)
+	}
+
 	return &insertBufferNode{
 		ctx:          ctx,
 		BaseNode:     baseNode,
@@ -684,6 +743,8 @@ func newInsertBufferNode(ctx context.Context, collID UniqueID, flushCh <-chan fl
 		resendTTChan:     resendTTCh,
 		flushingSegCache: flushingSegCache,
 		flushManager:     fm,
+		spiller:          spiller,
+		checkpoints:      config.checkpoints,
 
 		replica:     config.replica,
 		idAllocator: config.allocator,
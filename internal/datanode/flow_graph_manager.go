@@ -55,6 +55,7 @@ func (fm *flowgraphManager) addAndStart(dn *DataNode, vchan *datapb.VchannelInfo
 		log.Warn("new data sync service fail", zap.String("vChannelName", vchan.GetChannelName()), zap.Error(err))
 		return err
 	}
+	rateCol.registerChannel(vchan.GetChannelName())
 	dataSyncService.start()
 	fm.flowgraphs.Store(vchan.GetChannelName(), dataSyncService)
 
@@ -68,6 +69,7 @@ func (fm *flowgraphManager) release(vchanName string) {
 		metrics.DataNodeNumFlowGraphs.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID())).Dec()
 	}
 	rateCol.removeFlowGraphChannel(vchanName)
+	rateCol.deregisterChannel(vchanName)
 }
 
 func (fm *flowgraphManager) getFlushCh(segID UniqueID) (chan<- flushMsg, error) {
@@ -155,6 +157,16 @@ func (fm *flowgraphManager) getFlowGraphNum() int {
 	return length
 }
 
+// getChannels returns the vchannels of the flow graphs.
+func (fm *flowgraphManager) getChannels() []string {
+	channels := make([]string, 0)
+	fm.flowgraphs.Range(func(key, _ interface{}) bool {
+		channels = append(channels, key.(string))
+		return true
+	})
+	return channels
+}
+
 func (fm *flowgraphManager) dropAll() {
 	log.Info("start drop all flowgraph resources in DataNode")
 	fm.flowgraphs.Range(func(key, value interface{}) bool {
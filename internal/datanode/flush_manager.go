@@ -269,6 +269,8 @@ type rendezvousFlushManager struct {
 
 	dropping    atomic.Bool
 	dropHandler dropHandler
+
+	uploader *binlogUploader
 }
 
 // getFlushQueue gets or creates an orderFlushQueue for segment id if not found
@@ -361,14 +363,20 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segStats []by
 
 	// encode data and convert output data
 	inCodec := storage.NewInsertCodec(meta)
+	inCodec.SortByPK = Params.DataNodeCfg.SortByPK
+
+	binLogs, err := serializeInsertData(inCodec, partID, segmentID, data.buffer)
+	if err != nil {
+		return err
+	}
 
-	binLogs, _, err := inCodec.Serialize(partID, segmentID, data.buffer)
+	fieldStatsLogs, err := storage.SerializeFieldStats(storage.GenerateFieldStats(meta.GetSchema(), data.buffer))
 	if err != nil {
 		return err
 	}
 
-	// binlogs + 1 statslog
-	start, _, err := m.allocIDBatch(uint32(len(binLogs) + 1))
+	// binlogs + 1 statslog + field statslogs
+	start, _, err := m.allocIDBatch(uint32(len(binLogs) + 1 + len(fieldStatsLogs)))
 	if err != nil {
 		return err
 	}
@@ -377,6 +385,26 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segStats []by
 	kvs := make(map[string][]byte, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
 	for idx, blob := range binLogs {
+		if blob.GetKey() == storage.SegmentFileDir {
+			// all the fields share the segment file
+			logidx := start + int64(idx)
+			key := segmentFileKey(m.ChunkManager.RootPath(), collID, partID, segmentID, logidx)
+			kvs[key] = blob.Value[:]
+			checksum := storage.Checksum(blob.Value)
+			for fieldID := range data.buffer.Data {
+				field2Insert[fieldID] = &datapb.Binlog{
+					EntriesNum:    data.size,
+					TimestampFrom: data.tsFrom,
+					TimestampTo:   data.tsTo,
+					LogPath:       key,
+					LogSize:       int64(fieldMemorySize[fieldID]),
+					Checksum:      checksum,
+				}
+				field2Logidx[fieldID] = logidx
+			}
+			continue
+		}
+
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
 			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
@@ -397,6 +425,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segStats []by
 			TimestampTo:   data.tsTo,
 			LogPath:       key,
 			LogSize:       int64(fieldMemorySize[fieldID]),
+			Checksum:      storage.Checksum(blob.Value),
 		}
 		field2Logidx[fieldID] = logidx
 	}
@@ -419,11 +448,29 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segStats []by
 		TimestampTo:   0, //TODO,
 		LogPath:       key,
 		LogSize:       int64(len(segStats)),
+		Checksum:      storage.Checksum(segStats),
+	}
+
+	// write field stats binlog of the scalar fields
+	for idx, blob := range fieldStatsLogs {
+		// Blob Key is generated by SerializeFieldStats from int64 fieldID in collection schema, which won't raise error in ParseInt
+		fieldID, _ := strconv.ParseInt(blob.GetKey(), 10, 64)
+		logidx := start + int64(len(binLogs)+1+idx)
+		k := metautil.JoinIDPath(collID, partID, segmentID, fieldID, logidx)
+		key := path.Join(m.ChunkManager.RootPath(), common.SegmentStatslogPath, k)
+		kvs[key] = blob.GetValue()
+		field2Stats[fieldID] = &datapb.Binlog{
+			EntriesNum: data.size,
+			LogPath:    key,
+			LogSize:    int64(len(blob.GetValue())),
+			Checksum:   storage.Checksum(blob.GetValue()),
+		}
 	}
 
 	m.updateSegmentCheckPoint(segmentID)
 	m.handleInsertTask(segmentID, &flushBufferInsertTask{
 		ChunkManager: m.ChunkManager,
+		uploader:     m.uploader,
 		data:         kvs,
 	}, field2Insert, field2Stats, flushed, dropped, pos)
 
@@ -464,9 +511,11 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 	kvs := map[string][]byte{blobPath: blob.Value[:]}
 	data.LogSize = int64(len(blob.Value))
 	data.LogPath = blobPath
+	data.Checksum = storage.Checksum(blob.Value)
 	log.Info("delete blob path", zap.String("path", blobPath))
 	m.handleDeleteTask(segmentID, &flushBufferDeleteTask{
 		ChunkManager: m.ChunkManager,
+		uploader:     m.uploader,
 		data:         kvs,
 	}, data, pos)
 	return nil
@@ -562,7 +611,8 @@ func (m *rendezvousFlushManager) close() {
 
 type flushBufferInsertTask struct {
 	storage.ChunkManager
-	data map[string][]byte
+	uploader *binlogUploader
+	data     map[string][]byte
 }
 
 // flushInsertData implements flushInsertTask
@@ -571,13 +621,8 @@ func (t *flushBufferInsertTask) flushInsertData() error {
 	defer cancel()
 	if t.ChunkManager != nil && len(t.data) > 0 {
 		tr := timerecord.NewTimeRecorder("insertData")
-		err := t.MultiWrite(ctx, t.data)
+		err := t.uploader.upload(ctx, t.ChunkManager, t.data, metrics.InsertLabel)
 		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID()), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
-		if err == nil {
-			for _, d := range t.data {
-				metrics.DataNodeFlushedSize.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID()), metrics.InsertLabel).Add(float64(len(d)))
-			}
-		}
 		return err
 	}
 	return nil
@@ -585,7 +630,8 @@ func (t *flushBufferInsertTask) flushInsertData() error {
 
 type flushBufferDeleteTask struct {
 	storage.ChunkManager
-	data map[string][]byte
+	uploader *binlogUploader
+	data     map[string][]byte
 }
 
 // flushDeleteData implements flushDeleteTask
@@ -594,13 +640,8 @@ func (t *flushBufferDeleteTask) flushDeleteData() error {
 	defer cancel()
 	if len(t.data) > 0 && t.ChunkManager != nil {
 		tr := timerecord.NewTimeRecorder("deleteData")
-		err := t.MultiWrite(ctx, t.data)
+		err := t.uploader.upload(ctx, t.ChunkManager, t.data, metrics.DeleteLabel)
 		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID()), metrics.DeleteLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
-		if err == nil {
-			for _, d := range t.data {
-				metrics.DataNodeFlushedSize.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID()), metrics.DeleteLabel).Add(float64(len(d)))
-			}
-		}
 		return err
 	}
 	return nil
@@ -616,6 +657,7 @@ func NewRendezvousFlushManager(allocator allocatorInterface, cm storage.ChunkMan
 		dropHandler: dropHandler{
 			flushAndDrop: drop,
 		},
+		uploader: getBinlogUploader(),
 	}
 	// start with normal mode
 	fm.dropping.Store(false)
@@ -800,6 +842,7 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			StartPositions: startPos,
 			Flushed:        pack.flushed,
 			Dropped:        pack.dropped,
+			Sorted:         Params.DataNodeCfg.SortByPK,
 		}
 		err := retry.Do(context.Background(), func() error {
 			rsp, err := dsService.dataCoord.SaveBinlogPaths(context.Background(), req)
@@ -839,6 +882,7 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 		if pack.flushed || pack.dropped {
 			dsService.replica.segmentFlushed(pack.segmentID)
 		}
+		dsService.checkpoints.flushed(pack.segmentID, pack.pos)
 		dsService.flushingSegCache.Remove(req.GetSegmentID())
 	}
 }
@@ -157,6 +157,7 @@ func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBu
 				{
 					LogSize:       deltaLogs.GetLogSize(),
 					LogPath:       deltaLogs.GetLogPath(),
+					Checksum:      deltaLogs.GetChecksum(),
 					TimestampFrom: deltaLogs.GetTimestampFrom(),
 					TimestampTo:   deltaLogs.GetTimestampTo(),
 					EntriesNum:    deltaLogs.GetEntriesNum(),
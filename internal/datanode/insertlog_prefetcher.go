@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sync"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// insertlogGroup is the deserialized insert binlogs of all the fields generated by one `Serialize`
+type insertlogGroup struct {
+	iter *storage.InsertBinlogIterator
+	size int64
+	err  error
+}
+
+// insertlogPrefetcher downloads and deserializes the insert binlog groups of the compacted segments ahead of the merge.
+// A group is downloaded only when the size of the groups prefetched but not released is below the budget,
+// so the memory used is bounded by the budget plus the size of one group.
+// The size of a group is its deserialized size estimated by sizePerRecord, as the compressed blobs are
+// dropped once deserialized; the compressed size is used if sizePerRecord is unknown.
+type insertlogPrefetcher struct {
+	dl            downloader
+	paths         [][]string
+	pkID          UniqueID
+	pkType        schemapb.DataType
+	sizePerRecord int64
+	budget        int64
+
+	mu       sync.Mutex
+	inUse    int64
+	released chan struct{}
+	groups   chan *insertlogGroup
+}
+
+func newInsertlogPrefetcher(ctx context.Context, dl downloader, paths [][]string,
+	pkID UniqueID, pkType schemapb.DataType, sizePerRecord int64, budget int64) *insertlogPrefetcher {
+	p := &insertlogPrefetcher{
+		dl:            dl,
+		paths:         paths,
+		pkID:          pkID,
+		pkType:        pkType,
+		sizePerRecord: sizePerRecord,
+		budget:        budget,
+		released:      make(chan struct{}, 1),
+		groups:        make(chan *insertlogGroup),
+	}
+	go p.run(ctx)
+	return p
+}
+
+func (p *insertlogPrefetcher) run(ctx context.Context) {
+	defer close(p.groups)
+	for _, path := range p.paths {
+		if !p.waitBudget(ctx) {
+			return
+		}
+
+		group := p.load(ctx, path)
+
+		p.mu.Lock()
+		p.inUse += group.size
+		p.mu.Unlock()
+
+		select {
+		case p.groups <- group:
+		case <-ctx.Done():
+			return
+		}
+		if group.err != nil {
+			return
+		}
+	}
+}
+
+func (p *insertlogPrefetcher) load(ctx context.Context, path []string) *insertlogGroup {
+	blobs, err := p.dl.download(ctx, path)
+	if err != nil {
+		return &insertlogGroup{err: err}
+	}
+	iter, err := storage.NewInsertBinlogIterator(blobs, p.pkID, p.pkType)
+	if err != nil {
+		return &insertlogGroup{err: err}
+	}
+	group := &insertlogGroup{iter: iter}
+	if p.sizePerRecord > 0 {
+		group.size = p.sizePerRecord * int64(iter.RowNum())
+	} else {
+		for _, blob := range blobs {
+			group.size += int64(len(blob.GetValue()))
+		}
+	}
+	return group
+}
+
+// waitBudget blocks until the size in use is below the budget, returns false if ctx is done
+func (p *insertlogPrefetcher) waitBudget(ctx context.Context) bool {
+	for {
+		p.mu.Lock()
+		inUse := p.inUse
+		p.mu.Unlock()
+		if inUse < p.budget {
+			return true
+		}
+
+		select {
+		case <-p.released:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// next returns the next prefetched group, false if all the groups are consumed or the ctx is done
+func (p *insertlogPrefetcher) next() (*insertlogGroup, bool) {
+	group, ok := <-p.groups
+	return group, ok
+}
+
+// release disposes the group and frees the budget used by it once it is merged
+func (p *insertlogPrefetcher) release(group *insertlogGroup) {
+	group.iter.Dispose()
+	p.mu.Lock()
+	p.inUse -= group.size
+	p.mu.Unlock()
+
+	select {
+	case p.released <- struct{}{}:
+	default:
+	}
+}
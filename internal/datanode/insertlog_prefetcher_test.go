@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// sizedDownloader returns the binlogs of 2 rows serialized by InsertCodec for each group
+type sizedDownloader struct {
+	mu         sync.Mutex
+	blobs      []*Blob
+	downloaded [][]string
+	errAt      int // fails the download of the n-th group if positive
+}
+
+func newSizedDownloader(t *testing.T, errAt int) *sizedDownloader {
+	meta := NewMetaFactory().GetCollectionMeta(1, "test_prefetch", schemapb.DataType_Int64)
+	blobs, _, err := storage.NewInsertCodec(meta).Serialize(10, 1, genInsertData())
+	require.NoError(t, err)
+	return &sizedDownloader{blobs: blobs, errAt: errAt}
+}
+
+func (d *sizedDownloader) download(ctx context.Context, paths []string) ([]*Blob, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.downloaded = append(d.downloaded, paths)
+	if len(d.downloaded) == d.errAt {
+		return nil, errors.New("mock download error")
+	}
+	return d.blobs, nil
+}
+
+func (d *sizedDownloader) getDownloaded() [][]string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([][]string{}, d.downloaded...)
+}
+
+func TestInsertlogPrefetcher(t *testing.T) {
+	paths := [][]string{{"a1", "a2"}, {"b1", "b2"}, {"c1", "c2"}}
+	const (
+		pkID          = 106
+		sizePerRecord = 100
+		// each group is charged by the deserialized size of its 2 rows
+		groupSize = 2 * sizePerRecord
+	)
+
+	t.Run("bounded by budget", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		dl := newSizedDownloader(t, 0)
+		// only one group is prefetched ahead
+		p := newInsertlogPrefetcher(ctx, dl, paths, pkID, schemapb.DataType_Int64, sizePerRecord, groupSize)
+
+		group, ok := p.next()
+		require.True(t, ok)
+		assert.NoError(t, group.err)
+		assert.Equal(t, int64(groupSize), group.size)
+		assert.Equal(t, 2, group.iter.RowNum())
+
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, paths[:1], dl.getDownloaded())
+
+		p.release(group)
+		assert.False(t, group.iter.HasNext())
+		for i := 0; i < 2; i++ {
+			group, ok = p.next()
+			require.True(t, ok)
+			assert.NoError(t, group.err)
+			p.release(group)
+		}
+		_, ok = p.next()
+		assert.False(t, ok)
+		assert.Equal(t, paths, dl.getDownloaded())
+	})
+
+	t.Run("compressed size without size per record", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		dl := newSizedDownloader(t, 0)
+		p := newInsertlogPrefetcher(ctx, dl, paths[:1], pkID, schemapb.DataType_Int64, 0, groupSize)
+
+		var blobSize int64
+		for _, blob := range dl.blobs {
+			blobSize += int64(len(blob.GetValue()))
+		}
+		group, ok := p.next()
+		require.True(t, ok)
+		assert.NoError(t, group.err)
+		assert.Equal(t, blobSize, group.size)
+		p.release(group)
+	})
+
+	t.Run("download failed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		p := newInsertlogPrefetcher(ctx, newSizedDownloader(t, 2), paths, pkID, schemapb.DataType_Int64, sizePerRecord, 10*groupSize)
+
+		group, ok := p.next()
+		require.True(t, ok)
+		assert.NoError(t, group.err)
+
+		group, ok = p.next()
+		require.True(t, ok)
+		assert.Error(t, group.err)
+
+		_, ok = p.next()
+		assert.False(t, ok)
+	})
+
+	t.Run("deserialize failed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		dl := &sizedDownloader{blobs: []*Blob{{Key: "1", Value: []byte("invalid")}}}
+		p := newInsertlogPrefetcher(ctx, dl, paths, pkID, schemapb.DataType_Int64, sizePerRecord, 10*groupSize)
+
+		group, ok := p.next()
+		require.True(t, ok)
+		assert.Error(t, group.err)
+
+		_, ok = p.next()
+		assert.False(t, ok)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		p := newInsertlogPrefetcher(ctx, newSizedDownloader(t, 0), paths, pkID, schemapb.DataType_Int64, sizePerRecord, 1)
+
+		group, ok := p.next()
+		require.True(t, ok)
+		assert.NoError(t, group.err)
+
+		// the prefetcher is blocked by the budget until canceled
+		cancel()
+		_, ok = p.next()
+		assert.False(t, ok)
+	})
+}
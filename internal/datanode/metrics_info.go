@@ -49,8 +49,9 @@ func (node *DataNode) getQuotaMetrics() (*metricsinfo.DataNodeQuotaMetrics, erro
 	}
 
 	return &metricsinfo.DataNodeQuotaMetrics{
-		Hms: metricsinfo.HardwareMetrics{},
-		Rms: rms,
+		Hms:  metricsinfo.HardwareMetrics{},
+		Rms:  rms,
+		Crms: rateCol.getChannelInsertRates(node.flowgraphManager.getChannels()),
 		Fgm: metricsinfo.FlowGraphMetric{
 			MinFlowGraphTt: rateCol.getMinFlowGraphTt(),
 			NumFlowGraph:   node.flowgraphManager.getFlowGraphNum(),
@@ -58,6 +59,14 @@ func (node *DataNode) getQuotaMetrics() (*metricsinfo.DataNodeQuotaMetrics, erro
 	}, nil
 }
 
+// getCapacity returns the capacity of the datanode to consume DML channels, it's the number of CPU cores by default.
+func getCapacity() float64 {
+	if Params.DataNodeCfg.Capacity > 0 {
+		return Params.DataNodeCfg.Capacity
+	}
+	return float64(metricsinfo.GetCPUCoreCount(false))
+}
+
 //getComponentConfigurations returns the configurations of dataNode matching req.Pattern
 func getComponentConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) *internalpb.ShowConfigurationsResponse {
 	prefix := "datanode."
@@ -118,6 +127,7 @@ func (node *DataNode) getSystemInfoMetrics(ctx context.Context, req *milvuspb.Ge
 		},
 		SystemConfigurations: metricsinfo.DataNodeConfiguration{
 			FlushInsertBufferSize: Params.DataNodeCfg.FlushInsertBufferSize,
+			Capacity:              getCapacity(),
 		},
 		QuotaMetrics: quotaMetrics,
 	}
@@ -19,6 +19,7 @@ package datanode
 import (
 	"sync"
 
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -69,3 +70,40 @@ func (r *rateCollector) getMinFlowGraphTt() Timestamp {
 	}
 	return minTt
 }
+
+// channelInsertLabel returns the rate label of the insert throughput of the channel.
+func channelInsertLabel(channel string) string {
+	return metricsinfo.InsertConsumeThroughput + "-" + channel
+}
+
+// registerChannel starts to collect the insert throughput of the channel.
+func (r *rateCollector) registerChannel(channel string) {
+	r.Register(channelInsertLabel(channel))
+}
+
+// deregisterChannel stops collecting the insert throughput of the channel.
+func (r *rateCollector) deregisterChannel(channel string) {
+	r.Deregister(channelInsertLabel(channel))
+}
+
+// addChannelInsert increases the insert throughput of the channel, it's ignored if the channel is not registered.
+func (r *rateCollector) addChannelInsert(channel string, size float64) {
+	r.Add(channelInsertLabel(channel), size)
+}
+
+// getChannelInsertRates returns the insert throughput of the channels, the unregistered ones are skipped.
+func (r *rateCollector) getChannelInsertRates(channels []string) []metricsinfo.ChannelRateMetric {
+	rates := make([]metricsinfo.ChannelRateMetric, 0, len(channels))
+	for _, channel := range channels {
+		rate, err := r.Rate(channelInsertLabel(channel), ratelimitutil.DefaultAvgDuration)
+		if err != nil {
+			continue
+		}
+		rates = append(rates, metricsinfo.ChannelRateMetric{
+			Channel: channel,
+			Label:   metricsinfo.InsertConsumeThroughput,
+			Rate:    rate,
+		})
+	}
+	return rates
+}
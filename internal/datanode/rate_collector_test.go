@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -37,4 +38,22 @@ func TestRateCollector(t *testing.T) {
 		minTt = collector.getMinFlowGraphTt()
 		assert.Equal(t, Timestamp(50), minTt)
 	})
+
+	t.Run("test channel insert rates", func(t *testing.T) {
+		collector, err := newRateCollector()
+		assert.NoError(t, err)
+
+		collector.registerChannel("channel1")
+		collector.addChannelInsert("channel1", 100)
+		collector.addChannelInsert("channel2", 100)
+
+		rates := collector.getChannelInsertRates([]string{"channel1", "channel2"})
+		assert.Equal(t, 1, len(rates))
+		assert.Equal(t, "channel1", rates[0].Channel)
+		assert.Equal(t, metricsinfo.InsertConsumeThroughput, rates[0].Label)
+		assert.True(t, rates[0].Rate > 0)
+
+		collector.deregisterChannel("channel1")
+		assert.Empty(t, collector.getChannelInsertRates([]string{"channel1"}))
+	})
 }
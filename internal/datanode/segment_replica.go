@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -70,9 +71,11 @@ type Replica interface {
 	updateSegmentCheckPoint(segID UniqueID)
 	updateSegmentPKRange(segID UniqueID, ids storage.FieldData)
 	mergeFlushedSegments(seg *Segment, planID UniqueID, compactedFrom []UniqueID) error
+	clusterFlushedSegments(segs []*Segment, planID UniqueID, compactedFrom []UniqueID) error
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
+	listClusteredSegmentIDs() map[UniqueID][]UniqueID
 
 	updateStatistics(segID UniqueID, numRows int64)
 	refreshFlushedSegStatistics(segID UniqueID, numRows int64)
@@ -93,6 +96,7 @@ type Segment struct {
 	isFlushed    atomic.Value // bool
 	channelName  string
 	compactedTo  UniqueID
+	clusteredTo  []UniqueID // segments created by clustering compaction from this segment
 
 	checkPoint segmentCheckPoint
 	startPos   *internalpb.MsgPosition // TODO readonly
@@ -101,6 +105,8 @@ type Segment struct {
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
 	minPK    primaryKey         //	minimal pk value, shortcut for checking whether a pk is inside this segment
 	maxPK    primaryKey         //  maximal pk value, same above
+
+	fieldStats map[UniqueID]*storage.FieldStats // min/max of the scalar fields, only collected by compaction
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
@@ -147,6 +153,23 @@ func (s *Segment) updatePk(pk primaryKey) error {
 	return nil
 }
 
+// isPKExist returns whether the pk may exist in the segment by the bloom filter.
+func (s *Segment) isPKExist(pk primaryKey) bool {
+	switch pk.Type() {
+	case schemapb.DataType_Int64:
+		buf := make([]byte, 8)
+		int64Pk := pk.(*int64PrimaryKey)
+		common.Endian.PutUint64(buf, uint64(int64Pk.Value))
+		return s.pkFilter.Test(buf)
+	case schemapb.DataType_VarChar:
+		varCharPk := pk.(*varCharPrimaryKey)
+		return s.pkFilter.TestString(varCharPk.Value)
+	default:
+		//TODO::
+	}
+	return false
+}
+
 func (s *Segment) updatePKRange(ids storage.FieldData) error {
 	switch pks := ids.(type) {
 	case *storage.Int64FieldData:
@@ -181,6 +204,33 @@ func (s *Segment) updatePKRange(ids storage.FieldData) error {
 	return nil
 }
 
+// updateFieldStats updates the min/max statistics of the scalar field with @data.
+func (s *Segment) updateFieldStats(fieldID UniqueID, dataType schemapb.DataType, data storage.FieldData) {
+	if s.fieldStats == nil {
+		s.fieldStats = make(map[UniqueID]*storage.FieldStats)
+	}
+	stats, ok := s.fieldStats[fieldID]
+	if !ok {
+		stats = storage.NewFieldStats(fieldID, dataType)
+		s.fieldStats[fieldID] = stats
+	}
+	stats.UpdateByFieldData(data)
+}
+
+// getFieldStats returns the valid min/max statistics of the scalar fields.
+func (s *Segment) getFieldStats() []*storage.FieldStats {
+	results := make([]*storage.FieldStats, 0, len(s.fieldStats))
+	for _, stats := range s.fieldStats {
+		if stats.IsValid() {
+			results = append(results, stats)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].FieldID < results[j].FieldID
+	})
+	return results
+}
+
 func (s *Segment) getSegmentStatslog(pkID UniqueID, pkType schemapb.DataType) ([]byte, error) {
 	pks := storage.PrimaryKeyStats{
 		FieldID: pkID,
@@ -417,12 +467,31 @@ func (replica *SegmentReplica) listCompactedSegmentIDs() map[UniqueID][]UniqueID
 	compactedTo2From := make(map[UniqueID][]UniqueID)
 
 	for segID, seg := range replica.compactedSegments {
+		if len(seg.clusteredTo) > 0 {
+			continue
+		}
 		compactedTo2From[seg.compactedTo] = append(compactedTo2From[seg.compactedTo], segID)
 	}
 
 	return compactedTo2From
 }
 
+// listClusteredSegmentIDs returns the segments compacted by clustering compaction, and the segments clustered to.
+func (replica *SegmentReplica) listClusteredSegmentIDs() map[UniqueID][]UniqueID {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	clusteredFrom2To := make(map[UniqueID][]UniqueID)
+
+	for segID, seg := range replica.compactedSegments {
+		if len(seg.clusteredTo) > 0 {
+			clusteredFrom2To[segID] = seg.clusteredTo
+		}
+	}
+
+	return clusteredFrom2To
+}
+
 // filterSegments return segments with same channelName and partition ID
 // get all segments
 func (replica *SegmentReplica) filterSegments(channelName string, partitionID UniqueID) []*Segment {
@@ -798,6 +867,58 @@ func (replica *SegmentReplica) mergeFlushedSegments(seg *Segment, planID UniqueI
 	return nil
 }
 
+// clusterFlushedSegments replaces the compactedFrom segments with the segments created by clustering compaction.
+func (replica *SegmentReplica) clusterFlushedSegments(segs []*Segment, planID UniqueID, compactedFrom []UniqueID) error {
+	log := log.With(
+		zap.Int64("collection ID", replica.collectionID),
+		zap.Int64s("compacted from", compactedFrom),
+		zap.Int64("planID", planID))
+
+	clusteredTo := make([]UniqueID, 0, len(segs))
+	for _, seg := range segs {
+		if seg.collectionID != replica.collectionID {
+			log.Warn("Mismatch collection", zap.Int64("segment ID", seg.segmentID), zap.Int64("input ID", seg.collectionID))
+			return fmt.Errorf("mismatch collection, ID=%d", seg.collectionID)
+		}
+		clusteredTo = append(clusteredTo, seg.segmentID)
+	}
+
+	var inValidSegments []UniqueID
+	for _, ID := range compactedFrom {
+		if !replica.hasSegment(ID, true) {
+			inValidSegments = append(inValidSegments, ID)
+		}
+	}
+
+	if len(inValidSegments) > 0 {
+		log.Warn("no match flushed segments to cluster from", zap.Int64s("invalid segmentIDs", inValidSegments))
+		return fmt.Errorf("invalid compactedFrom segments: %v", inValidSegments)
+	}
+
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+	log.Info("cluster flushed segments", zap.Int64s("clustered to", clusteredTo))
+	for _, ID := range compactedFrom {
+		// the existent of the segments are already checked
+		s := replica.flushedSegments[ID]
+
+		s.clusteredTo = clusteredTo
+		replica.compactedSegments[ID] = s
+		delete(replica.flushedSegments, ID)
+	}
+
+	for _, seg := range segs {
+		// only store segments with numRows > 0
+		if seg.numRows > 0 {
+			seg.isNew.Store(false)
+			seg.isFlushed.Store(true)
+			replica.flushedSegments[seg.segmentID] = seg
+		}
+	}
+
+	return nil
+}
+
 // for tests only
 func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRows int64, ids storage.FieldData) error {
 	if collID != replica.collectionID {
@@ -884,6 +884,37 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		}
 	})
 
+	t.Run("Test_clusterFlushedSegments", func(t *testing.T) {
+		sr, err := newReplica(context.Background(), rc, cm, 1)
+		assert.Nil(t, err)
+
+		primaryKeyData := &storage.Int64FieldData{
+			Data: []UniqueID{1},
+		}
+		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, primaryKeyData)
+		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, primaryKeyData)
+
+		err = sr.clusterFlushedSegments([]*Segment{{segmentID: 3, collectionID: -1, numRows: 10}}, 100, []UniqueID{1, 2})
+		assert.Error(t, err)
+		err = sr.clusterFlushedSegments([]*Segment{{segmentID: 3, collectionID: 1, numRows: 10}}, 100, []UniqueID{1, 6})
+		assert.Error(t, err)
+
+		err = sr.clusterFlushedSegments([]*Segment{
+			{segmentID: 3, collectionID: 1, numRows: 12},
+			{segmentID: 4, collectionID: 1, numRows: 8},
+			{segmentID: 5, collectionID: 1, numRows: 0},
+		}, 100, []UniqueID{1, 2})
+		assert.NoError(t, err)
+		assert.True(t, sr.hasSegment(3, true))
+		assert.True(t, sr.hasSegment(4, true))
+		assert.False(t, sr.hasSegment(5, true))
+
+		assert.Empty(t, sr.listCompactedSegmentIDs())
+		from2to := sr.listClusteredSegmentIDs()
+		assert.Equal(t, 2, len(from2to))
+		assert.ElementsMatch(t, []UniqueID{3, 4, 5}, from2to[1])
+		assert.ElementsMatch(t, []UniqueID{3, 4, 5}, from2to[2])
+	})
 }
 func TestInnerFunctionSegment(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -608,3 +608,45 @@ func (c *Client) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// CloneSegments is the DataCoord client side code for CloneSegments call.
+func (c *Client) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).CloneSegments(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CloneSegmentsResponse), err
+}
+
+// UpdateChannelCheckpoint is the DataCoord client side code for UpdateChannelCheckpoint call.
+func (c *Client) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).UpdateChannelCheckpoint(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// GetChannelCheckpoints is the DataCoord client side code for GetChannelCheckpoints call.
+func (c *Client) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).GetChannelCheckpoints(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetChannelCheckpointsResponse), err
+}
@@ -142,6 +142,15 @@ func Test_NewClient(t *testing.T) {
 		r30, err := client.MarkSegmentsDropped(ctx, nil)
 		retCheck(retNotNil, r30, err)
 
+		r32, err := client.CloneSegments(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.UpdateChannelCheckpoint(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
+		r34, err := client.GetChannelCheckpoints(ctx, nil)
+		retCheck(retNotNil, r34, err)
+
 		r31, err := client.ShowConfigurations(ctx, nil)
 		retCheck(retNotNil, r31, err)
 	}
@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcdatacoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/datacoord"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// GCRouterPath is the http path to audit and run the garbage collection of datacoord, it is served with the metrics.
+//
+//	GET /datacoord/gc reports the orphan files and the dropped segment files without removing anything.
+//	POST /datacoord/gc removes the garbage files beyond the tolerance, even if gc runs in dry run mode,
+//	it's forbidden unless dataCoord.gc.enableManualCollect is on.
+const GCRouterPath = "/datacoord/gc"
+
+// garbageCollector is implemented by the datacoord which collects the garbage files in object storage.
+type garbageCollector interface {
+	ReportGarbage(ctx context.Context) (*datacoord.GarbageReport, error)
+	CollectGarbage(ctx context.Context) (*datacoord.GarbageReport, error)
+}
+
+type gcHandler struct {
+	collector garbageCollector
+}
+
+func (h *gcHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var report *datacoord.GarbageReport
+	var err error
+	switch req.Method {
+	case http.MethodGet:
+		report, err = h.collector.ReportGarbage(req.Context())
+	case http.MethodPost:
+		report, err = h.collector.CollectGarbage(req.Context())
+	default:
+		http.Error(w, "only GET and POST are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if errors.Is(err, datacoord.ErrManualGCDisabled) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Warn("failed to write garbage report", zap.Error(err))
+	}
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcdatacoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/datacoord"
+)
+
+type mockGarbageCollector struct {
+	report    *datacoord.GarbageReport
+	err       error
+	collected bool
+}
+
+func (m *mockGarbageCollector) ReportGarbage(ctx context.Context) (*datacoord.GarbageReport, error) {
+	return m.report, m.err
+}
+
+func (m *mockGarbageCollector) CollectGarbage(ctx context.Context) (*datacoord.GarbageReport, error) {
+	m.collected = true
+	return m.report, m.err
+}
+
+func TestGCHandler(t *testing.T) {
+	serve := func(collector garbageCollector, method string) *httptest.ResponseRecorder {
+		handler := &gcHandler{collector: collector}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, GCRouterPath, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(&mockGarbageCollector{}, http.MethodDelete).Code)
+	assert.Equal(t, http.StatusInternalServerError, serve(&mockGarbageCollector{err: errors.New("mock")}, http.MethodGet).Code)
+	assert.Equal(t, http.StatusForbidden, serve(&mockGarbageCollector{err: datacoord.ErrManualGCDisabled}, http.MethodPost).Code)
+
+	report := &datacoord.GarbageReport{
+		OrphanFiles: []*datacoord.GarbageFile{{Path: "files/insert_log/1/2/3/4/5", Size: 10, SegmentID: 3, Removable: true}},
+		OrphanSize:  10,
+		TotalSize:   10,
+	}
+	collector := &mockGarbageCollector{report: report}
+	w := serve(collector, http.MethodGet)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, collector.collected)
+	result := &datacoord.GarbageReport{}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(result))
+	assert.Equal(t, int64(10), result.TotalSize)
+	assert.Equal(t, 1, len(result.OrphanFiles))
+	assert.Equal(t, int64(3), result.OrphanFiles[0].SegmentID)
+
+	w = serve(collector, http.MethodPost)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, collector.collected)
+}
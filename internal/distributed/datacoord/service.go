@@ -21,6 +21,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -28,6 +29,7 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	icc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -52,6 +54,9 @@ import (
 // Params is the parameters for DataCoord grpc server
 var Params paramtable.GrpcServerConfig
 
+// registerHTTPHandlerOnce avoid register http handler multiple times
+var registerHTTPHandlerOnce sync.Once
+
 // Server is the grpc server of datacoord
 type Server struct {
 	ctx    context.Context
@@ -62,6 +67,7 @@ type Server struct {
 
 	etcdCli    *clientv3.Client
 	indexCoord types.IndexCoord
+	queryCoord types.QueryCoord
 
 	grpcErrChan chan error
 	grpcServer  *grpc.Server
@@ -119,6 +125,25 @@ func (s *Server) init() error {
 	log.Debug("init IndexCoord client for DataCoord done")
 	s.dataCoord.SetIndexCoord(s.indexCoord)
 
+	if s.queryCoord == nil {
+		var err error
+		log.Debug("create QueryCoord client for DataCoord")
+		s.queryCoord, err = qcc.NewClient(s.ctx, Params.EtcdCfg.MetaRootPath, etcdCli)
+		if err != nil {
+			log.Warn("failed to create QueryCoord client for DataCoord", zap.Error(err))
+			return err
+		}
+		log.Debug("create QueryCoord client for DataCoord done")
+	}
+
+	log.Debug("init QueryCoord client for DataCoord")
+	if err := s.queryCoord.Init(); err != nil {
+		log.Warn("failed to init QueryCoord client for DataCoord", zap.Error(err))
+		return err
+	}
+	log.Debug("init QueryCoord client for DataCoord done")
+	s.dataCoord.SetQueryCoord(s.queryCoord)
+
 	err = s.startGrpc()
 	if err != nil {
 		log.Debug("DataCoord startGrpc failed", zap.Error(err))
@@ -195,6 +220,12 @@ func (s *Server) start() error {
 		log.Debug("DataCoord register service failed", zap.Error(err))
 		return err
 	}
+
+	registerHTTPHandlerOnce.Do(func() {
+		if collector, ok := s.dataCoord.(garbageCollector); ok {
+			http.Handle(GCRouterPath, &gcHandler{collector: collector})
+		}
+	})
 	return nil
 }
 
@@ -392,3 +423,18 @@ func (s *Server) UnsetIsImportingState(ctx context.Context, request *datapb.Unse
 func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	return s.dataCoord.MarkSegmentsDropped(ctx, req)
 }
+
+// CloneSegments is the distributed caller of CloneSegments.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	return s.dataCoord.CloneSegments(ctx, req)
+}
+
+// UpdateChannelCheckpoint is the distributed caller of UpdateChannelCheckpoint.
+func (s *Server) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UpdateChannelCheckpoint(ctx, req)
+}
+
+// GetChannelCheckpoints is the distributed caller of GetChannelCheckpoints.
+func (s *Server) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	return s.dataCoord.GetChannelCheckpoints(ctx, req)
+}
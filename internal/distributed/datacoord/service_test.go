@@ -66,6 +66,9 @@ type MockDataCoord struct {
 	addSegmentResp            *commonpb.Status
 	unsetIsImportingStateResp *commonpb.Status
 	markSegmentsDroppedResp   *commonpb.Status
+	cloneSegmentsResp         *datapb.CloneSegmentsResponse
+	updateChannelCPResp       *commonpb.Status
+	getChannelCPsResp         *datapb.GetChannelCheckpointsResponse
 }
 
 func (m *MockDataCoord) Init() error {
@@ -90,6 +93,9 @@ func (m *MockDataCoord) SetEtcdClient(etcdClient *clientv3.Client) {
 func (m *MockDataCoord) SetIndexCoord(indexCoord types.IndexCoord) {
 }
 
+func (m *MockDataCoord) SetQueryCoord(queryCoord types.QueryCoord) {
+}
+
 func (m *MockDataCoord) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	return m.states, m.err
 }
@@ -214,6 +220,18 @@ func (m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.Mar
 	return m.markSegmentsDroppedResp, m.err
 }
 
+func (m *MockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	return m.cloneSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	return m.updateChannelCPResp, m.err
+}
+
+func (m *MockDataCoord) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	return m.getChannelCPsResp, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -514,6 +532,43 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("clone segments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			cloneSegmentsResp: &datapb.CloneSegmentsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.CloneSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("update channel checkpoint", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			updateChannelCPResp: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}
+		resp, err := server.UpdateChannelCheckpoint(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("get channel checkpoints", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getChannelCPsResp: &datapb.GetChannelCheckpointsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.GetChannelCheckpoints(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err := server.Stop()
 	assert.Nil(t, err)
 }
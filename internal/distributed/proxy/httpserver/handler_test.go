@@ -235,6 +235,30 @@ func (mockProxyComponent) ListImportTasks(ctx context.Context, request *milvuspb
 	return &milvuspb.ListImportTasksResponse{Status: testStatus}, nil
 }
 
+func (mockProxyComponent) CreateBackup(ctx context.Context, request *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	return &milvuspb.CreateBackupResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) ListBackups(ctx context.Context, request *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	return &milvuspb.ListBackupsResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) RestoreBackup(ctx context.Context, request *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	return &milvuspb.RestoreBackupResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) CloneCollection(ctx context.Context, request *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	return &milvuspb.CloneCollectionResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) ListDroppedCollections(ctx context.Context, request *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return &milvuspb.ListDroppedCollectionsResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) RestoreCollection(ctx context.Context, request *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func (mockProxyComponent) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
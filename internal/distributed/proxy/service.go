@@ -761,6 +761,30 @@ func (s *Server) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTa
 	return s.proxy.ListImportTasks(ctx, req)
 }
 
+func (s *Server) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	return s.proxy.CreateBackup(ctx, req)
+}
+
+func (s *Server) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	return s.proxy.ListBackups(ctx, req)
+}
+
+func (s *Server) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	return s.proxy.RestoreBackup(ctx, req)
+}
+
+func (s *Server) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	return s.proxy.CloneCollection(ctx, req)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return s.proxy.ListDroppedCollections(ctx, req)
+}
+
+func (s *Server) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RestoreCollection(ctx, req)
+}
+
 func (s *Server) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return s.proxy.GetReplicas(ctx, req)
 }
@@ -206,6 +206,38 @@ func (m *MockRootCoord) ListImportTasks(ctx context.Context, in *milvuspb.ListIm
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateBackup(ctx context.Context, in *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListBackups(ctx context.Context, in *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RestoreBackup(ctx context.Context, in *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CloneCollection(ctx context.Context, in *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RestoreCollection(ctx context.Context, in *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDDLJobs(ctx context.Context, in *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DescribeDDLJob(ctx context.Context, in *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -478,6 +510,18 @@ func (m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.Mar
 	return nil, nil
 }
 
+func (m *MockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	return nil, nil
 }
@@ -812,6 +856,30 @@ func (m *MockProxy) ListImportTasks(ctx context.Context, in *milvuspb.ListImport
 	return nil, nil
 }
 
+func (m *MockProxy) CreateBackup(ctx context.Context, in *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListBackups(ctx context.Context, in *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RestoreBackup(ctx context.Context, in *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CloneCollection(ctx context.Context, in *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RestoreCollection(ctx context.Context, in *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return nil, nil
 }
@@ -470,6 +470,118 @@ func (c *Client) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTa
 	return ret.(*milvuspb.ListImportTasksResponse), err
 }
 
+// CreateBackup backs up a collection to the backup location
+func (c *Client) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).CreateBackup(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.CreateBackupResponse), err
+}
+
+// ListBackups lists backups of a collection
+func (c *Client) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).ListBackups(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.ListBackupsResponse), err
+}
+
+// RestoreBackup restores a backup into a new collection
+func (c *Client) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).RestoreBackup(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.RestoreBackupResponse), err
+}
+
+// CloneCollection creates a new collection sharing the flushed binlogs of an existing one
+func (c *Client) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).CloneCollection(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.CloneCollectionResponse), err
+}
+
+// ListDroppedCollections lists the dropped collections kept in the recycle bin
+func (c *Client) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).ListDroppedCollections(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.ListDroppedCollectionsResponse), err
+}
+
+// RestoreCollection brings a dropped collection back from the recycle bin
+func (c *Client) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).RestoreCollection(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ListDDLJobs lists the DDL jobs kept by rootcoord
+func (c *Client) ListDDLJobs(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).ListDDLJobs(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*rootcoordpb.ListDDLJobsResponse), err
+}
+
+// DescribeDDLJob returns a DDL job with its steps
+func (c *Client) DescribeDDLJob(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(rootcoordpb.RootCoordClient).DescribeDDLJob(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*rootcoordpb.DescribeDDLJobResponse), err
+}
+
 // Report impot task state to rootcoord
 func (c *Client) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
@@ -152,6 +152,38 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ReportImport(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.CreateBackup(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListBackups(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RestoreBackup(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.CloneCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListDroppedCollections(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RestoreCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListDDLJobs(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DescribeDDLJob(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.CreateCredential(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -350,6 +382,38 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ReportImport(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.CreateBackup(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListBackups(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RestoreBackup(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.CloneCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListDroppedCollections(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RestoreCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListDDLJobs(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DescribeDDLJob(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CreateCredential(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcrootcoord
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// DDLJobRouterPath is the http path to inspect the DDL jobs of rootcoord, it is served with the metrics.
+//
+//	GET /rootcoord/ddl-jobs?collection=c&state=DDLJobFailed&limit=10 lists the jobs.
+//	GET /rootcoord/ddl-jobs?id=1 describes a job with its steps.
+const DDLJobRouterPath = "/rootcoord/ddl-jobs"
+
+type ddlJobHandler struct {
+	rootCoord types.RootCoord
+}
+
+func (h *ddlJobHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := req.URL.Query()
+	if id := query.Get("id"); id != "" {
+		jobID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid job id: %s", id), http.StatusBadRequest)
+			return
+		}
+		resp, err := h.rootCoord.DescribeDDLJob(req.Context(), &rootcoordpb.DescribeDDLJobRequest{JobID: jobID})
+		h.writeResponse(w, resp, resp.GetStatus(), err)
+		return
+	}
+
+	listReq := &rootcoordpb.ListDDLJobsRequest{CollectionName: query.Get("collection")}
+	for _, state := range query["state"] {
+		value, ok := rootcoordpb.DDLJobState_value[state]
+		if !ok {
+			http.Error(w, fmt.Sprintf("invalid job state: %s", state), http.StatusBadRequest)
+			return
+		}
+		listReq.States = append(listReq.States, rootcoordpb.DDLJobState(value))
+	}
+	if limit := query.Get("limit"); limit != "" {
+		var err error
+		if listReq.Limit, err = strconv.ParseInt(limit, 10, 64); err != nil {
+			http.Error(w, fmt.Sprintf("invalid limit: %s", limit), http.StatusBadRequest)
+			return
+		}
+	}
+	resp, err := h.rootCoord.ListDDLJobs(req.Context(), listReq)
+	h.writeResponse(w, resp, resp.GetStatus(), err)
+}
+
+func (h *ddlJobHandler) writeResponse(w http.ResponseWriter, resp proto.Message, status *commonpb.Status, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		http.Error(w, status.GetReason(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	marshaler := jsonpb.Marshaler{OrigName: true, Indent: "  "}
+	if err := marshaler.Marshal(w, resp); err != nil {
+		log.Warn("failed to write ddl jobs", zap.Error(err))
+	}
+}
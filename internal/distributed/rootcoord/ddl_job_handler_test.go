@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcrootcoord
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+func TestDDLJobHandler(t *testing.T) {
+	serve := func(rc *mocks.RootCoord, method string, target string) *httptest.ResponseRecorder {
+		handler := &ddlJobHandler{rootCoord: rc}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+	success := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+
+	t.Run("invalid request", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		assert.Equal(t, http.StatusMethodNotAllowed, serve(rc, http.MethodPost, DDLJobRouterPath).Code)
+		assert.Equal(t, http.StatusBadRequest, serve(rc, http.MethodGet, DDLJobRouterPath+"?id=a").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(rc, http.MethodGet, DDLJobRouterPath+"?state=Unknown").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(rc, http.MethodGet, DDLJobRouterPath+"?limit=a").Code)
+	})
+
+	t.Run("list jobs", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.EXPECT().ListDDLJobs(mock.Anything, &rootcoordpb.ListDDLJobsRequest{
+			CollectionName: "coll",
+			States:         []rootcoordpb.DDLJobState{rootcoordpb.DDLJobState_DDLJobFailed},
+			Limit:          10,
+		}).Return(&rootcoordpb.ListDDLJobsResponse{
+			Status: success,
+			Jobs:   []*rootcoordpb.DDLJobInfo{{JobID: 100, JobType: "DropCollection"}},
+		}, nil)
+
+		w := serve(rc, http.MethodGet, DDLJobRouterPath+"?collection=coll&state=DDLJobFailed&limit=10")
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := &rootcoordpb.ListDDLJobsResponse{}
+		assert.NoError(t, jsonpb.Unmarshal(w.Body, resp))
+		assert.Equal(t, 1, len(resp.GetJobs()))
+		assert.Equal(t, "DropCollection", resp.GetJobs()[0].GetJobType())
+	})
+
+	t.Run("describe job", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.EXPECT().DescribeDDLJob(mock.Anything, &rootcoordpb.DescribeDDLJobRequest{JobID: 100}).
+			Return(&rootcoordpb.DescribeDDLJobResponse{
+				Status: success,
+				Job: &rootcoordpb.DDLJobInfo{
+					JobID: 100,
+					Steps: []*rootcoordpb.DDLStepInfo{{Desc: "step", State: rootcoordpb.DDLStepState_DDLStepRetrying}},
+				},
+			}, nil)
+
+		w := serve(rc, http.MethodGet, DDLJobRouterPath+"?id=100")
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := &rootcoordpb.DescribeDDLJobResponse{}
+		assert.NoError(t, jsonpb.Unmarshal(w.Body, resp))
+		assert.Equal(t, rootcoordpb.DDLStepState_DDLStepRetrying, resp.GetJob().GetSteps()[0].GetState())
+	})
+
+	t.Run("rootcoord failed", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.EXPECT().DescribeDDLJob(mock.Anything, mock.Anything).
+			Return(&rootcoordpb.DescribeDDLJobResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "not found"},
+			}, nil)
+		rc.EXPECT().ListDDLJobs(mock.Anything, mock.Anything).Return(nil, errors.New("error mock ListDDLJobs"))
+
+		assert.Equal(t, http.StatusInternalServerError, serve(rc, http.MethodGet, DDLJobRouterPath+"?id=100").Code)
+		assert.Equal(t, http.StatusInternalServerError, serve(rc, http.MethodGet, DDLJobRouterPath).Code)
+	})
+}
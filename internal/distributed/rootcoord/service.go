@@ -20,6 +20,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -56,6 +57,9 @@ import (
 
 var Params paramtable.GrpcServerConfig
 
+// registerHTTPHandlerOnce avoid register http handler multiple times
+var registerHTTPHandlerOnce sync.Once
+
 // Server grpc wrapper
 type Server struct {
 	rootCoord   types.RootCoordComponent
@@ -282,6 +286,13 @@ func (s *Server) start() error {
 		return err
 	}
 
+	registerHTTPHandlerOnce.Do(func() {
+		http.Handle(DDLJobRouterPath, &ddlJobHandler{rootCoord: s.rootCoord})
+		if collector, ok := s.rootCoord.(snapshotCollector); ok {
+			http.Handle(SnapshotGCRouterPath, &snapshotGCHandler{collector: collector})
+		}
+	})
+
 	return nil
 }
 
@@ -435,6 +446,46 @@ func (s *Server) ListImportTasks(ctx context.Context, in *milvuspb.ListImportTas
 	return s.rootCoord.ListImportTasks(ctx, in)
 }
 
+// CreateBackup backs up a collection to the backup location
+func (s *Server) CreateBackup(ctx context.Context, in *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	return s.rootCoord.CreateBackup(ctx, in)
+}
+
+// ListBackups lists backups of a collection
+func (s *Server) ListBackups(ctx context.Context, in *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	return s.rootCoord.ListBackups(ctx, in)
+}
+
+// RestoreBackup restores a backup into a new collection
+func (s *Server) RestoreBackup(ctx context.Context, in *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	return s.rootCoord.RestoreBackup(ctx, in)
+}
+
+// CloneCollection creates a new collection sharing the flushed binlogs of an existing one
+func (s *Server) CloneCollection(ctx context.Context, in *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	return s.rootCoord.CloneCollection(ctx, in)
+}
+
+// ListDroppedCollections lists the dropped collections kept in the recycle bin
+func (s *Server) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return s.rootCoord.ListDroppedCollections(ctx, in)
+}
+
+// RestoreCollection brings a dropped collection back from the recycle bin
+func (s *Server) RestoreCollection(ctx context.Context, in *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RestoreCollection(ctx, in)
+}
+
+// ListDDLJobs lists the DDL jobs kept by rootcoord
+func (s *Server) ListDDLJobs(ctx context.Context, in *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error) {
+	return s.rootCoord.ListDDLJobs(ctx, in)
+}
+
+// DescribeDDLJob returns a DDL job with its steps
+func (s *Server) DescribeDDLJob(ctx context.Context, in *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	return s.rootCoord.DescribeDDLJob(ctx, in)
+}
+
 // Report impot task state to datacoord
 func (s *Server) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	return s.rootCoord.ReportImport(ctx, in)
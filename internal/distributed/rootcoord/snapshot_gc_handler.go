@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcrootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+)
+
+// SnapshotGCRouterPath is the http path to trigger the meta snapshot gc of rootcoord manually, it is served with the metrics.
+//
+//	POST /rootcoord/snapshot-gc removes the snapshots beyond the time travel retention.
+const SnapshotGCRouterPath = "/rootcoord/snapshot-gc"
+
+// snapshotCollector is implemented by the rootcoord which keeps meta snapshots.
+type snapshotCollector interface {
+	CollectSnapshots(ctx context.Context) (*kvmetestore.SnapshotGCStats, error)
+}
+
+type snapshotGCHandler struct {
+	collector snapshotCollector
+}
+
+func (h *snapshotGCHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	stats, err := h.collector.CollectSnapshots(req.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		log.Warn("failed to write snapshot gc stats", zap.Error(err))
+	}
+}
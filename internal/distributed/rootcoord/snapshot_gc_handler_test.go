@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcrootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+)
+
+type mockSnapshotCollector struct {
+	stats *kvmetestore.SnapshotGCStats
+	err   error
+}
+
+func (m *mockSnapshotCollector) CollectSnapshots(ctx context.Context) (*kvmetestore.SnapshotGCStats, error) {
+	return m.stats, m.err
+}
+
+func TestSnapshotGCHandler(t *testing.T) {
+	serve := func(collector snapshotCollector, method string) *httptest.ResponseRecorder {
+		handler := &snapshotGCHandler{collector: collector}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, SnapshotGCRouterPath, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(&mockSnapshotCollector{}, http.MethodGet).Code)
+	assert.Equal(t, http.StatusInternalServerError, serve(&mockSnapshotCollector{err: errors.New("mock")}, http.MethodPost).Code)
+
+	w := serve(&mockSnapshotCollector{stats: &kvmetestore.SnapshotGCStats{Removed: 3, Retained: 5}}, http.MethodPost)
+	assert.Equal(t, http.StatusOK, w.Code)
+	stats := &kvmetestore.SnapshotGCStats{}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(stats))
+	assert.Equal(t, 3, stats.Removed)
+	assert.Equal(t, 5, stats.Retained)
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/initcore"
@@ -196,6 +197,7 @@ func (i *IndexNode) Init() error {
 		i.closer = trace.InitTracing("index_node")
 
 		i.initKnowhere()
+		storage.EnablePayloadChecksum = Params.CommonCfg.EnablePayloadChecksum
 	})
 
 	log.Debug("Init IndexNode finished", zap.Error(initErr))
@@ -531,6 +531,15 @@ func (kv *EtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchCh
 	return rch
 }
 
+// WatchWithRevisionCtx acts like WatchWithRevision, and the watch is canceled once ctx is done.
+func (kv *EtcdKV) WatchWithRevisionCtx(ctx context.Context, key string, revision int64) clientv3.WatchChan {
+	start := time.Now()
+	key = path.Join(kv.rootPath, key)
+	rch := kv.client.Watch(ctx, key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+	CheckElapseAndWarn(start, "Slow etcd operation watch with revision", zap.String("key", key))
+	return rch
+}
+
 // MultiRemoveWithPrefix removes the keys with given prefix.
 func (kv *EtcdKV) MultiRemoveWithPrefix(keys []string) error {
 	start := time.Now()
@@ -17,6 +17,7 @@
 package etcdkv_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -732,6 +733,18 @@ func TestEtcdKV_Load(te *testing.T) {
 			assert.Equal(t, revision+1, resp.Header.Revision)
 		}
 
+		ctx, cancel := context.WithCancel(context.Background())
+		_, _, revision, err := etcdKV.LoadWithRevision("a/b")
+		require.NoError(t, err)
+		ch := etcdKV.WatchWithRevisionCtx(ctx, "a/b", revision+1)
+		require.NoError(t, etcdKV.Save("a/b/ctx", "1"))
+		resp := <-ch
+		require.Equal(t, 1, len(resp.Events))
+		assert.Equal(t, "1", string(resp.Events[0].Kv.Value))
+		cancel()
+		for range ch {
+		}
+
 		success, err := etcdKV.CompareVersionAndSwap("a/b/c", 0, "1")
 		assert.NoError(t, err)
 		assert.True(t, success)
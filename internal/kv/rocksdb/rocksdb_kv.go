@@ -19,6 +19,7 @@ package rocksdbkv
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -34,6 +35,11 @@ type RocksdbKV struct {
 	WriteOptions *gorocksdb.WriteOptions
 	ReadOptions  *gorocksdb.ReadOptions
 	name         string
+
+	// meta is the state shared by the meta kvs on this rocksdb, initialized by the first NewRocksdbMetaKV
+	metaOnce sync.Once
+	meta     *metaState
+	metaErr  error
 }
 
 const (
@@ -83,6 +89,9 @@ func NewRocksdbKVWithOpts(name string, opts *gorocksdb.Options) (*RocksdbKV, err
 
 // Close free resource of rocksdb
 func (kv *RocksdbKV) Close() {
+	if kv.meta != nil {
+		kv.meta.close()
+	}
 	if kv.DB != nil {
 		kv.DB.Close()
 	}
@@ -365,6 +374,22 @@ func (kv *RocksdbKV) MultiSaveAndRemove(saves map[string]string, removals []stri
 	return err
 }
 
+// MultiSaveBytesAndRemove provides a transaction to save a batch of key-values in bytes and remove a batch of keys
+func (kv *RocksdbKV) MultiSaveBytesAndRemove(saves map[string][]byte, removals []string) error {
+	if kv.DB == nil {
+		return errors.New("Rocksdb instance is nil when do MultiSaveBytesAndRemove")
+	}
+	writeBatch := gorocksdb.NewWriteBatch()
+	defer writeBatch.Destroy()
+	for k, v := range saves {
+		writeBatch.Put([]byte(k), v)
+	}
+	for _, key := range removals {
+		writeBatch.Delete([]byte(key))
+	}
+	return kv.DB.Write(kv.WriteOptions, writeBatch)
+}
+
 // DeleteRange remove a batch of key-values from startKey to endKey
 func (kv *RocksdbKV) DeleteRange(startKey, endKey string) error {
 	if kv.DB == nil {
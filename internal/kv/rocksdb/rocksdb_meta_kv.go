@@ -0,0 +1,903 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocksdbkv
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+var _ kv.MetaKv = (*RocksdbMetaKV)(nil)
+
+const (
+	// metaHeaderLen is the length of the header stored before each value of the meta kv,
+	// which records the create revision, mod revision, version and lease of the key
+	metaHeaderLen = 32
+	// metaRevisionKey stores the latest revision of the meta kvs, it's skipped by all the loads
+	metaRevisionKey = "\x00revision"
+)
+
+var (
+	// leaseCheckInterval is the interval to expire the leases which are not kept alive
+	leaseCheckInterval = 500 * time.Millisecond
+	// watchHistorySize is the number of events kept for the watches from an earlier revision,
+	// watching from a revision before them gets ErrCompacted like etcd
+	watchHistorySize = 1024
+)
+
+var errUnsupportedOpOption = errors.New("rocksdb meta kv does not support op options")
+
+// RocksdbMetaKV is MetaKv implemented by rocksdb, so that a standalone deployment could keep
+// its meta, watches and sessions without etcd.
+// Like etcd, every write gets a new revision, each key records its revisions and version,
+// leases and watches are kept in memory, so the keys bound to leases are removed on restart.
+// The rocksdb kv shall be dedicated to the meta kvs since the values are stored with headers.
+type RocksdbMetaKV struct {
+	state    *metaState
+	rootPath string
+}
+
+// NewRocksdbMetaKV returns a MetaKv rooted at rootPath on the rocksdb kv,
+// the meta kvs on the same rocksdb kv share the revisions, leases and watches.
+func NewRocksdbMetaKV(rocksdbKV *RocksdbKV, rootPath string) (*RocksdbMetaKV, error) {
+	if rocksdbKV == nil || rocksdbKV.DB == nil {
+		return nil, errors.New("rocksdb instance is nil when create meta kv")
+	}
+	rocksdbKV.metaOnce.Do(func() {
+		rocksdbKV.meta, rocksdbKV.metaErr = newMetaState(rocksdbKV)
+	})
+	if rocksdbKV.metaErr != nil {
+		return nil, rocksdbKV.metaErr
+	}
+	return &RocksdbMetaKV{state: rocksdbKV.meta, rootPath: rootPath}, nil
+}
+
+// localKV keeps the meta of all the components of the standalone instead of etcd if it's set.
+var localKV struct {
+	sync.RWMutex
+	kv *RocksdbKV
+}
+
+// SetLocalMetaKV makes the components started afterwards keep their meta, id allocators and watches
+// in the rocksdb kv rather than etcd, the standalone deployment sets it before the components start, nil means etcd.
+func SetLocalMetaKV(rocksdbKV *RocksdbKV) error {
+	if rocksdbKV != nil {
+		// load the meta state, so that the meta kvs are created without errors afterwards.
+		if _, err := NewRocksdbMetaKV(rocksdbKV, ""); err != nil {
+			return err
+		}
+	}
+	localKV.Lock()
+	defer localKV.Unlock()
+	localKV.kv = rocksdbKV
+	return nil
+}
+
+// NewLocalMetaKV returns the meta kv rooted at rootPath on the rocksdb kv set by SetLocalMetaKV,
+// nil if the meta is kept in etcd.
+func NewLocalMetaKV(rootPath string) *RocksdbMetaKV {
+	localKV.RLock()
+	defer localKV.RUnlock()
+	if localKV.kv == nil {
+		return nil
+	}
+	metaKV, err := NewRocksdbMetaKV(localKV.kv, rootPath)
+	if err != nil {
+		// the meta state is loaded when the local kv is set, it never fails afterwards.
+		panic(err)
+	}
+	return metaKV
+}
+
+// Close does nothing, the rocksdb kv shall be closed by its owner
+func (kv *RocksdbMetaKV) Close() {
+}
+
+// GetPath returns the path of the key.
+func (kv *RocksdbMetaKV) GetPath(key string) string {
+	return path.Join(kv.rootPath, key)
+}
+
+// Load returns the value of specified key
+func (kv *RocksdbMetaKV) Load(key string) (string, error) {
+	key = kv.GetPath(key)
+	kv.state.mu.RLock()
+	defer kv.state.mu.RUnlock()
+	record, err := kv.state.get(key)
+	if err != nil {
+		return "", err
+	}
+	if record == nil {
+		return "", common.NewKeyNotExistError(key)
+	}
+	return string(record.value), nil
+}
+
+// MultiLoad load a batch of values by keys, error is returned with the values if any key doesn't exist
+func (kv *RocksdbMetaKV) MultiLoad(keys []string) ([]string, error) {
+	kv.state.mu.RLock()
+	defer kv.state.mu.RUnlock()
+	result := make([]string, 0, len(keys))
+	invalid := make([]string, 0, len(keys))
+	for _, key := range keys {
+		record, err := kv.state.get(kv.GetPath(key))
+		if err != nil {
+			return []string{}, err
+		}
+		if record == nil {
+			invalid = append(invalid, key)
+			result = append(result, "")
+			continue
+		}
+		result = append(result, string(record.value))
+	}
+	if len(invalid) != 0 {
+		return result, fmt.Errorf("there are invalid keys: %s", invalid)
+	}
+	return result, nil
+}
+
+// LoadWithPrefix returns all the keys and values with the prefix, the keys are full paths sorted by key
+func (kv *RocksdbMetaKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	keys, values, _, _, err := kv.LoadWithRevisionAndVersions(key)
+	return keys, values, err
+}
+
+// LoadWithPrefix2 returns all the keys, values and versions with the prefix
+func (kv *RocksdbMetaKV) LoadWithPrefix2(key string) ([]string, []string, []int64, error) {
+	keys, values, versions, _, err := kv.LoadWithRevisionAndVersions(key)
+	return keys, values, versions, err
+}
+
+// LoadWithRevisionAndVersions returns all the keys, values and versions with the prefix and the current revision
+func (kv *RocksdbMetaKV) LoadWithRevisionAndVersions(key string) ([]string, []string, []int64, int64, error) {
+	kv.state.mu.RLock()
+	defer kv.state.mu.RUnlock()
+	keys, records, err := kv.state.rangePrefix(kv.GetPath(key))
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	values := make([]string, 0, len(records))
+	versions := make([]int64, 0, len(records))
+	for _, record := range records {
+		values = append(values, string(record.value))
+		versions = append(versions, record.version)
+	}
+	return keys, values, versions, kv.state.revision, nil
+}
+
+// LoadWithRevision returns all the keys and values with the prefix sorted by create revision, and the current revision
+func (kv *RocksdbMetaKV) LoadWithRevision(key string) ([]string, []string, int64, error) {
+	kv.state.mu.RLock()
+	defer kv.state.mu.RUnlock()
+	keys, records, err := kv.state.rangePrefix(kv.GetPath(key))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	indexes := make([]int, len(keys))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return records[indexes[i]].createRevision < records[indexes[j]].createRevision
+	})
+	sortedKeys := make([]string, 0, len(keys))
+	values := make([]string, 0, len(keys))
+	for _, i := range indexes {
+		sortedKeys = append(sortedKeys, keys[i])
+		values = append(values, string(records[i].value))
+	}
+	return sortedKeys, values, kv.state.revision, nil
+}
+
+// Save a pair of key-value, the key is unbound from its lease
+func (kv *RocksdbMetaKV) Save(key, value string) error {
+	return kv.MultiSaveAndRemove(map[string]string{key: value}, nil)
+}
+
+// MultiSave a batch of key-values in one revision
+func (kv *RocksdbMetaKV) MultiSave(kvs map[string]string) error {
+	return kv.MultiSaveAndRemove(kvs, nil)
+}
+
+// Remove is used to remove a pair of key-value
+func (kv *RocksdbMetaKV) Remove(key string) error {
+	return kv.MultiSaveAndRemove(nil, []string{key})
+}
+
+// MultiRemove is used to remove a batch of key-values in one revision
+func (kv *RocksdbMetaKV) MultiRemove(keys []string) error {
+	return kv.MultiSaveAndRemove(nil, keys)
+}
+
+// RemoveWithPrefix removes all the key-values with the prefix
+func (kv *RocksdbMetaKV) RemoveWithPrefix(prefix string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(nil, []string{prefix})
+}
+
+// MultiRemoveWithPrefix removes all the key-values with the prefixes in one revision
+func (kv *RocksdbMetaKV) MultiRemoveWithPrefix(prefixes []string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(nil, prefixes)
+}
+
+// MultiSaveAndRemove saves and removes a batch of key-values in one revision
+func (kv *RocksdbMetaKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	deletes := make([]string, 0, len(removals))
+	for _, key := range removals {
+		deletes = append(deletes, kv.GetPath(key))
+	}
+	return kv.state.commit(kv.toPuts(saves), deletes)
+}
+
+// MultiSaveAndRemoveWithPrefix saves a batch of key-values and removes the key-values with the prefixes in one revision
+func (kv *RocksdbMetaKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error {
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	var deletes []string
+	for _, prefix := range removals {
+		keys, _, err := kv.state.rangePrefix(kv.GetPath(prefix))
+		if err != nil {
+			return err
+		}
+		deletes = append(deletes, keys...)
+	}
+	return kv.state.commit(kv.toPuts(saves), deletes)
+}
+
+// SaveWithLease saves the key-value and binds the key to the lease
+func (kv *RocksdbMetaKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	return kv.state.commit([]metaPut{{key: kv.GetPath(key), value: value, lease: id}}, nil)
+}
+
+// SaveWithIgnoreLease updates the value of an existing key and keeps its lease
+func (kv *RocksdbMetaKV) SaveWithIgnoreLease(key, value string) error {
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	return kv.state.commit([]metaPut{{key: kv.GetPath(key), value: value, ignoreLease: true}}, nil)
+}
+
+// Grant creates a lease which expires after ttl seconds unless it's kept alive
+func (kv *RocksdbMetaKV) Grant(ttl int64) (clientv3.LeaseID, error) {
+	return kv.state.grant(ttl)
+}
+
+// KeepAlive keeps the lease alive until it's revoked or the rocksdb kv is closed
+func (kv *RocksdbMetaKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	return kv.state.keepAlive(id)
+}
+
+// Revoke revokes the lease and removes the keys bound to it
+func (kv *RocksdbMetaKV) Revoke(id clientv3.LeaseID) error {
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	return kv.state.revoke(id)
+}
+
+// CompareValueAndSwap saves the target if the value of the key equals to value, op options are not supported
+func (kv *RocksdbMetaKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error) {
+	if len(opts) != 0 {
+		return false, errUnsupportedOpOption
+	}
+	key = kv.GetPath(key)
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	record, err := kv.state.get(key)
+	if err != nil {
+		return false, err
+	}
+	if record == nil || string(record.value) != value {
+		return false, nil
+	}
+	if err := kv.state.commit([]metaPut{{key: key, value: target}}, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CompareVersionAndSwap saves the target if the version of the key equals to version, 0 means the key doesn't exist,
+// op options are not supported, use CompareVersionAndSwapWithLease to bind the key to a lease
+func (kv *RocksdbMetaKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
+	if len(opts) != 0 {
+		return false, errUnsupportedOpOption
+	}
+	return kv.CompareVersionAndSwapWithLease(key, version, target, clientv3.NoLease)
+}
+
+// CompareVersionAndSwapWithLease acts like CompareVersionAndSwap, and binds the key to the lease if it's not NoLease
+func (kv *RocksdbMetaKV) CompareVersionAndSwapWithLease(key string, version int64, target string, id clientv3.LeaseID) (bool, error) {
+	key = kv.GetPath(key)
+	kv.state.mu.Lock()
+	defer kv.state.mu.Unlock()
+	record, err := kv.state.get(key)
+	if err != nil {
+		return false, err
+	}
+	var current int64
+	if record != nil {
+		current = record.version
+	}
+	if current != version {
+		return false, nil
+	}
+	if err := kv.state.commit([]metaPut{{key: key, value: target, lease: id}}, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Watch watches the changes of the key from now on, the watch lasts until the rocksdb kv is closed
+func (kv *RocksdbMetaKV) Watch(key string) clientv3.WatchChan {
+	return kv.state.watch(context.Background(), kv.GetPath(key), false, 0, true)
+}
+
+// WatchWithPrefix watches the changes of the keys with the prefix from now on, the watch lasts until the rocksdb kv is closed
+func (kv *RocksdbMetaKV) WatchWithPrefix(key string) clientv3.WatchChan {
+	return kv.state.watch(context.Background(), kv.GetPath(key), true, 0, true)
+}
+
+// WatchWithRevision watches the changes of the keys with the prefix from the revision,
+// the events carry the previous key-values, the watch lasts until the rocksdb kv is closed
+func (kv *RocksdbMetaKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+	return kv.state.watch(context.Background(), kv.GetPath(key), true, revision, false)
+}
+
+// WatchWithRevisionCtx acts like WatchWithRevision, and the watch is removed and its channel closed once ctx is done
+func (kv *RocksdbMetaKV) WatchWithRevisionCtx(ctx context.Context, key string, revision int64) clientv3.WatchChan {
+	return kv.state.watch(ctx, kv.GetPath(key), true, revision, false)
+}
+
+func (kv *RocksdbMetaKV) toPuts(saves map[string]string) []metaPut {
+	puts := make([]metaPut, 0, len(saves))
+	for key, value := range saves {
+		puts = append(puts, metaPut{key: kv.GetPath(key), value: value})
+	}
+	return puts
+}
+
+// metaRecord is a value stored in the meta kv along with its header
+type metaRecord struct {
+	createRevision int64
+	modRevision    int64
+	version        int64
+	lease          clientv3.LeaseID
+	value          []byte
+}
+
+func (r *metaRecord) encode() []byte {
+	data := make([]byte, metaHeaderLen+len(r.value))
+	binary.BigEndian.PutUint64(data[0:], uint64(r.createRevision))
+	binary.BigEndian.PutUint64(data[8:], uint64(r.modRevision))
+	binary.BigEndian.PutUint64(data[16:], uint64(r.version))
+	binary.BigEndian.PutUint64(data[24:], uint64(r.lease))
+	copy(data[metaHeaderLen:], r.value)
+	return data
+}
+
+func decodeMetaRecord(key string, data []byte) (*metaRecord, error) {
+	if len(data) < metaHeaderLen {
+		return nil, fmt.Errorf("invalid meta record of key %s", key)
+	}
+	return &metaRecord{
+		createRevision: int64(binary.BigEndian.Uint64(data[0:])),
+		modRevision:    int64(binary.BigEndian.Uint64(data[8:])),
+		version:        int64(binary.BigEndian.Uint64(data[16:])),
+		lease:          clientv3.LeaseID(binary.BigEndian.Uint64(data[24:])),
+		value:          data[metaHeaderLen:],
+	}, nil
+}
+
+func (r *metaRecord) keyValue(key string) *mvccpb.KeyValue {
+	return &mvccpb.KeyValue{
+		Key:            []byte(key),
+		CreateRevision: r.createRevision,
+		ModRevision:    r.modRevision,
+		Version:        r.version,
+		Lease:          int64(r.lease),
+		Value:          r.value,
+	}
+}
+
+func encodeRevision(revision int64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(revision))
+	return data
+}
+
+// metaPut is a put in a meta kv commit
+type metaPut struct {
+	key         string
+	value       string
+	lease       clientv3.LeaseID
+	ignoreLease bool
+}
+
+// metaLease is a lease granted by the meta kv
+type metaLease struct {
+	ttl      int64
+	deadline time.Time
+	keys     map[string]struct{}
+}
+
+// metaState is the state shared by all the meta kvs on the same rocksdb kv,
+// writes are serialized by the lock to allocate revisions and deliver the events in order
+type metaState struct {
+	kv *RocksdbKV
+
+	mu       sync.RWMutex
+	revision int64
+	// compactRevision is the earliest revision which could be watched from
+	compactRevision int64
+	// history keeps the latest events for the watches from an earlier revision
+	history  []*clientv3.Event
+	watchers map[*metaWatcher]struct{}
+	leases   map[clientv3.LeaseID]*metaLease
+	leaseID  clientv3.LeaseID
+	closed   bool
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newMetaState(rocksdbKV *RocksdbKV) (*metaState, error) {
+	s := &metaState{
+		kv:       rocksdbKV,
+		watchers: make(map[*metaWatcher]struct{}),
+		leases:   make(map[clientv3.LeaseID]*metaLease),
+		closeCh:  make(chan struct{}),
+	}
+	value, err := rocksdbKV.LoadBytes(metaRevisionKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 8 {
+		s.revision = int64(binary.BigEndian.Uint64(value))
+	}
+
+	// leases don't survive restarts, remove the keys bound to the leases of the last run
+	keys, records, err := s.rangePrefix("")
+	if err != nil {
+		return nil, err
+	}
+	var expired []string
+	for i, record := range records {
+		if record.lease != clientv3.NoLease {
+			expired = append(expired, keys[i])
+		}
+	}
+	if len(expired) > 0 {
+		s.revision++
+		if err := rocksdbKV.MultiSaveBytesAndRemove(map[string][]byte{metaRevisionKey: encodeRevision(s.revision)}, expired); err != nil {
+			return nil, err
+		}
+	}
+	// no history is kept across restarts, watching from the current revision is still allowed
+	// since the state of it could be loaded
+	s.compactRevision = s.revision
+
+	s.wg.Add(1)
+	go s.expireLeaseLoop()
+	return s, nil
+}
+
+// get returns the record of the full key, nil if the key doesn't exist
+func (s *metaState) get(key string) (*metaRecord, error) {
+	if key == "" || key == metaRevisionKey {
+		return nil, nil
+	}
+	data, err := s.kv.LoadBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return decodeMetaRecord(key, data)
+}
+
+// rangePrefix returns the keys and records with the prefix sorted by key
+func (s *metaState) rangePrefix(prefix string) ([]string, []*metaRecord, error) {
+	keys, values, err := s.kv.LoadBytesWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	resultKeys := make([]string, 0, len(keys))
+	records := make([]*metaRecord, 0, len(keys))
+	for i, key := range keys {
+		if key == metaRevisionKey {
+			continue
+		}
+		record, err := decodeMetaRecord(key, values[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		resultKeys = append(resultKeys, key)
+		records = append(records, record)
+	}
+	return resultKeys, records, nil
+}
+
+// commit applies the puts and deletes with a new revision in one write batch,
+// then updates the leases and notifies the watchers, the lock shall be held by the caller
+func (s *metaState) commit(puts []metaPut, deletes []string) error {
+	if s.closed {
+		return errors.New("rocksdb meta kv is closed")
+	}
+	revision := s.revision + 1
+	saves := make(map[string][]byte, len(puts)+1)
+	removals := make([]string, 0, len(deletes))
+	events := make([]*clientv3.Event, 0, len(puts)+len(deletes))
+	for _, put := range puts {
+		if put.key == "" {
+			return errors.New("rocksdb meta kv does not support empty key")
+		}
+		prev, err := s.get(put.key)
+		if err != nil {
+			return err
+		}
+		record := &metaRecord{createRevision: revision, modRevision: revision, version: 1, lease: put.lease, value: []byte(put.value)}
+		if prev != nil {
+			record.createRevision = prev.createRevision
+			record.version = prev.version + 1
+		}
+		if put.ignoreLease {
+			if prev == nil {
+				return v3rpc.ErrKeyNotFound
+			}
+			record.lease = prev.lease
+		} else if _, ok := s.leases[put.lease]; put.lease != clientv3.NoLease && !ok {
+			return v3rpc.ErrLeaseNotFound
+		}
+		saves[put.key] = record.encode()
+		event := &clientv3.Event{Type: mvccpb.PUT, Kv: record.keyValue(put.key)}
+		if prev != nil {
+			event.PrevKv = prev.keyValue(put.key)
+		}
+		events = append(events, event)
+	}
+	for _, key := range deletes {
+		prev, err := s.get(key)
+		if err != nil {
+			return err
+		}
+		if prev == nil {
+			continue
+		}
+		removals = append(removals, key)
+		events = append(events, &clientv3.Event{
+			Type:   mvccpb.DELETE,
+			Kv:     &mvccpb.KeyValue{Key: []byte(key), ModRevision: revision},
+			PrevKv: prev.keyValue(key),
+		})
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	saves[metaRevisionKey] = encodeRevision(revision)
+	if err := s.kv.MultiSaveBytesAndRemove(saves, removals); err != nil {
+		return err
+	}
+	s.revision = revision
+	for _, event := range events {
+		key := string(event.Kv.Key)
+		if event.PrevKv != nil && event.PrevKv.Lease != event.Kv.Lease {
+			if lease, ok := s.leases[clientv3.LeaseID(event.PrevKv.Lease)]; ok {
+				delete(lease.keys, key)
+			}
+		}
+		if lease, ok := s.leases[clientv3.LeaseID(event.Kv.Lease)]; ok && event.Type == mvccpb.PUT {
+			lease.keys[key] = struct{}{}
+		}
+	}
+	s.notify(events)
+	return nil
+}
+
+// notify records the events of the latest revision and delivers them to the watchers
+func (s *metaState) notify(events []*clientv3.Event) {
+	s.history = append(s.history, events...)
+	for len(s.history) > watchHistorySize {
+		s.compactRevision = s.history[0].Kv.ModRevision + 1
+		s.history = s.history[1:]
+	}
+	for w := range s.watchers {
+		w.deliver(s.revision, events)
+	}
+}
+
+// watch creates a watcher of the key or the prefix, events from the revision are delivered if revision is not 0,
+// the watcher is removed when ctx is done
+func (s *metaState) watch(ctx context.Context, key string, prefix bool, revision int64, createdNotify bool) clientv3.WatchChan {
+	w := newMetaWatcher(key, prefix)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(w.ch)
+		return w.ch
+	}
+
+	header := etcdserverpb.ResponseHeader{Revision: s.revision}
+	if createdNotify {
+		w.push(clientv3.WatchResponse{Header: header, Created: true})
+	}
+	if revision > 0 && revision <= s.revision {
+		if revision < s.compactRevision {
+			// the events are gone, the watch is canceled with ErrCompacted like etcd
+			w.push(clientv3.WatchResponse{Header: header, CompactRevision: s.compactRevision, Canceled: true})
+			w.cancel()
+			s.wg.Add(1)
+			go s.runWatcher(ctx, w)
+			return w.ch
+		}
+		// replay the history grouped by revision
+		start := sort.Search(len(s.history), func(i int) bool {
+			return s.history[i].Kv.ModRevision >= revision
+		})
+		for i := start; i < len(s.history); {
+			j := i
+			for j < len(s.history) && s.history[j].Kv.ModRevision == s.history[i].Kv.ModRevision {
+				j++
+			}
+			w.deliver(s.history[i].Kv.ModRevision, s.history[i:j])
+			i = j
+		}
+	}
+	s.watchers[w] = struct{}{}
+	s.wg.Add(1)
+	go s.runWatcher(ctx, w)
+	return w.ch
+}
+
+// runWatcher delivers the events of the watcher until it's canceled, ctx is done or the state is closed,
+// then the watcher is removed so that no more events are queued for it
+func (s *metaState) runWatcher(ctx context.Context, w *metaWatcher) {
+	defer s.wg.Done()
+	w.run(ctx.Done(), s.closeCh)
+	s.mu.Lock()
+	delete(s.watchers, w)
+	s.mu.Unlock()
+}
+
+func (s *metaState) grant(ttl int64) (clientv3.LeaseID, error) {
+	if ttl <= 0 {
+		return clientv3.NoLease, fmt.Errorf("invalid lease ttl %d", ttl)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return clientv3.NoLease, errors.New("rocksdb meta kv is closed")
+	}
+	s.leaseID++
+	s.leases[s.leaseID] = &metaLease{
+		ttl:      ttl,
+		deadline: time.Now().Add(time.Duration(ttl) * time.Second),
+		keys:     make(map[string]struct{}),
+	}
+	return s.leaseID, nil
+}
+
+// renew extends the deadline of the lease, nil is returned if the lease is gone
+func (s *metaState) renew(id clientv3.LeaseID) *clientv3.LeaseKeepAliveResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lease, ok := s.leases[id]
+	if !ok {
+		return nil
+	}
+	lease.deadline = time.Now().Add(time.Duration(lease.ttl) * time.Second)
+	return &clientv3.LeaseKeepAliveResponse{
+		ResponseHeader: &etcdserverpb.ResponseHeader{Revision: s.revision},
+		ID:             id,
+		TTL:            lease.ttl,
+	}
+}
+
+func (s *metaState) keepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lease, ok := s.leases[id]
+	if !ok || s.closed {
+		return nil, v3rpc.ErrLeaseNotFound
+	}
+	// renew the lease 3 times in a ttl like etcd
+	interval := time.Duration(lease.ttl) * time.Second / 3
+	ch := make(chan *clientv3.LeaseKeepAliveResponse, 16)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			resp := s.renew(id)
+			if resp == nil {
+				return
+			}
+			// drop the response if the channel is full, the same as etcd
+			select {
+			case ch <- resp:
+			default:
+			}
+			select {
+			case <-s.closeCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// revoke removes the lease and the keys bound to it, the lock shall be held by the caller
+func (s *metaState) revoke(id clientv3.LeaseID) error {
+	lease, ok := s.leases[id]
+	if !ok {
+		return v3rpc.ErrLeaseNotFound
+	}
+	keys := make([]string, 0, len(lease.keys))
+	for key := range lease.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if err := s.commit(nil, keys); err != nil {
+		return err
+	}
+	delete(s.leases, id)
+	return nil
+}
+
+func (s *metaState) expireLeaseLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			for id, lease := range s.leases {
+				if lease.deadline.Before(now) {
+					// retry in the next round if failed
+					_ = s.revoke(id)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *metaState) close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.closeCh)
+	s.watchers = nil
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// metaWatcher delivers the events of a key or a prefix to the watch channel,
+// the events are queued so that a slow receiver never blocks the writes
+type metaWatcher struct {
+	key    string
+	prefix bool
+	ch     chan clientv3.WatchResponse
+
+	mu       sync.Mutex
+	pending  []clientv3.WatchResponse
+	canceled bool
+	notifyCh chan struct{}
+}
+
+func newMetaWatcher(key string, prefix bool) *metaWatcher {
+	return &metaWatcher{
+		key:      key,
+		prefix:   prefix,
+		ch:       make(chan clientv3.WatchResponse),
+		notifyCh: make(chan struct{}, 1),
+	}
+}
+
+func (w *metaWatcher) match(key []byte) bool {
+	if w.prefix {
+		return strings.HasPrefix(string(key), w.key)
+	}
+	return string(key) == w.key
+}
+
+// deliver queues the events of the revision which match the watcher
+func (w *metaWatcher) deliver(revision int64, events []*clientv3.Event) {
+	matched := make([]*clientv3.Event, 0, len(events))
+	for _, event := range events {
+		if w.match(event.Kv.Key) {
+			matched = append(matched, event)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+	w.push(clientv3.WatchResponse{Header: etcdserverpb.ResponseHeader{Revision: revision}, Events: matched})
+}
+
+func (w *metaWatcher) push(resp clientv3.WatchResponse) {
+	w.mu.Lock()
+	w.pending = append(w.pending, resp)
+	w.mu.Unlock()
+	select {
+	case w.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// cancel closes the watch channel after the pending responses are received
+func (w *metaWatcher) cancel() {
+	w.mu.Lock()
+	w.canceled = true
+	w.mu.Unlock()
+}
+
+func (w *metaWatcher) run(doneCh <-chan struct{}, closeCh <-chan struct{}) {
+	defer close(w.ch)
+	for {
+		w.mu.Lock()
+		pending, canceled := w.pending, w.canceled
+		w.pending = nil
+		w.mu.Unlock()
+		for _, resp := range pending {
+			select {
+			case w.ch <- resp:
+			case <-doneCh:
+				return
+			case <-closeCh:
+				return
+			}
+		}
+		if canceled {
+			return
+		}
+		select {
+		case <-w.notifyCh:
+		case <-doneCh:
+			return
+		case <-closeCh:
+			return
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocksdbkv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+)
+
+func newRocksdbMetaKV(t *testing.T, name string, rootPath string) (*rocksdbkv.RocksdbKV, *rocksdbkv.RocksdbMetaKV) {
+	rocksdbKV, err := rocksdbkv.NewRocksdbKV(name)
+	require.NoError(t, err)
+	metaKV, err := rocksdbkv.NewRocksdbMetaKV(rocksdbKV, rootPath)
+	require.NoError(t, err)
+	return rocksdbKV, metaKV
+}
+
+func receiveWatchResponse(t *testing.T, ch clientv3.WatchChan) clientv3.WatchResponse {
+	select {
+	case resp, ok := <-ch:
+		require.True(t, ok)
+		return resp
+	case <-time.After(time.Second):
+		t.Fatal("no watch response received")
+	}
+	return clientv3.WatchResponse{}
+}
+
+func TestRocksdbMetaKV(t *testing.T) {
+	name := t.TempDir()
+	rocksdbKV, metaKV := newRocksdbMetaKV(t, name, "meta")
+	defer func() {
+		rocksdbKV.Close()
+	}()
+
+	assert.Equal(t, "meta/key", metaKV.GetPath("key"))
+
+	_, err := metaKV.Load("a")
+	assert.Error(t, err)
+
+	assert.NoError(t, metaKV.Save("a", "1"))
+	assert.NoError(t, metaKV.MultiSave(map[string]string{"a": "2", "ab": "3", "b": "4"}))
+	value, err := metaKV.Load("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", value)
+
+	values, err := metaKV.MultiLoad([]string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "4"}, values)
+	values, err = metaKV.MultiLoad([]string{"a", "c"})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"2", ""}, values)
+
+	keys, values, versions, err := metaKV.LoadWithPrefix2("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"meta/a", "meta/ab"}, keys)
+	assert.Equal(t, []string{"2", "3"}, values)
+	assert.Equal(t, []int64{2, 1}, versions)
+
+	// a is created before ab and b
+	keys, _, revision, err := metaKV.LoadWithRevision("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"meta/a", "meta/ab", "meta/b"}, keys)
+	assert.Equal(t, int64(2), revision)
+
+	// keys out of the root path are invisible
+	otherKV, err := rocksdbkv.NewRocksdbMetaKV(rocksdbKV, "other")
+	require.NoError(t, err)
+	keys, _, err = otherKV.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	t.Run("compare and swap", func(t *testing.T) {
+		ok, err := metaKV.CompareValueAndSwap("a", "1", "5")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = metaKV.CompareValueAndSwap("a", "2", "5")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = metaKV.CompareVersionAndSwap("c", 1, "6")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = metaKV.CompareVersionAndSwap("c", 0, "6")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = metaKV.CompareVersionAndSwap("c", 1, "7")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		_, err = metaKV.CompareVersionAndSwap("c", 2, "8", clientv3.WithPrevKV())
+		assert.Error(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		assert.NoError(t, metaKV.MultiSaveAndRemove(map[string]string{"d": "8"}, []string{"c"}))
+		assert.NoError(t, metaKV.RemoveWithPrefix("a"))
+		keys, _, err := metaKV.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"meta/b", "meta/d"}, keys)
+
+		// the version is reset after the key is removed
+		ok, err := metaKV.CompareVersionAndSwap("c", 0, "9")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		_, _, revision, err := metaKV.LoadWithRevision("")
+		require.NoError(t, err)
+		rocksdbKV.Close()
+
+		rocksdbKV, metaKV = newRocksdbMetaKV(t, name, "meta")
+		keys, _, newRevision, err := metaKV.LoadWithRevision("")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"meta/b", "meta/d", "meta/c"}, keys)
+		assert.Equal(t, revision, newRevision)
+	})
+}
+
+func TestRocksdbMetaKV_Watch(t *testing.T) {
+	rocksdbKV, metaKV := newRocksdbMetaKV(t, t.TempDir(), "meta")
+
+	keyCh := metaKV.Watch("a")
+	prefixCh := metaKV.WatchWithPrefix("a")
+	assert.True(t, receiveWatchResponse(t, keyCh).Created)
+	assert.True(t, receiveWatchResponse(t, prefixCh).Created)
+
+	assert.NoError(t, metaKV.Save("a", "1"))
+	assert.NoError(t, metaKV.MultiSave(map[string]string{"a": "2", "ab": "3", "b": "4"}))
+	assert.NoError(t, metaKV.Remove("a"))
+
+	resp := receiveWatchResponse(t, keyCh)
+	assert.Equal(t, int64(1), resp.Header.Revision)
+	assert.Equal(t, mvccpb.PUT, resp.Events[0].Type)
+	resp = receiveWatchResponse(t, keyCh)
+	assert.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, "2", string(resp.Events[0].Kv.Value))
+	assert.Equal(t, "1", string(resp.Events[0].PrevKv.Value))
+	resp = receiveWatchResponse(t, keyCh)
+	assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+	assert.Equal(t, "2", string(resp.Events[0].PrevKv.Value))
+
+	receiveWatchResponse(t, prefixCh)
+	resp = receiveWatchResponse(t, prefixCh)
+	assert.Equal(t, 2, len(resp.Events))
+
+	t.Run("watch with revision", func(t *testing.T) {
+		ch := metaKV.WatchWithRevision("", 2)
+		resp := receiveWatchResponse(t, ch)
+		assert.Equal(t, int64(2), resp.Header.Revision)
+		assert.Equal(t, 3, len(resp.Events))
+		resp = receiveWatchResponse(t, ch)
+		assert.Equal(t, int64(3), resp.Header.Revision)
+
+		assert.NoError(t, metaKV.Save("c", "5"))
+		resp = receiveWatchResponse(t, ch)
+		assert.Equal(t, int64(4), resp.Header.Revision)
+		assert.Equal(t, "meta/c", string(resp.Events[0].Kv.Key))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := metaKV.WatchWithRevisionCtx(ctx, "c", 0)
+		assert.NoError(t, metaKV.Save("c", "6"))
+		resp := receiveWatchResponse(t, ch)
+		assert.Equal(t, "6", string(resp.Events[0].Kv.Value))
+
+		cancel()
+		// the watch is removed, the writes after it are not queued for the watch
+		assert.Eventually(t, func() bool {
+			select {
+			case _, ok := <-ch:
+				return !ok
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+		assert.NoError(t, metaKV.Save("c", "7"))
+	})
+
+	t.Run("compacted", func(t *testing.T) {
+		for i := 0; i < 1100; i++ {
+			assert.NoError(t, metaKV.Save("d", fmt.Sprint(i)))
+		}
+		ch := metaKV.WatchWithRevision("", 2)
+		resp := receiveWatchResponse(t, ch)
+		assert.ErrorIs(t, resp.Err(), v3rpc.ErrCompacted)
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	rocksdbKV.Close()
+	_, ok := <-keyCh
+	assert.False(t, ok)
+}
+
+func TestRocksdbMetaKV_Lease(t *testing.T) {
+	name := t.TempDir()
+	rocksdbKV, metaKV := newRocksdbMetaKV(t, name, "meta")
+	defer func() {
+		rocksdbKV.Close()
+	}()
+
+	_, err := metaKV.KeepAlive(100)
+	assert.Error(t, err)
+	assert.Error(t, metaKV.SaveWithLease("a", "1", 100))
+	assert.Error(t, metaKV.SaveWithIgnoreLease("a", "1"))
+
+	kept, err := metaKV.Grant(1)
+	require.NoError(t, err)
+	ch, err := metaKV.KeepAlive(kept)
+	require.NoError(t, err)
+	assert.NoError(t, metaKV.SaveWithLease("kept", "1", kept))
+
+	expired, err := metaKV.Grant(1)
+	require.NoError(t, err)
+	ok, err := metaKV.CompareVersionAndSwapWithLease("expired", 0, "1", expired)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoError(t, metaKV.SaveWithIgnoreLease("expired", "2"))
+
+	watchCh := metaKV.WatchWithRevision("expired", 0)
+	resp := <-ch
+	assert.Equal(t, kept, resp.ID)
+
+	assert.Eventually(t, func() bool {
+		_, err := metaKV.Load("expired")
+		return err != nil
+	}, 5*time.Second, 100*time.Millisecond)
+	event := receiveWatchResponse(t, watchCh).Events[0]
+	assert.Equal(t, mvccpb.DELETE, event.Type)
+	assert.Equal(t, "2", string(event.PrevKv.Value))
+
+	value, err := metaKV.Load("kept")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+
+	t.Run("revoke", func(t *testing.T) {
+		id, err := metaKV.Grant(10)
+		require.NoError(t, err)
+		ch, err := metaKV.KeepAlive(id)
+		require.NoError(t, err)
+		assert.NoError(t, metaKV.SaveWithLease("revoked", "1", id))
+		assert.NoError(t, metaKV.Revoke(id))
+		_, err = metaKV.Load("revoked")
+		assert.Error(t, err)
+		assert.Error(t, metaKV.Revoke(id))
+
+		assert.Eventually(t, func() bool {
+			select {
+			case _, ok := <-ch:
+				return !ok
+			default:
+				return false
+			}
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		assert.NoError(t, metaKV.Save("persisted", "1"))
+		rocksdbKV.Close()
+
+		// the leases are gone with the last run
+		rocksdbKV, metaKV = newRocksdbMetaKV(t, name, "meta")
+		keys, _, err := metaKV.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"meta/persisted"}, keys)
+	})
+}
+
+func TestLocalMetaKV(t *testing.T) {
+	assert.Nil(t, rocksdbkv.NewLocalMetaKV("meta"))
+
+	rocksdbKV, err := rocksdbkv.NewRocksdbKV(t.TempDir())
+	require.NoError(t, err)
+	defer rocksdbKV.Close()
+	require.NoError(t, rocksdbkv.SetLocalMetaKV(rocksdbKV))
+	defer rocksdbkv.SetLocalMetaKV(nil)
+
+	metaKV := rocksdbkv.NewLocalMetaKV("meta")
+	require.NotNil(t, metaKV)
+	ch := metaKV.WatchWithPrefix("")
+	// the meta kvs of the components share the rocksdb kv, along with its watches.
+	assert.NoError(t, rocksdbkv.NewLocalMetaKV("meta/sub").Save("key", "1"))
+	resp := receiveWatchResponse(t, ch)
+	require.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, "meta/sub/key", string(resp.Events[0].Kv.Key))
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelCheckpoint_List(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: channelName1,
+		Position:    []byte("position"),
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "channel_name", "position"}).
+				AddRow(checkpoint.TenantID, checkpoint.ChannelName, checkpoint.Position))
+
+	// actual
+	res, err := channelCheckpointTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.ChannelCheckpoint{checkpoint}, res)
+}
+
+func TestChannelCheckpoint_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := channelCheckpointTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Upsert(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: channelName1,
+		Position:    []byte("position"),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`channel_name`,`position`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(checkpoint.TenantID, checkpoint.ChannelName, checkpoint.Position, checkpoint.IsDeleted, checkpoint.CreatedAt, checkpoint.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := channelCheckpointTestDb.Upsert(checkpoint)
+	assert.Nil(t, err)
+}
+
+func TestChannelCheckpoint_Upsert_Error(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: channelName1,
+		Position:    []byte("position"),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`channel_name`,`position`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(checkpoint.TenantID, checkpoint.ChannelName, checkpoint.Position, checkpoint.IsDeleted, checkpoint.CreatedAt, checkpoint.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := channelCheckpointTestDb.Upsert(checkpoint)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_MarkDeleted(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `channel_checkpoints` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(true, AnyTime{}, tenantID, channelName1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := channelCheckpointTestDb.MarkDeleted(tenantID, channelName1)
+	assert.Nil(t, err)
+}
+
+func TestChannelCheckpoint_MarkDeleted_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `channel_checkpoints` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(true, AnyTime{}, tenantID, channelName1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := channelCheckpointTestDb.MarkDeleted(tenantID, channelName1)
+	assert.Error(t, err)
+}
@@ -19,7 +19,7 @@ type collectionDb struct {
 func (s *collectionDb) GetCollectionIDTs(tenantID string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*dbmodel.Collection, error) {
 	var col dbmodel.Collection
 
-	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id, ts").Where("tenant_id = ? AND collection_id = ? AND ts <= ?", tenantID, collectionID, ts).Order("ts desc").Take(&col).Error
+	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id, ts").Where("tenant_id = ? AND collection_id = ? AND ts <= ?", tenantID, collectionID, upperTs(ts)).Order("ts desc").Take(&col).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		log.Warn("record not found", zap.Int64("collID", collectionID), zap.Uint64("ts", ts), zap.Error(err))
@@ -36,7 +36,11 @@ func (s *collectionDb) GetCollectionIDTs(tenantID string, collectionID typeutil.
 func (s *collectionDb) ListCollectionIDTs(tenantID string, ts typeutil.Timestamp) ([]*dbmodel.Collection, error) {
 	var r []*dbmodel.Collection
 
-	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id, MAX(ts) ts").Where("tenant_id = ? AND ts <= ?", tenantID, ts).Group("collection_id").Find(&r).Error
+	// the latest record of a dropped collection is the tombstone, which is skipped
+	tombstoneTs := s.db.Table("collections AS tombstones").Select("tombstones.ts").
+		Where("tombstones.tenant_id = ? AND tombstones.collection_id = collections.collection_id AND tombstones.is_deleted = true", tenantID)
+	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id, MAX(ts) ts").Where("tenant_id = ? AND ts <= ?", tenantID, upperTs(ts)).Group("collection_id").
+		Having("MAX(ts) NOT IN (?)", tombstoneTs).Find(&r).Error
 	if err != nil {
 		log.Error("list collection_id & latest ts pairs in collections failed", zap.String("tenant", tenantID), zap.Uint64("ts", ts), zap.Error(err))
 		return nil, err
@@ -64,7 +68,7 @@ func (s *collectionDb) Get(tenantID string, collectionID typeutil.UniqueID, ts t
 func (s *collectionDb) GetCollectionIDByName(tenantID string, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
 	var r dbmodel.Collection
 
-	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id").Where("tenant_id = ? AND collection_name = ? AND ts <= ?", tenantID, collectionName, ts).Order("ts desc").Take(&r).Error
+	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id").Where("tenant_id = ? AND collection_name = ? AND ts <= ?", tenantID, collectionName, upperTs(ts)).Order("ts desc").Take(&r).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return 0, fmt.Errorf("get collection_id by collection_name not found, collName=%s, ts=%d", collectionName, ts)
@@ -81,6 +85,7 @@ func (s *collectionDb) GetCollectionIDByName(tenantID string, collectionName str
 func (s *collectionDb) Insert(in *dbmodel.Collection) error {
 	err := s.db.Clauses(clause.OnConflict{
 		// constraint UNIQUE (tenant_id, collection_id, ts)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "collection_id"}, {Name: "ts"}},
 		DoNothing: true,
 	}).Create(&in).Error
 
@@ -104,6 +109,7 @@ func generateCollectionUpdatesWithoutID(in *dbmodel.Collection) map[string]inter
 		"consistency_level": in.ConsistencyLevel,
 		"status":            in.Status,
 		"ts":                in.Ts,
+		"drop_time":         in.DropTime,
 		"is_deleted":        in.IsDeleted,
 		"created_at":        in.CreatedAt,
 		"updated_at":        in.UpdatedAt,
@@ -19,6 +19,7 @@ type collAliasDb struct {
 func (s *collAliasDb) Insert(in []*dbmodel.CollectionAlias) error {
 	err := s.db.Clauses(clause.OnConflict{
 		// constraint UNIQUE (tenant_id, collection_alias, ts)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "collection_alias"}, {Name: "ts"}},
 		DoNothing: true,
 	}).Create(&in).Error
 
@@ -33,7 +34,7 @@ func (s *collAliasDb) Insert(in []*dbmodel.CollectionAlias) error {
 func (s *collAliasDb) GetCollectionIDByAlias(tenantID string, alias string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
 	var r dbmodel.CollectionAlias
 
-	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id").Where("tenant_id = ? AND collection_alias = ? AND ts <= ?", tenantID, alias, ts).Order("ts desc").Take(&r).Error
+	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id").Where("tenant_id = ? AND collection_alias = ? AND ts <= ?", tenantID, alias, upperTs(ts)).Order("ts desc").Take(&r).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return 0, fmt.Errorf("get collection_id by alias not found, alias=%s, ts=%d", alias, ts)
@@ -46,12 +47,14 @@ func (s *collAliasDb) GetCollectionIDByAlias(tenantID string, alias string, ts t
 	return r.CollectionID, nil
 }
 
+// ListCollectionIDTs lists the latest ts of each alias, the aliases of one collection are created, altered and dropped
+// at different ts, so the latest records are grouped by the alias instead of the collection.
 func (s *collAliasDb) ListCollectionIDTs(tenantID string, ts typeutil.Timestamp) ([]*dbmodel.CollectionAlias, error) {
 	var r []*dbmodel.CollectionAlias
 
-	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id, MAX(ts) ts").Where("tenant_id = ? AND ts <= ?", tenantID, ts).Group("collection_id").Find(&r).Error
+	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_alias, MAX(ts) ts").Where("tenant_id = ? AND ts <= ?", tenantID, upperTs(ts)).Group("collection_alias").Find(&r).Error
 	if err != nil {
-		log.Error("list collection_id & latest ts pairs in collection_aliases failed", zap.String("tenant", tenantID), zap.Uint64("ts", ts), zap.Error(err))
+		log.Error("list alias & latest ts pairs in collection_aliases failed", zap.String("tenant", tenantID), zap.Uint64("ts", ts), zap.Error(err))
 		return nil, err
 	}
 
@@ -61,16 +64,16 @@ func (s *collAliasDb) ListCollectionIDTs(tenantID string, ts typeutil.Timestamp)
 func (s *collAliasDb) List(tenantID string, cidTsPairs []*dbmodel.CollectionAlias) ([]*dbmodel.CollectionAlias, error) {
 	var collAliases []*dbmodel.CollectionAlias
 
-	inValues := make([][]interface{}, 0, len(cidTsPairs))
+	pairs := make([][2]interface{}, 0, len(cidTsPairs))
 	for _, pair := range cidTsPairs {
-		in := []interface{}{pair.CollectionID, pair.Ts}
-		inValues = append(inValues, in)
+		pairs = append(pairs, [2]interface{}{pair.CollectionAlias, pair.Ts})
 	}
+	cond, args := pairsCondition("collection_alias", "ts", pairs)
 
 	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id, collection_alias").
-		Where("tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ?", tenantID, inValues).Find(&collAliases).Error
+		Where("tenant_id = ? AND is_deleted = false AND "+cond, append([]interface{}{tenantID}, args...)...).Find(&collAliases).Error
 	if err != nil {
-		log.Error("list alias by collection_id and alias pairs failed", zap.String("tenant", tenantID), zap.Any("collIdTs", inValues), zap.Error(err))
+		log.Error("list alias by alias and ts pairs failed", zap.String("tenant", tenantID), zap.Any("aliasTs", pairs), zap.Error(err))
 		return nil, err
 	}
 
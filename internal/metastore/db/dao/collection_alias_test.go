@@ -106,25 +106,25 @@ func TestCollectionAlias_GetCollectionIDByName_ErrRecordNotFound(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestCollectionAlias_ListCidTs(t *testing.T) {
+func TestCollectionAlias_ListAliasTs(t *testing.T) {
 	var collAliases = []*dbmodel.CollectionAlias{
 		{
-			CollectionID: collID1,
-			Ts:           typeutil.Timestamp(2),
+			CollectionAlias: "test_alias_1",
+			Ts:              typeutil.Timestamp(2),
 		},
 		{
-			CollectionID: collID2,
-			Ts:           typeutil.Timestamp(5),
+			CollectionAlias: "test_alias_2",
+			Ts:              typeutil.Timestamp(5),
 		},
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collection_aliases` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id`").
+	mock.ExpectQuery("SELECT collection_alias, MAX(ts) ts FROM `collection_aliases` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_alias`").
 		WithArgs(tenantID, ts).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"collection_id", "ts"}).
-				AddRow(collID1, typeutil.Timestamp(2)).
-				AddRow(collID2, typeutil.Timestamp(5)))
+			sqlmock.NewRows([]string{"collection_alias", "ts"}).
+				AddRow("test_alias_1", typeutil.Timestamp(2)).
+				AddRow("test_alias_2", typeutil.Timestamp(5)))
 
 	// actual
 	res, err := aliasTestDb.ListCollectionIDTs(tenantID, ts)
@@ -132,9 +132,9 @@ func TestCollectionAlias_ListCidTs(t *testing.T) {
 	assert.Equal(t, collAliases, res)
 }
 
-func TestCollectionAlias_ListCidTs_Error(t *testing.T) {
+func TestCollectionAlias_ListAliasTs_Error(t *testing.T) {
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collection_aliases` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id`").
+	mock.ExpectQuery("SELECT collection_alias, MAX(ts) ts FROM `collection_aliases` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_alias`").
 		WithArgs(tenantID, ts).
 		WillReturnError(errors.New("test error"))
 
@@ -147,12 +147,12 @@ func TestCollectionAlias_ListCidTs_Error(t *testing.T) {
 func TestCollectionAlias_List(t *testing.T) {
 	var cidTsPairs = []*dbmodel.CollectionAlias{
 		{
-			CollectionID: collID1,
-			Ts:           typeutil.Timestamp(2),
+			CollectionAlias: "test_alias_1",
+			Ts:              typeutil.Timestamp(2),
 		},
 		{
-			CollectionID: collID2,
-			Ts:           typeutil.Timestamp(5),
+			CollectionAlias: "test_alias_2",
+			Ts:              typeutil.Timestamp(5),
 		},
 	}
 	var out = []*dbmodel.CollectionAlias{
@@ -167,8 +167,8 @@ func TestCollectionAlias_List(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
-		WithArgs(tenantID, cidTsPairs[0].CollectionID, cidTsPairs[0].Ts, cidTsPairs[1].CollectionID, cidTsPairs[1].Ts).
+	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND ((collection_alias = ? AND ts = ?) OR (collection_alias = ? AND ts = ?))").
+		WithArgs(tenantID, cidTsPairs[0].CollectionAlias, cidTsPairs[0].Ts, cidTsPairs[1].CollectionAlias, cidTsPairs[1].Ts).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id", "collection_alias"}).
 				AddRow(collID1, "test_alias_1").
@@ -183,18 +183,18 @@ func TestCollectionAlias_List(t *testing.T) {
 func TestCollectionAlias_List_Error(t *testing.T) {
 	var cidTsPairs = []*dbmodel.CollectionAlias{
 		{
-			CollectionID: collID1,
-			Ts:           typeutil.Timestamp(2),
+			CollectionAlias: "test_alias_1",
+			Ts:              typeutil.Timestamp(2),
 		},
 		{
-			CollectionID: collID2,
-			Ts:           typeutil.Timestamp(5),
+			CollectionAlias: "test_alias_2",
+			Ts:              typeutil.Timestamp(5),
 		},
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
-		WithArgs(tenantID, cidTsPairs[0].CollectionID, cidTsPairs[0].Ts, cidTsPairs[1].CollectionID, cidTsPairs[1].Ts).
+	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND ((collection_alias = ? AND ts = ?) OR (collection_alias = ? AND ts = ?))").
+		WithArgs(tenantID, cidTsPairs[0].CollectionAlias, cidTsPairs[0].Ts, cidTsPairs[1].CollectionAlias, cidTsPairs[1].Ts).
 		WillReturnError(errors.New("test error"))
 
 	// actual
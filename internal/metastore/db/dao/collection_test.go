@@ -49,6 +49,14 @@ var (
 	userRoleTestDb  dbmodel.IUserRoleDb
 	grantTestDb     dbmodel.IGrantDb
 	grantIDTestDb   dbmodel.IGrantIDDb
+
+	segmentTestDb            dbmodel.ISegmentDb
+	segmentBinlogTestDb      dbmodel.ISegmentBinlogDb
+	removedChannelTestDb     dbmodel.IRemovedChannelDb
+	channelCheckpointTestDb  dbmodel.IChannelCheckpointDb
+	collectionLoadInfoTestDb dbmodel.ICollectionLoadInfoDb
+	partitionLoadInfoTestDb  dbmodel.IPartitionLoadInfoDb
+	replicaTestDb            dbmodel.IReplicaDb
 )
 
 // TestMain is the first function executed in current package, we will do some initial here
@@ -88,6 +96,13 @@ func TestMain(m *testing.M) {
 	userRoleTestDb = NewMetaDomain().UserRoleDb(ctx)
 	grantTestDb = NewMetaDomain().GrantDb(ctx)
 	grantIDTestDb = NewMetaDomain().GrantIDDb(ctx)
+	segmentTestDb = NewMetaDomain().SegmentDb(ctx)
+	segmentBinlogTestDb = NewMetaDomain().SegmentBinlogDb(ctx)
+	removedChannelTestDb = NewMetaDomain().RemovedChannelDb(ctx)
+	channelCheckpointTestDb = NewMetaDomain().ChannelCheckpointDb(ctx)
+	collectionLoadInfoTestDb = NewMetaDomain().CollectionLoadInfoDb(ctx)
+	partitionLoadInfoTestDb = NewMetaDomain().PartitionLoadInfoDb(ctx)
+	replicaTestDb = NewMetaDomain().ReplicaDb(ctx)
 
 	// m.Run entry for executing tests
 	os.Exit(m.Run())
@@ -171,8 +186,8 @@ func TestCollection_ListCidTs_TsNot0(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collections` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id`").
-		WithArgs(tenantID, ts).
+	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collections` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id` HAVING MAX(ts) NOT IN (SELECT tombstones.ts FROM collections AS tombstones WHERE tombstones.tenant_id = ? AND tombstones.collection_id = collections.collection_id AND tombstones.is_deleted = true)").
+		WithArgs(tenantID, ts, tenantID).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id", "ts"}).
 				AddRow(collID1, typeutil.Timestamp(2)).
@@ -186,8 +201,8 @@ func TestCollection_ListCidTs_TsNot0(t *testing.T) {
 
 func TestCollection_ListCidTs_TsNot0_Error(t *testing.T) {
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collections` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id`").
-		WithArgs(tenantID, ts).
+	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collections` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id` HAVING MAX(ts) NOT IN (SELECT tombstones.ts FROM collections AS tombstones WHERE tombstones.tenant_id = ? AND tombstones.collection_id = collections.collection_id AND tombstones.is_deleted = true)").
+		WithArgs(tenantID, ts, tenantID).
 		WillReturnError(errors.New("test error"))
 
 	// actual
@@ -209,8 +224,8 @@ func TestCollection_ListCidTs_Ts0(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collections` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id`").
-		WithArgs(tenantID, noTs).
+	mock.ExpectQuery("SELECT collection_id, MAX(ts) ts FROM `collections` WHERE tenant_id = ? AND ts <= ? GROUP BY `collection_id` HAVING MAX(ts) NOT IN (SELECT tombstones.ts FROM collections AS tombstones WHERE tombstones.tenant_id = ? AND tombstones.collection_id = collections.collection_id AND tombstones.is_deleted = true)").
+		WithArgs(tenantID, noTs, tenantID).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id", "ts"}).
 				AddRow(collID1, noTs).
@@ -358,8 +373,8 @@ func TestCollection_Insert(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`ts`,`drop_time`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Ts, collection.DropTime, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -386,8 +401,8 @@ func TestCollection_Insert_Error(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`ts`,`drop_time`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Ts, collection.DropTime, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
 		WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
@@ -443,8 +458,8 @@ func Test_collectionDb_Update(t *testing.T) {
 
 		// expectation
 		mock.ExpectBegin()
-		mock.ExpectExec("UPDATE `collections` SET `auto_id`=?,`collection_id`=?,`collection_name`=?,`consistency_level`=?,`created_at`=?,`description`=?,`is_deleted`=?,`shards_num`=?,`start_position`=?,`status`=?,`tenant_id`=?,`ts`=?,`updated_at`=? WHERE id = ?").
-			WithArgs(collection.AutoID, collection.CollectionID, collection.CollectionName, collection.ConsistencyLevel, collection.CreatedAt, collection.Description, collection.IsDeleted, collection.ShardsNum, collection.StartPosition, collection.Status, collection.TenantID, collection.Ts, collection.UpdatedAt, collection.ID).
+		mock.ExpectExec("UPDATE `collections` SET `auto_id`=?,`collection_id`=?,`collection_name`=?,`consistency_level`=?,`created_at`=?,`description`=?,`drop_time`=?,`is_deleted`=?,`shards_num`=?,`start_position`=?,`status`=?,`tenant_id`=?,`ts`=?,`updated_at`=? WHERE id = ?").
+			WithArgs(collection.AutoID, collection.CollectionID, collection.CollectionName, collection.ConsistencyLevel, collection.CreatedAt, collection.Description, collection.DropTime, collection.IsDeleted, collection.ShardsNum, collection.StartPosition, collection.Status, collection.TenantID, collection.Ts, collection.UpdatedAt, collection.ID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
@@ -471,8 +486,8 @@ func Test_collectionDb_Update(t *testing.T) {
 
 		// expectation
 		mock.ExpectBegin()
-		mock.ExpectExec("UPDATE `collections` SET `auto_id`=?,`collection_id`=?,`collection_name`=?,`consistency_level`=?,`created_at`=?,`description`=?,`is_deleted`=?,`shards_num`=?,`start_position`=?,`status`=?,`tenant_id`=?,`ts`=?,`updated_at`=? WHERE id = ?").
-			WithArgs(collection.AutoID, collection.CollectionID, collection.CollectionName, collection.ConsistencyLevel, collection.CreatedAt, collection.Description, collection.IsDeleted, collection.ShardsNum, collection.StartPosition, collection.Status, collection.TenantID, collection.Ts, collection.UpdatedAt, collection.ID).
+		mock.ExpectExec("UPDATE `collections` SET `auto_id`=?,`collection_id`=?,`collection_name`=?,`consistency_level`=?,`created_at`=?,`description`=?,`drop_time`=?,`is_deleted`=?,`shards_num`=?,`start_position`=?,`status`=?,`tenant_id`=?,`ts`=?,`updated_at`=? WHERE id = ?").
+			WithArgs(collection.AutoID, collection.CollectionID, collection.CollectionName, collection.ConsistencyLevel, collection.CreatedAt, collection.Description, collection.DropTime, collection.IsDeleted, collection.ShardsNum, collection.StartPosition, collection.Status, collection.TenantID, collection.Ts, collection.UpdatedAt, collection.ID).
 			WillReturnError(errors.New("error mock Update"))
 		mock.ExpectRollback()
 
@@ -2,9 +2,13 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type metaDomain struct{}
@@ -60,3 +64,56 @@ func (d *metaDomain) GrantDb(ctx context.Context) dbmodel.IGrantDb {
 func (d *metaDomain) GrantIDDb(ctx context.Context) dbmodel.IGrantIDDb {
 	return &grantIDDb{dbcore.GetDB(ctx)}
 }
+
+func (*metaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	return &segmentDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) SegmentBinlogDb(ctx context.Context) dbmodel.ISegmentBinlogDb {
+	return &segmentBinlogDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) RemovedChannelDb(ctx context.Context) dbmodel.IRemovedChannelDb {
+	return &removedChannelDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) ChannelCheckpointDb(ctx context.Context) dbmodel.IChannelCheckpointDb {
+	return &channelCheckpointDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionLoadInfoDb(ctx context.Context) dbmodel.ICollectionLoadInfoDb {
+	return &collectionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) PartitionLoadInfoDb(ctx context.Context) dbmodel.IPartitionLoadInfoDb {
+	return &partitionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) ReplicaDb(ctx context.Context) dbmodel.IReplicaDb {
+	return &replicaDb{dbcore.GetDB(ctx)}
+}
+
+// upperTs bounds the ts used as the upper limit of a query into the signed 64-bit range, since integers of
+// some dialects (e.g. sqlite) are always signed. tso never allocates such a large ts, so typeutil.MaxTimestamp
+// still means the latest.
+func upperTs(ts typeutil.Timestamp) typeutil.Timestamp {
+	if ts > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return ts
+}
+
+// pairsCondition builds a condition matching any of the (col1, col2) pairs, row value expressions like
+// "(col1, col2) IN ((?,?))" are avoided since they are not supported by every dialect.
+func pairsCondition(col1, col2 string, pairs [][2]interface{}) (string, []interface{}) {
+	if len(pairs) == 0 {
+		return "1 = 0", nil
+	}
+	conds := make([]string, 0, len(pairs))
+	args := make([]interface{}, 0, 2*len(pairs))
+	for _, pair := range pairs {
+		conds = append(conds, fmt.Sprintf("(%s = ? AND %s = ?)", col1, col2))
+		args = append(args, pair[0], pair[1])
+	}
+	return "(" + strings.Join(conds, " OR ") + ")", args
+}
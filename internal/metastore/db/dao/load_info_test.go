@@ -0,0 +1,222 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionLoadInfo_List(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       collID1,
+		ReleasedPartitions: "[3001]",
+		ReplicaNumber:      1,
+		Status:             2,
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "released_partitions", "replica_number", "status"}).
+				AddRow(info.TenantID, info.CollectionID, info.ReleasedPartitions, info.ReplicaNumber, info.Status))
+
+	// actual
+	res, err := collectionLoadInfoTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.CollectionLoadInfo{info}, res)
+}
+
+func TestCollectionLoadInfo_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := collectionLoadInfoTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       collID1,
+		ReleasedPartitions: "[]",
+		ReplicaNumber:      1,
+		Status:             1,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`released_partitions`,`replica_number`,`status`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `released_partitions`=VALUES(`released_partitions`),`replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(info.TenantID, info.CollectionID, info.ReleasedPartitions, info.ReplicaNumber, info.Status, info.IsDeleted, info.CreatedAt, info.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collectionLoadInfoTestDb.Upsert(info)
+	assert.Nil(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert_Error(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`released_partitions`,`replica_number`,`status`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `released_partitions`=VALUES(`released_partitions`),`replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(info.TenantID, info.CollectionID, info.ReleasedPartitions, info.ReplicaNumber, info.Status, info.IsDeleted, info.CreatedAt, info.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collectionLoadInfoTestDb.Upsert(info)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_MarkDeleted(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `collection_load_infos` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collectionLoadInfoTestDb.MarkDeleted(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestCollectionLoadInfo_MarkDeleted_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `collection_load_infos` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collectionLoadInfoTestDb.MarkDeleted(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_List(t *testing.T) {
+	var info = &dbmodel.PartitionLoadInfo{
+		TenantID:      tenantID,
+		CollectionID:  collID1,
+		PartitionID:   partitionID1,
+		ReplicaNumber: 1,
+		Status:        2,
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "replica_number", "status"}).
+				AddRow(info.TenantID, info.CollectionID, info.PartitionID, info.ReplicaNumber, info.Status))
+
+	// actual
+	res, err := partitionLoadInfoTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.PartitionLoadInfo{info}, res)
+}
+
+func TestPartitionLoadInfo_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := partitionLoadInfoTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			ReplicaNumber: 1,
+			Status:        1,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`replica_number`,`status`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(infos[0].TenantID, infos[0].CollectionID, infos[0].PartitionID, infos[0].ReplicaNumber, infos[0].Status, infos[0].IsDeleted, infos[0].CreatedAt, infos[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partitionLoadInfoTestDb.Upsert(infos)
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert_Error(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`replica_number`,`status`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(infos[0].TenantID, infos[0].CollectionID, infos[0].PartitionID, infos[0].ReplicaNumber, infos[0].Status, infos[0].IsDeleted, infos[0].CreatedAt, infos[0].UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partitionLoadInfoTestDb.Upsert(infos)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_MarkDeleted(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `partition_load_infos` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(true, AnyTime{}, tenantID, collID1, partitionID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partitionLoadInfoTestDb.MarkDeleted(tenantID, collID1, []int64{partitionID1})
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_MarkDeleted_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `partition_load_infos` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(true, AnyTime{}, tenantID, collID1, partitionID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partitionLoadInfoTestDb.MarkDeleted(tenantID, collID1, []int64{partitionID1})
+	assert.Error(t, err)
+}
@@ -43,6 +43,7 @@ func generatePartitionUpdatesWithoutID(in *dbmodel.Partition) map[string]interfa
 		"collection_id":               in.CollectionID,
 		"status":                      in.Status,
 		"ts":                          in.Ts,
+		"drop_time":                   in.DropTime,
 		"is_deleted":                  in.IsDeleted,
 		"created_at":                  in.CreatedAt,
 		"updated_at":                  in.UpdatedAt,
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type removedChannelDb struct {
+	db *gorm.DB
+}
+
+func (s *removedChannelDb) Exist(tenantID string, channelName string) (bool, error) {
+	var count int64
+
+	err := s.db.Model(&dbmodel.RemovedChannel{}).Where("tenant_id = ? AND channel_name = ? AND is_deleted = false", tenantID, channelName).Count(&count).Error
+	if err != nil {
+		log.Error("get removed channel failed", zap.String("tenant", tenantID), zap.String("channel", channelName), zap.Error(err))
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *removedChannelDb) Upsert(in *dbmodel.RemovedChannel) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, channel_name)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "channel_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"is_deleted"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert removed_channels failed", zap.String("tenant", in.TenantID), zap.String("channel", in.ChannelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *removedChannelDb) MarkDeleted(tenantID string, channelName string) error {
+	err := s.db.Model(&dbmodel.RemovedChannel{}).Where("tenant_id = ? AND channel_name = ?", tenantID, channelName).Update("is_deleted", true).Error
+	if err != nil {
+		log.Error("update removed_channels is_deleted=true failed", zap.String("tenant", tenantID), zap.String("channel", channelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
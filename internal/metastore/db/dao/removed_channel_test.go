@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+const channelName1 = "by-dev-rootcoord-dml_0_101v0"
+
+func TestRemovedChannel_Exist(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `removed_channels` WHERE tenant_id = ? AND channel_name = ? AND is_deleted = false").
+		WithArgs(tenantID, channelName1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// actual
+	exist, err := removedChannelTestDb.Exist(tenantID, channelName1)
+	assert.Nil(t, err)
+	assert.True(t, exist)
+}
+
+func TestRemovedChannel_Exist_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `removed_channels` WHERE tenant_id = ? AND channel_name = ? AND is_deleted = false").
+		WithArgs(tenantID, channelName1).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	exist, err := removedChannelTestDb.Exist(tenantID, channelName1)
+	assert.Error(t, err)
+	assert.False(t, exist)
+}
+
+func TestRemovedChannel_Upsert(t *testing.T) {
+	var channel = &dbmodel.RemovedChannel{
+		TenantID:    tenantID,
+		ChannelName: channelName1,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `removed_channels` (`tenant_id`,`channel_name`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(channel.TenantID, channel.ChannelName, channel.IsDeleted, channel.CreatedAt, channel.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := removedChannelTestDb.Upsert(channel)
+	assert.Nil(t, err)
+}
+
+func TestRemovedChannel_Upsert_Error(t *testing.T) {
+	var channel = &dbmodel.RemovedChannel{
+		TenantID:    tenantID,
+		ChannelName: channelName1,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `removed_channels` (`tenant_id`,`channel_name`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(channel.TenantID, channel.ChannelName, channel.IsDeleted, channel.CreatedAt, channel.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := removedChannelTestDb.Upsert(channel)
+	assert.Error(t, err)
+}
+
+func TestRemovedChannel_MarkDeleted(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `removed_channels` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(true, AnyTime{}, tenantID, channelName1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := removedChannelTestDb.MarkDeleted(tenantID, channelName1)
+	assert.Nil(t, err)
+}
+
+func TestRemovedChannel_MarkDeleted_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `removed_channels` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(true, AnyTime{}, tenantID, channelName1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := removedChannelTestDb.MarkDeleted(tenantID, channelName1)
+	assert.Error(t, err)
+}
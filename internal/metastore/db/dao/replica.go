@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type replicaDb struct {
+	db *gorm.DB
+}
+
+func (s *replicaDb) List(tenantID string) ([]*dbmodel.Replica, error) {
+	var r []*dbmodel.Replica
+
+	err := s.db.Model(&dbmodel.Replica{}).Where("tenant_id = ? AND is_deleted = false", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list replicas failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *replicaDb) Upsert(in *dbmodel.Replica) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id, replica_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "collection_id"}, {Name: "replica_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"nodes", "is_deleted"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert replicas failed", zap.String("tenant", in.TenantID), zap.Int64("collID", in.CollectionID), zap.Int64("replicaID", in.ReplicaID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *replicaDb) MarkDeleted(tenantID string, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) error {
+	err := s.db.Model(&dbmodel.Replica{}).Where("tenant_id = ? AND collection_id = ? AND replica_id = ?", tenantID, collectionID, replicaID).Update("is_deleted", true).Error
+	if err != nil {
+		log.Error("update replicas is_deleted=true failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Int64("replicaID", replicaID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *replicaDb) MarkDeletedByCollectionID(tenantID string, collectionID typeutil.UniqueID) error {
+	err := s.db.Model(&dbmodel.Replica{}).Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Update("is_deleted", true).Error
+	if err != nil {
+		log.Error("update replicas is_deleted=true by collection_id failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
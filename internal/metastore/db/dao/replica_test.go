@@ -0,0 +1,141 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+const replicaID1 = int64(4001)
+
+func TestReplica_List(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    replicaID1,
+		Nodes:        "[1,2]",
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "replica_id", "nodes"}).
+				AddRow(replica.TenantID, replica.CollectionID, replica.ReplicaID, replica.Nodes))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.Replica{replica}, res)
+}
+
+func TestReplica_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestReplica_Upsert(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    replicaID1,
+		Nodes:        "[1,2]",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`collection_id`,`replica_id`,`nodes`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `nodes`=VALUES(`nodes`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(replica.TenantID, replica.CollectionID, replica.ReplicaID, replica.Nodes, replica.IsDeleted, replica.CreatedAt, replica.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Nil(t, err)
+}
+
+func TestReplica_Upsert_Error(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    replicaID1,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`collection_id`,`replica_id`,`nodes`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `nodes`=VALUES(`nodes`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(replica.TenantID, replica.CollectionID, replica.ReplicaID, replica.Nodes, replica.IsDeleted, replica.CreatedAt, replica.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Error(t, err)
+}
+
+func TestReplica_MarkDeleted(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `replicas` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, collID1, replicaID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.MarkDeleted(tenantID, collID1, replicaID1)
+	assert.Nil(t, err)
+}
+
+func TestReplica_MarkDeleted_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `replicas` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, collID1, replicaID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.MarkDeleted(tenantID, collID1, replicaID1)
+	assert.Error(t, err)
+}
+
+func TestReplica_MarkDeletedByCollectionID(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `replicas` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.MarkDeletedByCollectionID(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestReplica_MarkDeletedByCollectionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `replicas` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.MarkDeletedByCollectionID(tenantID, collID1)
+	assert.Error(t, err)
+}
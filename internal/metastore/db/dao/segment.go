@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type segmentDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentDb) List(tenantID string) ([]*dbmodel.Segment, error) {
+	var r []*dbmodel.Segment
+
+	err := s.db.Model(&dbmodel.Segment{}).Where("tenant_id = ? AND is_deleted = false", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list segments failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentDb) Upsert(in []*dbmodel.Segment) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id)
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "segment_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"collection_id", "partition_id", "insert_channel", "state",
+			"num_rows", "segment_info", "is_deleted"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert segments failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentDb) MarkDeleted(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	err := s.db.Model(&dbmodel.Segment{}).
+		Where("tenant_id = ? AND segment_id IN (?)", tenantID, segmentIDs).
+		Update("is_deleted", true).Error
+
+	if err != nil {
+		log.Error("update segments is_deleted=true failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
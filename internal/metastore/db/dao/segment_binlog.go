@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type segmentBinlogDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentBinlogDb) List(tenantID string) ([]*dbmodel.SegmentBinlog, error) {
+	var r []*dbmodel.SegmentBinlog
+
+	err := s.db.Model(&dbmodel.SegmentBinlog{}).Where("tenant_id = ? AND is_deleted = false", tenantID).Order("field_id").Find(&r).Error
+	if err != nil {
+		log.Error("list segment_binlogs failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentBinlogDb) Upsert(in []*dbmodel.SegmentBinlog) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id, log_type, field_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "segment_id"}, {Name: "log_type"}, {Name: "field_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"binlogs", "is_deleted"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert segment_binlogs failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentBinlogDb) MarkDeletedBySegmentIDs(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	err := s.db.Model(&dbmodel.SegmentBinlog{}).
+		Where("tenant_id = ? AND segment_id IN (?)", tenantID, segmentIDs).
+		Update("is_deleted", true).Error
+
+	if err != nil {
+		log.Error("update segment_binlogs is_deleted=true failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentBinlog_List(t *testing.T) {
+	var binlog = &dbmodel.SegmentBinlog{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		PartitionID:  partitionID1,
+		SegmentID:    segmentID1,
+		FieldID:      fieldID1,
+		LogType:      0,
+		Binlogs:      []byte("binlogs"),
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segment_binlogs` WHERE tenant_id = ? AND is_deleted = false ORDER BY field_id").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "segment_id", "field_id", "log_type", "binlogs"}).
+				AddRow(binlog.TenantID, binlog.CollectionID, binlog.PartitionID, binlog.SegmentID, binlog.FieldID, binlog.LogType, binlog.Binlogs))
+
+	// actual
+	res, err := segmentBinlogTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.SegmentBinlog{binlog}, res)
+}
+
+func TestSegmentBinlog_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segment_binlogs` WHERE tenant_id = ? AND is_deleted = false ORDER BY field_id").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := segmentBinlogTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestSegmentBinlog_Upsert(t *testing.T) {
+	var binlogs = []*dbmodel.SegmentBinlog{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			SegmentID:    segmentID1,
+			FieldID:      fieldID1,
+			LogType:      2,
+			Binlogs:      []byte("binlogs"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segment_binlogs` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`field_id`,`log_type`,`binlogs`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `binlogs`=VALUES(`binlogs`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(binlogs[0].TenantID, binlogs[0].CollectionID, binlogs[0].PartitionID, binlogs[0].SegmentID, binlogs[0].FieldID, binlogs[0].LogType, binlogs[0].Binlogs, binlogs[0].IsDeleted, binlogs[0].CreatedAt, binlogs[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentBinlogTestDb.Upsert(binlogs)
+	assert.Nil(t, err)
+}
+
+func TestSegmentBinlog_Upsert_Error(t *testing.T) {
+	var binlogs = []*dbmodel.SegmentBinlog{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segment_binlogs` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`field_id`,`log_type`,`binlogs`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `binlogs`=VALUES(`binlogs`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(binlogs[0].TenantID, binlogs[0].CollectionID, binlogs[0].PartitionID, binlogs[0].SegmentID, binlogs[0].FieldID, binlogs[0].LogType, binlogs[0].Binlogs, binlogs[0].IsDeleted, binlogs[0].CreatedAt, binlogs[0].UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentBinlogTestDb.Upsert(binlogs)
+	assert.Error(t, err)
+}
+
+func TestSegmentBinlog_MarkDeletedBySegmentIDs(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `segment_binlogs` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(true, AnyTime{}, tenantID, segmentID1, segmentID2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentBinlogTestDb.MarkDeletedBySegmentIDs(tenantID, []int64{segmentID1, segmentID2})
+	assert.Nil(t, err)
+}
+
+func TestSegmentBinlog_MarkDeletedBySegmentIDs_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `segment_binlogs` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND segment_id IN (?)").
+		WithArgs(true, AnyTime{}, tenantID, segmentID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentBinlogTestDb.MarkDeletedBySegmentIDs(tenantID, []int64{segmentID1})
+	assert.Error(t, err)
+}
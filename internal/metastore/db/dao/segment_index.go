@@ -32,7 +32,7 @@ func (s *segmentIndexDb) List(tenantID string) ([]*dbmodel.SegmentIndexResult, e
 			"segment_indexes.build_id AS build_id, segment_indexes.node_id AS node_id, segment_indexes.index_version AS index_version, "+
 			"segment_indexes.index_state AS index_state,segment_indexes.fail_reason AS fail_reason, segment_indexes.create_time AS create_time,"+
 			"segment_indexes.index_file_paths AS index_file_paths, segment_indexes.index_size AS index_size, segment_indexes.is_deleted AS is_deleted").
-		Where("indexes.tenant_id = ?", tenantID)
+		Where("segment_indexes.tenant_id = ?", tenantID)
 
 	var rs []*dbmodel.SegmentIndexResult
 	err := tx.Scan(&rs).Error
@@ -54,20 +54,17 @@ func (s *segmentIndexDb) Insert(in []*dbmodel.SegmentIndex) error {
 	return nil
 }
 
+// Update overwrites the segment index of the same segment and index, which is unique.
 func (s *segmentIndexDb) Update(in *dbmodel.SegmentIndex) error {
-	err := s.db.CreateInBatches(in, 100).Error
-	if err != nil {
-		log.Error("insert segment_indexes failed", zap.Error(err))
-		return err
-	}
-
-	return nil
+	return s.Upsert([]*dbmodel.SegmentIndex{in})
 }
 
 func (s *segmentIndexDb) Upsert(in []*dbmodel.SegmentIndex) error {
 	err := s.db.Clauses(clause.OnConflict{
 		// constraint UNIQUE (tenant_id, segment_id, index_id)
-		DoUpdates: clause.AssignmentColumns([]string{"index_build_id", "enable_index", "create_time"}),
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "segment_id"}, {Name: "index_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"build_id", "node_id", "index_version", "index_state", "fail_reason",
+			"create_time", "index_file_paths", "index_size", "is_deleted"}),
 	}).CreateInBatches(in, 100).Error
 
 	if err != nil {
@@ -79,18 +76,17 @@ func (s *segmentIndexDb) Upsert(in []*dbmodel.SegmentIndex) error {
 }
 
 func (s *segmentIndexDb) MarkDeleted(tenantID string, segIndexes []*dbmodel.SegmentIndex) error {
-	inValues := make([][]interface{}, 0, len(segIndexes))
+	pairs := make([][2]interface{}, 0, len(segIndexes))
 	for _, segIdx := range segIndexes {
-		in := []interface{}{segIdx.SegmentID, segIdx.IndexID}
-		inValues = append(inValues, in)
+		pairs = append(pairs, [2]interface{}{segIdx.SegmentID, segIdx.IndexID})
 	}
+	cond, args := pairsCondition("segment_id", "index_id", pairs)
 
-	err := s.db.Model(&dbmodel.SegmentIndex{}).Where("tenant_id = ? AND (segment_id, index_id) IN ?", tenantID, inValues).Updates(dbmodel.SegmentIndex{
+	err := s.db.Model(&dbmodel.SegmentIndex{}).Where("tenant_id = ? AND "+cond, append([]interface{}{tenantID}, args...)...).Updates(dbmodel.SegmentIndex{
 		IsDeleted: true,
 	}).Error
-
 	if err != nil {
-		log.Error("update segment_indexes deleted failed", zap.String("tenant", tenantID), zap.Any("segmentIDIndexID", inValues), zap.Error(err))
+		log.Error("update segment_indexes deleted failed", zap.String("tenant", tenantID), zap.Any("segmentIDIndexID", pairs), zap.Error(err))
 		return err
 	}
 
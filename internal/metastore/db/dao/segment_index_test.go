@@ -105,7 +105,7 @@ func TestSegmentIndex_Update(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `segment_indexes` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`num_rows`,`index_id`,`build_id`,`node_id`,`index_version`,`index_state`,`fail_reason`,`create_time`,`index_file_paths`,`index_size`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+	mock.ExpectExec("INSERT INTO `segment_indexes` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`num_rows`,`index_id`,`build_id`,`node_id`,`index_version`,`index_state`,`fail_reason`,`create_time`,`index_file_paths`,`index_size`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `build_id`=VALUES(`build_id`),`node_id`=VALUES(`node_id`),`index_version`=VALUES(`index_version`),`index_state`=VALUES(`index_state`),`fail_reason`=VALUES(`fail_reason`),`create_time`=VALUES(`create_time`),`index_file_paths`=VALUES(`index_file_paths`),`index_size`=VALUES(`index_size`),`is_deleted`=VALUES(`is_deleted`)").
 		WithArgs(segIndexes[0].TenantID, segIndexes[0].CollectionID, segIndexes[0].PartitionID, segIndexes[0].SegmentID, segIndexes[0].NumRows, segIndexes[0].IndexID, segIndexes[0].BuildID, segIndexes[0].NodeID, segIndexes[0].IndexVersion, segIndexes[0].IndexState, segIndexes[0].FailReason, segIndexes[0].CreateTime, segIndexes[0].IndexFilePaths, segIndexes[0].IndexSize, segIndexes[0].IsDeleted, segIndexes[0].CreatedAt, segIndexes[0].UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
@@ -150,6 +150,41 @@ func TestSegmentIndex_Upsert_Error(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSegmentIndex_Upsert(t *testing.T) {
+	var segIndexes = []*dbmodel.SegmentIndex{
+		{
+			TenantID:       tenantID,
+			CollectionID:   collID1,
+			PartitionID:    partitionID1,
+			SegmentID:      segmentID1,
+			NumRows:        NumRows,
+			IndexID:        indexID1,
+			BuildID:        1002,
+			NodeID:         3,
+			IndexVersion:   1,
+			IndexState:     3,
+			FailReason:     "",
+			CreateTime:     uint64(1011),
+			IndexFilePaths: "",
+			IndexSize:      1024,
+			IsDeleted:      false,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segment_indexes` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`num_rows`,`index_id`,`build_id`,`node_id`,`index_version`,`index_state`,`fail_reason`,`create_time`,`index_file_paths`,`index_size`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `build_id`=VALUES(`build_id`),`node_id`=VALUES(`node_id`),`index_version`=VALUES(`index_version`),`index_state`=VALUES(`index_state`),`fail_reason`=VALUES(`fail_reason`),`create_time`=VALUES(`create_time`),`index_file_paths`=VALUES(`index_file_paths`),`index_size`=VALUES(`index_size`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(segIndexes[0].TenantID, segIndexes[0].CollectionID, segIndexes[0].PartitionID, segIndexes[0].SegmentID, segIndexes[0].NumRows, segIndexes[0].IndexID, segIndexes[0].BuildID, segIndexes[0].NodeID, segIndexes[0].IndexVersion, segIndexes[0].IndexState, segIndexes[0].FailReason, segIndexes[0].CreateTime, segIndexes[0].IndexFilePaths, segIndexes[0].IndexSize, segIndexes[0].IsDeleted, segIndexes[0].CreatedAt, segIndexes[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segIndexTestDb.(*segmentIndexDb).Upsert(segIndexes)
+	assert.Nil(t, err)
+}
+
 func TestSegmentIndex_MarkDeleted(t *testing.T) {
 	var segIndexes = []*dbmodel.SegmentIndex{
 		{
@@ -164,7 +199,7 @@ func TestSegmentIndex_MarkDeleted(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE `segment_indexes` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND (segment_id, index_id) IN ((?,?),(?,?))").
+	mock.ExpectExec("UPDATE `segment_indexes` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND ((segment_id = ? AND index_id = ?) OR (segment_id = ? AND index_id = ?))").
 		WithArgs(true, AnyTime{}, tenantID, segIndexes[0].SegmentID, segIndexes[0].IndexID, segIndexes[1].SegmentID, segIndexes[1].IndexID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
@@ -184,7 +219,7 @@ func TestSegmentIndex_MarkDeleted_Error(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE `segment_indexes` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND (segment_id, index_id) IN ((?,?))").
+	mock.ExpectExec("UPDATE `segment_indexes` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND ((segment_id = ? AND index_id = ?))").
 		WithArgs(true, AnyTime{}, tenantID, segIndexes[0].SegmentID, segIndexes[0].IndexID).
 		WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
@@ -0,0 +1,124 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment_List(t *testing.T) {
+	var segment = &dbmodel.Segment{
+		TenantID:      tenantID,
+		CollectionID:  collID1,
+		PartitionID:   partitionID1,
+		SegmentID:     segmentID1,
+		InsertChannel: "ch",
+		State:         3,
+		NumRows:       NumRows,
+		SegmentInfo:   []byte("info"),
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "segment_id", "insert_channel", "state", "num_rows", "segment_info"}).
+				AddRow(segment.TenantID, segment.CollectionID, segment.PartitionID, segment.SegmentID, segment.InsertChannel, segment.State, segment.NumRows, segment.SegmentInfo))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.Segment{segment}, res)
+}
+
+func TestSegment_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestSegment_Upsert(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			SegmentID:     segmentID1,
+			InsertChannel: "ch",
+			State:         3,
+			NumRows:       NumRows,
+			SegmentInfo:   []byte("info"),
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`insert_channel`,`state`,`num_rows`,`segment_info`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `collection_id`=VALUES(`collection_id`),`partition_id`=VALUES(`partition_id`),`insert_channel`=VALUES(`insert_channel`),`state`=VALUES(`state`),`num_rows`=VALUES(`num_rows`),`segment_info`=VALUES(`segment_info`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(segments[0].TenantID, segments[0].CollectionID, segments[0].PartitionID, segments[0].SegmentID, segments[0].InsertChannel, segments[0].State, segments[0].NumRows, segments[0].SegmentInfo, segments[0].IsDeleted, segments[0].CreatedAt, segments[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Nil(t, err)
+}
+
+func TestSegment_Upsert_Error(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`insert_channel`,`state`,`num_rows`,`segment_info`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `collection_id`=VALUES(`collection_id`),`partition_id`=VALUES(`partition_id`),`insert_channel`=VALUES(`insert_channel`),`state`=VALUES(`state`),`num_rows`=VALUES(`num_rows`),`segment_info`=VALUES(`segment_info`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(segments[0].TenantID, segments[0].CollectionID, segments[0].PartitionID, segments[0].SegmentID, segments[0].InsertChannel, segments[0].State, segments[0].NumRows, segments[0].SegmentInfo, segments[0].IsDeleted, segments[0].CreatedAt, segments[0].UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Error(t, err)
+}
+
+func TestSegment_MarkDeleted(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `segments` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(true, AnyTime{}, tenantID, segmentID1, segmentID2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.MarkDeleted(tenantID, []int64{segmentID1, segmentID2})
+	assert.Nil(t, err)
+}
+
+func TestSegment_MarkDeleted_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `segments` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND segment_id IN (?)").
+		WithArgs(true, AnyTime{}, tenantID, segmentID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.MarkDeleted(tenantID, []int64{segmentID1})
+	assert.Error(t, err)
+}
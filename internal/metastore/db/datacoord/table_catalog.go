@@ -0,0 +1,353 @@
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Catalog keeps the segment meta of DataCoord in the database.
+// IndexCoord watches the flushed segments in the meta kv, so they are still notified through txn.
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+	txn        kv.TxnKV
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain, txn kv.TxnKV) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+		txn:        txn,
+	}
+}
+
+func (tc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	segments, err := tc.metaDomain.SegmentDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	binlogs, err := tc.metaDomain.SegmentBinlogDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	segmentInfos := make(map[typeutil.UniqueID]*datapb.SegmentInfo, len(segments))
+	for _, segment := range segments {
+		segmentInfo := &datapb.SegmentInfo{}
+		if err := proto.Unmarshal(segment.SegmentInfo, segmentInfo); err != nil {
+			log.Error("unmarshal segment info failed", zap.String("tenant", tenantID), zap.Int64("segmentID", segment.SegmentID), zap.Error(err))
+			return nil, err
+		}
+		result = append(result, segmentInfo)
+		segmentInfos[segment.SegmentID] = segmentInfo
+	}
+
+	for _, binlog := range binlogs {
+		segmentInfo, ok := segmentInfos[binlog.SegmentID]
+		if !ok {
+			continue
+		}
+
+		fieldBinlog := &datapb.FieldBinlog{}
+		if err := proto.Unmarshal(binlog.Binlogs, fieldBinlog); err != nil {
+			log.Error("unmarshal field binlog failed", zap.String("tenant", tenantID), zap.Int64("segmentID", binlog.SegmentID),
+				zap.Int64("fieldID", binlog.FieldID), zap.Error(err))
+			return nil, err
+		}
+
+		switch storage.BinlogType(binlog.LogType) {
+		case storage.InsertBinlog:
+			segmentInfo.Binlogs = append(segmentInfo.Binlogs, fieldBinlog)
+		case storage.DeleteBinlog:
+			segmentInfo.Deltalogs = append(segmentInfo.Deltalogs, fieldBinlog)
+		case storage.StatsBinlog:
+			segmentInfo.Statslogs = append(segmentInfo.Statslogs, fieldBinlog)
+		default:
+			return nil, fmt.Errorf("invalid binlog type: %d", binlog.LogType)
+		}
+	}
+
+	return result, nil
+}
+
+func (tc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, []*datapb.SegmentInfo{segment}, true)
+	})
+	if err != nil {
+		return err
+	}
+
+	return tc.saveFlushedSegments(segment)
+}
+
+func (tc *Catalog) AlterSegments(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, segments, true)
+	})
+	if err != nil {
+		return err
+	}
+
+	return tc.saveFlushedSegments(segments...)
+}
+
+// AlterSegmentsInBatch alters all the segments in one transaction, the db has no limit on the operations of a transaction.
+func (tc *Catalog) AlterSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	return tc.AlterSegments(ctx, segments)
+}
+
+func (tc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// binlogs of the compacted segments are unchanged
+		if err := tc.saveSegments(txCtx, segments, false); err != nil {
+			return err
+		}
+
+		if newSegment != nil {
+			return tc.saveSegments(txCtx, []*datapb.SegmentInfo{newSegment}, true)
+		}
+		return nil
+	})
+}
+
+// RevertAlterSegmentsAndAddNewSegment reverts the metastore operation of AlterSegmentsAndAddNewSegment
+func (tc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegment *datapb.SegmentInfo) error {
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.saveSegments(txCtx, oldSegments, true); err != nil {
+			return err
+		}
+
+		if removeSegment != nil {
+			return tc.dropSegments(txCtx, []typeutil.UniqueID{removeSegment.GetID()})
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, segments, false)
+	})
+}
+
+func (tc *Catalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.dropSegments(txCtx, []typeutil.UniqueID{segment.GetID()})
+	})
+}
+
+func (tc *Catalog) MarkChannelDeleted(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.RemovedChannelDb(ctx).Upsert(&dbmodel.RemovedChannel{
+		TenantID:    tenantID,
+		ChannelName: channel,
+	})
+	if err != nil {
+		log.Error("failed to mark channel dropped", zap.String("tenant", tenantID), zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) IsChannelDropped(ctx context.Context, channel string) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	exist, err := tc.metaDomain.RemovedChannelDb(ctx).Exist(tenantID, channel)
+	if err != nil {
+		return false
+	}
+
+	return exist
+}
+
+// DropChannel removes channel remove flag after whole procedure is finished
+func (tc *Catalog) DropChannel(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.RemovedChannelDb(ctx).MarkDeleted(tenantID, channel)
+}
+
+func (tc *Catalog) ListChannelCheckpoint(ctx context.Context) (map[string]*internalpb.MsgPosition, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	rows, err := tc.metaDomain.ChannelCheckpointDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCPs := make(map[string]*internalpb.MsgPosition, len(rows))
+	for _, row := range rows {
+		pos := &internalpb.MsgPosition{}
+		if err := proto.Unmarshal(row.Position, pos); err != nil {
+			log.Error("unmarshal channel checkpoint failed", zap.String("tenant", tenantID), zap.String("channel", row.ChannelName), zap.Error(err))
+			return nil, err
+		}
+		channelCPs[row.ChannelName] = pos
+	}
+
+	return channelCPs, nil
+}
+
+func (tc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *internalpb.MsgPosition) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	posBytes, err := proto.Marshal(pos)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint of channel: %s, err: %w", vChannel, err)
+	}
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Upsert(&dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: vChannel,
+		Position:    posBytes,
+	})
+}
+
+func (tc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).MarkDeleted(tenantID, vChannel)
+}
+
+// saveSegments upserts the segments, binlogs of the segments are saved as well if withBinlogs is true.
+func (tc *Catalog) saveSegments(ctx context.Context, segments []*datapb.SegmentInfo, withBinlogs bool) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	tenantID := contextutil.TenantID(ctx)
+
+	segmentRows := make([]*dbmodel.Segment, 0, len(segments))
+	var binlogRows []*dbmodel.SegmentBinlog
+	for _, segment := range segments {
+		segmentRow, rows, err := buildSegmentRows(tenantID, segment)
+		if err != nil {
+			return err
+		}
+
+		segmentRows = append(segmentRows, segmentRow)
+		if withBinlogs {
+			binlogRows = append(binlogRows, rows...)
+		}
+	}
+
+	if err := tc.metaDomain.SegmentDb(ctx).Upsert(segmentRows); err != nil {
+		return err
+	}
+
+	if len(binlogRows) == 0 {
+		return nil
+	}
+	return tc.metaDomain.SegmentBinlogDb(ctx).Upsert(binlogRows)
+}
+
+func (tc *Catalog) dropSegments(ctx context.Context, segmentIDs []typeutil.UniqueID) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	if err := tc.metaDomain.SegmentDb(ctx).MarkDeleted(tenantID, segmentIDs); err != nil {
+		return err
+	}
+
+	return tc.metaDomain.SegmentBinlogDb(ctx).MarkDeletedBySegmentIDs(tenantID, segmentIDs)
+}
+
+// saveFlushedSegments saves the handoff requests of the flushed segments for IndexCoord.
+func (tc *Catalog) saveFlushedSegments(segments ...*datapb.SegmentInfo) error {
+	kvs := make(map[string]string)
+	for _, segment := range segments {
+		if segment.GetState() == commonpb.SegmentState_Flushed {
+			key := buildFlushedSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+			kvs[key] = strconv.FormatInt(segment.GetID(), 10)
+		}
+	}
+
+	if len(kvs) == 0 {
+		return nil
+	}
+	return tc.txn.MultiSave(kvs)
+}
+
+func buildSegmentRows(tenantID string, segment *datapb.SegmentInfo) (*dbmodel.Segment, []*dbmodel.SegmentBinlog, error) {
+	noBinlogsSegment := proto.Clone(segment).(*datapb.SegmentInfo)
+	noBinlogsSegment.Binlogs = nil
+	noBinlogsSegment.Deltalogs = nil
+	noBinlogsSegment.Statslogs = nil
+
+	segmentBytes, err := proto.Marshal(noBinlogsSegment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal segment: %d, err: %w", segment.GetID(), err)
+	}
+
+	segmentRow := &dbmodel.Segment{
+		TenantID:      tenantID,
+		CollectionID:  segment.GetCollectionID(),
+		PartitionID:   segment.GetPartitionID(),
+		SegmentID:     segment.GetID(),
+		InsertChannel: segment.GetInsertChannel(),
+		State:         int32(segment.GetState()),
+		NumRows:       segment.GetNumOfRows(),
+		SegmentInfo:   segmentBytes,
+	}
+
+	var binlogRows []*dbmodel.SegmentBinlog
+	for binlogType, fieldBinlogs := range [][]*datapb.FieldBinlog{
+		storage.InsertBinlog: segment.GetBinlogs(),
+		storage.DeleteBinlog: segment.GetDeltalogs(),
+		storage.StatsBinlog:  segment.GetStatslogs(),
+	} {
+		for _, fieldBinlog := range fieldBinlogs {
+			binlogBytes, err := proto.Marshal(fieldBinlog)
+			if err != nil {
+				return nil, nil, fmt.Errorf("marshal binlogs failed, collectionID:%d, segmentID:%d, fieldID:%d, error:%w",
+					segment.GetCollectionID(), segment.GetID(), fieldBinlog.GetFieldID(), err)
+			}
+
+			binlogRows = append(binlogRows, &dbmodel.SegmentBinlog{
+				TenantID:     tenantID,
+				CollectionID: segment.GetCollectionID(),
+				PartitionID:  segment.GetPartitionID(),
+				SegmentID:    segment.GetID(),
+				FieldID:      fieldBinlog.GetFieldID(),
+				LogType:      int32(binlogType),
+				Binlogs:      binlogBytes,
+			})
+		}
+	}
+
+	return segmentRow, binlogRows, nil
+}
+
+// buildFlushedSegmentPath common logic mapping segment info to corresponding key of IndexCoord in kv store
+func buildFlushedSegmentPath(collectionID typeutil.UniqueID, partitionID typeutil.UniqueID, segmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", util.FlushedSegmentPrefix, collectionID, partitionID, segmentID)
+}
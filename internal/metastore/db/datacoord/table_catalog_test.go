@@ -0,0 +1,201 @@
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	collID1      = int64(100)
+	partitionID1 = int64(101)
+	fieldID1     = int64(102)
+	channel1     = "by-dev-rootcoord-dml_0_100v0"
+)
+
+func newSqliteCatalog(t *testing.T) (*Catalog, *memkv.MemoryKV) {
+	// start from an empty database
+	dbcore.SetGlobalDB(nil)
+	err := dbcore.Connect(&paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: dbcore.SqliteMemoryPath})
+	require.NoError(t, err)
+	txn := memkv.NewMemoryKV()
+	return NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), txn), txn
+}
+
+func newSegment(segmentID int64, state commonpb.SegmentState) *datapb.SegmentInfo {
+	return &datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  collID1,
+		PartitionID:   partitionID1,
+		InsertChannel: channel1,
+		NumOfRows:     100,
+		State:         state,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: fieldID1, Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: fmt.Sprintf("insert_log/%d/%d/%d/%d/1", collID1, partitionID1, segmentID, fieldID1)}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogID: 2, LogPath: fmt.Sprintf("delta_log/%d/%d/%d/2", collID1, partitionID1, segmentID)}}},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: fieldID1, Binlogs: []*datapb.Binlog{{LogID: 3, LogPath: fmt.Sprintf("stats_log/%d/%d/%d/%d/3", collID1, partitionID1, segmentID, fieldID1)}}},
+		},
+	}
+}
+
+func listSegments(t *testing.T, catalog *Catalog) map[int64]*datapb.SegmentInfo {
+	segments, err := catalog.ListSegments(context.TODO())
+	require.NoError(t, err)
+	result := make(map[int64]*datapb.SegmentInfo)
+	for _, segment := range segments {
+		result[segment.GetID()] = segment
+	}
+	return result
+}
+
+func TestTableCatalog_Segment(t *testing.T) {
+	catalog, txn := newSqliteCatalog(t)
+	ctx := context.TODO()
+
+	growing := newSegment(1, commonpb.SegmentState_Growing)
+	require.NoError(t, catalog.AddSegment(ctx, growing))
+	segments := listSegments(t, catalog)
+	assert.Len(t, segments, 1)
+	assert.True(t, proto.Equal(growing, segments[1]))
+	_, err := txn.Load(buildFlushedSegmentPath(collID1, partitionID1, 1))
+	assert.Error(t, err)
+
+	// flushed segments are notified through the kv
+	flushed := newSegment(1, commonpb.SegmentState_Flushed)
+	flushed.Binlogs[0].Binlogs = append(flushed.Binlogs[0].Binlogs, &datapb.Binlog{LogID: 4, LogPath: "insert_log/4"})
+	require.NoError(t, catalog.AlterSegments(ctx, []*datapb.SegmentInfo{flushed}))
+	segments = listSegments(t, catalog)
+	assert.True(t, proto.Equal(flushed, segments[1]))
+	v, err := txn.Load(buildFlushedSegmentPath(collID1, partitionID1, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+
+	require.NoError(t, catalog.AlterSegments(ctx, nil))
+
+	require.NoError(t, catalog.DropSegment(ctx, flushed))
+	assert.Empty(t, listSegments(t, catalog))
+
+	// adding a dropped segment again
+	require.NoError(t, catalog.AddSegment(ctx, flushed))
+	segments = listSegments(t, catalog)
+	assert.True(t, proto.Equal(flushed, segments[1]))
+}
+
+func TestTableCatalog_Compaction(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+	ctx := context.TODO()
+
+	seg1 := newSegment(1, commonpb.SegmentState_Flushed)
+	seg2 := newSegment(2, commonpb.SegmentState_Flushed)
+	require.NoError(t, catalog.AlterSegments(ctx, []*datapb.SegmentInfo{seg1, seg2}))
+
+	dropped1 := proto.Clone(seg1).(*datapb.SegmentInfo)
+	dropped1.State = commonpb.SegmentState_Dropped
+	dropped2 := proto.Clone(seg2).(*datapb.SegmentInfo)
+	dropped2.State = commonpb.SegmentState_Dropped
+	compacted := newSegment(3, commonpb.SegmentState_Flushed)
+	compacted.CompactionFrom = []int64{1, 2}
+	compacted.CreatedByCompaction = true
+
+	require.NoError(t, catalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{dropped1, dropped2}, compacted))
+	segments := listSegments(t, catalog)
+	assert.Len(t, segments, 3)
+	assert.True(t, proto.Equal(dropped1, segments[1]))
+	assert.True(t, proto.Equal(dropped2, segments[2]))
+	assert.True(t, proto.Equal(compacted, segments[3]))
+
+	require.NoError(t, catalog.RevertAlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{seg1, seg2}, compacted))
+	segments = listSegments(t, catalog)
+	assert.Len(t, segments, 2)
+	assert.True(t, proto.Equal(seg1, segments[1]))
+	assert.True(t, proto.Equal(seg2, segments[2]))
+
+	require.NoError(t, catalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{dropped1}, nil))
+	segments = listSegments(t, catalog)
+	assert.Equal(t, commonpb.SegmentState_Dropped, segments[1].GetState())
+}
+
+func TestTableCatalog_SaveDroppedSegmentsInBatch(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+	ctx := context.TODO()
+
+	require.NoError(t, catalog.SaveDroppedSegmentsInBatch(ctx, nil))
+
+	var segments []*datapb.SegmentInfo
+	for i := int64(1); i <= 150; i++ {
+		segment := newSegment(i, commonpb.SegmentState_Flushed)
+		require.NoError(t, catalog.AddSegment(ctx, segment))
+		segment.State = commonpb.SegmentState_Dropped
+		segments = append(segments, segment)
+	}
+
+	require.NoError(t, catalog.SaveDroppedSegmentsInBatch(ctx, segments))
+	saved := listSegments(t, catalog)
+	assert.Len(t, saved, len(segments))
+	for _, segment := range segments {
+		assert.True(t, proto.Equal(segment, saved[segment.GetID()]))
+	}
+}
+
+func TestTableCatalog_Channel(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+	ctx := context.TODO()
+
+	assert.False(t, catalog.IsChannelDropped(ctx, channel1))
+
+	require.NoError(t, catalog.MarkChannelDeleted(ctx, channel1))
+	assert.True(t, catalog.IsChannelDropped(ctx, channel1))
+	// marking again is idempotent
+	require.NoError(t, catalog.MarkChannelDeleted(ctx, channel1))
+	assert.True(t, catalog.IsChannelDropped(ctx, channel1))
+
+	require.NoError(t, catalog.DropChannel(ctx, channel1))
+	assert.False(t, catalog.IsChannelDropped(ctx, channel1))
+
+	require.NoError(t, catalog.MarkChannelDeleted(ctx, channel1))
+	assert.True(t, catalog.IsChannelDropped(ctx, channel1))
+}
+
+func TestTableCatalog_ChannelCheckpoint(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+	ctx := context.TODO()
+
+	channelCPs, err := catalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, channelCPs)
+
+	require.NoError(t, catalog.SaveChannelCheckpoint(ctx, channel1, &internalpb.MsgPosition{ChannelName: channel1, Timestamp: 100}))
+	require.NoError(t, catalog.SaveChannelCheckpoint(ctx, channel1, &internalpb.MsgPosition{ChannelName: channel1, Timestamp: 200}))
+	channelCPs, err = catalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(channelCPs))
+	assert.Equal(t, uint64(200), channelCPs[channel1].GetTimestamp())
+
+	require.NoError(t, catalog.DropChannelCheckpoint(ctx, channel1))
+	channelCPs, err = catalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, channelCPs)
+
+	// saving again revives the dropped checkpoint
+	require.NoError(t, catalog.SaveChannelCheckpoint(ctx, channel1, &internalpb.MsgPosition{ChannelName: channel1, Timestamp: 300}))
+	channelCPs, err = catalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), channelCPs[channel1].GetTimestamp())
+}
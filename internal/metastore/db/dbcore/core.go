@@ -4,57 +4,96 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.uber.org/zap"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
 var (
 	globalDB *gorm.DB
+	// coordinators of a standalone process share one connection to the same database,
+	// otherwise each of them would get a brand new in-memory sqlite database.
+	connectMu  sync.Mutex
+	connectKey string
 )
 
+// Connect opens the meta database with the driver selected by cfg.Driver,
+// it's a no-op if the same database is already connected.
 func Connect(cfg *paramtable.MetaDBConfig) error {
-	// load config
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", cfg.Username, cfg.Password, cfg.Address, cfg.Port, cfg.DBName)
+	connectMu.Lock()
+	defer connectMu.Unlock()
+
+	key := dbKey(cfg)
+	if globalDB != nil && connectKey == key {
+		return nil
+	}
+
+	driver, err := getDriver(cfg.Driver)
+	if err != nil {
+		log.Error("fail to get db driver", zap.String("driver", cfg.Driver), zap.Error(err))
+		return err
+	}
+
+	dialector, err := driver.Dialector(cfg)
+	if err != nil {
+		log.Error("fail to create db dialector", zap.String("driver", cfg.Driver), zap.Error(err))
+		return err
+	}
 
 	var ormLogger logger.Interface
-	if cfg.Base.Log.Level == "debug" {
+	if cfg.Base != nil && cfg.Base.Log.Level == "debug" {
 		ormLogger = logger.Default.LogMode(logger.Info)
 	} else {
 		ormLogger = logger.Default
 	}
 
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+	db, err := gorm.Open(dialector, &gorm.Config{
 		Logger:          ormLogger,
 		CreateBatchSize: 100,
 	})
 	if err != nil {
-		log.Error("fail to connect db", zap.String("host", cfg.Address), zap.Int("port", cfg.Port), zap.String("database", cfg.DBName), zap.Error(err))
+		log.Error("fail to connect db", append(dbFields(cfg), zap.Error(err))...)
 		return err
 	}
 
-	idb, err := db.DB()
-	if err != nil {
-		log.Error("fail to create db instance", zap.String("host", cfg.Address), zap.Int("port", cfg.Port), zap.String("database", cfg.DBName), zap.Error(err))
+	if err = driver.Init(db, cfg); err != nil {
+		log.Error("fail to init db instance", append(dbFields(cfg), zap.Error(err))...)
 		return err
 	}
-	idb.SetMaxIdleConns(cfg.MaxIdleConns)
-	idb.SetMaxOpenConns(cfg.MaxOpenConns)
 
 	globalDB = db
+	connectKey = key
 
-	log.Info("db connected success", zap.String("host", cfg.Address), zap.Int("port", cfg.Port), zap.String("database", cfg.DBName))
+	log.Info("db connected success", dbFields(cfg)...)
 
 	return nil
 }
 
+func dbFields(cfg *paramtable.MetaDBConfig) []zap.Field {
+	if cfg.Driver == util.MetaStoreTypeSqlite {
+		return []zap.Field{zap.String("driver", cfg.Driver), zap.String("path", cfg.SqlitePath)}
+	}
+	return []zap.Field{zap.String("driver", cfg.Driver), zap.String("host", cfg.Address), zap.Int("port", cfg.Port), zap.String("database", cfg.DBName)}
+}
+
+func dbKey(cfg *paramtable.MetaDBConfig) string {
+	if cfg.Driver == util.MetaStoreTypeSqlite {
+		return fmt.Sprintf("%s:%s", cfg.Driver, cfg.SqlitePath)
+	}
+	return fmt.Sprintf("%s:%s@%s:%d/%s", cfg.Driver, cfg.Username, cfg.Address, cfg.Port, cfg.DBName)
+}
+
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
+	connectMu.Lock()
+	defer connectMu.Unlock()
 	globalDB = db
+	connectKey = ""
 }
 
 type ctxTransactionKey struct{}
@@ -0,0 +1,90 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type testRecord struct {
+	ID   int64
+	Name string
+}
+
+func TestConnect_UnknownDriver(t *testing.T) {
+	err := Connect(&paramtable.MetaDBConfig{Driver: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestRegisterDriver_Duplicated(t *testing.T) {
+	assert.Panics(t, func() {
+		RegisterDriver(util.MetaStoreTypeSqlite, &sqliteDriver{})
+	})
+}
+
+func TestConnect_Sqlite(t *testing.T) {
+	for _, path := range []string{SqliteMemoryPath, filepath.Join(t.TempDir(), "meta", "meta.db")} {
+		t.Run(path, func(t *testing.T) {
+			cfg := &paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: path}
+			require.NoError(t, Connect(cfg))
+
+			var tables []string
+			err := GetDB(context.TODO()).Raw("SELECT name FROM sqlite_master WHERE type = 'table'").Scan(&tables).Error
+			require.NoError(t, err)
+			assert.Subset(t, tables, []string{"collections", "collection_aliases", "collection_channels", "field_schemas",
+				"partitions", "indexes", "segment_indexes", "credential_users", "role", "user_role", "grant", "grant_id"})
+
+			// connecting the same database again reuses the connection.
+			db := globalDB
+			require.NoError(t, Connect(cfg))
+			assert.Same(t, db, globalDB)
+
+			// opening the same database again keeps the tables.
+			SetGlobalDB(nil)
+			require.NoError(t, Connect(cfg))
+		})
+	}
+}
+
+func TestTxImpl_Transaction(t *testing.T) {
+	SetGlobalDB(nil)
+	require.NoError(t, Connect(&paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: SqliteMemoryPath}))
+	ctx := context.TODO()
+	require.NoError(t, GetDB(ctx).Exec("CREATE TABLE test_records (id INTEGER PRIMARY KEY, name VARCHAR(128))").Error)
+
+	count := func() int64 {
+		var n int64
+		require.NoError(t, GetDB(ctx).Table("test_records").Count(&n).Error)
+		return n
+	}
+
+	err := NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		return GetDB(txCtx).Table("test_records").Create(&testRecord{ID: 1, Name: "a"}).Error
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count())
+
+	err = NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		if err := GetDB(txCtx).Table("test_records").Create(&testRecord{ID: 2, Name: "b"}).Error; err != nil {
+			return err
+		}
+		return errors.New("mock error")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), count())
+}
+
+func TestGetDB_InvalidTransaction(t *testing.T) {
+	ctx := context.WithValue(context.TODO(), ctxTransactionKey{}, "invalid")
+	assert.Nil(t, GetDB(ctx))
+
+	tx := &gorm.DB{}
+	assert.Equal(t, tx, GetDB(CtxWithTransaction(context.TODO(), tx)))
+}
@@ -0,0 +1,42 @@
+package dbcore
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"gorm.io/gorm"
+)
+
+// Driver opens the meta database of a specific sql dialect.
+type Driver interface {
+	// Dialector returns the gorm dialector connecting to the database described by cfg.
+	Dialector(cfg *paramtable.MetaDBConfig) (gorm.Dialector, error)
+	// Init prepares a newly opened database before it's used, e.g. tunes the connection pool.
+	Init(db *gorm.DB, cfg *paramtable.MetaDBConfig) error
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]Driver)
+)
+
+// RegisterDriver makes a meta database driver available by the provided name, it panics if the name is duplicated.
+func RegisterDriver(name string, driver Driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if _, ok := drivers[name]; ok {
+		panic(fmt.Sprintf("meta db driver %s is registered twice", name))
+	}
+	drivers[name] = driver
+}
+
+func getDriver(name string) (Driver, error) {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	driver, ok := drivers[name]
+	if !ok {
+		return nil, fmt.Errorf("not supported meta db driver: %s", name)
+	}
+	return driver, nil
+}
@@ -0,0 +1,32 @@
+package dbcore
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func init() {
+	RegisterDriver(util.MetaStoreTypeMysql, &mysqlDriver{})
+}
+
+// mysqlDriver connects to a mysql server, the tables are expected to be created by scripts/sql/meta.sql.
+type mysqlDriver struct{}
+
+func (*mysqlDriver) Dialector(cfg *paramtable.MetaDBConfig) (gorm.Dialector, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", cfg.Username, cfg.Password, cfg.Address, cfg.Port, cfg.DBName)
+	return mysql.Open(dsn), nil
+}
+
+func (*mysqlDriver) Init(db *gorm.DB, cfg *paramtable.MetaDBConfig) error {
+	idb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	idb.SetMaxIdleConns(cfg.MaxIdleConns)
+	idb.SetMaxOpenConns(cfg.MaxOpenConns)
+	return nil
+}
@@ -0,0 +1,53 @@
+package dbcore
+
+import (
+	_ "embed" // embed the sqlite schema
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// SqliteMemoryPath keeps the whole meta database in memory, it's lost once the process exits.
+const SqliteMemoryPath = ":memory:"
+
+//go:embed sqlite.sql
+var sqliteSchema string
+
+func init() {
+	RegisterDriver(util.MetaStoreTypeSqlite, &sqliteDriver{})
+}
+
+// sqliteDriver stores the meta in a local database file or in memory, the tables are created on open.
+type sqliteDriver struct{}
+
+func (*sqliteDriver) Dialector(cfg *paramtable.MetaDBConfig) (gorm.Dialector, error) {
+	if cfg.SqlitePath == SqliteMemoryPath {
+		return sqlite.Open(SqliteMemoryPath), nil
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.SqlitePath), os.ModePerm); err != nil {
+		return nil, err
+	}
+	return sqlite.Open(fmt.Sprintf("%s?_busy_timeout=5000&_journal_mode=WAL", cfg.SqlitePath)), nil
+}
+
+func (*sqliteDriver) Init(db *gorm.DB, cfg *paramtable.MetaDBConfig) error {
+	idb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	// sqlite allows only one writer at a time, and every connection of an in-memory database
+	// is a brand new database, so all the operations share a single connection which never expires.
+	idb.SetMaxOpenConns(1)
+	idb.SetMaxIdleConns(1)
+	idb.SetConnMaxLifetime(0)
+
+	if _, err := idb.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("failed to create sqlite meta tables: %w", err)
+	}
+	return nil
+}
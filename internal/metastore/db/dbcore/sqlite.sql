@@ -0,0 +1,297 @@
+/*
+ create tables script of the sqlite meta store, it's executed every time the database is opened.
+
+ Notices:
+    1. keep the tables in sync with the models in internal/metastore/db/dbmodel.
+    2. sqlite integers are signed 64 bits, timestamps are always less than 1 << 63.
+ */
+
+-- collections
+CREATE TABLE IF NOT EXISTS collections (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    collection_name VARCHAR(256),
+    description VARCHAR(2048) DEFAULT NULL,
+    auto_id BOOL DEFAULT FALSE,
+    shards_num INT,
+    start_position TEXT,
+    consistency_level INT,
+    status INT NOT NULL,
+    ts BIGINT DEFAULT 0,
+    drop_time BIGINT DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_collections_tenant_id_collection_id_ts ON collections (tenant_id, collection_id, ts);
+
+-- collection aliases
+CREATE TABLE IF NOT EXISTS collection_aliases (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    collection_alias VARCHAR(128),
+    ts BIGINT DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_collection_aliases_tenant_id_collection_alias_ts ON collection_aliases (tenant_id, collection_alias, ts);
+CREATE INDEX IF NOT EXISTS idx_collection_aliases_tenant_id_collection_id_ts ON collection_aliases (tenant_id, collection_id, ts);
+
+-- channels
+CREATE TABLE IF NOT EXISTS collection_channels (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    virtual_channel_name VARCHAR(256) NOT NULL,
+    physical_channel_name VARCHAR(256) NOT NULL,
+    removed BOOL DEFAULT FALSE,
+    ts BIGINT DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_collection_channels_tenant_id_collection_id_virtual_channel_name_ts ON collection_channels (tenant_id, collection_id, virtual_channel_name, ts);
+CREATE INDEX IF NOT EXISTS idx_collection_channels_tenant_id_collection_id_ts ON collection_channels (tenant_id, collection_id, ts);
+
+-- fields
+CREATE TABLE IF NOT EXISTS field_schemas (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    field_id BIGINT NOT NULL,
+    field_name VARCHAR(256) NOT NULL,
+    is_primary_key BOOL NOT NULL,
+    description VARCHAR(2048) DEFAULT NULL,
+    data_type INT NOT NULL,
+    type_params VARCHAR(2048),
+    index_params VARCHAR(2048),
+    auto_id BOOL NOT NULL,
+    collection_id BIGINT NOT NULL,
+    ts BIGINT DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_field_schemas_tenant_id_collection_id_field_name_ts ON field_schemas (tenant_id, collection_id, field_name, ts);
+CREATE INDEX IF NOT EXISTS idx_field_schemas_tenant_id_collection_id_field_id_ts ON field_schemas (tenant_id, collection_id, field_id, ts);
+
+-- partitions
+CREATE TABLE IF NOT EXISTS partitions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    partition_id BIGINT NOT NULL,
+    partition_name VARCHAR(256),
+    partition_created_timestamp BIGINT,
+    collection_id BIGINT NOT NULL,
+    status INT NOT NULL,
+    ts BIGINT DEFAULT 0,
+    drop_time BIGINT DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_partitions_tenant_id_collection_id_partition_name_ts ON partitions (tenant_id, collection_id, partition_name, ts);
+CREATE INDEX IF NOT EXISTS idx_partitions_tenant_id_collection_id_partition_id_ts ON partitions (tenant_id, collection_id, partition_id, ts);
+
+-- indexes
+CREATE TABLE IF NOT EXISTS indexes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    field_id BIGINT NOT NULL,
+    collection_id BIGINT NOT NULL,
+    index_id BIGINT NOT NULL,
+    index_name VARCHAR(256),
+    index_params VARCHAR(2048),
+    type_params VARCHAR(2048),
+    create_time BIGINT,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_indexes_tenant_id_collection_id_index_id ON indexes (tenant_id, collection_id, index_id);
+
+-- segment indexes
+CREATE TABLE IF NOT EXISTS segment_indexes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    num_rows BIGINT,
+    index_id BIGINT NOT NULL,
+    build_id BIGINT,
+    node_id BIGINT,
+    index_version BIGINT,
+    index_state INT,
+    fail_reason VARCHAR(2048),
+    create_time BIGINT,
+    index_file_paths VARCHAR(4096),
+    index_size BIGINT,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_segment_indexes_tenant_id_segment_id_index_id ON segment_indexes (tenant_id, segment_id, index_id);
+CREATE INDEX IF NOT EXISTS idx_segment_indexes_tenant_id_collection_id_segment_id_index_id ON segment_indexes (tenant_id, collection_id, segment_id, index_id);
+
+-- users
+CREATE TABLE IF NOT EXISTS credential_users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    username VARCHAR(128) NOT NULL,
+    encrypted_password VARCHAR(256) NOT NULL,
+    is_super BOOL NOT NULL DEFAULT FALSE,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_credential_users_tenant_id_username ON credential_users (tenant_id, username);
+
+-- role
+CREATE TABLE IF NOT EXISTS role (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    name VARCHAR(128) NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_role_tenant_name ON role (tenant_id, name, is_deleted);
+
+-- user-role
+CREATE TABLE IF NOT EXISTS user_role (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    user_id BIGINT NOT NULL,
+    role_id BIGINT NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_role_mapping_tenant_user_role ON user_role (tenant_id, user_id, role_id, is_deleted);
+
+-- grant
+CREATE TABLE IF NOT EXISTS `grant` (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    role_id BIGINT NOT NULL,
+    object VARCHAR(128) NOT NULL,
+    object_name VARCHAR(128) NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_grant_principal_resource_tenant ON `grant` (tenant_id, role_id, object, object_name, is_deleted);
+
+-- grant-id
+CREATE TABLE IF NOT EXISTS grant_id (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    grant_id BIGINT NOT NULL,
+    grantor_id BIGINT NOT NULL,
+    privilege VARCHAR(128) NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_grant_id_tenant_grantor ON grant_id (tenant_id, grant_id, grantor_id, is_deleted);
+
+-- segments
+CREATE TABLE IF NOT EXISTS segments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    insert_channel VARCHAR(256) NOT NULL,
+    state INT NOT NULL,
+    num_rows BIGINT,
+    segment_info BLOB,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_segments_tenant_id_segment_id ON segments (tenant_id, segment_id);
+CREATE INDEX IF NOT EXISTS idx_segments_tenant_id_collection_id ON segments (tenant_id, collection_id);
+
+-- segment binlogs
+CREATE TABLE IF NOT EXISTS segment_binlogs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    field_id BIGINT NOT NULL,
+    log_type INT NOT NULL,
+    binlogs BLOB,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_segment_binlogs_tenant_id_segment_id_log_type_field_id ON segment_binlogs (tenant_id, segment_id, log_type, field_id);
+
+-- removed channels
+CREATE TABLE IF NOT EXISTS removed_channels (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_removed_channels_tenant_id_channel_name ON removed_channels (tenant_id, channel_name);
+
+-- channel checkpoints
+CREATE TABLE IF NOT EXISTS channel_checkpoints (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    position BLOB,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_channel_checkpoints_tenant_id_channel_name ON channel_checkpoints (tenant_id, channel_name);
+
+-- collection load infos
+CREATE TABLE IF NOT EXISTS collection_load_infos (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    released_partitions TEXT,
+    replica_number INT,
+    status INT,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_collection_load_infos_tenant_id_collection_id ON collection_load_infos (tenant_id, collection_id);
+
+-- partition load infos
+CREATE TABLE IF NOT EXISTS partition_load_infos (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    replica_number INT,
+    status INT,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_partition_load_infos_tenant_id_collection_id_partition_id ON partition_load_infos (tenant_id, collection_id, partition_id);
+
+-- replicas
+CREATE TABLE IF NOT EXISTS replicas (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    replica_id BIGINT NOT NULL,
+    nodes TEXT,
+    is_deleted BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uk_replicas_tenant_id_collection_id_replica_id ON replicas (tenant_id, collection_id, replica_id);
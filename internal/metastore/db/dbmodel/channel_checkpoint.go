@@ -0,0 +1,26 @@
+package dbmodel
+
+import "time"
+
+// ChannelCheckpoint is the position of a vchannel before which all the consumed data is durable.
+type ChannelCheckpoint struct {
+	ID          int64  `gorm:"id"`
+	TenantID    string `gorm:"tenant_id"`
+	ChannelName string `gorm:"channel_name"`
+	// Position is the marshaled internalpb.MsgPosition
+	Position  []byte    `gorm:"position"`
+	IsDeleted bool      `gorm:"is_deleted"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v ChannelCheckpoint) TableName() string {
+	return "channel_checkpoints"
+}
+
+//go:generate mockery --name=IChannelCheckpointDb
+type IChannelCheckpointDb interface {
+	List(tenantID string) ([]*ChannelCheckpoint, error)
+	Upsert(in *ChannelCheckpoint) error
+	MarkDeleted(tenantID string, channelName string) error
+}
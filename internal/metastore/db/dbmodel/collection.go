@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
@@ -23,6 +24,7 @@ type Collection struct {
 	ConsistencyLevel int32              `gorm:"consistency_level"`
 	Status           int32              `gorm:"status"`
 	Ts               typeutil.Timestamp `gorm:"ts"`
+	DropTime         typeutil.Timestamp `gorm:"drop_time"`
 	IsDeleted        bool               `gorm:"is_deleted"`
 	CreatedAt        time.Time          `gorm:"created_at"`
 	UpdatedAt        time.Time          `gorm:"updated_at"`
@@ -64,6 +66,8 @@ func UnmarshalCollectionModel(coll *Collection) (*model.Collection, error) {
 		ShardsNum:        coll.ShardsNum,
 		StartPositions:   startPositions,
 		ConsistencyLevel: commonpb.ConsistencyLevel(coll.ConsistencyLevel),
+		State:            pb.CollectionState(coll.Status),
 		CreateTime:       coll.Ts,
+		DropTime:         coll.DropTime,
 	}, nil
 }
@@ -0,0 +1,96 @@
+package dbmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+type CollectionLoadInfo struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	// ReleasedPartitions is the json encoded ids of the released partitions
+	ReleasedPartitions string    `gorm:"released_partitions"`
+	ReplicaNumber      int32     `gorm:"replica_number"`
+	Status             int32     `gorm:"status"`
+	IsDeleted          bool      `gorm:"is_deleted"`
+	CreatedAt          time.Time `gorm:"created_at"`
+	UpdatedAt          time.Time `gorm:"updated_at"`
+}
+
+func (v CollectionLoadInfo) TableName() string {
+	return "collection_load_infos"
+}
+
+//go:generate mockery --name=ICollectionLoadInfoDb
+type ICollectionLoadInfoDb interface {
+	List(tenantID string) ([]*CollectionLoadInfo, error)
+	Upsert(in *CollectionLoadInfo) error
+	MarkDeleted(tenantID string, collectionID typeutil.UniqueID) error
+}
+
+type PartitionLoadInfo struct {
+	ID            int64     `gorm:"id"`
+	TenantID      string    `gorm:"tenant_id"`
+	CollectionID  int64     `gorm:"collection_id"`
+	PartitionID   int64     `gorm:"partition_id"`
+	ReplicaNumber int32     `gorm:"replica_number"`
+	Status        int32     `gorm:"status"`
+	IsDeleted     bool      `gorm:"is_deleted"`
+	CreatedAt     time.Time `gorm:"created_at"`
+	UpdatedAt     time.Time `gorm:"updated_at"`
+}
+
+func (v PartitionLoadInfo) TableName() string {
+	return "partition_load_infos"
+}
+
+//go:generate mockery --name=IPartitionLoadInfoDb
+type IPartitionLoadInfoDb interface {
+	List(tenantID string) ([]*PartitionLoadInfo, error)
+	Upsert(in []*PartitionLoadInfo) error
+	MarkDeleted(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+}
+
+// model <---> db
+
+func UnmarshalCollectionLoadInfoModel(infos []*CollectionLoadInfo) ([]*querypb.CollectionLoadInfo, error) {
+	result := make([]*querypb.CollectionLoadInfo, 0, len(infos))
+	for _, info := range infos {
+		var releasedPartitions []int64
+		if info.ReleasedPartitions != "" {
+			if err := json.Unmarshal([]byte(info.ReleasedPartitions), &releasedPartitions); err != nil {
+				log.Error("unmarshal released partitions of collection load info failed", zap.Int64("collID", info.CollectionID), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		result = append(result, &querypb.CollectionLoadInfo{
+			CollectionID:       info.CollectionID,
+			ReleasedPartitions: releasedPartitions,
+			ReplicaNumber:      info.ReplicaNumber,
+			Status:             querypb.LoadStatus(info.Status),
+		})
+	}
+
+	return result, nil
+}
+
+func UnmarshalPartitionLoadInfoModel(infos []*PartitionLoadInfo) map[int64][]*querypb.PartitionLoadInfo {
+	result := make(map[int64][]*querypb.PartitionLoadInfo)
+	for _, info := range infos {
+		result[info.CollectionID] = append(result[info.CollectionID], &querypb.PartitionLoadInfo{
+			CollectionID:  info.CollectionID,
+			PartitionID:   info.PartitionID,
+			ReplicaNumber: info.ReplicaNumber,
+			Status:        querypb.LoadStatus(info.Status),
+		})
+	}
+
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -16,6 +17,7 @@ type Partition struct {
 	CollectionID              int64              `gorm:"collection_id"`
 	Status                    int32              `gorm:"status"`
 	Ts                        typeutil.Timestamp `gorm:"ts"`
+	DropTime                  typeutil.Timestamp `gorm:"drop_time"`
 	IsDeleted                 bool               `gorm:"is_deleted"`
 	CreatedAt                 time.Time          `gorm:"created_at"`
 	UpdatedAt                 time.Time          `gorm:"updated_at"`
@@ -50,5 +52,7 @@ func ConvertPartitionDBToModel(partiton *Partition) *model.Partition {
 		PartitionID:               partiton.PartitionID,
 		PartitionName:             partiton.PartitionName,
 		PartitionCreatedTimestamp: partiton.PartitionCreatedTimestamp,
+		State:                     pb.PartitionState(partiton.Status),
+		DropTime:                  partiton.DropTime,
 	}
 }
@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+// RemovedChannel is the remove flag of a vchannel, it's kept until datacoord finishes dropping the channel.
+type RemovedChannel struct {
+	ID          int64     `gorm:"id"`
+	TenantID    string    `gorm:"tenant_id"`
+	ChannelName string    `gorm:"channel_name"`
+	IsDeleted   bool      `gorm:"is_deleted"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v RemovedChannel) TableName() string {
+	return "removed_channels"
+}
+
+//go:generate mockery --name=IRemovedChannelDb
+type IRemovedChannelDb interface {
+	Exist(tenantID string, channelName string) (bool, error)
+	Upsert(in *RemovedChannel) error
+	MarkDeleted(tenantID string, channelName string) error
+}
@@ -0,0 +1,59 @@
+package dbmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+type Replica struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	ReplicaID    int64  `gorm:"replica_id"`
+	// Nodes is the json encoded ids of the query nodes
+	Nodes     string    `gorm:"nodes"`
+	IsDeleted bool      `gorm:"is_deleted"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v Replica) TableName() string {
+	return "replicas"
+}
+
+//go:generate mockery --name=IReplicaDb
+type IReplicaDb interface {
+	List(tenantID string) ([]*Replica, error)
+	Upsert(in *Replica) error
+	MarkDeleted(tenantID string, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) error
+	MarkDeletedByCollectionID(tenantID string, collectionID typeutil.UniqueID) error
+}
+
+// model <---> db
+
+func UnmarshalReplicaModel(replicas []*Replica) ([]*querypb.Replica, error) {
+	result := make([]*querypb.Replica, 0, len(replicas))
+	for _, replica := range replicas {
+		var nodes []int64
+		if replica.Nodes != "" {
+			if err := json.Unmarshal([]byte(replica.Nodes), &nodes); err != nil {
+				log.Error("unmarshal nodes of replica failed", zap.Int64("collID", replica.CollectionID),
+					zap.Int64("replicaID", replica.ReplicaID), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		result = append(result, &querypb.Replica{
+			ID:           replica.ReplicaID,
+			CollectionID: replica.CollectionID,
+			Nodes:        nodes,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Segment struct {
+	ID            int64  `gorm:"id"`
+	TenantID      string `gorm:"tenant_id"`
+	CollectionID  int64  `gorm:"collection_id"`
+	PartitionID   int64  `gorm:"partition_id"`
+	SegmentID     int64  `gorm:"segment_id"`
+	InsertChannel string `gorm:"insert_channel"`
+	State         int32  `gorm:"state"`
+	NumRows       int64  `gorm:"num_rows"`
+	// SegmentInfo is the marshaled datapb.SegmentInfo without binlogs, binlogs are kept in segment_binlogs
+	SegmentInfo []byte    `gorm:"segment_info"`
+	IsDeleted   bool      `gorm:"is_deleted"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v Segment) TableName() string {
+	return "segments"
+}
+
+//go:generate mockery --name=ISegmentDb
+type ISegmentDb interface {
+	List(tenantID string) ([]*Segment, error)
+	Upsert(in []*Segment) error
+	MarkDeleted(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
@@ -0,0 +1,33 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type SegmentBinlog struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	PartitionID  int64  `gorm:"partition_id"`
+	SegmentID    int64  `gorm:"segment_id"`
+	FieldID      int64  `gorm:"field_id"`
+	LogType      int32  `gorm:"log_type"`
+	// Binlogs is the marshaled datapb.FieldBinlog of the field, log paths included
+	Binlogs   []byte    `gorm:"binlogs"`
+	IsDeleted bool      `gorm:"is_deleted"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v SegmentBinlog) TableName() string {
+	return "segment_binlogs"
+}
+
+//go:generate mockery --name=ISegmentBinlogDb
+type ISegmentBinlogDb interface {
+	List(tenantID string) ([]*SegmentBinlog, error)
+	Upsert(in []*SegmentBinlog) error
+	MarkDeletedBySegmentIDs(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
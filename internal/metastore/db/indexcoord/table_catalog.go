@@ -150,7 +150,7 @@ func (tc *Catalog) AlterIndexes(ctx context.Context, indexes []*model.Index) err
 				CreateTime:   index.CreateTime,
 				IsDeleted:    index.IsDeleted,
 			}
-			err = tc.metaDomain.IndexDb(ctx).Update(idx)
+			err = tc.metaDomain.IndexDb(txCtx).Update(idx)
 			if err != nil {
 				return err
 			}
@@ -295,7 +295,7 @@ func (tc *Catalog) AlterSegmentIndexes(ctx context.Context, segIdxes []*model.Se
 				IndexSize:      segIndex.IndexSize,
 				IsDeleted:      segIndex.IsDeleted,
 			}
-			err = tc.metaDomain.SegmentIndexDb(ctx).Update(idx)
+			err = tc.metaDomain.SegmentIndexDb(txCtx).Update(idx)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,247 @@
+package indexcoord
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	tenantID      = "test_tenant"
+	collID1       = typeutil.UniqueID(101)
+	partitionID1  = typeutil.UniqueID(500)
+	fieldID1      = typeutil.UniqueID(1000)
+	indexID1      = typeutil.UniqueID(1500)
+	segmentID1    = typeutil.UniqueID(2000)
+	indexBuildID1 = typeutil.UniqueID(3000)
+)
+
+// TableCatalogSuite runs the index catalog against a real database opened by dbcore,
+// so the catalog is tested with the real dao and sql instead of the mocked meta domain.
+type TableCatalogSuite struct {
+	suite.Suite
+
+	// sqlitePath is the database of the suite, a file path means a new file in a temp dir for each case.
+	sqlitePath string
+	ctx        context.Context
+	catalog    *Catalog
+}
+
+func (s *TableCatalogSuite) SetupTest() {
+	path := s.sqlitePath
+	if path != dbcore.SqliteMemoryPath {
+		path = filepath.Join(s.T().TempDir(), path)
+	}
+	// start from an empty database
+	dbcore.SetGlobalDB(nil)
+	err := dbcore.Connect(&paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: path})
+	s.Require().NoError(err)
+	s.ctx = contextutil.WithTenantID(context.Background(), tenantID)
+	s.catalog = NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain())
+}
+
+func (s *TableCatalogSuite) TearDownTest() {
+	db, err := dbcore.GetDB(s.ctx).DB()
+	s.Require().NoError(err)
+	s.NoError(db.Close())
+	dbcore.SetGlobalDB(nil)
+}
+
+func TestTableCatalog_Sqlite(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		suite.Run(t, &TableCatalogSuite{sqlitePath: dbcore.SqliteMemoryPath})
+	})
+	t.Run("file", func(t *testing.T) {
+		suite.Run(t, &TableCatalogSuite{sqlitePath: "meta.db"})
+	})
+}
+
+func newIndex(indexID typeutil.UniqueID) *model.Index {
+	return &model.Index{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		FieldID:      fieldID1,
+		IndexID:      indexID,
+		IndexName:    "test_index_name",
+		CreateTime:   10,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: "dim", Value: "128"},
+		},
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "IVF_FLAT"},
+			{Key: "metric_type", Value: "L2"},
+		},
+	}
+}
+
+func newSegmentIndex(segmentID typeutil.UniqueID, buildID typeutil.UniqueID) *model.SegmentIndex {
+	return &model.SegmentIndex{
+		SegmentID:    segmentID,
+		CollectionID: collID1,
+		PartitionID:  partitionID1,
+		NumRows:      1024,
+		IndexID:      indexID1,
+		BuildID:      buildID,
+		NodeID:       1,
+		IndexVersion: 1,
+		IndexState:   commonpb.IndexState_InProgress,
+		CreateTime:   10,
+	}
+}
+
+func (s *TableCatalogSuite) TestCreateIndex() {
+	index := newIndex(indexID1)
+	s.NoError(s.catalog.CreateIndex(s.ctx, index))
+
+	indexes, err := s.catalog.ListIndexes(s.ctx)
+	s.NoError(err)
+	s.Require().Equal(1, len(indexes))
+	s.Equal(collID1, indexes[0].CollectionID)
+	s.Equal(fieldID1, indexes[0].FieldID)
+	s.Equal(indexID1, indexes[0].IndexID)
+	s.Equal(index.IndexName, indexes[0].IndexName)
+	s.Equal(index.CreateTime, indexes[0].CreateTime)
+	s.Equal(index.TypeParams, indexes[0].TypeParams)
+	s.Equal(index.IndexParams, indexes[0].IndexParams)
+	s.False(indexes[0].IsDeleted)
+}
+
+func (s *TableCatalogSuite) TestListIndexes() {
+	indexes, err := s.catalog.ListIndexes(s.ctx)
+	s.NoError(err)
+	s.Equal(0, len(indexes))
+
+	s.Require().NoError(s.catalog.CreateIndex(s.ctx, newIndex(indexID1)))
+	s.Require().NoError(s.catalog.CreateIndex(s.ctx, newIndex(indexID1+1)))
+
+	indexes, err = s.catalog.ListIndexes(s.ctx)
+	s.NoError(err)
+	s.Equal(2, len(indexes))
+
+	// indexes are isolated by tenant
+	indexes, err = s.catalog.ListIndexes(contextutil.WithTenantID(context.Background(), "other_tenant"))
+	s.NoError(err)
+	s.Equal(0, len(indexes))
+}
+
+func (s *TableCatalogSuite) TestAlterIndex() {
+	index := newIndex(indexID1)
+	s.Require().NoError(s.catalog.CreateIndex(s.ctx, index))
+
+	index.IsDeleted = true
+	index.CreateTime = 20
+	s.NoError(s.catalog.AlterIndex(s.ctx, index))
+
+	indexes, err := s.catalog.ListIndexes(s.ctx)
+	s.NoError(err)
+	s.Require().Equal(1, len(indexes))
+	s.True(indexes[0].IsDeleted)
+	s.Equal(uint64(20), indexes[0].CreateTime)
+}
+
+func (s *TableCatalogSuite) TestAlterIndexes() {
+	indexes := []*model.Index{newIndex(indexID1), newIndex(indexID1 + 1)}
+	for _, index := range indexes {
+		s.Require().NoError(s.catalog.CreateIndex(s.ctx, index))
+		index.CreateTime = 20
+	}
+	s.NoError(s.catalog.AlterIndexes(s.ctx, indexes))
+
+	got, err := s.catalog.ListIndexes(s.ctx)
+	s.NoError(err)
+	s.Require().Equal(2, len(got))
+	for _, index := range got {
+		s.Equal(uint64(20), index.CreateTime)
+	}
+}
+
+func (s *TableCatalogSuite) TestDropIndex() {
+	s.Require().NoError(s.catalog.CreateIndex(s.ctx, newIndex(indexID1)))
+	s.Require().NoError(s.catalog.CreateIndex(s.ctx, newIndex(indexID1+1)))
+
+	s.NoError(s.catalog.DropIndex(s.ctx, collID1, indexID1))
+
+	indexes, err := s.catalog.ListIndexes(s.ctx)
+	s.NoError(err)
+	s.Require().Equal(2, len(indexes))
+	for _, index := range indexes {
+		s.Equal(index.IndexID == indexID1, index.IsDeleted)
+	}
+}
+
+func (s *TableCatalogSuite) TestCreateSegmentIndex() {
+	segIdx := newSegmentIndex(segmentID1, indexBuildID1)
+	s.NoError(s.catalog.CreateSegmentIndex(s.ctx, segIdx))
+
+	segIdxes, err := s.catalog.ListSegmentIndexes(s.ctx)
+	s.NoError(err)
+	s.Equal([]*model.SegmentIndex{segIdx}, segIdxes)
+
+	// an index is built only once for a segment
+	s.Error(s.catalog.CreateSegmentIndex(s.ctx, newSegmentIndex(segmentID1, indexBuildID1+1)))
+}
+
+func (s *TableCatalogSuite) TestListSegmentIndexes() {
+	segIdxes, err := s.catalog.ListSegmentIndexes(s.ctx)
+	s.NoError(err)
+	s.Equal(0, len(segIdxes))
+
+	s.Require().NoError(s.catalog.CreateSegmentIndex(s.ctx, newSegmentIndex(segmentID1, indexBuildID1)))
+	s.Require().NoError(s.catalog.CreateSegmentIndex(s.ctx, newSegmentIndex(segmentID1+1, indexBuildID1+1)))
+
+	segIdxes, err = s.catalog.ListSegmentIndexes(s.ctx)
+	s.NoError(err)
+	s.Equal(2, len(segIdxes))
+}
+
+func (s *TableCatalogSuite) TestAlterSegmentIndex() {
+	segIdx := newSegmentIndex(segmentID1, indexBuildID1)
+	s.Require().NoError(s.catalog.CreateSegmentIndex(s.ctx, segIdx))
+
+	segIdx.IndexState = commonpb.IndexState_Finished
+	segIdx.IndexFilePaths = []string{"file1", "file2"}
+	segIdx.IndexSize = 1024
+	s.NoError(s.catalog.AlterSegmentIndex(s.ctx, segIdx))
+
+	segIdxes, err := s.catalog.ListSegmentIndexes(s.ctx)
+	s.NoError(err)
+	s.Equal([]*model.SegmentIndex{segIdx}, segIdxes)
+}
+
+func (s *TableCatalogSuite) TestAlterSegmentIndexes() {
+	segIdxes := []*model.SegmentIndex{newSegmentIndex(segmentID1, indexBuildID1), newSegmentIndex(segmentID1+1, indexBuildID1+1)}
+	for _, segIdx := range segIdxes {
+		s.Require().NoError(s.catalog.CreateSegmentIndex(s.ctx, segIdx))
+		segIdx.IndexState = commonpb.IndexState_Failed
+		segIdx.FailReason = "fail reason"
+	}
+	s.NoError(s.catalog.AlterSegmentIndexes(s.ctx, segIdxes))
+
+	got, err := s.catalog.ListSegmentIndexes(s.ctx)
+	s.NoError(err)
+	s.ElementsMatch(segIdxes, got)
+}
+
+func (s *TableCatalogSuite) TestDropSegmentIndex() {
+	s.Require().NoError(s.catalog.CreateSegmentIndex(s.ctx, newSegmentIndex(segmentID1, indexBuildID1)))
+	s.Require().NoError(s.catalog.CreateSegmentIndex(s.ctx, newSegmentIndex(segmentID1+1, indexBuildID1+1)))
+
+	s.NoError(s.catalog.DropSegmentIndex(s.ctx, collID1, partitionID1, segmentID1, indexBuildID1))
+
+	segIdxes, err := s.catalog.ListSegmentIndexes(s.ctx)
+	s.NoError(err)
+	s.Require().Equal(2, len(segIdxes))
+	for _, segIdx := range segIdxes {
+		s.Equal(segIdx.BuildID == indexBuildID1, segIdx.IsDeleted)
+	}
+}
@@ -0,0 +1,166 @@
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+)
+
+// Catalog keeps the load meta of QueryCoord in the database.
+// Handoff events are produced by IndexCoord in the meta kv, so they are still removed from cli.
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+	cli        kv.MetaKv
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain, cli kv.MetaKv) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+		cli:        cli,
+	}
+}
+
+func (tc *Catalog) SaveCollection(info *querypb.CollectionLoadInfo) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	releasedPartitions, err := json.Marshal(info.GetReleasedPartitions())
+	if err != nil {
+		log.Error("marshal released partitions of collection load info failed", zap.String("tenant", tenantID),
+			zap.Int64("collID", info.GetCollectionID()), zap.Error(err))
+		return err
+	}
+
+	return tc.metaDomain.CollectionLoadInfoDb(ctx).Upsert(&dbmodel.CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       info.GetCollectionID(),
+		ReleasedPartitions: string(releasedPartitions),
+		ReplicaNumber:      info.GetReplicaNumber(),
+		Status:             int32(info.GetStatus()),
+	})
+}
+
+func (tc *Catalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
+	if len(info) == 0 {
+		return nil
+	}
+
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	partitions := make([]*dbmodel.PartitionLoadInfo, 0, len(info))
+	for _, partition := range info {
+		partitions = append(partitions, &dbmodel.PartitionLoadInfo{
+			TenantID:      tenantID,
+			CollectionID:  partition.GetCollectionID(),
+			PartitionID:   partition.GetPartitionID(),
+			ReplicaNumber: partition.GetReplicaNumber(),
+			Status:        int32(partition.GetStatus()),
+		})
+	}
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).Upsert(partitions)
+}
+
+func (tc *Catalog) SaveReplica(replica *querypb.Replica) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	nodes, err := json.Marshal(replica.GetNodes())
+	if err != nil {
+		log.Error("marshal nodes of replica failed", zap.String("tenant", tenantID), zap.Int64("collID", replica.GetCollectionID()),
+			zap.Int64("replicaID", replica.GetID()), zap.Error(err))
+		return err
+	}
+
+	return tc.metaDomain.ReplicaDb(ctx).Upsert(&dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: replica.GetCollectionID(),
+		ReplicaID:    replica.GetID(),
+		Nodes:        string(nodes),
+	})
+}
+
+func (tc *Catalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	infos, err := tc.metaDomain.CollectionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmodel.UnmarshalCollectionLoadInfoModel(infos)
+}
+
+func (tc *Catalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	infos, err := tc.metaDomain.PartitionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmodel.UnmarshalPartitionLoadInfoModel(infos), nil
+}
+
+func (tc *Catalog) GetReplicas() ([]*querypb.Replica, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	replicas, err := tc.metaDomain.ReplicaDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmodel.UnmarshalReplicaModel(replicas)
+}
+
+func (tc *Catalog) ReleaseCollection(id int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.CollectionLoadInfoDb(ctx).MarkDeleted(tenantID, id)
+}
+
+func (tc *Catalog) ReleasePartition(collection int64, partitions ...int64) error {
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).MarkDeleted(tenantID, collection, partitions)
+}
+
+func (tc *Catalog) ReleaseReplicas(collectionID int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).MarkDeletedByCollectionID(tenantID, collectionID)
+}
+
+func (tc *Catalog) ReleaseReplica(collection, replica int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).MarkDeleted(tenantID, collection, replica)
+}
+
+func (tc *Catalog) RemoveHandoffEvent(info *querypb.SegmentInfo) error {
+	key := fmt.Sprintf("%s/%d/%d/%d", util.HandoffSegmentPrefix, info.GetCollectionID(), info.GetPartitionID(), info.GetSegmentID())
+	return tc.cli.Remove(key)
+}
@@ -0,0 +1,134 @@
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type mockMetaKv struct {
+	kv.MetaKv
+	removed []string
+}
+
+func (m *mockMetaKv) Remove(key string) error {
+	m.removed = append(m.removed, key)
+	return nil
+}
+
+func newSqliteCatalog(t *testing.T) (*Catalog, *mockMetaKv) {
+	// start from an empty database
+	dbcore.SetGlobalDB(nil)
+	err := dbcore.Connect(&paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: dbcore.SqliteMemoryPath})
+	require.NoError(t, err)
+	cli := &mockMetaKv{}
+	return NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), cli), cli
+}
+
+func TestTableCatalog_Collection(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+
+	info := &querypb.CollectionLoadInfo{
+		CollectionID:  1,
+		ReplicaNumber: 2,
+		Status:        querypb.LoadStatus_Loading,
+	}
+	require.NoError(t, catalog.SaveCollection(info))
+	require.NoError(t, catalog.SaveCollection(&querypb.CollectionLoadInfo{CollectionID: 2, ReplicaNumber: 1}))
+
+	info.ReleasedPartitions = []int64{10, 11}
+	info.Status = querypb.LoadStatus_Loaded
+	require.NoError(t, catalog.SaveCollection(info))
+
+	collections, err := catalog.GetCollections()
+	require.NoError(t, err)
+	assert.Len(t, collections, 2)
+	for _, collection := range collections {
+		if collection.GetCollectionID() == 1 {
+			assert.Equal(t, info, collection)
+		}
+	}
+
+	require.NoError(t, catalog.ReleaseCollection(1))
+	collections, err = catalog.GetCollections()
+	require.NoError(t, err)
+	assert.Len(t, collections, 1)
+	assert.Equal(t, int64(2), collections[0].GetCollectionID())
+
+	// loading a released collection again
+	require.NoError(t, catalog.SaveCollection(info))
+	collections, err = catalog.GetCollections()
+	require.NoError(t, err)
+	assert.Len(t, collections, 2)
+}
+
+func TestTableCatalog_Partition(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+
+	require.NoError(t, catalog.SavePartition())
+	require.NoError(t, catalog.SavePartition(
+		&querypb.PartitionLoadInfo{CollectionID: 1, PartitionID: 10, ReplicaNumber: 1, Status: querypb.LoadStatus_Loading},
+		&querypb.PartitionLoadInfo{CollectionID: 1, PartitionID: 11, ReplicaNumber: 1, Status: querypb.LoadStatus_Loading},
+		&querypb.PartitionLoadInfo{CollectionID: 2, PartitionID: 20, ReplicaNumber: 1, Status: querypb.LoadStatus_Loaded},
+	))
+	loaded := &querypb.PartitionLoadInfo{CollectionID: 1, PartitionID: 10, ReplicaNumber: 1, Status: querypb.LoadStatus_Loaded}
+	require.NoError(t, catalog.SavePartition(loaded))
+
+	partitions, err := catalog.GetPartitions()
+	require.NoError(t, err)
+	assert.Len(t, partitions, 2)
+	assert.Len(t, partitions[1], 2)
+	assert.Len(t, partitions[2], 1)
+	assert.Contains(t, partitions[1], loaded)
+
+	require.NoError(t, catalog.ReleasePartition(1))
+	require.NoError(t, catalog.ReleasePartition(1, 10, 11))
+	partitions, err = catalog.GetPartitions()
+	require.NoError(t, err)
+	assert.Len(t, partitions, 1)
+	assert.Len(t, partitions[2], 1)
+}
+
+func TestTableCatalog_Replica(t *testing.T) {
+	catalog, _ := newSqliteCatalog(t)
+
+	replica := &querypb.Replica{ID: 100, CollectionID: 1, Nodes: []int64{1, 2}}
+	require.NoError(t, catalog.SaveReplica(replica))
+	require.NoError(t, catalog.SaveReplica(&querypb.Replica{ID: 101, CollectionID: 1, Nodes: []int64{3}}))
+	require.NoError(t, catalog.SaveReplica(&querypb.Replica{ID: 200, CollectionID: 2}))
+
+	replica.Nodes = []int64{1, 2, 4}
+	require.NoError(t, catalog.SaveReplica(replica))
+
+	replicas, err := catalog.GetReplicas()
+	require.NoError(t, err)
+	assert.Len(t, replicas, 3)
+	assert.Contains(t, replicas, replica)
+
+	require.NoError(t, catalog.ReleaseReplica(1, 101))
+	replicas, err = catalog.GetReplicas()
+	require.NoError(t, err)
+	assert.Len(t, replicas, 2)
+
+	require.NoError(t, catalog.ReleaseReplicas(1))
+	replicas, err = catalog.GetReplicas()
+	require.NoError(t, err)
+	assert.Len(t, replicas, 1)
+	assert.Equal(t, int64(200), replicas[0].GetID())
+}
+
+func TestTableCatalog_RemoveHandoffEvent(t *testing.T) {
+	catalog, cli := newSqliteCatalog(t)
+
+	err := catalog.RemoveHandoffEvent(&querypb.SegmentInfo{CollectionID: 1, PartitionID: 2, SegmentID: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{util.HandoffSegmentPrefix + "/1/2/3"}, cli.removed)
+}
@@ -63,6 +63,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Colle
 			ConsistencyLevel: int32(collection.ConsistencyLevel),
 			Status:           int32(collection.State),
 			Ts:               ts,
+			DropTime:         collection.DropTime,
 		})
 		if err != nil {
 			return err
@@ -116,7 +117,9 @@ func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Colle
 				PartitionName:             partition.PartitionName,
 				PartitionCreatedTimestamp: partition.PartitionCreatedTimestamp,
 				CollectionID:              collection.CollectionID,
+				Status:                    int32(partition.State),
 				Ts:                        ts,
+				DropTime:                  partition.DropTime,
 			}
 			partitions = append(partitions, p)
 		}
@@ -231,7 +234,7 @@ func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 // [collection3, t3, is_deleted=false]
 // t1, t2, t3 are the largest timestamp that less than or equal to @param ts
 // the final result will only return collection2 and collection3 since collection1 is deleted
-func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) ([]*model.Collection, error) {
 	tenantID := contextutil.TenantID(ctx)
 
 	// 1. find each collection_id with latest ts <= @param ts
@@ -240,7 +243,7 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, err
 	}
 	if len(cidTsPairs) == 0 {
-		return map[string]*model.Collection{}, nil
+		return []*model.Collection{}, nil
 	}
 
 	// 2. populate each collection
@@ -266,12 +269,7 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, err
 	}
 
-	r := map[string]*model.Collection{}
-	for _, c := range collections {
-		r[c.Name] = c
-	}
-
-	return r, nil
+	return collections, nil
 }
 
 func (tc *Catalog) CollectionExists(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) bool {
@@ -395,9 +393,20 @@ func (tc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 		startPositionsStr = string(startPositionsBytes)
 	}
 
-	createdAt, _ := tsoutil.ParseTS(newColl.CreateTime)
+	// fields, partitions and channels are bound to the ts of the collection record, so the record is updated in place
 	tenantID := contextutil.TenantID(ctx)
+	cidTsPair, err := tc.metaDomain.CollectionDb(ctx).GetCollectionIDTs(tenantID, newColl.CollectionID, ts)
+	if err != nil {
+		return err
+	}
+	current, err := tc.metaDomain.CollectionDb(ctx).Get(tenantID, newColl.CollectionID, cidTsPair.Ts)
+	if err != nil {
+		return err
+	}
+
+	createdAt, _ := tsoutil.ParseTS(newColl.CreateTime)
 	coll := &dbmodel.Collection{
+		ID:               current.ID,
 		TenantID:         tenantID,
 		CollectionID:     newColl.CollectionID,
 		CollectionName:   newColl.Name,
@@ -407,7 +416,8 @@ func (tc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 		StartPosition:    startPositionsStr,
 		ConsistencyLevel: int32(newColl.ConsistencyLevel),
 		Status:           int32(newColl.State),
-		Ts:               ts,
+		Ts:               current.Ts,
+		DropTime:         newColl.DropTime,
 		CreatedAt:        createdAt,
 		UpdatedAt:        time.Now(),
 	}
@@ -433,6 +443,7 @@ func (tc *Catalog) CreatePartition(ctx context.Context, partition *model.Partiti
 		CollectionID:              partition.CollectionID,
 		Status:                    int32(partition.State),
 		Ts:                        ts,
+		DropTime:                  partition.DropTime,
 	}
 	err := tc.metaDomain.PartitionDb(ctx).Insert([]*dbmodel.Partition{p})
 	if err != nil {
@@ -472,6 +483,7 @@ func (tc *Catalog) alterModifyPartition(ctx context.Context, oldPart *model.Part
 		CollectionID:              newPart.CollectionID,
 		Status:                    int32(newPart.State),
 		Ts:                        ts,
+		DropTime:                  newPart.DropTime,
 		IsDeleted:                 false,
 		CreatedAt:                 createdAt,
 		UpdatedAt:                 time.Now(),
@@ -543,7 +555,7 @@ func (tc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeut
 func (tc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Alias, error) {
 	tenantID := contextutil.TenantID(ctx)
 
-	// 1. find each collection with latest ts
+	// 1. find each alias with latest ts
 	cidTsPairs, err := tc.metaDomain.CollAliasDb(ctx).ListCollectionIDTs(tenantID, ts)
 	if err != nil {
 		log.Error("list latest ts and corresponding collectionID in collection_aliases failed", zap.Uint64("ts", ts), zap.Error(err))
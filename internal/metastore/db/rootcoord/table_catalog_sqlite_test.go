@@ -0,0 +1,866 @@
+package rootcoord
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/suite"
+)
+
+// TableCatalogSuite runs the cases of table_catalog_test.go against a real database opened by dbcore,
+// so the catalog is tested with the real dao and sql instead of the mocked meta domain.
+// The cases injecting dao errors are only covered by the mocked tests.
+type TableCatalogSuite struct {
+	suite.Suite
+
+	// sqlitePath is the database of the suite, a file path means a new file in a temp dir for each case.
+	sqlitePath string
+	catalog    *Catalog
+}
+
+func (s *TableCatalogSuite) SetupTest() {
+	path := s.sqlitePath
+	if path != dbcore.SqliteMemoryPath {
+		path = filepath.Join(s.T().TempDir(), path)
+	}
+	// start from an empty database
+	dbcore.SetGlobalDB(nil)
+	err := dbcore.Connect(&paramtable.MetaDBConfig{Driver: util.MetaStoreTypeSqlite, SqlitePath: path})
+	s.Require().NoError(err)
+	s.catalog = NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain())
+}
+
+func (s *TableCatalogSuite) TearDownTest() {
+	db, err := dbcore.GetDB(ctx).DB()
+	s.Require().NoError(err)
+	s.NoError(db.Close())
+	dbcore.SetGlobalDB(nil)
+}
+
+func TestTableCatalog_Sqlite(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		suite.Run(t, &TableCatalogSuite{sqlitePath: dbcore.SqliteMemoryPath})
+	})
+	t.Run("file", func(t *testing.T) {
+		suite.Run(t, &TableCatalogSuite{sqlitePath: "meta.db"})
+	})
+}
+
+func (s *TableCatalogSuite) newCollection() *model.Collection {
+	return &model.Collection{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		Name:         collName1,
+		ShardsNum:    2,
+		State:        pb.CollectionState_CollectionCreated,
+		Fields: []*model.Field{
+			{
+				FieldID:      fieldID1,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  fieldID1 + 1,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "128"},
+				},
+				IndexParams: []*commonpb.KeyValuePair{
+					{Key: "index_type", Value: "IVF_FLAT"},
+				},
+			},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: partitionID1, PartitionName: "_default", PartitionCreatedTimestamp: ts},
+		},
+		StartPositions: []*commonpb.KeyDataPair{
+			{Key: "dml_0", Data: []byte("position")},
+		},
+		VirtualChannelNames:  []string{"dml_0_101v0", "dml_1_101v1"},
+		PhysicalChannelNames: []string{"dml_0", "dml_1"},
+		Aliases:              []string{collAlias1},
+	}
+}
+
+func (s *TableCatalogSuite) checkCollection(expected *model.Collection, c *model.Collection) {
+	s.Equal(expected.CollectionID, c.CollectionID)
+	s.Equal(expected.Name, c.Name)
+	s.Equal(expected.ShardsNum, c.ShardsNum)
+	s.Equal(ts, c.CreateTime)
+	s.Equal(expected.StartPositions, c.StartPositions)
+	s.Require().Equal(len(expected.Fields), len(c.Fields))
+	for _, field := range c.Fields {
+		if field.Name == "vec" {
+			s.Equal("128", field.TypeParams[0].GetValue())
+			s.Equal("IVF_FLAT", field.IndexParams[0].GetValue())
+		}
+	}
+	s.Require().Equal(1, len(c.Partitions))
+	s.Equal(partitionID1, c.Partitions[0].PartitionID)
+	s.Equal("_default", c.Partitions[0].PartitionName)
+	s.ElementsMatch(expected.VirtualChannelNames, c.VirtualChannelNames)
+	s.ElementsMatch(expected.PhysicalChannelNames, c.PhysicalChannelNames)
+}
+
+func (s *TableCatalogSuite) TestCreateCollection() {
+	coll := s.newCollection()
+	s.NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	// creating a collection without fields, partitions and channels
+	s.NoError(s.catalog.CreateCollection(ctx, &model.Collection{TenantID: tenantID, CollectionID: collID1 + 1, Name: "empty"}, ts))
+	c, err := s.catalog.GetCollectionByID(ctx, collID1+1, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Equal("empty", c.Name)
+	s.Empty(c.Fields)
+	s.Empty(c.Partitions)
+}
+
+func (s *TableCatalogSuite) TestGetCollectionByID() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	c, err := s.catalog.GetCollectionByID(ctx, collID1, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.checkCollection(coll, c)
+
+	c, err = s.catalog.GetCollectionByID(ctx, collID1, ts)
+	s.Require().NoError(err)
+	s.checkCollection(coll, c)
+
+	_, err = s.catalog.GetCollectionByID(ctx, collID1, ts-1)
+	s.Error(err)
+	_, err = s.catalog.GetCollectionByID(ctx, collID1+1, typeutil.MaxTimestamp)
+	s.Error(err)
+}
+
+func (s *TableCatalogSuite) TestGetCollectionByName() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	c, err := s.catalog.GetCollectionByName(ctx, collName1, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.checkCollection(coll, c)
+
+	_, err = s.catalog.GetCollectionByName(ctx, collName1, ts-1)
+	s.Error(err)
+	_, err = s.catalog.GetCollectionByName(ctx, "not_exist", typeutil.MaxTimestamp)
+	s.Error(err)
+}
+
+func (s *TableCatalogSuite) TestListCollections() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	colls, err := s.catalog.ListCollections(ctx, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(colls))
+	s.checkCollection(coll, colls[0])
+
+	colls, err = s.catalog.ListCollections(ctx, ts-1)
+	s.NoError(err)
+	s.Equal(0, len(colls))
+
+	// dropped collections are not listed, even a collection is created at the same ts
+	s.Require().NoError(s.catalog.DropCollection(ctx, coll, ts+1))
+	s.Require().NoError(s.catalog.CreateCollection(ctx, &model.Collection{TenantID: tenantID, CollectionID: collID1 + 1, Name: "other"}, ts+1))
+	colls, err = s.catalog.ListCollections(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Require().Equal(1, len(colls))
+	s.Equal(collID1+1, colls[0].CollectionID)
+}
+
+func (s *TableCatalogSuite) TestCollectionExists() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	s.True(s.catalog.CollectionExists(ctx, collID1, typeutil.MaxTimestamp))
+	s.True(s.catalog.CollectionExists(ctx, collID1, ts))
+	s.False(s.catalog.CollectionExists(ctx, collID1, ts-1))
+}
+
+func (s *TableCatalogSuite) TestCollectionExists_IsDeletedTrue() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+	s.Require().NoError(s.catalog.DropCollection(ctx, coll, ts+1))
+
+	s.False(s.catalog.CollectionExists(ctx, collID1, typeutil.MaxTimestamp))
+	// time travel
+	s.True(s.catalog.CollectionExists(ctx, collID1, ts))
+}
+
+func (s *TableCatalogSuite) TestCollectionExists_CollNotExists() {
+	s.False(s.catalog.CollectionExists(ctx, collID1, typeutil.MaxTimestamp))
+}
+
+func (s *TableCatalogSuite) TestDropCollection_TsNot0() {
+	coll := s.newCollection()
+	coll.Aliases = []string{collAlias1, collAlias2}
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+	for _, alias := range coll.Aliases {
+		s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: alias, CollectionID: collID1}, ts))
+	}
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: "other_alias", CollectionID: collID1 + 1}, ts))
+
+	s.NoError(s.catalog.DropCollection(ctx, coll, ts+1))
+
+	_, err := s.catalog.GetCollectionByID(ctx, collID1, typeutil.MaxTimestamp)
+	s.Error(err)
+	_, err = s.catalog.GetCollectionByName(ctx, collName1, typeutil.MaxTimestamp)
+	s.Error(err)
+	aliases, err := s.catalog.ListAliases(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Equal([]*model.Alias{{Name: "other_alias", CollectionID: collID1 + 1}}, aliases)
+	partitions, err := dao.NewMetaDomain().PartitionDb(ctx).GetByCollectionID(tenantID, collID1, ts+1)
+	s.NoError(err)
+	s.Equal(0, len(partitions))
+
+	// time travel
+	c, err := s.catalog.GetCollectionByID(ctx, collID1, ts)
+	s.Require().NoError(err)
+	s.checkCollection(coll, c)
+	aliases, err = s.catalog.ListAliases(ctx, ts)
+	s.NoError(err)
+	s.Equal(3, len(aliases))
+}
+
+func (s *TableCatalogSuite) TestAlterCollection() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	newColl := s.newCollection()
+	newColl.State = pb.CollectionState_CollectionDropping
+	s.NoError(s.catalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts+1))
+
+	c, err := s.catalog.GetCollectionByID(ctx, collID1, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.Equal(pb.CollectionState_CollectionDropping, c.State)
+	s.checkCollection(coll, c)
+	colls, err := s.catalog.ListCollections(ctx, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(colls))
+	s.Equal(pb.CollectionState_CollectionDropping, colls[0].State)
+
+	newColl.CollectionID = collID1 + 1
+	s.Error(s.catalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts+1))
+}
+
+func (s *TableCatalogSuite) TestAlterCollection_Recycle() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	dropTime := ts + 100
+	newColl := s.newCollection()
+	newColl.State = pb.CollectionState_CollectionRecycled
+	newColl.DropTime = dropTime
+	s.NoError(s.catalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts+1))
+
+	// the drop time survives a reload, or the recycle bin would purge the collection at once
+	c, err := s.catalog.GetCollectionByID(ctx, collID1, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.True(c.Recycled())
+	s.Equal(dropTime, c.DropTime)
+	colls, err := s.catalog.ListCollections(ctx, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(colls))
+	s.Equal(dropTime, colls[0].DropTime)
+
+	// restored
+	restored := s.newCollection()
+	s.NoError(s.catalog.AlterCollection(ctx, newColl, restored, metastore.MODIFY, ts+2))
+	c, err = s.catalog.GetCollectionByID(ctx, collID1, typeutil.MaxTimestamp)
+	s.Require().NoError(err)
+	s.False(c.Recycled())
+	s.Zero(c.DropTime)
+}
+
+func (s *TableCatalogSuite) TestAlterCollection_TsNot0_AlterTypeError() {
+	coll := s.newCollection()
+	s.Require().NoError(s.catalog.CreateCollection(ctx, coll, ts))
+
+	s.Error(s.catalog.AlterCollection(ctx, coll, coll, metastore.ADD, ts))
+	s.Error(s.catalog.AlterCollection(ctx, coll, coll, metastore.DELETE, ts))
+}
+
+func (s *TableCatalogSuite) TestCreatePartition() {
+	partition := &model.Partition{
+		PartitionID:               partitionID1,
+		PartitionName:             "partition",
+		PartitionCreatedTimestamp: ts,
+		CollectionID:              collID1,
+	}
+	s.NoError(s.catalog.CreatePartition(ctx, partition, ts))
+
+	partitions, err := dao.NewMetaDomain().PartitionDb(ctx).GetByCollectionID(tenantID, collID1, ts)
+	s.NoError(err)
+	s.Require().Equal(1, len(partitions))
+	s.Equal("partition", partitions[0].PartitionName)
+	s.Equal(ts, partitions[0].PartitionCreatedTimestamp)
+}
+
+func (s *TableCatalogSuite) TestDropPartition_TsNot0() {
+	partition := &model.Partition{
+		PartitionID:   partitionID1,
+		PartitionName: "partition",
+		CollectionID:  collID1,
+	}
+	s.Require().NoError(s.catalog.CreatePartition(ctx, partition, ts))
+	s.NoError(s.catalog.DropPartition(ctx, collID1, partitionID1, ts+1))
+
+	partitions, err := dao.NewMetaDomain().PartitionDb(ctx).GetByCollectionID(tenantID, collID1, ts+1)
+	s.NoError(err)
+	s.Equal(0, len(partitions))
+
+	// time travel
+	partitions, err = dao.NewMetaDomain().PartitionDb(ctx).GetByCollectionID(tenantID, collID1, ts)
+	s.NoError(err)
+	s.Equal(1, len(partitions))
+}
+
+func (s *TableCatalogSuite) TestAlterPartition() {
+	partition := &model.Partition{
+		PartitionID:   partitionID1,
+		PartitionName: "partition",
+		CollectionID:  collID1,
+	}
+	s.Require().NoError(s.catalog.CreatePartition(ctx, partition, ts))
+
+	newPartition := *partition
+	newPartition.State = pb.PartitionState_PartitionDropping
+	s.NoError(s.catalog.AlterPartition(ctx, partition, &newPartition, metastore.MODIFY, ts))
+}
+
+func (s *TableCatalogSuite) TestAlterPartition_TsNot0_AlterTypeError() {
+	partition := &model.Partition{
+		PartitionID:   partitionID1,
+		PartitionName: "partition",
+		CollectionID:  collID1,
+	}
+	s.Require().NoError(s.catalog.CreatePartition(ctx, partition, ts))
+
+	s.Error(s.catalog.AlterPartition(ctx, partition, partition, metastore.ADD, ts))
+	s.Error(s.catalog.AlterPartition(ctx, partition, partition, metastore.DELETE, ts))
+}
+
+func (s *TableCatalogSuite) TestCreateAlias() {
+	alias := &model.Alias{Name: collAlias1, CollectionID: collID1}
+	s.NoError(s.catalog.CreateAlias(ctx, alias, ts))
+	// retry is idempotent
+	s.NoError(s.catalog.CreateAlias(ctx, alias, ts))
+
+	aliases, err := s.catalog.ListAliases(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Equal([]*model.Alias{alias}, aliases)
+}
+
+func (s *TableCatalogSuite) TestDropAlias_TsNot0() {
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: collAlias1, CollectionID: collID1}, ts))
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: collAlias2, CollectionID: collID1}, ts))
+
+	s.NoError(s.catalog.DropAlias(ctx, collAlias1, ts+1))
+	s.Error(s.catalog.DropAlias(ctx, "not_exist", ts+1))
+
+	aliases, err := s.catalog.ListAliases(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Equal([]*model.Alias{{Name: collAlias2, CollectionID: collID1}}, aliases)
+
+	// time travel
+	aliases, err = s.catalog.ListAliases(ctx, ts)
+	s.NoError(err)
+	s.Equal(2, len(aliases))
+}
+
+func (s *TableCatalogSuite) TestAlterAlias_TsNot0() {
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: collAlias1, CollectionID: collID1}, ts))
+
+	s.NoError(s.catalog.AlterAlias(ctx, &model.Alias{Name: collAlias1, CollectionID: collID1 + 1}, ts+1))
+
+	aliases, err := s.catalog.ListAliases(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Equal([]*model.Alias{{Name: collAlias1, CollectionID: collID1 + 1}}, aliases)
+}
+
+func (s *TableCatalogSuite) TestListAliases() {
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: collAlias1, CollectionID: collID1}, ts))
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: collAlias2, CollectionID: collID1}, ts))
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: "other_alias", CollectionID: collID1 + 1}, ts+1))
+
+	aliases, err := s.catalog.ListAliases(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.ElementsMatch([]*model.Alias{
+		{Name: collAlias1, CollectionID: collID1},
+		{Name: collAlias2, CollectionID: collID1},
+		{Name: "other_alias", CollectionID: collID1 + 1},
+	}, aliases)
+
+	aliases, err = s.catalog.ListAliases(ctx, ts)
+	s.NoError(err)
+	s.Equal(2, len(aliases))
+}
+
+func (s *TableCatalogSuite) TestListAliases_NoResult() {
+	aliases, err := s.catalog.ListAliases(ctx, typeutil.MaxTimestamp)
+	s.NoError(err)
+	s.Equal(0, len(aliases))
+
+	s.Require().NoError(s.catalog.CreateAlias(ctx, &model.Alias{Name: collAlias1, CollectionID: collID1}, ts))
+	aliases, err = s.catalog.ListAliases(ctx, ts-1)
+	s.NoError(err)
+	s.Equal(0, len(aliases))
+}
+
+func (s *TableCatalogSuite) TestGetCredential() {
+	s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: username, EncryptedPassword: password}))
+
+	credential, err := s.catalog.GetCredential(ctx, username)
+	s.NoError(err)
+	s.Equal(username, credential.Username)
+	s.Equal(password, credential.EncryptedPassword)
+
+	_, err = s.catalog.GetCredential(ctx, "not_exist")
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestCreateCredential() {
+	s.NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: username, EncryptedPassword: password}))
+
+	usernames, err := s.catalog.ListCredentials(ctx)
+	s.NoError(err)
+	s.Equal([]string{username}, usernames)
+}
+
+func (s *TableCatalogSuite) TestAlterCredential() {
+	s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: username, EncryptedPassword: password}))
+
+	s.NoError(s.catalog.AlterCredential(ctx, &model.Credential{Username: username, EncryptedPassword: "new_password"}))
+	credential, err := s.catalog.GetCredential(ctx, username)
+	s.NoError(err)
+	s.Equal("new_password", credential.EncryptedPassword)
+}
+
+func (s *TableCatalogSuite) TestDropCredential() {
+	s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: username, EncryptedPassword: password}))
+
+	s.NoError(s.catalog.DropCredential(ctx, username))
+	_, err := s.catalog.GetCredential(ctx, username)
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestListCredentials() {
+	s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: username, EncryptedPassword: password}))
+	s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: "user2", EncryptedPassword: password}))
+	s.Require().NoError(s.catalog.DropCredential(ctx, "user2"))
+	s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: "user3", EncryptedPassword: password}))
+
+	usernames, err := s.catalog.ListCredentials(ctx)
+	s.NoError(err)
+	s.ElementsMatch([]string{username, "user3"}, usernames)
+}
+
+func (s *TableCatalogSuite) TestCreateRole() {
+	role := &milvuspb.RoleEntity{Name: "foo"}
+	s.NoError(s.catalog.CreateRole(ctx, tenantID, role))
+
+	err := s.catalog.CreateRole(ctx, tenantID, role)
+	s.True(common.IsIgnorableError(err))
+
+	// roles are isolated by tenant
+	s.NoError(s.catalog.CreateRole(ctx, "other_tenant", role))
+}
+
+func (s *TableCatalogSuite) TestDropRole() {
+	s.Require().NoError(s.catalog.CreateRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo"}))
+
+	s.NoError(s.catalog.DropRole(ctx, tenantID, "foo"))
+	_, err := s.catalog.ListRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo"}, false)
+	s.True(common.IsKeyNotExistError(err))
+
+	// the role can be created again
+	s.NoError(s.catalog.CreateRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo"}))
+}
+
+// prepareUserRole creates the users and the roles, and adds each user to the role of the same index.
+func (s *TableCatalogSuite) prepareUserRole(usernames []string, roleNames []string) {
+	for _, name := range usernames {
+		s.Require().NoError(s.catalog.CreateCredential(ctx, &model.Credential{Username: name, EncryptedPassword: password}))
+	}
+	for _, name := range roleNames {
+		s.Require().NoError(s.catalog.CreateRole(ctx, tenantID, &milvuspb.RoleEntity{Name: name}))
+	}
+	for i := 0; i < len(usernames) && i < len(roleNames); i++ {
+		err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: usernames[i]}, &milvuspb.RoleEntity{Name: roleNames[i]}, milvuspb.OperateUserRoleType_AddUserToRole)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *TableCatalogSuite) TestAlterUserRole() {
+	s.prepareUserRole([]string{username}, nil)
+	s.Require().NoError(s.catalog.CreateRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo"}))
+
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.NoError(err)
+
+	userRoles, err := s.catalog.ListUserRole(ctx, tenantID)
+	s.NoError(err)
+	s.Equal([]string{funcutil.EncodeUserRoleCache(username, "foo")}, userRoles)
+}
+
+func (s *TableCatalogSuite) TestAlterUserRole_GetUserIDError() {
+	s.prepareUserRole(nil, []string{"foo"})
+
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestAlterUserRole_GetRoleIDError() {
+	s.prepareUserRole([]string{username}, nil)
+
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestAlterUserRole_RepeatUserRole() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.True(common.IsIgnorableError(err))
+}
+
+func (s *TableCatalogSuite) TestAlterUserRole_Delete() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_RemoveUserFromRole)
+	s.NoError(err)
+	userRoles, err := s.catalog.ListUserRole(ctx, tenantID)
+	s.NoError(err)
+	s.Equal(0, len(userRoles))
+
+	err = s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_RemoveUserFromRole)
+	s.True(common.IsIgnorableError(err))
+
+	// the user can be added to the role again
+	err = s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.NoError(err)
+}
+
+func (s *TableCatalogSuite) TestAlterUserRole_InvalidType() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: username}, &milvuspb.RoleEntity{Name: "foo"}, 100)
+	s.Error(err)
+	s.False(common.IsIgnorableError(err))
+}
+
+func (s *TableCatalogSuite) TestListRole_AllRole() {
+	s.prepareUserRole(nil, []string{"foo1", "foo2"})
+
+	results, err := s.catalog.ListRole(ctx, tenantID, nil, false)
+	s.NoError(err)
+	s.Require().Equal(2, len(results))
+	s.ElementsMatch([]string{"foo1", "foo2"}, []string{results[0].Role.Name, results[1].Role.Name})
+	s.Empty(results[0].Users)
+}
+
+func (s *TableCatalogSuite) TestListRole_AllRole_IncludeUserInfo() {
+	s.prepareUserRole([]string{"user1", "user2"}, []string{"foo1", "foo2"})
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: "user2"}, &milvuspb.RoleEntity{Name: "foo1"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.Require().NoError(err)
+
+	results, err := s.catalog.ListRole(ctx, tenantID, nil, true)
+	s.NoError(err)
+	s.Require().Equal(2, len(results))
+	for _, result := range results {
+		var users []string
+		for _, user := range result.Users {
+			users = append(users, user.Name)
+		}
+		switch result.Role.Name {
+		case "foo1":
+			s.ElementsMatch([]string{"user1", "user2"}, users)
+		case "foo2":
+			s.ElementsMatch([]string{"user2"}, users)
+		default:
+			s.Fail("unexpected role", result.Role.Name)
+		}
+	}
+}
+
+func (s *TableCatalogSuite) TestListRole_AllRole_Empty() {
+	results, err := s.catalog.ListRole(ctx, tenantID, nil, false)
+	s.NoError(err)
+	s.Equal(0, len(results))
+}
+
+func (s *TableCatalogSuite) TestListRole_OneRole() {
+	s.prepareUserRole(nil, []string{"foo1", "foo2"})
+
+	results, err := s.catalog.ListRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo1"}, false)
+	s.NoError(err)
+	s.Require().Equal(1, len(results))
+	s.Equal("foo1", results[0].Role.Name)
+}
+
+func (s *TableCatalogSuite) TestListRole_OneRole_IncludeUserInfo() {
+	s.prepareUserRole([]string{"user1", "user2"}, []string{"foo1", "foo2"})
+
+	results, err := s.catalog.ListRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo1"}, true)
+	s.NoError(err)
+	s.Require().Equal(1, len(results))
+	s.Equal("foo1", results[0].Role.Name)
+	s.Require().Equal(1, len(results[0].Users))
+	s.Equal("user1", results[0].Users[0].Name)
+}
+
+func (s *TableCatalogSuite) TestListRole_OneRole_Empty() {
+	_, err := s.catalog.ListRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo"}, false)
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestListUser_AllUser() {
+	s.prepareUserRole([]string{"user1", "user2"}, nil)
+
+	results, err := s.catalog.ListUser(ctx, tenantID, nil, false)
+	s.NoError(err)
+	s.Require().Equal(2, len(results))
+	s.ElementsMatch([]string{"user1", "user2"}, []string{results[0].User.Name, results[1].User.Name})
+	s.Empty(results[0].Roles)
+}
+
+func (s *TableCatalogSuite) TestListUser_AllUser_IncludeRoleInfo() {
+	s.prepareUserRole([]string{"user1", "user2"}, []string{"foo1", "foo2"})
+	err := s.catalog.AlterUserRole(ctx, tenantID, &milvuspb.UserEntity{Name: "user1"}, &milvuspb.RoleEntity{Name: "foo2"}, milvuspb.OperateUserRoleType_AddUserToRole)
+	s.Require().NoError(err)
+
+	results, err := s.catalog.ListUser(ctx, tenantID, nil, true)
+	s.NoError(err)
+	s.Require().Equal(2, len(results))
+	for _, result := range results {
+		var roles []string
+		for _, role := range result.Roles {
+			roles = append(roles, role.Name)
+		}
+		switch result.User.Name {
+		case "user1":
+			s.ElementsMatch([]string{"foo1", "foo2"}, roles)
+		case "user2":
+			s.ElementsMatch([]string{"foo2"}, roles)
+		default:
+			s.Fail("unexpected user", result.User.Name)
+		}
+	}
+}
+
+func (s *TableCatalogSuite) TestListUser_AllUser_Empty() {
+	results, err := s.catalog.ListUser(ctx, tenantID, nil, false)
+	s.NoError(err)
+	s.Equal(0, len(results))
+}
+
+func (s *TableCatalogSuite) TestListUser_OneUser() {
+	s.prepareUserRole([]string{"user1", "user2"}, nil)
+
+	results, err := s.catalog.ListUser(ctx, tenantID, &milvuspb.UserEntity{Name: "user1"}, false)
+	s.NoError(err)
+	s.Require().Equal(1, len(results))
+	s.Equal("user1", results[0].User.Name)
+}
+
+func (s *TableCatalogSuite) TestListUser_OneUser_IncludeRoleInfo() {
+	s.prepareUserRole([]string{"user1", "user2"}, []string{"foo1", "foo2"})
+
+	results, err := s.catalog.ListUser(ctx, tenantID, &milvuspb.UserEntity{Name: "user1"}, true)
+	s.NoError(err)
+	s.Require().Equal(1, len(results))
+	s.Equal("user1", results[0].User.Name)
+	s.Require().Equal(1, len(results[0].Roles))
+	s.Equal("foo1", results[0].Roles[0].Name)
+}
+
+func (s *TableCatalogSuite) TestListUser_GetByUsernameError() {
+	_, err := s.catalog.ListUser(ctx, tenantID, &milvuspb.UserEntity{Name: "user1"}, false)
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func newGrant(roleName string, object string, objectName string, privilege string) *milvuspb.GrantEntity {
+	return &milvuspb.GrantEntity{
+		Role:       &milvuspb.RoleEntity{Name: roleName},
+		Object:     &milvuspb.ObjectEntity{Name: object},
+		ObjectName: objectName,
+		Grantor: &milvuspb.GrantorEntity{
+			User:      &milvuspb.UserEntity{Name: username},
+			Privilege: &milvuspb.PrivilegeEntity{Name: privilege},
+		},
+	}
+}
+
+func (s *TableCatalogSuite) TestAlterGrant_Grant() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+	grant := newGrant("foo", "Collection", "col1", "PrivilegeLoad")
+
+	s.NoError(s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Grant))
+	err := s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Grant)
+	s.True(common.IsIgnorableError(err))
+
+	// another privilege of the same object reuses the grant
+	s.NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Collection", "col1", "PrivilegeInsert"), milvuspb.OperatePrivilegeType_Grant))
+
+	// the role doesn't exist
+	err = s.catalog.AlterGrant(ctx, tenantID, newGrant("not_exist", "Collection", "col1", "PrivilegeLoad"), milvuspb.OperatePrivilegeType_Grant)
+	s.True(common.IsKeyNotExistError(err))
+
+	// the grantor doesn't exist
+	grant = newGrant("foo", "Collection", "col2", "PrivilegeLoad")
+	grant.Grantor.User.Name = "not_exist"
+	err = s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Grant)
+	s.True(common.IsKeyNotExistError(err))
+
+	policies, err := s.catalog.ListPolicy(ctx, tenantID)
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		funcutil.PolicyForPrivilege("foo", "Collection", "col1", "PrivilegeLoad"),
+		funcutil.PolicyForPrivilege("foo", "Collection", "col1", "PrivilegeInsert"),
+	}, policies)
+}
+
+func (s *TableCatalogSuite) TestAlterGrant_Revoke() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+	grant := newGrant("foo", "Collection", "col1", "PrivilegeLoad")
+
+	// neither the grant nor the privilege exists
+	err := s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Revoke)
+	s.True(common.IsIgnorableError(err))
+
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Grant))
+	s.NoError(s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Revoke))
+
+	// the grant exists but the privilege is revoked
+	err = s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Revoke)
+	s.True(common.IsIgnorableError(err))
+
+	policies, err := s.catalog.ListPolicy(ctx, tenantID)
+	s.NoError(err)
+	s.Equal(0, len(policies))
+
+	// granting again after the revoke
+	s.NoError(s.catalog.AlterGrant(ctx, tenantID, grant, milvuspb.OperatePrivilegeType_Grant))
+}
+
+func (s *TableCatalogSuite) TestAlterGrant_InvalidType() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+
+	err := s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Collection", "col1", "PrivilegeLoad"), 100)
+	s.Error(err)
+	s.False(common.IsIgnorableError(err))
+}
+
+func (s *TableCatalogSuite) TestListGrant() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Collection", "col1", "PrivilegeLoad"), milvuspb.OperatePrivilegeType_Grant))
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Collection", "col1", util.AnyWord), milvuspb.OperatePrivilegeType_Grant))
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Global", util.AnyWord, "PrivilegeCreateCollection"), milvuspb.OperatePrivilegeType_Grant))
+
+	entities, err := s.catalog.ListGrant(ctx, tenantID, &milvuspb.GrantEntity{
+		Role:       &milvuspb.RoleEntity{Name: "foo"},
+		Object:     &milvuspb.ObjectEntity{Name: "Collection"},
+		ObjectName: "col1",
+	})
+	s.NoError(err)
+	s.Require().Equal(2, len(entities))
+	var privileges []string
+	for _, entity := range entities {
+		s.Equal("foo", entity.Role.Name)
+		s.Equal("Collection", entity.Object.Name)
+		s.Equal("col1", entity.ObjectName)
+		s.Equal(username, entity.Grantor.User.Name)
+		privileges = append(privileges, entity.Grantor.Privilege.Name)
+	}
+	s.ElementsMatch([]string{util.PrivilegeNameForAPI("PrivilegeLoad"), util.AnyWord}, privileges)
+
+	// all grants of the role
+	entities, err = s.catalog.ListGrant(ctx, tenantID, &milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "foo"}})
+	s.NoError(err)
+	s.Equal(3, len(entities))
+}
+
+func (s *TableCatalogSuite) TestListGrant_GetRolesError() {
+	_, err := s.catalog.ListGrant(ctx, tenantID, &milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "foo"}})
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestListGrant_NotExistError() {
+	s.prepareUserRole([]string{username}, []string{"foo"})
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Collection", "col1", "PrivilegeLoad"), milvuspb.OperatePrivilegeType_Grant))
+
+	_, err := s.catalog.ListGrant(ctx, tenantID, &milvuspb.GrantEntity{
+		Role:       &milvuspb.RoleEntity{Name: "foo"},
+		Object:     &milvuspb.ObjectEntity{Name: "Collection"},
+		ObjectName: "col2",
+	})
+	s.True(common.IsKeyNotExistError(err))
+
+	// listing all grants of a role without grants is not an error
+	s.Require().NoError(s.catalog.CreateRole(ctx, tenantID, &milvuspb.RoleEntity{Name: "bar"}))
+	entities, err := s.catalog.ListGrant(ctx, tenantID, &milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "bar"}})
+	s.NoError(err)
+	s.Equal(0, len(entities))
+}
+
+func (s *TableCatalogSuite) TestDropGrant() {
+	s.prepareUserRole([]string{username}, []string{"foo", "bar"})
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo", "Collection", "col1", "PrivilegeLoad"), milvuspb.OperatePrivilegeType_Grant))
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("bar", "Collection", "col1", "PrivilegeLoad"), milvuspb.OperatePrivilegeType_Grant))
+
+	s.NoError(s.catalog.DeleteGrant(ctx, tenantID, &milvuspb.RoleEntity{Name: "foo"}))
+
+	policies, err := s.catalog.ListPolicy(ctx, tenantID)
+	s.NoError(err)
+	s.Equal([]string{funcutil.PolicyForPrivilege("bar", "Collection", "col1", "PrivilegeLoad")}, policies)
+
+	err = s.catalog.DeleteGrant(ctx, tenantID, &milvuspb.RoleEntity{Name: "not_exist"})
+	s.True(common.IsKeyNotExistError(err))
+}
+
+func (s *TableCatalogSuite) TestListPolicy() {
+	s.prepareUserRole([]string{username}, []string{"foo1", "foo2"})
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo1", "obj1", "col1", "PrivilegeInsert"), milvuspb.OperatePrivilegeType_Grant))
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo1", "obj1", "col1", "PrivilegeQuery"), milvuspb.OperatePrivilegeType_Grant))
+	s.Require().NoError(s.catalog.AlterGrant(ctx, tenantID, newGrant("foo2", "obj2", "col2", "PrivilegeInsert"), milvuspb.OperatePrivilegeType_Grant))
+
+	policies, err := s.catalog.ListPolicy(ctx, tenantID)
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		funcutil.PolicyForPrivilege("foo1", "obj1", "col1", "PrivilegeInsert"),
+		funcutil.PolicyForPrivilege("foo1", "obj1", "col1", "PrivilegeQuery"),
+		funcutil.PolicyForPrivilege("foo2", "obj2", "col2", "PrivilegeInsert"),
+	}, policies)
+
+	// policies are isolated by tenant
+	policies, err = s.catalog.ListPolicy(ctx, "other_tenant")
+	s.NoError(err)
+	s.Equal(0, len(policies))
+}
+
+func (s *TableCatalogSuite) TestListUserRole() {
+	s.prepareUserRole([]string{"user1", "user2"}, []string{"foo1", "foo2"})
+
+	userRoles, err := s.catalog.ListUserRole(ctx, tenantID)
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		funcutil.EncodeUserRoleCache("user1", "foo1"),
+		funcutil.EncodeUserRoleCache("user2", "foo2"),
+	}, userRoles)
+}
@@ -536,17 +536,17 @@ func TestTableCatalog_ListCollections(t *testing.T) {
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, 1, len(res))
-	require.Equal(t, coll.TenantID, res[coll.CollectionName].TenantID)
-	require.Equal(t, coll.CollectionID, res[coll.CollectionName].CollectionID)
-	require.Equal(t, coll.CollectionName, res[coll.CollectionName].Name)
-	require.Equal(t, coll.AutoID, res[coll.CollectionName].AutoID)
-	require.Equal(t, coll.Ts, res[coll.CollectionName].CreateTime)
-	require.Empty(t, res[coll.CollectionName].StartPositions)
+	require.Equal(t, coll.TenantID, res[0].TenantID)
+	require.Equal(t, coll.CollectionID, res[0].CollectionID)
+	require.Equal(t, coll.CollectionName, res[0].Name)
+	require.Equal(t, coll.AutoID, res[0].AutoID)
+	require.Equal(t, coll.Ts, res[0].CreateTime)
+	require.Empty(t, res[0].StartPositions)
 	// partitions/fields/channels
-	require.NotEmpty(t, res[coll.CollectionName].Partitions)
-	require.NotEmpty(t, res[coll.CollectionName].Fields)
-	require.NotEmpty(t, res[coll.CollectionName].VirtualChannelNames)
-	require.NotEmpty(t, res[coll.CollectionName].PhysicalChannelNames)
+	require.NotEmpty(t, res[0].Partitions)
+	require.NotEmpty(t, res[0].Fields)
+	require.NotEmpty(t, res[0].VirtualChannelNames)
+	require.NotEmpty(t, res[0].PhysicalChannelNames)
 }
 
 func TestTableCatalog_CollectionExists(t *testing.T) {
@@ -785,12 +785,35 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		Aliases:      []string{collAlias1, collAlias2},
 	}
 
-	collDbMock.On("Update", mock.Anything).Return(nil).Once()
+	collDbMock.On("GetCollectionIDTs", tenantID, collID1, ts).Return(&dbmodel.Collection{CollectionID: collID1, Ts: ts - 1}, nil).Once()
+	collDbMock.On("Get", tenantID, collID1, ts-1).Return(&dbmodel.Collection{ID: 1, CollectionID: collID1, Ts: ts - 1}, nil).Once()
+	collDbMock.On("Update", mock.MatchedBy(func(coll *dbmodel.Collection) bool {
+		return coll.ID == 1 && coll.Ts == ts-1 && coll.Status == int32(pb.CollectionState_CollectionDropping)
+	})).Return(nil).Once()
 
 	gotErr := mockCatalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts)
 	require.NoError(t, gotErr)
 }
 
+func TestCatalog_AlterCollection_TsNot0_GetCollectionError(t *testing.T) {
+	coll := &model.Collection{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		Name:         collName1,
+		State:        pb.CollectionState_CollectionCreated,
+	}
+
+	errTest := errors.New("test error")
+	collDbMock.On("GetCollectionIDTs", tenantID, collID1, ts).Return(nil, errTest).Once()
+	gotErr := mockCatalog.AlterCollection(ctx, coll, coll, metastore.MODIFY, ts)
+	require.Error(t, gotErr)
+
+	collDbMock.On("GetCollectionIDTs", tenantID, collID1, ts).Return(&dbmodel.Collection{CollectionID: collID1, Ts: ts}, nil).Once()
+	collDbMock.On("Get", tenantID, collID1, ts).Return(nil, errTest).Once()
+	gotErr = mockCatalog.AlterCollection(ctx, coll, coll, metastore.MODIFY, ts)
+	require.Error(t, gotErr)
+}
+
 func TestTableCatalog_AlterCollection_TsNot0_AlterTypeError(t *testing.T) {
 	coll := &model.Collection{
 		TenantID:     tenantID,
@@ -818,6 +841,8 @@ func TestCatalog_AlterCollection_TsNot0_CollInsertError(t *testing.T) {
 
 	// expectation
 	errTest := errors.New("test error")
+	collDbMock.On("GetCollectionIDTs", tenantID, collID1, ts).Return(&dbmodel.Collection{CollectionID: collID1, Ts: ts}, nil).Once()
+	collDbMock.On("Get", tenantID, collID1, ts).Return(&dbmodel.Collection{ID: 1, CollectionID: collID1, Ts: ts}, nil).Once()
 	collDbMock.On("Update", mock.Anything).Return(errTest).Once()
 
 	// actual
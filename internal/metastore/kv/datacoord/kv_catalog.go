@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -111,11 +112,36 @@ func (kc *Catalog) AlterSegments(ctx context.Context, modSegments []*datapb.Segm
 		return nil
 	}
 
+	kvs, err := buildAlterSegmentsKvs(modSegments)
+	if err != nil {
+		return err
+	}
+	return kc.Txn.MultiSave(kvs)
+}
+
+// AlterSegmentsInBatch saves the segments like AlterSegments, but splits the kvs into several txns to not exceed
+// the max operations of an etcd txn, so the segments are not saved atomically.
+func (kc *Catalog) AlterSegmentsInBatch(ctx context.Context, modSegments []*datapb.SegmentInfo) error {
+	if len(modSegments) == 0 {
+		return nil
+	}
+
+	kvs, err := buildAlterSegmentsKvs(modSegments)
+	if err != nil {
+		return err
+	}
+	saveFn := func(partialKvs map[string]string) error {
+		return kc.Txn.MultiSave(partialKvs)
+	}
+	return etcd.SaveByBatch(kvs, saveFn)
+}
+
+func buildAlterSegmentsKvs(modSegments []*datapb.SegmentInfo) (map[string]string, error) {
 	kvs := make(map[string]string)
 	for _, segment := range modSegments {
 		segmentKvs, err := buildSegmentAndBinlogsKvs(segment)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		maps.Copy(kvs, segmentKvs)
@@ -125,8 +151,7 @@ func (kc *Catalog) AlterSegments(ctx context.Context, modSegments []*datapb.Segm
 			kvs[flushSegKey] = strconv.FormatInt(segment.GetID(), 10)
 		}
 	}
-
-	return kc.Txn.MultiSave(kvs)
+	return kvs, nil
 }
 
 func (kc *Catalog) hasBinlogPrefix(segment *datapb.SegmentInfo) (bool, error) {
@@ -169,7 +194,7 @@ func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments [
 			// convert to new format that include segment key and three binlog keys,
 			// or GC can not find data path on the storage.
 			if !hasBinlogkeys {
-				binlogsKvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs)
+				binlogsKvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs, noBinlogsSegment.GetSharedFrom())
 				if err != nil {
 					return err
 				}
@@ -288,6 +313,42 @@ func (kc *Catalog) DropChannel(ctx context.Context, channel string) error {
 	return kc.Txn.Remove(key)
 }
 
+func (kc *Catalog) ListChannelCheckpoint(ctx context.Context) (map[string]*internalpb.MsgPosition, error) {
+	keys, values, err := kc.Txn.LoadWithPrefix(ChannelCheckpointPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCPs := make(map[string]*internalpb.MsgPosition)
+	for i, key := range keys {
+		channelCP := &internalpb.MsgPosition{}
+		err = proto.Unmarshal([]byte(values[i]), channelCP)
+		if err != nil {
+			log.Error("unmarshal channel checkpoint error", zap.String("key", key), zap.Error(err))
+			return nil, err
+		}
+		ss := strings.Split(key, "/")
+		vChannel := ss[len(ss)-1]
+		channelCPs[vChannel] = channelCP
+	}
+
+	return channelCPs, nil
+}
+
+func (kc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *internalpb.MsgPosition) error {
+	k := buildChannelCPKey(vChannel)
+	v, err := proto.Marshal(pos)
+	if err != nil {
+		return err
+	}
+	return kc.Txn.Save(k, string(v))
+}
+
+func (kc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
+	k := buildChannelCPKey(vChannel)
+	return kc.Txn.Remove(k)
+}
+
 func (kc *Catalog) getBinlogsWithPrefix(binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID) ([]string, []string, error) {
 	var binlogPrefix string
@@ -333,6 +394,10 @@ func (kc *Catalog) unmarshalBinlog(binlogType storage.BinlogType, collectionID,
 func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID, fieldBinlog *datapb.FieldBinlog) error {
 	for _, binlog := range fieldBinlog.Binlogs {
+		// shared binlogs of a cloned segment keep the path of their owner
+		if binlog.GetLogPath() != "" {
+			continue
+		}
 		path, err := buildLogPath(chunkManagerRootPath, binlogType, collectionID, partitionID,
 			segmentID, fieldBinlog.GetFieldID(), binlog.GetLogID())
 		if err != nil {
@@ -345,7 +410,7 @@ func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogTy
 	return nil
 }
 
-func fillLogIDByLogPath(multiFieldBinlogs ...[]*datapb.FieldBinlog) error {
+func fillLogIDByLogPath(isShared func(logPath string) bool, multiFieldBinlogs ...[]*datapb.FieldBinlog) error {
 	for _, fieldBinlogs := range multiFieldBinlogs {
 		for _, fieldBinlog := range fieldBinlogs {
 			for _, binlog := range fieldBinlog.Binlogs {
@@ -360,15 +425,25 @@ func fillLogIDByLogPath(multiFieldBinlogs ...[]*datapb.FieldBinlog) error {
 					return err
 				}
 
+				binlog.LogID = logID
+				// the path of a shared binlog can not be rebuilt by the log id, keep it
+				if isShared(logPath) {
+					continue
+				}
 				// set log path to empty and only store log id
 				binlog.LogPath = ""
-				binlog.LogID = logID
 			}
 		}
 	}
 	return nil
 }
 
+// isSegmentLogPath checks whether the log path is under the directory of the segment,
+// binlogs shared by a cloned segment are under the directory of the segment owning them.
+func isSegmentLogPath(logPath string, collectionID, partitionID, segmentID typeutil.UniqueID) bool {
+	return strings.Contains(logPath, fmt.Sprintf("/%d/%d/%d/", collectionID, partitionID, segmentID))
+}
+
 // build a binlog path on the storage by metadata
 func buildLogPath(chunkManagerRootPath string, binlogType storage.BinlogType, collectionID, partitionID, segmentID, filedID, logID typeutil.UniqueID) (string, error) {
 	switch binlogType {
@@ -387,9 +462,15 @@ func buildLogPath(chunkManagerRootPath string, binlogType storage.BinlogType, co
 }
 
 func buildBinlogKvsWithLogID(collectionID, partitionID, segmentID typeutil.UniqueID,
-	binlogs, deltalogs, statslogs []*datapb.FieldBinlog) (map[string]string, error) {
+	binlogs, deltalogs, statslogs []*datapb.FieldBinlog, sharedFrom []typeutil.UniqueID) (map[string]string, error) {
 
-	fillLogIDByLogPath(binlogs, deltalogs, statslogs)
+	isShared := func(logPath string) bool { return false }
+	if len(sharedFrom) > 0 {
+		isShared = func(logPath string) bool {
+			return !isSegmentLogPath(logPath, collectionID, partitionID, segmentID)
+		}
+	}
+	fillLogIDByLogPath(isShared, binlogs, deltalogs, statslogs)
 	kvs, err := buildBinlogKvs(collectionID, partitionID, segmentID, binlogs, deltalogs, statslogs)
 	if err != nil {
 		return nil, err
@@ -402,7 +483,7 @@ func buildSegmentAndBinlogsKvs(segment *datapb.SegmentInfo) (map[string]string,
 	noBinlogsSegment, binlogs, deltalogs, statslogs := CloneSegmentWithExcludeBinlogs(segment)
 
 	// save binlogs separately
-	kvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs)
+	kvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs, noBinlogsSegment.GetSharedFrom())
 	if err != nil {
 		return nil, err
 	}
@@ -534,3 +615,7 @@ func buildFieldStatslogPathPrefix(collectionID typeutil.UniqueID, partitionID ty
 func buildChannelRemovePath(channel string) string {
 	return fmt.Sprintf("%s/%s", ChannelRemovePrefix, channel)
 }
+
+func buildChannelCPKey(vChannel string) string {
+	return fmt.Sprintf("%s/%s", ChannelCheckpointPrefix, vChannel)
+}
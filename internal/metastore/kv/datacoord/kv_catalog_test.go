@@ -9,8 +9,10 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -289,6 +291,50 @@ func Test_AddSegments(t *testing.T) {
 	})
 }
 
+func Test_AddSegmentWithSharedBinlogs(t *testing.T) {
+	catalog := &Catalog{memkv.NewMemoryKV(), "a"}
+	clonedSegmentID := segmentID + 100
+	ownDeltalogPath := metautil.BuildDeltaLogPath("a", collectionID+1, partitionID+1, clonedSegmentID, logID+1)
+	cloned := &datapb.SegmentInfo{
+		ID:           clonedSegmentID,
+		CollectionID: collectionID + 1,
+		PartitionID:  partitionID + 1,
+		NumOfRows:    100,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: fieldID, Binlogs: []*datapb.Binlog{{LogPath: binlogPath}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{FieldID: fieldID, Binlogs: []*datapb.Binlog{{LogPath: deltalogPath}, {LogPath: ownDeltalogPath}}},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: fieldID, Binlogs: []*datapb.Binlog{{LogPath: statslogPath}}},
+		},
+		SharedFrom: []int64{segmentID},
+	}
+	err := catalog.AddSegment(context.TODO(), cloned)
+	assert.NoError(t, err)
+
+	segments, err := catalog.ListSegments(context.TODO())
+	assert.NoError(t, err)
+	var segment *datapb.SegmentInfo
+	for _, s := range segments {
+		if s.GetID() == clonedSegmentID {
+			segment = s
+		}
+	}
+	assert.NotNil(t, segment)
+	assert.Equal(t, []int64{segmentID}, segment.GetSharedFrom())
+
+	// shared binlogs keep the paths of the source segment, own binlogs are rebuilt by log id
+	assert.Equal(t, binlogPath, segment.Binlogs[0].Binlogs[0].LogPath)
+	assert.Equal(t, logID, segment.Binlogs[0].Binlogs[0].LogID)
+	assert.Equal(t, statslogPath, segment.Statslogs[0].Binlogs[0].LogPath)
+	assert.Equal(t, deltalogPath, segment.Deltalogs[0].Binlogs[0].LogPath)
+	assert.Equal(t, ownDeltalogPath, segment.Deltalogs[0].Binlogs[1].LogPath)
+	assert.Equal(t, logID+1, segment.Deltalogs[0].Binlogs[1].LogID)
+}
+
 func Test_AlterSegments(t *testing.T) {
 	t.Run("generate binlog kvs failed", func(t *testing.T) {
 		txn := &MockedTxnKV{}
@@ -335,6 +381,52 @@ func Test_AlterSegments(t *testing.T) {
 	})
 }
 
+func Test_AlterSegmentsInBatch(t *testing.T) {
+	t.Run("save error", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		txn.EXPECT().MultiSave(mock.Anything).Return(errors.New("mock error"))
+
+		catalog := &Catalog{txn, ""}
+		err := catalog.AlterSegmentsInBatch(context.TODO(), []*datapb.SegmentInfo{segment1})
+		assert.Error(t, err)
+	})
+
+	t.Run("save in batches", func(t *testing.T) {
+		var (
+			count  = 0
+			kvSize = 0
+		)
+		txn := &mocks.TxnKV{}
+		txn.EXPECT().
+			MultiSave(mock.Anything).
+			Run(func(kvs map[string]string) {
+				count++
+				kvSize += len(kvs)
+			}).
+			Return(nil)
+
+		catalog := &Catalog{txn, ""}
+		err := catalog.AlterSegmentsInBatch(context.TODO(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+
+		segments := make([]*datapb.SegmentInfo, 40)
+		for i := range segments {
+			segments[i] = &datapb.SegmentInfo{
+				ID:           int64(i),
+				CollectionID: 1000,
+				PartitionID:  100,
+				State:        commonpb.SegmentState_Flushed,
+			}
+		}
+		err = catalog.AlterSegmentsInBatch(context.TODO(), segments)
+		assert.NoError(t, err)
+		// a segment key and a flushed segment key each segment
+		assert.Equal(t, 2, count)
+		assert.Equal(t, 80, kvSize)
+	})
+}
+
 func Test_AlterSegmentsAndAddNewSegment(t *testing.T) {
 	t.Run("save error", func(t *testing.T) {
 		txn := &MockedTxnKV{}
@@ -520,6 +612,58 @@ func Test_MarkChannelDeleted_SaveError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCatalog_ChannelCheckpoint(t *testing.T) {
+	channel := "ch1"
+	pos := &internalpb.MsgPosition{
+		ChannelName: "ch1",
+		MsgID:       []byte{},
+		Timestamp:   1000,
+	}
+
+	t.Run("ListChannelCheckpoint", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		v, err := proto.Marshal(pos)
+		assert.NoError(t, err)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return([]string{buildChannelCPKey(channel)}, []string{string(v)}, nil)
+
+		catalog := &Catalog{txn, ""}
+		res, err := catalog.ListChannelCheckpoint(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, pos.GetTimestamp(), res[channel].GetTimestamp())
+	})
+
+	t.Run("ListChannelCheckpoint failed", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		catalog := &Catalog{txn, ""}
+		_, err := catalog.ListChannelCheckpoint(context.TODO())
+		assert.Error(t, err)
+
+		txn = &mocks.TxnKV{}
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return([]string{buildChannelCPKey(channel)}, []string{"invalid"}, nil)
+		catalog = &Catalog{txn, ""}
+		_, err = catalog.ListChannelCheckpoint(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("SaveChannelCheckpoint", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		txn.EXPECT().Save(buildChannelCPKey(channel), mock.Anything).Return(nil)
+		catalog := &Catalog{txn, ""}
+		err := catalog.SaveChannelCheckpoint(context.TODO(), channel, pos)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DropChannelCheckpoint", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		txn.EXPECT().Remove(buildChannelCPKey(channel)).Return(errors.New("mock error"))
+		catalog := &Catalog{txn, ""}
+		err := catalog.DropChannelCheckpoint(context.TODO(), channel)
+		assert.Error(t, err)
+	})
+}
+
 func verifyBinlogs(t *testing.T, binlogBytes []byte) {
 	binlogs := &datapb.FieldBinlog{}
 	err := proto.Unmarshal([]byte(binlogBytes), binlogs)
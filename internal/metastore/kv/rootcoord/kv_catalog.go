@@ -394,6 +394,7 @@ func (kc *Catalog) alterModifyPartition(oldPart *model.Partition, newPart *model
 	oldPartClone.PartitionName = newPartClone.PartitionName
 	oldPartClone.PartitionCreatedTimestamp = newPartClone.PartitionCreatedTimestamp
 	oldPartClone.State = newPartClone.State
+	oldPartClone.DropTime = newPartClone.DropTime
 	key := buildPartitionKey(oldPart.CollectionID, oldPart.PartitionID)
 	value, err := proto.Marshal(model.MarshalPartitionModel(oldPartClone))
 	if err != nil {
@@ -474,6 +475,7 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 		return nil, err
 	}
 
+	var recycled *pb.CollectionInfo
 	for _, val := range vals {
 		colMeta := pb.CollectionInfo{}
 		err = proto.Unmarshal([]byte(val), &colMeta)
@@ -482,15 +484,23 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 			continue
 		}
 		if colMeta.Schema.Name == collectionName {
+			// a recycled collection may share the name with a new collection, prefer the new one.
+			if colMeta.GetState() == pb.CollectionState_CollectionRecycled {
+				recycled = &colMeta
+				continue
+			}
 			// compatibility handled by kc.GetCollectionByID.
 			return kc.GetCollectionByID(ctx, colMeta.GetID(), ts)
 		}
 	}
+	if recycled != nil {
+		return kc.GetCollectionByID(ctx, recycled.GetID(), ts)
+	}
 
 	return nil, common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s, at timestamp = %d", collectionName, ts))
 }
 
-func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) ([]*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Error("get collections meta fail",
@@ -500,7 +510,7 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, nil
 	}
 
-	colls := make(map[string]*model.Collection)
+	colls := make([]*model.Collection, 0, len(vals))
 	for _, val := range vals {
 		collMeta := pb.CollectionInfo{}
 		err := proto.Unmarshal([]byte(val), &collMeta)
@@ -512,7 +522,7 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		if err != nil {
 			return nil, err
 		}
-		colls[collMeta.Schema.Name] = collection
+		colls = append(colls, collection)
 	}
 
 	return colls, nil
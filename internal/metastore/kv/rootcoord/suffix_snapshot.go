@@ -29,6 +29,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
@@ -525,3 +526,93 @@ func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 	}
 	return err
 }
+
+// SnapshotGCStats is the result of one round of snapshot garbage collection
+type SnapshotGCStats struct {
+	// Removed is the number of ts-keys (and dropped original keys) removed in this round
+	Removed int `json:"removed"`
+	// Retained is the number of ts-keys left in the snapshot path
+	Retained int `json:"retained"`
+}
+
+// RemoveExpiredSnapshots removes the ts-keys which can't be reached by any time travel after retentionTs
+// for each key, all the versions after retentionTs are kept, and the latest version before retentionTs is kept
+// as well, since it's the value seen by a load in [retentionTs, next version)
+// if that version is a tombstone and no later version exists, the key is removed totally,
+// including the tombstone left in the original key, otherwise LoadWithPrefix would treat it as a plain value
+func (ss *SuffixSnapshot) RemoveExpiredSnapshots(retentionTs typeutil.Timestamp) (*SnapshotGCStats, error) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	keys, values, err := ss.TxnKV.LoadWithPrefix(ss.snapshotPrefix)
+	if err != nil {
+		log.Warn("SuffixSnapshot txnkv LoadWithPrefix failed", zap.String("prefix", ss.snapshotPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	// versions of the same original key
+	type version struct {
+		tsKey string
+		tsv
+	}
+	groups := make(map[string][]version)
+	for i, key := range keys {
+		tsKey := ss.hideRootPrefix(key)
+		if !strings.HasPrefix(tsKey, ss.snapshotPrefix) {
+			continue
+		}
+		matches := ss.exp.FindStringSubmatch(tsKey[ss.snapshotLen:])
+		if len(matches) < 3 {
+			continue
+		}
+		// err ignores since it's protected by the regexp
+		ts, _ := strconv.ParseUint(matches[2], 10, 64)
+		groups[matches[1]] = append(groups[matches[1]], version{tsKey: tsKey, tsv: tsv{value: values[i], ts: ts}})
+	}
+
+	stats := &SnapshotGCStats{}
+	var tsKeys, droppedKeys []string
+	for key, versions := range groups {
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].ts < versions[j].ts
+		})
+		// expired is the number of versions before retentionTs
+		expired := sort.Search(len(versions), func(i int) bool {
+			return versions[i].ts > retentionTs
+		})
+		if expired == 0 {
+			stats.Retained += len(versions)
+			continue
+		}
+		// the latest expired version is still visible unless it's a tombstone
+		keep := expired - 1
+		if ss.isTombstone(versions[keep].value) {
+			keep = expired
+			if expired == len(versions) {
+				droppedKeys = append(droppedKeys, key)
+			}
+		}
+		for _, v := range versions[:keep] {
+			tsKeys = append(tsKeys, v.tsKey)
+		}
+		stats.Retained += len(versions) - keep
+	}
+
+	// remove the original keys first, so that a partial failure never exposes a tombstone as a value,
+	// the ts-keys left are cleaned in the next round
+	sort.Strings(droppedKeys)
+	if err := etcd.RemoveByBatch(droppedKeys, ss.TxnKV.MultiRemove); err != nil {
+		return nil, err
+	}
+	for _, key := range droppedKeys {
+		delete(ss.lastestTS, key)
+	}
+	stats.Removed += len(droppedKeys)
+
+	sort.Strings(tsKeys)
+	if err := etcd.RemoveByBatch(tsKeys, ss.TxnKV.MultiRemove); err != nil {
+		return nil, err
+	}
+	stats.Removed += len(tsKeys)
+	return stats, nil
+}
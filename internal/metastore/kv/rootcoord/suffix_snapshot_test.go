@@ -471,3 +471,71 @@ func Test_SuffixSnapshotMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	// cleanup
 	ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{""}, 0)
 }
+
+func Test_SuffixSnapshotRemoveExpiredSnapshots(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+	sep := "_ts"
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+	etcdkv := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	defer etcdkv.Close()
+	defer etcdkv.RemoveWithPrefix("")
+
+	ss, err := NewSuffixSnapshot(etcdkv, sep, rootPath, snapshotPrefix)
+	require.Nil(t, err)
+
+	// k-alive: updated at 100, 110, 120
+	// k-dropped: created at 100, dropped at 110
+	// k-recreated: created at 100, dropped at 110, created again at 130
+	// k-new: created at 130
+	require.Nil(t, ss.MultiSave(map[string]string{"k-alive": "v100", "k-dropped": "v100", "k-recreated": "v100"}, 100))
+	require.Nil(t, ss.MultiSaveAndRemoveWithPrefix(map[string]string{"k-alive": "v110"}, []string{"k-dropped", "k-recreated"}, 110))
+	require.Nil(t, ss.Save("k-alive", "v120", 120))
+	require.Nil(t, ss.MultiSave(map[string]string{"k-recreated": "v130", "k-new": "v130"}, 130))
+
+	stats, err := ss.RemoveExpiredSnapshots(115)
+	require.Nil(t, err)
+	// v100 of k-alive, v100 of k-recreated and its tombstone,
+	// v100 of k-dropped, its tombstone and the original key
+	assert.Equal(t, 6, stats.Removed)
+	assert.Equal(t, 4, stats.Retained)
+
+	val, err := ss.Load("k-alive", 115)
+	assert.Nil(t, err)
+	assert.Equal(t, "v110", val)
+	val, err = ss.Load("k-alive", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "v120", val)
+	_, err = ss.Load("k-dropped", 115)
+	assert.NotNil(t, err)
+	_, err = ss.Load("k-recreated", 115)
+	assert.NotNil(t, err)
+
+	expected := map[typeutil.Timestamp][]string{
+		115: {"v110"},
+		120: {"v120"},
+		130: {"v120", "v130", "v130"},
+		0:   {"v120", "v130", "v130"},
+	}
+	for ts, values := range expected {
+		_, vals, err := ss.LoadWithPrefix("k-", ts)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, values, vals, ts)
+	}
+
+	keys, _, err := etcdkv.LoadWithPrefix("k-dropped")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	// nothing more to collect in the same retention
+	stats, err = ss.RemoveExpiredSnapshots(115)
+	require.Nil(t, err)
+	assert.Equal(t, 0, stats.Removed)
+	assert.Equal(t, 4, stats.Retained)
+}
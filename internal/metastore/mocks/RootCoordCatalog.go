@@ -368,15 +368,15 @@ func (_m *RootCoordCatalog) ListAliases(ctx context.Context, ts uint64) ([]*mode
 }
 
 // ListCollections provides a mock function with given fields: ctx, ts
-func (_m *RootCoordCatalog) ListCollections(ctx context.Context, ts uint64) (map[string]*model.Collection, error) {
+func (_m *RootCoordCatalog) ListCollections(ctx context.Context, ts uint64) ([]*model.Collection, error) {
 	ret := _m.Called(ctx, ts)
 
-	var r0 map[string]*model.Collection
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) map[string]*model.Collection); ok {
+	var r0 []*model.Collection
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*model.Collection); ok {
 		r0 = rf(ctx, ts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]*model.Collection)
+			r0 = ret.Get(0).([]*model.Collection)
 		}
 	}
 
@@ -24,12 +24,18 @@ type Collection struct {
 	Aliases              []string          // TODO: deprecate this.
 	Extra                map[string]string // deprecated.
 	State                pb.CollectionState
+	DropTime             uint64 // when the collection was moved to the recycle bin
 }
 
 func (c Collection) Available() bool {
 	return c.State == pb.CollectionState_CollectionCreated
 }
 
+// Recycled returns true if the collection is dropped but kept in the recycle bin.
+func (c Collection) Recycled() bool {
+	return c.State == pb.CollectionState_CollectionRecycled
+}
+
 func (c Collection) Clone() *Collection {
 	return &Collection{
 		TenantID:             c.TenantID,
@@ -48,6 +54,7 @@ func (c Collection) Clone() *Collection {
 		Aliases:              common.CloneStringList(c.Aliases),
 		Extra:                common.CloneStr2Str(c.Extra),
 		State:                c.State,
+		DropTime:             c.DropTime,
 	}
 }
 
@@ -99,6 +106,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		CreateTime:           coll.CreateTime,
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
+		DropTime:             coll.DroppedTime,
 	}
 }
 
@@ -134,5 +142,6 @@ func MarshalCollectionModel(coll *Collection) *pb.CollectionInfo {
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
+		DroppedTime:          coll.DropTime,
 	}
 }
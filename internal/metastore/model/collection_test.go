@@ -90,3 +90,17 @@ func TestUnmarshalCollectionModel(t *testing.T) {
 func TestMarshalCollectionModel(t *testing.T) {
 	assert.Nil(t, MarshalCollectionModel(nil))
 }
+
+func TestCollection_Recycled(t *testing.T) {
+	coll := colModel.Clone()
+	assert.False(t, coll.Recycled())
+
+	coll.State = pb.CollectionState_CollectionRecycled
+	coll.DropTime = 100
+	assert.True(t, coll.Recycled())
+	assert.False(t, coll.Available())
+
+	ret := UnmarshalCollectionModel(MarshalCollectionModel(coll))
+	assert.True(t, ret.Recycled())
+	assert.Equal(t, uint64(100), ret.DropTime)
+}
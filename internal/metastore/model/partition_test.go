@@ -2,6 +2,10 @@ package model
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 )
 
 func TestCheckPartitionsEqual(t *testing.T) {
@@ -45,3 +49,18 @@ func TestCheckPartitionsEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestPartition_Recycled(t *testing.T) {
+	partition := &Partition{PartitionID: 1, PartitionName: "p", CollectionID: 100}
+	assert.False(t, partition.Recycled())
+
+	partition.State = pb.PartitionState_PartitionRecycled
+	partition.DropTime = 100
+	assert.True(t, partition.Recycled())
+	assert.False(t, partition.Available())
+	assert.Equal(t, uint64(100), partition.Clone().DropTime)
+
+	ret := UnmarshalPartitionModel(MarshalPartitionModel(partition))
+	assert.True(t, ret.Recycled())
+	assert.Equal(t, uint64(100), ret.DropTime)
+}
@@ -84,6 +84,42 @@ var (
 			Help:      "synchronized unix epoch per physical channel",
 		}, []string{channelNameLabelName})
 
+	// DataCoordNumSealedSegments counts the sealed segments by the reason why they are sealed.
+	DataCoordNumSealedSegments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "sealed_segment_count",
+			Help:      "count of sealed segments by seal reason",
+		}, []string{collectionIDLabelName, sealReasonLabelName})
+
+	// DataCoordChannelIngestRate records the rows allocated per second of each DML channel.
+	DataCoordChannelIngestRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_ingest_rate",
+			Help:      "rows allocated per second of each DML channel",
+		}, []string{channelNameLabelName})
+
+	// DataCoordNumScrubbedFiles counts the binlogs verified by the scrubber by the result.
+	DataCoordNumScrubbedFiles = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "scrubbed_file_count",
+			Help:      "count of binlogs verified by the scrubber",
+		}, []string{statusLabelName})
+
+	// DataCoordNumCorruptedSegments counts the segments marked corrupted by the scrubber.
+	DataCoordNumCorruptedSegments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "corrupted_segment_count",
+			Help:      "count of segments marked corrupted by the scrubber",
+		}, []string{collectionIDLabelName})
+
 	/* hard to implement, commented now
 	DataCoordSegmentSizeRatio = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -139,4 +175,8 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordNumStoredRows)
 	registry.MustRegister(DataCoordNumStoredRowsCounter)
 	registry.MustRegister(DataCoordSyncEpoch)
+	registry.MustRegister(DataCoordNumSealedSegments)
+	registry.MustRegister(DataCoordChannelIngestRate)
+	registry.MustRegister(DataCoordNumScrubbedFiles)
+	registry.MustRegister(DataCoordNumCorruptedSegments)
 }
@@ -118,6 +118,40 @@ var (
 			msgTypeLabelName,
 		})
 
+	DataNodeUploadLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "upload_latency",
+			Help:      "latency of uploading a single binlog to storage, including retries",
+			Buckets:   []float64{0, 10, 100, 200, 400, 1000, 10000},
+		}, []string{
+			nodeIDLabelName,
+			msgTypeLabelName,
+		})
+
+	DataNodeBinlogCompressionRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "binlog_compression_ratio",
+			Help:      "ratio of the memory size of the flushed data to the size of the written insert binlogs",
+			Buckets:   compressionRatioBuckets,
+		}, []string{
+			nodeIDLabelName,
+			compressionLabelName,
+		})
+
+	DataNodeUploadBytesInFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "upload_bytes_in_flight",
+			Help:      "byte size of binlogs being uploaded to storage",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	DataNodeFlushBufferCount = prometheus.NewCounterVec( // TODO: arguably
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -140,6 +174,38 @@ var (
 			statusLabelName,
 		})
 
+	DataNodeInsertBufferSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "insert_buffer_size",
+			Help:      "byte size of insert buffers in memory",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataNodeSpilledBufferSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "spilled_buffer_size",
+			Help:      "byte size of insert buffers spilled to local disk",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataNodeSpillBufferCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "spill_buffer_count",
+			Help:      "count of insert buffers spilled to local disk",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	DataNodeCompactionLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -184,8 +250,14 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeNumUnflushedSegments)
 	registry.MustRegister(DataNodeEncodeBufferLatency)
 	registry.MustRegister(DataNodeSave2StorageLatency)
+	registry.MustRegister(DataNodeUploadLatency)
+	registry.MustRegister(DataNodeBinlogCompressionRatio)
+	registry.MustRegister(DataNodeUploadBytesInFlight)
 	registry.MustRegister(DataNodeFlushBufferCount)
 	registry.MustRegister(DataNodeAutoFlushBufferCount)
+	registry.MustRegister(DataNodeInsertBufferSize)
+	registry.MustRegister(DataNodeSpilledBufferSize)
+	registry.MustRegister(DataNodeSpillBufferCount)
 	registry.MustRegister(DataNodeCompactionLatency)
 	registry.MustRegister(DataNodeFlushReqCounter)
 	registry.MustRegister(DataNodeConsumeCounter)
@@ -71,16 +71,21 @@ const (
 	functionLabelName        = "function_name"
 	queryTypeLabelName       = "query_type"
 	segmentStateLabelName    = "segment_state"
+	sealReasonLabelName      = "seal_reason"
 	usernameLabelName        = "username"
 	rolenameLabelName        = "role_name"
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
+	compressionLabelName     = "compression"
 )
 
 var (
 	// buckets involves durations in milliseconds,
 	// [1 2 4 8 16 32 64 128 256 512 1024 2048 4096 8192 16384 32768 65536 1.31072e+05]
 	buckets = prometheus.ExponentialBuckets(1, 2, 18)
+
+	// compressionRatioBuckets involves the ratios of the uncompressed size to the compressed size
+	compressionRatioBuckets = []float64{0.5, 1, 1.5, 2, 3, 4, 6, 8, 12, 16}
 )
 
 //ServeHTTP serves prometheus http service
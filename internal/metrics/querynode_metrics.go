@@ -166,6 +166,18 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeBinlogCompressionRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "binlog_compression_ratio",
+			Help:      "ratio of the memory size of the loaded field data to the size of the read insert binlogs",
+			Buckets:   compressionRatioBuckets,
+		}, []string{
+			nodeIDLabelName,
+			compressionLabelName,
+		})
+
 	QueryNodeReadTaskUnsolveLen = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -325,6 +337,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSQSegmentLatencyInCore)
 	registry.MustRegister(QueryNodeReduceLatency)
 	registry.MustRegister(QueryNodeLoadSegmentLatency)
+	registry.MustRegister(QueryNodeBinlogCompressionRatio)
 	registry.MustRegister(QueryNodeReadTaskUnsolveLen)
 	registry.MustRegister(QueryNodeReadTaskReadyLen)
 	registry.MustRegister(QueryNodeReadTaskConcurrency)
@@ -133,6 +133,24 @@ var (
 			Name:      "num_of_roles",
 			Help:      "The number of roles",
 		})
+
+	// RootCoordNumOfSnapshotKeys counts the number of snapshot keys retained after the snapshot gc.
+	RootCoordNumOfSnapshotKeys = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "snapshot_key_num",
+			Help:      "number of snapshot keys retained in meta storage",
+		})
+
+	// RootCoordSnapshotKeysRemoved counts the number of snapshot keys removed by the snapshot gc.
+	RootCoordSnapshotKeysRemoved = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "snapshot_key_removed_count",
+			Help:      "count of snapshot keys removed from meta storage",
+		})
 )
 
 //RegisterRootCoord registers RootCoord metrics
@@ -163,4 +181,8 @@ func RegisterRootCoord(registry *prometheus.Registry) {
 	registry.MustRegister(RootCoordNumOfCredentials)
 
 	registry.MustRegister(RootCoordNumOfRoles)
+
+	// for snapshot gc
+	registry.MustRegister(RootCoordNumOfSnapshotKeys)
+	registry.MustRegister(RootCoordSnapshotKeysRemoved)
 }
@@ -123,6 +123,53 @@ func (_c *DataCoord_AssignSegmentID_Call) Return(_a0 *datapb.AssignSegmentIDResp
 	return _c
 }
 
+// CloneSegments provides a mock function with given fields: ctx, req
+func (_m *DataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.CloneSegmentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) *datapb.CloneSegmentsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CloneSegmentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type DataCoord_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CloneSegmentsRequest
+func (_e *DataCoord_Expecter) CloneSegments(ctx interface{}, req interface{}) *DataCoord_CloneSegments_Call {
+	return &DataCoord_CloneSegments_Call{Call: _e.mock.On("CloneSegments", ctx, req)}
+}
+
+func (_c *DataCoord_CloneSegments_Call) Run(run func(ctx context.Context, req *datapb.CloneSegmentsRequest)) *DataCoord_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_CloneSegments_Call) Return(_a0 *datapb.CloneSegmentsResponse, _a1 error) *DataCoord_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: ctx, req
 func (_m *DataCoord) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -217,6 +264,54 @@ func (_c *DataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *Da
 	return _c
 }
 
+// GetChannelCheckpoints provides a mock function with given fields: ctx, req
+func (_m *DataCoord) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetChannelCheckpointsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetChannelCheckpointsRequest) *datapb.GetChannelCheckpointsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetChannelCheckpointsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetChannelCheckpointsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_GetChannelCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChannelCheckpoints'
+type DataCoord_GetChannelCheckpoints_Call struct {
+	*mock.Call
+}
+
+// GetChannelCheckpoints is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.GetChannelCheckpointsRequest
+func (_e *DataCoord_Expecter) GetChannelCheckpoints(ctx interface{}, req interface{}) *DataCoord_GetChannelCheckpoints_Call {
+	return &DataCoord_GetChannelCheckpoints_Call{Call: _e.mock.On("GetChannelCheckpoints", ctx, req)}
+}
+
+func (_c *DataCoord_GetChannelCheckpoints_Call) Run(run func(ctx context.Context, req *datapb.GetChannelCheckpointsRequest)) *DataCoord_GetChannelCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetChannelCheckpointsRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_GetChannelCheckpoints_Call) Return(_a0 *datapb.GetChannelCheckpointsResponse, _a1 error) *DataCoord_GetChannelCheckpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+
 // GetCollectionStatistics provides a mock function with given fields: ctx, req
 func (_m *DataCoord) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1485,6 +1580,54 @@ func (_c *DataCoord_UnsetIsImportingState_Call) Return(_a0 *commonpb.Status, _a1
 	return _c
 }
 
+// UpdateChannelCheckpoint provides a mock function with given fields: ctx, req
+func (_m *DataCoord) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateChannelCheckpointRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateChannelCheckpointRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_UpdateChannelCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChannelCheckpoint'
+type DataCoord_UpdateChannelCheckpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateChannelCheckpoint is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.UpdateChannelCheckpointRequest
+func (_e *DataCoord_Expecter) UpdateChannelCheckpoint(ctx interface{}, req interface{}) *DataCoord_UpdateChannelCheckpoint_Call {
+	return &DataCoord_UpdateChannelCheckpoint_Call{Call: _e.mock.On("UpdateChannelCheckpoint", ctx, req)}
+}
+
+func (_c *DataCoord_UpdateChannelCheckpoint_Call) Run(run func(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest)) *DataCoord_UpdateChannelCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.UpdateChannelCheckpointRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_UpdateChannelCheckpoint_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_UpdateChannelCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+
 // UpdateSegmentStatistics provides a mock function with given fields: ctx, req
 func (_m *DataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
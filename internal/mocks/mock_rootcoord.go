@@ -172,6 +172,53 @@ func (_c *RootCoord_AlterAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *Ro
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.CloneCollectionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CloneCollectionRequest) *milvuspb.CloneCollectionResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.CloneCollectionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CloneCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type RootCoord_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.CloneCollectionRequest
+func (_e *RootCoord_Expecter) CloneCollection(ctx interface{}, req interface{}) *RootCoord_CloneCollection_Call {
+	return &RootCoord_CloneCollection_Call{Call: _e.mock.On("CloneCollection", ctx, req)}
+}
+
+func (_c *RootCoord_CloneCollection_Call) Run(run func(ctx context.Context, req *milvuspb.CloneCollectionRequest)) *RootCoord_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CloneCollection_Call) Return(_a0 *milvuspb.CloneCollectionResponse, _a1 error) *RootCoord_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -219,6 +266,53 @@ func (_c *RootCoord_CreateAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *R
 	return _c
 }
 
+// CreateBackup provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.CreateBackupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateBackupRequest) *milvuspb.CreateBackupResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.CreateBackupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateBackupRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBackup'
+type RootCoord_CreateBackup_Call struct {
+	*mock.Call
+}
+
+// CreateBackup is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.CreateBackupRequest
+func (_e *RootCoord_Expecter) CreateBackup(ctx interface{}, req interface{}) *RootCoord_CreateBackup_Call {
+	return &RootCoord_CreateBackup_Call{Call: _e.mock.On("CreateBackup", ctx, req)}
+}
+
+func (_c *RootCoord_CreateBackup_Call) Run(run func(ctx context.Context, req *milvuspb.CreateBackupRequest)) *RootCoord_CreateBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateBackupRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateBackup_Call) Return(_a0 *milvuspb.CreateBackupResponse, _a1 error) *RootCoord_CreateBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CreateCollection provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -501,6 +595,53 @@ func (_c *RootCoord_DescribeCollection_Call) Return(_a0 *milvuspb.DescribeCollec
 	return _c
 }
 
+// DescribeDDLJob provides a mock function with given fields: ctx, req
+func (_m *RootCoord) DescribeDDLJob(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.DescribeDDLJobResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DescribeDDLJobRequest) *rootcoordpb.DescribeDDLJobResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.DescribeDDLJobResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DescribeDDLJobRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DescribeDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDDLJob'
+type RootCoord_DescribeDDLJob_Call struct {
+	*mock.Call
+}
+
+// DescribeDDLJob is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.DescribeDDLJobRequest
+func (_e *RootCoord_Expecter) DescribeDDLJob(ctx interface{}, req interface{}) *RootCoord_DescribeDDLJob_Call {
+	return &RootCoord_DescribeDDLJob_Call{Call: _e.mock.On("DescribeDDLJob", ctx, req)}
+}
+
+func (_c *RootCoord_DescribeDDLJob_Call) Run(run func(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest)) *RootCoord_DescribeDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DescribeDDLJobRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DescribeDDLJob_Call) Return(_a0 *rootcoordpb.DescribeDDLJobResponse, _a1 error) *RootCoord_DescribeDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, req
 func (_m *RootCoord) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -1192,6 +1333,53 @@ func (_c *RootCoord_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Sta
 	return _c
 }
 
+// ListBackups provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.ListBackupsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListBackupsRequest) *milvuspb.ListBackupsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListBackupsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListBackupsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListBackups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackups'
+type RootCoord_ListBackups_Call struct {
+	*mock.Call
+}
+
+// ListBackups is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.ListBackupsRequest
+func (_e *RootCoord_Expecter) ListBackups(ctx interface{}, req interface{}) *RootCoord_ListBackups_Call {
+	return &RootCoord_ListBackups_Call{Call: _e.mock.On("ListBackups", ctx, req)}
+}
+
+func (_c *RootCoord_ListBackups_Call) Run(run func(ctx context.Context, req *milvuspb.ListBackupsRequest)) *RootCoord_ListBackups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ListBackupsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListBackups_Call) Return(_a0 *milvuspb.ListBackupsResponse, _a1 error) *RootCoord_ListBackups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1239,6 +1427,100 @@ func (_c *RootCoord_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersRespon
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListDDLJobs(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.ListDDLJobsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListDDLJobsRequest) *rootcoordpb.ListDDLJobsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListDDLJobsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListDDLJobsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type RootCoord_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ListDDLJobsRequest
+func (_e *RootCoord_Expecter) ListDDLJobs(ctx interface{}, req interface{}) *RootCoord_ListDDLJobs_Call {
+	return &RootCoord_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", ctx, req)}
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) Run(run func(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest)) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListDDLJobsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) Return(_a0 *rootcoordpb.ListDDLJobsResponse, _a1 error) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListDroppedCollections provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.ListDroppedCollectionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListDroppedCollectionsRequest) *milvuspb.ListDroppedCollectionsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListDroppedCollectionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListDroppedCollectionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDroppedCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDroppedCollections'
+type RootCoord_ListDroppedCollections_Call struct {
+	*mock.Call
+}
+
+// ListDroppedCollections is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.ListDroppedCollectionsRequest
+func (_e *RootCoord_Expecter) ListDroppedCollections(ctx interface{}, req interface{}) *RootCoord_ListDroppedCollections_Call {
+	return &RootCoord_ListDroppedCollections_Call{Call: _e.mock.On("ListDroppedCollections", ctx, req)}
+}
+
+func (_c *RootCoord_ListDroppedCollections_Call) Run(run func(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest)) *RootCoord_ListDroppedCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ListDroppedCollectionsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDroppedCollections_Call) Return(_a0 *milvuspb.ListDroppedCollectionsResponse, _a1 error) *RootCoord_ListDroppedCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1510,6 +1792,100 @@ func (_c *RootCoord_ReportImport_Call) Return(_a0 *commonpb.Status, _a1 error) *
 	return _c
 }
 
+// RestoreBackup provides a mock function with given fields: ctx, req
+func (_m *RootCoord) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.RestoreBackupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RestoreBackupRequest) *milvuspb.RestoreBackupResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.RestoreBackupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RestoreBackupRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type RootCoord_RestoreBackup_Call struct {
+	*mock.Call
+}
+
+// RestoreBackup is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.RestoreBackupRequest
+func (_e *RootCoord_Expecter) RestoreBackup(ctx interface{}, req interface{}) *RootCoord_RestoreBackup_Call {
+	return &RootCoord_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup", ctx, req)}
+}
+
+func (_c *RootCoord_RestoreBackup_Call) Run(run func(ctx context.Context, req *milvuspb.RestoreBackupRequest)) *RootCoord_RestoreBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.RestoreBackupRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RestoreBackup_Call) Return(_a0 *milvuspb.RestoreBackupResponse, _a1 error) *RootCoord_RestoreBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RestoreCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RestoreCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RestoreCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RestoreCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreCollection'
+type RootCoord_RestoreCollection_Call struct {
+	*mock.Call
+}
+
+// RestoreCollection is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.RestoreCollectionRequest
+func (_e *RootCoord_Expecter) RestoreCollection(ctx interface{}, req interface{}) *RootCoord_RestoreCollection_Call {
+	return &RootCoord_RestoreCollection_Call{Call: _e.mock.On("RestoreCollection", ctx, req)}
+}
+
+func (_c *RootCoord_RestoreCollection_Call) Run(run func(ctx context.Context, req *milvuspb.RestoreCollectionRequest)) *RootCoord_RestoreCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.RestoreCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RestoreCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RestoreCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: ctx, req
 func (_m *RootCoord) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -11,6 +11,7 @@ import (
 	milvuspb "github.com/milvus-io/milvus/api/milvuspb"
 	schemapb "github.com/milvus-io/milvus/api/schemapb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
+	planpb "github.com/milvus-io/milvus/internal/proto/planpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -102,21 +103,24 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 type CompactionType int32
 
 const (
-	CompactionType_UndefinedCompaction CompactionType = 0
-	CompactionType_MergeCompaction     CompactionType = 2
-	CompactionType_MixCompaction       CompactionType = 3
+	CompactionType_UndefinedCompaction  CompactionType = 0
+	CompactionType_MergeCompaction      CompactionType = 2
+	CompactionType_MixCompaction        CompactionType = 3
+	CompactionType_ClusteringCompaction CompactionType = 4
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	2: "MergeCompaction",
 	3: "MixCompaction",
+	4: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"MergeCompaction":     2,
-	"MixCompaction":       3,
+	"UndefinedCompaction":  0,
+	"MergeCompaction":      2,
+	"MixCompaction":        3,
+	"ClusteringCompaction": 4,
 }
 
 func (x CompactionType) String() string {
@@ -1660,7 +1664,15 @@ type SegmentInfo struct {
 	// A flag indicating if:
 	// (1) this segment is created by bulk load, and
 	// (2) the bulk load task that creates this segment has not yet reached `ImportCompleted` state.
-	IsImporting          bool     `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	IsImporting bool `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	// IDs of the segments owning the binlogs shared with this segment, only set if this segment is cloned from another collection.
+	SharedFrom []int64 `protobuf:"varint,18,rep,packed,name=shared_from,json=sharedFrom,proto3" json:"shared_from,omitempty"`
+	// The value range of the clustering field, only set if this segment is created by clustering compaction.
+	ClusteringRange *ClusteringRange `protobuf:"bytes,19,opt,name=clustering_range,json=clusteringRange,proto3" json:"clustering_range,omitempty"`
+	// Whether all the insert binlogs of this segment are sorted by primary key.
+	Sorted bool `protobuf:"varint,20,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Whether any binlog of this segment fails the checksum verification of the scrubber.
+	Corrupted            bool     `protobuf:"varint,21,opt,name=corrupted,proto3" json:"corrupted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1810,6 +1822,90 @@ func (m *SegmentInfo) GetIsImporting() bool {
 	return false
 }
 
+func (m *SegmentInfo) GetSharedFrom() []int64 {
+	if m != nil {
+		return m.SharedFrom
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetClusteringRange() *ClusteringRange {
+	if m != nil {
+		return m.ClusteringRange
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetSorted() bool {
+	if m != nil {
+		return m.Sorted
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetCorrupted() bool {
+	if m != nil {
+		return m.Corrupted
+	}
+	return false
+}
+
+// ClusteringRange is the [min, max] value range of the clustering field in a segment.
+type ClusteringRange struct {
+	FieldID              int64                `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Min                  *planpb.GenericValue `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *planpb.GenericValue `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ClusteringRange) Reset()         { *m = ClusteringRange{} }
+func (m *ClusteringRange) String() string { return proto.CompactTextString(m) }
+func (*ClusteringRange) ProtoMessage()    {}
+func (*ClusteringRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{27}
+}
+
+func (m *ClusteringRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteringRange.Unmarshal(m, b)
+}
+func (m *ClusteringRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteringRange.Marshal(b, m, deterministic)
+}
+func (m *ClusteringRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteringRange.Merge(m, src)
+}
+func (m *ClusteringRange) XXX_Size() int {
+	return xxx_messageInfo_ClusteringRange.Size(m)
+}
+func (m *ClusteringRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteringRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteringRange proto.InternalMessageInfo
+
+func (m *ClusteringRange) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *ClusteringRange) GetMin() *planpb.GenericValue {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *ClusteringRange) GetMax() *planpb.GenericValue {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1822,7 +1918,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1858,27 +1954,29 @@ func (m *SegmentStartPosition) GetSegmentID() int64 {
 }
 
 type SaveBinlogPathsRequest struct {
-	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Field2BinlogPaths    []*FieldBinlog          `protobuf:"bytes,4,rep,name=field2BinlogPaths,proto3" json:"field2BinlogPaths,omitempty"`
-	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
-	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
-	Importing            bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Base                *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID           int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID        int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Field2BinlogPaths   []*FieldBinlog          `protobuf:"bytes,4,rep,name=field2BinlogPaths,proto3" json:"field2BinlogPaths,omitempty"`
+	CheckPoints         []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
+	StartPositions      []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Flushed             bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Field2StatslogPaths []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Dropped             bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Importing           bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
+	// Whether the insert binlogs in field2BinlogPaths are sorted by primary key.
+	Sorted               bool     `protobuf:"varint,12,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{} }
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1976,6 +2074,13 @@ func (m *SaveBinlogPathsRequest) GetImporting() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetSorted() bool {
+	if m != nil {
+		return m.Sorted
+	}
+	return false
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1989,7 +2094,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -2046,7 +2151,7 @@ func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
 func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
 func (*DeltaLogInfo) ProtoMessage()    {}
 func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
@@ -2116,7 +2221,7 @@ func (m *DataNodeTtMsg) Reset()         { *m = DataNodeTtMsg{} }
 func (m *DataNodeTtMsg) String() string { return proto.CompactTextString(m) }
 func (*DataNodeTtMsg) ProtoMessage()    {}
 func (*DataNodeTtMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *DataNodeTtMsg) XXX_Unmarshal(b []byte) error {
@@ -2177,7 +2282,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2225,7 +2330,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -2280,7 +2385,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -2338,7 +2443,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2413,7 +2518,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2453,9 +2558,11 @@ type Binlog struct {
 	TimestampFrom uint64 `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
 	TimestampTo   uint64 `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
 	// deprecated
-	LogPath              string   `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
-	LogSize              int64    `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
-	LogID                int64    `protobuf:"varint,6,opt,name=logID,proto3" json:"logID,omitempty"`
+	LogPath string `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
+	LogSize int64  `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
+	LogID   int64  `protobuf:"varint,6,opt,name=logID,proto3" json:"logID,omitempty"`
+	// CRC32C of the whole binlog file, 0 if it's not recorded.
+	Checksum             uint32   `protobuf:"varint,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2465,7 +2572,7 @@ func (m *Binlog) Reset()         { *m = Binlog{} }
 func (m *Binlog) String() string { return proto.CompactTextString(m) }
 func (*Binlog) ProtoMessage()    {}
 func (*Binlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *Binlog) XXX_Unmarshal(b []byte) error {
@@ -2528,6 +2635,13 @@ func (m *Binlog) GetLogID() int64 {
 	return 0
 }
 
+func (m *Binlog) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -2541,7 +2655,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2596,7 +2710,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2651,7 +2765,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2705,7 +2819,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2752,7 +2866,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2803,7 +2917,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{44}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2863,7 +2977,7 @@ func (m *CompactionStateRequest) Reset()         { *m = CompactionStateRequest{}
 func (m *CompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateRequest) ProtoMessage()    {}
 func (*CompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
 func (m *CompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2892,21 +3006,23 @@ func (m *CompactionStateRequest) GetBase() *commonpb.MsgBase {
 }
 
 type SyncSegmentsRequest struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	CompactedTo          int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	CompactedFrom        []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
-	StatsLogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID        int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CompactedTo   int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	NumOfRows     int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	CompactedFrom []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
+	StatsLogs     []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
+	// segments created by clustering compaction, compacted_to is not used if it's set.
+	ClusteredSegments    []*ClusteredSegment `protobuf:"bytes,6,rep,name=clustered_segments,json=clusteredSegments,proto3" json:"clustered_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
 func (m *SyncSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncSegmentsRequest) ProtoMessage()    {}
 func (*SyncSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{45}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *SyncSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2962,6 +3078,13 @@ func (m *SyncSegmentsRequest) GetStatsLogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SyncSegmentsRequest) GetClusteredSegments() []*ClusteredSegment {
+	if m != nil {
+		return m.ClusteredSegments
+	}
+	return nil
+}
+
 type CompactionSegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
@@ -2977,7 +3100,7 @@ func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlo
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{46}
+	return fileDescriptor_82cd95f524594f49, []int{47}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -3034,23 +3157,27 @@ func (m *CompactionSegmentBinlogs) GetInsertChannel() string {
 }
 
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID           int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs   []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime        uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type             CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel       uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel          string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the field to sort and split the segments on for clustering compaction
+	ClusteringFieldID int64 `protobuf:"varint,8,opt,name=clustering_fieldID,json=clusteringFieldID,proto3" json:"clustering_fieldID,omitempty"`
+	// the max number of rows of each segment created by clustering compaction
+	MaxSegmentRows       int64    `protobuf:"varint,9,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{47}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -3120,23 +3247,41 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetClusteringFieldID() int64 {
+	if m != nil {
+		return m.ClusteringFieldID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	// segments created by clustering compaction, the fields above are not used if it's set.
+	ClusteredSegments []*ClusteredSegment `protobuf:"bytes,7,rep,name=clustered_segments,json=clusteredSegments,proto3" json:"clustered_segments,omitempty"`
+	// Whether the insert binlogs of the compacted segments are sorted by primary key.
+	Sorted               bool     `protobuf:"varint,8,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{48}
+	return fileDescriptor_82cd95f524594f49, []int{49}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -3199,6 +3344,99 @@ func (m *CompactionResult) GetDeltalogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *CompactionResult) GetClusteredSegments() []*ClusteredSegment {
+	if m != nil {
+		return m.ClusteredSegments
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetSorted() bool {
+	if m != nil {
+		return m.Sorted
+	}
+	return false
+}
+
+type ClusteredSegment struct {
+	SegmentID            int64            `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64            `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog   `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog   `protobuf:"bytes,4,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog   `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringRange      *ClusteringRange `protobuf:"bytes,6,opt,name=clustering_range,json=clusteringRange,proto3" json:"clustering_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ClusteredSegment) Reset()         { *m = ClusteredSegment{} }
+func (m *ClusteredSegment) String() string { return proto.CompactTextString(m) }
+func (*ClusteredSegment) ProtoMessage()    {}
+func (*ClusteredSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{50}
+}
+
+func (m *ClusteredSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteredSegment.Unmarshal(m, b)
+}
+func (m *ClusteredSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteredSegment.Marshal(b, m, deterministic)
+}
+func (m *ClusteredSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteredSegment.Merge(m, src)
+}
+func (m *ClusteredSegment) XXX_Size() int {
+	return xxx_messageInfo_ClusteredSegment.Size(m)
+}
+func (m *ClusteredSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteredSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteredSegment proto.InternalMessageInfo
+
+func (m *ClusteredSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ClusteredSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *ClusteredSegment) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *ClusteredSegment) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *ClusteredSegment) GetDeltalogs() []*FieldBinlog {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *ClusteredSegment) GetClusteringRange() *ClusteringRange {
+	if m != nil {
+		return m.ClusteringRange
+	}
+	return nil
+}
+
 type CompactionStateResult struct {
 	PlanID               int64                    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
@@ -3212,7 +3450,7 @@ func (m *CompactionStateResult) Reset()         { *m = CompactionStateResult{} }
 func (m *CompactionStateResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResult) ProtoMessage()    {}
 func (*CompactionStateResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{49}
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
 func (m *CompactionStateResult) XXX_Unmarshal(b []byte) error {
@@ -3266,7 +3504,7 @@ func (m *CompactionStateResponse) Reset()         { *m = CompactionStateResponse
 func (m *CompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResponse) ProtoMessage()    {}
 func (*CompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{50}
+	return fileDescriptor_82cd95f524594f49, []int{52}
 }
 
 func (m *CompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3314,7 +3552,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{51}
+	return fileDescriptor_82cd95f524594f49, []int{53}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -3362,7 +3600,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{52}
+	return fileDescriptor_82cd95f524594f49, []int{54}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -3415,7 +3653,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{53}
+	return fileDescriptor_82cd95f524594f49, []int{55}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -3456,7 +3694,7 @@ func (m *SetSegmentStateRequest) Reset()         { *m = SetSegmentStateRequest{}
 func (m *SetSegmentStateRequest) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateRequest) ProtoMessage()    {}
 func (*SetSegmentStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{54}
+	return fileDescriptor_82cd95f524594f49, []int{56}
 }
 
 func (m *SetSegmentStateRequest) XXX_Unmarshal(b []byte) error {
@@ -3509,7 +3747,7 @@ func (m *SetSegmentStateResponse) Reset()         { *m = SetSegmentStateResponse
 func (m *SetSegmentStateResponse) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateResponse) ProtoMessage()    {}
 func (*SetSegmentStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{55}
+	return fileDescriptor_82cd95f524594f49, []int{57}
 }
 
 func (m *SetSegmentStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3550,7 +3788,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{56}
+	return fileDescriptor_82cd95f524594f49, []int{58}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3610,7 +3848,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{57}
+	return fileDescriptor_82cd95f524594f49, []int{59}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -3698,7 +3936,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{58}
+	return fileDescriptor_82cd95f524594f49, []int{60}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -3744,7 +3982,7 @@ func (m *ImportTask) Reset()         { *m = ImportTask{} }
 func (m *ImportTask) String() string { return proto.CompactTextString(m) }
 func (*ImportTask) ProtoMessage()    {}
 func (*ImportTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{59}
+	return fileDescriptor_82cd95f524594f49, []int{61}
 }
 
 func (m *ImportTask) XXX_Unmarshal(b []byte) error {
@@ -3836,7 +4074,7 @@ func (m *ImportTaskState) Reset()         { *m = ImportTaskState{} }
 func (m *ImportTaskState) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskState) ProtoMessage()    {}
 func (*ImportTaskState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{60}
+	return fileDescriptor_82cd95f524594f49, []int{62}
 }
 
 func (m *ImportTaskState) XXX_Unmarshal(b []byte) error {
@@ -3906,6 +4144,7 @@ type ImportTaskInfo struct {
 	State                *ImportTaskState `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
 	CollectionName       string           `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	PartitionName        string           `protobuf:"bytes,13,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	EndTs                uint64           `protobuf:"varint,14,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -3915,7 +4154,7 @@ func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
 func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskInfo) ProtoMessage()    {}
 func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{61}
+	return fileDescriptor_82cd95f524594f49, []int{63}
 }
 
 func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
@@ -4028,6 +4267,13 @@ func (m *ImportTaskInfo) GetPartitionName() string {
 	return ""
 }
 
+func (m *ImportTaskInfo) GetEndTs() uint64 {
+	if m != nil {
+		return m.EndTs
+	}
+	return 0
+}
+
 type ImportTaskResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
@@ -4040,7 +4286,7 @@ func (m *ImportTaskResponse) Reset()         { *m = ImportTaskResponse{} }
 func (m *ImportTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskResponse) ProtoMessage()    {}
 func (*ImportTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{62}
+	return fileDescriptor_82cd95f524594f49, []int{64}
 }
 
 func (m *ImportTaskResponse) XXX_Unmarshal(b []byte) error {
@@ -4088,7 +4334,7 @@ func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
 func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskRequest) ProtoMessage()    {}
 func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{63}
+	return fileDescriptor_82cd95f524594f49, []int{65}
 }
 
 func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -4142,7 +4388,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{64}
+	return fileDescriptor_82cd95f524594f49, []int{66}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -4188,7 +4434,7 @@ func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsReq
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{65}
+	return fileDescriptor_82cd95f524594f49, []int{67}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4228,7 +4474,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{66}
+	return fileDescriptor_82cd95f524594f49, []int{68}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4280,7 +4526,7 @@ func (m *AddImportSegmentRequest) Reset()         { *m = AddImportSegmentRequest
 func (m *AddImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentRequest) ProtoMessage()    {}
 func (*AddImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{67}
+	return fileDescriptor_82cd95f524594f49, []int{69}
 }
 
 func (m *AddImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4362,7 +4608,7 @@ func (m *AddImportSegmentResponse) Reset()         { *m = AddImportSegmentRespon
 func (m *AddImportSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentResponse) ProtoMessage()    {}
 func (*AddImportSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{68}
+	return fileDescriptor_82cd95f524594f49, []int{70}
 }
 
 func (m *AddImportSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -4415,7 +4661,7 @@ func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentReque
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{71}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4504,7 +4750,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{72}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4551,7 +4797,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4586,7 +4832,133 @@ func (m *MarkSegmentsDroppedRequest) GetSegmentIds() []int64 {
 	return nil
 }
 
-type SegmentReferenceLock struct {
+type CloneSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	TargetCollectionID   int64             `protobuf:"varint,3,opt,name=target_collectionID,json=targetCollectionID,proto3" json:"target_collectionID,omitempty"`
+	PartitionMapping     map[int64]int64   `protobuf:"bytes,4,rep,name=partition_mapping,json=partitionMapping,proto3" json:"partition_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ChannelMapping       map[string]string `protobuf:"bytes,5,rep,name=channel_mapping,json=channelMapping,proto3" json:"channel_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timestamp            uint64            `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CloneSegmentsRequest) Reset()         { *m = CloneSegmentsRequest{} }
+func (m *CloneSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneSegmentsRequest) ProtoMessage()    {}
+func (*CloneSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{74}
+}
+
+func (m *CloneSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneSegmentsRequest.Unmarshal(m, b)
+}
+func (m *CloneSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneSegmentsRequest.Merge(m, src)
+}
+func (m *CloneSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneSegmentsRequest.Size(m)
+}
+func (m *CloneSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneSegmentsRequest proto.InternalMessageInfo
+
+func (m *CloneSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CloneSegmentsRequest) GetTargetCollectionID() int64 {
+	if m != nil {
+		return m.TargetCollectionID
+	}
+	return 0
+}
+
+func (m *CloneSegmentsRequest) GetPartitionMapping() map[int64]int64 {
+	if m != nil {
+		return m.PartitionMapping
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetChannelMapping() map[string]string {
+	if m != nil {
+		return m.ChannelMapping
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type CloneSegmentsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CloneSegmentsResponse) Reset()         { *m = CloneSegmentsResponse{} }
+func (m *CloneSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*CloneSegmentsResponse) ProtoMessage()    {}
+func (*CloneSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{75}
+}
+
+func (m *CloneSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneSegmentsResponse.Unmarshal(m, b)
+}
+func (m *CloneSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *CloneSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneSegmentsResponse.Merge(m, src)
+}
+func (m *CloneSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_CloneSegmentsResponse.Size(m)
+}
+func (m *CloneSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneSegmentsResponse proto.InternalMessageInfo
+
+func (m *CloneSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CloneSegmentsResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type SegmentReferenceLock struct {
 	TaskID               int64    `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
 	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
@@ -4599,7 +4971,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4641,6 +5013,210 @@ func (m *SegmentReferenceLock) GetSegmentIDs() []int64 {
 	return nil
 }
 
+type UpdateChannelCheckpointRequest struct {
+	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	VChannel             string                  `protobuf:"bytes,2,opt,name=vChannel,proto3" json:"vChannel,omitempty"`
+	Position             *internalpb.MsgPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *UpdateChannelCheckpointRequest) Reset()         { *m = UpdateChannelCheckpointRequest{} }
+func (m *UpdateChannelCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateChannelCheckpointRequest) ProtoMessage()    {}
+func (*UpdateChannelCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{77}
+}
+
+func (m *UpdateChannelCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChannelCheckpointRequest.Unmarshal(m, b)
+}
+func (m *UpdateChannelCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChannelCheckpointRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateChannelCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChannelCheckpointRequest.Merge(m, src)
+}
+func (m *UpdateChannelCheckpointRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateChannelCheckpointRequest.Size(m)
+}
+func (m *UpdateChannelCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChannelCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChannelCheckpointRequest proto.InternalMessageInfo
+
+func (m *UpdateChannelCheckpointRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpdateChannelCheckpointRequest) GetVChannel() string {
+	if m != nil {
+		return m.VChannel
+	}
+	return ""
+}
+
+func (m *UpdateChannelCheckpointRequest) GetPosition() *internalpb.MsgPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+type GetChannelCheckpointsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	VChannels            []string          `protobuf:"bytes,2,rep,name=vChannels,proto3" json:"vChannels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetChannelCheckpointsRequest) Reset()         { *m = GetChannelCheckpointsRequest{} }
+func (m *GetChannelCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelCheckpointsRequest) ProtoMessage()    {}
+func (*GetChannelCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{78}
+}
+
+func (m *GetChannelCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelCheckpointsRequest.Unmarshal(m, b)
+}
+func (m *GetChannelCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelCheckpointsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChannelCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelCheckpointsRequest.Merge(m, src)
+}
+func (m *GetChannelCheckpointsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChannelCheckpointsRequest.Size(m)
+}
+func (m *GetChannelCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelCheckpointsRequest proto.InternalMessageInfo
+
+func (m *GetChannelCheckpointsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetChannelCheckpointsRequest) GetVChannels() []string {
+	if m != nil {
+		return m.VChannels
+	}
+	return nil
+}
+
+type ChannelCheckpoint struct {
+	VChannel             string                  `protobuf:"bytes,1,opt,name=vChannel,proto3" json:"vChannel,omitempty"`
+	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	LagMs                int64                   `protobuf:"varint,3,opt,name=lag_ms,json=lagMs,proto3" json:"lag_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ChannelCheckpoint) Reset()         { *m = ChannelCheckpoint{} }
+func (m *ChannelCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ChannelCheckpoint) ProtoMessage()    {}
+func (*ChannelCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{79}
+}
+
+func (m *ChannelCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCheckpoint.Unmarshal(m, b)
+}
+func (m *ChannelCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *ChannelCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCheckpoint.Merge(m, src)
+}
+func (m *ChannelCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_ChannelCheckpoint.Size(m)
+}
+func (m *ChannelCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCheckpoint proto.InternalMessageInfo
+
+func (m *ChannelCheckpoint) GetVChannel() string {
+	if m != nil {
+		return m.VChannel
+	}
+	return ""
+}
+
+func (m *ChannelCheckpoint) GetPosition() *internalpb.MsgPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+func (m *ChannelCheckpoint) GetLagMs() int64 {
+	if m != nil {
+		return m.LagMs
+	}
+	return 0
+}
+
+type GetChannelCheckpointsResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Checkpoints          []*ChannelCheckpoint `protobuf:"bytes,2,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetChannelCheckpointsResponse) Reset()         { *m = GetChannelCheckpointsResponse{} }
+func (m *GetChannelCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChannelCheckpointsResponse) ProtoMessage()    {}
+func (*GetChannelCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{80}
+}
+
+func (m *GetChannelCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelCheckpointsResponse.Unmarshal(m, b)
+}
+func (m *GetChannelCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelCheckpointsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChannelCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelCheckpointsResponse.Merge(m, src)
+}
+func (m *GetChannelCheckpointsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChannelCheckpointsResponse.Size(m)
+}
+func (m *GetChannelCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelCheckpointsResponse proto.InternalMessageInfo
+
+func (m *GetChannelCheckpointsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetChannelCheckpointsResponse) GetCheckpoints() []*ChannelCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
 	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
@@ -4672,6 +5248,7 @@ func init() {
 	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
 	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*ClusteringRange)(nil), "milvus.proto.data.ClusteringRange")
 	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
 	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
 	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
@@ -4694,6 +5271,7 @@ func init() {
 	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
 	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
 	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*ClusteredSegment)(nil), "milvus.proto.data.ClusteredSegment")
 	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
 	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
 	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
@@ -4717,274 +5295,314 @@ func init() {
 	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
 	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
 	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
+	proto.RegisterType((*CloneSegmentsRequest)(nil), "milvus.proto.data.CloneSegmentsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.data.CloneSegmentsRequest.ChannelMappingEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.data.CloneSegmentsRequest.PartitionMappingEntry")
+	proto.RegisterType((*CloneSegmentsResponse)(nil), "milvus.proto.data.CloneSegmentsResponse")
 	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
+	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
+	proto.RegisterType((*GetChannelCheckpointsRequest)(nil), "milvus.proto.data.GetChannelCheckpointsRequest")
+	proto.RegisterType((*ChannelCheckpoint)(nil), "milvus.proto.data.ChannelCheckpoint")
+	proto.RegisterType((*GetChannelCheckpointsResponse)(nil), "milvus.proto.data.GetChannelCheckpointsResponse")
 }
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x6f, 0x1c, 0x59,
-	0x5a, 0xa9, 0xbe, 0xb9, 0xfb, 0xeb, 0x8b, 0xdb, 0x27, 0x19, 0xbb, 0xd3, 0xb9, 0x4e, 0xcd, 0x24,
-	0x93, 0xc9, 0x64, 0x92, 0x19, 0x0f, 0xa3, 0x1d, 0x91, 0x9d, 0x59, 0x8d, 0xe3, 0x89, 0xd3, 0x60,
-	0x7b, 0xbd, 0x65, 0x67, 0x22, 0xed, 0x22, 0xb5, 0x2a, 0x5d, 0xc7, 0xed, 0x5a, 0x77, 0x55, 0x75,
-	0xaa, 0xaa, 0xe3, 0x78, 0x79, 0xd8, 0x11, 0x2b, 0x21, 0x2d, 0x42, 0x2c, 0x17, 0x21, 0xc1, 0x03,
-	0x12, 0xe2, 0x69, 0x01, 0x21, 0x21, 0xad, 0x78, 0x00, 0x84, 0x78, 0xe0, 0x65, 0x05, 0x48, 0x88,
-	0x17, 0x1e, 0xf8, 0x01, 0xf0, 0x03, 0xf8, 0x03, 0xe8, 0x5c, 0xea, 0xd4, 0xed, 0x54, 0x77, 0xb9,
-	0x3b, 0x99, 0x20, 0xf6, 0xcd, 0xe7, 0xeb, 0xef, 0x7c, 0xe7, 0x3b, 0xdf, 0xf9, 0xee, 0xe7, 0x94,
-	0xa1, 0x6d, 0xe8, 0xbe, 0xde, 0x1f, 0x38, 0x8e, 0x6b, 0xdc, 0x1d, 0xbb, 0x8e, 0xef, 0xa0, 0x15,
-	0xcb, 0x1c, 0x3d, 0x9f, 0x78, 0x6c, 0x74, 0x97, 0xfc, 0xdc, 0x6d, 0x0c, 0x1c, 0xcb, 0x72, 0x6c,
-	0x06, 0xea, 0xb6, 0x4c, 0xdb, 0xc7, 0xae, 0xad, 0x8f, 0xf8, 0xb8, 0x11, 0x9d, 0xd0, 0x6d, 0x78,
-	0x83, 0x23, 0x6c, 0xe9, 0x6c, 0xa4, 0x2e, 0x41, 0xf9, 0x0b, 0x6b, 0xec, 0x9f, 0xaa, 0x7f, 0xa4,
-	0x40, 0xe3, 0xe1, 0x68, 0xe2, 0x1d, 0x69, 0xf8, 0xd9, 0x04, 0x7b, 0x3e, 0xfa, 0x00, 0x4a, 0x4f,
-	0x75, 0x0f, 0x77, 0x94, 0xeb, 0xca, 0xad, 0xfa, 0xfa, 0xe5, 0xbb, 0xb1, 0x55, 0xf9, 0x7a, 0x3b,
-	0xde, 0x70, 0x43, 0xf7, 0xb0, 0x46, 0x31, 0x11, 0x82, 0x92, 0xf1, 0xb4, 0xb7, 0xd9, 0x29, 0x5c,
-	0x57, 0x6e, 0x15, 0x35, 0xfa, 0x37, 0xba, 0x0a, 0xe0, 0xe1, 0xa1, 0x85, 0x6d, 0xbf, 0xb7, 0xe9,
-	0x75, 0x8a, 0xd7, 0x8b, 0xb7, 0x8a, 0x5a, 0x04, 0x82, 0x54, 0x68, 0x0c, 0x9c, 0xd1, 0x08, 0x0f,
-	0x7c, 0xd3, 0xb1, 0x7b, 0x9b, 0x9d, 0x12, 0x9d, 0x1b, 0x83, 0xa9, 0xff, 0xa5, 0x40, 0x93, 0xb3,
-	0xe6, 0x8d, 0x1d, 0xdb, 0xc3, 0xe8, 0x23, 0xa8, 0x78, 0xbe, 0xee, 0x4f, 0x3c, 0xce, 0xdd, 0x25,
-	0x29, 0x77, 0xfb, 0x14, 0x45, 0xe3, 0xa8, 0x52, 0xf6, 0x92, 0xcb, 0x17, 0xd3, 0xcb, 0x27, 0xb6,
-	0x50, 0x4a, 0x6d, 0xe1, 0x16, 0x2c, 0x1f, 0x12, 0xee, 0xf6, 0x43, 0xa4, 0x32, 0x45, 0x4a, 0x82,
-	0x09, 0x25, 0xdf, 0xb4, 0xf0, 0xb7, 0x0f, 0xf7, 0xb1, 0x3e, 0xea, 0x54, 0xe8, 0x5a, 0x11, 0x88,
-	0xfa, 0xef, 0x0a, 0xb4, 0x05, 0x7a, 0x70, 0x0e, 0x17, 0xa0, 0x3c, 0x70, 0x26, 0xb6, 0x4f, 0xb7,
-	0xda, 0xd4, 0xd8, 0x00, 0xbd, 0x09, 0x8d, 0xc1, 0x91, 0x6e, 0xdb, 0x78, 0xd4, 0xb7, 0x75, 0x0b,
-	0xd3, 0x4d, 0xd5, 0xb4, 0x3a, 0x87, 0xed, 0xea, 0x16, 0xce, 0xb5, 0xb7, 0xeb, 0x50, 0x1f, 0xeb,
-	0xae, 0x6f, 0xc6, 0xa4, 0x1f, 0x05, 0xa1, 0x2e, 0x54, 0x4d, 0xaf, 0x67, 0x8d, 0x1d, 0xd7, 0xef,
-	0x94, 0xaf, 0x2b, 0xb7, 0xaa, 0x9a, 0x18, 0x93, 0x15, 0x4c, 0xfa, 0xd7, 0x81, 0xee, 0x1d, 0xf7,
-	0x36, 0xf9, 0x8e, 0x62, 0x30, 0xf5, 0x4f, 0x15, 0x58, 0xfd, 0xdc, 0xf3, 0xcc, 0xa1, 0x9d, 0xda,
-	0xd9, 0x2a, 0x54, 0x6c, 0xc7, 0xc0, 0xbd, 0x4d, 0xba, 0xb5, 0xa2, 0xc6, 0x47, 0xe8, 0x12, 0xd4,
-	0xc6, 0x18, 0xbb, 0x7d, 0xd7, 0x19, 0x05, 0x1b, 0xab, 0x12, 0x80, 0xe6, 0x8c, 0x30, 0xfa, 0x0e,
-	0xac, 0x78, 0x09, 0x42, 0x4c, 0xaf, 0xea, 0xeb, 0x6f, 0xdd, 0x4d, 0x59, 0xc6, 0xdd, 0xe4, 0xa2,
-	0x5a, 0x7a, 0xb6, 0xfa, 0x55, 0x01, 0xce, 0x0b, 0x3c, 0xc6, 0x2b, 0xf9, 0x9b, 0x48, 0xde, 0xc3,
-	0x43, 0xc1, 0x1e, 0x1b, 0xe4, 0x91, 0xbc, 0x38, 0xb2, 0x62, 0xf4, 0xc8, 0x72, 0xa8, 0x7a, 0xf2,
-	0x3c, 0xca, 0xe9, 0xf3, 0xb8, 0x06, 0x75, 0xfc, 0x62, 0x6c, 0xba, 0xb8, 0x4f, 0x14, 0x87, 0x8a,
-	0xbc, 0xa4, 0x01, 0x03, 0x1d, 0x98, 0x56, 0xd4, 0x36, 0x96, 0x72, 0xdb, 0x86, 0xfa, 0x67, 0x0a,
-	0xac, 0xa5, 0x4e, 0x89, 0x1b, 0x9b, 0x06, 0x6d, 0xba, 0xf3, 0x50, 0x32, 0xc4, 0xec, 0x88, 0xc0,
-	0x6f, 0x4e, 0x13, 0x78, 0x88, 0xae, 0xa5, 0xe6, 0x47, 0x98, 0x2c, 0xe4, 0x67, 0xf2, 0x18, 0xd6,
-	0xb6, 0xb0, 0xcf, 0x17, 0x20, 0xbf, 0x61, 0x6f, 0x7e, 0x67, 0x15, 0xb7, 0xea, 0x42, 0xd2, 0xaa,
-	0xd5, 0xbf, 0x2e, 0x08, 0x5b, 0xa4, 0x4b, 0xf5, 0xec, 0x43, 0x07, 0x5d, 0x86, 0x9a, 0x40, 0xe1,
-	0x5a, 0x11, 0x02, 0xd0, 0x37, 0xa0, 0x4c, 0x38, 0x65, 0x2a, 0xd1, 0x5a, 0x7f, 0x53, 0xbe, 0xa7,
-	0x08, 0x4d, 0x8d, 0xe1, 0xa3, 0x1e, 0xb4, 0x3c, 0x5f, 0x77, 0xfd, 0xfe, 0xd8, 0xf1, 0xe8, 0x39,
-	0x53, 0xc5, 0xa9, 0xaf, 0xab, 0x71, 0x0a, 0xc2, 0xad, 0xef, 0x78, 0xc3, 0x3d, 0x8e, 0xa9, 0x35,
-	0xe9, 0xcc, 0x60, 0x88, 0xbe, 0x80, 0x06, 0xb6, 0x8d, 0x90, 0x50, 0x29, 0x37, 0xa1, 0x3a, 0xb6,
-	0x0d, 0x41, 0x26, 0x3c, 0x9f, 0x72, 0xfe, 0xf3, 0xf9, 0x6d, 0x05, 0x3a, 0xe9, 0x03, 0x5a, 0xc4,
-	0x65, 0xdf, 0x67, 0x93, 0x30, 0x3b, 0xa0, 0xa9, 0x16, 0x2e, 0x0e, 0x49, 0xe3, 0x53, 0xd4, 0x3f,
-	0x54, 0xe0, 0x8d, 0x90, 0x1d, 0xfa, 0xd3, 0xab, 0xd2, 0x16, 0x74, 0x1b, 0xda, 0xa6, 0x3d, 0x18,
-	0x4d, 0x0c, 0xfc, 0xd8, 0x7e, 0x84, 0xf5, 0x91, 0x7f, 0x74, 0x4a, 0xcf, 0xb0, 0xaa, 0xa5, 0xe0,
-	0xea, 0x8f, 0x14, 0x58, 0x4d, 0xf2, 0xb5, 0x88, 0x90, 0x7e, 0x09, 0xca, 0xa6, 0x7d, 0xe8, 0x04,
-	0x32, 0xba, 0x3a, 0xc5, 0x28, 0xc9, 0x5a, 0x0c, 0x59, 0xb5, 0xe0, 0xd2, 0x16, 0xf6, 0x7b, 0xb6,
-	0x87, 0x5d, 0x7f, 0xc3, 0xb4, 0x47, 0xce, 0x70, 0x4f, 0xf7, 0x8f, 0x16, 0x30, 0xa8, 0x98, 0x6d,
-	0x14, 0x12, 0xb6, 0xa1, 0xfe, 0x54, 0x81, 0xcb, 0xf2, 0xf5, 0xf8, 0xd6, 0xbb, 0x50, 0x3d, 0x34,
-	0xf1, 0xc8, 0x20, 0xf2, 0x55, 0xa8, 0x7c, 0xc5, 0x98, 0x18, 0xd6, 0x98, 0x20, 0xf3, 0x1d, 0xbe,
-	0x99, 0xa1, 0xcd, 0xfb, 0xbe, 0x6b, 0xda, 0xc3, 0x6d, 0xd3, 0xf3, 0x35, 0x86, 0x1f, 0x91, 0x67,
-	0x31, 0xbf, 0x1a, 0xff, 0x96, 0x02, 0x57, 0xb7, 0xb0, 0xff, 0x40, 0xf8, 0x65, 0xf2, 0xbb, 0xe9,
-	0xf9, 0xe6, 0xc0, 0x7b, 0xb9, 0xb9, 0x51, 0x8e, 0x00, 0xad, 0xfe, 0x44, 0x81, 0x6b, 0x99, 0xcc,
-	0x70, 0xd1, 0x71, 0xbf, 0x13, 0x78, 0x65, 0xb9, 0xdf, 0xf9, 0x55, 0x7c, 0xfa, 0xa5, 0x3e, 0x9a,
-	0xe0, 0x3d, 0xdd, 0x74, 0x99, 0xdf, 0x99, 0xd3, 0x0b, 0xff, 0x95, 0x02, 0x57, 0xb6, 0xb0, 0xbf,
-	0x17, 0xc4, 0xa4, 0xd7, 0x28, 0x1d, 0x82, 0x13, 0x89, 0x8d, 0x41, 0x72, 0x16, 0x83, 0xa9, 0xbf,
-	0xc3, 0x8e, 0x53, 0xca, 0xef, 0x6b, 0x11, 0xe0, 0x55, 0x6a, 0x09, 0x11, 0x93, 0x7c, 0xc0, 0x52,
-	0x07, 0x2e, 0x3e, 0xf5, 0x4f, 0x14, 0xb8, 0xf8, 0xf9, 0xe0, 0xd9, 0xc4, 0x74, 0x31, 0x47, 0xda,
-	0x76, 0x06, 0xc7, 0xf3, 0x0b, 0x37, 0x4c, 0xb3, 0x0a, 0xb1, 0x34, 0x6b, 0x56, 0x6a, 0xbe, 0x0a,
-	0x15, 0x9f, 0xe5, 0x75, 0x2c, 0x53, 0xe1, 0x23, 0xca, 0x9f, 0x86, 0x47, 0x58, 0xf7, 0xfe, 0x6f,
-	0xf2, 0xf7, 0x93, 0x12, 0x34, 0xbe, 0xe4, 0xe9, 0x18, 0x8d, 0xda, 0x49, 0x4d, 0x52, 0xe4, 0x89,
-	0x57, 0x24, 0x83, 0x93, 0x25, 0x75, 0x5b, 0xd0, 0xf4, 0x30, 0x3e, 0x9e, 0x27, 0x46, 0x37, 0xc8,
-	0x44, 0x11, 0x5b, 0xb7, 0x61, 0x65, 0x62, 0xd3, 0xd2, 0x00, 0x1b, 0x5c, 0x80, 0x4c, 0x73, 0x67,
-	0xfb, 0xee, 0xf4, 0x44, 0xf4, 0x88, 0x57, 0x1f, 0x11, 0x5a, 0xe5, 0x5c, 0xb4, 0x92, 0xd3, 0x50,
-	0x0f, 0xda, 0x86, 0xeb, 0x8c, 0xc7, 0xd8, 0xe8, 0x7b, 0x01, 0xa9, 0x4a, 0x3e, 0x52, 0x7c, 0x9e,
-	0x20, 0xf5, 0x01, 0x9c, 0x4f, 0x72, 0xda, 0x33, 0x48, 0x42, 0x4a, 0xce, 0x50, 0xf6, 0x13, 0xba,
-	0x03, 0x2b, 0x69, 0xfc, 0x2a, 0xc5, 0x4f, 0xff, 0x80, 0xde, 0x07, 0x94, 0x60, 0x95, 0xa0, 0xd7,
-	0x18, 0x7a, 0x9c, 0x99, 0x9e, 0xe1, 0xa9, 0x3f, 0x56, 0x60, 0xf5, 0x89, 0xee, 0x0f, 0x8e, 0x36,
-	0x2d, 0x6e, 0x6b, 0x0b, 0xf8, 0xaa, 0x4f, 0xa1, 0xf6, 0x9c, 0xeb, 0x45, 0x10, 0x90, 0xae, 0x49,
-	0xe4, 0x13, 0xd5, 0x40, 0x2d, 0x9c, 0xa1, 0xfe, 0x5c, 0x81, 0x0b, 0x0f, 0x23, 0x75, 0xe1, 0x6b,
-	0xf0, 0x9a, 0xb3, 0x0a, 0xda, 0x9b, 0xd0, 0xb2, 0x74, 0xf7, 0x38, 0x55, 0xcf, 0x26, 0xa0, 0xea,
-	0x0b, 0x00, 0x3e, 0xda, 0xf1, 0x86, 0x73, 0xf0, 0xff, 0x09, 0x2c, 0xf1, 0x55, 0xb9, 0xfb, 0x9c,
-	0xa5, 0x67, 0x01, 0xba, 0xfa, 0xcf, 0x0a, 0xb4, 0xc2, 0x90, 0x48, 0x8d, 0xbc, 0x05, 0x05, 0x61,
-	0xda, 0x85, 0xde, 0x26, 0xfa, 0x14, 0x2a, 0xac, 0xd1, 0xc1, 0x69, 0xdf, 0x88, 0xd3, 0xe6, 0x4d,
-	0x90, 0x48, 0x5c, 0xa5, 0x00, 0x8d, 0x4f, 0x22, 0x32, 0x12, 0x51, 0x44, 0x38, 0x9f, 0x10, 0x82,
-	0x7a, 0xb0, 0x1c, 0x4f, 0xd9, 0x03, 0x13, 0xbe, 0x9e, 0x15, 0x3c, 0x36, 0x75, 0x5f, 0xa7, 0xb1,
-	0xa3, 0x15, 0xcb, 0xd8, 0x3d, 0xf5, 0xf7, 0x2a, 0x50, 0x8f, 0xec, 0x32, 0xb5, 0x93, 0xe4, 0x91,
-	0x16, 0x66, 0xd7, 0x8d, 0xc5, 0x74, 0xdd, 0x78, 0x03, 0x5a, 0x26, 0x4d, 0xbe, 0xfa, 0x5c, 0x15,
-	0xa9, 0xd7, 0xac, 0x69, 0x4d, 0x06, 0xe5, 0x76, 0x81, 0xae, 0x42, 0xdd, 0x9e, 0x58, 0x7d, 0xe7,
-	0xb0, 0xef, 0x3a, 0x27, 0x1e, 0x2f, 0x40, 0x6b, 0xf6, 0xc4, 0xfa, 0xf6, 0xa1, 0xe6, 0x9c, 0x78,
-	0x61, 0x8d, 0x53, 0x39, 0x63, 0x8d, 0x73, 0x15, 0xea, 0x96, 0xfe, 0x82, 0x50, 0xed, 0xdb, 0x13,
-	0x8b, 0xd6, 0xa6, 0x45, 0xad, 0x66, 0xe9, 0x2f, 0x34, 0xe7, 0x64, 0x77, 0x62, 0xa1, 0x5b, 0xd0,
-	0x1e, 0xe9, 0x9e, 0xdf, 0x8f, 0x16, 0xb7, 0x55, 0x5a, 0xdc, 0xb6, 0x08, 0xfc, 0x8b, 0xb0, 0xc0,
-	0x4d, 0x57, 0x4b, 0xb5, 0x05, 0xaa, 0x25, 0xc3, 0x1a, 0x85, 0x84, 0x20, 0x7f, 0xb5, 0x64, 0x58,
-	0x23, 0x41, 0xe6, 0x13, 0x58, 0x7a, 0x4a, 0x53, 0x5a, 0xaf, 0x53, 0xcf, 0x74, 0x98, 0x0f, 0x49,
-	0x36, 0xcb, 0x32, 0x5f, 0x2d, 0x40, 0x47, 0xdf, 0x84, 0x1a, 0xcd, 0x24, 0xe8, 0xdc, 0x46, 0xae,
-	0xb9, 0xe1, 0x04, 0x32, 0xdb, 0xc0, 0x23, 0x5f, 0xa7, 0xb3, 0x9b, 0xf9, 0x66, 0x8b, 0x09, 0xc4,
-	0x49, 0x0f, 0x5c, 0xac, 0xfb, 0xd8, 0xd8, 0x38, 0x7d, 0xe0, 0x58, 0x63, 0x9d, 0x2a, 0x53, 0xa7,
-	0x45, 0xcb, 0x16, 0xd9, 0x4f, 0xc4, 0x31, 0x0c, 0xc4, 0xe8, 0xa1, 0xeb, 0x58, 0x9d, 0x65, 0xe6,
-	0x18, 0xe2, 0x50, 0x74, 0x05, 0x20, 0x70, 0xcf, 0xba, 0xdf, 0x69, 0xd3, 0x53, 0xac, 0x71, 0xc8,
-	0xe7, 0xb4, 0x77, 0x65, 0x7a, 0x7d, 0xd6, 0x25, 0x32, 0xed, 0x61, 0x67, 0x85, 0xae, 0x58, 0x0f,
-	0xda, 0x4a, 0xa6, 0x3d, 0x54, 0x7f, 0x08, 0x17, 0x42, 0x25, 0x8a, 0x1c, 0x58, 0xfa, 0xec, 0x95,
-	0x79, 0xcf, 0x7e, 0x7a, 0xbd, 0xf2, 0x6f, 0x25, 0x58, 0xdd, 0xd7, 0x9f, 0xe3, 0x57, 0x5f, 0x1a,
-	0xe5, 0x72, 0xd9, 0xdb, 0xb0, 0x42, 0xab, 0xa1, 0xf5, 0x08, 0x3f, 0x53, 0x72, 0x86, 0xe8, 0x89,
-	0xa7, 0x27, 0xa2, 0x6f, 0x91, 0x64, 0x07, 0x0f, 0x8e, 0xf7, 0x1c, 0x33, 0xcc, 0x17, 0xae, 0x48,
-	0xe8, 0x3c, 0x10, 0x58, 0x5a, 0x74, 0x06, 0xda, 0x4b, 0x7b, 0x3f, 0x96, 0x29, 0xbc, 0x33, 0xb5,
-	0x40, 0x0f, 0xa5, 0x9f, 0x74, 0x82, 0xa8, 0x03, 0x4b, 0x3c, 0xcc, 0x53, 0xd7, 0x50, 0xd5, 0x82,
-	0x21, 0xda, 0x83, 0xf3, 0x6c, 0x07, 0xfb, 0x5c, 0xef, 0xd9, 0xe6, 0xab, 0xb9, 0x36, 0x2f, 0x9b,
-	0x1a, 0x37, 0x9b, 0xda, 0x59, 0xcd, 0xa6, 0x03, 0x4b, 0x5c, 0x95, 0xa9, 0xbb, 0xa8, 0x6a, 0xc1,
-	0x90, 0x1c, 0x73, 0xa8, 0xd4, 0x75, 0xfa, 0x5b, 0x08, 0x20, 0x65, 0x25, 0x84, 0xf2, 0x9c, 0xd1,
-	0x4a, 0xfa, 0x0c, 0xaa, 0x42, 0xc3, 0x0b, 0xb9, 0x35, 0x5c, 0xcc, 0x49, 0xba, 0xf1, 0x62, 0xc2,
-	0x8d, 0xab, 0xff, 0xa2, 0x40, 0x63, 0x93, 0x6c, 0x69, 0xdb, 0x19, 0xd2, 0xa0, 0x73, 0x03, 0x5a,
-	0x2e, 0x1e, 0x38, 0xae, 0xd1, 0xc7, 0xb6, 0xef, 0x9a, 0x98, 0x75, 0x20, 0x4a, 0x5a, 0x93, 0x41,
-	0xbf, 0x60, 0x40, 0x82, 0x46, 0x3c, 0xb3, 0xe7, 0xeb, 0xd6, 0xb8, 0x7f, 0x48, 0x3c, 0x40, 0x81,
-	0xa1, 0x09, 0x28, 0x75, 0x00, 0x6f, 0x42, 0x23, 0x44, 0xf3, 0x1d, 0xba, 0x7e, 0x49, 0xab, 0x0b,
-	0xd8, 0x81, 0x83, 0xde, 0x86, 0x16, 0x95, 0x69, 0x7f, 0xe4, 0x0c, 0xfb, 0xa4, 0x5a, 0xe7, 0xf1,
-	0xa8, 0x61, 0x70, 0xb6, 0xc8, 0x59, 0xc5, 0xb1, 0x3c, 0xf3, 0x07, 0x98, 0x47, 0x24, 0x81, 0xb5,
-	0x6f, 0xfe, 0x00, 0x93, 0x74, 0xa0, 0x49, 0xc2, 0xeb, 0xae, 0x63, 0xe0, 0x83, 0x39, 0x93, 0x91,
-	0x1c, 0x6d, 0xdd, 0xcb, 0x50, 0x13, 0x3b, 0xe0, 0x5b, 0x0a, 0x01, 0xe8, 0x21, 0xb4, 0x82, 0xb4,
-	0xb9, 0xcf, 0xaa, 0xc9, 0x52, 0x66, 0x72, 0x18, 0x09, 0x90, 0x9e, 0xd6, 0x0c, 0xa6, 0xd1, 0xa1,
-	0xfa, 0x10, 0x1a, 0xd1, 0x9f, 0xc9, 0xaa, 0xfb, 0x49, 0x45, 0x11, 0x00, 0xa2, 0x8d, 0xbb, 0x13,
-	0x8b, 0x9c, 0x29, 0x77, 0x2c, 0xc1, 0x50, 0xfd, 0x91, 0x02, 0x4d, 0x1e, 0xd5, 0xf7, 0xc5, 0x05,
-	0x08, 0xdd, 0x9a, 0x42, 0xb7, 0x46, 0xff, 0x46, 0xbf, 0x1c, 0xef, 0x59, 0xbe, 0x2d, 0x75, 0x02,
-	0x94, 0x08, 0x4d, 0xa0, 0x63, 0x21, 0x3d, 0x4f, 0xff, 0xe2, 0x2b, 0xa2, 0x68, 0xfc, 0x68, 0xa8,
-	0xa2, 0x75, 0x60, 0x49, 0x37, 0x0c, 0x17, 0x7b, 0x1e, 0xe7, 0x23, 0x18, 0x92, 0x5f, 0x9e, 0x63,
-	0xd7, 0x0b, 0x54, 0xbe, 0xa8, 0x05, 0x43, 0xf4, 0x4d, 0xa8, 0x8a, 0x8c, 0xbb, 0x28, 0xcb, 0xb2,
-	0xa2, 0x7c, 0xf2, 0x6a, 0x5b, 0xcc, 0x50, 0xff, 0xa6, 0x00, 0x2d, 0x2e, 0xb0, 0x0d, 0x1e, 0x76,
-	0xa7, 0x1b, 0xdf, 0x06, 0x34, 0x0e, 0x43, 0xdb, 0x9f, 0xd6, 0x57, 0x8b, 0xba, 0x88, 0xd8, 0x9c,
-	0x59, 0x06, 0x18, 0x0f, 0xfc, 0xa5, 0x85, 0x02, 0x7f, 0xf9, 0xac, 0x1e, 0x2c, 0x9d, 0x0a, 0x56,
-	0x24, 0xa9, 0xa0, 0xfa, 0x6b, 0x50, 0x8f, 0x10, 0xa0, 0x1e, 0x9a, 0x35, 0xe4, 0xb8, 0xc4, 0x82,
-	0x21, 0xfa, 0x28, 0x4c, 0x7f, 0x98, 0xa8, 0x2e, 0x4a, 0x78, 0x49, 0x64, 0x3e, 0xea, 0x3f, 0x2a,
-	0x50, 0xe1, 0x94, 0xaf, 0x41, 0x9d, 0x3b, 0x1d, 0x9a, 0x1a, 0x32, 0xea, 0xc0, 0x41, 0x24, 0x37,
-	0x7c, 0x79, 0x5e, 0xe7, 0x22, 0x54, 0x13, 0xfe, 0x66, 0x89, 0x87, 0x85, 0xe0, 0xa7, 0x88, 0x93,
-	0x21, 0x3f, 0x11, 0xff, 0x82, 0x2e, 0x40, 0x79, 0xe4, 0x0c, 0xc5, 0x05, 0x17, 0x1b, 0x90, 0x4a,
-	0x6e, 0x6d, 0x0b, 0xfb, 0x1a, 0x1e, 0x38, 0xcf, 0xb1, 0x7b, 0xba, 0x78, 0x23, 0xf7, 0x7e, 0x44,
-	0xcd, 0x73, 0x16, 0x96, 0x62, 0x02, 0xba, 0x1f, 0x1e, 0x42, 0x51, 0xd6, 0xc5, 0x8a, 0xfa, 0x1d,
-	0xae, 0xa4, 0xe1, 0x61, 0xfc, 0x2e, 0x6b, 0x49, 0xc7, 0xb7, 0x32, 0x6f, 0xb6, 0xf3, 0x52, 0xea,
-	0x15, 0xf5, 0x0f, 0x14, 0xb8, 0xb8, 0x85, 0xfd, 0x87, 0xf1, 0x26, 0xc5, 0xeb, 0xe6, 0xca, 0x82,
-	0xae, 0x8c, 0xa9, 0x45, 0x4e, 0xbd, 0x0b, 0x55, 0xd1, 0x6e, 0x61, 0x17, 0x0b, 0x62, 0xac, 0xfe,
-	0xa6, 0x02, 0x1d, 0xbe, 0x0a, 0x5d, 0x93, 0xe4, 0xe2, 0x23, 0xec, 0x63, 0xe3, 0xeb, 0x2e, 0xb8,
-	0xff, 0x41, 0x81, 0x76, 0x34, 0x0e, 0x50, 0x57, 0xfe, 0x31, 0x94, 0x69, 0x5f, 0x83, 0x73, 0x30,
-	0x53, 0x59, 0x19, 0x36, 0x71, 0x24, 0x34, 0xf9, 0x3b, 0x10, 0x21, 0x8b, 0x0f, 0xc3, 0x60, 0x54,
-	0x3c, 0x7b, 0x30, 0xe2, 0xc1, 0xd9, 0x99, 0x10, 0xba, 0xac, 0x21, 0x18, 0x02, 0xd4, 0x5f, 0x81,
-	0xd5, 0xb0, 0x8e, 0x61, 0xf3, 0xe6, 0xd5, 0x24, 0xf5, 0x3f, 0x14, 0x38, 0xbf, 0x7f, 0x6a, 0x0f,
-	0x92, 0x3a, 0xb9, 0x0a, 0x95, 0xf1, 0x48, 0x0f, 0x1b, 0x8c, 0x7c, 0x44, 0x33, 0x0b, 0xb6, 0x36,
-	0x36, 0x88, 0x5b, 0x62, 0x9b, 0xae, 0x0b, 0xd8, 0x81, 0x33, 0x33, 0x5a, 0xdc, 0x10, 0x85, 0x17,
-	0x36, 0x98, 0x03, 0x64, 0x5d, 0x9b, 0xa6, 0x80, 0x52, 0x07, 0xf8, 0x29, 0x00, 0x8d, 0x11, 0xfd,
-	0xb3, 0xc4, 0x05, 0x3a, 0x63, 0x9b, 0x78, 0x81, 0x9f, 0x15, 0xa0, 0x13, 0x91, 0xd2, 0xd7, 0x1d,
-	0x32, 0x33, 0x12, 0xfd, 0xe2, 0x4b, 0x4a, 0xf4, 0x4b, 0x8b, 0x87, 0xc9, 0xb2, 0x2c, 0x4c, 0xfe,
-	0x7d, 0x01, 0x5a, 0xa1, 0xd4, 0xf6, 0x46, 0xba, 0x9d, 0xa9, 0x09, 0xfb, 0x22, 0x45, 0x8c, 0xcb,
-	0xe9, 0x3d, 0x99, 0xa2, 0x67, 0x1c, 0x84, 0x96, 0x20, 0x41, 0x8a, 0x6d, 0x56, 0x8b, 0xd1, 0x96,
-	0x09, 0x4f, 0x4b, 0x99, 0x45, 0x99, 0x16, 0x46, 0x77, 0x00, 0x71, 0x33, 0xe8, 0x9b, 0x76, 0xdf,
-	0xc3, 0x03, 0xc7, 0x36, 0x98, 0x81, 0x94, 0xb5, 0x36, 0xff, 0xa5, 0x67, 0xef, 0x33, 0x38, 0xfa,
-	0x18, 0x4a, 0xfe, 0xe9, 0x98, 0x05, 0xc0, 0x96, 0x34, 0x84, 0x84, 0x7c, 0x1d, 0x9c, 0x8e, 0xb1,
-	0x46, 0xd1, 0x83, 0x87, 0x2d, 0xbe, 0xab, 0x3f, 0xe7, 0xd9, 0x44, 0x49, 0x8b, 0x40, 0x88, 0xc9,
-	0x07, 0x32, 0x5c, 0x62, 0x51, 0x97, 0x0f, 0xd5, 0xbf, 0x2d, 0x40, 0x3b, 0x24, 0xa9, 0x61, 0x6f,
-	0x32, 0xca, 0xb6, 0xa4, 0xe9, 0x75, 0xf4, 0x2c, 0x23, 0xfa, 0x16, 0xd4, 0xf9, 0x79, 0x9e, 0x41,
-	0x1f, 0x80, 0x4d, 0xd9, 0x9e, 0xa2, 0xa0, 0xe5, 0x97, 0xa4, 0xa0, 0x95, 0x33, 0x2a, 0xa8, 0xfa,
-	0x53, 0x05, 0xde, 0x48, 0x79, 0xb5, 0xa9, 0x02, 0x9c, 0x9e, 0xed, 0x73, 0x6f, 0x97, 0x24, 0xc9,
-	0x1d, 0xec, 0x7d, 0xa8, 0xb8, 0x94, 0x3a, 0xbf, 0xf8, 0x78, 0x6b, 0xaa, 0x72, 0x30, 0x46, 0x34,
-	0x3e, 0x45, 0xfd, 0x7d, 0x05, 0xd6, 0xd2, 0xac, 0x2e, 0x10, 0x35, 0x37, 0x60, 0x89, 0x91, 0x0e,
-	0x6c, 0xe8, 0xd6, 0x74, 0x1b, 0x0a, 0x85, 0xa3, 0x05, 0x13, 0xd5, 0x7d, 0x58, 0x0d, 0x82, 0x6b,
-	0x28, 0xe0, 0x1d, 0xec, 0xeb, 0x53, 0x72, 0xdd, 0x6b, 0x50, 0x67, 0x49, 0x13, 0xcb, 0x21, 0x59,
-	0x95, 0x08, 0x4f, 0x45, 0x73, 0x45, 0xfd, 0x73, 0x05, 0x2e, 0xd0, 0xe8, 0x94, 0xbc, 0x69, 0xc8,
-	0x73, 0x0b, 0xa5, 0x8a, 0x22, 0x94, 0x14, 0x9c, 0x6c, 0x6b, 0x35, 0x2d, 0x06, 0x93, 0x75, 0x9e,
-	0x8b, 0x73, 0x76, 0x9e, 0xb7, 0xe1, 0x8d, 0x04, 0xab, 0x0b, 0x1c, 0x09, 0xd9, 0xf9, 0xea, 0x7e,
-	0xfc, 0xf9, 0xc7, 0xfc, 0xe9, 0xda, 0x15, 0x71, 0x47, 0xd1, 0x37, 0x8d, 0xa4, 0xad, 0x1b, 0xe8,
-	0x33, 0xa8, 0xd9, 0xf8, 0xa4, 0x1f, 0xcd, 0x16, 0x72, 0xb4, 0xa2, 0xab, 0x36, 0x3e, 0xa1, 0x7f,
-	0xa9, 0xbb, 0xb0, 0x96, 0x62, 0x75, 0x91, 0xbd, 0xff, 0x9d, 0x02, 0x17, 0x37, 0x5d, 0x67, 0xfc,
-	0xa5, 0xe9, 0xfa, 0x13, 0x7d, 0x14, 0xbf, 0xd1, 0x7d, 0x35, 0xdd, 0x88, 0x47, 0x91, 0xbc, 0x91,
-	0x29, 0xc0, 0x1d, 0x89, 0x09, 0xa4, 0x99, 0xe2, 0x9b, 0x8e, 0x64, 0x99, 0xff, 0x5d, 0x94, 0x31,
-	0xcf, 0xf1, 0x66, 0x04, 0xfe, 0x3c, 0x69, 0xb5, 0xb4, 0x79, 0x59, 0x9c, 0xb7, 0x79, 0x99, 0xe1,
-	0x85, 0x4b, 0x2f, 0xc9, 0x0b, 0x9f, 0xb9, 0x9a, 0x7e, 0x04, 0xf1, 0xc6, 0x32, 0x0d, 0x7f, 0x73,
-	0x75, 0xa4, 0x37, 0x00, 0xc2, 0x26, 0x2b, 0x7f, 0xbd, 0x97, 0x87, 0x4c, 0x64, 0x16, 0x39, 0x2d,
-	0x11, 0xf1, 0xe8, 0xfd, 0x49, 0xac, 0xed, 0xf7, 0x1d, 0xe8, 0xca, 0xb4, 0x74, 0x11, 0xcd, 0xff,
-	0x59, 0x01, 0xa0, 0x27, 0x1e, 0x7c, 0xce, 0xe7, 0xcc, 0xdf, 0x82, 0x66, 0xa8, 0x30, 0xa1, 0xbd,
-	0x47, 0xb5, 0xc8, 0x20, 0x26, 0x21, 0x2a, 0x31, 0x82, 0x93, 0xaa, 0xce, 0x0c, 0x4a, 0x27, 0x62,
-	0x35, 0x4c, 0x29, 0x92, 0xfe, 0xf3, 0x12, 0xd4, 0x5c, 0xe7, 0xa4, 0x4f, 0xcc, 0xcc, 0x08, 0x5e,
-	0xb4, 0xba, 0xce, 0x09, 0x31, 0x3e, 0x03, 0xad, 0xc1, 0x92, 0xaf, 0x7b, 0xc7, 0x84, 0x7e, 0x25,
-	0xf2, 0xa8, 0xc0, 0x40, 0x17, 0xa0, 0x7c, 0x68, 0x8e, 0x30, 0xbb, 0xc3, 0xae, 0x69, 0x6c, 0x80,
-	0xbe, 0x11, 0x3c, 0xbd, 0xaa, 0xe6, 0x7e, 0x38, 0xc2, 0x5e, 0x5f, 0xfd, 0x5c, 0x81, 0xe5, 0x50,
-	0x6a, 0xd4, 0x01, 0x11, 0x9f, 0x46, 0xfd, 0xd9, 0x03, 0xc7, 0x60, 0xae, 0xa2, 0x95, 0xe1, 0xd2,
-	0xd9, 0x44, 0xe6, 0xb5, 0xc2, 0x29, 0xd3, 0x0a, 0x49, 0xb2, 0x2f, 0xb2, 0x69, 0xd3, 0x08, 0xee,
-	0x32, 0x2b, 0xae, 0x73, 0xd2, 0x33, 0x84, 0x34, 0xd8, 0x73, 0x55, 0x56, 0x36, 0x11, 0x69, 0x3c,
-	0xa0, 0x2f, 0x56, 0xdf, 0x82, 0x26, 0x76, 0x5d, 0xc7, 0xed, 0x5b, 0xd8, 0xf3, 0xf4, 0x21, 0xe6,
-	0x09, 0x70, 0x83, 0x02, 0x77, 0x18, 0x4c, 0xfd, 0xa7, 0x22, 0xb4, 0xc2, 0xad, 0x04, 0x37, 0x98,
-	0xa6, 0x11, 0xdc, 0x60, 0x9a, 0xe4, 0xe8, 0xc0, 0x65, 0xae, 0x50, 0x1c, 0xee, 0x46, 0xa1, 0xa3,
-	0x68, 0x35, 0x0e, 0xed, 0x19, 0x24, 0xae, 0x12, 0x23, 0xb3, 0x1d, 0x03, 0x87, 0x87, 0x0b, 0x01,
-	0x88, 0x9f, 0x6d, 0x4c, 0x47, 0x4a, 0x39, 0x74, 0xa4, 0x9c, 0x43, 0x47, 0x2a, 0x12, 0x1d, 0x59,
-	0x85, 0xca, 0xd3, 0xc9, 0xe0, 0x18, 0xfb, 0x3c, 0x5d, 0xe5, 0xa3, 0xb8, 0xee, 0x54, 0x13, 0xba,
-	0x23, 0x54, 0xa4, 0x16, 0x55, 0x91, 0x4b, 0x50, 0x63, 0x57, 0x69, 0x7d, 0xdf, 0xa3, 0x17, 0x06,
-	0x45, 0xad, 0xca, 0x00, 0x07, 0x1e, 0xfa, 0x24, 0xc8, 0xc7, 0xea, 0x32, 0x63, 0xa7, 0x5e, 0x27,
-	0xa1, 0x25, 0x41, 0x36, 0xf6, 0x0e, 0x2c, 0x47, 0xc4, 0x41, 0x63, 0x44, 0x83, 0xb2, 0xda, 0x0a,
-	0xc1, 0x34, 0x4c, 0xdc, 0x80, 0x56, 0x28, 0x12, 0x8a, 0xd7, 0x64, 0x55, 0x8c, 0x80, 0x12, 0x34,
-	0xf5, 0xfb, 0x80, 0xc2, 0x95, 0x16, 0x4b, 0xcd, 0x12, 0x47, 0x59, 0x48, 0x1e, 0xa5, 0xfa, 0x17,
-	0x0a, 0xac, 0x44, 0x17, 0x9b, 0x37, 0x48, 0x7e, 0x06, 0x75, 0x76, 0xbd, 0xd2, 0x27, 0x46, 0xca,
-	0x5b, 0x1a, 0x57, 0xa6, 0xca, 0x50, 0x83, 0xf0, 0x71, 0x3a, 0x51, 0x85, 0x13, 0xc7, 0x3d, 0x36,
-	0xed, 0x61, 0x9f, 0x70, 0x16, 0x98, 0x46, 0x83, 0x03, 0x77, 0x09, 0x4c, 0xfd, 0xb1, 0x02, 0x57,
-	0x1f, 0x8f, 0x0d, 0xdd, 0xc7, 0x91, 0x6c, 0x61, 0xd1, 0xf7, 0x6e, 0x1f, 0x07, 0x0f, 0xce, 0x0a,
-	0xf9, 0xae, 0x08, 0x18, 0xb6, 0xba, 0x03, 0x17, 0x35, 0xec, 0x61, 0xdb, 0x88, 0xfd, 0x38, 0x77,
-	0x23, 0x63, 0x0c, 0x5d, 0x19, 0xb9, 0x45, 0xce, 0x9e, 0xa5, 0x6d, 0x7d, 0x97, 0x90, 0xf5, 0xb9,
-	0x17, 0x22, 0xd9, 0x02, 0x5d, 0xc7, 0x57, 0xff, 0xb2, 0x00, 0x6b, 0x9f, 0x1b, 0x06, 0x77, 0x60,
-	0x3c, 0x11, 0x79, 0x55, 0x39, 0x62, 0x32, 0x87, 0x2a, 0xa6, 0x73, 0xa8, 0x97, 0xe5, 0x54, 0xb8,
-	0x7b, 0xb5, 0x27, 0x56, 0x10, 0x36, 0x5c, 0xf6, 0xaa, 0xe1, 0x3e, 0x6f, 0xf3, 0x93, 0x92, 0x93,
-	0x86, 0x8e, 0xd9, 0xa9, 0x45, 0x35, 0x68, 0xc8, 0xa8, 0x63, 0xe8, 0xa4, 0x85, 0xb5, 0xa0, 0x65,
-	0x06, 0x12, 0x19, 0x3b, 0xac, 0xfb, 0xd6, 0x20, 0xd9, 0x03, 0x05, 0xed, 0x39, 0x9e, 0xfa, 0x3f,
-	0x05, 0xe8, 0xec, 0xeb, 0xcf, 0xf1, 0x2f, 0xce, 0x01, 0x7d, 0x17, 0x2e, 0x78, 0xfa, 0x73, 0xdc,
-	0x8f, 0x14, 0x75, 0x7d, 0x17, 0x3f, 0xe3, 0xd9, 0xd7, 0xbb, 0x32, 0xc3, 0x94, 0xbe, 0x0a, 0xd0,
-	0x56, 0xbc, 0x18, 0x5c, 0xc3, 0xcf, 0xd0, 0x4d, 0x58, 0x8e, 0xbe, 0x2e, 0x21, 0xac, 0x55, 0xa9,
-	0xc8, 0x9b, 0x91, 0xc7, 0x23, 0x3d, 0x43, 0x7d, 0x06, 0x97, 0x1f, 0xdb, 0x1e, 0xf6, 0x7b, 0xe1,
-	0x03, 0x88, 0x05, 0xab, 0xa7, 0x6b, 0x50, 0x0f, 0x05, 0x9f, 0x7a, 0xaf, 0x6e, 0x78, 0xaa, 0x03,
-	0xdd, 0x9d, 0xf0, 0x31, 0x97, 0xb7, 0xc9, 0x6e, 0xb0, 0x5f, 0xe1, 0x82, 0x87, 0xe2, 0x41, 0x87,
-	0x86, 0x0f, 0xb1, 0x8b, 0xed, 0x01, 0xde, 0x76, 0x06, 0xc7, 0x91, 0x47, 0x9c, 0x4a, 0xf4, 0x11,
-	0xe7, 0xbc, 0x8f, 0x42, 0x6f, 0xdf, 0x13, 0x6f, 0xa9, 0x0e, 0x4e, 0xc7, 0x18, 0x2d, 0x41, 0x71,
-	0x17, 0x9f, 0xb4, 0xcf, 0x21, 0x80, 0xca, 0xae, 0xe3, 0x5a, 0xfa, 0xa8, 0xad, 0xa0, 0x3a, 0x2c,
-	0xf1, 0x0e, 0x7e, 0xbb, 0x70, 0xfb, 0x8f, 0x15, 0x58, 0x49, 0x35, 0x95, 0x51, 0x0b, 0xe0, 0xb1,
-	0x3d, 0xe0, 0xdd, 0xf6, 0xf6, 0x39, 0xd4, 0x80, 0x6a, 0xd0, 0x7b, 0x67, 0x04, 0x0e, 0x1c, 0x8a,
-	0xdd, 0x2e, 0xa0, 0x36, 0x34, 0xd8, 0xc4, 0xc9, 0x60, 0x80, 0x3d, 0xaf, 0x5d, 0x14, 0x90, 0x87,
-	0xba, 0x39, 0x9a, 0xb8, 0xb8, 0x5d, 0x42, 0x4d, 0xa8, 0x1d, 0x38, 0xfc, 0xcd, 0x6c, 0xbb, 0x8c,
-	0x10, 0xb4, 0x82, 0x07, 0xb4, 0x7c, 0x52, 0x25, 0x02, 0x0b, 0xa6, 0x2d, 0xdd, 0x7e, 0x12, 0xed,
-	0x2c, 0xd2, 0xfd, 0xac, 0xc1, 0xf9, 0xc7, 0xb6, 0x81, 0x0f, 0x4d, 0x1b, 0x1b, 0xe1, 0x4f, 0xed,
-	0x73, 0xe8, 0x3c, 0x2c, 0xef, 0x60, 0x77, 0x88, 0x23, 0xc0, 0x02, 0x5a, 0x81, 0xe6, 0x8e, 0xf9,
-	0x22, 0x02, 0x2a, 0xaa, 0xa5, 0xaa, 0xd2, 0x56, 0xd6, 0xff, 0xf3, 0x22, 0xd4, 0x36, 0x75, 0x5f,
-	0x7f, 0xe0, 0x38, 0xae, 0x81, 0xc6, 0x80, 0xe8, 0x13, 0x73, 0x6b, 0xec, 0xd8, 0xe2, 0xc3, 0x0d,
-	0xf4, 0x41, 0x46, 0xe5, 0x91, 0x46, 0xe5, 0x6a, 0xd3, 0xbd, 0x99, 0x31, 0x23, 0x81, 0xae, 0x9e,
-	0x43, 0x16, 0x5d, 0xf1, 0xc0, 0xb4, 0xf0, 0x81, 0x39, 0x38, 0x0e, 0xde, 0x9e, 0x4d, 0x59, 0x31,
-	0x81, 0x1a, 0xac, 0x98, 0xe8, 0x41, 0xf1, 0x01, 0xfb, 0x0e, 0x20, 0x70, 0x95, 0xea, 0x39, 0xf4,
-	0x0c, 0x2e, 0x6c, 0xe1, 0x48, 0xe0, 0x0e, 0x16, 0x5c, 0xcf, 0x5e, 0x30, 0x85, 0x7c, 0xc6, 0x25,
-	0xb7, 0xa1, 0x4c, 0x75, 0x0c, 0xc9, 0x62, 0x7b, 0xf4, 0x3b, 0xcb, 0xee, 0xf5, 0x6c, 0x04, 0x41,
-	0xed, 0xfb, 0xb0, 0x9c, 0xf8, 0x3a, 0x0b, 0xc9, 0x5c, 0x93, 0xfc, 0x3b, 0xbb, 0xee, 0xed, 0x3c,
-	0xa8, 0x62, 0xad, 0x21, 0xb4, 0xe2, 0xcf, 0xd3, 0x91, 0xac, 0xb5, 0x26, 0xfd, 0xb0, 0xa6, 0xfb,
-	0x6e, 0x0e, 0x4c, 0xb1, 0x90, 0x05, 0xed, 0xe4, 0xd7, 0x42, 0xe8, 0xf6, 0x54, 0x02, 0x71, 0x75,
-	0x7b, 0x2f, 0x17, 0xae, 0x58, 0xee, 0x94, 0x2a, 0x41, 0xea, 0x03, 0x14, 0x74, 0x57, 0x4e, 0x26,
-	0xeb, 0xcb, 0x98, 0xee, 0xbd, 0xdc, 0xf8, 0x62, 0xe9, 0xdf, 0x60, 0x37, 0xc5, 0xb2, 0x8f, 0x38,
-	0xd0, 0x87, 0x72, 0x72, 0x53, 0xbe, 0x3e, 0xe9, 0xae, 0x9f, 0x65, 0x8a, 0x60, 0xe2, 0x87, 0xf4,
-	0x8a, 0x57, 0xf2, 0x19, 0x44, 0xd2, 0xee, 0x02, 0x7a, 0xd9, 0x5f, 0x78, 0x74, 0x3f, 0x3c, 0xc3,
-	0x0c, 0xc1, 0x80, 0x93, 0xfc, 0x1c, 0x2b, 0x30, 0xc3, 0x7b, 0x33, 0xb5, 0x66, 0x3e, 0x1b, 0xfc,
-	0x1e, 0x2c, 0x27, 0x82, 0x35, 0xca, 0x1f, 0xd0, 0xbb, 0xd3, 0x32, 0x2a, 0x66, 0x92, 0x89, 0x1b,
-	0x73, 0x94, 0xa1, 0xfd, 0x92, 0x5b, 0xf5, 0xee, 0xed, 0x3c, 0xa8, 0x62, 0x23, 0x1e, 0x75, 0x97,
-	0x89, 0x5b, 0x67, 0x74, 0x47, 0x4e, 0x43, 0x7e, 0x63, 0xde, 0x7d, 0x3f, 0x27, 0xb6, 0x58, 0xf4,
-	0xd7, 0x01, 0xed, 0x1f, 0x39, 0x27, 0x0f, 0x1c, 0xfb, 0xd0, 0x1c, 0x4e, 0x5c, 0x9d, 0xbd, 0xd3,
-	0xcb, 0xf2, 0xd1, 0x69, 0xd4, 0x0c, 0x5d, 0x99, 0x3a, 0x43, 0x2c, 0xde, 0x07, 0xd8, 0xc2, 0xfe,
-	0x0e, 0xf6, 0x5d, 0xa2, 0xa0, 0x37, 0xa5, 0xe7, 0x1d, 0x22, 0x04, 0x4b, 0xbd, 0x33, 0x13, 0x2f,
-	0x12, 0x12, 0xda, 0x3b, 0xba, 0x3d, 0xd1, 0x47, 0x91, 0xe7, 0xad, 0x77, 0xa4, 0xd3, 0x93, 0x68,
-	0x19, 0x02, 0xcd, 0xc4, 0x16, 0x4b, 0x9e, 0x88, 0x30, 0x1b, 0xb9, 0x93, 0x48, 0xba, 0x9f, 0x90,
-	0x67, 0xf9, 0x7d, 0x75, 0xd2, 0xfd, 0x4c, 0xc1, 0x17, 0x0b, 0x7f, 0xa5, 0xd0, 0x6f, 0xfd, 0x12,
-	0x08, 0x4f, 0x4c, 0xff, 0x68, 0x6f, 0xa4, 0xdb, 0x5e, 0x1e, 0x16, 0x28, 0xe2, 0x19, 0x58, 0xe0,
-	0xf8, 0x82, 0x05, 0x03, 0x9a, 0xb1, 0x9b, 0x06, 0x24, 0x7b, 0x28, 0x2a, 0xbb, 0x36, 0xe9, 0xde,
-	0x9a, 0x8d, 0x28, 0x56, 0x39, 0x82, 0x66, 0xa0, 0xd2, 0x4c, 0xb8, 0xef, 0x66, 0x71, 0x1a, 0xe2,
-	0x64, 0x58, 0xa4, 0x1c, 0x35, 0x6a, 0x91, 0xe9, 0x46, 0x2a, 0xca, 0xd7, 0x80, 0x9f, 0x66, 0x91,
-	0xd9, 0xdd, 0x59, 0xe6, 0x72, 0x12, 0x97, 0x16, 0x72, 0x7f, 0x26, 0xbd, 0x83, 0x91, 0xba, 0x9c,
-	0x8c, 0x3b, 0x10, 0xf5, 0x1c, 0x7a, 0x02, 0x15, 0xfe, 0x91, 0xff, 0xdb, 0xd3, 0x1b, 0x2a, 0x9c,
-	0xfa, 0x8d, 0x19, 0x58, 0x82, 0xf0, 0x31, 0xac, 0x65, 0xb4, 0x53, 0xa4, 0xa1, 0x70, 0x7a, 0xeb,
-	0x65, 0x96, 0x93, 0xd6, 0x01, 0xa5, 0xbf, 0xa4, 0x93, 0x1e, 0x53, 0xe6, 0x07, 0x77, 0x39, 0x96,
-	0x48, 0x7f, 0x0c, 0x27, 0x5d, 0x22, 0xf3, 0x9b, 0xb9, 0x59, 0x4b, 0xf4, 0x61, 0x25, 0x55, 0x94,
-	0xa3, 0xf7, 0x32, 0x22, 0x99, 0xac, 0x74, 0x9f, 0xb5, 0xc0, 0x10, 0xde, 0x90, 0x16, 0xa0, 0xd2,
-	0xc8, 0x3c, 0xad, 0x54, 0x9d, 0xb5, 0xd0, 0x00, 0xce, 0x4b, 0xca, 0x4e, 0x24, 0xb3, 0x84, 0xec,
-	0xf2, 0x74, 0xc6, 0x22, 0xeb, 0xff, 0x5a, 0x83, 0x6a, 0xf0, 0xe4, 0xf4, 0x35, 0xd4, 0x36, 0xaf,
-	0xa1, 0xd8, 0xf8, 0x1e, 0x2c, 0x27, 0x3e, 0x6f, 0x93, 0x3a, 0x06, 0xf9, 0x27, 0x70, 0xb3, 0xce,
-	0xec, 0x09, 0xff, 0xe7, 0x2b, 0x22, 0xef, 0x78, 0x27, 0xab, 0x60, 0x49, 0xa6, 0x1c, 0x33, 0x08,
-	0xff, 0xff, 0x4e, 0x30, 0x76, 0x01, 0x22, 0xa9, 0xc5, 0xf4, 0x97, 0x34, 0x24, 0x5a, 0xce, 0x92,
-	0x96, 0x25, 0xcd, 0x1e, 0xde, 0xcd, 0xf3, 0xea, 0x21, 0xdb, 0xff, 0x67, 0xe7, 0x0c, 0x8f, 0xa1,
-	0x11, 0x7d, 0xe3, 0x86, 0xa4, 0xff, 0xea, 0x23, 0xfd, 0x08, 0x6e, 0xd6, 0x2e, 0x76, 0xce, 0x18,
-	0x56, 0x66, 0x90, 0xf3, 0x88, 0xf3, 0x4d, 0x76, 0xb0, 0x33, 0x9c, 0x6f, 0x46, 0xdf, 0x5c, 0x1a,
-	0x86, 0xb3, 0xdb, 0xe2, 0xac, 0x6e, 0x4d, 0xb6, 0x65, 0xa5, 0x75, 0x6b, 0x46, 0xa3, 0x5b, 0x5a,
-	0xb7, 0x66, 0xf5, 0x79, 0xd5, 0x73, 0x1b, 0x1f, 0x7d, 0xf7, 0xc3, 0xa1, 0xe9, 0x1f, 0x4d, 0x9e,
-	0x92, 0xdd, 0xdf, 0x63, 0x53, 0xdf, 0x37, 0x1d, 0xfe, 0xd7, 0xbd, 0x40, 0xdd, 0xef, 0x51, 0x6a,
-	0xf7, 0x08, 0xb5, 0xf1, 0xd3, 0xa7, 0x15, 0x3a, 0xfa, 0xe8, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x51, 0x51, 0xef, 0xc9, 0x3e, 0x4a, 0x00, 0x00,
+	// 4682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x8f, 0x1c, 0x57,
+	0x5a, 0xae, 0xbe, 0xf7, 0xd7, 0x97, 0xe9, 0x39, 0xb6, 0xc7, 0xed, 0xf6, 0x25, 0x76, 0x25, 0x76,
+	0x1c, 0xc7, 0xb1, 0x9d, 0x09, 0x11, 0xd1, 0x7a, 0x93, 0x95, 0x3d, 0x93, 0x71, 0x1a, 0x3c, 0x5e,
+	0x6f, 0xcd, 0x38, 0x91, 0x76, 0x91, 0x5a, 0xe5, 0xae, 0x33, 0x3d, 0x95, 0xe9, 0xaa, 0x6a, 0x57,
+	0x55, 0xcf, 0x78, 0x16, 0xa4, 0x8d, 0x40, 0x42, 0x02, 0x21, 0x96, 0xdb, 0x4a, 0x20, 0x81, 0x84,
+	0x90, 0x90, 0x60, 0x57, 0x20, 0xa4, 0x15, 0x42, 0x42, 0xe2, 0x1d, 0x01, 0x2f, 0xbc, 0xc2, 0x3b,
+	0xfb, 0x8a, 0xc4, 0x1f, 0x40, 0xe7, 0x52, 0xa7, 0x6e, 0xa7, 0xba, 0x6b, 0xba, 0xed, 0x78, 0x05,
+	0x6f, 0x7d, 0xbe, 0xfa, 0xbe, 0x73, 0xfd, 0xee, 0xe7, 0x3b, 0x0d, 0x1d, 0x43, 0xf7, 0xf5, 0xc1,
+	0xd0, 0x71, 0x5c, 0xe3, 0xf6, 0xc4, 0x75, 0x7c, 0x07, 0xad, 0x5a, 0xe6, 0xf8, 0x70, 0xea, 0xb1,
+	0xd6, 0x6d, 0xf2, 0xb9, 0xd7, 0x1c, 0x3a, 0x96, 0xe5, 0xd8, 0x0c, 0xd4, 0x6b, 0x9b, 0xb6, 0x8f,
+	0x5d, 0x5b, 0x1f, 0xf3, 0x76, 0x33, 0x4a, 0xd0, 0x6b, 0x7a, 0xc3, 0x7d, 0x6c, 0xe9, 0xbc, 0x05,
+	0x93, 0xb1, 0xce, 0xe9, 0xd4, 0x2a, 0x94, 0x3f, 0xb5, 0x26, 0xfe, 0xb1, 0xfa, 0xc7, 0x0a, 0x34,
+	0xb7, 0xc6, 0x53, 0x6f, 0x5f, 0xc3, 0xcf, 0xa7, 0xd8, 0xf3, 0xd1, 0x5d, 0x28, 0x3d, 0xd3, 0x3d,
+	0xdc, 0x55, 0xae, 0x28, 0x37, 0x1a, 0xeb, 0x17, 0x6f, 0xc7, 0x66, 0xc0, 0xc7, 0xde, 0xf6, 0x46,
+	0x0f, 0x74, 0x0f, 0x6b, 0x14, 0x13, 0x21, 0x28, 0x19, 0xcf, 0xfa, 0x9b, 0xdd, 0xc2, 0x15, 0xe5,
+	0x46, 0x51, 0xa3, 0xbf, 0xd1, 0x65, 0x00, 0x0f, 0x8f, 0x2c, 0x6c, 0xfb, 0xfd, 0x4d, 0xaf, 0x5b,
+	0xbc, 0x52, 0xbc, 0x51, 0xd4, 0x22, 0x10, 0xa4, 0x42, 0x73, 0xe8, 0x8c, 0xc7, 0x78, 0xe8, 0x9b,
+	0x8e, 0xdd, 0xdf, 0xec, 0x96, 0x28, 0x6d, 0x0c, 0xa6, 0xfe, 0x97, 0x02, 0x2d, 0x3e, 0x35, 0x6f,
+	0xe2, 0xd8, 0x1e, 0x46, 0x1f, 0x40, 0xc5, 0xf3, 0x75, 0x7f, 0xea, 0xf1, 0xd9, 0x5d, 0x90, 0xce,
+	0x6e, 0x87, 0xa2, 0x68, 0x1c, 0x55, 0x3a, 0xbd, 0xe4, 0xf0, 0xc5, 0xf4, 0xf0, 0x89, 0x25, 0x94,
+	0x52, 0x4b, 0xb8, 0x01, 0x2b, 0x7b, 0x64, 0x76, 0x3b, 0x21, 0x52, 0x99, 0x22, 0x25, 0xc1, 0xa4,
+	0x27, 0xdf, 0xb4, 0xf0, 0xb7, 0xf7, 0x76, 0xb0, 0x3e, 0xee, 0x56, 0xe8, 0x58, 0x11, 0x88, 0xfa,
+	0xef, 0x0a, 0x74, 0x04, 0x7a, 0x70, 0x0e, 0x67, 0xa0, 0x3c, 0x74, 0xa6, 0xb6, 0x4f, 0x97, 0xda,
+	0xd2, 0x58, 0x03, 0x5d, 0x85, 0xe6, 0x70, 0x5f, 0xb7, 0x6d, 0x3c, 0x1e, 0xd8, 0xba, 0x85, 0xe9,
+	0xa2, 0xea, 0x5a, 0x83, 0xc3, 0x1e, 0xeb, 0x16, 0xce, 0xb5, 0xb6, 0x2b, 0xd0, 0x98, 0xe8, 0xae,
+	0x6f, 0xc6, 0x76, 0x3f, 0x0a, 0x42, 0x3d, 0xa8, 0x99, 0x5e, 0xdf, 0x9a, 0x38, 0xae, 0xdf, 0x2d,
+	0x5f, 0x51, 0x6e, 0xd4, 0x34, 0xd1, 0x26, 0x23, 0x98, 0xf4, 0xd7, 0xae, 0xee, 0x1d, 0xf4, 0x37,
+	0xf9, 0x8a, 0x62, 0x30, 0xf5, 0xcf, 0x15, 0x58, 0xbb, 0xef, 0x79, 0xe6, 0xc8, 0x4e, 0xad, 0x6c,
+	0x0d, 0x2a, 0xb6, 0x63, 0xe0, 0xfe, 0x26, 0x5d, 0x5a, 0x51, 0xe3, 0x2d, 0x74, 0x01, 0xea, 0x13,
+	0x8c, 0xdd, 0x81, 0xeb, 0x8c, 0x83, 0x85, 0xd5, 0x08, 0x40, 0x73, 0xc6, 0x18, 0x7d, 0x07, 0x56,
+	0xbd, 0x44, 0x47, 0x8c, 0xaf, 0x1a, 0xeb, 0x6f, 0xde, 0x4e, 0x49, 0xc9, 0xed, 0xe4, 0xa0, 0x5a,
+	0x9a, 0x5a, 0xfd, 0xaa, 0x00, 0xa7, 0x05, 0x1e, 0x9b, 0x2b, 0xf9, 0x4d, 0x76, 0xde, 0xc3, 0x23,
+	0x31, 0x3d, 0xd6, 0xc8, 0xb3, 0xf3, 0xe2, 0xc8, 0x8a, 0xd1, 0x23, 0xcb, 0xc1, 0xea, 0xc9, 0xf3,
+	0x28, 0xa7, 0xcf, 0xe3, 0x0d, 0x68, 0xe0, 0x17, 0x13, 0xd3, 0xc5, 0x03, 0xc2, 0x38, 0x74, 0xcb,
+	0x4b, 0x1a, 0x30, 0xd0, 0xae, 0x69, 0x45, 0x65, 0xa3, 0x9a, 0x5b, 0x36, 0xd4, 0xbf, 0x50, 0xe0,
+	0x5c, 0xea, 0x94, 0xb8, 0xb0, 0x69, 0xd0, 0xa1, 0x2b, 0x0f, 0x77, 0x86, 0x88, 0x1d, 0xd9, 0xf0,
+	0xeb, 0xb3, 0x36, 0x3c, 0x44, 0xd7, 0x52, 0xf4, 0x91, 0x49, 0x16, 0xf2, 0x4f, 0xf2, 0x00, 0xce,
+	0x3d, 0xc4, 0x3e, 0x1f, 0x80, 0x7c, 0xc3, 0xde, 0xe2, 0xca, 0x2a, 0x2e, 0xd5, 0x85, 0xa4, 0x54,
+	0xab, 0x7f, 0x57, 0x80, 0x4e, 0x74, 0xa8, 0xbe, 0xbd, 0xe7, 0xa0, 0x8b, 0x50, 0x17, 0x28, 0x9c,
+	0x2b, 0x42, 0x00, 0xfa, 0x45, 0x28, 0x93, 0x99, 0x32, 0x96, 0x68, 0xaf, 0x5f, 0x95, 0xaf, 0x29,
+	0xd2, 0xa7, 0xc6, 0xf0, 0x51, 0x1f, 0xda, 0x9e, 0xaf, 0xbb, 0xfe, 0x60, 0xe2, 0x78, 0xf4, 0x9c,
+	0x29, 0xe3, 0x34, 0xd6, 0xd5, 0x78, 0x0f, 0x42, 0xc5, 0x6f, 0x7b, 0xa3, 0x27, 0x1c, 0x53, 0x6b,
+	0x51, 0xca, 0xa0, 0x89, 0x3e, 0x85, 0x26, 0xb6, 0x8d, 0xb0, 0xa3, 0x52, 0xee, 0x8e, 0x1a, 0xd8,
+	0x36, 0x44, 0x37, 0xe1, 0xf9, 0x94, 0xf3, 0x9f, 0xcf, 0xef, 0x28, 0xd0, 0x4d, 0x1f, 0xd0, 0x32,
+	0x2a, 0xfb, 0x1e, 0x23, 0xc2, 0xec, 0x80, 0x66, 0x4a, 0xb8, 0x38, 0x24, 0x8d, 0x93, 0xa8, 0x3f,
+	0x52, 0xe0, 0x6c, 0x38, 0x1d, 0xfa, 0xe9, 0x55, 0x71, 0x0b, 0xba, 0x09, 0x1d, 0xd3, 0x1e, 0x8e,
+	0xa7, 0x06, 0x7e, 0x6a, 0x7f, 0x86, 0xf5, 0xb1, 0xbf, 0x7f, 0x4c, 0xcf, 0xb0, 0xa6, 0xa5, 0xe0,
+	0xea, 0x6f, 0x28, 0xb0, 0x96, 0x9c, 0xd7, 0x32, 0x9b, 0xf4, 0x0b, 0x50, 0x36, 0xed, 0x3d, 0x27,
+	0xd8, 0xa3, 0xcb, 0x33, 0x84, 0x92, 0x8c, 0xc5, 0x90, 0x55, 0x0b, 0x2e, 0x3c, 0xc4, 0x7e, 0xdf,
+	0xf6, 0xb0, 0xeb, 0x3f, 0x30, 0xed, 0xb1, 0x33, 0x7a, 0xa2, 0xfb, 0xfb, 0x4b, 0x08, 0x54, 0x4c,
+	0x36, 0x0a, 0x09, 0xd9, 0x50, 0xff, 0x4a, 0x81, 0x8b, 0xf2, 0xf1, 0xf8, 0xd2, 0x7b, 0x50, 0xdb,
+	0x33, 0xf1, 0xd8, 0xe8, 0x6f, 0x32, 0xed, 0x52, 0xd4, 0x44, 0x9b, 0x08, 0xd6, 0x84, 0x20, 0xf3,
+	0x15, 0x5e, 0xcd, 0xe0, 0xe6, 0x1d, 0xdf, 0x35, 0xed, 0xd1, 0x23, 0xd3, 0xf3, 0x35, 0x86, 0x1f,
+	0xd9, 0xcf, 0x62, 0x7e, 0x36, 0xfe, 0x6d, 0x05, 0x2e, 0x3f, 0xc4, 0xfe, 0x86, 0xd0, 0xcb, 0xe4,
+	0xbb, 0xe9, 0xf9, 0xe6, 0xd0, 0x7b, 0xb9, 0xbe, 0x51, 0x0e, 0x03, 0xad, 0xfe, 0x50, 0x81, 0x37,
+	0x32, 0x27, 0xc3, 0xb7, 0x8e, 0xeb, 0x9d, 0x40, 0x2b, 0xcb, 0xf5, 0xce, 0x2f, 0xe3, 0xe3, 0xcf,
+	0xf5, 0xf1, 0x14, 0x3f, 0xd1, 0x4d, 0x97, 0xe9, 0x9d, 0x05, 0xb5, 0xf0, 0xdf, 0x28, 0x70, 0xe9,
+	0x21, 0xf6, 0x9f, 0x04, 0x36, 0xe9, 0x35, 0xee, 0x0e, 0xc1, 0x89, 0xd8, 0xc6, 0xc0, 0x39, 0x8b,
+	0xc1, 0xd4, 0xdf, 0x65, 0xc7, 0x29, 0x9d, 0xef, 0x6b, 0xd9, 0xc0, 0xcb, 0x54, 0x12, 0x22, 0x22,
+	0xb9, 0xc1, 0x5c, 0x07, 0xbe, 0x7d, 0xea, 0x9f, 0x29, 0x70, 0xfe, 0xfe, 0xf0, 0xf9, 0xd4, 0x74,
+	0x31, 0x47, 0x7a, 0xe4, 0x0c, 0x0f, 0x16, 0xdf, 0xdc, 0xd0, 0xcd, 0x2a, 0xc4, 0xdc, 0xac, 0x79,
+	0xae, 0xf9, 0x1a, 0x54, 0x7c, 0xe6, 0xd7, 0x31, 0x4f, 0x85, 0xb7, 0xe8, 0xfc, 0x34, 0x3c, 0xc6,
+	0xba, 0xf7, 0xf3, 0x39, 0xbf, 0x1f, 0x96, 0xa0, 0xf9, 0x39, 0x77, 0xc7, 0xa8, 0xd5, 0x4e, 0x72,
+	0x92, 0x22, 0x77, 0xbc, 0x22, 0x1e, 0x9c, 0xcc, 0xa9, 0x7b, 0x08, 0x2d, 0x0f, 0xe3, 0x83, 0x45,
+	0x6c, 0x74, 0x93, 0x10, 0x06, 0x2d, 0xf4, 0x08, 0x56, 0xa7, 0x36, 0x0d, 0x0d, 0xb0, 0xc1, 0x37,
+	0x90, 0x71, 0xee, 0x7c, 0xdd, 0x9d, 0x26, 0x44, 0x9f, 0xc1, 0x4a, 0x02, 0xd4, 0x2d, 0xe7, 0xea,
+	0x2b, 0x49, 0x86, 0xfa, 0xd0, 0x31, 0x5c, 0x67, 0x32, 0xc1, 0xc6, 0xc0, 0x0b, 0xba, 0xaa, 0xe4,
+	0xeb, 0x8a, 0xd3, 0x89, 0xae, 0xee, 0xc2, 0xe9, 0xe4, 0x4c, 0xfb, 0x06, 0x71, 0x48, 0xc9, 0x19,
+	0xca, 0x3e, 0xa1, 0x5b, 0xb0, 0x9a, 0xc6, 0xaf, 0x51, 0xfc, 0xf4, 0x07, 0xf4, 0x1e, 0xa0, 0xc4,
+	0x54, 0x09, 0x7a, 0x9d, 0xa1, 0xc7, 0x27, 0xd3, 0x37, 0x3c, 0xf5, 0xb7, 0x14, 0x58, 0xfb, 0x42,
+	0xf7, 0x87, 0xfb, 0x9b, 0x16, 0x97, 0xb5, 0x25, 0x74, 0xd5, 0xc7, 0x50, 0x3f, 0xe4, 0x7c, 0x11,
+	0x18, 0xa4, 0x37, 0x24, 0xfb, 0x13, 0xe5, 0x40, 0x2d, 0xa4, 0x50, 0xff, 0x59, 0x81, 0x33, 0x5b,
+	0x91, 0xb8, 0xf0, 0x35, 0x68, 0xcd, 0x79, 0x01, 0xed, 0x75, 0x68, 0x5b, 0xba, 0x7b, 0x90, 0x8a,
+	0x67, 0x13, 0x50, 0xf5, 0x05, 0x00, 0x6f, 0x6d, 0x7b, 0xa3, 0x05, 0xe6, 0xff, 0x11, 0x54, 0xf9,
+	0xa8, 0x5c, 0x7d, 0xce, 0xe3, 0xb3, 0x00, 0x5d, 0xfd, 0x17, 0x05, 0xda, 0xa1, 0x49, 0xa4, 0x42,
+	0xde, 0x86, 0x82, 0x10, 0xed, 0x42, 0x7f, 0x13, 0x7d, 0x0c, 0x15, 0x96, 0xf4, 0xe0, 0x7d, 0x5f,
+	0x8b, 0xf7, 0xcd, 0xbe, 0xdd, 0x8e, 0xd8, 0x55, 0x0a, 0xd0, 0x38, 0x11, 0xd9, 0x23, 0x61, 0x45,
+	0x84, 0xf2, 0x09, 0x21, 0xa8, 0x0f, 0x2b, 0x71, 0x97, 0x3d, 0x10, 0xe1, 0x2b, 0x59, 0xc6, 0x63,
+	0x53, 0xf7, 0x75, 0x6a, 0x3b, 0xda, 0x31, 0x8f, 0xdd, 0x53, 0xff, 0xa1, 0x0a, 0x8d, 0xc8, 0x2a,
+	0x53, 0x2b, 0x49, 0x1e, 0x69, 0x61, 0x7e, 0xdc, 0x58, 0x4c, 0xc7, 0x8d, 0xd7, 0xa0, 0x6d, 0x52,
+	0xe7, 0x6b, 0xc0, 0x59, 0x91, 0x6a, 0xcd, 0xba, 0xd6, 0x62, 0x50, 0x2e, 0x17, 0xe8, 0x32, 0x34,
+	0xec, 0xa9, 0x35, 0x70, 0xf6, 0x06, 0xae, 0x73, 0xe4, 0xf1, 0x00, 0xb4, 0x6e, 0x4f, 0xad, 0x6f,
+	0xef, 0x69, 0xce, 0x91, 0x17, 0xc6, 0x38, 0x95, 0x13, 0xc6, 0x38, 0x97, 0xa1, 0x61, 0xe9, 0x2f,
+	0x48, 0xaf, 0x03, 0x7b, 0x6a, 0xd1, 0xd8, 0xb4, 0xa8, 0xd5, 0x2d, 0xfd, 0x85, 0xe6, 0x1c, 0x3d,
+	0x9e, 0x5a, 0xe8, 0x06, 0x74, 0xc6, 0xba, 0xe7, 0x0f, 0xa2, 0xc1, 0x6d, 0x8d, 0x06, 0xb7, 0x6d,
+	0x02, 0xff, 0x34, 0x0c, 0x70, 0xd3, 0xd1, 0x52, 0x7d, 0x89, 0x68, 0xc9, 0xb0, 0xc6, 0x61, 0x47,
+	0x90, 0x3f, 0x5a, 0x32, 0xac, 0xb1, 0xe8, 0xe6, 0x23, 0xa8, 0x3e, 0xa3, 0x2e, 0xad, 0xd7, 0x6d,
+	0x64, 0x2a, 0xcc, 0x2d, 0xe2, 0xcd, 0x32, 0xcf, 0x57, 0x0b, 0xd0, 0xd1, 0x37, 0xa1, 0x4e, 0x3d,
+	0x09, 0x4a, 0xdb, 0xcc, 0x45, 0x1b, 0x12, 0x10, 0x6a, 0x03, 0x8f, 0x7d, 0x9d, 0x52, 0xb7, 0xf2,
+	0x51, 0x0b, 0x02, 0xa2, 0xa4, 0x87, 0x2e, 0xd6, 0x7d, 0x6c, 0x3c, 0x38, 0xde, 0x70, 0xac, 0x89,
+	0x4e, 0x99, 0xa9, 0xdb, 0xa6, 0x61, 0x8b, 0xec, 0x13, 0x51, 0x0c, 0x43, 0xd1, 0xda, 0x72, 0x1d,
+	0xab, 0xbb, 0xc2, 0x14, 0x43, 0x1c, 0x8a, 0x2e, 0x01, 0x04, 0xea, 0x59, 0xf7, 0xbb, 0x1d, 0x7a,
+	0x8a, 0x75, 0x0e, 0xb9, 0x4f, 0x73, 0x57, 0xa6, 0x37, 0x60, 0x59, 0x22, 0xd3, 0x1e, 0x75, 0x57,
+	0xe9, 0x88, 0x8d, 0x20, 0xad, 0x64, 0xda, 0x23, 0x92, 0xe5, 0xf0, 0xf6, 0x75, 0x17, 0x1b, 0x83,
+	0x3d, 0x32, 0x0c, 0xe2, 0x3a, 0x8a, 0x82, 0xe8, 0x10, 0xdb, 0xd0, 0x19, 0x8e, 0xa7, 0x9e, 0x8f,
+	0x89, 0xcb, 0x3f, 0x70, 0x75, 0x7b, 0x84, 0xbb, 0xa7, 0x65, 0xa7, 0x47, 0x77, 0x60, 0x43, 0xa0,
+	0x6a, 0x04, 0x53, 0x5b, 0x19, 0xc6, 0x01, 0xc4, 0x97, 0xf0, 0x1c, 0xd7, 0xc7, 0x46, 0xf7, 0x0c,
+	0x9d, 0x0c, 0x6f, 0x91, 0xa0, 0x66, 0xe8, 0xb8, 0xee, 0x74, 0x42, 0x3e, 0x9d, 0xa5, 0x9f, 0x42,
+	0x80, 0xfa, 0xfb, 0x0a, 0xac, 0x24, 0xba, 0x46, 0x5d, 0xa8, 0xf2, 0xb8, 0x85, 0x8b, 0x70, 0xd0,
+	0x44, 0xef, 0x43, 0xd1, 0x32, 0x6d, 0xae, 0x8e, 0x12, 0x26, 0x83, 0x66, 0x64, 0x1f, 0x62, 0x1b,
+	0xbb, 0xe6, 0x90, 0x7a, 0x99, 0x1a, 0xc1, 0xa5, 0x24, 0xfa, 0x8b, 0x6e, 0x31, 0x2f, 0x89, 0xfe,
+	0x42, 0xfd, 0x01, 0x9c, 0x09, 0xc5, 0x2f, 0xc2, 0xea, 0x69, 0xa9, 0x51, 0x16, 0x95, 0x9a, 0xd9,
+	0x91, 0xde, 0xcf, 0x4a, 0xb0, 0xb6, 0xa3, 0x1f, 0xe2, 0x57, 0x1f, 0x54, 0xe6, 0x32, 0x76, 0x8f,
+	0x60, 0x95, 0x1e, 0xc0, 0x7a, 0x64, 0x3e, 0xdd, 0x52, 0x2e, 0x59, 0x49, 0x13, 0xa2, 0x6f, 0x11,
+	0x37, 0x11, 0x0f, 0x0f, 0x9e, 0x38, 0x66, 0xe8, 0x69, 0x5d, 0x92, 0x71, 0x9c, 0xc0, 0xd2, 0xa2,
+	0x14, 0xe8, 0x49, 0xda, 0x6e, 0x30, 0x1f, 0xeb, 0xed, 0x99, 0xa9, 0x8d, 0x70, 0xf7, 0x93, 0xe6,
+	0x83, 0x32, 0x1c, 0x73, 0x90, 0xa8, 0x52, 0xad, 0x69, 0x41, 0x13, 0x3d, 0x81, 0xd3, 0x6c, 0x05,
+	0x3b, 0x5c, 0x63, 0xb0, 0xc5, 0xd7, 0x72, 0x2d, 0x5e, 0x46, 0x1a, 0x57, 0x38, 0xf5, 0x93, 0x2a,
+	0x9c, 0x2e, 0x54, 0xb9, 0x12, 0xa0, 0x8a, 0xb6, 0xa6, 0x05, 0x4d, 0x72, 0xcc, 0xa1, 0x3a, 0x68,
+	0x30, 0x31, 0x13, 0x80, 0x88, 0x70, 0x36, 0xa3, 0xc2, 0x49, 0x02, 0x75, 0x08, 0xf7, 0x79, 0x4e,
+	0x72, 0xee, 0x13, 0xa8, 0x09, 0xce, 0x2f, 0xe4, 0xe6, 0x7c, 0x41, 0x93, 0x34, 0x8c, 0xc5, 0x84,
+	0x61, 0x54, 0xff, 0x55, 0x81, 0xe6, 0x26, 0x59, 0xea, 0x23, 0x67, 0x44, 0xcd, 0xf8, 0x35, 0x68,
+	0xbb, 0x78, 0xe8, 0xb8, 0xc6, 0x00, 0xdb, 0xbe, 0x6b, 0x62, 0x96, 0xd3, 0x29, 0x69, 0x2d, 0x06,
+	0xfd, 0x94, 0x01, 0x09, 0x1a, 0xb1, 0x75, 0x9e, 0xaf, 0x5b, 0x13, 0xa6, 0xec, 0x0a, 0x0c, 0x4d,
+	0x40, 0xa9, 0xbe, 0xbb, 0x0a, 0xcd, 0x10, 0xcd, 0x77, 0xe8, 0xf8, 0x25, 0xad, 0x21, 0x60, 0xbb,
+	0x0e, 0x7a, 0x0b, 0xda, 0x74, 0xaf, 0x07, 0x63, 0x67, 0x34, 0x20, 0xf9, 0x0f, 0x6e, 0xe1, 0x9b,
+	0x06, 0x9f, 0x16, 0x39, 0xc3, 0x38, 0x96, 0x67, 0x7e, 0x1f, 0x73, 0x1b, 0x2f, 0xb0, 0x76, 0xcc,
+	0xef, 0x63, 0xe2, 0x60, 0xb5, 0x88, 0xc3, 0xf2, 0xd8, 0x31, 0xf0, 0xee, 0x82, 0xee, 0x5d, 0x8e,
+	0x44, 0xf9, 0x45, 0xa8, 0x8b, 0x15, 0xf0, 0x25, 0x85, 0x00, 0xb4, 0x05, 0xed, 0x20, 0x10, 0x19,
+	0xb0, 0xf8, 0xbc, 0x94, 0xe9, 0x6e, 0x47, 0x5c, 0x0e, 0x4f, 0x6b, 0x05, 0x64, 0xb4, 0xa9, 0x6e,
+	0x41, 0x33, 0xfa, 0x99, 0x8c, 0xba, 0x93, 0x64, 0x14, 0x01, 0x20, 0x5c, 0xfa, 0x78, 0x6a, 0x91,
+	0x33, 0xe5, 0x0a, 0x27, 0x68, 0x92, 0xc4, 0x5d, 0x8b, 0xfb, 0x49, 0x3b, 0xe2, 0x4a, 0x89, 0x2e,
+	0x4d, 0xa1, 0x4b, 0xa3, 0xbf, 0xd1, 0x37, 0xe2, 0x59, 0xe0, 0xb7, 0xa4, 0xca, 0x81, 0x76, 0x42,
+	0x43, 0x92, 0x98, 0x93, 0x94, 0x27, 0x23, 0xf4, 0x15, 0x61, 0x34, 0x7e, 0x34, 0x94, 0xd1, 0xba,
+	0x50, 0xd5, 0x0d, 0xc3, 0xc5, 0x9e, 0xc7, 0xe7, 0x11, 0x34, 0xc9, 0x97, 0x43, 0xec, 0x7a, 0x01,
+	0xcb, 0x17, 0xb5, 0xa0, 0x89, 0xbe, 0x09, 0x35, 0x11, 0xc3, 0x14, 0x65, 0x7e, 0x6b, 0x74, 0x9e,
+	0x3c, 0x7f, 0x21, 0x28, 0xd4, 0xbf, 0x2f, 0x40, 0x9b, 0x6f, 0xd8, 0x03, 0xee, 0xc8, 0xcc, 0x16,
+	0xbe, 0x07, 0xd0, 0xdc, 0x0b, 0x75, 0xc2, 0xac, 0x4c, 0x65, 0x54, 0x75, 0xc4, 0x68, 0xe6, 0x09,
+	0x60, 0xdc, 0x95, 0x2a, 0x2d, 0xe5, 0x4a, 0x95, 0x4f, 0xaa, 0xd9, 0xd2, 0xce, 0x75, 0x45, 0xe2,
+	0x5c, 0xab, 0xbf, 0x02, 0x8d, 0x48, 0x07, 0x33, 0x5c, 0x85, 0x0f, 0x42, 0x87, 0x92, 0x6d, 0xd5,
+	0x79, 0xc9, 0x5c, 0x12, 0xbe, 0xa4, 0xfa, 0x9f, 0x0a, 0x54, 0x78, 0xcf, 0xe4, 0x92, 0x88, 0xe9,
+	0x17, 0xea, 0x6c, 0xb3, 0xde, 0x81, 0x83, 0x88, 0xb7, 0xfd, 0xf2, 0xb4, 0xce, 0x79, 0xa8, 0x25,
+	0xf4, 0x4d, 0x95, 0x9b, 0x8b, 0xe0, 0x53, 0x44, 0xc9, 0x54, 0xc7, 0x4c, 0xbf, 0x90, 0x1b, 0xb2,
+	0xb1, 0x33, 0x12, 0x57, 0x86, 0xac, 0x41, 0x72, 0xc0, 0xd4, 0x56, 0x7a, 0x3c, 0x40, 0x68, 0x69,
+	0xa2, 0x4d, 0xe2, 0x66, 0x72, 0xfb, 0xa3, 0xe1, 0xa1, 0x73, 0x88, 0xdd, 0xe3, 0xe5, 0xd3, 0xe6,
+	0xf7, 0x22, 0x22, 0x90, 0x33, 0x8c, 0x17, 0x04, 0xe8, 0x5e, 0x78, 0x40, 0x45, 0x59, 0xce, 0x30,
+	0xaa, 0x93, 0x38, 0x03, 0x87, 0x07, 0xf5, 0x7b, 0xec, 0x02, 0x20, 0xbe, 0x94, 0x45, 0x3d, 0xa4,
+	0x97, 0x12, 0x1d, 0xaa, 0x7f, 0xa8, 0xc0, 0xf9, 0x87, 0xd8, 0xdf, 0x8a, 0xa7, 0x84, 0x5e, 0xf7,
+	0xac, 0x2c, 0xe8, 0xc9, 0x26, 0xb5, 0xcc, 0xa9, 0xf7, 0xa0, 0x26, 0x92, 0x5b, 0xec, 0x1a, 0x47,
+	0xb4, 0xd5, 0xdf, 0x54, 0xa0, 0xcb, 0x47, 0xa1, 0x63, 0x92, 0xc8, 0x67, 0x8c, 0x7d, 0x6c, 0x7c,
+	0xdd, 0xe9, 0x8d, 0x7f, 0x52, 0xa0, 0x13, 0xb5, 0x11, 0xe4, 0x2b, 0xfa, 0x10, 0xca, 0x34, 0x8b,
+	0xc4, 0x67, 0x30, 0x97, 0x59, 0x19, 0x36, 0x51, 0x32, 0xd4, 0x61, 0xdc, 0x15, 0xe6, 0x8c, 0x37,
+	0x43, 0x43, 0x55, 0x3c, 0xb9, 0xa1, 0xe2, 0x86, 0xdb, 0x99, 0x92, 0x7e, 0x59, 0xfa, 0x35, 0x04,
+	0xa8, 0xbf, 0x04, 0x6b, 0x61, 0xd4, 0xc8, 0xe8, 0x16, 0xe5, 0x24, 0xf5, 0xc7, 0xe4, 0x72, 0xfe,
+	0xd8, 0x1e, 0x26, 0x79, 0x72, 0x0d, 0x2a, 0x24, 0x02, 0x0a, 0x8b, 0x07, 0x58, 0x8b, 0x7a, 0x1d,
+	0x6c, 0x6c, 0x6c, 0x10, 0x95, 0xc5, 0x16, 0xdd, 0x10, 0xb0, 0x5d, 0x67, 0xae, 0x25, 0xb9, 0x26,
+	0xc2, 0xdc, 0x20, 0xfe, 0x64, 0x39, 0xb2, 0x96, 0x80, 0x52, 0xe5, 0xf8, 0x31, 0x00, 0xb5, 0x1f,
+	0x83, 0x93, 0xd8, 0x0c, 0x4a, 0xf1, 0x88, 0xd8, 0x0c, 0x0d, 0x10, 0x8f, 0x42, 0xd3, 0x09, 0xd7,
+	0x37, 0xb3, 0x63, 0x58, 0x21, 0x08, 0xda, 0xea, 0x30, 0x01, 0xf1, 0xd4, 0x9f, 0x16, 0xa0, 0x1b,
+	0xd9, 0xf9, 0xaf, 0xdb, 0x44, 0x67, 0x04, 0x1c, 0xc5, 0x97, 0x14, 0x70, 0x94, 0x96, 0x37, 0xcb,
+	0x65, 0x99, 0x59, 0xfe, 0xa3, 0x22, 0xb4, 0xc3, 0x5d, 0x7b, 0x32, 0xd6, 0xed, 0x4c, 0xee, 0xda,
+	0x11, 0x2e, 0x69, 0x7c, 0x9f, 0xde, 0x95, 0x1d, 0x58, 0xc6, 0x41, 0x68, 0x89, 0x2e, 0x48, 0xba,
+	0x84, 0xc5, 0x84, 0x34, 0xe9, 0xc5, 0xdd, 0x60, 0x26, 0xa5, 0x24, 0xdf, 0x75, 0x0b, 0x10, 0x17,
+	0xad, 0x81, 0x69, 0x0f, 0x3c, 0x3c, 0x74, 0x6c, 0x83, 0x09, 0x5d, 0x59, 0xeb, 0xf0, 0x2f, 0x7d,
+	0x7b, 0x87, 0xc1, 0xd1, 0x87, 0x50, 0xf2, 0x8f, 0x27, 0xcc, 0xe0, 0xb6, 0xd7, 0xaf, 0xce, 0x9c,
+	0xd7, 0xee, 0xf1, 0x04, 0x6b, 0x14, 0x3d, 0x28, 0x4d, 0xf2, 0x5d, 0xfd, 0x90, 0x7b, 0x2f, 0x25,
+	0x2d, 0x02, 0x21, 0x6a, 0x24, 0xd8, 0xc3, 0x2a, 0xb3, 0xf2, 0xbc, 0x49, 0x72, 0xf1, 0x91, 0x4c,
+	0x4c, 0xe0, 0xd0, 0xd4, 0xe8, 0xb6, 0xad, 0x86, 0x5f, 0xb6, 0xd8, 0x07, 0x92, 0xe7, 0x23, 0x79,
+	0x40, 0xbe, 0x05, 0x4c, 0x02, 0xeb, 0x14, 0xb9, 0x6d, 0xe9, 0x2f, 0x02, 0xde, 0x26, 0xee, 0xf6,
+	0x5f, 0x16, 0xa1, 0x13, 0xce, 0x55, 0xc3, 0xde, 0x74, 0x9c, 0x2d, 0xf6, 0xb3, 0x13, 0x05, 0xf3,
+	0x24, 0xfe, 0x5b, 0xd0, 0xe0, 0x8c, 0x72, 0x02, 0x46, 0x03, 0x46, 0xf2, 0x68, 0x06, 0xe7, 0x97,
+	0x5f, 0x12, 0xe7, 0x57, 0x4e, 0xca, 0xf9, 0x72, 0xe5, 0x52, 0x5d, 0x46, 0xb9, 0x44, 0xc2, 0xf0,
+	0x5a, 0x2c, 0x0c, 0xff, 0xef, 0x02, 0x74, 0x92, 0xf4, 0x73, 0x94, 0x4d, 0xe2, 0x3c, 0x0a, 0x73,
+	0xce, 0xa3, 0xf8, 0xb2, 0xce, 0xa3, 0xf4, 0x92, 0xce, 0xe3, 0xc4, 0x01, 0x82, 0x2c, 0x5d, 0x59,
+	0x59, 0x38, 0x5d, 0x49, 0xaa, 0x29, 0xce, 0xa6, 0x2c, 0xec, 0x4c, 0xf9, 0x98, 0x1d, 0x95, 0x72,
+	0xcb, 0x9b, 0xec, 0x92, 0x1b, 0xfb, 0x7b, 0x50, 0x71, 0x69, 0xef, 0x3c, 0x11, 0xf9, 0xe6, 0x4c,
+	0xa5, 0xc2, 0x26, 0xa2, 0x71, 0x12, 0xf5, 0x0f, 0x14, 0x38, 0x97, 0x9e, 0xea, 0x12, 0x1e, 0xdc,
+	0x03, 0xa8, 0xb2, 0xae, 0x03, 0xdd, 0x7b, 0x63, 0xb6, 0xee, 0x0d, 0x37, 0x47, 0x0b, 0x08, 0xd5,
+	0x1d, 0x58, 0x0b, 0x1c, 0xbd, 0xf0, 0xbc, 0xb6, 0xb1, 0xaf, 0xcf, 0x88, 0xc9, 0xde, 0x80, 0x06,
+	0x73, 0xe0, 0x59, 0xac, 0xc3, 0xb2, 0x19, 0xf0, 0x4c, 0x24, 0x07, 0xd5, 0xbf, 0x56, 0xe0, 0x0c,
+	0xf5, 0x94, 0x92, 0x77, 0x8c, 0x79, 0xee, 0x9f, 0x55, 0x68, 0x46, 0x12, 0x23, 0x6c, 0x69, 0x75,
+	0x2d, 0x06, 0x93, 0xdd, 0x39, 0x15, 0x17, 0xbc, 0x73, 0x7a, 0x04, 0x67, 0x13, 0x53, 0x5d, 0xe2,
+	0x48, 0xc8, 0xca, 0xd7, 0x76, 0xe2, 0x85, 0x5f, 0x8b, 0x87, 0x0e, 0x97, 0xc4, 0xed, 0xe4, 0xc0,
+	0x34, 0x92, 0xaa, 0xdc, 0x40, 0x9f, 0x40, 0xdd, 0xc6, 0x47, 0x83, 0xa8, 0xe7, 0x9a, 0xe3, 0x12,
+	0xaa, 0x66, 0xe3, 0x23, 0xfa, 0x4b, 0x7d, 0x0c, 0xe7, 0x52, 0x53, 0x5d, 0x66, 0xed, 0xff, 0xa8,
+	0xc0, 0xf9, 0x4d, 0xd7, 0x99, 0x7c, 0x6e, 0xba, 0xfe, 0x54, 0x1f, 0xc7, 0x6b, 0x39, 0x5e, 0x4d,
+	0xd6, 0xec, 0xb3, 0x48, 0x0c, 0xc3, 0x18, 0xe0, 0x96, 0x44, 0x04, 0xd2, 0x93, 0x0a, 0x74, 0x7b,
+	0x18, 0xf1, 0xfc, 0xac, 0x08, 0xe7, 0x33, 0xf1, 0xe6, 0xe8, 0xf0, 0x3c, 0x21, 0x9e, 0x34, 0xf9,
+	0x5e, 0x5c, 0x34, 0xf9, 0xfe, 0xf3, 0xa6, 0xd4, 0x3f, 0x83, 0xf8, 0xc5, 0x48, 0xb7, 0x92, 0x3b,
+	0xaf, 0x1c, 0x27, 0x44, 0x0f, 0x00, 0xc2, 0x4b, 0x82, 0x6e, 0x35, 0x77, 0x37, 0x11, 0x2a, 0x72,
+	0x5a, 0xc2, 0x80, 0x72, 0xf7, 0x2b, 0x04, 0xa8, 0xdf, 0x81, 0x9e, 0x8c, 0x4b, 0x97, 0xe1, 0xfc,
+	0x9f, 0x16, 0x00, 0xfa, 0xa2, 0xd4, 0x7b, 0x31, 0x65, 0xfe, 0x26, 0xb4, 0x42, 0x86, 0x09, 0xe5,
+	0x3d, 0xca, 0x45, 0x06, 0x11, 0x09, 0x91, 0x15, 0x20, 0x38, 0xa9, 0x4c, 0x81, 0x41, 0xfb, 0x89,
+	0x48, 0x0d, 0x63, 0x8a, 0xa4, 0xfe, 0xbc, 0x00, 0x75, 0x72, 0xfd, 0x4c, 0xc4, 0xcc, 0x08, 0x6a,
+	0xd9, 0x5d, 0xe7, 0x88, 0x08, 0x9f, 0x81, 0xce, 0x41, 0x95, 0xd4, 0x0f, 0x91, 0xfe, 0x2b, 0x91,
+	0x72, 0x22, 0x83, 0xa4, 0xaa, 0xf6, 0xcc, 0x31, 0x66, 0xde, 0x53, 0x5d, 0x63, 0x0d, 0x72, 0x0f,
+	0xce, 0x8a, 0x2e, 0x6b, 0xb9, 0x4b, 0xc6, 0x28, 0x3e, 0xc9, 0x63, 0xad, 0x84, 0xbb, 0x46, 0x15,
+	0x10, 0xd1, 0x69, 0x54, 0x9f, 0x6d, 0x38, 0x06, 0x53, 0x15, 0xed, 0x0c, 0x95, 0xce, 0x08, 0x99,
+	0xd6, 0x0a, 0x49, 0x66, 0x25, 0x35, 0xc8, 0xba, 0xc8, 0xa2, 0x4d, 0x23, 0xa8, 0x62, 0xa8, 0xb8,
+	0xce, 0x51, 0xdf, 0x10, 0xbb, 0xc1, 0x0a, 0xd5, 0x59, 0x08, 0x4f, 0x76, 0x63, 0x83, 0xb4, 0xc9,
+	0x7e, 0x62, 0xd7, 0x75, 0xdc, 0x81, 0x85, 0x3d, 0x4f, 0x1f, 0x61, 0x1e, 0x38, 0x35, 0x29, 0x70,
+	0x9b, 0xc1, 0xd4, 0xff, 0x28, 0x42, 0x3b, 0x5c, 0x4a, 0x50, 0xbb, 0x60, 0x1a, 0x41, 0xed, 0x82,
+	0x49, 0x8e, 0x0e, 0x5c, 0xa6, 0x0a, 0xc5, 0xe1, 0x3e, 0x28, 0x74, 0x15, 0xad, 0xce, 0xa1, 0x7d,
+	0x83, 0xd8, 0x55, 0x22, 0x64, 0xb6, 0x63, 0xe0, 0xf0, 0x70, 0x21, 0x00, 0xf1, 0xb3, 0x8d, 0xf1,
+	0x48, 0x29, 0x07, 0x8f, 0x94, 0x73, 0xf0, 0x48, 0x45, 0xc2, 0x23, 0x6b, 0x50, 0x79, 0x36, 0x1d,
+	0x1e, 0x60, 0x9f, 0x87, 0x39, 0xbc, 0x15, 0xe7, 0x9d, 0x5a, 0x82, 0x77, 0x04, 0x8b, 0xd4, 0xa3,
+	0x2c, 0x72, 0x01, 0xea, 0xec, 0x12, 0x7d, 0xe0, 0x7b, 0xf4, 0xc2, 0xab, 0xa8, 0xd5, 0x18, 0x60,
+	0xd7, 0x43, 0x1f, 0x05, 0xfe, 0x58, 0x23, 0xd3, 0x0b, 0x4c, 0x70, 0x49, 0xe0, 0x8d, 0xbd, 0x0d,
+	0x2b, 0x91, 0xed, 0xa0, 0x36, 0xa2, 0x49, 0xa7, 0xda, 0x0e, 0xc1, 0xd4, 0x4c, 0x5c, 0x83, 0x76,
+	0xb8, 0x25, 0x14, 0xaf, 0xc5, 0xa2, 0x5f, 0x01, 0xa5, 0x68, 0x67, 0xa1, 0x42, 0x2a, 0xc6, 0x7d,
+	0x8f, 0xde, 0xfc, 0x97, 0xb4, 0x32, 0xb6, 0x8d, 0x5d, 0x4f, 0xfd, 0x12, 0x50, 0x38, 0x81, 0xe5,
+	0x3c, 0xb6, 0xc4, 0x09, 0x17, 0x92, 0x27, 0xac, 0xfe, 0x58, 0x81, 0xd5, 0xe8, 0x60, 0x8b, 0xda,
+	0xce, 0x4f, 0xa0, 0xc1, 0x6e, 0x0d, 0x07, 0x44, 0x76, 0x79, 0xd6, 0xed, 0xd2, 0xcc, 0xad, 0xd5,
+	0x20, 0x7c, 0xad, 0x42, 0x38, 0xe4, 0xc8, 0x71, 0x0f, 0x88, 0x8b, 0x4e, 0x66, 0x16, 0x48, 0x4c,
+	0x93, 0x03, 0xc9, 0x8d, 0x0b, 0x2d, 0x26, 0xbb, 0xfc, 0x74, 0x62, 0xe8, 0x3e, 0x8e, 0x38, 0x11,
+	0xcb, 0x16, 0xc0, 0x7e, 0x18, 0x54, 0xa0, 0x16, 0xf2, 0xdd, 0x70, 0x31, 0x6c, 0x75, 0x9b, 0x54,
+	0x62, 0x7a, 0xd8, 0x36, 0x62, 0x1f, 0x17, 0xce, 0xb5, 0x4d, 0xa0, 0x27, 0xeb, 0x6e, 0x99, 0xb3,
+	0x67, 0xde, 0xdc, 0xc0, 0xc5, 0x1e, 0xcb, 0x83, 0x16, 0xb9, 0x13, 0x41, 0xc7, 0xf1, 0xd5, 0x9f,
+	0x14, 0xe0, 0xdc, 0x7d, 0xc3, 0xe0, 0x7a, 0x8d, 0xfb, 0x27, 0xaf, 0xca, 0x75, 0x4c, 0xba, 0x56,
+	0xc5, 0xb4, 0x6b, 0xf5, 0xb2, 0x74, 0x0d, 0xd7, 0xba, 0xe4, 0xf2, 0x85, 0x5b, 0x13, 0x97, 0x95,
+	0x39, 0xdd, 0xe3, 0xb7, 0x54, 0x24, 0xb0, 0xed, 0x56, 0x73, 0x79, 0x1c, 0xb5, 0x20, 0x67, 0xa8,
+	0x4e, 0xa0, 0x9b, 0xde, 0xac, 0x25, 0x25, 0x33, 0xd8, 0x91, 0x89, 0xc3, 0xe2, 0xf0, 0xa6, 0x06,
+	0x1c, 0xf4, 0xc4, 0xf1, 0xd4, 0xff, 0x29, 0x40, 0x97, 0x14, 0x73, 0xfc, 0xff, 0x39, 0xa0, 0xef,
+	0xc2, 0x19, 0x4f, 0x3f, 0xc4, 0x83, 0x48, 0xac, 0x37, 0x70, 0xf1, 0x73, 0xee, 0x94, 0xbd, 0x23,
+	0x13, 0x4c, 0x69, 0xb1, 0x8b, 0xb6, 0xea, 0xc5, 0xe0, 0x1a, 0x7e, 0x8e, 0xae, 0xc3, 0x4a, 0xb4,
+	0xdc, 0x6c, 0x60, 0x32, 0x53, 0xd2, 0xd4, 0x5a, 0x91, 0x6a, 0xb2, 0xbe, 0xa1, 0x3e, 0x87, 0x8b,
+	0x4f, 0x6d, 0x0f, 0xfb, 0xfd, 0xb0, 0x22, 0x6a, 0xc9, 0xa0, 0x8a, 0xd4, 0x53, 0x89, 0x8d, 0x4f,
+	0x3d, 0x60, 0x31, 0x3c, 0xd5, 0x81, 0xde, 0x76, 0x58, 0xdd, 0xe9, 0x6d, 0xb2, 0xc2, 0x8c, 0x57,
+	0x38, 0xe0, 0x8f, 0x4a, 0x70, 0x66, 0x63, 0xec, 0xd8, 0xf8, 0xeb, 0xb9, 0x6c, 0xba, 0x03, 0xa7,
+	0x7d, 0xdd, 0x1d, 0x61, 0x7f, 0x20, 0xb9, 0x60, 0x47, 0xec, 0xd3, 0x46, 0x94, 0xe0, 0x4b, 0x58,
+	0x0d, 0x79, 0xc8, 0xd2, 0x27, 0x13, 0x52, 0x9a, 0xc2, 0x42, 0x8d, 0x8f, 0xa5, 0x29, 0x9b, 0xf4,
+	0x52, 0x6e, 0x8b, 0x37, 0x07, 0xdb, 0x8c, 0x9e, 0xd4, 0x7c, 0x1c, 0x6b, 0x9d, 0x49, 0x02, 0x8c,
+	0x0c, 0x58, 0x09, 0xf8, 0x3e, 0x18, 0x89, 0x05, 0x23, 0xf7, 0xf2, 0x8e, 0xc4, 0x1d, 0xfa, 0xd8,
+	0x38, 0xed, 0x61, 0x0c, 0x18, 0x2f, 0xb6, 0xa8, 0x24, 0x8a, 0x2d, 0x7a, 0x1b, 0x70, 0x56, 0x3a,
+	0x5d, 0xd4, 0x81, 0xe2, 0x01, 0x3e, 0xe6, 0x3e, 0x1d, 0xf9, 0x49, 0xdc, 0x9d, 0x43, 0xe2, 0xd6,
+	0xf2, 0x8d, 0x66, 0x8d, 0x6f, 0x14, 0x3e, 0x52, 0x7a, 0xf7, 0xe1, 0xb4, 0x64, 0x26, 0xd1, 0x2e,
+	0xea, 0x92, 0x2e, 0xea, 0x91, 0x2e, 0xd4, 0x31, 0x9c, 0x4d, 0xac, 0x70, 0x19, 0x05, 0x37, 0xef,
+	0x95, 0xdf, 0x9e, 0xa8, 0x96, 0xd3, 0xf0, 0x1e, 0x76, 0xb1, 0x3d, 0xc4, 0xe4, 0x31, 0x43, 0xe4,
+	0x6d, 0x81, 0x12, 0x7d, 0x5b, 0xb0, 0xe8, 0x5b, 0x05, 0xf5, 0x6f, 0x85, 0xd3, 0xc0, 0xf7, 0x87,
+	0xd6, 0x2d, 0x4d, 0x68, 0x7d, 0xd8, 0xc2, 0x7c, 0xdf, 0x83, 0xda, 0x21, 0xef, 0x2e, 0x78, 0x26,
+	0x1b, 0xb4, 0x63, 0xe5, 0x4e, 0xc5, 0x93, 0x97, 0x3b, 0xa9, 0x36, 0x7d, 0xa4, 0x92, 0x9a, 0xec,
+	0x72, 0xa5, 0x7c, 0xc1, 0xec, 0x82, 0xf4, 0x56, 0x08, 0x20, 0x77, 0xaf, 0xab, 0xa9, 0xd1, 0x62,
+	0x2b, 0x54, 0x66, 0xac, 0x70, 0x91, 0x82, 0xae, 0xb3, 0x50, 0x19, 0xeb, 0xa3, 0x81, 0x15, 0x5c,
+	0x07, 0x94, 0xc7, 0xfa, 0x68, 0xdb, 0x53, 0xff, 0x94, 0x3d, 0x6f, 0x92, 0xad, 0x7c, 0x19, 0x46,
+	0xdc, 0xe2, 0x85, 0x83, 0xac, 0x2f, 0xee, 0xe6, 0xcd, 0xb8, 0x72, 0x8d, 0xf0, 0x47, 0x94, 0xf0,
+	0xe6, 0x1d, 0x51, 0x2b, 0x4e, 0x2e, 0x6f, 0x50, 0x15, 0x8a, 0x8f, 0xf1, 0x51, 0xe7, 0x14, 0x02,
+	0xa8, 0x3c, 0x76, 0x5c, 0x4b, 0x1f, 0x77, 0x14, 0xd4, 0x80, 0x2a, 0xbf, 0x33, 0xef, 0x14, 0x6e,
+	0xfe, 0x49, 0xb8, 0xb1, 0xe1, 0x35, 0x2e, 0x6a, 0x03, 0x3c, 0xb5, 0x87, 0xfc, 0x7e, 0xbb, 0x73,
+	0x0a, 0x35, 0xa1, 0x16, 0xdc, 0x76, 0xb3, 0x0e, 0x76, 0x1d, 0x8a, 0xdd, 0x29, 0xa0, 0x0e, 0x34,
+	0x19, 0xe1, 0x74, 0x38, 0xc4, 0x9e, 0xd7, 0x29, 0x0a, 0xc8, 0x96, 0x6e, 0x8e, 0xa7, 0x2e, 0xee,
+	0x94, 0x50, 0x0b, 0xea, 0xbb, 0x0e, 0x7f, 0x13, 0xd4, 0x29, 0x23, 0x04, 0x6d, 0xde, 0x08, 0x88,
+	0x2a, 0x11, 0x58, 0x40, 0x56, 0xbd, 0xf9, 0x3c, 0x7a, 0xef, 0x46, 0xd7, 0x73, 0x0e, 0x4e, 0x3f,
+	0xb5, 0x0d, 0xbc, 0x67, 0xda, 0xd8, 0x08, 0x3f, 0x75, 0x4e, 0xa1, 0xd3, 0xb0, 0xb2, 0x8d, 0xdd,
+	0x11, 0x8e, 0x00, 0x0b, 0x68, 0x15, 0x5a, 0xdb, 0xe6, 0x8b, 0x08, 0xa8, 0x88, 0xba, 0xc4, 0xaa,
+	0x04, 0xc9, 0xf2, 0xc8, 0x97, 0x92, 0x5a, 0xaa, 0x29, 0x1d, 0x65, 0xfd, 0x27, 0x17, 0xa1, 0x4e,
+	0xb2, 0xa2, 0x1b, 0x8e, 0xe3, 0x1a, 0x68, 0x02, 0x88, 0x9c, 0xb5, 0x63, 0x4d, 0x1c, 0x5b, 0x3c,
+	0x59, 0x45, 0x77, 0x33, 0xf8, 0x28, 0x8d, 0xca, 0xa5, 0xa1, 0x77, 0x3d, 0x83, 0x22, 0x81, 0xae,
+	0x9e, 0x42, 0x16, 0x1d, 0x91, 0xdc, 0xeb, 0xed, 0x9a, 0xc3, 0x83, 0x80, 0x97, 0x67, 0x8c, 0x98,
+	0x40, 0x0d, 0x46, 0x4c, 0xe4, 0xe0, 0x79, 0x83, 0xbd, 0x80, 0x0c, 0x38, 0x55, 0x3d, 0x85, 0x9e,
+	0xc3, 0x99, 0x87, 0x38, 0x12, 0xa1, 0x04, 0x03, 0xae, 0x67, 0x0f, 0x98, 0x42, 0x3e, 0xe1, 0x90,
+	0x8f, 0xa0, 0x4c, 0xb9, 0x0f, 0xc9, 0x82, 0x98, 0xe8, 0x3f, 0x4c, 0xf4, 0xae, 0x64, 0x23, 0x88,
+	0xde, 0xbe, 0x84, 0x95, 0xc4, 0xbb, 0x74, 0x24, 0xf3, 0xc1, 0xe4, 0xff, 0x30, 0xd0, 0xbb, 0x99,
+	0x07, 0x55, 0x8c, 0x35, 0x82, 0x76, 0xfc, 0x61, 0x1e, 0x92, 0x5d, 0x2d, 0x48, 0x9f, 0x14, 0xf7,
+	0xde, 0xc9, 0x81, 0x29, 0x06, 0xb2, 0xa0, 0x93, 0x7c, 0x27, 0x8d, 0x6e, 0xce, 0xec, 0x20, 0xce,
+	0x6e, 0xef, 0xe6, 0xc2, 0x15, 0xc3, 0x1d, 0xc3, 0x19, 0xd9, 0xd3, 0x5b, 0x74, 0x5b, 0xde, 0x4d,
+	0xd6, 0x9b, 0xe0, 0xde, 0x9d, 0xdc, 0xf8, 0x62, 0xe8, 0x5f, 0x67, 0x55, 0x5b, 0xb2, 0xe7, 0xab,
+	0xe8, 0x7d, 0x79, 0x77, 0x33, 0xde, 0xdd, 0xf6, 0xd6, 0x4f, 0x42, 0x22, 0x26, 0xf1, 0x03, 0x5a,
+	0x6e, 0x25, 0x79, 0x00, 0x8a, 0xee, 0xca, 0xfb, 0xcb, 0x7e, 0xdb, 0xda, 0x7b, 0xff, 0x04, 0x14,
+	0x62, 0x02, 0x4e, 0xf2, 0x21, 0x7a, 0x20, 0x86, 0x77, 0xe6, 0x72, 0xcd, 0x62, 0x32, 0xf8, 0x3d,
+	0x58, 0x49, 0x44, 0x25, 0x28, 0x7f, 0xe4, 0xd2, 0x9b, 0x65, 0xd0, 0x98, 0x48, 0x26, 0xaa, 0xd7,
+	0x50, 0x06, 0xf7, 0x4b, 0x2a, 0xdc, 0x7a, 0x37, 0xf3, 0xa0, 0x8a, 0x85, 0x78, 0x54, 0x5d, 0x26,
+	0x2a, 0xc0, 0xd0, 0x2d, 0x79, 0x1f, 0xf2, 0xea, 0xb5, 0xde, 0x7b, 0x39, 0xb1, 0xc5, 0xa0, 0xbf,
+	0x0a, 0x68, 0x67, 0x9f, 0x64, 0x42, 0xed, 0x3d, 0x73, 0x34, 0x75, 0x75, 0x56, 0x67, 0x9f, 0xa5,
+	0xa3, 0xd3, 0xa8, 0x19, 0xbc, 0x32, 0x93, 0x42, 0x0c, 0x3e, 0x00, 0x78, 0x88, 0xfd, 0x6d, 0xec,
+	0xbb, 0x84, 0x41, 0xaf, 0x4b, 0xcf, 0x3b, 0x44, 0x08, 0x86, 0x7a, 0x7b, 0x2e, 0x5e, 0xc4, 0x24,
+	0x74, 0xb6, 0x75, 0x9b, 0x5c, 0x02, 0x84, 0x0f, 0x7b, 0x6e, 0x49, 0xc9, 0x93, 0x68, 0x19, 0x1b,
+	0x9a, 0x89, 0x2d, 0x86, 0x3c, 0x12, 0x66, 0x36, 0x72, 0x27, 0x8b, 0x6e, 0x4b, 0xbb, 0x49, 0x23,
+	0x66, 0xa8, 0x9f, 0x19, 0xf8, 0x62, 0xe0, 0xaf, 0x14, 0xb8, 0x90, 0x46, 0xf8, 0xc2, 0xf4, 0xf7,
+	0x49, 0x95, 0x8f, 0x97, 0x67, 0x0a, 0x14, 0xf1, 0x04, 0x53, 0xe0, 0xf8, 0x62, 0x0a, 0x06, 0xb4,
+	0x62, 0x37, 0xad, 0x48, 0xf6, 0xd0, 0x43, 0x76, 0x6d, 0xdc, 0xbb, 0x31, 0x1f, 0x51, 0x8c, 0xb2,
+	0x0f, 0xad, 0x80, 0xa5, 0xd9, 0xe6, 0xbe, 0x93, 0x35, 0xd3, 0x10, 0x27, 0x43, 0x22, 0xe5, 0xa8,
+	0x51, 0x89, 0x4c, 0x5f, 0x24, 0xa1, 0x7c, 0x17, 0x90, 0xb3, 0x24, 0x32, 0xfb, 0x76, 0x8a, 0xa9,
+	0x9c, 0xc4, 0xa5, 0xad, 0x5c, 0x9f, 0x49, 0xef, 0xa0, 0x7b, 0x37, 0xf3, 0xa0, 0x8a, 0xb1, 0xbe,
+	0x80, 0x0a, 0xff, 0x7b, 0xa3, 0xb7, 0x66, 0x67, 0x8e, 0x79, 0xef, 0xd7, 0xe6, 0x60, 0x89, 0x8e,
+	0x0f, 0xe0, 0x5c, 0x46, 0xde, 0x58, 0x6a, 0x0a, 0x67, 0xe7, 0x98, 0xe7, 0x29, 0x69, 0x1d, 0x50,
+	0xfa, 0x3f, 0x04, 0xa4, 0xc7, 0x94, 0xf9, 0x57, 0x03, 0x39, 0x86, 0x48, 0xff, 0x0d, 0x80, 0x74,
+	0x88, 0xcc, 0x7f, 0x0b, 0x98, 0x37, 0xc4, 0x00, 0x56, 0x53, 0xd9, 0x47, 0xf4, 0x6e, 0x86, 0x25,
+	0x93, 0xe5, 0x28, 0xe7, 0x0d, 0x30, 0x82, 0xb3, 0xd2, 0x4c, 0x9b, 0xd4, 0x32, 0xcf, 0xca, 0xc9,
+	0xcd, 0x1b, 0x68, 0x08, 0xa7, 0x25, 0xf9, 0x35, 0x24, 0x93, 0x84, 0xec, 0x3c, 0xdc, 0xbc, 0x41,
+	0x0c, 0x68, 0xc5, 0x72, 0x27, 0x52, 0x5d, 0x23, 0xcb, 0x1f, 0xf5, 0x6e, 0xcc, 0x47, 0x4c, 0xf3,
+	0x71, 0x3a, 0x5e, 0xcf, 0xe6, 0xe3, 0xac, 0xb4, 0xc7, 0xbc, 0x25, 0xfd, 0x1a, 0x75, 0x9d, 0x52,
+	0xd4, 0x5e, 0x96, 0xeb, 0x94, 0x99, 0xb1, 0xe8, 0xdd, 0xcd, 0x4f, 0x10, 0x2c, 0x75, 0xfd, 0xdf,
+	0xea, 0x50, 0x0b, 0xde, 0xda, 0xbc, 0x86, 0x60, 0xf1, 0x35, 0x44, 0x6f, 0xdf, 0x83, 0x95, 0xc4,
+	0x3f, 0x25, 0x48, 0x35, 0xad, 0xfc, 0xdf, 0x14, 0xe6, 0x1d, 0xe6, 0x17, 0xfc, 0x7f, 0xfc, 0x66,
+	0xf2, 0xa7, 0xec, 0xcf, 0x11, 0xe6, 0x75, 0xfc, 0x7f, 0xdb, 0x63, 0x7b, 0x0c, 0x10, 0xf1, 0xd5,
+	0x66, 0x97, 0xf4, 0x12, 0xf7, 0x63, 0xde, 0x6e, 0x59, 0x52, 0x77, 0xec, 0x9d, 0x3c, 0x65, 0x74,
+	0xd9, 0x06, 0x35, 0xdb, 0x09, 0x7b, 0x0a, 0xcd, 0x68, 0x01, 0x3f, 0x92, 0xfe, 0x6b, 0x5c, 0xba,
+	0xc2, 0x7f, 0xde, 0x2a, 0xb6, 0x4f, 0x68, 0xa7, 0xe7, 0x74, 0xe7, 0x01, 0x4a, 0xdf, 0x7d, 0x66,
+	0x58, 0xb3, 0x8c, 0x1b, 0xd7, 0xde, 0x7b, 0x39, 0xb1, 0xa3, 0x89, 0x80, 0xe4, 0x85, 0x9e, 0x34,
+	0x11, 0x90, 0x71, 0x45, 0xda, 0x7b, 0x37, 0x17, 0x6e, 0x30, 0xdc, 0x83, 0x0f, 0xbe, 0xfb, 0xfe,
+	0xc8, 0xf4, 0xf7, 0xa7, 0xcf, 0xc8, 0xea, 0xef, 0x30, 0xd2, 0xf7, 0x4c, 0x87, 0xff, 0xba, 0x13,
+	0xb0, 0xfb, 0x1d, 0xda, 0xdb, 0x1d, 0xd2, 0xdb, 0xe4, 0xd9, 0xb3, 0x0a, 0x6d, 0x7d, 0xf0, 0xbf,
+	0x03, 0x00, 0x67, 0x87, 0x99, 0x07, 0x95, 0x54, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5031,6 +5649,9 @@ type DataCoordClient interface {
 	SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*CloneSegmentsResponse, error)
+	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetChannelCheckpoints(ctx context.Context, in *GetChannelCheckpointsRequest, opts ...grpc.CallOption) (*GetChannelCheckpointsResponse, error)
 }
 
 type dataCoordClient struct {
@@ -5311,6 +5932,33 @@ func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegme
 	return out, nil
 }
 
+func (c *dataCoordClient) CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*CloneSegmentsResponse, error) {
+	out := new(CloneSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CloneSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetChannelCheckpoints(ctx context.Context, in *GetChannelCheckpointsRequest, opts ...grpc.CallOption) (*GetChannelCheckpointsResponse, error) {
+	out := new(GetChannelCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetChannelCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -5345,6 +5993,9 @@ type DataCoordServer interface {
 	SaveImportSegment(context.Context, *SaveImportSegmentRequest) (*commonpb.Status, error)
 	UnsetIsImportingState(context.Context, *UnsetIsImportingStateRequest) (*commonpb.Status, error)
 	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	CloneSegments(context.Context, *CloneSegmentsRequest) (*CloneSegmentsResponse, error)
+	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
+	GetChannelCheckpoints(context.Context, *GetChannelCheckpointsRequest) (*GetChannelCheckpointsResponse, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -5441,6 +6092,15 @@ func (*UnimplementedDataCoordServer) UnsetIsImportingState(ctx context.Context,
 func (*UnimplementedDataCoordServer) MarkSegmentsDropped(ctx context.Context, req *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
 }
+func (*UnimplementedDataCoordServer) CloneSegments(ctx context.Context, req *CloneSegmentsRequest) (*CloneSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) UpdateChannelCheckpoint(ctx context.Context, req *UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelCheckpoint not implemented")
+}
+func (*UnimplementedDataCoordServer) GetChannelCheckpoints(ctx context.Context, req *GetChannelCheckpointsRequest) (*GetChannelCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelCheckpoints not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -5986,6 +6646,60 @@ func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CloneSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CloneSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CloneSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CloneSegments(ctx, req.(*CloneSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_UpdateChannelCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, req.(*UpdateChannelCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetChannelCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetChannelCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetChannelCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetChannelCheckpoints(ctx, req.(*GetChannelCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -6110,6 +6824,18 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarkSegmentsDropped",
 			Handler:    _DataCoord_MarkSegmentsDropped_Handler,
 		},
+		{
+			MethodName: "CloneSegments",
+			Handler:    _DataCoord_CloneSegments_Handler,
+		},
+		{
+			MethodName: "UpdateChannelCheckpoint",
+			Handler:    _DataCoord_UpdateChannelCheckpoint_Handler,
+		},
+		{
+			MethodName: "GetChannelCheckpoints",
+			Handler:    _DataCoord_GetChannelCheckpoints_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -29,6 +29,7 @@ const (
 	CollectionState_CollectionCreating CollectionState = 1
 	CollectionState_CollectionDropping CollectionState = 2
 	CollectionState_CollectionDropped  CollectionState = 3
+	CollectionState_CollectionRecycled CollectionState = 4
 )
 
 var CollectionState_name = map[int32]string{
@@ -36,6 +37,7 @@ var CollectionState_name = map[int32]string{
 	1: "CollectionCreating",
 	2: "CollectionDropping",
 	3: "CollectionDropped",
+	4: "CollectionRecycled",
 }
 
 var CollectionState_value = map[string]int32{
@@ -43,6 +45,7 @@ var CollectionState_value = map[string]int32{
 	"CollectionCreating": 1,
 	"CollectionDropping": 2,
 	"CollectionDropped":  3,
+	"CollectionRecycled": 4,
 }
 
 func (x CollectionState) String() string {
@@ -60,6 +63,7 @@ const (
 	PartitionState_PartitionCreating PartitionState = 1
 	PartitionState_PartitionDropping PartitionState = 2
 	PartitionState_PartitionDropped  PartitionState = 3
+	PartitionState_PartitionRecycled PartitionState = 4
 )
 
 var PartitionState_name = map[int32]string{
@@ -67,6 +71,7 @@ var PartitionState_name = map[int32]string{
 	1: "PartitionCreating",
 	2: "PartitionDropping",
 	3: "PartitionDropped",
+	4: "PartitionRecycled",
 }
 
 var PartitionState_value = map[string]int32{
@@ -74,6 +79,7 @@ var PartitionState_value = map[string]int32{
 	"PartitionCreating": 1,
 	"PartitionDropping": 2,
 	"PartitionDropped":  3,
+	"PartitionRecycled": 4,
 }
 
 func (x PartitionState) String() string {
@@ -251,6 +257,7 @@ type CollectionInfo struct {
 	StartPositions             []*commonpb.KeyDataPair   `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	ConsistencyLevel           commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	State                      CollectionState           `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.etcd.CollectionState" json:"state,omitempty"`
+	DroppedTime                uint64                    `protobuf:"varint,14,opt,name=dropped_time,json=droppedTime,proto3" json:"dropped_time,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
 	XXX_unrecognized           []byte                    `json:"-"`
 	XXX_sizecache              int32                     `json:"-"`
@@ -372,12 +379,20 @@ func (m *CollectionInfo) GetState() CollectionState {
 	return CollectionState_CollectionCreated
 }
 
+func (m *CollectionInfo) GetDroppedTime() uint64 {
+	if m != nil {
+		return m.DroppedTime
+	}
+	return 0
+}
+
 type PartitionInfo struct {
 	PartitionID               int64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PartitionName             string         `protobuf:"bytes,2,opt,name=partitionName,proto3" json:"partitionName,omitempty"`
 	PartitionCreatedTimestamp uint64         `protobuf:"varint,3,opt,name=partition_created_timestamp,json=partitionCreatedTimestamp,proto3" json:"partition_created_timestamp,omitempty"`
 	CollectionId              int64          `protobuf:"varint,4,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
 	State                     PartitionState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.etcd.PartitionState" json:"state,omitempty"`
+	DroppedTime               uint64         `protobuf:"varint,6,opt,name=dropped_time,json=droppedTime,proto3" json:"dropped_time,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}       `json:"-"`
 	XXX_unrecognized          []byte         `json:"-"`
 	XXX_sizecache             int32          `json:"-"`
@@ -443,6 +458,13 @@ func (m *PartitionInfo) GetState() PartitionState {
 	return PartitionState_PartitionCreated
 }
 
+func (m *PartitionInfo) GetDroppedTime() uint64 {
+	if m != nil {
+		return m.DroppedTime
+	}
+	return 0
+}
+
 type AliasInfo struct {
 	AliasName            string     `protobuf:"bytes,1,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
 	CollectionId         int64      `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
@@ -771,68 +793,70 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x6e, 0x23, 0x45,
-	0x14, 0x9d, 0x76, 0xfb, 0xd5, 0xd7, 0x8f, 0xd8, 0xc5, 0x4c, 0xd4, 0x13, 0x66, 0xa0, 0xc7, 0x30,
-	0xd0, 0x1a, 0x69, 0x12, 0x91, 0xf0, 0xda, 0x80, 0x80, 0xb4, 0x46, 0xb2, 0x80, 0x91, 0xd5, 0x89,
-	0xb2, 0x60, 0xd3, 0x2a, 0x77, 0x57, 0xec, 0x42, 0xfd, 0x52, 0x57, 0x39, 0x90, 0x3f, 0xe0, 0x4f,
-	0xf8, 0x04, 0xf8, 0x01, 0xbe, 0x86, 0x35, 0x7b, 0x54, 0x55, 0xfd, 0xb6, 0xc3, 0x92, 0x9d, 0xef,
-	0xe9, 0xba, 0xb7, 0xee, 0xb9, 0xf7, 0xd4, 0x31, 0x1c, 0x11, 0xee, 0x07, 0x5e, 0x44, 0x38, 0x3e,
-	0x4d, 0xb3, 0x84, 0x27, 0x68, 0x1e, 0xd1, 0xf0, 0x6e, 0xc7, 0x54, 0x74, 0x2a, 0xbe, 0x9e, 0x8c,
-	0xfd, 0x24, 0x8a, 0x92, 0x58, 0x41, 0x27, 0x63, 0xe6, 0x6f, 0x49, 0x94, 0x1f, 0x5f, 0xfc, 0xa5,
-	0x81, 0xb1, 0x8c, 0x03, 0xf2, 0xeb, 0x32, 0xbe, 0x4d, 0xd0, 0x73, 0x00, 0x2a, 0x02, 0x2f, 0xc6,
-	0x11, 0x31, 0x35, 0x4b, 0xb3, 0x0d, 0xd7, 0x90, 0xc8, 0x5b, 0x1c, 0x11, 0x64, 0xc2, 0x40, 0x06,
-	0x4b, 0xc7, 0xec, 0x58, 0x9a, 0xad, 0xbb, 0x45, 0x88, 0x1c, 0x18, 0xab, 0xc4, 0x14, 0x67, 0x38,
-	0x62, 0xa6, 0x6e, 0xe9, 0xf6, 0xe8, 0xfc, 0xc5, 0x69, 0xa3, 0x99, 0xbc, 0x8d, 0xef, 0xc9, 0xfd,
-	0x0d, 0x0e, 0x77, 0x64, 0x85, 0x69, 0xe6, 0x8e, 0x64, 0xda, 0x4a, 0x66, 0x89, 0xfa, 0x01, 0x09,
-	0x09, 0x27, 0x81, 0xd9, 0xb5, 0x34, 0x7b, 0xe8, 0x16, 0x21, 0x7a, 0x1f, 0x46, 0x7e, 0x46, 0x30,
-	0x27, 0x1e, 0xa7, 0x11, 0x31, 0x7b, 0x96, 0x66, 0x77, 0x5d, 0x50, 0xd0, 0x35, 0x8d, 0xc8, 0xc2,
-	0x81, 0xe9, 0x1b, 0x4a, 0xc2, 0xa0, 0xe2, 0x62, 0xc2, 0xe0, 0x96, 0x86, 0x24, 0x58, 0x3a, 0x92,
-	0x88, 0xee, 0x16, 0xe1, 0xc3, 0x34, 0x16, 0x7f, 0xf6, 0x60, 0x7a, 0x99, 0x84, 0x21, 0xf1, 0x39,
-	0x4d, 0x62, 0x59, 0x66, 0x0a, 0x9d, 0xb2, 0x42, 0x67, 0xe9, 0xa0, 0xaf, 0xa0, 0xaf, 0x06, 0x28,
-	0x73, 0x47, 0xe7, 0x2f, 0x9b, 0x1c, 0xf3, 0xe1, 0x56, 0x45, 0xae, 0x24, 0xe0, 0xe6, 0x49, 0x6d,
-	0x22, 0x7a, 0x9b, 0x08, 0x5a, 0xc0, 0x38, 0xc5, 0x19, 0xa7, 0xb2, 0x01, 0x87, 0x99, 0x5d, 0x4b,
-	0xb7, 0x75, 0xb7, 0x81, 0xa1, 0x8f, 0x60, 0x5a, 0xc6, 0x62, 0x31, 0xcc, 0xec, 0x59, 0xba, 0x6d,
-	0xb8, 0x2d, 0x14, 0xbd, 0x81, 0xc9, 0xad, 0x18, 0x8a, 0x27, 0xf9, 0x11, 0x66, 0xf6, 0x0f, 0xad,
-	0x45, 0x68, 0xe4, 0xb4, 0x39, 0x3c, 0x77, 0x7c, 0x5b, 0xc6, 0x84, 0xa1, 0x73, 0x78, 0x72, 0x47,
-	0x33, 0xbe, 0xc3, 0xa1, 0xe7, 0x6f, 0x71, 0x1c, 0x93, 0x50, 0x0a, 0x84, 0x99, 0x03, 0x79, 0xed,
-	0x3b, 0xf9, 0xc7, 0x4b, 0xf5, 0x4d, 0xdd, 0xfd, 0x29, 0x1c, 0xa7, 0xdb, 0x7b, 0x46, 0xfd, 0xbd,
-	0xa4, 0xa1, 0x4c, 0x7a, 0x5c, 0x7c, 0x6d, 0x64, 0x7d, 0x03, 0xcf, 0x4a, 0x0e, 0x9e, 0x9a, 0x4a,
-	0x20, 0x27, 0xc5, 0x38, 0x8e, 0x52, 0x66, 0x1a, 0x96, 0x6e, 0x77, 0xdd, 0x93, 0xf2, 0xcc, 0xa5,
-	0x3a, 0x72, 0x5d, 0x9e, 0x10, 0x12, 0x66, 0x5b, 0x9c, 0x05, 0xcc, 0x8b, 0x77, 0x91, 0x09, 0x96,
-	0x66, 0xf7, 0x5c, 0x43, 0x21, 0x6f, 0x77, 0x11, 0x5a, 0xc2, 0x11, 0xe3, 0x38, 0xe3, 0x5e, 0x9a,
-	0x30, 0x59, 0x81, 0x99, 0x23, 0x39, 0x14, 0xeb, 0x21, 0xad, 0x3a, 0x98, 0x63, 0x29, 0xd5, 0xa9,
-	0x4c, 0x5c, 0x15, 0x79, 0xc8, 0x85, 0xb9, 0x9f, 0xc4, 0x8c, 0x32, 0x4e, 0x62, 0xff, 0xde, 0x0b,
-	0xc9, 0x1d, 0x09, 0xcd, 0xb1, 0xa5, 0xd9, 0xd3, 0xb6, 0x28, 0xf2, 0x62, 0x97, 0xd5, 0xe9, 0x1f,
-	0xc4, 0x61, 0x77, 0xe6, 0xb7, 0x10, 0xf4, 0x25, 0xf4, 0x18, 0xc7, 0x9c, 0x98, 0x13, 0x59, 0x67,
-	0x71, 0x60, 0x53, 0x35, 0x69, 0x89, 0x93, 0xae, 0x4a, 0x58, 0xfc, 0xa3, 0xc1, 0x64, 0x55, 0x8a,
-	0x44, 0x28, 0xd7, 0x82, 0x51, 0x4d, 0x35, 0xb9, 0x84, 0xeb, 0x10, 0xfa, 0x10, 0x26, 0x0d, 0xc5,
-	0x48, 0x49, 0x1b, 0x6e, 0x13, 0x44, 0x5f, 0xc3, 0xbb, 0xff, 0xb1, 0x93, 0x5c, 0xc2, 0x4f, 0x1f,
-	0x5c, 0x09, 0xfa, 0x00, 0x26, 0x7e, 0xd9, 0xb3, 0x47, 0xd5, 0xdb, 0xd6, 0xdd, 0x71, 0x05, 0x2e,
-	0x03, 0xf4, 0x45, 0x41, 0xbc, 0x27, 0x89, 0x1f, 0x92, 0x68, 0xc9, 0xae, 0xc1, 0xfb, 0x77, 0x0d,
-	0x8c, 0x6f, 0x43, 0x8a, 0x59, 0x61, 0x60, 0x58, 0x04, 0x0d, 0x03, 0x93, 0x88, 0xa4, 0xb2, 0xd7,
-	0x4a, 0xe7, 0x40, 0x2b, 0x2f, 0x60, 0x5c, 0x67, 0x99, 0x13, 0xcc, 0x9f, 0xad, 0xe4, 0x85, 0x2e,
-	0x8a, 0x6e, 0xbb, 0xb2, 0xdb, 0xe7, 0x07, 0xba, 0x95, 0x3d, 0x35, 0x3a, 0xfd, 0xad, 0x03, 0xb3,
-	0x2b, 0xb2, 0x89, 0x48, 0xcc, 0x2b, 0x97, 0x5a, 0x40, 0xfd, 0xf2, 0x62, 0x4b, 0x0d, 0xac, 0xbd,
-	0xc8, 0xce, 0xfe, 0x22, 0x9f, 0x81, 0xc1, 0xf2, 0xca, 0x8e, 0xec, 0x57, 0x77, 0x2b, 0x40, 0x39,
-	0xa1, 0x78, 0xce, 0x4e, 0x3e, 0xfa, 0x22, 0xac, 0x3b, 0x61, 0xaf, 0x69, 0xe8, 0x26, 0x0c, 0xd6,
-	0x3b, 0x2a, 0x73, 0xfa, 0xea, 0x4b, 0x1e, 0x8a, 0xf1, 0x90, 0x18, 0xaf, 0x43, 0xa2, 0x5c, 0xc5,
-	0x1c, 0x48, 0xa7, 0x1e, 0x29, 0x4c, 0x12, 0x6b, 0x9b, 0xdc, 0x70, 0xcf, 0xad, 0xff, 0xd6, 0xea,
-	0x3e, 0xfb, 0x23, 0xe1, 0xf8, 0x7f, 0xf7, 0xd9, 0xf7, 0x00, 0xca, 0x09, 0x15, 0x2e, 0x5b, 0x43,
-	0xd0, 0xcb, 0x9a, 0xc7, 0x7a, 0x1c, 0x6f, 0x0a, 0x8f, 0xad, 0x1e, 0xc7, 0x35, 0xde, 0xb0, 0x3d,
-	0xbb, 0xee, 0xef, 0xdb, 0xf5, 0xe2, 0x0f, 0xc1, 0x36, 0x23, 0x01, 0x89, 0x39, 0xc5, 0xa1, 0x5c,
-	0xfb, 0x09, 0x0c, 0x77, 0x8c, 0x64, 0x35, 0x95, 0x96, 0x31, 0x7a, 0x0d, 0x88, 0xc4, 0x7e, 0x76,
-	0x9f, 0x0a, 0x05, 0xa6, 0x98, 0xb1, 0x5f, 0x92, 0x2c, 0xc8, 0x9f, 0xe6, 0xbc, 0xfc, 0xb2, 0xca,
-	0x3f, 0xa0, 0x63, 0xe8, 0x73, 0x12, 0xe3, 0x98, 0x4b, 0x92, 0x86, 0x9b, 0x47, 0xe8, 0x29, 0x0c,
-	0x29, 0xf3, 0xd8, 0x2e, 0x25, 0x59, 0xf1, 0x6f, 0x4a, 0xd9, 0x95, 0x08, 0xd1, 0xc7, 0x70, 0xc4,
-	0xb6, 0xf8, 0xfc, 0xb3, 0xcf, 0xab, 0xf2, 0x3d, 0x99, 0x3b, 0x55, 0x70, 0x51, 0xfb, 0x55, 0x02,
-	0x47, 0x2d, 0xbb, 0x41, 0x4f, 0x60, 0x5e, 0x41, 0xf9, 0x5b, 0x9f, 0x3d, 0x42, 0xc7, 0x80, 0x5a,
-	0x30, 0x8d, 0x37, 0x33, 0xad, 0x89, 0x3b, 0x59, 0x92, 0xa6, 0x02, 0xef, 0x34, 0xcb, 0x48, 0x9c,
-	0x04, 0x33, 0xfd, 0xd5, 0xcf, 0x30, 0x6d, 0x3e, 0x73, 0xf4, 0x18, 0x66, 0xab, 0x96, 0xb5, 0xcc,
-	0x1e, 0x89, 0xf4, 0x26, 0xaa, 0x6e, 0xab, 0xc3, 0xb5, 0xcb, 0xea, 0x35, 0xaa, 0xbb, 0x6e, 0x00,
-	0xaa, 0x47, 0x8a, 0x66, 0x30, 0x96, 0x51, 0x75, 0xc7, 0x1c, 0x26, 0x15, 0xa2, 0xea, 0x17, 0x50,
-	0xad, 0x76, 0x91, 0x57, 0xd6, 0xfd, 0xee, 0xe2, 0xa7, 0x4f, 0x36, 0x94, 0x6f, 0x77, 0x6b, 0xe1,
-	0xfb, 0x67, 0x4a, 0xb5, 0xaf, 0x69, 0x92, 0xff, 0x3a, 0xa3, 0x31, 0x17, 0x8b, 0x0e, 0xcf, 0xa4,
-	0x90, 0xcf, 0x84, 0x59, 0xa4, 0xeb, 0x75, 0x5f, 0x46, 0x17, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x43, 0x11, 0x13, 0x31, 0xd0, 0x09, 0x00, 0x00,
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x5e, 0xc7, 0x49, 0x5a, 0x9f, 0x38, 0x69, 0x3a, 0xec, 0x56, 0xde, 0xb2, 0x0b, 0x6e, 0xa0,
+	0x60, 0xad, 0xb4, 0xad, 0x68, 0xf9, 0xbb, 0x01, 0x01, 0xb5, 0x56, 0x8a, 0x80, 0x55, 0x34, 0xad,
+	0xf6, 0x82, 0x1b, 0x6b, 0x6a, 0x4f, 0x9b, 0x91, 0xfc, 0x27, 0xcf, 0xa4, 0xd0, 0x4b, 0xae, 0xe0,
+	0x11, 0x78, 0x03, 0x1e, 0x81, 0x27, 0xe0, 0x29, 0x78, 0x04, 0x5e, 0x02, 0xcd, 0x8c, 0x7f, 0x93,
+	0x74, 0x2f, 0xb9, 0xf3, 0xf9, 0xe6, 0x9c, 0x33, 0xe7, 0xe7, 0x9b, 0xcf, 0xb0, 0x47, 0x45, 0x18,
+	0x05, 0x09, 0x15, 0xe4, 0x24, 0x2f, 0x32, 0x91, 0xa1, 0xfd, 0x84, 0xc5, 0x77, 0x2b, 0xae, 0xad,
+	0x13, 0x79, 0x7a, 0x68, 0x87, 0x59, 0x92, 0x64, 0xa9, 0x86, 0x0e, 0x6d, 0x1e, 0x2e, 0x69, 0x52,
+	0xba, 0xcf, 0xfe, 0x36, 0xc0, 0x9a, 0xa7, 0x11, 0xfd, 0x65, 0x9e, 0xde, 0x64, 0xe8, 0x39, 0x00,
+	0x93, 0x46, 0x90, 0x92, 0x84, 0x3a, 0x86, 0x6b, 0x78, 0x16, 0xb6, 0x14, 0xf2, 0x9a, 0x24, 0x14,
+	0x39, 0xb0, 0xa3, 0x8c, 0xb9, 0xef, 0xf4, 0x5c, 0xc3, 0x33, 0x71, 0x65, 0x22, 0x1f, 0x6c, 0x1d,
+	0x98, 0x93, 0x82, 0x24, 0xdc, 0x31, 0x5d, 0xd3, 0x1b, 0x9d, 0x1d, 0x9d, 0x74, 0x8a, 0x29, 0xcb,
+	0xf8, 0x9e, 0xde, 0xbf, 0x21, 0xf1, 0x8a, 0x2e, 0x08, 0x2b, 0xf0, 0x48, 0x85, 0x2d, 0x54, 0x94,
+	0xcc, 0x1f, 0xd1, 0x98, 0x0a, 0x1a, 0x39, 0x7d, 0xd7, 0xf0, 0x76, 0x71, 0x65, 0xa2, 0xf7, 0x61,
+	0x14, 0x16, 0x94, 0x08, 0x1a, 0x08, 0x96, 0x50, 0x67, 0xe0, 0x1a, 0x5e, 0x1f, 0x83, 0x86, 0xae,
+	0x58, 0x42, 0x67, 0x3e, 0x4c, 0x5e, 0x31, 0x1a, 0x47, 0x4d, 0x2f, 0x0e, 0xec, 0xdc, 0xb0, 0x98,
+	0x46, 0x73, 0x5f, 0x35, 0x62, 0xe2, 0xca, 0x7c, 0xb8, 0x8d, 0xd9, 0x3f, 0x03, 0x98, 0x5c, 0x64,
+	0x71, 0x4c, 0x43, 0xc1, 0xb2, 0x54, 0xa5, 0x99, 0x40, 0xaf, 0xce, 0xd0, 0x9b, 0xfb, 0xe8, 0x2b,
+	0x18, 0xea, 0x01, 0xaa, 0xd8, 0xd1, 0xd9, 0x71, 0xb7, 0xc7, 0x72, 0xb8, 0x4d, 0x92, 0x4b, 0x05,
+	0xe0, 0x32, 0x68, 0xbd, 0x11, 0x73, 0xbd, 0x11, 0x34, 0x03, 0x3b, 0x27, 0x85, 0x60, 0xaa, 0x00,
+	0x9f, 0x3b, 0x7d, 0xd7, 0xf4, 0x4c, 0xdc, 0xc1, 0xd0, 0x47, 0x30, 0xa9, 0x6d, 0xb9, 0x18, 0xee,
+	0x0c, 0x5c, 0xd3, 0xb3, 0xf0, 0x1a, 0x8a, 0x5e, 0xc1, 0xf8, 0x46, 0x0e, 0x25, 0x50, 0xfd, 0x51,
+	0xee, 0x0c, 0xb7, 0xad, 0x45, 0x72, 0xe4, 0xa4, 0x3b, 0x3c, 0x6c, 0xdf, 0xd4, 0x36, 0xe5, 0xe8,
+	0x0c, 0x9e, 0xdc, 0xb1, 0x42, 0xac, 0x48, 0x1c, 0x84, 0x4b, 0x92, 0xa6, 0x34, 0x56, 0x04, 0xe1,
+	0xce, 0x8e, 0xba, 0xf6, 0x9d, 0xf2, 0xf0, 0x42, 0x9f, 0xe9, 0xbb, 0x3f, 0x85, 0x83, 0x7c, 0x79,
+	0xcf, 0x59, 0xb8, 0x11, 0xb4, 0xab, 0x82, 0x1e, 0x57, 0xa7, 0x9d, 0xa8, 0x6f, 0xe0, 0x59, 0xdd,
+	0x43, 0xa0, 0xa7, 0x12, 0xa9, 0x49, 0x71, 0x41, 0x92, 0x9c, 0x3b, 0x96, 0x6b, 0x7a, 0x7d, 0x7c,
+	0x58, 0xfb, 0x5c, 0x68, 0x97, 0xab, 0xda, 0x43, 0x52, 0x98, 0x2f, 0x49, 0x11, 0xf1, 0x20, 0x5d,
+	0x25, 0x0e, 0xb8, 0x86, 0x37, 0xc0, 0x96, 0x46, 0x5e, 0xaf, 0x12, 0x34, 0x87, 0x3d, 0x2e, 0x48,
+	0x21, 0x82, 0x3c, 0xe3, 0x2a, 0x03, 0x77, 0x46, 0x6a, 0x28, 0xee, 0x43, 0x5c, 0xf5, 0x89, 0x20,
+	0x8a, 0xaa, 0x13, 0x15, 0xb8, 0xa8, 0xe2, 0x10, 0x86, 0xfd, 0x30, 0x4b, 0x39, 0xe3, 0x82, 0xa6,
+	0xe1, 0x7d, 0x10, 0xd3, 0x3b, 0x1a, 0x3b, 0xb6, 0x6b, 0x78, 0x93, 0xb3, 0xe3, 0xad, 0xc9, 0x2e,
+	0x1a, 0xef, 0x1f, 0xa4, 0x33, 0x9e, 0x86, 0x6b, 0x08, 0xfa, 0x12, 0x06, 0x5c, 0x10, 0x41, 0x9d,
+	0xb1, 0xca, 0x33, 0xdb, 0xb2, 0xa9, 0x16, 0xb5, 0xa4, 0x27, 0xd6, 0x01, 0xe8, 0x08, 0xec, 0xa8,
+	0xc8, 0xf2, 0xbc, 0x9c, 0x97, 0x33, 0x51, 0xcc, 0x1a, 0x95, 0x98, 0x7a, 0x23, 0x7f, 0xf4, 0x60,
+	0xbc, 0xa8, 0x79, 0x24, 0xc9, 0xed, 0xc2, 0xa8, 0x45, 0xac, 0x92, 0xe5, 0x6d, 0x08, 0x7d, 0x08,
+	0xe3, 0x0e, 0xa9, 0x14, 0xeb, 0x2d, 0xdc, 0x05, 0xd1, 0xd7, 0xf0, 0xee, 0x5b, 0xd6, 0x56, 0xb2,
+	0xfc, 0xe9, 0x83, 0x5b, 0x43, 0x1f, 0xc0, 0x38, 0xac, 0xdb, 0x0a, 0x98, 0x7e, 0xfe, 0x26, 0xb6,
+	0x1b, 0x70, 0x1e, 0xa1, 0x2f, 0xaa, 0xd9, 0x0c, 0xd4, 0x6c, 0xb6, 0xb1, 0xb8, 0xee, 0xee, 0xad,
+	0xa3, 0x19, 0x6e, 0x8e, 0xe6, 0x4f, 0x03, 0xac, 0x6f, 0x63, 0x46, 0x78, 0x25, 0x83, 0x44, 0x1a,
+	0x1d, 0x19, 0x54, 0x88, 0xea, 0x76, 0xa3, 0xda, 0xde, 0x96, 0x6a, 0x8f, 0xc0, 0x6e, 0x0f, 0xa2,
+	0x9c, 0x41, 0xf9, 0xf8, 0xd5, 0xa5, 0xe8, 0xbc, 0x6a, 0xa8, 0xaf, 0x1a, 0x7a, 0xbe, 0xa5, 0x21,
+	0x55, 0x53, 0xbb, 0x99, 0xd9, 0xef, 0x3d, 0x98, 0x5e, 0xd2, 0xdb, 0x84, 0xa6, 0xa2, 0xd1, 0xba,
+	0x19, 0xb4, 0x2f, 0xaf, 0x16, 0xd9, 0xc1, 0xd6, 0x77, 0xdd, 0xdb, 0xdc, 0xf5, 0x33, 0xb0, 0x78,
+	0x99, 0xd9, 0x57, 0xf5, 0x9a, 0xb8, 0x01, 0xb4, 0x9e, 0x4a, 0x51, 0xf0, 0xcb, 0xed, 0x54, 0x66,
+	0x5b, 0x4f, 0x07, 0xdd, 0xdf, 0x82, 0x03, 0x3b, 0xd7, 0x2b, 0xa6, 0x62, 0x86, 0xfa, 0xa4, 0x34,
+	0xe5, 0x78, 0x68, 0x4a, 0xae, 0x63, 0xaa, 0xb5, 0xc9, 0xd9, 0x51, 0x7a, 0x3f, 0xd2, 0x98, 0x6a,
+	0x6c, 0x5d, 0x2a, 0x77, 0x37, 0x34, 0xff, 0x5f, 0xa3, 0xad, 0xd6, 0x3f, 0x52, 0x41, 0xfe, 0x77,
+	0xb5, 0x7e, 0x0f, 0xa0, 0x9e, 0x50, 0xa5, 0xd5, 0x2d, 0x04, 0x1d, 0xb7, 0x94, 0x3a, 0x10, 0xe4,
+	0xb6, 0x52, 0xea, 0xe6, 0xfd, 0x5c, 0x91, 0x5b, 0xbe, 0x21, 0xfa, 0xc3, 0x4d, 0xd1, 0x9f, 0xfd,
+	0x25, 0xbb, 0x2d, 0x68, 0x44, 0x53, 0xc1, 0x48, 0xac, 0xd6, 0x7e, 0x08, 0xbb, 0x2b, 0x4e, 0x8b,
+	0x16, 0x4b, 0x6b, 0x1b, 0xbd, 0x04, 0x44, 0xd3, 0xb0, 0xb8, 0xcf, 0x25, 0x03, 0x73, 0xc2, 0xf9,
+	0xcf, 0x59, 0x11, 0x95, 0xaf, 0x77, 0xbf, 0x3e, 0x59, 0x94, 0x07, 0xe8, 0x00, 0x86, 0x82, 0xa6,
+	0x24, 0x15, 0xaa, 0x49, 0x0b, 0x97, 0x16, 0x7a, 0x0a, 0xbb, 0x8c, 0x07, 0x7c, 0x95, 0xd3, 0xa2,
+	0xfa, 0x27, 0x33, 0x7e, 0x29, 0x4d, 0xf4, 0x31, 0xec, 0xf1, 0x25, 0x39, 0xfb, 0xec, 0xf3, 0x26,
+	0xfd, 0x40, 0xc5, 0x4e, 0x34, 0x5c, 0xe5, 0x7e, 0xf1, 0x9b, 0x01, 0x7b, 0x6b, 0xaa, 0x85, 0x9e,
+	0xc0, 0x7e, 0x03, 0x95, 0x7a, 0x30, 0x7d, 0x84, 0x0e, 0x00, 0xad, 0xc1, 0x2c, 0xbd, 0x9d, 0x1a,
+	0x5d, 0xdc, 0x97, 0x0f, 0x57, 0xe2, 0xbd, 0x6e, 0x1a, 0x5f, 0x3f, 0xe8, 0xa9, 0xd9, 0x75, 0xc7,
+	0x34, 0xbc, 0x0f, 0x63, 0x1a, 0x4d, 0xfb, 0x2f, 0x7e, 0x35, 0x60, 0xd2, 0xd5, 0x08, 0xf4, 0x18,
+	0xa6, 0x8b, 0x35, 0x5d, 0x9a, 0x3e, 0x92, 0x79, 0xbb, 0xa8, 0x2e, 0xa3, 0x0d, 0xb7, 0xaa, 0x68,
+	0xe7, 0x68, 0x8a, 0x68, 0x3b, 0xb7, 0x6a, 0x78, 0x03, 0xd0, 0xbc, 0x6a, 0x34, 0x05, 0x5b, 0x59,
+	0xcd, 0xd5, 0xfb, 0x30, 0x6e, 0x10, 0x7d, 0x6d, 0x05, 0xb5, 0xae, 0xac, 0xe2, 0xea, 0xeb, 0xbe,
+	0x3b, 0xff, 0xe9, 0x93, 0x5b, 0x26, 0x96, 0xab, 0x6b, 0xf9, 0xbb, 0x39, 0xd5, 0x34, 0x7f, 0xc9,
+	0xb2, 0xf2, 0xeb, 0x94, 0xa5, 0x42, 0x32, 0x23, 0x3e, 0x55, 0xcc, 0x3f, 0x95, 0xea, 0x92, 0x5f,
+	0x5f, 0x0f, 0x95, 0x75, 0xfe, 0xdf, 0x00, 0x10, 0x6d, 0x2f, 0x46, 0x47, 0x0a, 0x00, 0x00,
 }
@@ -215,7 +215,7 @@ func (SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
 }
 
-//--------------------QueryCoord grpc request and response proto------------------
+// --------------------QueryCoord grpc request and response proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -1169,7 +1169,7 @@ func (m *ShardLeadersList) GetNodeAddrs() []string {
 	return nil
 }
 
-//-----------------query node grpc request and response proto----------------
+// -----------------query node grpc request and response proto----------------
 type LoadMetaInfo struct {
 	LoadType             LoadType `protobuf:"varint,1,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
 	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1422,6 +1422,7 @@ type SegmentLoadInfo struct {
 	IndexInfos           []*FieldIndexInfo     `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
 	SegmentSize          int64                 `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
 	InsertChannel        string                `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	Sorted               bool                  `protobuf:"varint,14,opt,name=sorted,proto3" json:"sorted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1543,6 +1544,13 @@ func (m *SegmentLoadInfo) GetInsertChannel() string {
 	return ""
 }
 
+func (m *SegmentLoadInfo) GetSorted() bool {
+	if m != nil {
+		return m.Sorted
+	}
+	return false
+}
+
 type FieldIndexInfo struct {
 	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	// deprecated
@@ -2130,7 +2138,7 @@ func (m *ReplicaSegmentsInfo) GetVersions() []int64 {
 	return nil
 }
 
-//----------------request auto triggered by QueryCoord-----------------
+// ----------------request auto triggered by QueryCoord-----------------
 type HandoffSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
@@ -2819,7 +2827,7 @@ func (m *UnsubscribeChannelInfo) GetCollectionChannels() []*UnsubscribeChannels
 	return nil
 }
 
-//---- synchronize messages proto between QueryCoord and QueryNode -----
+// ---- synchronize messages proto between QueryCoord and QueryNode -----
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -3655,228 +3663,228 @@ func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69e
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
 	// 3540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x49, 0x6c, 0x1c, 0xd7,
-	0x95, 0xac, 0x5e, 0xc8, 0xee, 0xd7, 0x0b, 0x8b, 0x9f, 0x12, 0xd5, 0x6e, 0x6b, 0x73, 0xc9, 0x92,
-	0x39, 0x94, 0x4d, 0xca, 0x94, 0x6d, 0xc8, 0x33, 0x36, 0x66, 0x24, 0xd2, 0xa2, 0x39, 0x92, 0x68,
-	0x4e, 0xb5, 0xa4, 0x19, 0x08, 0xc6, 0xf4, 0x54, 0x77, 0x7d, 0x36, 0x0b, 0xaa, 0xa5, 0x55, 0x55,
-	0x4d, 0x89, 0x9e, 0x6b, 0x2e, 0x59, 0x81, 0xe4, 0x90, 0x53, 0x92, 0x53, 0x02, 0x24, 0x81, 0x0d,
-	0x24, 0x40, 0x02, 0xe4, 0x10, 0x04, 0x01, 0x72, 0x48, 0x4e, 0x41, 0x0e, 0x39, 0x06, 0xc8, 0x31,
-	0x87, 0xe4, 0x9a, 0x43, 0x6e, 0xc1, 0xdf, 0x6a, 0x2f, 0xb2, 0x44, 0x5a, 0x5e, 0x82, 0xdc, 0xba,
-	0xde, 0x5f, 0xde, 0xfb, 0x6f, 0x7f, 0xef, 0xff, 0x86, 0xb9, 0x47, 0x13, 0xec, 0xee, 0xf7, 0x87,
-	0x8e, 0xe3, 0xea, 0xcb, 0x63, 0xd7, 0xf1, 0x1d, 0x84, 0x2c, 0xc3, 0xdc, 0x9b, 0x78, 0xec, 0x6b,
-	0x99, 0x8e, 0x77, 0x9b, 0x43, 0xc7, 0xb2, 0x1c, 0x9b, 0xc1, 0xba, 0xcd, 0xe8, 0x8c, 0x6e, 0xdb,
-	0xb0, 0x7d, 0xec, 0xda, 0x9a, 0x29, 0x46, 0xbd, 0xe1, 0x2e, 0xb6, 0x34, 0xfe, 0x25, 0xeb, 0x9a,
-	0xaf, 0x45, 0xf7, 0x57, 0xbe, 0x20, 0xc1, 0x42, 0x6f, 0xd7, 0x79, 0xbc, 0xe6, 0x98, 0x26, 0x1e,
-	0xfa, 0x86, 0x63, 0x7b, 0x2a, 0x7e, 0x34, 0xc1, 0x9e, 0x8f, 0xae, 0x40, 0x65, 0xa0, 0x79, 0xb8,
-	0x23, 0x9d, 0x97, 0x16, 0x1b, 0xab, 0xa7, 0x97, 0x63, 0x94, 0x70, 0x12, 0xee, 0x78, 0xa3, 0x1b,
-	0x9a, 0x87, 0x55, 0x3a, 0x13, 0x21, 0xa8, 0xe8, 0x83, 0xcd, 0xf5, 0x4e, 0xe9, 0xbc, 0xb4, 0x58,
-	0x56, 0xe9, 0x6f, 0xf4, 0x22, 0xb4, 0x86, 0xc1, 0xde, 0x9b, 0xeb, 0x5e, 0xa7, 0x7c, 0xbe, 0xbc,
-	0x58, 0x56, 0xe3, 0x40, 0xe5, 0x8f, 0x12, 0x9c, 0x4a, 0x91, 0xe1, 0x8d, 0x1d, 0xdb, 0xc3, 0xe8,
-	0x2a, 0x4c, 0x7b, 0xbe, 0xe6, 0x4f, 0x3c, 0x4e, 0xc9, 0xf3, 0x99, 0x94, 0xf4, 0xe8, 0x14, 0x95,
-	0x4f, 0x4d, 0xa3, 0x2d, 0x65, 0xa0, 0x45, 0xaf, 0xc2, 0x09, 0xc3, 0xbe, 0x83, 0x2d, 0xc7, 0xdd,
-	0xef, 0x8f, 0xb1, 0x3b, 0xc4, 0xb6, 0xaf, 0x8d, 0xb0, 0xa0, 0x71, 0x5e, 0x8c, 0x6d, 0x87, 0x43,
-	0xe8, 0x0d, 0x38, 0xc5, 0xa4, 0xe4, 0x61, 0x77, 0xcf, 0x18, 0xe2, 0xbe, 0xb6, 0xa7, 0x19, 0xa6,
-	0x36, 0x30, 0x71, 0xa7, 0x72, 0xbe, 0xbc, 0x58, 0x53, 0x4f, 0xd2, 0xe1, 0x1e, 0x1b, 0xbd, 0x2e,
-	0x06, 0x95, 0xef, 0x49, 0x70, 0x92, 0x9c, 0x70, 0x5b, 0x73, 0x7d, 0xe3, 0x19, 0xf0, 0x59, 0x81,
-	0x66, 0xf4, 0x6c, 0x9d, 0x32, 0x1d, 0x8b, 0xc1, 0xc8, 0x9c, 0xb1, 0x40, 0x4f, 0x78, 0x52, 0xa1,
-	0xc7, 0x8c, 0xc1, 0x94, 0xef, 0x72, 0x85, 0x88, 0xd2, 0x79, 0x1c, 0x41, 0x24, 0x71, 0x96, 0xd2,
-	0x38, 0x8f, 0x20, 0x06, 0xe5, 0x4f, 0x12, 0x9c, 0xbc, 0xed, 0x68, 0x7a, 0xa8, 0x30, 0x9f, 0x3c,
-	0x3b, 0xdf, 0x86, 0x69, 0x66, 0x5d, 0x9d, 0x0a, 0xc5, 0x75, 0x31, 0x8e, 0x8b, 0x5b, 0x5e, 0x48,
-	0x61, 0x8f, 0x02, 0x54, 0xbe, 0x08, 0x5d, 0x84, 0xb6, 0x8b, 0xc7, 0xa6, 0x31, 0xd4, 0xfa, 0xf6,
-	0xc4, 0x1a, 0x60, 0xb7, 0x53, 0x3d, 0x2f, 0x2d, 0x56, 0xd5, 0x16, 0x87, 0x6e, 0x51, 0xa0, 0xf2,
-	0x2d, 0x09, 0x3a, 0x2a, 0x36, 0xb1, 0xe6, 0xe1, 0x4f, 0xf3, 0xb0, 0x0b, 0x30, 0x6d, 0x3b, 0x3a,
-	0xde, 0x5c, 0xa7, 0x87, 0x2d, 0xab, 0xfc, 0x4b, 0xf9, 0x9b, 0x04, 0x27, 0x36, 0xb0, 0x4f, 0xa4,
-	0x6e, 0x78, 0xbe, 0x31, 0x0c, 0xd4, 0xfa, 0x6d, 0x28, 0xbb, 0xf8, 0x11, 0xa7, 0xec, 0x72, 0x9c,
-	0xb2, 0xc0, 0x49, 0x65, 0xad, 0x54, 0xc9, 0x3a, 0xf4, 0x02, 0x34, 0x75, 0xcb, 0xec, 0x0f, 0x77,
-	0x35, 0xdb, 0xc6, 0x26, 0xd3, 0x9b, 0xba, 0xda, 0xd0, 0x2d, 0x73, 0x8d, 0x83, 0xd0, 0x59, 0x00,
-	0x0f, 0x8f, 0x2c, 0x6c, 0xfb, 0xa1, 0x5f, 0x89, 0x40, 0xd0, 0x12, 0xcc, 0xed, 0xb8, 0x8e, 0xd5,
-	0xf7, 0x76, 0x35, 0x57, 0xef, 0x9b, 0x58, 0xd3, 0xb1, 0x4b, 0xa9, 0xaf, 0xa9, 0xb3, 0x64, 0xa0,
-	0x47, 0xe0, 0xb7, 0x29, 0x18, 0x5d, 0x85, 0xaa, 0x37, 0x74, 0xc6, 0x98, 0xca, 0xa0, 0xbd, 0x7a,
-	0x66, 0x39, 0xed, 0x77, 0x97, 0xd7, 0x35, 0x5f, 0xeb, 0x91, 0x49, 0x2a, 0x9b, 0xab, 0x7c, 0xb9,
-	0xc4, 0x94, 0xf0, 0x33, 0x6e, 0xd3, 0x11, 0x45, 0xad, 0x7e, 0x3c, 0x8a, 0x3a, 0x9d, 0xa5, 0xa8,
-	0xbf, 0x0c, 0x15, 0xf5, 0xb3, 0xce, 0x90, 0x50, 0x99, 0xab, 0x31, 0x65, 0xfe, 0x81, 0x04, 0xcf,
-	0x6d, 0x60, 0x3f, 0x20, 0x9f, 0xe8, 0x26, 0xfe, 0x8c, 0x3a, 0xea, 0x8f, 0x24, 0xe8, 0x66, 0xd1,
-	0x7a, 0x1c, 0x67, 0xfd, 0x00, 0x16, 0x02, 0x1c, 0x7d, 0x1d, 0x7b, 0x43, 0xd7, 0x18, 0x53, 0x31,
-	0x52, 0xf3, 0x6b, 0xac, 0x5e, 0xc8, 0x32, 0x8b, 0x24, 0x05, 0x27, 0x83, 0x2d, 0xd6, 0x23, 0x3b,
-	0x28, 0x5f, 0x95, 0xe0, 0x24, 0x31, 0x77, 0x6e, 0x9f, 0xf6, 0x8e, 0x73, 0x74, 0xbe, 0xc6, 0x2d,
-	0xbf, 0x94, 0xb2, 0xfc, 0x02, 0x3c, 0xa6, 0x99, 0x4f, 0x92, 0x9e, 0xe3, 0xf0, 0xee, 0x75, 0xa8,
-	0x1a, 0xf6, 0x8e, 0x23, 0x58, 0x75, 0x2e, 0x8b, 0x55, 0x51, 0x64, 0x6c, 0xb6, 0x62, 0x33, 0x2a,
-	0x42, 0x57, 0x74, 0x0c, 0x75, 0x4b, 0x1e, 0xbb, 0x94, 0x71, 0xec, 0xaf, 0x48, 0x70, 0x2a, 0x85,
-	0xf0, 0x38, 0xe7, 0x7e, 0x0b, 0xa6, 0xa9, 0x83, 0x15, 0x07, 0x7f, 0x31, 0xf3, 0xe0, 0x11, 0x74,
-	0xb7, 0x0d, 0xcf, 0x57, 0xf9, 0x1a, 0xc5, 0x01, 0x39, 0x39, 0x46, 0x5c, 0x3f, 0x77, 0xfb, 0x7d,
-	0x5b, 0xb3, 0x18, 0x03, 0xea, 0x6a, 0x83, 0xc3, 0xb6, 0x34, 0x0b, 0xa3, 0xe7, 0xa0, 0x46, 0x4c,
-	0xb6, 0x6f, 0xe8, 0x42, 0xfc, 0x33, 0xd4, 0x84, 0x75, 0x0f, 0x9d, 0x01, 0xa0, 0x43, 0x9a, 0xae,
-	0xbb, 0x2c, 0x2a, 0xd4, 0xd5, 0x3a, 0x81, 0x5c, 0x27, 0x00, 0xe5, 0xeb, 0x12, 0x34, 0x89, 0xcf,
-	0xbe, 0x83, 0x7d, 0x8d, 0xc8, 0x01, 0xbd, 0x09, 0x75, 0xd3, 0xd1, 0xf4, 0xbe, 0xbf, 0x3f, 0x66,
-	0xa8, 0xda, 0x49, 0x5e, 0xb3, 0x23, 0x90, 0x45, 0x77, 0xf7, 0xc7, 0x58, 0xad, 0x99, 0xfc, 0x57,
-	0x11, 0x7e, 0xa7, 0x4c, 0xb9, 0x9c, 0x61, 0xca, 0x1f, 0x56, 0x61, 0xe1, 0xbf, 0x35, 0x7f, 0xb8,
-	0xbb, 0x6e, 0x89, 0xe0, 0x76, 0x74, 0x25, 0x08, 0x7d, 0x5b, 0x29, 0xea, 0xdb, 0x3e, 0x36, 0xdf,
-	0x19, 0xe8, 0x79, 0x35, 0x4b, 0xcf, 0x49, 0x81, 0xb1, 0x7c, 0x9f, 0x8b, 0x2a, 0xa2, 0xe7, 0x91,
-	0x18, 0x34, 0x7d, 0x94, 0x18, 0xb4, 0x06, 0x2d, 0xfc, 0x64, 0x68, 0x4e, 0x88, 0xcc, 0x29, 0xf6,
-	0x19, 0x8a, 0xfd, 0x6c, 0x06, 0xf6, 0xa8, 0x91, 0x35, 0xf9, 0xa2, 0x4d, 0x4e, 0x03, 0x13, 0xb5,
-	0x85, 0x7d, 0xad, 0x53, 0xa3, 0x64, 0x9c, 0xcf, 0x13, 0xb5, 0xd0, 0x0f, 0x26, 0x6e, 0xf2, 0x85,
-	0x4e, 0x43, 0x9d, 0x47, 0xbc, 0xcd, 0xf5, 0x4e, 0x9d, 0xb2, 0x2f, 0x04, 0x20, 0x0d, 0x5a, 0xdc,
-	0x03, 0x71, 0x0a, 0x81, 0x52, 0xf8, 0x56, 0x16, 0x82, 0x6c, 0x61, 0x47, 0x29, 0xf7, 0xde, 0xb1,
-	0x7d, 0x77, 0x5f, 0x6d, 0x7a, 0x11, 0x10, 0x29, 0x6a, 0x9c, 0x9d, 0x1d, 0xd3, 0xb0, 0xf1, 0x16,
-	0x93, 0x70, 0x83, 0x12, 0x11, 0x07, 0x76, 0xfb, 0x30, 0x97, 0xda, 0x08, 0xc9, 0x50, 0x7e, 0x88,
-	0xf7, 0xa9, 0x1a, 0x95, 0x55, 0xf2, 0x13, 0xbd, 0x06, 0xd5, 0x3d, 0xcd, 0x9c, 0x60, 0xaa, 0x26,
-	0x87, 0x73, 0x92, 0x4d, 0xfe, 0xd7, 0xd2, 0x35, 0x49, 0xf9, 0xbe, 0x04, 0x27, 0xef, 0xd9, 0xde,
-	0x64, 0x10, 0x9c, 0xe0, 0xd3, 0xd1, 0xd6, 0xa4, 0x9f, 0xa8, 0xa4, 0xfc, 0x84, 0xf2, 0x8b, 0x0a,
-	0xcc, 0xf2, 0x53, 0x10, 0xa1, 0x52, 0x83, 0x3f, 0x0d, 0xf5, 0x20, 0x54, 0x70, 0x86, 0x84, 0x00,
-	0x74, 0x1e, 0x1a, 0x11, 0x75, 0xe7, 0x54, 0x45, 0x41, 0x85, 0x48, 0x13, 0x81, 0xbf, 0x12, 0x09,
-	0xfc, 0x67, 0x00, 0x76, 0xcc, 0x89, 0xb7, 0xdb, 0xf7, 0x0d, 0x0b, 0xf3, 0xc4, 0xa3, 0x4e, 0x21,
-	0x77, 0x0d, 0x0b, 0xa3, 0xeb, 0xd0, 0x1c, 0x18, 0xb6, 0xe9, 0x8c, 0xfa, 0x63, 0xcd, 0xdf, 0xf5,
-	0x3a, 0xd3, 0xb9, 0x0a, 0x7e, 0xd3, 0xc0, 0xa6, 0x7e, 0x83, 0xce, 0x55, 0x1b, 0x6c, 0xcd, 0x36,
-	0x59, 0x82, 0xce, 0x42, 0xc3, 0x9e, 0x58, 0x7d, 0x67, 0xa7, 0xef, 0x3a, 0x8f, 0x89, 0x89, 0x50,
-	0x14, 0xf6, 0xc4, 0x7a, 0x6f, 0x47, 0x75, 0x1e, 0x13, 0x57, 0x5d, 0x27, 0x4e, 0xdb, 0x33, 0x9d,
-	0x91, 0xd7, 0xa9, 0x15, 0xda, 0x3f, 0x5c, 0x40, 0x56, 0xeb, 0xd8, 0xf4, 0x35, 0xba, 0xba, 0x5e,
-	0x6c, 0x75, 0xb0, 0x00, 0x5d, 0x82, 0xf6, 0xd0, 0xb1, 0xc6, 0x1a, 0xe5, 0xd0, 0x4d, 0xd7, 0xb1,
-	0xa8, 0x7d, 0x94, 0xd5, 0x04, 0x14, 0xad, 0x41, 0xc3, 0xb0, 0x75, 0xfc, 0x84, 0x1b, 0x51, 0x83,
-	0xe2, 0x51, 0xb2, 0x8c, 0x88, 0x22, 0xda, 0x24, 0x73, 0xa9, 0x82, 0x82, 0x21, 0x7e, 0x7a, 0x44,
-	0x33, 0x84, 0x2d, 0x7a, 0xc6, 0x07, 0xb8, 0xd3, 0x64, 0x52, 0xe4, 0xb0, 0x9e, 0xf1, 0x01, 0x26,
-	0x49, 0xad, 0x61, 0x7b, 0xd8, 0xf5, 0x45, 0x89, 0xd1, 0x69, 0x51, 0xf5, 0x69, 0x31, 0x28, 0x57,
-	0x6c, 0xe5, 0xf7, 0x25, 0x68, 0xc7, 0x11, 0xa1, 0x0e, 0xcc, 0xec, 0x50, 0x88, 0xd0, 0x1e, 0xf1,
-	0x49, 0xd0, 0x62, 0x9b, 0x54, 0xfb, 0x7d, 0x4a, 0x0b, 0x55, 0x9e, 0x9a, 0xda, 0x60, 0x30, 0xba,
-	0x01, 0x51, 0x02, 0x76, 0x3c, 0xaa, 0xb1, 0x65, 0x8a, 0xb2, 0x4e, 0x21, 0x34, 0xae, 0x75, 0x60,
-	0x86, 0x1d, 0x43, 0xa8, 0x8e, 0xf8, 0x24, 0x23, 0x83, 0x89, 0x41, 0xb1, 0x32, 0xd5, 0x11, 0x9f,
-	0x68, 0x1d, 0x9a, 0x6c, 0xcb, 0xb1, 0xe6, 0x6a, 0x96, 0x50, 0x9c, 0x17, 0x32, 0x8d, 0xef, 0x16,
-	0xde, 0xbf, 0x4f, 0xec, 0x78, 0x5b, 0x33, 0x5c, 0x95, 0x31, 0x7a, 0x9b, 0xae, 0x42, 0x8b, 0x20,
-	0xb3, 0x5d, 0x76, 0x0c, 0x13, 0x73, 0x15, 0x9c, 0xa1, 0xc1, 0xb3, 0x4d, 0xe1, 0x37, 0x0d, 0x13,
-	0x33, 0x2d, 0x0b, 0x8e, 0x40, 0x59, 0x5b, 0x63, 0x4a, 0x46, 0x21, 0x94, 0xb1, 0x17, 0xa0, 0xc5,
-	0x86, 0xf7, 0xb0, 0xeb, 0x19, 0x8e, 0xcd, 0x3d, 0x25, 0xa3, 0xf1, 0x3e, 0x83, 0x29, 0xdf, 0xa8,
-	0xc0, 0x3c, 0x31, 0x48, 0x6e, 0x9b, 0xc7, 0x08, 0x77, 0x67, 0x00, 0x74, 0xcf, 0xef, 0xc7, 0x9c,
-	0x48, 0x5d, 0xf7, 0x7c, 0xe6, 0x0c, 0xd1, 0x9b, 0x22, 0x5a, 0x95, 0xf3, 0x13, 0xd8, 0x84, 0x83,
-	0x48, 0x47, 0xac, 0x23, 0x95, 0xf7, 0x17, 0xa0, 0xe5, 0x39, 0x13, 0x77, 0x88, 0xfb, 0xb1, 0x52,
-	0xa3, 0xc9, 0x80, 0x5b, 0xd9, 0x6e, 0x6e, 0x3a, 0xb3, 0xcd, 0x10, 0x89, 0x5a, 0x33, 0xc7, 0x8b,
-	0x5a, 0xb5, 0x64, 0xd4, 0xba, 0x05, 0xb3, 0xd4, 0x46, 0xfb, 0x63, 0xc7, 0x63, 0x15, 0x1b, 0x37,
-	0x6d, 0x25, 0xa7, 0x62, 0xbf, 0xe3, 0x8d, 0xb6, 0xf9, 0x54, 0xb5, 0x4d, 0x97, 0x8a, 0x4f, 0x8f,
-	0xe8, 0xa8, 0x10, 0x3a, 0x30, 0x1d, 0xe5, 0x9f, 0x84, 0x19, 0x36, 0xc6, 0x7a, 0xdf, 0x77, 0x35,
-	0xdb, 0xdb, 0xc1, 0x2e, 0x8d, 0x5c, 0x35, 0xb5, 0x49, 0x80, 0x77, 0x39, 0x4c, 0xf9, 0x6d, 0x09,
-	0x16, 0x78, 0x01, 0x79, 0x7c, 0xbd, 0xc8, 0x0b, 0x2c, 0xc2, 0x33, 0x97, 0x0f, 0x28, 0xc9, 0x2a,
-	0x05, 0x52, 0xa3, 0x6a, 0x46, 0x6a, 0x14, 0x2f, 0x4b, 0xa6, 0x53, 0x65, 0x49, 0xd0, 0x64, 0x98,
-	0x29, 0xde, 0x64, 0x40, 0x27, 0xa0, 0x4a, 0x73, 0x65, 0x2a, 0xbb, 0xba, 0xca, 0x3e, 0x8a, 0x31,
-	0xf4, 0xcf, 0x12, 0xb4, 0x7a, 0x58, 0x73, 0x87, 0xbb, 0x82, 0x8f, 0x6f, 0x44, 0x9b, 0x32, 0x2f,
-	0xe6, 0x88, 0x38, 0xb6, 0xe4, 0xf3, 0xd3, 0x8d, 0xf9, 0x8b, 0x04, 0xcd, 0xff, 0x22, 0x43, 0xe2,
-	0xb0, 0xd7, 0xa2, 0x87, 0xbd, 0x94, 0x73, 0x58, 0x15, 0xfb, 0xae, 0x81, 0xf7, 0xf0, 0xe7, 0xee,
-	0xb8, 0xbf, 0x96, 0xa0, 0xdb, 0xdb, 0xb7, 0x87, 0x2a, 0xb3, 0xe5, 0xe3, 0x5b, 0xcc, 0x05, 0x68,
-	0xed, 0xc5, 0xf2, 0xa9, 0x12, 0x55, 0xb8, 0xe6, 0x5e, 0xb4, 0xf0, 0x52, 0x41, 0x16, 0xbd, 0x20,
-	0x7e, 0x58, 0xe1, 0x5a, 0x5f, 0xca, 0xa2, 0x3a, 0x41, 0x1c, 0x75, 0x4d, 0xb3, 0x6e, 0x1c, 0xa8,
-	0x7c, 0x4d, 0x82, 0xf9, 0x8c, 0x89, 0xe8, 0x14, 0xcc, 0xf0, 0x22, 0x8f, 0x07, 0x5a, 0x66, 0xc3,
-	0x3a, 0x11, 0x4f, 0xd8, 0xa6, 0x30, 0xf4, 0x74, 0x92, 0xa6, 0xa3, 0x73, 0xd0, 0x08, 0xb2, 0x71,
-	0x3d, 0x25, 0x1f, 0xdd, 0x43, 0x5d, 0xa8, 0x71, 0xe7, 0x24, 0xca, 0x9c, 0xe0, 0x5b, 0xf9, 0xb9,
-	0x04, 0x0b, 0xef, 0x6a, 0xb6, 0xee, 0xec, 0xec, 0x1c, 0x9f, 0xad, 0x6b, 0x10, 0x4b, 0xe2, 0x8b,
-	0xb6, 0x07, 0xe2, 0x99, 0xff, 0x65, 0x98, 0x73, 0x99, 0x67, 0xd4, 0xe3, 0x7c, 0x2f, 0xab, 0xb2,
-	0x18, 0x08, 0xf8, 0xf9, 0x61, 0x09, 0x10, 0x09, 0x06, 0x37, 0x34, 0x53, 0xb3, 0x87, 0xf8, 0xe8,
-	0xa4, 0x5f, 0x84, 0x76, 0x2c, 0x84, 0x05, 0xb7, 0x28, 0xd1, 0x18, 0xe6, 0xa1, 0x5b, 0xd0, 0x1e,
-	0x30, 0x54, 0x7d, 0x17, 0x6b, 0x9e, 0x63, 0x53, 0xe7, 0xda, 0xce, 0xee, 0x04, 0xdc, 0x75, 0x8d,
-	0xd1, 0x08, 0xbb, 0x6b, 0x8e, 0xad, 0xb3, 0x20, 0xd2, 0x1a, 0x08, 0x32, 0xc9, 0x52, 0x22, 0xb8,
-	0x30, 0x9e, 0x0b, 0xd1, 0x40, 0x10, 0xd0, 0x29, 0x2b, 0x3c, 0xac, 0x99, 0x21, 0x23, 0x42, 0x6f,
-	0x2c, 0xb3, 0x81, 0x5e, 0x7e, 0x23, 0x28, 0x23, 0xbe, 0x2a, 0x3f, 0x91, 0x00, 0x05, 0x95, 0x0c,
-	0xad, 0xcc, 0xa8, 0xf6, 0x25, 0x97, 0x4a, 0x19, 0x41, 0xe1, 0x34, 0xd4, 0x75, 0xb1, 0x92, 0x9b,
-	0x4b, 0x08, 0xa0, 0x3e, 0x9a, 0x12, 0xdd, 0x27, 0xc1, 0x18, 0xeb, 0xa2, 0x52, 0x60, 0xc0, 0xdb,
-	0x14, 0x16, 0x0f, 0xcf, 0x95, 0x64, 0x78, 0x8e, 0xf6, 0x39, 0xaa, 0xb1, 0x3e, 0x87, 0xf2, 0x51,
-	0x09, 0x64, 0xea, 0xee, 0xd6, 0xc2, 0x62, 0xbb, 0x10, 0xd1, 0x17, 0xa0, 0xc5, 0xef, 0x19, 0x63,
-	0x84, 0x37, 0x1f, 0x45, 0x36, 0x43, 0x57, 0xe0, 0x04, 0x9b, 0xe4, 0x62, 0x6f, 0x62, 0x86, 0x49,
-	0x32, 0xcb, 0x58, 0xd1, 0x23, 0xe6, 0x67, 0xc9, 0x90, 0x58, 0x71, 0x0f, 0x16, 0x46, 0xa6, 0x33,
-	0xd0, 0xcc, 0x7e, 0x5c, 0x3c, 0x4c, 0x86, 0x05, 0x34, 0xfe, 0x04, 0x5b, 0xde, 0x8b, 0xca, 0xd0,
-	0x43, 0x1b, 0xa4, 0xac, 0xc6, 0x0f, 0x83, 0xfc, 0x84, 0xb7, 0xb0, 0x8b, 0xa4, 0x27, 0x4d, 0xb2,
-	0x50, 0x7c, 0x29, 0xdf, 0x91, 0x60, 0x36, 0xd1, 0xaa, 0x4c, 0x16, 0x7b, 0x52, 0xba, 0xd8, 0xbb,
-	0x06, 0x55, 0x52, 0x01, 0x31, 0x67, 0xd8, 0xce, 0x2e, 0x44, 0xe2, 0xbb, 0xaa, 0x6c, 0x01, 0x5a,
-	0x81, 0xf9, 0x8c, 0x4b, 0x2d, 0xae, 0x03, 0x28, 0x7d, 0xa7, 0xa5, 0xfc, 0xa1, 0x02, 0x8d, 0x08,
-	0x3f, 0x0e, 0xa9, 0x53, 0x8b, 0xf4, 0x9e, 0x12, 0xc7, 0x2b, 0xa7, 0x8f, 0x97, 0x73, 0xab, 0x43,
-	0xf4, 0xce, 0xc2, 0x16, 0xcb, 0xf0, 0x79, 0xb9, 0x61, 0x61, 0x8b, 0xe6, 0xf7, 0x44, 0x25, 0x27,
-	0x16, 0xab, 0x30, 0x99, 0x39, 0xcd, 0xd8, 0x13, 0x8b, 0xd6, 0x97, 0xf1, 0xe2, 0x66, 0xe6, 0x80,
-	0xe2, 0xa6, 0x16, 0x2f, 0x6e, 0x62, 0x76, 0x54, 0x4f, 0xda, 0x51, 0xd1, 0xd2, 0xf1, 0x0a, 0xcc,
-	0x0f, 0x5d, 0xac, 0xf9, 0x58, 0xbf, 0xb1, 0xbf, 0x16, 0x0c, 0xf1, 0xcc, 0x28, 0x6b, 0x08, 0xdd,
-	0x0c, 0x7b, 0x36, 0x4c, 0xca, 0x4d, 0x2a, 0xe5, 0xec, 0xda, 0x89, 0xcb, 0x86, 0x09, 0x59, 0xb8,
-	0x67, 0xfa, 0x95, 0x2c, 0x5a, 0x5b, 0x47, 0x2a, 0x5a, 0xcf, 0x41, 0x43, 0x84, 0x56, 0x62, 0xee,
-	0x6d, 0xe6, 0xf9, 0x84, 0x2f, 0xd0, 0xbd, 0x98, 0x33, 0x98, 0x8d, 0x37, 0x3d, 0x93, 0x95, 0xa7,
-	0x9c, 0xaa, 0x3c, 0x95, 0xdf, 0x95, 0xa1, 0x1d, 0x16, 0x2b, 0x85, 0xbd, 0x45, 0x91, 0xfb, 0xdb,
-	0x2d, 0x90, 0xc3, 0x78, 0x4c, 0x19, 0x79, 0x60, 0xbd, 0x95, 0xbc, 0x30, 0x98, 0x1d, 0x27, 0xcc,
-	0x32, 0xd6, 0x92, 0xad, 0x3c, 0x55, 0x4b, 0xf6, 0x98, 0x57, 0x5d, 0x57, 0xe1, 0x64, 0x10, 0x67,
-	0x63, 0xc7, 0x66, 0xc9, 0xfc, 0x09, 0x31, 0xb8, 0x1d, 0x3d, 0x7e, 0x8e, 0xa5, 0xcf, 0xe4, 0x59,
-	0x7a, 0x52, 0xd2, 0xb5, 0x94, 0xa4, 0xd3, 0x37, 0x6e, 0xf5, 0xac, 0x1b, 0xb7, 0x7b, 0x30, 0x4f,
-	0xfb, 0x70, 0xde, 0xd0, 0x35, 0x06, 0x38, 0x48, 0x4d, 0x8b, 0x88, 0xb5, 0x0b, 0xb5, 0x44, 0x76,
-	0x1b, 0x7c, 0x2b, 0x5f, 0x92, 0x60, 0x21, 0xbd, 0x2f, 0xd5, 0x98, 0xd0, 0x5f, 0x48, 0x31, 0x7f,
-	0xf1, 0x3f, 0x30, 0x1f, 0x6e, 0x1f, 0xcf, 0x9b, 0x73, 0x32, 0xc3, 0x0c, 0xc2, 0x55, 0x14, 0xee,
-	0x21, 0x60, 0xca, 0x5f, 0xa5, 0xa0, 0x9d, 0x49, 0x60, 0x23, 0xda, 0xca, 0x25, 0x31, 0xcc, 0xb1,
-	0x4d, 0xc3, 0x0e, 0x8a, 0x6b, 0x7e, 0x46, 0x06, 0xe4, 0xc5, 0xf5, 0xbb, 0x30, 0xcb, 0x27, 0x05,
-	0xa1, 0xa8, 0x60, 0xf2, 0xd5, 0x66, 0xeb, 0x82, 0x20, 0x74, 0x11, 0xda, 0xbc, 0xc7, 0x2a, 0xf0,
-	0x95, 0x33, 0x3a, 0xaf, 0xe8, 0x3f, 0x41, 0x16, 0xd3, 0x9e, 0x36, 0xf8, 0xcd, 0xf2, 0x85, 0x41,
-	0x12, 0xf7, 0x45, 0x09, 0x3a, 0xf1, 0x50, 0x18, 0x39, 0xfe, 0xd3, 0xa7, 0x72, 0xff, 0x16, 0xbf,
-	0x9d, 0xba, 0x78, 0x00, 0x3d, 0x21, 0x1e, 0x71, 0x47, 0xb5, 0x45, 0x6f, 0x1a, 0x49, 0x05, 0xb2,
-	0x6e, 0x78, 0xbe, 0x6b, 0x0c, 0x26, 0xc7, 0x7a, 0x83, 0xa0, 0xfc, 0xb4, 0x04, 0xcf, 0x67, 0x6e,
-	0x78, 0x9c, 0x7b, 0xa8, 0xbc, 0x82, 0xff, 0x06, 0xd4, 0x12, 0x95, 0xca, 0xa5, 0x03, 0x0e, 0xcf,
-	0x1b, 0x54, 0xac, 0x87, 0x22, 0xd6, 0x91, 0x3d, 0x02, 0x9d, 0xae, 0xe4, 0xef, 0xc1, 0x95, 0x36,
-	0xb6, 0x87, 0x58, 0x87, 0xae, 0x43, 0x93, 0x55, 0x81, 0xfd, 0x3d, 0x03, 0x3f, 0x16, 0xd7, 0x27,
-	0x67, 0x33, 0xfd, 0x1a, 0x9d, 0x77, 0xdf, 0xc0, 0x8f, 0xd5, 0x86, 0x19, 0xfc, 0xf6, 0x94, 0x1f,
-	0x96, 0x00, 0xc2, 0x31, 0x52, 0x82, 0x86, 0x06, 0xc3, 0x2d, 0x20, 0x02, 0x21, 0xf1, 0x36, 0x9e,
-	0xe2, 0x89, 0x4f, 0xa4, 0x86, 0xfd, 0x51, 0xdd, 0xf0, 0x7c, 0xce, 0x97, 0x95, 0x83, 0x69, 0x11,
-	0x2c, 0x22, 0x22, 0x63, 0xb7, 0x13, 0xa2, 0xc4, 0x22, 0x10, 0xf4, 0x0a, 0xa0, 0x91, 0xeb, 0x3c,
-	0x36, 0xec, 0x51, 0x34, 0x31, 0x67, 0xf9, 0xfb, 0x1c, 0x1f, 0x09, 0x33, 0xf3, 0x6e, 0x1f, 0xe4,
-	0xe4, 0x7e, 0x19, 0x97, 0x14, 0xaf, 0xc7, 0x2f, 0x29, 0x0e, 0x32, 0x23, 0xb2, 0x4d, 0xf4, 0x96,
-	0xe2, 0xdf, 0x83, 0x6c, 0x8a, 0x92, 0x97, 0xe7, 0xb9, 0x22, 0x3d, 0xab, 0x52, 0xac, 0x67, 0xa5,
-	0x7c, 0x53, 0x02, 0x94, 0xd6, 0x0a, 0xd4, 0x86, 0x52, 0xb0, 0x49, 0x69, 0x73, 0x3d, 0x21, 0x85,
-	0x52, 0x4a, 0x0a, 0xa7, 0xa1, 0x1e, 0x44, 0x12, 0xee, 0x36, 0x42, 0x40, 0x54, 0x46, 0x95, 0xb8,
-	0x8c, 0x22, 0x84, 0x55, 0xe3, 0x84, 0xed, 0x02, 0x4a, 0x6b, 0x5a, 0x74, 0x27, 0x29, 0xbe, 0xd3,
-	0x61, 0x14, 0x46, 0x30, 0x95, 0xe3, 0x98, 0x7e, 0x23, 0x01, 0x0a, 0x63, 0x65, 0x70, 0x83, 0x52,
-	0x24, 0xc0, 0xac, 0xc0, 0x7c, 0x3a, 0x92, 0x8a, 0xf4, 0x01, 0xa5, 0xe2, 0x68, 0x56, 0xcc, 0x2b,
-	0x67, 0xc4, 0x3c, 0xf4, 0x46, 0xe0, 0x1b, 0x58, 0x62, 0x70, 0x36, 0x2f, 0x31, 0x88, 0xbb, 0x07,
-	0xe5, 0x67, 0x12, 0xcc, 0x05, 0xd8, 0x9e, 0xea, 0x24, 0x87, 0xdf, 0x08, 0x3d, 0x63, 0xd2, 0x7b,
-	0x30, 0xc3, 0xdb, 0x23, 0x29, 0xe5, 0x2b, 0x52, 0x05, 0x9c, 0x80, 0x2a, 0xd1, 0x75, 0xd1, 0x2f,
-	0x60, 0x1f, 0xca, 0x8f, 0x24, 0x80, 0xde, 0xbe, 0x3d, 0xbc, 0xce, 0x74, 0xe0, 0x0a, 0x54, 0x0e,
-	0xbb, 0x00, 0x27, 0xb3, 0x69, 0xb6, 0x45, 0x67, 0x16, 0x60, 0x4b, 0xac, 0x80, 0x29, 0x27, 0x0b,
-	0x98, 0xbc, 0xd2, 0x23, 0x5f, 0xef, 0x7f, 0x25, 0xc1, 0x29, 0x42, 0xc4, 0xc7, 0x12, 0x84, 0x0a,
-	0xb1, 0x2e, 0x62, 0x53, 0xe5, 0xb8, 0x4d, 0x5d, 0x83, 0x19, 0x56, 0x43, 0x88, 0x80, 0x70, 0x36,
-	0x8f, 0x65, 0x8c, 0xc1, 0xaa, 0x98, 0xbe, 0xf4, 0x1f, 0x50, 0x0f, 0x7a, 0x79, 0xa8, 0x01, 0x33,
-	0xf7, 0xec, 0x5b, 0xb6, 0xf3, 0xd8, 0x96, 0xa7, 0xd0, 0x0c, 0x94, 0xaf, 0x9b, 0xa6, 0x2c, 0xa1,
-	0x16, 0xd4, 0x7b, 0xbe, 0x8b, 0x35, 0xcb, 0xb0, 0x47, 0x72, 0x09, 0xb5, 0x01, 0xde, 0x35, 0x3c,
-	0xdf, 0x71, 0x8d, 0xa1, 0x66, 0xca, 0xe5, 0xa5, 0x0f, 0xa0, 0x1d, 0x4f, 0xa1, 0x51, 0x13, 0x6a,
-	0x5b, 0x8e, 0xff, 0xce, 0x13, 0xc3, 0xf3, 0xe5, 0x29, 0x32, 0x7f, 0xcb, 0xf1, 0xb7, 0x5d, 0xec,
-	0x61, 0xdb, 0x97, 0x25, 0x04, 0x30, 0xfd, 0x9e, 0xbd, 0x6e, 0x78, 0x0f, 0xe5, 0x12, 0x9a, 0xe7,
-	0x45, 0xb0, 0x66, 0x6e, 0xf2, 0xbc, 0x54, 0x2e, 0x93, 0xe5, 0xc1, 0x57, 0x05, 0xc9, 0xd0, 0x0c,
-	0xa6, 0x6c, 0x6c, 0xdf, 0x93, 0xab, 0xa8, 0x0e, 0x55, 0xf6, 0x73, 0x7a, 0x49, 0x07, 0x39, 0xd9,
-	0xc1, 0x21, 0x7b, 0xb2, 0x43, 0x04, 0x20, 0x79, 0x8a, 0x9c, 0x8c, 0xb7, 0xd0, 0x64, 0x09, 0xcd,
-	0x42, 0x23, 0xd2, 0x90, 0x92, 0x4b, 0x04, 0xb0, 0xe1, 0x8e, 0x87, 0x5c, 0x7a, 0x8c, 0x04, 0x92,
-	0x44, 0xad, 0x13, 0x4e, 0x54, 0x96, 0x6e, 0x40, 0x4d, 0xe4, 0xf6, 0x64, 0x2a, 0x67, 0x11, 0xf9,
-	0x94, 0xa7, 0xd0, 0x1c, 0xb4, 0x62, 0x8f, 0xee, 0x64, 0x09, 0x21, 0x68, 0xc7, 0x1f, 0x83, 0xca,
-	0xa5, 0xa5, 0x55, 0x80, 0xd0, 0x96, 0x08, 0x39, 0x9b, 0xf6, 0x9e, 0x66, 0x1a, 0x3a, 0xa3, 0x8d,
-	0x0c, 0x11, 0xee, 0x52, 0xee, 0xb0, 0x56, 0x8c, 0x5c, 0x5a, 0x3a, 0x07, 0x35, 0xa1, 0xe5, 0x04,
-	0xae, 0x62, 0xcb, 0xd9, 0xc3, 0x4c, 0x32, 0x3d, 0xec, 0xcb, 0xd2, 0xea, 0xb7, 0x5b, 0x00, 0xac,
-	0xe9, 0xe2, 0x38, 0xae, 0x8e, 0xc6, 0x80, 0x36, 0xb0, 0x4f, 0x0a, 0x4a, 0xc7, 0x16, 0xc5, 0xa0,
-	0x87, 0xae, 0xe4, 0x3f, 0x76, 0x4c, 0x4c, 0xe5, 0xe7, 0xef, 0xe6, 0x35, 0xa7, 0x13, 0xd3, 0x95,
-	0x29, 0x64, 0x51, 0x8c, 0x77, 0x0d, 0x0b, 0xdf, 0x35, 0x86, 0x0f, 0x83, 0x6e, 0x4d, 0x3e, 0xc6,
-	0xc4, 0x54, 0x81, 0x31, 0x51, 0x98, 0xf1, 0x8f, 0x9e, 0xef, 0x1a, 0xf6, 0x48, 0xe4, 0x61, 0xca,
-	0x14, 0x7a, 0x94, 0x78, 0xdc, 0x29, 0x10, 0xae, 0x16, 0x79, 0xcf, 0x79, 0x34, 0x94, 0x26, 0xcc,
-	0x26, 0x5e, 0x82, 0xa3, 0xa5, 0xec, 0x27, 0x45, 0x59, 0xaf, 0xd6, 0xbb, 0x97, 0x0b, 0xcd, 0x0d,
-	0xb0, 0x19, 0xd0, 0x8e, 0xbf, 0x76, 0x46, 0xff, 0x92, 0xb7, 0x41, 0xea, 0x51, 0x63, 0x77, 0xa9,
-	0xc8, 0xd4, 0x00, 0xd5, 0x03, 0xa6, 0xa4, 0x87, 0xa1, 0xca, 0x7c, 0x50, 0xda, 0x3d, 0x28, 0x05,
-	0x56, 0xa6, 0xd0, 0xff, 0xc1, 0x5c, 0xea, 0xe9, 0x25, 0x7a, 0x39, 0xbb, 0x23, 0x9f, 0xfd, 0x42,
-	0xf3, 0x30, 0x0c, 0x0f, 0x92, 0x26, 0x96, 0x4f, 0x7d, 0xea, 0x99, 0x72, 0x71, 0xea, 0x23, 0xdb,
-	0x1f, 0x44, 0xfd, 0x53, 0x63, 0x98, 0x50, 0xb3, 0x49, 0xb6, 0xff, 0x5e, 0xc9, 0x42, 0x91, 0xfb,
-	0xfe, 0xb3, 0xbb, 0x5c, 0x74, 0x7a, 0x54, 0xbb, 0xe2, 0x4f, 0x0c, 0xb3, 0x99, 0x96, 0xf9, 0x2c,
-	0x32, 0x5b, 0xbb, 0xb2, 0x5f, 0x2c, 0x2a, 0x53, 0xe8, 0x6e, 0xcc, 0xc5, 0xa2, 0x4b, 0x79, 0xc2,
-	0x89, 0x5f, 0x0a, 0x1c, 0xc6, 0xb7, 0xff, 0x07, 0xc4, 0x6c, 0xc7, 0xde, 0x31, 0x46, 0x13, 0x57,
-	0x63, 0x8a, 0x95, 0xe7, 0x6e, 0xd2, 0x53, 0x05, 0x9a, 0x57, 0x9f, 0x62, 0x45, 0x70, 0xa4, 0x3e,
-	0xc0, 0x06, 0xf6, 0xef, 0x60, 0xdf, 0x35, 0x86, 0x5e, 0xf2, 0x44, 0xfc, 0x23, 0x9c, 0x20, 0x50,
-	0xbd, 0x74, 0xe8, 0xbc, 0x00, 0xc1, 0x00, 0x1a, 0x1b, 0xd8, 0xe7, 0xb9, 0x95, 0x87, 0x72, 0x57,
-	0x8a, 0x19, 0x02, 0xc5, 0xe2, 0xe1, 0x13, 0xa3, 0xee, 0x2c, 0xf1, 0xdc, 0x12, 0xe5, 0x0a, 0x36,
-	0xfd, 0x08, 0x34, 0xdb, 0x9d, 0xe5, 0xbc, 0xdf, 0x54, 0xa6, 0x56, 0x7f, 0xdc, 0x86, 0x3a, 0x8d,
-	0x4f, 0x24, 0x98, 0xfe, 0x33, 0x3c, 0x3d, 0x83, 0xf0, 0xf4, 0x3e, 0xcc, 0x26, 0x5e, 0xef, 0x65,
-	0xcb, 0x33, 0xfb, 0x89, 0x5f, 0x01, 0x2f, 0x1b, 0x7f, 0x59, 0x97, 0xed, 0x30, 0x32, 0x5f, 0xdf,
-	0x1d, 0xb6, 0xf7, 0x7d, 0xf6, 0xf0, 0x35, 0x68, 0x6a, 0xbd, 0x94, 0x5b, 0x7e, 0xc4, 0xef, 0x3c,
-	0x3f, 0x7d, 0xef, 0xfd, 0xec, 0xa3, 0xdb, 0xfb, 0x30, 0x9b, 0x78, 0x79, 0x92, 0x2d, 0xd5, 0xec,
-	0xe7, 0x29, 0x87, 0xed, 0xfe, 0x09, 0x86, 0x01, 0x1d, 0xe6, 0x33, 0x1e, 0x05, 0xa0, 0xe5, 0xbc,
-	0xea, 0x24, 0xfb, 0xf5, 0xc0, 0xe1, 0x07, 0x6a, 0xc5, 0x4c, 0x09, 0x2d, 0xe6, 0x11, 0x99, 0xfc,
-	0x73, 0x4f, 0xf7, 0xe5, 0x62, 0xff, 0x04, 0x0a, 0x0e, 0xd4, 0x83, 0x69, 0xf6, 0x1e, 0x05, 0xbd,
-	0x90, 0xdd, 0xfc, 0x89, 0xbc, 0x55, 0xe9, 0x1e, 0xf6, 0xa2, 0xc5, 0x9b, 0x98, 0xbe, 0x47, 0x37,
-	0xad, 0x52, 0x2f, 0x89, 0x32, 0x1f, 0x52, 0x45, 0x1f, 0x91, 0x74, 0x0f, 0x7f, 0x37, 0x22, 0x36,
-	0xfd, 0xc7, 0x8e, 0x95, 0x4f, 0x60, 0x3e, 0xa3, 0x65, 0x8b, 0xf2, 0x72, 0xa2, 0x9c, 0x66, 0x71,
-	0x77, 0xa5, 0xf0, 0xfc, 0x00, 0xf3, 0xff, 0x82, 0x9c, 0xac, 0xfa, 0xd1, 0xe5, 0x3c, 0x7d, 0xce,
-	0xc2, 0x79, 0xb0, 0x32, 0xdf, 0x78, 0xed, 0xc1, 0xea, 0xc8, 0xf0, 0x77, 0x27, 0x03, 0x32, 0xb2,
-	0xc2, 0xa6, 0xbe, 0x62, 0x38, 0xfc, 0xd7, 0x8a, 0xe0, 0xff, 0x0a, 0x5d, 0xbd, 0x42, 0x51, 0x8d,
-	0x07, 0x83, 0x69, 0xfa, 0x79, 0xf5, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xec, 0xe6, 0x23,
-	0xb4, 0x3b, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0x59,
+	0xb5, 0xa9, 0xfe, 0xd8, 0xdd, 0xa7, 0x3f, 0x2e, 0x5f, 0x27, 0x4e, 0x4f, 0x4f, 0x7e, 0x53, 0x99,
+	0x64, 0xfc, 0x9c, 0x19, 0x3b, 0xe3, 0xcc, 0x8c, 0x32, 0xef, 0xcd, 0xe8, 0x91, 0xd8, 0x13, 0x8f,
+	0x49, 0xe2, 0x31, 0xd5, 0x49, 0x40, 0xd1, 0x88, 0xa6, 0xba, 0xeb, 0xba, 0x5d, 0x4a, 0x7d, 0x3a,
+	0x55, 0xd5, 0x4e, 0x3c, 0x6c, 0xd9, 0xf0, 0x95, 0x60, 0xc1, 0x0a, 0x58, 0x81, 0x04, 0x68, 0x46,
+	0x02, 0x09, 0x24, 0x16, 0x2c, 0x90, 0x58, 0xc0, 0x0a, 0xb1, 0x40, 0xac, 0x90, 0x58, 0xb2, 0x80,
+	0x2d, 0x0b, 0x76, 0xe8, 0xfe, 0xea, 0x5f, 0x76, 0xc5, 0x9e, 0xcc, 0x07, 0xb1, 0xeb, 0x3a, 0xf7,
+	0x73, 0xce, 0x3d, 0xff, 0x73, 0xee, 0x6d, 0x98, 0x7d, 0x38, 0xc1, 0xee, 0x5e, 0x7f, 0xe8, 0x38,
+	0xae, 0xbe, 0x34, 0x76, 0x1d, 0xdf, 0x41, 0xc8, 0x32, 0xcc, 0xdd, 0x89, 0xc7, 0xbe, 0x96, 0xe8,
+	0x78, 0xb7, 0x39, 0x74, 0x2c, 0xcb, 0xb1, 0x19, 0xac, 0xdb, 0x8c, 0xce, 0xe8, 0xb6, 0x0d, 0xdb,
+	0xc7, 0xae, 0xad, 0x99, 0x62, 0xd4, 0x1b, 0xee, 0x60, 0x4b, 0xe3, 0x5f, 0xb2, 0xae, 0xf9, 0x5a,
+	0x74, 0x7f, 0xe5, 0x2b, 0x12, 0xcc, 0xf7, 0x76, 0x9c, 0x47, 0xab, 0x8e, 0x69, 0xe2, 0xa1, 0x6f,
+	0x38, 0xb6, 0xa7, 0xe2, 0x87, 0x13, 0xec, 0xf9, 0xe8, 0x32, 0x54, 0x06, 0x9a, 0x87, 0x3b, 0xd2,
+	0x39, 0x69, 0xa1, 0xb1, 0x72, 0x6a, 0x29, 0x46, 0x09, 0x27, 0xe1, 0xb6, 0x37, 0xba, 0xae, 0x79,
+	0x58, 0xa5, 0x33, 0x11, 0x82, 0x8a, 0x3e, 0xd8, 0x58, 0xeb, 0x94, 0xce, 0x49, 0x0b, 0x65, 0x95,
+	0xfe, 0x46, 0xcf, 0x43, 0x6b, 0x18, 0xec, 0xbd, 0xb1, 0xe6, 0x75, 0xca, 0xe7, 0xca, 0x0b, 0x65,
+	0x35, 0x0e, 0x54, 0xfe, 0x2a, 0xc1, 0xc9, 0x14, 0x19, 0xde, 0xd8, 0xb1, 0x3d, 0x8c, 0xae, 0xc0,
+	0x94, 0xe7, 0x6b, 0xfe, 0xc4, 0xe3, 0x94, 0x3c, 0x9b, 0x49, 0x49, 0x8f, 0x4e, 0x51, 0xf9, 0xd4,
+	0x34, 0xda, 0x52, 0x06, 0x5a, 0xf4, 0x32, 0x1c, 0x37, 0xec, 0xdb, 0xd8, 0x72, 0xdc, 0xbd, 0xfe,
+	0x18, 0xbb, 0x43, 0x6c, 0xfb, 0xda, 0x08, 0x0b, 0x1a, 0xe7, 0xc4, 0xd8, 0x56, 0x38, 0x84, 0x5e,
+	0x83, 0x93, 0x4c, 0x4a, 0x1e, 0x76, 0x77, 0x8d, 0x21, 0xee, 0x6b, 0xbb, 0x9a, 0x61, 0x6a, 0x03,
+	0x13, 0x77, 0x2a, 0xe7, 0xca, 0x0b, 0x35, 0xf5, 0x04, 0x1d, 0xee, 0xb1, 0xd1, 0x6b, 0x62, 0x50,
+	0xf9, 0x91, 0x04, 0x27, 0xc8, 0x09, 0xb7, 0x34, 0xd7, 0x37, 0x9e, 0x02, 0x9f, 0x15, 0x68, 0x46,
+	0xcf, 0xd6, 0x29, 0xd3, 0xb1, 0x18, 0x8c, 0xcc, 0x19, 0x0b, 0xf4, 0x84, 0x27, 0x15, 0x7a, 0xcc,
+	0x18, 0x4c, 0xf9, 0x21, 0x57, 0x88, 0x28, 0x9d, 0x47, 0x11, 0x44, 0x12, 0x67, 0x29, 0x8d, 0xf3,
+	0x10, 0x62, 0x50, 0xfe, 0x26, 0xc1, 0x89, 0x5b, 0x8e, 0xa6, 0x87, 0x0a, 0xf3, 0xd1, 0xb3, 0xf3,
+	0x4d, 0x98, 0x62, 0xd6, 0xd5, 0xa9, 0x50, 0x5c, 0x17, 0xe2, 0xb8, 0xd8, 0xd8, 0x52, 0x48, 0x61,
+	0x8f, 0x02, 0x54, 0xbe, 0x08, 0x5d, 0x80, 0xb6, 0x8b, 0xc7, 0xa6, 0x31, 0xd4, 0xfa, 0xf6, 0xc4,
+	0x1a, 0x60, 0xb7, 0x53, 0x3d, 0x27, 0x2d, 0x54, 0xd5, 0x16, 0x87, 0x6e, 0x52, 0xa0, 0xf2, 0x3d,
+	0x09, 0x3a, 0x2a, 0x36, 0xb1, 0xe6, 0xe1, 0x8f, 0xf3, 0xb0, 0xf3, 0x30, 0x65, 0x3b, 0x3a, 0xde,
+	0x58, 0xa3, 0x87, 0x2d, 0xab, 0xfc, 0x4b, 0xf9, 0x97, 0x04, 0xc7, 0xd7, 0xb1, 0x4f, 0xa4, 0x6e,
+	0x78, 0xbe, 0x31, 0x0c, 0xd4, 0xfa, 0x4d, 0x28, 0xbb, 0xf8, 0x21, 0xa7, 0xec, 0x52, 0x9c, 0xb2,
+	0xc0, 0x49, 0x65, 0xad, 0x54, 0xc9, 0x3a, 0xf4, 0x1c, 0x34, 0x75, 0xcb, 0xec, 0x0f, 0x77, 0x34,
+	0xdb, 0xc6, 0x26, 0xd3, 0x9b, 0xba, 0xda, 0xd0, 0x2d, 0x73, 0x95, 0x83, 0xd0, 0x19, 0x00, 0x0f,
+	0x8f, 0x2c, 0x6c, 0xfb, 0xa1, 0x5f, 0x89, 0x40, 0xd0, 0x22, 0xcc, 0x6e, 0xbb, 0x8e, 0xd5, 0xf7,
+	0x76, 0x34, 0x57, 0xef, 0x9b, 0x58, 0xd3, 0xb1, 0x4b, 0xa9, 0xaf, 0xa9, 0x33, 0x64, 0xa0, 0x47,
+	0xe0, 0xb7, 0x28, 0x18, 0x5d, 0x81, 0xaa, 0x37, 0x74, 0xc6, 0x98, 0xca, 0xa0, 0xbd, 0x72, 0x7a,
+	0x29, 0xed, 0x77, 0x97, 0xd6, 0x34, 0x5f, 0xeb, 0x91, 0x49, 0x2a, 0x9b, 0xab, 0x7c, 0xbd, 0xc4,
+	0x94, 0xf0, 0x13, 0x6e, 0xd3, 0x11, 0x45, 0xad, 0x7e, 0x38, 0x8a, 0x3a, 0x95, 0xa5, 0xa8, 0xbf,
+	0x09, 0x15, 0xf5, 0x93, 0xce, 0x90, 0x50, 0x99, 0xab, 0x31, 0x65, 0xfe, 0x89, 0x04, 0xcf, 0xac,
+	0x63, 0x3f, 0x20, 0x9f, 0xe8, 0x26, 0xfe, 0x84, 0x3a, 0xea, 0x0f, 0x24, 0xe8, 0x66, 0xd1, 0x7a,
+	0x14, 0x67, 0x7d, 0x1f, 0xe6, 0x03, 0x1c, 0x7d, 0x1d, 0x7b, 0x43, 0xd7, 0x18, 0x93, 0xdf, 0xcc,
+	0xfc, 0x1a, 0x2b, 0xe7, 0xb3, 0xcc, 0x22, 0x49, 0xc1, 0x89, 0x60, 0x8b, 0xb5, 0xc8, 0x0e, 0xca,
+	0x37, 0x25, 0x38, 0x41, 0xcc, 0x9d, 0xdb, 0xa7, 0xbd, 0xed, 0x1c, 0x9e, 0xaf, 0x71, 0xcb, 0x2f,
+	0xa5, 0x2c, 0xbf, 0x00, 0x8f, 0x69, 0xe6, 0x93, 0xa4, 0xe7, 0x28, 0xbc, 0x7b, 0x15, 0xaa, 0x86,
+	0xbd, 0xed, 0x08, 0x56, 0x9d, 0xcd, 0x62, 0x55, 0x14, 0x19, 0x9b, 0xad, 0xd8, 0x8c, 0x8a, 0xd0,
+	0x15, 0x1d, 0x41, 0xdd, 0x92, 0xc7, 0x2e, 0x65, 0x1c, 0xfb, 0x1b, 0x12, 0x9c, 0x4c, 0x21, 0x3c,
+	0xca, 0xb9, 0xdf, 0x80, 0x29, 0xea, 0x60, 0xc5, 0xc1, 0x9f, 0xcf, 0x3c, 0x78, 0x04, 0xdd, 0x2d,
+	0xc3, 0xf3, 0x55, 0xbe, 0x46, 0x71, 0x40, 0x4e, 0x8e, 0x11, 0xd7, 0xcf, 0xdd, 0x7e, 0xdf, 0xd6,
+	0x2c, 0xc6, 0x80, 0xba, 0xda, 0xe0, 0xb0, 0x4d, 0xcd, 0xc2, 0xe8, 0x19, 0xa8, 0x11, 0x93, 0xed,
+	0x1b, 0xba, 0x10, 0xff, 0x34, 0x35, 0x61, 0xdd, 0x43, 0xa7, 0x01, 0xe8, 0x90, 0xa6, 0xeb, 0x2e,
+	0x8b, 0x0a, 0x75, 0xb5, 0x4e, 0x20, 0xd7, 0x08, 0x40, 0xf9, 0xb6, 0x04, 0x4d, 0xe2, 0xb3, 0x6f,
+	0x63, 0x5f, 0x23, 0x72, 0x40, 0xaf, 0x43, 0xdd, 0x74, 0x34, 0xbd, 0xef, 0xef, 0x8d, 0x19, 0xaa,
+	0xf6, 0xca, 0xa9, 0xac, 0x23, 0x90, 0x45, 0x77, 0xf6, 0xc6, 0x58, 0xad, 0x99, 0xfc, 0x57, 0x11,
+	0x7e, 0xa7, 0x4c, 0xb9, 0x9c, 0x61, 0xca, 0xef, 0x57, 0x61, 0xfe, 0xf3, 0x9a, 0x3f, 0xdc, 0x59,
+	0xb3, 0x44, 0x70, 0x3b, 0xbc, 0x12, 0x84, 0xbe, 0xad, 0x14, 0xf5, 0x6d, 0x1f, 0x9a, 0xef, 0x0c,
+	0xf4, 0xbc, 0x9a, 0xa5, 0xe7, 0xa4, 0xc0, 0x58, 0xba, 0xc7, 0x45, 0x15, 0xd1, 0xf3, 0x48, 0x0c,
+	0x9a, 0x3a, 0x4c, 0x0c, 0x5a, 0x85, 0x16, 0x7e, 0x3c, 0x34, 0x27, 0x44, 0xe6, 0x14, 0xfb, 0x34,
+	0xc5, 0x7e, 0x26, 0x03, 0x7b, 0xd4, 0xc8, 0x9a, 0x7c, 0xd1, 0x06, 0xa7, 0x81, 0x89, 0xda, 0xc2,
+	0xbe, 0xd6, 0xa9, 0x51, 0x32, 0xce, 0xe5, 0x89, 0x5a, 0xe8, 0x07, 0x13, 0x37, 0xf9, 0x42, 0xa7,
+	0xa0, 0xce, 0x23, 0xde, 0xc6, 0x5a, 0xa7, 0x4e, 0xd9, 0x17, 0x02, 0x90, 0x06, 0x2d, 0xee, 0x81,
+	0x38, 0x85, 0x40, 0x29, 0x7c, 0x23, 0x0b, 0x41, 0xb6, 0xb0, 0xa3, 0x94, 0x7b, 0x6f, 0xd9, 0xbe,
+	0xbb, 0xa7, 0x36, 0xbd, 0x08, 0x88, 0x14, 0x35, 0xce, 0xf6, 0xb6, 0x69, 0xd8, 0x78, 0x93, 0x49,
+	0xb8, 0x41, 0x89, 0x88, 0x03, 0xbb, 0x7d, 0x98, 0x4d, 0x6d, 0x84, 0x64, 0x28, 0x3f, 0xc0, 0x7b,
+	0x54, 0x8d, 0xca, 0x2a, 0xf9, 0x89, 0x5e, 0x81, 0xea, 0xae, 0x66, 0x4e, 0x30, 0x55, 0x93, 0x83,
+	0x39, 0xc9, 0x26, 0xff, 0x6f, 0xe9, 0xaa, 0xa4, 0xfc, 0x58, 0x82, 0x13, 0x77, 0x6d, 0x6f, 0x32,
+	0x08, 0x4e, 0xf0, 0xf1, 0x68, 0x6b, 0xd2, 0x4f, 0x54, 0x52, 0x7e, 0x42, 0xf9, 0x73, 0x05, 0x66,
+	0xf8, 0x29, 0x88, 0x50, 0xa9, 0xc1, 0x9f, 0x82, 0x7a, 0x10, 0x2a, 0x38, 0x43, 0x42, 0x00, 0x3a,
+	0x07, 0x8d, 0x88, 0xba, 0x73, 0xaa, 0xa2, 0xa0, 0x42, 0xa4, 0x89, 0xc0, 0x5f, 0x89, 0x04, 0xfe,
+	0xd3, 0x00, 0xdb, 0xe6, 0xc4, 0xdb, 0xe9, 0xfb, 0x86, 0x85, 0x79, 0xe2, 0x51, 0xa7, 0x90, 0x3b,
+	0x86, 0x85, 0xd1, 0x35, 0x68, 0x0e, 0x0c, 0xdb, 0x74, 0x46, 0xfd, 0xb1, 0xe6, 0xef, 0x78, 0x9d,
+	0xa9, 0x5c, 0x05, 0xbf, 0x61, 0x60, 0x53, 0xbf, 0x4e, 0xe7, 0xaa, 0x0d, 0xb6, 0x66, 0x8b, 0x2c,
+	0x41, 0x67, 0xa0, 0x61, 0x4f, 0xac, 0xbe, 0xb3, 0xdd, 0x77, 0x9d, 0x47, 0xc4, 0x44, 0x28, 0x0a,
+	0x7b, 0x62, 0xbd, 0xb3, 0xad, 0x3a, 0x8f, 0x88, 0xab, 0xae, 0x13, 0xa7, 0xed, 0x99, 0xce, 0xc8,
+	0xeb, 0xd4, 0x0a, 0xed, 0x1f, 0x2e, 0x20, 0xab, 0x75, 0x6c, 0xfa, 0x1a, 0x5d, 0x5d, 0x2f, 0xb6,
+	0x3a, 0x58, 0x80, 0x2e, 0x42, 0x7b, 0xe8, 0x58, 0x63, 0x8d, 0x72, 0xe8, 0x86, 0xeb, 0x58, 0xd4,
+	0x3e, 0xca, 0x6a, 0x02, 0x8a, 0x56, 0xa1, 0x61, 0xd8, 0x3a, 0x7e, 0xcc, 0x8d, 0xa8, 0x41, 0xf1,
+	0x28, 0x59, 0x46, 0x44, 0x11, 0x6d, 0x90, 0xb9, 0x54, 0x41, 0xc1, 0x10, 0x3f, 0x3d, 0xa2, 0x19,
+	0xc2, 0x16, 0x3d, 0xe3, 0x3d, 0xdc, 0x69, 0x32, 0x29, 0x72, 0x58, 0xcf, 0x78, 0x0f, 0x93, 0xa4,
+	0xd6, 0xb0, 0x3d, 0xec, 0xfa, 0xa2, 0xc4, 0xe8, 0xb4, 0xa8, 0xfa, 0xb4, 0x18, 0x94, 0x2b, 0x36,
+	0xd1, 0x4f, 0xcf, 0x71, 0x7d, 0xac, 0x77, 0xda, 0xb4, 0x70, 0xe0, 0x5f, 0xca, 0x9f, 0x4a, 0xd0,
+	0x8e, 0x13, 0x80, 0x3a, 0x30, 0xbd, 0x4d, 0x21, 0x42, 0xab, 0xc4, 0x27, 0x21, 0x07, 0xdb, 0xa4,
+	0x0b, 0xd0, 0xa7, 0x34, 0x52, 0xa5, 0xaa, 0xa9, 0x0d, 0x06, 0xa3, 0x1b, 0x10, 0xe5, 0x60, 0xc7,
+	0xa6, 0x9a, 0x5c, 0xa6, 0xa4, 0xd4, 0x29, 0x84, 0xc6, 0xbb, 0x0e, 0x4c, 0xb3, 0xe3, 0x09, 0x95,
+	0x12, 0x9f, 0x64, 0x64, 0x30, 0x31, 0x28, 0x56, 0xa6, 0x52, 0xe2, 0x13, 0xad, 0x41, 0x93, 0x6d,
+	0x39, 0xd6, 0x5c, 0xcd, 0x12, 0x0a, 0xf5, 0x5c, 0xa6, 0x51, 0xde, 0xc4, 0x7b, 0xf7, 0x88, 0x7d,
+	0x6f, 0x69, 0x86, 0xab, 0x32, 0x01, 0x6c, 0xd1, 0x55, 0x68, 0x01, 0x64, 0xb6, 0xcb, 0xb6, 0x61,
+	0x62, 0xae, 0x9a, 0xd3, 0x34, 0xa8, 0xb6, 0x29, 0xfc, 0x86, 0x61, 0x62, 0xa6, 0x7d, 0xc1, 0x11,
+	0x28, 0xcb, 0x6b, 0x4c, 0xf9, 0x28, 0x84, 0x32, 0xfc, 0x3c, 0xb4, 0xd8, 0xf0, 0x2e, 0x76, 0x3d,
+	0xc3, 0xb1, 0xb9, 0x07, 0x65, 0x34, 0xde, 0x63, 0x30, 0xe5, 0x3b, 0x15, 0x98, 0x23, 0x86, 0xca,
+	0x6d, 0xf6, 0x08, 0x61, 0xf0, 0x34, 0x80, 0xee, 0xf9, 0xfd, 0x98, 0x73, 0xa9, 0xeb, 0x9e, 0xcf,
+	0x9c, 0x24, 0x7a, 0x5d, 0x44, 0xb1, 0x72, 0x7e, 0x62, 0x9b, 0x70, 0x1c, 0xe9, 0x48, 0x76, 0xa8,
+	0xb2, 0xff, 0x3c, 0xb4, 0x3c, 0x67, 0xe2, 0x0e, 0x71, 0x3f, 0x56, 0x82, 0x34, 0x19, 0x70, 0x33,
+	0xdb, 0xfd, 0x4d, 0x65, 0xb6, 0x1f, 0x22, 0xd1, 0x6c, 0xfa, 0x68, 0xd1, 0xac, 0x96, 0x8c, 0x66,
+	0x37, 0x61, 0x86, 0xda, 0x6e, 0x7f, 0xec, 0x78, 0xac, 0x92, 0xeb, 0xd4, 0xb3, 0x4c, 0x31, 0xa8,
+	0xe4, 0x6f, 0x7b, 0xa3, 0x2d, 0x3e, 0x55, 0x6d, 0xd3, 0xa5, 0xe2, 0xd3, 0x23, 0x3a, 0x2a, 0x84,
+	0x0e, 0x4c, 0x47, 0xf9, 0x27, 0x61, 0x86, 0x8d, 0xb1, 0xde, 0xf7, 0x5d, 0xcd, 0xf6, 0xb6, 0xb1,
+	0x4b, 0x23, 0x5a, 0x4d, 0x6d, 0x12, 0xe0, 0x1d, 0x0e, 0x53, 0xfe, 0x50, 0x82, 0x79, 0x5e, 0x58,
+	0x1e, 0x5d, 0x2f, 0xf2, 0x02, 0x8e, 0xf0, 0xd8, 0xe5, 0x7d, 0x4a, 0xb5, 0x4a, 0x81, 0x94, 0xa9,
+	0x9a, 0x91, 0x32, 0xc5, 0xcb, 0x95, 0xa9, 0x54, 0xb9, 0x12, 0x34, 0x1f, 0xa6, 0x8b, 0x37, 0x1f,
+	0xd0, 0x71, 0xa8, 0xd2, 0x1c, 0x9a, 0xca, 0xae, 0xae, 0xb2, 0x8f, 0x62, 0x0c, 0xfd, 0xbb, 0x04,
+	0xad, 0x1e, 0xd6, 0xdc, 0xe1, 0x8e, 0xe0, 0xe3, 0x6b, 0xd1, 0x66, 0xcd, 0xf3, 0x39, 0x22, 0x8e,
+	0x2d, 0xf9, 0xf4, 0x74, 0x69, 0xfe, 0x21, 0x41, 0xf3, 0x73, 0x64, 0x48, 0x1c, 0xf6, 0x6a, 0xf4,
+	0xb0, 0x17, 0x73, 0x0e, 0xab, 0x62, 0xdf, 0x35, 0xf0, 0x2e, 0xfe, 0xd4, 0x1d, 0xf7, 0x77, 0x12,
+	0x74, 0x7b, 0x7b, 0xf6, 0x50, 0x65, 0xb6, 0x7c, 0x74, 0x8b, 0x39, 0x0f, 0xad, 0xdd, 0x58, 0x9e,
+	0x55, 0xa2, 0x0a, 0xd7, 0xdc, 0x8d, 0x16, 0x64, 0x2a, 0xc8, 0xa2, 0x47, 0xc4, 0x0f, 0x2b, 0x5c,
+	0xeb, 0x0b, 0x59, 0x54, 0x27, 0x88, 0xa3, 0xae, 0x69, 0xc6, 0x8d, 0x03, 0x95, 0x6f, 0x49, 0x30,
+	0x97, 0x31, 0x11, 0x9d, 0x84, 0x69, 0x5e, 0xfc, 0x75, 0xa4, 0x88, 0x0d, 0xeb, 0x44, 0x3c, 0x61,
+	0xfb, 0xc2, 0xd0, 0xd3, 0xc9, 0x9b, 0x8e, 0xce, 0x42, 0x23, 0xc8, 0xd2, 0xf5, 0x94, 0x7c, 0x74,
+	0x0f, 0x75, 0xa1, 0xc6, 0x9d, 0x93, 0x28, 0x7f, 0x82, 0x6f, 0xe5, 0xd7, 0x12, 0xcc, 0xbf, 0xad,
+	0xd9, 0xba, 0xb3, 0xbd, 0x7d, 0x74, 0xb6, 0xae, 0x42, 0x2c, 0xb9, 0x2f, 0xda, 0x36, 0x88, 0x2d,
+	0x42, 0x97, 0x60, 0xd6, 0x65, 0x9e, 0x51, 0x8f, 0xf3, 0xbd, 0xac, 0xca, 0x62, 0x20, 0xe0, 0xe7,
+	0xfb, 0x25, 0x40, 0x24, 0x18, 0x5c, 0xd7, 0x4c, 0xcd, 0x1e, 0xe2, 0xc3, 0x93, 0x7e, 0x01, 0xda,
+	0xb1, 0x10, 0x16, 0xdc, 0xae, 0x44, 0x63, 0x98, 0x87, 0x6e, 0x42, 0x7b, 0xc0, 0x50, 0xf5, 0x5d,
+	0xac, 0x79, 0x8e, 0x4d, 0x9d, 0x6b, 0x3b, 0xbb, 0x43, 0x70, 0xc7, 0x35, 0x46, 0x23, 0xec, 0xae,
+	0x3a, 0xb6, 0xce, 0x82, 0x48, 0x6b, 0x20, 0xc8, 0x24, 0x4b, 0x89, 0xe0, 0xc2, 0x78, 0x2e, 0x44,
+	0x03, 0x41, 0x40, 0xa7, 0xac, 0xf0, 0xb0, 0x66, 0x86, 0x8c, 0x08, 0xbd, 0xb1, 0xcc, 0x06, 0x7a,
+	0xf9, 0x0d, 0xa2, 0x8c, 0xf8, 0xaa, 0xfc, 0x42, 0x02, 0x14, 0x54, 0x38, 0xb4, 0x62, 0xa3, 0xda,
+	0x97, 0x5c, 0x2a, 0xa5, 0x97, 0x92, 0xd8, 0xaa, 0x8b, 0x95, 0xdc, 0x5c, 0x42, 0x00, 0xf5, 0xd1,
+	0x94, 0xe8, 0x3e, 0x09, 0xc6, 0x58, 0x17, 0x15, 0x04, 0x03, 0xde, 0xa2, 0xb0, 0x78, 0x78, 0xae,
+	0x24, 0xc3, 0x73, 0xb4, 0xff, 0x51, 0x8d, 0xf5, 0x3f, 0x94, 0x0f, 0x4a, 0x20, 0x53, 0x77, 0xb7,
+	0x1a, 0x16, 0xe1, 0x85, 0x88, 0x3e, 0x0f, 0x2d, 0x7e, 0xff, 0x18, 0x23, 0xbc, 0xf9, 0x30, 0xb2,
+	0x19, 0xba, 0x0c, 0xc7, 0xd9, 0x24, 0x17, 0x7b, 0x13, 0x33, 0x4c, 0x9e, 0x59, 0xc6, 0x8a, 0x1e,
+	0x32, 0x3f, 0x4b, 0x86, 0xc4, 0x8a, 0xbb, 0x30, 0x3f, 0x32, 0x9d, 0x81, 0x66, 0xf6, 0xe3, 0xe2,
+	0x61, 0x32, 0x2c, 0xa0, 0xf1, 0xc7, 0xd9, 0xf2, 0x5e, 0x54, 0x86, 0x1e, 0x5a, 0x27, 0xe5, 0x36,
+	0x7e, 0x10, 0xe4, 0x27, 0xbc, 0xb5, 0x5d, 0x24, 0x3d, 0x69, 0x92, 0x85, 0xe2, 0x4b, 0xf9, 0x81,
+	0x04, 0x33, 0x89, 0x16, 0x66, 0xb2, 0x08, 0x94, 0xd2, 0x45, 0xe0, 0x55, 0xa8, 0x7a, 0x64, 0x2e,
+	0x65, 0x52, 0x3b, 0xbb, 0x40, 0x89, 0xef, 0xaa, 0xb2, 0x05, 0x68, 0x19, 0xe6, 0x32, 0x2e, 0xbb,
+	0xb8, 0x0e, 0xa0, 0xf4, 0x5d, 0x97, 0xf2, 0x97, 0x0a, 0x34, 0x22, 0xfc, 0x38, 0xa0, 0x7e, 0x2d,
+	0xd2, 0x93, 0x4a, 0x1c, 0xaf, 0x9c, 0x3e, 0x5e, 0xce, 0x6d, 0x0f, 0xd1, 0x3b, 0x0b, 0x5b, 0x2c,
+	0xc3, 0xe7, 0xe5, 0x86, 0x85, 0x2d, 0x9a, 0xdf, 0x13, 0x95, 0x9c, 0x58, 0xac, 0xf2, 0x64, 0xe6,
+	0x34, 0x6d, 0x4f, 0x2c, 0x5a, 0x77, 0xc6, 0x8b, 0x9b, 0xe9, 0x7d, 0x8a, 0x9b, 0x5a, 0xbc, 0xb8,
+	0x89, 0xd9, 0x51, 0x3d, 0x69, 0x47, 0x45, 0x4b, 0xca, 0xcb, 0x30, 0x37, 0x74, 0xb1, 0xe6, 0x63,
+	0xfd, 0xfa, 0xde, 0x6a, 0x30, 0xc4, 0x33, 0xa3, 0xac, 0x21, 0x74, 0x23, 0xec, 0xe5, 0x30, 0x29,
+	0x37, 0xa9, 0x94, 0xb3, 0x6b, 0x27, 0x2e, 0x1b, 0x26, 0xe4, 0xa6, 0x17, 0xf9, 0x4a, 0x16, 0xb3,
+	0xad, 0x43, 0x15, 0xb3, 0x67, 0xa1, 0x21, 0x42, 0x2b, 0x31, 0xf7, 0x36, 0xf3, 0x7c, 0x1c, 0x44,
+	0x42, 0x56, 0xd4, 0x19, 0xcc, 0xc4, 0x9b, 0xa1, 0xc9, 0xca, 0x53, 0x4e, 0x55, 0x9e, 0xca, 0x1f,
+	0xcb, 0xd0, 0x0e, 0x8b, 0x95, 0xc2, 0xde, 0xa2, 0xc8, 0xbd, 0xee, 0x26, 0xc8, 0x61, 0x3c, 0xa6,
+	0x8c, 0xdc, 0xb7, 0xde, 0x4a, 0x5e, 0x24, 0xcc, 0x8c, 0xe3, 0x80, 0x78, 0xab, 0xb6, 0xf2, 0x44,
+	0xad, 0xda, 0x23, 0x5e, 0x81, 0x5d, 0x81, 0x13, 0x41, 0x9c, 0x8d, 0x1d, 0x9b, 0x25, 0xf3, 0xc7,
+	0xc5, 0xe0, 0x56, 0xf4, 0xf8, 0x39, 0x96, 0x3e, 0x9d, 0x67, 0xe9, 0x49, 0x49, 0xd7, 0x52, 0x92,
+	0x4e, 0xdf, 0xc4, 0xd5, 0xb3, 0x6e, 0xe2, 0xee, 0xc2, 0x1c, 0xed, 0xcf, 0x91, 0xdb, 0x97, 0x01,
+	0x0e, 0x52, 0xd3, 0x22, 0x62, 0xed, 0x42, 0x2d, 0x91, 0xdd, 0x06, 0xdf, 0xca, 0xd7, 0x24, 0x98,
+	0x4f, 0xef, 0x4b, 0x35, 0x26, 0xf4, 0x17, 0x52, 0xcc, 0x5f, 0x7c, 0x01, 0xe6, 0xc2, 0xed, 0xe3,
+	0x79, 0x73, 0x4e, 0x66, 0x98, 0x41, 0xb8, 0x8a, 0xc2, 0x3d, 0x04, 0x4c, 0xf9, 0xa7, 0x14, 0xb4,
+	0x39, 0x09, 0x6c, 0x44, 0x5b, 0xbc, 0x24, 0x86, 0x39, 0xb6, 0x69, 0xd8, 0xb8, 0x1f, 0x23, 0xa7,
+	0xc9, 0x80, 0xbc, 0xb8, 0x7e, 0x1b, 0x66, 0xf8, 0xa4, 0x20, 0x14, 0x15, 0x4c, 0xbe, 0xda, 0x6c,
+	0x5d, 0x10, 0x84, 0x2e, 0x40, 0x9b, 0xf7, 0x5e, 0x05, 0xbe, 0x72, 0x46, 0x47, 0x16, 0x7d, 0x16,
+	0x64, 0x31, 0xed, 0x49, 0x83, 0xdf, 0x0c, 0x5f, 0x18, 0x24, 0x71, 0x5f, 0x95, 0xa0, 0x13, 0x0f,
+	0x85, 0x91, 0xe3, 0x3f, 0x79, 0x2a, 0xf7, 0x7f, 0xf1, 0x5b, 0xab, 0x0b, 0xfb, 0xd0, 0x13, 0xe2,
+	0x11, 0x77, 0x57, 0x9b, 0xf4, 0x06, 0x92, 0x54, 0x20, 0x6b, 0x86, 0xe7, 0xbb, 0xc6, 0x60, 0x72,
+	0xa4, 0xb7, 0x09, 0xca, 0x2f, 0x4b, 0xf0, 0x6c, 0xe6, 0x86, 0x47, 0xb9, 0x9f, 0xca, 0x2b, 0xf8,
+	0xaf, 0x43, 0x2d, 0x51, 0xa9, 0x5c, 0xdc, 0xe7, 0xf0, 0xbc, 0x41, 0xc5, 0x7a, 0x28, 0x62, 0x1d,
+	0xd9, 0x23, 0xd0, 0xe9, 0x4a, 0xfe, 0x1e, 0x5c, 0x69, 0x63, 0x7b, 0x88, 0x75, 0xa4, 0xef, 0xcb,
+	0xaa, 0xc0, 0xfe, 0xae, 0x81, 0x1f, 0x89, 0x6b, 0x95, 0x33, 0x99, 0x7e, 0x8d, 0xce, 0xbb, 0x67,
+	0xe0, 0x47, 0x6a, 0xc3, 0x0c, 0x7e, 0x7b, 0xca, 0x4f, 0x4b, 0x00, 0xe1, 0x18, 0x29, 0x41, 0x43,
+	0x83, 0xe1, 0x16, 0x10, 0x81, 0x90, 0x78, 0x1b, 0x4f, 0xf1, 0xc4, 0x27, 0x52, 0xc3, 0xbe, 0xa9,
+	0x6e, 0x78, 0x3e, 0xe7, 0xcb, 0xf2, 0xfe, 0xb4, 0x08, 0x16, 0x11, 0x91, 0xb1, 0x5b, 0x8b, 0x86,
+	0x17, 0x42, 0xd0, 0x4b, 0x80, 0x46, 0xae, 0xf3, 0xc8, 0xb0, 0x47, 0xd1, 0xc4, 0x9c, 0xe5, 0xef,
+	0xb3, 0x7c, 0x24, 0xcc, 0xcc, 0xbb, 0x7d, 0x90, 0x93, 0xfb, 0x65, 0x5c, 0x5e, 0xbc, 0x1a, 0xbf,
+	0xbc, 0xd8, 0xcf, 0x8c, 0xc8, 0x36, 0xd1, 0xdb, 0x8b, 0xff, 0x87, 0x46, 0x64, 0x24, 0xd7, 0x73,
+	0x45, 0x7a, 0x56, 0xa5, 0x58, 0xcf, 0x4a, 0xf9, 0xae, 0x04, 0x28, 0xad, 0x15, 0xa8, 0x0d, 0xa5,
+	0x60, 0x93, 0xd2, 0xc6, 0x5a, 0x42, 0x0a, 0xa5, 0x94, 0x14, 0x4e, 0x41, 0x3d, 0x88, 0x24, 0xdc,
+	0x6d, 0x84, 0x80, 0xa8, 0x8c, 0x2a, 0x71, 0x19, 0x45, 0x08, 0xab, 0xc6, 0x09, 0xdb, 0x01, 0x94,
+	0xd6, 0xb4, 0xe8, 0x4e, 0x52, 0x7c, 0xa7, 0x83, 0x28, 0x8c, 0x60, 0x2a, 0xc7, 0x31, 0xfd, 0x5e,
+	0x02, 0x14, 0xc6, 0xca, 0xe0, 0x66, 0xa5, 0x48, 0x80, 0x59, 0x86, 0xb9, 0x74, 0x24, 0x15, 0xe9,
+	0x03, 0x4a, 0xc5, 0xd1, 0xac, 0x98, 0x57, 0xce, 0x88, 0x79, 0xe8, 0xb5, 0xc0, 0x37, 0xb0, 0xc4,
+	0xe0, 0x4c, 0x5e, 0x62, 0x10, 0x77, 0x0f, 0xca, 0xaf, 0x24, 0x98, 0x0d, 0xb0, 0x3d, 0xd1, 0x49,
+	0x0e, 0xbe, 0x29, 0x7a, 0xca, 0xa4, 0xf7, 0x60, 0x9a, 0xb7, 0x47, 0x52, 0xca, 0x57, 0xa4, 0x0a,
+	0x38, 0x0e, 0x55, 0xa2, 0xeb, 0xa2, 0x5f, 0xc0, 0x3e, 0x94, 0x9f, 0x49, 0x00, 0xa4, 0x7d, 0x74,
+	0x8d, 0xe9, 0xc0, 0x65, 0xa8, 0x1c, 0x74, 0x31, 0x4e, 0x66, 0xd3, 0x6c, 0x8b, 0xce, 0x2c, 0xc0,
+	0x96, 0x58, 0x01, 0x53, 0x4e, 0x16, 0x30, 0x79, 0xa5, 0x47, 0xbe, 0xde, 0xff, 0x96, 0x3c, 0x1e,
+	0xdd, 0xb3, 0x87, 0x1f, 0x4a, 0x10, 0x2a, 0xc4, 0xba, 0x88, 0x4d, 0x95, 0xe3, 0x36, 0x75, 0x15,
+	0xa6, 0x59, 0x0d, 0x21, 0x02, 0xc2, 0x99, 0x3c, 0x96, 0x31, 0x06, 0xab, 0x62, 0xfa, 0xe2, 0x67,
+	0xa0, 0x1e, 0xf4, 0xf2, 0x50, 0x03, 0xa6, 0xef, 0xda, 0x37, 0x6d, 0xe7, 0x91, 0x2d, 0x1f, 0x43,
+	0xd3, 0x50, 0xbe, 0x66, 0x9a, 0xb2, 0x84, 0x5a, 0x50, 0xef, 0xf9, 0x2e, 0xd6, 0x2c, 0xc3, 0x1e,
+	0xc9, 0x25, 0xd4, 0x06, 0x78, 0xdb, 0xf0, 0x7c, 0xc7, 0x35, 0x86, 0x9a, 0x29, 0x97, 0x17, 0xdf,
+	0x83, 0x76, 0x3c, 0x85, 0x46, 0x4d, 0xa8, 0x6d, 0x3a, 0xfe, 0x5b, 0x8f, 0x0d, 0xcf, 0x97, 0x8f,
+	0x91, 0xf9, 0x9b, 0x8e, 0xbf, 0xe5, 0x62, 0x0f, 0xdb, 0xbe, 0x2c, 0x21, 0x80, 0xa9, 0x77, 0xec,
+	0x35, 0xc3, 0x7b, 0x20, 0x97, 0xd0, 0x1c, 0x2f, 0x82, 0x35, 0x73, 0x83, 0xe7, 0xa5, 0x72, 0x99,
+	0x2c, 0x0f, 0xbe, 0x2a, 0x48, 0x86, 0x66, 0x30, 0x65, 0x7d, 0xeb, 0xae, 0x5c, 0x45, 0x75, 0xa8,
+	0xb2, 0x9f, 0x53, 0x8b, 0x3a, 0xc8, 0xc9, 0x0e, 0x0e, 0xd9, 0x93, 0x1d, 0x22, 0x00, 0xc9, 0xc7,
+	0xc8, 0xc9, 0x78, 0x0b, 0x4d, 0x96, 0xd0, 0x0c, 0x34, 0x22, 0x0d, 0x29, 0xb9, 0x44, 0x00, 0xeb,
+	0xee, 0x78, 0xc8, 0xa5, 0xc7, 0x48, 0x20, 0x49, 0xd4, 0x1a, 0xe1, 0x44, 0x65, 0xf1, 0x3a, 0xd4,
+	0x44, 0x6e, 0x4f, 0xa6, 0x72, 0x16, 0x91, 0x4f, 0xf9, 0x18, 0x9a, 0x85, 0x56, 0xec, 0x31, 0x9e,
+	0x2c, 0x21, 0x04, 0xed, 0xf8, 0x23, 0x51, 0xb9, 0xb4, 0xb8, 0x02, 0x10, 0xda, 0x12, 0x21, 0x67,
+	0xc3, 0xde, 0xd5, 0x4c, 0x43, 0x67, 0xb4, 0x91, 0x21, 0xc2, 0x5d, 0xca, 0x1d, 0xd6, 0x8a, 0x91,
+	0x4b, 0x8b, 0x67, 0xa1, 0x26, 0xb4, 0x9c, 0xc0, 0x55, 0x6c, 0x39, 0xbb, 0x98, 0x49, 0xa6, 0x87,
+	0x7d, 0x59, 0x5a, 0xf9, 0x7e, 0x0b, 0x80, 0x35, 0x5d, 0x1c, 0xc7, 0xd5, 0xd1, 0x18, 0xd0, 0x3a,
+	0xf6, 0x49, 0x41, 0xe9, 0xd8, 0xa2, 0x18, 0xf4, 0xd0, 0xe5, 0xfc, 0x47, 0x90, 0x89, 0xa9, 0xfc,
+	0xfc, 0xdd, 0xbc, 0xe6, 0x74, 0x62, 0xba, 0x72, 0x0c, 0x59, 0x14, 0x23, 0xb9, 0x48, 0xbe, 0x63,
+	0x0c, 0x1f, 0x04, 0xdd, 0x9a, 0x7c, 0x8c, 0x89, 0xa9, 0x02, 0x63, 0xa2, 0x30, 0xe3, 0x1f, 0x3d,
+	0xdf, 0x35, 0xec, 0x91, 0xc8, 0xc3, 0x94, 0x63, 0xe8, 0x61, 0xe2, 0xd1, 0xa7, 0x40, 0xb8, 0x52,
+	0xe4, 0x9d, 0xe7, 0xe1, 0x50, 0x9a, 0x30, 0x93, 0x78, 0x21, 0x8e, 0x16, 0xb3, 0x9f, 0x1a, 0x65,
+	0xbd, 0x66, 0xef, 0x5e, 0x2a, 0x34, 0x37, 0xc0, 0x66, 0x40, 0x3b, 0xfe, 0x0a, 0x1a, 0xfd, 0x4f,
+	0xde, 0x06, 0xa9, 0xc7, 0x8e, 0xdd, 0xc5, 0x22, 0x53, 0x03, 0x54, 0xf7, 0x99, 0x92, 0x1e, 0x84,
+	0x2a, 0xf3, 0xa1, 0x69, 0x77, 0xbf, 0x14, 0x58, 0x39, 0x86, 0xbe, 0x04, 0xb3, 0xa9, 0x27, 0x99,
+	0xe8, 0xc5, 0xec, 0x8e, 0x7c, 0xf6, 0xcb, 0xcd, 0x83, 0x30, 0xdc, 0x4f, 0x9a, 0x58, 0x3e, 0xf5,
+	0xa9, 0xe7, 0xcb, 0xc5, 0xa9, 0x8f, 0x6c, 0xbf, 0x1f, 0xf5, 0x4f, 0x8c, 0x61, 0x42, 0xcd, 0x26,
+	0xd9, 0xfe, 0x7b, 0x29, 0x0b, 0x45, 0xee, 0xbb, 0xd0, 0xee, 0x52, 0xd1, 0xe9, 0x51, 0xed, 0x8a,
+	0x3f, 0x3d, 0xcc, 0x66, 0x5a, 0xe6, 0x73, 0xc9, 0xee, 0x62, 0x91, 0xa9, 0x01, 0xaa, 0x3b, 0x31,
+	0x17, 0x8b, 0x2e, 0xe6, 0x09, 0x27, 0x7e, 0x29, 0x70, 0x10, 0xdf, 0xbe, 0x0c, 0x88, 0xd9, 0x8e,
+	0xbd, 0x6d, 0x8c, 0x26, 0xae, 0xc6, 0x14, 0x2b, 0xcf, 0xdd, 0xa4, 0xa7, 0x0a, 0x34, 0x2f, 0x3f,
+	0xc1, 0x8a, 0xe0, 0x48, 0x7d, 0x80, 0x75, 0xec, 0xdf, 0xc6, 0xbe, 0x6b, 0x0c, 0xbd, 0xe4, 0x89,
+	0xf8, 0x47, 0x38, 0x41, 0xa0, 0x7a, 0xe1, 0xc0, 0x79, 0x01, 0x82, 0x01, 0x34, 0xd6, 0xb1, 0xcf,
+	0x73, 0x2b, 0x0f, 0xe5, 0xae, 0x14, 0x33, 0x04, 0x8a, 0x85, 0x83, 0x27, 0x46, 0xdd, 0x59, 0xe2,
+	0x19, 0x26, 0xca, 0x15, 0x6c, 0xfa, 0x71, 0x68, 0xf7, 0x52, 0xa1, 0xb9, 0x02, 0xdb, 0xca, 0xcf,
+	0xdb, 0x50, 0xa7, 0xf1, 0x89, 0x04, 0xd3, 0xff, 0x86, 0xa7, 0xa7, 0x10, 0x9e, 0xde, 0x85, 0x99,
+	0xc4, 0xab, 0xbe, 0x6c, 0x79, 0x66, 0x3f, 0xfd, 0x2b, 0xe0, 0x65, 0xe3, 0x2f, 0xee, 0xb2, 0x1d,
+	0x46, 0xe6, 0xab, 0xbc, 0x83, 0xf6, 0xbe, 0xc7, 0x1e, 0xc4, 0x06, 0x4d, 0xad, 0x17, 0x72, 0xcb,
+	0x8f, 0xf8, 0x9d, 0xe7, 0xc7, 0xef, 0xbd, 0x9f, 0x7e, 0x74, 0x7b, 0x17, 0x66, 0x12, 0x2f, 0x4f,
+	0xb2, 0xa5, 0x9a, 0xfd, 0x3c, 0xe5, 0xa0, 0xdd, 0x3f, 0xc2, 0x30, 0xa0, 0xc3, 0x5c, 0xc6, 0xa3,
+	0x00, 0xb4, 0x94, 0x57, 0x9d, 0x64, 0xbf, 0x1e, 0x38, 0xf8, 0x40, 0xad, 0x98, 0x29, 0xa1, 0x85,
+	0x3c, 0x22, 0x93, 0x7f, 0xfa, 0xe9, 0xbe, 0x58, 0xec, 0x1f, 0x42, 0xc1, 0x81, 0x7a, 0x30, 0xc5,
+	0xde, 0xa3, 0xa0, 0xe7, 0x32, 0xcf, 0x10, 0x7d, 0xab, 0xd2, 0x3d, 0xe8, 0x45, 0x8b, 0x37, 0x31,
+	0x7d, 0x8f, 0x6e, 0x5a, 0xa5, 0x5e, 0x12, 0x65, 0x3e, 0xa4, 0x8a, 0x3e, 0x22, 0xe9, 0x1e, 0xfc,
+	0x6e, 0x44, 0x6c, 0xfa, 0x9f, 0x1d, 0x2b, 0x1f, 0xc3, 0x5c, 0x46, 0xcb, 0x16, 0xe5, 0xe5, 0x44,
+	0x39, 0xcd, 0xe2, 0xee, 0x72, 0xe1, 0xf9, 0x01, 0xe6, 0x2f, 0x82, 0x9c, 0xac, 0xfa, 0xd1, 0xa5,
+	0x3c, 0x7d, 0xce, 0xc2, 0xb9, 0xbf, 0x32, 0x5f, 0x7f, 0xe5, 0xfe, 0xca, 0xc8, 0xf0, 0x77, 0x26,
+	0x03, 0x32, 0xb2, 0xcc, 0xa6, 0xbe, 0x64, 0x38, 0xfc, 0xd7, 0xb2, 0xe0, 0xff, 0x32, 0x5d, 0xbd,
+	0x4c, 0x51, 0x8d, 0x07, 0x83, 0x29, 0xfa, 0x79, 0xe5, 0xdf, 0x03, 0x00, 0xb6, 0xdd, 0xdc, 0x4c,
+	0xcc, 0x3b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
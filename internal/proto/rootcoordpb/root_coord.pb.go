@@ -9,7 +9,10 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus/api/commonpb"
 	milvuspb "github.com/milvus-io/milvus/api/milvuspb"
+	schemapb "github.com/milvus-io/milvus/api/schemapb"
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
 	etcdpb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	indexpb "github.com/milvus-io/milvus/internal/proto/indexpb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
 	grpc "google.golang.org/grpc"
@@ -29,6 +32,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type DDLJobState int32
+
+const (
+	DDLJobState_DDLJobPending   DDLJobState = 0
+	DDLJobState_DDLJobRunning   DDLJobState = 1
+	DDLJobState_DDLJobSucceeded DDLJobState = 2
+	DDLJobState_DDLJobFailed    DDLJobState = 3
+)
+
+var DDLJobState_name = map[int32]string{
+	0: "DDLJobPending",
+	1: "DDLJobRunning",
+	2: "DDLJobSucceeded",
+	3: "DDLJobFailed",
+}
+
+var DDLJobState_value = map[string]int32{
+	"DDLJobPending":   0,
+	"DDLJobRunning":   1,
+	"DDLJobSucceeded": 2,
+	"DDLJobFailed":    3,
+}
+
+func (x DDLJobState) String() string {
+	return proto.EnumName(DDLJobState_name, int32(x))
+}
+
+func (DDLJobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
+type DDLStepState int32
+
+const (
+	DDLStepState_DDLStepPending DDLStepState = 0
+	DDLStepState_DDLStepRunning DDLStepState = 1
+	// the step failed and will be rescheduled.
+	DDLStepState_DDLStepRetrying DDLStepState = 2
+	DDLStepState_DDLStepDone     DDLStepState = 3
+	DDLStepState_DDLStepFailed   DDLStepState = 4
+)
+
+var DDLStepState_name = map[int32]string{
+	0: "DDLStepPending",
+	1: "DDLStepRunning",
+	2: "DDLStepRetrying",
+	3: "DDLStepDone",
+	4: "DDLStepFailed",
+}
+
+var DDLStepState_value = map[string]int32{
+	"DDLStepPending":  0,
+	"DDLStepRunning":  1,
+	"DDLStepRetrying": 2,
+	"DDLStepDone":     3,
+	"DDLStepFailed":   4,
+}
+
+func (x DDLStepState) String() string {
+	return proto.EnumName(DDLStepState_name, int32(x))
+}
+
+func (DDLStepState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{1}
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -655,7 +724,554 @@ func (m *GetCredentialResponse) GetPassword() string {
 	return ""
 }
 
+type BackupPartition struct {
+	PartitionID          int64    `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	PartitionName        string   `protobuf:"bytes,2,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupPartition) Reset()         { *m = BackupPartition{} }
+func (m *BackupPartition) String() string { return proto.CompactTextString(m) }
+func (*BackupPartition) ProtoMessage()    {}
+func (*BackupPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
+
+func (m *BackupPartition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupPartition.Unmarshal(m, b)
+}
+func (m *BackupPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupPartition.Marshal(b, m, deterministic)
+}
+func (m *BackupPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupPartition.Merge(m, src)
+}
+func (m *BackupPartition) XXX_Size() int {
+	return xxx_messageInfo_BackupPartition.Size(m)
+}
+func (m *BackupPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupPartition proto.InternalMessageInfo
+
+func (m *BackupPartition) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *BackupPartition) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+// BackupMeta is persisted next to the copied binlogs, it holds everything needed to rebuild the collection.
+type BackupMeta struct {
+	Info                 *milvuspb.BackupInfo       `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum            int32                      `protobuf:"varint,3,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel  `protobuf:"varint,4,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	Partitions           []*BackupPartition         `protobuf:"bytes,5,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Indexes              []*indexpb.IndexInfo       `protobuf:"bytes,6,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Segments             []*datapb.SegmentInfo      `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *BackupMeta) Reset()         { *m = BackupMeta{} }
+func (m *BackupMeta) String() string { return proto.CompactTextString(m) }
+func (*BackupMeta) ProtoMessage()    {}
+func (*BackupMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
+}
+
+func (m *BackupMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupMeta.Unmarshal(m, b)
+}
+func (m *BackupMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupMeta.Marshal(b, m, deterministic)
+}
+func (m *BackupMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupMeta.Merge(m, src)
+}
+func (m *BackupMeta) XXX_Size() int {
+	return xxx_messageInfo_BackupMeta.Size(m)
+}
+func (m *BackupMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupMeta proto.InternalMessageInfo
+
+func (m *BackupMeta) GetInfo() *milvuspb.BackupInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *BackupMeta) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *BackupMeta) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *BackupMeta) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *BackupMeta) GetPartitions() []*BackupPartition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *BackupMeta) GetIndexes() []*indexpb.IndexInfo {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *BackupMeta) GetSegments() []*datapb.SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type DDLStepInfo struct {
+	Desc  string       `protobuf:"bytes,1,opt,name=desc,proto3" json:"desc,omitempty"`
+	State DDLStepState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.rootcoord.DDLStepState" json:"state,omitempty"`
+	// async steps are executed in background after the job returns.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+	// undo steps roll back the executed steps of a failed job.
+	Undo       bool  `protobuf:"varint,4,opt,name=undo,proto3" json:"undo,omitempty"`
+	RetryTimes int64 `protobuf:"varint,5,opt,name=retry_times,json=retryTimes,proto3" json:"retry_times,omitempty"`
+	// utc time in milliseconds.
+	StartTime            uint64   `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              uint64   `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	LastError            string   `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DDLStepInfo) Reset()         { *m = DDLStepInfo{} }
+func (m *DDLStepInfo) String() string { return proto.CompactTextString(m) }
+func (*DDLStepInfo) ProtoMessage()    {}
+func (*DDLStepInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
+}
+
+func (m *DDLStepInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DDLStepInfo.Unmarshal(m, b)
+}
+func (m *DDLStepInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DDLStepInfo.Marshal(b, m, deterministic)
+}
+func (m *DDLStepInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDLStepInfo.Merge(m, src)
+}
+func (m *DDLStepInfo) XXX_Size() int {
+	return xxx_messageInfo_DDLStepInfo.Size(m)
+}
+func (m *DDLStepInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDLStepInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DDLStepInfo proto.InternalMessageInfo
+
+func (m *DDLStepInfo) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *DDLStepInfo) GetState() DDLStepState {
+	if m != nil {
+		return m.State
+	}
+	return DDLStepState_DDLStepPending
+}
+
+func (m *DDLStepInfo) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *DDLStepInfo) GetUndo() bool {
+	if m != nil {
+		return m.Undo
+	}
+	return false
+}
+
+func (m *DDLStepInfo) GetRetryTimes() int64 {
+	if m != nil {
+		return m.RetryTimes
+	}
+	return 0
+}
+
+func (m *DDLStepInfo) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *DDLStepInfo) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *DDLStepInfo) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type DDLJobInfo struct {
+	JobID          int64       `protobuf:"varint,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	JobType        string      `protobuf:"bytes,2,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	CollectionName string      `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	State          DDLJobState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.rootcoord.DDLJobState" json:"state,omitempty"`
+	Timestamp      uint64      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// utc time in milliseconds.
+	StartTime            uint64         `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              uint64         `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	LastError            string         `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Steps                []*DDLStepInfo `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DDLJobInfo) Reset()         { *m = DDLJobInfo{} }
+func (m *DDLJobInfo) String() string { return proto.CompactTextString(m) }
+func (*DDLJobInfo) ProtoMessage()    {}
+func (*DDLJobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{14}
+}
+
+func (m *DDLJobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DDLJobInfo.Unmarshal(m, b)
+}
+func (m *DDLJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DDLJobInfo.Marshal(b, m, deterministic)
+}
+func (m *DDLJobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDLJobInfo.Merge(m, src)
+}
+func (m *DDLJobInfo) XXX_Size() int {
+	return xxx_messageInfo_DDLJobInfo.Size(m)
+}
+func (m *DDLJobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDLJobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DDLJobInfo proto.InternalMessageInfo
+
+func (m *DDLJobInfo) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *DDLJobInfo) GetJobType() string {
+	if m != nil {
+		return m.JobType
+	}
+	return ""
+}
+
+func (m *DDLJobInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DDLJobInfo) GetState() DDLJobState {
+	if m != nil {
+		return m.State
+	}
+	return DDLJobState_DDLJobPending
+}
+
+func (m *DDLJobInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DDLJobInfo) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *DDLJobInfo) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *DDLJobInfo) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *DDLJobInfo) GetSteps() []*DDLStepInfo {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+type ListDDLJobsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// empty means all collections.
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// empty means all states.
+	States []DDLJobState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=milvus.proto.rootcoord.DDLJobState" json:"states,omitempty"`
+	// 0 means no limit, the latest jobs come first.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDDLJobsRequest) Reset()         { *m = ListDDLJobsRequest{} }
+func (m *ListDDLJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDDLJobsRequest) ProtoMessage()    {}
+func (*ListDDLJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{15}
+}
+
+func (m *ListDDLJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDDLJobsRequest.Unmarshal(m, b)
+}
+func (m *ListDDLJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDDLJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDDLJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDDLJobsRequest.Merge(m, src)
+}
+func (m *ListDDLJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDDLJobsRequest.Size(m)
+}
+func (m *ListDDLJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDDLJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDDLJobsRequest proto.InternalMessageInfo
+
+func (m *ListDDLJobsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListDDLJobsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ListDDLJobsRequest) GetStates() []DDLJobState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ListDDLJobsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListDDLJobsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// steps are not included, use DescribeDDLJob to get them.
+	Jobs                 []*DDLJobInfo `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListDDLJobsResponse) Reset()         { *m = ListDDLJobsResponse{} }
+func (m *ListDDLJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDDLJobsResponse) ProtoMessage()    {}
+func (*ListDDLJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{16}
+}
+
+func (m *ListDDLJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDDLJobsResponse.Unmarshal(m, b)
+}
+func (m *ListDDLJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDDLJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDDLJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDDLJobsResponse.Merge(m, src)
+}
+func (m *ListDDLJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDDLJobsResponse.Size(m)
+}
+func (m *ListDDLJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDDLJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDDLJobsResponse proto.InternalMessageInfo
+
+func (m *ListDDLJobsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDDLJobsResponse) GetJobs() []*DDLJobInfo {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type DescribeDDLJobRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID                int64             `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeDDLJobRequest) Reset()         { *m = DescribeDDLJobRequest{} }
+func (m *DescribeDDLJobRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeDDLJobRequest) ProtoMessage()    {}
+func (*DescribeDDLJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{17}
+}
+
+func (m *DescribeDDLJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeDDLJobRequest.Unmarshal(m, b)
+}
+func (m *DescribeDDLJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeDDLJobRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeDDLJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeDDLJobRequest.Merge(m, src)
+}
+func (m *DescribeDDLJobRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeDDLJobRequest.Size(m)
+}
+func (m *DescribeDDLJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeDDLJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeDDLJobRequest proto.InternalMessageInfo
+
+func (m *DescribeDDLJobRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DescribeDDLJobRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type DescribeDDLJobResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Job                  *DDLJobInfo      `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DescribeDDLJobResponse) Reset()         { *m = DescribeDDLJobResponse{} }
+func (m *DescribeDDLJobResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeDDLJobResponse) ProtoMessage()    {}
+func (*DescribeDDLJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{18}
+}
+
+func (m *DescribeDDLJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeDDLJobResponse.Unmarshal(m, b)
+}
+func (m *DescribeDDLJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeDDLJobResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeDDLJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeDDLJobResponse.Merge(m, src)
+}
+func (m *DescribeDDLJobResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeDDLJobResponse.Size(m)
+}
+func (m *DescribeDDLJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeDDLJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeDDLJobResponse proto.InternalMessageInfo
+
+func (m *DescribeDDLJobResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeDDLJobResponse) GetJob() *DDLJobInfo {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("milvus.proto.rootcoord.DDLJobState", DDLJobState_name, DDLJobState_value)
+	proto.RegisterEnum("milvus.proto.rootcoord.DDLStepState", DDLStepState_name, DDLStepState_value)
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
@@ -669,106 +1285,161 @@ func init() {
 	proto.RegisterMapType((map[int64]*SegmentInfos)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse.SegmentInfosEntry")
 	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
 	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+	proto.RegisterType((*BackupPartition)(nil), "milvus.proto.rootcoord.BackupPartition")
+	proto.RegisterType((*BackupMeta)(nil), "milvus.proto.rootcoord.BackupMeta")
+	proto.RegisterType((*DDLStepInfo)(nil), "milvus.proto.rootcoord.DDLStepInfo")
+	proto.RegisterType((*DDLJobInfo)(nil), "milvus.proto.rootcoord.DDLJobInfo")
+	proto.RegisterType((*ListDDLJobsRequest)(nil), "milvus.proto.rootcoord.ListDDLJobsRequest")
+	proto.RegisterType((*ListDDLJobsResponse)(nil), "milvus.proto.rootcoord.ListDDLJobsResponse")
+	proto.RegisterType((*DescribeDDLJobRequest)(nil), "milvus.proto.rootcoord.DescribeDDLJobRequest")
+	proto.RegisterType((*DescribeDDLJobResponse)(nil), "milvus.proto.rootcoord.DescribeDDLJobResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0x13, 0x37,
-	0x14, 0xc6, 0x36, 0x49, 0xec, 0x63, 0xc7, 0x0e, 0x1a, 0x2e, 0xae, 0xa1, 0xd4, 0xb8, 0x14, 0xcc,
-	0xcd, 0xa1, 0x61, 0x86, 0x52, 0xde, 0x88, 0xcd, 0x04, 0x4f, 0x9b, 0x21, 0x5d, 0x43, 0x87, 0x5e,
-	0x18, 0x57, 0xde, 0x15, 0x8e, 0x26, 0xeb, 0x95, 0x59, 0xc9, 0xb9, 0x4c, 0x9f, 0x3a, 0xd3, 0xf7,
-	0xfe, 0xa5, 0x4e, 0xfb, 0x53, 0xfa, 0x47, 0x3a, 0x5a, 0xed, 0xca, 0xbb, 0xeb, 0x5d, 0x67, 0x03,
-	0xbc, 0x59, 0xda, 0x4f, 0xdf, 0x77, 0xf4, 0x49, 0x47, 0x47, 0x32, 0x6c, 0xb8, 0x8c, 0x89, 0xa1,
-	0xc9, 0x98, 0x6b, 0x75, 0xa6, 0x2e, 0x13, 0x0c, 0x5d, 0x9e, 0x50, 0xfb, 0x70, 0xc6, 0x55, 0xab,
-	0x23, 0x3f, 0x7b, 0x5f, 0x1b, 0x15, 0x93, 0x4d, 0x26, 0xcc, 0x51, 0xfd, 0x8d, 0x4a, 0x18, 0xd5,
-	0xa8, 0x52, 0x47, 0x10, 0xd7, 0xc1, 0xb6, 0xdf, 0x2e, 0x4f, 0x5d, 0x76, 0x7c, 0xe2, 0x37, 0x6a,
-	0x44, 0x98, 0xd6, 0x70, 0x42, 0x04, 0x56, 0x1d, 0xad, 0x21, 0x5c, 0x7a, 0x66, 0xdb, 0xcc, 0x7c,
-	0x45, 0x27, 0x84, 0x0b, 0x3c, 0x99, 0x1a, 0xe4, 0xfd, 0x8c, 0x70, 0x81, 0x1e, 0xc2, 0xf9, 0x11,
-	0xe6, 0xa4, 0x9e, 0x6b, 0xe6, 0xda, 0xe5, 0xad, 0x6b, 0x9d, 0x48, 0x24, 0xbe, 0xfc, 0x2e, 0x1f,
-	0x6f, 0x63, 0x4e, 0x0c, 0x0f, 0x89, 0x2e, 0xc2, 0x8a, 0xc9, 0x66, 0x8e, 0xa8, 0x17, 0x9a, 0xb9,
-	0xf6, 0xba, 0xa1, 0x1a, 0xad, 0x3f, 0x72, 0x70, 0x39, 0xae, 0xc0, 0xa7, 0xcc, 0xe1, 0x04, 0x3d,
-	0x82, 0x55, 0x2e, 0xb0, 0x98, 0x71, 0x5f, 0xe4, 0x6a, 0xa2, 0xc8, 0xc0, 0x83, 0x18, 0x3e, 0x14,
-	0x5d, 0x83, 0x92, 0x08, 0x98, 0xea, 0xf9, 0x66, 0xae, 0x7d, 0xde, 0x98, 0x77, 0xa4, 0xc4, 0xf0,
-	0x06, 0xaa, 0x5e, 0x08, 0xfd, 0xde, 0x27, 0x98, 0x5d, 0x3e, 0xcc, 0x6c, 0x43, 0x4d, 0x33, 0x7f,
-	0xcc, 0xac, 0xaa, 0x90, 0xef, 0xf7, 0x3c, 0xea, 0x82, 0x91, 0xef, 0xf7, 0x52, 0xe6, 0xf1, 0x4f,
-	0x1e, 0x2a, 0xfd, 0xc9, 0x94, 0xb9, 0xc2, 0x20, 0x7c, 0x66, 0x8b, 0x0f, 0xd3, 0xba, 0x02, 0x6b,
-	0x02, 0xf3, 0x83, 0x21, 0xb5, 0x7c, 0xc1, 0x55, 0xd9, 0xec, 0x5b, 0xe8, 0x0b, 0x28, 0x5b, 0x58,
-	0x60, 0x87, 0x59, 0x44, 0x7e, 0x2c, 0x78, 0x1f, 0x21, 0xe8, 0xea, 0x5b, 0xe8, 0x31, 0xac, 0x48,
-	0x0e, 0x52, 0x3f, 0xdf, 0xcc, 0xb5, 0xab, 0x5b, 0xcd, 0x44, 0x35, 0x15, 0xa0, 0xd4, 0x24, 0x86,
-	0x82, 0xa3, 0x06, 0x14, 0x39, 0x19, 0x4f, 0x88, 0x23, 0x78, 0x7d, 0xa5, 0x59, 0x68, 0x17, 0x0c,
-	0xdd, 0x46, 0x9f, 0x41, 0x11, 0xcf, 0x04, 0x1b, 0x52, 0x8b, 0xd7, 0x57, 0xbd, 0x6f, 0x6b, 0xb2,
-	0xdd, 0xb7, 0x38, 0xba, 0x0a, 0x25, 0x97, 0x1d, 0x0d, 0x95, 0x11, 0x6b, 0x5e, 0x34, 0x45, 0x97,
-	0x1d, 0x75, 0x65, 0x1b, 0x7d, 0x03, 0x2b, 0xd4, 0x79, 0xc7, 0x78, 0xbd, 0xd8, 0x2c, 0xb4, 0xcb,
-	0x5b, 0x37, 0x12, 0x63, 0xf9, 0x8e, 0x9c, 0xfc, 0x88, 0xed, 0x19, 0xd9, 0xc3, 0xd4, 0x35, 0x14,
-	0xbe, 0xf5, 0x57, 0x0e, 0xae, 0xf4, 0x08, 0x37, 0x5d, 0x3a, 0x22, 0x03, 0x3f, 0x8a, 0x0f, 0xdf,
-	0x16, 0x2d, 0xa8, 0x98, 0xcc, 0xb6, 0x89, 0x29, 0x28, 0x73, 0xf4, 0x12, 0x46, 0xfa, 0xd0, 0x75,
-	0x00, 0x7f, 0xba, 0xfd, 0x1e, 0xaf, 0x17, 0xbc, 0x49, 0x86, 0x7a, 0x5a, 0x33, 0xa8, 0xf9, 0x81,
-	0x48, 0xe2, 0xbe, 0xf3, 0x8e, 0x2d, 0xd0, 0xe6, 0x12, 0x68, 0x9b, 0x50, 0x9e, 0x62, 0x57, 0xd0,
-	0x88, 0x72, 0xb8, 0x4b, 0xe6, 0x8a, 0x96, 0xf1, 0x97, 0x73, 0xde, 0xd1, 0xfa, 0x2f, 0x0f, 0x15,
-	0x5f, 0x57, 0x6a, 0x72, 0xd4, 0x83, 0x92, 0x9c, 0xd3, 0x50, 0xfa, 0xe4, 0x5b, 0x70, 0xbb, 0x93,
-	0x7c, 0x02, 0x75, 0x62, 0x01, 0x1b, 0xc5, 0x51, 0x10, 0x7a, 0x0f, 0xca, 0xd4, 0xb1, 0xc8, 0xf1,
-	0x50, 0x2d, 0x4f, 0xde, 0x5b, 0x9e, 0x2f, 0xa3, 0x3c, 0xf2, 0x14, 0xea, 0x68, 0x6d, 0x8b, 0x1c,
-	0x7b, 0x1c, 0x40, 0x83, 0x9f, 0x1c, 0x11, 0xb8, 0x40, 0x8e, 0x85, 0x8b, 0x87, 0x61, 0xae, 0x82,
-	0xc7, 0xf5, 0xed, 0x29, 0x31, 0x79, 0x04, 0x9d, 0xe7, 0x72, 0xb4, 0xe6, 0xe6, 0xcf, 0x1d, 0xe1,
-	0x9e, 0x18, 0x35, 0x12, 0xed, 0x6d, 0xfc, 0x06, 0x17, 0x93, 0x80, 0x68, 0x03, 0x0a, 0x07, 0xe4,
-	0xc4, 0xb7, 0x5d, 0xfe, 0x44, 0x5b, 0xb0, 0x72, 0x28, 0xb7, 0x92, 0xe7, 0xf3, 0xc2, 0xde, 0xf0,
-	0x26, 0x34, 0x9f, 0x89, 0x82, 0x3e, 0xcd, 0x3f, 0xc9, 0xb5, 0xfe, 0xcd, 0x43, 0x7d, 0x71, 0xbb,
-	0x7d, 0xcc, 0x59, 0x91, 0x65, 0xcb, 0x8d, 0x61, 0xdd, 0x5f, 0xe8, 0x88, 0x75, 0xdb, 0x69, 0xd6,
-	0xa5, 0x45, 0x18, 0xf1, 0x54, 0x79, 0x58, 0xe1, 0xa1, 0xae, 0x06, 0x81, 0x0b, 0x0b, 0x90, 0x04,
-	0xf7, 0x9e, 0x46, 0xdd, 0xbb, 0x99, 0x65, 0x09, 0xc3, 0x2e, 0x5a, 0x70, 0x71, 0x87, 0x88, 0xae,
-	0x4b, 0x2c, 0xe2, 0x08, 0x8a, 0xed, 0x0f, 0x4f, 0xd8, 0x06, 0x14, 0x67, 0x5c, 0xd6, 0xc7, 0x89,
-	0x0a, 0xa6, 0x64, 0xe8, 0x76, 0xeb, 0xcf, 0x1c, 0x5c, 0x8a, 0xc9, 0x7c, 0xcc, 0x42, 0x2d, 0x91,
-	0x92, 0xdf, 0xa6, 0x98, 0xf3, 0x23, 0xe6, 0xaa, 0x83, 0xb6, 0x64, 0xe8, 0xf6, 0xd6, 0xdf, 0xd7,
-	0xa1, 0x64, 0x30, 0x26, 0xba, 0xd2, 0x12, 0x34, 0x05, 0x24, 0x63, 0x62, 0x93, 0x29, 0x73, 0x88,
-	0xa3, 0x0e, 0x56, 0x8e, 0x1e, 0x46, 0x03, 0xd0, 0x35, 0x7f, 0x11, 0xea, 0x5b, 0xd5, 0xb8, 0x95,
-	0x32, 0x22, 0x06, 0x6f, 0x9d, 0x43, 0x13, 0x4f, 0x51, 0xd6, 0xeb, 0x57, 0xd4, 0x3c, 0xe8, 0xee,
-	0x63, 0xc7, 0x21, 0xf6, 0x32, 0xc5, 0x18, 0x34, 0x50, 0x8c, 0x25, 0xbd, 0xdf, 0x18, 0x08, 0x97,
-	0x3a, 0xe3, 0xc0, 0xd9, 0xd6, 0x39, 0xf4, 0xde, 0x5b, 0x5b, 0xa9, 0x4e, 0xb9, 0xa0, 0x26, 0x0f,
-	0x04, 0xb7, 0xd2, 0x05, 0x17, 0xc0, 0x67, 0x94, 0x1c, 0xc2, 0x46, 0xd7, 0x25, 0x58, 0x90, 0xae,
-	0x4e, 0x1a, 0x74, 0x3f, 0x71, 0x68, 0x1c, 0x16, 0x08, 0x2d, 0xdb, 0x00, 0xad, 0x73, 0xe8, 0x17,
-	0xa8, 0xf6, 0x5c, 0x36, 0x0d, 0xd1, 0xdf, 0x4d, 0xa4, 0x8f, 0x82, 0x32, 0x92, 0x0f, 0x61, 0xfd,
-	0x05, 0xe6, 0x21, 0xee, 0x3b, 0x89, 0xdc, 0x11, 0x4c, 0x40, 0x7d, 0x23, 0x11, 0xba, 0xcd, 0x98,
-	0x1d, 0xb2, 0xe7, 0x08, 0x50, 0x70, 0x20, 0x84, 0x54, 0x3a, 0xc9, 0x33, 0x58, 0x00, 0x06, 0x52,
-	0x9b, 0x99, 0xf1, 0x5a, 0xf8, 0x35, 0x94, 0x95, 0xe1, 0xcf, 0x6c, 0x8a, 0x39, 0xba, 0xbd, 0x64,
-	0x49, 0x3c, 0x44, 0x46, 0xc3, 0x7e, 0x80, 0x92, 0x34, 0x5a, 0x91, 0x7e, 0x95, 0xba, 0x10, 0x67,
-	0xa1, 0x1c, 0x00, 0x3c, 0xb3, 0x05, 0x71, 0x15, 0xe7, 0xad, 0x44, 0xce, 0x39, 0x20, 0x23, 0xa9,
-	0x03, 0xb5, 0xc1, 0xbe, 0xbc, 0xe0, 0x04, 0xd6, 0x70, 0x74, 0x2f, 0x79, 0x43, 0x47, 0x51, 0x01,
-	0xfd, 0xfd, 0x6c, 0x60, 0x6d, 0xf7, 0x5b, 0xa8, 0x29, 0x33, 0xf7, 0x82, 0x4b, 0x43, 0x8a, 0x5e,
-	0x0c, 0x95, 0x71, 0x3a, 0x3f, 0xc1, 0xba, 0xb4, 0x75, 0x4e, 0x7e, 0x27, 0xd5, 0xfa, 0xb3, 0x52,
-	0xbf, 0x85, 0xca, 0x0b, 0xcc, 0xe7, 0xcc, 0xed, 0xb4, 0x0c, 0x58, 0x20, 0xce, 0x94, 0x00, 0x07,
-	0x50, 0x95, 0xae, 0xe9, 0xc1, 0x3c, 0x25, 0x7d, 0xa3, 0xa0, 0x40, 0xe2, 0x5e, 0x26, 0xac, 0x16,
-	0x23, 0x50, 0x91, 0xdf, 0x82, 0xd2, 0x9b, 0x32, 0x97, 0x30, 0x24, 0x10, 0xba, 0x93, 0x01, 0x19,
-	0x3a, 0x66, 0xab, 0xd1, 0x77, 0x18, 0x7a, 0x90, 0x56, 0x85, 0x13, 0x5f, 0x84, 0x8d, 0x4e, 0x56,
-	0xb8, 0x96, 0xfc, 0x15, 0xd6, 0xfc, 0xd7, 0x51, 0x3c, 0x43, 0x62, 0x83, 0xf5, 0xc3, 0xac, 0x71,
-	0xfb, 0x54, 0x9c, 0x66, 0xc7, 0x70, 0xe9, 0xf5, 0xd4, 0x92, 0xa7, 0xb3, 0xaa, 0x01, 0x41, 0x15,
-	0x8a, 0x6f, 0xb3, 0x79, 0xa5, 0x8b, 0xe2, 0x76, 0xf9, 0xf8, 0xb4, 0x6d, 0xe6, 0xc2, 0xe7, 0x7d,
-	0xe7, 0x10, 0xdb, 0xd4, 0x8a, 0x14, 0x81, 0x5d, 0x22, 0x70, 0x17, 0x9b, 0xfb, 0x24, 0x5e, 0xa3,
-	0xd4, 0x53, 0x3b, 0x3a, 0x44, 0x83, 0x33, 0x6e, 0xed, 0xdf, 0x01, 0xa9, 0x8c, 0x75, 0xde, 0xd1,
-	0xf1, 0xcc, 0xc5, 0x6a, 0xff, 0xa5, 0x55, 0xdf, 0x45, 0x68, 0x20, 0xf3, 0xf5, 0x19, 0x46, 0x84,
-	0x0a, 0x23, 0xec, 0x10, 0xb1, 0x4b, 0x84, 0x4b, 0xcd, 0xb4, 0x63, 0x6d, 0x0e, 0x48, 0x59, 0xb4,
-	0x04, 0x9c, 0x16, 0x18, 0xc0, 0xaa, 0x7a, 0x20, 0xa2, 0x56, 0xe2, 0xa0, 0xe0, 0x79, 0xbb, 0xac,
-	0x9c, 0xeb, 0x27, 0x70, 0x28, 0x5d, 0x77, 0x88, 0x08, 0x3d, 0x3c, 0x53, 0xd2, 0x35, 0x0a, 0x5a,
-	0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa0, 0xf6, 0x3d, 0xe5, 0xfe, 0xc7, 0x57, 0x98, 0x1f, 0xa4,
-	0x1d, 0xd2, 0x31, 0xd4, 0xf2, 0x43, 0x7a, 0x01, 0x1c, 0x72, 0xac, 0x62, 0x10, 0xf9, 0xc1, 0xf7,
-	0x2d, 0xf5, 0xee, 0x1c, 0xfe, 0x67, 0xe0, 0xb4, 0x4d, 0xf6, 0x46, 0x5f, 0x80, 0xf4, 0x5d, 0x37,
-	0x5e, 0x18, 0xe7, 0x69, 0xa3, 0x21, 0xf2, 0x5a, 0x9e, 0x81, 0xd9, 0xcf, 0xca, 0x4f, 0xcd, 0x3c,
-	0x84, 0x8d, 0x1e, 0xb1, 0x49, 0x84, 0xf9, 0x7e, 0xca, 0x1d, 0x23, 0x0a, 0xcb, 0x98, 0x79, 0xfb,
-	0xb0, 0x2e, 0x97, 0x41, 0x8e, 0x7b, 0xcd, 0x89, 0xcb, 0x53, 0xea, 0x55, 0x04, 0x13, 0x50, 0xdf,
-	0xcd, 0x02, 0x0d, 0xed, 0xa1, 0xf5, 0xc8, 0x3b, 0x23, 0x3e, 0x8f, 0xf9, 0xa2, 0x26, 0xbd, 0x7a,
-	0x1a, 0x0f, 0x32, 0xa2, 0x43, 0x7b, 0x08, 0xd4, 0x72, 0x1b, 0xcc, 0x26, 0x29, 0x69, 0x3d, 0x07,
-	0x64, 0xb4, 0xeb, 0x25, 0x14, 0x65, 0xe9, 0xf6, 0x28, 0x6f, 0xa6, 0x56, 0xf6, 0x33, 0x10, 0xbe,
-	0x85, 0xda, 0xcb, 0x29, 0x71, 0xb1, 0x20, 0xd2, 0x2f, 0x8f, 0x37, 0x39, 0xb3, 0x62, 0xa8, 0xcc,
-	0xd7, 0x66, 0x18, 0x10, 0x79, 0x82, 0x2f, 0x31, 0x61, 0x0e, 0x58, 0x7e, 0xb6, 0x85, 0x71, 0xe1,
-	0xc3, 0x53, 0xf5, 0xcb, 0xc0, 0x96, 0x0a, 0x78, 0x91, 0x67, 0x10, 0x50, 0xb8, 0xf0, 0xb3, 0xc5,
-	0x9f, 0xfa, 0x9e, 0x4b, 0x0f, 0xa9, 0x4d, 0xc6, 0x24, 0x25, 0x03, 0xe2, 0xb0, 0x8c, 0x16, 0x8d,
-	0xa0, 0xac, 0x84, 0x77, 0x5c, 0xec, 0x08, 0xb4, 0x2c, 0x34, 0x0f, 0x11, 0xd0, 0xb6, 0x4f, 0x07,
-	0xea, 0x49, 0x98, 0x00, 0x32, 0x2d, 0xf6, 0x98, 0x4d, 0xcd, 0x93, 0xf8, 0x65, 0x47, 0x1f, 0x0d,
-	0x73, 0x48, 0xca, 0x65, 0x27, 0x11, 0x19, 0x88, 0x6c, 0x3f, 0xf9, 0xf9, 0xf1, 0x98, 0x8a, 0xfd,
-	0xd9, 0x48, 0x4e, 0x71, 0x53, 0x0d, 0x7c, 0x40, 0x99, 0xff, 0x6b, 0x33, 0x18, 0xbc, 0xe9, 0x71,
-	0x6d, 0xea, 0x04, 0x9a, 0x8e, 0x46, 0xab, 0x5e, 0xd7, 0xa3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x19, 0x92, 0xda, 0x17, 0x8d, 0x17, 0x00, 0x00,
+	// 2243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x76, 0xdb, 0xc6,
+	0xd1, 0x37, 0x49, 0x51, 0x22, 0x87, 0x14, 0x49, 0x6d, 0x6c, 0x87, 0x1f, 0x13, 0x27, 0x0a, 0x1d,
+	0xd9, 0xb2, 0x6c, 0x53, 0xf9, 0xe4, 0x1e, 0x27, 0x71, 0x4f, 0x2f, 0x6c, 0xd1, 0x55, 0x98, 0xda,
+	0x89, 0x0a, 0xd9, 0x3d, 0xae, 0x5b, 0x1f, 0x16, 0x04, 0xd6, 0x12, 0x24, 0x10, 0x8b, 0x60, 0x97,
+	0x92, 0x79, 0x7a, 0x95, 0xb6, 0xf7, 0x7d, 0x90, 0x5e, 0xf5, 0x15, 0xd2, 0x47, 0xe8, 0x23, 0xf4,
+	0x45, 0x7a, 0x76, 0x17, 0x7f, 0x16, 0x20, 0x00, 0x41, 0x56, 0x7a, 0x87, 0x9d, 0xfd, 0xed, 0xfc,
+	0x76, 0x66, 0x76, 0x66, 0x16, 0x0b, 0x1d, 0x8f, 0x10, 0x36, 0x36, 0x08, 0xf1, 0xcc, 0x81, 0xeb,
+	0x11, 0x46, 0xd0, 0xf5, 0xa9, 0x65, 0x9f, 0xce, 0xa8, 0x1c, 0x0d, 0xf8, 0xb4, 0x98, 0xed, 0x35,
+	0x0d, 0x32, 0x9d, 0x12, 0x47, 0xca, 0x7b, 0x4d, 0x15, 0xd5, 0x6b, 0x59, 0x0e, 0xc3, 0x9e, 0xa3,
+	0xdb, 0xfe, 0xb8, 0xe1, 0x7a, 0xe4, 0xdd, 0x3c, 0x80, 0x52, 0xe3, 0x08, 0x4f, 0x75, 0x7f, 0xd4,
+	0x31, 0x75, 0xa6, 0xab, 0x84, 0xbd, 0x35, 0xcb, 0x31, 0xf1, 0xbb, 0x98, 0xa8, 0x8d, 0x99, 0x61,
+	0x8e, 0xa7, 0x98, 0xf9, 0xab, 0xfa, 0x63, 0xb8, 0xf6, 0xd8, 0xb6, 0x89, 0xf1, 0xc2, 0x9a, 0x62,
+	0xca, 0xf4, 0xa9, 0xab, 0xe1, 0x1f, 0x66, 0x98, 0x32, 0xf4, 0x05, 0x2c, 0x4d, 0x74, 0x8a, 0xbb,
+	0xa5, 0xf5, 0xd2, 0x66, 0x63, 0xe7, 0xe3, 0x41, 0x6c, 0xf3, 0xfe, 0x8e, 0x9f, 0xd3, 0xc3, 0x27,
+	0x3a, 0xc5, 0x9a, 0x40, 0xa2, 0xab, 0x50, 0x35, 0xc8, 0xcc, 0x61, 0xdd, 0xca, 0x7a, 0x69, 0x73,
+	0x55, 0x93, 0x83, 0xfe, 0x8f, 0x25, 0xb8, 0x9e, 0x64, 0xa0, 0x2e, 0x71, 0x28, 0x46, 0x0f, 0x60,
+	0x99, 0x32, 0x9d, 0xcd, 0xa8, 0x4f, 0xf2, 0x51, 0x2a, 0xc9, 0x81, 0x80, 0x68, 0x3e, 0x14, 0x7d,
+	0x0c, 0x75, 0x16, 0x68, 0xea, 0x96, 0xd7, 0x4b, 0x9b, 0x4b, 0x5a, 0x24, 0xc8, 0xd8, 0xc3, 0x2b,
+	0x68, 0x89, 0x2d, 0x8c, 0x86, 0x3f, 0x83, 0x75, 0x65, 0x55, 0xb3, 0x0d, 0xed, 0x50, 0xf3, 0x65,
+	0xac, 0x6a, 0x41, 0x79, 0x34, 0x14, 0xaa, 0x2b, 0x5a, 0x79, 0x34, 0xcc, 0xb0, 0xe3, 0xa7, 0x32,
+	0x34, 0x47, 0x53, 0x97, 0x78, 0x4c, 0xc3, 0x74, 0x66, 0xb3, 0xf7, 0xe3, 0xfa, 0x10, 0x56, 0x98,
+	0x4e, 0x4f, 0xc6, 0x96, 0xe9, 0x13, 0x2e, 0xf3, 0xe1, 0xc8, 0x44, 0x9f, 0x42, 0x83, 0x9f, 0x21,
+	0x87, 0x98, 0x98, 0x4f, 0x56, 0xc4, 0x24, 0x04, 0xa2, 0x91, 0x89, 0x1e, 0x42, 0x95, 0xeb, 0xc0,
+	0xdd, 0xa5, 0xf5, 0xd2, 0x66, 0x6b, 0x67, 0x3d, 0x95, 0x4d, 0x6e, 0x90, 0x73, 0x62, 0x4d, 0xc2,
+	0x51, 0x0f, 0x6a, 0x14, 0x1f, 0x4e, 0xb1, 0xc3, 0x68, 0xb7, 0xba, 0x5e, 0xd9, 0xac, 0x68, 0xe1,
+	0x18, 0xfd, 0x1f, 0xd4, 0xf4, 0x19, 0x23, 0x63, 0xcb, 0xa4, 0xdd, 0x65, 0x31, 0xb7, 0xc2, 0xc7,
+	0x23, 0x93, 0xa2, 0x8f, 0xa0, 0xee, 0x91, 0xb3, 0xb1, 0x74, 0xc4, 0x8a, 0xd8, 0x4d, 0xcd, 0x23,
+	0x67, 0xbb, 0x7c, 0x8c, 0xbe, 0x84, 0xaa, 0xe5, 0xbc, 0x25, 0xb4, 0x5b, 0x5b, 0xaf, 0x6c, 0x36,
+	0x76, 0x3e, 0x4b, 0xdd, 0xcb, 0x6f, 0xf0, 0xfc, 0x77, 0xba, 0x3d, 0xc3, 0xfb, 0xba, 0xe5, 0x69,
+	0x12, 0xdf, 0xff, 0x7b, 0x09, 0x3e, 0x1c, 0x62, 0x6a, 0x78, 0xd6, 0x04, 0x1f, 0xf8, 0xbb, 0x78,
+	0xff, 0x63, 0xd1, 0x87, 0xa6, 0x41, 0x6c, 0x1b, 0x1b, 0xcc, 0x22, 0x4e, 0x18, 0xc2, 0x98, 0x0c,
+	0x7d, 0x02, 0xe0, 0x9b, 0x3b, 0x1a, 0xd2, 0x6e, 0x45, 0x18, 0xa9, 0x48, 0xfa, 0x33, 0x68, 0xfb,
+	0x1b, 0xe1, 0x8a, 0x47, 0xce, 0x5b, 0xb2, 0xa0, 0xb6, 0x94, 0xa2, 0x76, 0x1d, 0x1a, 0xae, 0xee,
+	0x31, 0x2b, 0xc6, 0xac, 0x8a, 0x78, 0xae, 0x84, 0x34, 0x7e, 0x38, 0x23, 0x41, 0xff, 0x3f, 0x65,
+	0x68, 0xfa, 0xbc, 0x9c, 0x93, 0xa2, 0x21, 0xd4, 0xb9, 0x4d, 0x63, 0xee, 0x27, 0xdf, 0x05, 0xb7,
+	0x07, 0xe9, 0x45, 0x6b, 0x90, 0xd8, 0xb0, 0x56, 0x9b, 0x04, 0x5b, 0x1f, 0x42, 0x43, 0xd6, 0x1d,
+	0x19, 0x9e, 0xb2, 0x08, 0xcf, 0xcd, 0xb8, 0x1e, 0x5e, 0x85, 0x06, 0x21, 0xb7, 0x89, 0xdf, 0x09,
+	0x1d, 0x60, 0x05, 0x9f, 0x14, 0x61, 0x58, 0xc3, 0xef, 0x98, 0xa7, 0x8f, 0x55, 0x5d, 0x15, 0xa1,
+	0xeb, 0xeb, 0x73, 0xf6, 0x24, 0x14, 0x0c, 0x9e, 0xf2, 0xd5, 0xa1, 0x6e, 0xfa, 0xd4, 0x61, 0xde,
+	0x5c, 0x6b, 0xe3, 0xb8, 0xb4, 0xf7, 0x27, 0xb8, 0x9a, 0x06, 0x44, 0x1d, 0xa8, 0x9c, 0xe0, 0xb9,
+	0xef, 0x76, 0xfe, 0x89, 0x76, 0xa0, 0x7a, 0xca, 0x8f, 0x52, 0xb7, 0x9c, 0x76, 0x36, 0x84, 0x41,
+	0x91, 0x25, 0x12, 0xfa, 0xa8, 0xfc, 0x55, 0xa9, 0xff, 0xaf, 0x32, 0x74, 0x17, 0x8f, 0xdb, 0x65,
+	0x6a, 0x45, 0x91, 0x23, 0x77, 0x08, 0xab, 0x7e, 0xa0, 0x63, 0xae, 0x7b, 0x92, 0xe5, 0xba, 0xac,
+	0x1d, 0xc6, 0x7c, 0x2a, 0x7d, 0xd8, 0xa4, 0x8a, 0xa8, 0x87, 0x61, 0x6d, 0x01, 0x92, 0xe2, 0xbd,
+	0x47, 0x71, 0xef, 0x7d, 0x5e, 0x24, 0x84, 0xaa, 0x17, 0x4d, 0xb8, 0xba, 0x87, 0xd9, 0xae, 0x87,
+	0x4d, 0xec, 0x30, 0x4b, 0xb7, 0xdf, 0x3f, 0x61, 0x7b, 0x50, 0x9b, 0x51, 0xde, 0x52, 0xa7, 0x72,
+	0x33, 0x75, 0x2d, 0x1c, 0xf7, 0xff, 0x56, 0x82, 0x6b, 0x09, 0x9a, 0xcb, 0x04, 0x2a, 0x87, 0x8a,
+	0xcf, 0xb9, 0x3a, 0xa5, 0x67, 0xc4, 0x93, 0x85, 0xb6, 0xae, 0x85, 0xe3, 0xfe, 0x6b, 0x68, 0x3f,
+	0xd1, 0x8d, 0x93, 0x99, 0xbb, 0x1f, 0xe4, 0x72, 0x32, 0xd7, 0x4b, 0x8b, 0xb9, 0xbe, 0x01, 0xad,
+	0x70, 0x38, 0x56, 0x28, 0x57, 0x43, 0xe9, 0x77, 0xdc, 0xc4, 0x7f, 0x56, 0x00, 0xa4, 0xf2, 0xe7,
+	0x98, 0xe9, 0xe8, 0x01, 0x2c, 0x29, 0xd9, 0xfe, 0x69, 0xdc, 0x2a, 0x7f, 0x20, 0xe1, 0xe2, 0x5c,
+	0x0b, 0x30, 0xfa, 0x15, 0x2c, 0xcb, 0x9b, 0x87, 0x1f, 0xcd, 0x8d, 0xf8, 0x32, 0x39, 0x37, 0xd8,
+	0x0d, 0xcf, 0xe3, 0x81, 0x10, 0x68, 0xfe, 0x22, 0x74, 0x03, 0x80, 0x1e, 0xe9, 0x9e, 0x49, 0xc7,
+	0xce, 0x6c, 0x2a, 0x8c, 0xaf, 0x6a, 0x75, 0x29, 0xf9, 0x6e, 0x36, 0x45, 0x1a, 0xac, 0x19, 0xc4,
+	0xa1, 0x16, 0x65, 0xd8, 0x31, 0xe6, 0x63, 0x1b, 0x9f, 0x62, 0xdb, 0x6f, 0x38, 0x1b, 0xa9, 0x5e,
+	0xdf, 0x8d, 0xd0, 0xcf, 0x38, 0x58, 0xeb, 0x18, 0x09, 0x09, 0xda, 0x03, 0x08, 0xdd, 0x20, 0x5b,
+	0x50, 0x4e, 0x69, 0x4b, 0xf8, 0x5e, 0x53, 0x96, 0xa2, 0x2f, 0x61, 0x45, 0x14, 0x24, 0x2c, 0x9b,
+	0x55, 0x63, 0xe7, 0x46, 0x5c, 0x8b, 0x98, 0x54, 0x0a, 0x41, 0x80, 0x46, 0x8f, 0x94, 0x16, 0xb8,
+	0x22, 0x56, 0x7e, 0x12, 0x5f, 0xc9, 0xdb, 0xac, 0x7a, 0xfc, 0xa3, 0x16, 0xd9, 0xff, 0xb1, 0x0c,
+	0x8d, 0xe1, 0xf0, 0xd9, 0x01, 0xc3, 0x22, 0x0a, 0x08, 0xc1, 0x92, 0x89, 0xa9, 0x21, 0x82, 0x56,
+	0xd7, 0xc4, 0x37, 0x4f, 0x30, 0xd9, 0x9a, 0xcb, 0xc2, 0x53, 0x99, 0x09, 0xe6, 0xeb, 0x89, 0xb5,
+	0xe7, 0xab, 0x50, 0xd5, 0xe9, 0xdc, 0x31, 0x44, 0x2c, 0x6a, 0x9a, 0x1c, 0x70, 0x96, 0x99, 0x63,
+	0x12, 0xe1, 0xfa, 0x9a, 0x26, 0xbe, 0xf9, 0x0d, 0xc1, 0xc3, 0xcc, 0x9b, 0x8f, 0xc5, 0x8d, 0xab,
+	0x5b, 0x95, 0x37, 0x04, 0x21, 0x12, 0xd7, 0x3b, 0x11, 0x5b, 0xa6, 0x7b, 0x4c, 0x00, 0xba, 0xcb,
+	0xf2, 0x7a, 0x26, 0x24, 0x7c, 0x9e, 0x37, 0x7b, 0xec, 0x98, 0x72, 0x72, 0x45, 0x4c, 0xae, 0x60,
+	0xc7, 0x14, 0x53, 0x37, 0x00, 0x6c, 0x9d, 0xb2, 0x31, 0xf6, 0x3c, 0xe2, 0x75, 0x6b, 0xc2, 0xb4,
+	0x3a, 0x97, 0x3c, 0xe5, 0x82, 0xfe, 0xbf, 0xcb, 0x00, 0xc3, 0xe1, 0xb3, 0x6f, 0xc9, 0x44, 0xb8,
+	0xe0, 0x2a, 0x54, 0x8f, 0xc9, 0x24, 0xcc, 0x04, 0x39, 0xe0, 0xea, 0x8f, 0xc9, 0x64, 0xcc, 0xe6,
+	0x6e, 0x70, 0xfa, 0x57, 0x8e, 0xc9, 0xe4, 0xc5, 0xdc, 0xc5, 0xe8, 0x36, 0xb4, 0xa3, 0x02, 0x29,
+	0xf3, 0x43, 0xa6, 0x5d, 0x2b, 0x12, 0xf3, 0x04, 0x41, 0x5f, 0xc7, 0xef, 0x38, 0x37, 0x73, 0x1c,
+	0xf9, 0x2d, 0x99, 0xc4, 0xfc, 0x18, 0xbb, 0x9a, 0x56, 0x93, 0x57, 0xd3, 0xff, 0x95, 0x6b, 0xe4,
+	0x8e, 0xb1, 0x4b, 0xbb, 0xf5, 0xb4, 0x56, 0xbb, 0x10, 0x7a, 0xd9, 0xa0, 0xc4, 0x8a, 0xfe, 0x4f,
+	0x25, 0x40, 0xcf, 0x2c, 0xca, 0xa4, 0x31, 0x97, 0xb8, 0x06, 0xa5, 0xb8, 0xb7, 0x9c, 0xea, 0xde,
+	0x5f, 0xca, 0x42, 0x8a, 0x65, 0x47, 0x2a, 0xe8, 0x5f, 0x7f, 0x09, 0x8f, 0xba, 0x6d, 0x4d, 0x2d,
+	0x26, 0x62, 0x53, 0xd1, 0xe4, 0xa0, 0xff, 0x97, 0x12, 0x7c, 0x10, 0x33, 0xe2, 0x32, 0x35, 0xfb,
+	0x21, 0x2c, 0x1d, 0x93, 0x49, 0x70, 0x6d, 0xe9, 0xe7, 0xef, 0x4e, 0xd6, 0x44, 0x8e, 0xe7, 0xff,
+	0x51, 0x41, 0x0f, 0x95, 0x73, 0x97, 0xfa, 0xd3, 0x90, 0x67, 0xbb, 0xac, 0x9c, 0xed, 0xfe, 0x5f,
+	0x4b, 0x70, 0x3d, 0xc9, 0x70, 0x19, 0x43, 0x7f, 0x01, 0x95, 0x63, 0x32, 0xf1, 0x2b, 0x78, 0x11,
+	0x3b, 0x39, 0x7c, 0xeb, 0x35, 0x34, 0x94, 0xc0, 0xa0, 0x35, 0x58, 0x95, 0xc3, 0x7d, 0xec, 0x98,
+	0x96, 0x73, 0xd8, 0xb9, 0x12, 0x89, 0xb4, 0x99, 0xe3, 0x70, 0x51, 0x09, 0x7d, 0x00, 0x6d, 0x7f,
+	0xd1, 0xcc, 0x30, 0x30, 0x36, 0xb1, 0xd9, 0x29, 0xa3, 0x0e, 0x34, 0xa5, 0xf0, 0xd7, 0xba, 0x65,
+	0x63, 0xb3, 0x53, 0xd9, 0x22, 0xd0, 0xf4, 0x8f, 0xa8, 0x54, 0x8e, 0xa0, 0xe5, 0x8f, 0x23, 0xed,
+	0x91, 0x2c, 0xa9, 0x5e, 0xc8, 0x78, 0x21, 0xe2, 0xc2, 0x32, 0x6a, 0x87, 0x25, 0x73, 0x48, 0x1c,
+	0xdc, 0xa9, 0xf8, 0xfb, 0xe2, 0x02, 0x9f, 0x70, 0x69, 0xe7, 0x1f, 0x1b, 0x50, 0xd7, 0x08, 0x61,
+	0xbb, 0xdc, 0x54, 0xe4, 0x02, 0xe2, 0xbd, 0x9f, 0x4c, 0x5d, 0xe2, 0x60, 0x47, 0xfe, 0xc0, 0x50,
+	0xf4, 0x45, 0xb2, 0xbe, 0xfb, 0xbf, 0xe3, 0x8b, 0x50, 0x3f, 0xe0, 0xbd, 0x5b, 0x19, 0x2b, 0x12,
+	0xf0, 0xfe, 0x15, 0x34, 0x15, 0x8c, 0x3c, 0xc5, 0x5f, 0x58, 0xc6, 0xc9, 0xee, 0x91, 0xee, 0x38,
+	0xd8, 0xce, 0x63, 0x4c, 0x40, 0x03, 0xc6, 0x9b, 0xa9, 0x6d, 0xfb, 0x80, 0x79, 0x96, 0x73, 0x18,
+	0x1c, 0x92, 0xfe, 0x15, 0xf4, 0x83, 0xb8, 0x43, 0x71, 0x76, 0x8b, 0x32, 0xcb, 0xa0, 0x01, 0xe1,
+	0x4e, 0x36, 0xe1, 0x02, 0xf8, 0x82, 0x94, 0x63, 0xe8, 0xec, 0x7a, 0x58, 0x67, 0x38, 0xba, 0x0c,
+	0xa0, 0x7b, 0xa9, 0x4b, 0x93, 0xb0, 0x80, 0x28, 0xef, 0x2c, 0xf7, 0xaf, 0xa0, 0x3f, 0x40, 0x6b,
+	0xe8, 0x11, 0x57, 0x51, 0xbf, 0x95, 0xaa, 0x3e, 0x0e, 0x2a, 0xa8, 0x7c, 0x0c, 0xab, 0xdf, 0xe8,
+	0x54, 0xd1, 0x7d, 0x27, 0x55, 0x77, 0x0c, 0x13, 0xa8, 0xfe, 0x2c, 0xfd, 0x2a, 0x45, 0x88, 0xad,
+	0xb8, 0xe7, 0x0c, 0x50, 0x90, 0xd2, 0x0a, 0xcb, 0x20, 0xdd, 0x82, 0x05, 0x60, 0x40, 0xb5, 0x5d,
+	0x18, 0x1f, 0x12, 0xbf, 0x84, 0x86, 0x74, 0xf8, 0x63, 0xdb, 0xd2, 0x29, 0xba, 0x9d, 0x13, 0x12,
+	0x81, 0x28, 0xe8, 0xb0, 0xdf, 0x42, 0x9d, 0x3b, 0x5a, 0x2a, 0xdd, 0xc8, 0x0c, 0xc4, 0x45, 0x54,
+	0x1e, 0x00, 0x3c, 0xb6, 0x19, 0xf6, 0xa4, 0xce, 0x5b, 0xa9, 0x3a, 0x23, 0x40, 0x41, 0xa5, 0x0e,
+	0xb4, 0x0f, 0x8e, 0xc8, 0x59, 0xe4, 0x1a, 0x8a, 0xee, 0xa6, 0x1f, 0xe8, 0x38, 0x2a, 0x50, 0x7f,
+	0xaf, 0x18, 0x38, 0x74, 0xf7, 0x1b, 0x68, 0x4b, 0x67, 0x46, 0x17, 0xfa, 0xbb, 0x39, 0x2e, 0x0f,
+	0x51, 0x05, 0xcd, 0xf9, 0x3d, 0xac, 0x72, 0xb7, 0x46, 0xca, 0xef, 0x64, 0xba, 0xfe, 0xa2, 0xaa,
+	0xdf, 0x40, 0xf3, 0x1b, 0x9d, 0x46, 0x9a, 0x37, 0xb3, 0x32, 0x60, 0x41, 0x71, 0xa1, 0x04, 0x38,
+	0x81, 0x16, 0xf7, 0xda, 0x7e, 0x74, 0xc1, 0xde, 0xca, 0x74, 0x6d, 0x04, 0x0a, 0x28, 0xee, 0x16,
+	0xc2, 0x86, 0x64, 0x18, 0x9a, 0x7c, 0x2e, 0xf8, 0xc5, 0xcd, 0xb0, 0x45, 0x85, 0x04, 0x44, 0x77,
+	0x0a, 0x20, 0x95, 0x32, 0xdb, 0x8a, 0xbf, 0x77, 0xa2, 0xfb, 0x59, 0xdd, 0x35, 0xf5, 0xe5, 0xb5,
+	0x37, 0x28, 0x0a, 0x0f, 0x29, 0xff, 0x08, 0x2b, 0xfe, 0x2b, 0x24, 0xba, 0x95, 0xbb, 0x38, 0x7c,
+	0x00, 0xed, 0xdd, 0x3e, 0x17, 0x17, 0x6a, 0xd7, 0xe1, 0xda, 0x4b, 0xd7, 0xe4, 0xd5, 0x59, 0xf6,
+	0x80, 0xa0, 0x0b, 0xa1, 0x3b, 0x19, 0x8d, 0x23, 0x81, 0x7b, 0x4e, 0x0f, 0xcf, 0x3b, 0x66, 0x1e,
+	0xdc, 0x18, 0x39, 0xa7, 0xba, 0x6d, 0x99, 0xb1, 0x26, 0xc0, 0x7f, 0x51, 0x77, 0x75, 0xe3, 0x08,
+	0x27, 0x7b, 0x94, 0x7c, 0x05, 0x8f, 0x2f, 0x09, 0xc1, 0x05, 0x8f, 0xf6, 0x9f, 0x01, 0xc9, 0x8c,
+	0x75, 0xde, 0x5a, 0x87, 0x33, 0x4f, 0x97, 0xe7, 0x2f, 0xab, 0xfb, 0x2e, 0x42, 0x03, 0x9a, 0xff,
+	0xbf, 0xc0, 0x0a, 0xa5, 0x31, 0xc2, 0x1e, 0x66, 0xcf, 0x31, 0xf3, 0x2c, 0x23, 0xab, 0xac, 0x45,
+	0x80, 0x8c, 0xa0, 0xa5, 0xe0, 0x42, 0x82, 0x03, 0x58, 0x96, 0x0f, 0xb1, 0xa8, 0x9f, 0xba, 0x28,
+	0x78, 0x46, 0xce, 0x6b, 0xe7, 0x01, 0x46, 0x4d, 0xd7, 0x3d, 0xcc, 0x94, 0x07, 0xde, 0x8c, 0x74,
+	0x8d, 0x83, 0xf2, 0xd3, 0x35, 0x89, 0x0d, 0xc9, 0x1c, 0x68, 0xf3, 0x5b, 0xbd, 0x9c, 0x7c, 0xa1,
+	0xd3, 0x93, 0xac, 0x22, 0x9d, 0x40, 0xe5, 0x17, 0xe9, 0x05, 0xb0, 0xe2, 0xb1, 0xa6, 0x86, 0xf9,
+	0x84, 0xef, 0xb7, 0xcc, 0x5f, 0x68, 0xf5, 0x05, 0xfe, 0xbc, 0x43, 0x86, 0xa1, 0x29, 0x6b, 0xba,
+	0x7c, 0x54, 0xc8, 0xa8, 0x39, 0x2a, 0x24, 0xbf, 0xe6, 0xc4, 0x91, 0xe1, 0xde, 0x27, 0xd0, 0xe0,
+	0x86, 0x49, 0x79, 0x56, 0x3f, 0x57, 0x10, 0x01, 0xc9, 0xe6, 0xf9, 0xc0, 0x90, 0xe3, 0x08, 0x56,
+	0x35, 0x4c, 0x19, 0xf1, 0x02, 0x5b, 0xd2, 0x77, 0x18, 0xc3, 0x04, 0x3c, 0x5b, 0x45, 0xa0, 0x6a,
+	0xe4, 0x77, 0x6d, 0xe2, 0xa8, 0x77, 0xa2, 0x8c, 0x76, 0x19, 0x47, 0xe5, 0x47, 0x7e, 0x01, 0x1c,
+	0xf2, 0xf1, 0x5f, 0x2b, 0xf1, 0x03, 0xe9, 0x11, 0xd7, 0xc5, 0xa6, 0x7a, 0x2d, 0xd8, 0xc9, 0x74,
+	0xd0, 0x22, 0x38, 0xa0, 0x7f, 0x70, 0xa1, 0x35, 0x4a, 0x99, 0x5d, 0xf3, 0x1d, 0xa2, 0xd8, 0x7d,
+	0x3f, 0xcf, 0x71, 0x17, 0xbe, 0xd0, 0x1e, 0xc9, 0x63, 0xe2, 0xff, 0x28, 0xa3, 0xad, 0xac, 0x13,
+	0xbe, 0xf8, 0x24, 0xd0, 0xbb, 0x5b, 0x08, 0xab, 0x36, 0xc1, 0xf8, 0xcf, 0x6a, 0x76, 0x13, 0x4c,
+	0xfd, 0x6d, 0xee, 0x0d, 0x8a, 0xc2, 0x43, 0xca, 0x57, 0xe1, 0xbf, 0x46, 0xf8, 0x7c, 0x8b, 0x36,
+	0x32, 0x6a, 0x73, 0x04, 0xe1, 0xbf, 0xb6, 0xe7, 0xb9, 0xed, 0x15, 0x74, 0xfc, 0x06, 0xf8, 0x73,
+	0x6b, 0x1e, 0x43, 0x67, 0x88, 0x6d, 0x1c, 0xd3, 0x7c, 0x2f, 0xe3, 0x3a, 0x1f, 0x87, 0x15, 0x8e,
+	0xf8, 0x2a, 0x0f, 0x10, 0x5f, 0xf7, 0x92, 0x62, 0x8f, 0x66, 0x24, 0x6d, 0x0c, 0x93, 0x9f, 0xb4,
+	0x09, 0xa8, 0x92, 0xb4, 0xab, 0xb1, 0xa7, 0x73, 0x74, 0x2f, 0x2b, 0x82, 0x69, 0x0f, 0xf9, 0xbd,
+	0xfb, 0x05, 0xd1, 0x4a, 0xb9, 0x06, 0x19, 0x6e, 0x8d, 0xd8, 0x38, 0xa3, 0x83, 0x46, 0x80, 0x82,
+	0xee, 0xfa, 0x1e, 0x6a, 0x3c, 0x47, 0x85, 0xca, 0xcf, 0x33, 0x2f, 0xd1, 0x17, 0x50, 0xf8, 0x06,
+	0xda, 0xdf, 0xbb, 0xd8, 0xd3, 0x19, 0xe6, 0xfe, 0x12, 0x7a, 0xd3, 0x4b, 0x59, 0x02, 0x55, 0xf8,
+	0x0f, 0x15, 0x0e, 0x30, 0xaf, 0x01, 0x39, 0x4e, 0x88, 0x00, 0xf9, 0xd7, 0x08, 0x15, 0xa7, 0xde,
+	0x53, 0xa4, 0x9c, 0x6f, 0x2c, 0x97, 0x40, 0xec, 0xbc, 0x00, 0x81, 0xc4, 0xa9, 0x2f, 0x04, 0xbe,
+	0xe9, 0xfb, 0x9e, 0x75, 0x6a, 0xd9, 0xf8, 0x10, 0x67, 0x64, 0x40, 0x12, 0x56, 0xd0, 0x45, 0x13,
+	0x68, 0x48, 0xe2, 0x3d, 0x4f, 0x77, 0x18, 0xca, 0xdb, 0x9a, 0x40, 0xe4, 0xb7, 0xc6, 0x18, 0x30,
+	0x34, 0xc2, 0x00, 0xe0, 0x69, 0xb1, 0x4f, 0x6c, 0xcb, 0x98, 0xa3, 0xcd, 0x8c, 0xd2, 0x10, 0x41,
+	0x32, 0x7a, 0x7c, 0x2a, 0x32, 0x20, 0x79, 0xf2, 0xd5, 0xeb, 0x87, 0x87, 0x16, 0x3b, 0x9a, 0x4d,
+	0xb8, 0x89, 0xdb, 0x72, 0xe1, 0x7d, 0x8b, 0xf8, 0x5f, 0xdb, 0xc1, 0xe2, 0x6d, 0xa1, 0x6b, 0x3b,
+	0x4c, 0x20, 0x77, 0x32, 0x59, 0x16, 0xa2, 0x07, 0xff, 0x1d, 0x00, 0x48, 0x9d, 0x5f, 0x20, 0x93,
+	0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -858,6 +1529,15 @@ type RootCoordClient interface {
 	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)
 	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateBackup(ctx context.Context, in *milvuspb.CreateBackupRequest, opts ...grpc.CallOption) (*milvuspb.CreateBackupResponse, error)
+	ListBackups(ctx context.Context, in *milvuspb.ListBackupsRequest, opts ...grpc.CallOption) (*milvuspb.ListBackupsResponse, error)
+	RestoreBackup(ctx context.Context, in *milvuspb.RestoreBackupRequest, opts ...grpc.CallOption) (*milvuspb.RestoreBackupResponse, error)
+	CloneCollection(ctx context.Context, in *milvuspb.CloneCollectionRequest, opts ...grpc.CallOption) (*milvuspb.CloneCollectionResponse, error)
+	ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error)
+	RestoreCollection(ctx context.Context, in *milvuspb.RestoreCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// used by operators to inspect the DDL jobs and their steps, not exposed to sdk
+	ListDDLJobs(ctx context.Context, in *ListDDLJobsRequest, opts ...grpc.CallOption) (*ListDDLJobsResponse, error)
+	DescribeDDLJob(ctx context.Context, in *DescribeDDLJobRequest, opts ...grpc.CallOption) (*DescribeDDLJobResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -1118,6 +1798,78 @@ func (c *rootCoordClient) ReportImport(ctx context.Context, in *ImportResult, op
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateBackup(ctx context.Context, in *milvuspb.CreateBackupRequest, opts ...grpc.CallOption) (*milvuspb.CreateBackupResponse, error) {
+	out := new(milvuspb.CreateBackupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListBackups(ctx context.Context, in *milvuspb.ListBackupsRequest, opts ...grpc.CallOption) (*milvuspb.ListBackupsResponse, error) {
+	out := new(milvuspb.ListBackupsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RestoreBackup(ctx context.Context, in *milvuspb.RestoreBackupRequest, opts ...grpc.CallOption) (*milvuspb.RestoreBackupResponse, error) {
+	out := new(milvuspb.RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RestoreBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CloneCollection(ctx context.Context, in *milvuspb.CloneCollectionRequest, opts ...grpc.CallOption) (*milvuspb.CloneCollectionResponse, error) {
+	out := new(milvuspb.CloneCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CloneCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	out := new(milvuspb.ListDroppedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDroppedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RestoreCollection(ctx context.Context, in *milvuspb.RestoreCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RestoreCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDDLJobs(ctx context.Context, in *ListDDLJobsRequest, opts ...grpc.CallOption) (*ListDDLJobsResponse, error) {
+	out := new(ListDDLJobsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDDLJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DescribeDDLJob(ctx context.Context, in *DescribeDDLJobRequest, opts ...grpc.CallOption) (*DescribeDDLJobResponse, error) {
+	out := new(DescribeDDLJobResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeDDLJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
@@ -1312,6 +2064,15 @@ type RootCoordServer interface {
 	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(context.Context, *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
+	CreateBackup(context.Context, *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error)
+	ListBackups(context.Context, *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error)
+	RestoreBackup(context.Context, *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error)
+	CloneCollection(context.Context, *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error)
+	ListDroppedCollections(context.Context, *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error)
+	RestoreCollection(context.Context, *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error)
+	// used by operators to inspect the DDL jobs and their steps, not exposed to sdk
+	ListDDLJobs(context.Context, *ListDDLJobsRequest) (*ListDDLJobsResponse, error)
+	DescribeDDLJob(context.Context, *DescribeDDLJobRequest) (*DescribeDDLJobResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
 	UpdateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
@@ -1412,6 +2173,30 @@ func (*UnimplementedRootCoordServer) ListImportTasks(ctx context.Context, req *m
 func (*UnimplementedRootCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (*UnimplementedRootCoordServer) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (*UnimplementedRootCoordServer) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
+func (*UnimplementedRootCoordServer) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDroppedCollections not implemented")
+}
+func (*UnimplementedRootCoordServer) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDDLJobs(ctx context.Context, req *ListDDLJobsRequest) (*ListDDLJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDDLJobs not implemented")
+}
+func (*UnimplementedRootCoordServer) DescribeDDLJob(ctx context.Context, req *DescribeDDLJobRequest) (*DescribeDDLJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeDDLJob not implemented")
+}
 func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
@@ -1924,6 +2709,150 @@ func _RootCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateBackup(ctx, req.(*milvuspb.CreateBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListBackups(ctx, req.(*milvuspb.ListBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RestoreBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RestoreBackup(ctx, req.(*milvuspb.RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CloneCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CloneCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CloneCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CloneCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CloneCollection(ctx, req.(*milvuspb.CloneCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDroppedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDroppedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDroppedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDroppedCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDroppedCollections(ctx, req.(*milvuspb.ListDroppedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RestoreCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RestoreCollection(ctx, req.(*milvuspb.RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDDLJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDDLJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDDLJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDDLJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDDLJobs(ctx, req.(*ListDDLJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DescribeDDLJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDDLJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DescribeDDLJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DescribeDDLJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DescribeDDLJob(ctx, req.(*DescribeDDLJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.CredentialInfo)
 	if err := dec(in); err != nil {
@@ -2266,6 +3195,38 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportImport",
 			Handler:    _RootCoord_ReportImport_Handler,
 		},
+		{
+			MethodName: "CreateBackup",
+			Handler:    _RootCoord_CreateBackup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _RootCoord_ListBackups_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _RootCoord_RestoreBackup_Handler,
+		},
+		{
+			MethodName: "CloneCollection",
+			Handler:    _RootCoord_CloneCollection_Handler,
+		},
+		{
+			MethodName: "ListDroppedCollections",
+			Handler:    _RootCoord_ListDroppedCollections_Handler,
+		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _RootCoord_RestoreCollection_Handler,
+		},
+		{
+			MethodName: "ListDDLJobs",
+			Handler:    _RootCoord_ListDDLJobs_Handler,
+		},
+		{
+			MethodName: "DescribeDDLJob",
+			Handler:    _RootCoord_DescribeDDLJob_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _RootCoord_CreateCredential_Handler,
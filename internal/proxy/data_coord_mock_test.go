@@ -126,6 +126,18 @@ func (coord *DataCoordMock) MarkSegmentsDropped(ctx context.Context, req *datapb
 	panic("implement me")
 }
 
+func (coord *DataCoordMock) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	panic("implement me")
+}
+
+func (coord *DataCoordMock) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *DataCoordMock) GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error) {
+	panic("implement me")
+}
+
 func (coord *DataCoordMock) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	panic("implement me")
 }
@@ -3983,6 +3983,145 @@ func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImport
 	return resp, err
 }
 
+// CreateBackup backs up a collection through rootcoord
+func (node *Proxy) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	log.Info("received create backup request",
+		zap.String("collection name", req.GetCollectionName()),
+		zap.String("backup name", req.GetBackupName()),
+		zap.Uint64("backup timestamp", req.GetBackupTimestamp()))
+	resp := &milvuspb.CreateBackupResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.rootCoord.CreateBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to create backup", zap.String("backup name", req.GetBackupName()), zap.Error(err))
+		return &milvuspb.CreateBackupResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// ListBackups lists backups from rootcoord
+func (node *Proxy) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	log.Info("received list backups request", zap.String("collection name", req.GetCollectionName()))
+	resp := &milvuspb.ListBackupsResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.rootCoord.ListBackups(ctx, req)
+	log.Info("received list backups response", zap.Int("backup number", len(resp.GetBackups())), zap.Error(err))
+	return resp, err
+}
+
+// RestoreBackup restores a backup into a new collection through rootcoord
+func (node *Proxy) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	log.Info("received restore backup request",
+		zap.String("backup name", req.GetBackupName()),
+		zap.String("collection name", req.GetCollectionName()))
+	resp := &milvuspb.RestoreBackupResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.rootCoord.RestoreBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to restore backup", zap.String("backup name", req.GetBackupName()), zap.Error(err))
+		return &milvuspb.RestoreBackupResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// CloneCollection creates a new collection sharing the flushed binlogs of an existing collection.
+func (node *Proxy) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	log.Info("received clone collection request",
+		zap.String("collection name", req.GetCollectionName()),
+		zap.String("new collection name", req.GetNewCollectionName()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+	resp := &milvuspb.CloneCollectionResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	if err := validateCollectionName(req.GetNewCollectionName()); err != nil {
+		resp.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalCollectionName,
+			Reason:    err.Error(),
+		}
+		return resp, nil
+	}
+
+	resp, err := node.rootCoord.CloneCollection(ctx, req)
+	if err != nil {
+		log.Error("failed to clone collection", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		return &milvuspb.CloneCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// ListDroppedCollections lists the dropped collections and partitions kept in the recycle bin of rootcoord
+func (node *Proxy) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	log.Debug("received list dropped collections request", zap.String("collection name", req.GetCollectionName()))
+	resp := &milvuspb.ListDroppedCollectionsResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.rootCoord.ListDroppedCollections(ctx, req)
+	if err != nil {
+		log.Error("failed to list dropped collections", zap.Error(err))
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// RestoreCollection brings a dropped collection or partition back from the recycle bin of rootcoord
+func (node *Proxy) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	log.Info("received restore collection request",
+		zap.String("collection name", req.GetCollectionName()),
+		zap.Int64("collection id", req.GetCollectionID()),
+		zap.String("partition name", req.GetPartitionName()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	status, err := node.rootCoord.RestoreCollection(ctx, req)
+	if err != nil {
+		log.Error("failed to restore collection", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return status, nil
+}
+
 // GetReplicas gets replica info
 func (node *Proxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	log.Info("received get replicas request")
@@ -3869,6 +3869,128 @@ func TestProxy_ListImportTasks(t *testing.T) {
 	})
 }
 
+func TestProxy_CreateBackup(t *testing.T) {
+	req := &milvuspb.CreateBackupRequest{}
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(internalpb.StateCode_Healthy)
+	t.Run("test create backup", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.CreateBackup(context.TODO(), req)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test create backup with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.CreateBackup(context.TODO(), req)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func TestProxy_ListBackups(t *testing.T) {
+	req := &milvuspb.ListBackupsRequest{}
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(internalpb.StateCode_Healthy)
+	t.Run("test list backups", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.ListBackups(context.TODO(), req)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test list backups with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.ListBackups(context.TODO(), req)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func TestProxy_RestoreBackup(t *testing.T) {
+	req := &milvuspb.RestoreBackupRequest{}
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(internalpb.StateCode_Healthy)
+	t.Run("test restore backup", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.RestoreBackup(context.TODO(), req)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test restore backup with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.RestoreBackup(context.TODO(), req)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func TestProxy_CloneCollection(t *testing.T) {
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(internalpb.StateCode_Healthy)
+	t.Run("test clone collection", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.CloneCollection(context.TODO(), &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "coll_cloned"})
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test clone collection with invalid name", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.CloneCollection(context.TODO(), &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "$coll"})
+		assert.EqualValues(t, commonpb.ErrorCode_IllegalCollectionName, resp.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test clone collection with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.CloneCollection(context.TODO(), &milvuspb.CloneCollectionRequest{})
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func TestProxy_RecycleBin(t *testing.T) {
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(internalpb.StateCode_Healthy)
+	t.Run("test list dropped collections", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.ListDroppedCollections(context.TODO(), &milvuspb.ListDroppedCollectionsRequest{CollectionName: "coll"})
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test restore collection", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.RestoreCollection(context.TODO(), &milvuspb.RestoreCollectionRequest{CollectionName: "coll"})
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+		assert.Nil(t, err)
+	})
+	t.Run("test recycle bin with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		listResp, err := proxy.ListDroppedCollections(context.TODO(), &milvuspb.ListDroppedCollectionsRequest{})
+		assert.EqualValues(t, unhealthyStatus(), listResp.Status)
+		assert.Nil(t, err)
+		resp, err := proxy.RestoreCollection(context.TODO(), &milvuspb.RestoreCollectionRequest{})
+		assert.EqualValues(t, unhealthyStatus(), resp)
+		assert.Nil(t, err)
+	})
+}
+
 func TestProxy_GetStatistics(t *testing.T) {
 
 }
@@ -261,6 +261,27 @@ func (s metaStore) LoadHandoffWithRevision() ([]string, []string, int64, error)
 	return s.cli.LoadWithRevision(util.HandoffSegmentPrefix)
 }
 
+// catalogStore keeps the load meta in the given catalog, while the handoff events are always watched in the meta kv.
+type catalogStore struct {
+	metastore.QueryCoordCatalog
+	cli kv.MetaKv
+}
+
+func NewCatalogStore(catalog metastore.QueryCoordCatalog, cli kv.MetaKv) catalogStore {
+	return catalogStore{
+		QueryCoordCatalog: catalog,
+		cli:               cli,
+	}
+}
+
+func (s catalogStore) WatchHandoffEvent(revision int64) WatchStoreChan {
+	return s.cli.WatchWithRevision(util.HandoffSegmentPrefix, revision)
+}
+
+func (s catalogStore) LoadHandoffWithRevision() ([]string, []string, int64, error) {
+	return s.cli.LoadWithRevision(util.HandoffSegmentPrefix)
+}
+
 func encodeCollectionLoadInfoKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionLoadInfoPrefix, collection)
 }
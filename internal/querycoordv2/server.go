@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -22,7 +23,11 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/db/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
@@ -36,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -138,12 +144,18 @@ func (s *Server) Init() error {
 	s.factory.Init(Params)
 
 	// Init KV
-	etcdKV := etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath)
-	s.kv = etcdKV
-	log.Debug("query coordinator try to connect etcd success")
+	var idAllocatorKV kv.TxnKV
+	if metaKV := rocksdbkv.NewLocalMetaKV(Params.EtcdCfg.MetaRootPath); metaKV != nil {
+		s.kv = metaKV
+		idAllocatorKV = rocksdbkv.NewLocalMetaKV(path.Join(Params.EtcdCfg.KvRootPath, "querycoord-id-allocator"))
+		log.Debug("query coordinator keeps the meta in rocksdb")
+	} else {
+		s.kv = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath)
+		idAllocatorKV = tsoutil.NewTSOKVBase(s.etcdCli, Params.EtcdCfg.KvRootPath, "querycoord-id-allocator")
+		log.Debug("query coordinator try to connect etcd success")
+	}
 
 	// Init ID allocator
-	idAllocatorKV := tsoutil.NewTSOKVBase(s.etcdCli, Params.EtcdCfg.KvRootPath, "querycoord-id-allocator")
 	idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", idAllocatorKV)
 	err := idAllocator.Initialize()
 	if err != nil {
@@ -218,7 +230,18 @@ func (s *Server) Init() error {
 
 func (s *Server) initMeta() error {
 	log.Debug("init meta")
-	s.store = meta.NewMetaStore(s.kv)
+	switch Params.MetaStoreCfg.MetaStoreType {
+	case util.MetaStoreTypeEtcd:
+		s.store = meta.NewMetaStore(s.kv)
+	case util.MetaStoreTypeMysql, util.MetaStoreTypeSqlite:
+		if err := dbcore.Connect(&Params.DBCfg); err != nil {
+			log.Error("failed to connect meta database", zap.Error(err))
+			return err
+		}
+		s.store = meta.NewCatalogStore(querycoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), s.kv), s.kv)
+	default:
+		return fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType)
+	}
 	s.meta = meta.NewMeta(s.idAllocator, s.store)
 
 	log.Debug("recover meta...")
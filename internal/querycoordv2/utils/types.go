@@ -75,6 +75,7 @@ func PackSegmentLoadInfo(segment *datapb.SegmentInfo, indexes []*querypb.FieldIn
 		Deltalogs:     segment.Deltalogs,
 		InsertChannel: segment.InsertChannel,
 		IndexInfos:    indexes,
+		Sorted:        segment.GetSorted(),
 	}
 	loadInfo.SegmentSize = calculateSegmentSize(loadInfo)
 	return loadInfo
@@ -362,6 +362,10 @@ func filterSegmentsByPKs(pks []primaryKey, timestamps []Timestamp, segment *Segm
 		default:
 			return nil, nil, fmt.Errorf("invalid data type of delete primary keys")
 		}
+		// the bloom filter may be false positive, the sorted primary keys tell exactly
+		if exist && segment.getSortedPKs() != nil {
+			exist = segment.getSortedPKs().contains(pk)
+		}
 		if exist {
 			retPks = append(retPks, pk)
 			retTss = append(retTss, timestamps[index])
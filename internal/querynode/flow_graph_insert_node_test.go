@@ -428,4 +428,26 @@ func TestFilterSegmentsByPKs(t *testing.T) {
 		_, _, err = filterSegmentsByPKs([]primaryKey{pk0, pk1, pk2, pk3, pk4}, timestamps, nil)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("filter by sorted pks", func(t *testing.T) {
+		buf := make([]byte, 8)
+		filter := bloom.NewWithEstimates(1000000, 0.01)
+		for i := 0; i < 5; i++ {
+			common.Endian.PutUint64(buf, uint64(i))
+			filter.Add(buf)
+		}
+		segment := &Segment{
+			segmentID: 1,
+			pkFilter:  filter,
+		}
+		// the bloom filter says all the pks may exist
+		segment.setSortedPKs(newSortedPKs(&storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{0, 2, 4}}))
+
+		pks := []primaryKey{newInt64PrimaryKey(0), newInt64PrimaryKey(1), newInt64PrimaryKey(2), newInt64PrimaryKey(3), newInt64PrimaryKey(4)}
+		timestamps := []uint64{1, 2, 3, 4, 5}
+		retPks, retTss, err := filterSegmentsByPKs(pks, timestamps, segment)
+		assert.Nil(t, err)
+		assert.Equal(t, []primaryKey{pks[0], pks[2], pks[4]}, retPks)
+		assert.Equal(t, []uint64{1, 3, 5}, retTss)
+	})
 }
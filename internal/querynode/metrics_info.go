@@ -71,6 +71,13 @@ func getQuotaMetrics(node *QueryNode) (*metricsinfo.QueryNodeQuotaMetrics, error
 	if err != nil {
 		return nil, err
 	}
+	growingSegmentsSize := make(map[int64]int64)
+	for _, segment := range node.metaReplica.getGrowingSegments() {
+		// the segment released meanwhile reports -1
+		if size := segment.getMemSize(); size >= 0 {
+			growingSegmentsSize[segment.ID()] = size
+		}
+	}
 	defer rateCol.rtCounter.resetQueueTime()
 	return &metricsinfo.QueryNodeQuotaMetrics{
 		Hms: metricsinfo.HardwareMetrics{},
@@ -79,8 +86,9 @@ func getQuotaMetrics(node *QueryNode) (*metricsinfo.QueryNodeQuotaMetrics, error
 			MinFlowGraphTt: rateCol.getMinTSafe(),
 			NumFlowGraph:   node.dataSyncService.getFlowGraphNum(),
 		},
-		SearchQueue: rateCol.rtCounter.getSearchNQInQueue(),
-		QueryQueue:  rateCol.rtCounter.getQueryTasksInQueue(),
+		SearchQueue:         rateCol.rtCounter.getSearchNQInQueue(),
+		QueryQueue:          rateCol.rtCounter.getQueryTasksInQueue(),
+		GrowingSegmentsSize: growingSegmentsSize,
 	}, nil
 }
 
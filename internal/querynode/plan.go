@@ -30,6 +30,7 @@ import (
 	"unsafe"
 
 	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
@@ -96,17 +97,20 @@ type searchRequest struct {
 	timestamp         Timestamp
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	predicates        *planpb.Expr // used to prune the sealed segments
 }
 
 func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*searchRequest, error) {
 	var err error
 	var plan *SearchPlan
+	var predicates *planpb.Expr
 	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := req.Req.SerializedExprPlan
 		plan, err = createSearchPlanByExpr(collection, expr)
 		if err != nil {
 			return nil, err
 		}
+		predicates = getPlanPredicates(expr)
 	} else {
 		dsl := req.Req.GetDsl()
 		plan, err = createSearchPlan(collection, dsl)
@@ -143,6 +147,7 @@ func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		timestamp:         req.Req.GetTravelTimestamp(),
 		msgID:             req.GetReq().GetBase().GetMsgID(),
 		searchFieldID:     int64(fieldID),
+		predicates:        predicates,
 	}
 
 	return ret, nil
@@ -181,7 +186,8 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*searchRequ
 type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     Timestamp
-	msgID         UniqueID // only used to debug.
+	msgID         UniqueID     // only used to debug.
+	predicates    *planpb.Expr // used to prune the sealed segments
 }
 
 func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
@@ -197,6 +203,7 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp,
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
 		msgID:         msgID,
+		predicates:    getPlanPredicates(expr),
 	}
 	return newPlan, nil
 }
@@ -49,7 +49,9 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -116,7 +118,7 @@ type QueryNode struct {
 	eventCh <-chan *sessionutil.SessionEvent
 
 	vectorStorage storage.ChunkManager
-	etcdKV        *etcdkv.EtcdKV
+	etcdKV        kv.MetaKv
 
 	// shard cluster service, handle shard leader functions
 	ShardClusterService *ShardClusterService
@@ -252,7 +254,11 @@ func (node *QueryNode) Init() error {
 			return
 		}
 
-		node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath)
+		if metaKV := rocksdbkv.NewLocalMetaKV(Params.EtcdCfg.MetaRootPath); metaKV != nil {
+			node.etcdKV = metaKV
+		} else {
+			node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath)
+		}
 		log.Info("queryNode try to connect etcd success", zap.Any("MetaRootPath", Params.EtcdCfg.MetaRootPath))
 
 		cpuNum := runtime.GOMAXPROCS(0)
@@ -28,6 +28,7 @@ import (
 func retrieveOnSegments(ctx context.Context, replica ReplicaInterface, segType segmentType, collID UniqueID, plan *RetrievePlan, segIDs []UniqueID, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, error) {
 	var retrieveResults []*segcorepb.RetrieveResults
 
+	segIDs = pruneSegmentsByStats(replica, segType, plan.predicates, segIDs)
 	for _, segID := range segIDs {
 		seg, err := replica.getSegmentByID(segID, segType)
 		if err != nil {
@@ -100,6 +100,10 @@ type Segment struct {
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
 
+	fieldStats map[UniqueID]*storage.FieldStats // min/max of the scalar fields, set when loading and only used to prune sealed segments
+
+	sortedPKs *sortedPKs // primary keys of a sealed segment whose binlogs are sorted by primary key, nil if unsorted
+
 	pool *concurrency.Pool
 }
 
@@ -132,6 +136,29 @@ func (s *Segment) getType() segmentType {
 	return commonpb.SegmentState(s.segmentType.Load())
 }
 
+// setFieldStats records the min/max statistics of a field, must be called before the segment is set to replica
+func (s *Segment) setFieldStats(stats *storage.FieldStats) {
+	if s.fieldStats == nil {
+		s.fieldStats = make(map[UniqueID]*storage.FieldStats)
+	}
+	s.fieldStats[stats.FieldID] = stats
+}
+
+// getFieldStats returns the min/max statistics of the field, nil if not recorded
+func (s *Segment) getFieldStats(fieldID UniqueID) *storage.FieldStats {
+	return s.fieldStats[fieldID]
+}
+
+// setSortedPKs records the sorted primary keys, must be called before the segment is set to replica
+func (s *Segment) setSortedPKs(pks *sortedPKs) {
+	s.sortedPKs = pks
+}
+
+// getSortedPKs returns the sorted primary keys, nil if the segment is not sorted by primary key
+func (s *Segment) getSortedPKs() *sortedPKs {
+	return s.sortedPKs
+}
+
 func (s *Segment) setIndexedFieldInfo(fieldID UniqueID, info *IndexedFieldInfo) {
 	s.indexedFieldInfos.Insert(fieldID, info)
 }
@@ -31,7 +31,7 @@ import (
 	"github.com/milvus-io/milvus/api/commonpb"
 	"github.com/milvus-io/milvus/api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -63,7 +63,7 @@ type segmentLoader struct {
 	dataCoord types.DataCoord
 
 	cm     storage.ChunkManager // minio cm
-	etcdKV *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 
 	ioPool  *concurrency.Pool
 	cpuPool *concurrency.Pool
@@ -285,6 +285,14 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		}
 	}
 
+	if segment.getType() == segmentTypeSealed {
+		log.Debug("loading field stats...", zap.Int64("segmentID", segmentID))
+		err = loader.loadSegmentFieldStats(ctx, segment, loadInfo, pkFieldID)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Debug("loading delta...", zap.Int64("segmentID", segmentID))
 	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 	return err
@@ -312,8 +320,20 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 
 	// change all field bin log loading into concurrent
 	loadFutures := make([]*concurrency.Future, 0, len(fieldBinlogs))
+	// the fields of a storage v2 segment file share the same path, load it once
+	segmentFiles := make(map[string]struct{})
 	for _, fieldBinlog := range fieldBinlogs {
-		futures := loader.loadFieldBinlogsAsync(ctx, fieldBinlog)
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if storage.IsSegmentFilePath(binlog.GetLogPath()) {
+				if _, ok := segmentFiles[binlog.GetLogPath()]; ok {
+					continue
+				}
+				segmentFiles[binlog.GetLogPath()] = struct{}{}
+			}
+			binlogs = append(binlogs, binlog)
+		}
+		futures := loader.loadFieldBinlogsAsync(ctx, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID(), Binlogs: binlogs}, false)
 		loadFutures = append(loadFutures, futures...)
 	}
 
@@ -393,37 +413,101 @@ func (loader *segmentLoader) loadSealedFieldAsync(ctx context.Context, segment *
 	// Avoid consuming too much memory if no CPU worker ready,
 	// acquire a CPU worker before load field binlogs
 	return loader.cpuPool.Submit(func() (interface{}, error) {
-		futures := loader.loadFieldBinlogsAsync(ctx, field)
+		futures := loader.loadFieldBinlogsAsync(ctx, field, true)
 
-		blobs := make([]*storage.Blob, len(futures))
-		for index, future := range futures {
+		insertData := storage.InsertData{
+			Data: make(map[int64]storage.FieldData),
+		}
+		// deserialize in the order of the binlogs, which may be of both storage versions
+		for _, future := range futures {
 			if !future.OK() {
 				return nil, future.Err()
 			}
 
-			blob := future.Value().(*storage.Blob)
-			blobs[index] = blob
-		}
-
-		insertData := storage.InsertData{
-			Data: make(map[int64]storage.FieldData),
+			var err error
+			switch value := future.Value().(type) {
+			case *storage.Blob:
+				memorySize := fieldMemorySize(&insertData, field.GetFieldID())
+				_, _, _, err = iCodec.DeserializeInto([]*storage.Blob{value}, int(loadInfo.GetNumOfRows()), &insertData)
+				if err == nil {
+					observeBinlogCompressionRatio(value, fieldMemorySize(&insertData, field.GetFieldID())-memorySize)
+				}
+			case *storage.SegmentFileReader:
+				err = value.ReadFieldInto(field.GetFieldID(), &insertData)
+				value.Close()
+			}
+			if err != nil {
+				log.Warn(err.Error())
+				return nil, err
+			}
 		}
-		_, _, _, err := iCodec.DeserializeInto(blobs, int(loadInfo.GetNumOfRows()), &insertData)
-		if err != nil {
-			log.Warn(err.Error())
-			return nil, err
+		if loadInfo.GetSorted() {
+			loader.loadSortedPKs(segment, &insertData)
 		}
 
 		return nil, loader.loadSealedSegments(segment, &insertData)
 	})
 }
 
-// Load binlogs concurrently into memory from KV storage asyncly
-func (loader *segmentLoader) loadFieldBinlogsAsync(ctx context.Context, field *datapb.FieldBinlog) []*concurrency.Future {
+// fieldMemorySize returns the memory size of the field data loaded into insertData
+func fieldMemorySize(insertData *storage.InsertData, fieldID int64) int {
+	if fieldData, ok := insertData.Data[fieldID]; ok {
+		return fieldData.GetMemorySize()
+	}
+	return 0
+}
+
+// observeBinlogCompressionRatio observes the ratio of the memory size of the field data read from
+// an insert binlog to the binlog size, by the compression recorded in the binlog
+func observeBinlogCompressionRatio(blob *storage.Blob, memorySize int) {
+	if len(blob.GetValue()) == 0 {
+		return
+	}
+	compression, err := storage.GetBinlogCompression(blob.GetValue())
+	if err != nil {
+		return
+	}
+	metrics.QueryNodeBinlogCompressionRatio.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID()), string(compression.Type)).
+		Observe(float64(memorySize) / float64(len(blob.GetValue())))
+}
+
+// loadSortedPKs keeps the primary keys of a sealed segment whose binlogs are sorted by primary key,
+// so that the existence of a primary key can be decided by binary search
+func (loader *segmentLoader) loadSortedPKs(segment *Segment, insertData *storage.InsertData) {
+	pkFieldID, err := loader.metaReplica.getPKFieldIDByCollectionID(segment.collectionID)
+	if err != nil {
+		return
+	}
+	pkData, ok := insertData.Data[pkFieldID]
+	if !ok {
+		return
+	}
+	pks := newSortedPKs(pkData)
+	if pks == nil {
+		log.Warn("primary keys of the sorted segment are not sorted",
+			zap.Int64("collectionID", segment.collectionID),
+			zap.Int64("segmentID", segment.segmentID))
+		return
+	}
+	segment.setSortedPKs(pks)
+}
+
+// Load binlogs concurrently into memory from KV storage asyncly,
+// the segment files of storage v2 are only opened if @byColumn, so that just the column of the field is fetched later.
+func (loader *segmentLoader) loadFieldBinlogsAsync(ctx context.Context, field *datapb.FieldBinlog, byColumn bool) []*concurrency.Future {
 	futures := make([]*concurrency.Future, 0, len(field.Binlogs))
 	for i := range field.Binlogs {
 		path := field.Binlogs[i].GetLogPath()
 		future := loader.ioPool.Submit(func() (interface{}, error) {
+			if byColumn && storage.IsSegmentFilePath(path) {
+				reader, err := storage.OpenSegmentFile(ctx, loader.cm, path)
+				if err != nil {
+					log.Warn("failed to open segment file", zap.String("filePath", path), zap.Error(err))
+					return nil, err
+				}
+				return reader, nil
+			}
+
 			binLog, err := loader.cm.Read(ctx, path)
 			if err != nil {
 				log.Warn("failed to load binlog", zap.String("filePath", path), zap.Error(err))
@@ -643,6 +727,9 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 		log.Warn("failed to deserialize stats", zap.Error(err))
 		return err
 	}
+	if pkStats := mergePKFieldStats(stats); pkStats != nil {
+		segment.setFieldStats(pkStats)
+	}
 	// just one BF, just use it
 	if len(stats) == 1 && stats[0].BF != nil {
 		segment.pkFilter = stats[0].BF
@@ -662,6 +749,67 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 	return nil
 }
 
+// mergePKFieldStats returns the pk range recorded by the pk stats logs, nil if any of them has no range
+func mergePKFieldStats(stats []*storage.PrimaryKeyStats) *storage.FieldStats {
+	var result *storage.FieldStats
+	for _, stat := range stats {
+		if stat.MinPk == nil || stat.MaxPk == nil {
+			return nil
+		}
+		pkStats := storage.NewFieldStats(stat.FieldID, schemapb.DataType(stat.PkType))
+		pkStats.Min = stat.MinPk.GetValue()
+		pkStats.Max = stat.MaxPk.GetValue()
+		if result == nil {
+			result = pkStats
+		} else {
+			result.Merge(pkStats)
+		}
+	}
+	return result
+}
+
+// loadSegmentFieldStats loads the min/max statistics of the non-pk fields of a sealed segment.
+// The statistics are only used to prune segments, so the broken or partial ones are ignored.
+func (loader *segmentLoader) loadSegmentFieldStats(ctx context.Context, segment *Segment, loadInfo *querypb.SegmentLoadInfo, pkFieldID int64) error {
+	var paths []string
+	for _, fieldBinlog := range loadInfo.GetStatslogs() {
+		if fieldBinlog.GetFieldID() == pkFieldID {
+			continue
+		}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	values, err := loader.cm.MultiRead(ctx, paths)
+	if err != nil {
+		return err
+	}
+	blobs := make([]*storage.Blob, 0, len(values))
+	for _, value := range values {
+		blobs = append(blobs, &storage.Blob{Value: value})
+	}
+
+	stats, err := storage.DeserializeFieldStats(blobs)
+	if err != nil {
+		log.Warn("failed to deserialize field stats, skip them", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+		return nil
+	}
+	for fieldID, s := range stats {
+		// stats that don't cover all the rows, e.g. written partly before upgrading, can't prove anything
+		if s.RowNum != loadInfo.GetNumOfRows() || !s.IsValid() {
+			log.Debug("skip partial field stats", zap.Int64("segmentID", segment.segmentID),
+				zap.Int64("fieldID", fieldID), zap.Int64("statsRows", s.RowNum), zap.Int64("segmentRows", loadInfo.GetNumOfRows()))
+			continue
+		}
+		segment.setFieldStats(s)
+	}
+	return nil
+}
+
 func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment, deltaLogs []*datapb.FieldBinlog) error {
 	dCodec := storage.DeleteCodec{}
 	var blobs []*storage.Blob
@@ -934,7 +1082,7 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 
 func newSegmentLoader(
 	metaReplica ReplicaInterface,
-	etcdKV *etcdkv.EtcdKV,
+	etcdKV kv.MetaKv,
 	cm storage.ChunkManager,
 	factory msgstream.Factory,
 	pool *concurrency.Pool) *segmentLoader {
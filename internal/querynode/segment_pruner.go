@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// getPlanPredicates returns the predicates of the serialized plan, nil if there is no predicate or the plan can't be parsed
+func getPlanPredicates(serializedPlan []byte) *planpb.Expr {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil
+	}
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		return node.VectorAnns.GetPredicates()
+	case *planpb.PlanNode_Predicates:
+		return node.Predicates
+	default:
+		return nil
+	}
+}
+
+// pruneSegmentsByStats filters out the sealed segments whose field statistics prove that no row matches @predicates,
+// segments without statistics are always kept.
+func pruneSegmentsByStats(replica ReplicaInterface, segType segmentType, predicates *planpb.Expr, segIDs []UniqueID) []UniqueID {
+	if predicates == nil || segType != segmentTypeSealed || len(segIDs) == 0 {
+		return segIDs
+	}
+
+	result := make([]UniqueID, 0, len(segIDs))
+	for _, segID := range segIDs {
+		seg, err := replica.getSegmentByID(segID, segType)
+		// let the caller handle the missing segment
+		if err != nil || mayMatch(seg, predicates) {
+			result = append(result, segID)
+		}
+	}
+	if len(result) < len(segIDs) {
+		log.Debug("prune segments by field stats",
+			zap.Int("segmentNum", len(segIDs)),
+			zap.Int("prunedNum", len(segIDs)-len(result)))
+	}
+	return result
+}
+
+// mayMatch returns false only if the field statistics of @seg prove that no row matches @expr
+func mayMatch(seg *Segment, expr *planpb.Expr) bool {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return mayMatch(seg, e.BinaryExpr.GetLeft()) && mayMatch(seg, e.BinaryExpr.GetRight())
+		case planpb.BinaryExpr_LogicalOr:
+			return mayMatch(seg, e.BinaryExpr.GetLeft()) || mayMatch(seg, e.BinaryExpr.GetRight())
+		default:
+			return true
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetOp() == planpb.OpType_Equal && e.UnaryRangeExpr.GetColumnInfo().GetIsPrimaryKey() && seg.getSortedPKs() != nil {
+			return seg.getSortedPKs().containsValue(e.UnaryRangeExpr.GetValue())
+		}
+		return unaryRangeMayMatch(seg.getFieldStats(e.UnaryRangeExpr.GetColumnInfo().GetFieldId()), e.UnaryRangeExpr)
+	case *planpb.Expr_BinaryRangeExpr:
+		return binaryRangeMayMatch(seg.getFieldStats(e.BinaryRangeExpr.GetColumnInfo().GetFieldId()), e.BinaryRangeExpr)
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetIsPrimaryKey() && seg.getSortedPKs() != nil {
+			return termContainsPK(seg.getSortedPKs(), e.TermExpr)
+		}
+		return termMayMatch(seg.getFieldStats(e.TermExpr.GetColumnInfo().GetFieldId()), e.TermExpr)
+	default:
+		// NOT, compare and arithmetic expressions can't be decided by min/max
+		return true
+	}
+}
+
+func unaryRangeMayMatch(stats *storage.FieldStats, expr *planpb.UnaryRangeExpr) bool {
+	if stats == nil || stats.Min == nil || stats.Max == nil {
+		return true
+	}
+	value := expr.GetValue()
+	switch expr.GetOp() {
+	case planpb.OpType_GreaterThan:
+		c, ok := compareWithGenericValue(stats, stats.Max, value)
+		return !ok || c > 0
+	case planpb.OpType_GreaterEqual:
+		c, ok := compareWithGenericValue(stats, stats.Max, value)
+		return !ok || c >= 0
+	case planpb.OpType_LessThan:
+		c, ok := compareWithGenericValue(stats, stats.Min, value)
+		return !ok || c < 0
+	case planpb.OpType_LessEqual:
+		c, ok := compareWithGenericValue(stats, stats.Min, value)
+		return !ok || c <= 0
+	case planpb.OpType_Equal:
+		return inStatsRange(stats, value)
+	case planpb.OpType_NotEqual:
+		// only a segment full of the same value can be skipped
+		cMin, ok1 := compareWithGenericValue(stats, stats.Min, value)
+		cMax, ok2 := compareWithGenericValue(stats, stats.Max, value)
+		return !ok1 || !ok2 || cMin != 0 || cMax != 0
+	case planpb.OpType_PrefixMatch:
+		minStr, ok1 := stats.Min.(string)
+		maxStr, ok2 := stats.Max.(string)
+		prefix, ok3 := value.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok1 || !ok2 || !ok3 {
+			return true
+		}
+		// strings with the prefix sort between the prefix itself and the first string greater than it without the prefix
+		return maxStr >= prefix.StringVal && (minStr <= prefix.StringVal || strings.HasPrefix(minStr, prefix.StringVal))
+	default:
+		return true
+	}
+}
+
+func binaryRangeMayMatch(stats *storage.FieldStats, expr *planpb.BinaryRangeExpr) bool {
+	if stats == nil || stats.Min == nil || stats.Max == nil {
+		return true
+	}
+	if c, ok := compareWithGenericValue(stats, stats.Max, expr.GetLowerValue()); ok {
+		if c < 0 || (c == 0 && !expr.GetLowerInclusive()) {
+			return false
+		}
+	}
+	if c, ok := compareWithGenericValue(stats, stats.Min, expr.GetUpperValue()); ok {
+		if c > 0 || (c == 0 && !expr.GetUpperInclusive()) {
+			return false
+		}
+	}
+	return true
+}
+
+func termMayMatch(stats *storage.FieldStats, expr *planpb.TermExpr) bool {
+	if stats == nil || stats.Min == nil || stats.Max == nil {
+		return true
+	}
+	for _, value := range expr.GetValues() {
+		if inStatsRange(stats, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// termContainsPK returns whether any primary key of @expr exists in the sorted primary keys
+func termContainsPK(pks *sortedPKs, expr *planpb.TermExpr) bool {
+	for _, value := range expr.GetValues() {
+		if pks.containsValue(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// inStatsRange returns whether @value may be within [min, max] of @stats
+func inStatsRange(stats *storage.FieldStats, value *planpb.GenericValue) bool {
+	cMin, ok := compareWithGenericValue(stats, stats.Min, value)
+	if !ok {
+		return true
+	}
+	cMax, ok := compareWithGenericValue(stats, stats.Max, value)
+	if !ok {
+		return true
+	}
+	return cMin <= 0 && cMax >= 0
+}
+
+// compareWithGenericValue compares the stats value with the value of the expression,
+// the bool result is false if they are not comparable.
+func compareWithGenericValue(stats *storage.FieldStats, statsValue interface{}, value *planpb.GenericValue) (int, bool) {
+	switch sv := statsValue.(type) {
+	case bool:
+		v, ok := value.GetVal().(*planpb.GenericValue_BoolVal)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case sv == v.BoolVal:
+			return 0, true
+		case !sv:
+			return -1, true
+		default:
+			return 1, true
+		}
+	case int64:
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return compareOrdered(sv, v.Int64Val), true
+		case *planpb.GenericValue_FloatVal:
+			if math.IsNaN(v.FloatVal) {
+				return 0, false
+			}
+			return compareOrdered(float64(sv), v.FloatVal), true
+		default:
+			return 0, false
+		}
+	case float64:
+		var f float64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			f = float64(v.Int64Val)
+		case *planpb.GenericValue_FloatVal:
+			f = v.FloatVal
+		default:
+			return 0, false
+		}
+		if math.IsNaN(f) {
+			return 0, false
+		}
+		// segcore compares the float field in float32
+		if stats.Type == schemapb.DataType_Float {
+			f = float64(float32(f))
+		}
+		return compareOrdered(sv, f), true
+	case string:
+		v, ok := value.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(sv, v.StringVal), true
+	default:
+		return 0, false
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
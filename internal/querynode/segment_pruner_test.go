@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func int64Value(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func floatValue(v float64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+}
+
+func stringValue(v string) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+}
+
+func unaryRange(fieldID int64, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+		Op:         op,
+		Value:      value,
+	}}}
+}
+
+func TestSegmentPruner_mayMatch(t *testing.T) {
+	seg := &Segment{}
+	seg.setFieldStats(&storage.FieldStats{FieldID: 100, Type: schemapb.DataType_Int64, Min: int64(10), Max: int64(20)})
+	seg.setFieldStats(&storage.FieldStats{FieldID: 101, Type: schemapb.DataType_Float, Min: float64(float32(0.1)), Max: float64(float32(0.5))})
+	seg.setFieldStats(&storage.FieldStats{FieldID: 102, Type: schemapb.DataType_VarChar, Min: "apple", Max: "banana"})
+
+	and := func(l, r *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: planpb.BinaryExpr_LogicalAnd, Left: l, Right: r}}}
+	}
+	or := func(l, r *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: planpb.BinaryExpr_LogicalOr, Left: l, Right: r}}}
+	}
+	not := func(child *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{Op: planpb.UnaryExpr_Not, Child: child}}}
+	}
+	binaryRange := func(fieldID int64, lower, upper *planpb.GenericValue, lowerInclusive, upperInclusive bool) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     &planpb.ColumnInfo{FieldId: fieldID},
+			LowerValue:     lower,
+			UpperValue:     upper,
+			LowerInclusive: lowerInclusive,
+			UpperInclusive: upperInclusive,
+		}}}
+	}
+	term := func(fieldID int64, values ...*planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+			Values:     values,
+		}}}
+	}
+
+	cases := []struct {
+		name   string
+		expr   *planpb.Expr
+		expect bool
+	}{
+		{"gt max", unaryRange(100, planpb.OpType_GreaterThan, int64Value(20)), false},
+		{"ge max", unaryRange(100, planpb.OpType_GreaterEqual, int64Value(20)), true},
+		{"lt min", unaryRange(100, planpb.OpType_LessThan, int64Value(10)), false},
+		{"le min", unaryRange(100, planpb.OpType_LessEqual, int64Value(10)), true},
+		{"eq out of range", unaryRange(100, planpb.OpType_Equal, int64Value(21)), false},
+		{"eq in range", unaryRange(100, planpb.OpType_Equal, int64Value(15)), true},
+		{"ne", unaryRange(100, planpb.OpType_NotEqual, int64Value(15)), true},
+		{"int field with float value", unaryRange(100, planpb.OpType_GreaterThan, floatValue(20.5)), false},
+		{"float field compared in float32", unaryRange(101, planpb.OpType_GreaterThan, floatValue(0.5)), false},
+		{"float field with int value", unaryRange(101, planpb.OpType_LessThan, int64Value(0)), false},
+		{"nan value", unaryRange(101, planpb.OpType_NotEqual, floatValue(math.NaN())), true},
+		{"string eq", unaryRange(102, planpb.OpType_Equal, stringValue("cherry")), false},
+		{"prefix match", unaryRange(102, planpb.OpType_PrefixMatch, stringValue("ba")), true},
+		{"prefix mismatch", unaryRange(102, planpb.OpType_PrefixMatch, stringValue("c")), false},
+		{"prefix before min", unaryRange(102, planpb.OpType_PrefixMatch, stringValue("ab")), false},
+		{"incompatible type", unaryRange(102, planpb.OpType_Equal, int64Value(1)), true},
+		{"no stats", unaryRange(103, planpb.OpType_Equal, int64Value(1)), true},
+		{"binary range overlap", binaryRange(100, int64Value(0), int64Value(10), false, true), true},
+		{"binary range exclusive upper", binaryRange(100, int64Value(0), int64Value(10), false, false), false},
+		{"binary range exclusive lower", binaryRange(100, int64Value(20), int64Value(30), false, true), false},
+		{"in hit", term(100, int64Value(1), int64Value(12)), true},
+		{"in miss", term(100, int64Value(1), int64Value(30)), false},
+		{"and", and(unaryRange(100, planpb.OpType_Equal, int64Value(15)), unaryRange(102, planpb.OpType_Equal, stringValue("zoo"))), false},
+		{"or", or(unaryRange(100, planpb.OpType_Equal, int64Value(15)), unaryRange(102, planpb.OpType_Equal, stringValue("zoo"))), true},
+		{"not", not(unaryRange(100, planpb.OpType_GreaterThan, int64Value(20))), true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, mayMatch(seg, c.expr))
+		})
+	}
+}
+
+func TestSegmentPruner_mayMatchSortedPKs(t *testing.T) {
+	seg := &Segment{}
+	seg.setFieldStats(&storage.FieldStats{FieldID: 100, Type: schemapb.DataType_Int64, Min: int64(10), Max: int64(20)})
+	seg.setSortedPKs(newSortedPKs(&storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{10, 15, 20}}))
+
+	pkColumn := &planpb.ColumnInfo{FieldId: 100, IsPrimaryKey: true}
+	pkTerm := func(values ...*planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{ColumnInfo: pkColumn, Values: values}}}
+	}
+	pkEqual := func(value *planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: pkColumn, Op: planpb.OpType_Equal, Value: value,
+		}}}
+	}
+
+	assert.True(t, mayMatch(seg, pkTerm(int64Value(1), int64Value(15))))
+	// within [min, max] but not in the segment
+	assert.False(t, mayMatch(seg, pkTerm(int64Value(11), int64Value(19))))
+	assert.True(t, mayMatch(seg, pkEqual(int64Value(20))))
+	assert.False(t, mayMatch(seg, pkEqual(int64Value(12))))
+	// range expressions on the primary key still use the stats
+	assert.True(t, mayMatch(seg, unaryRange(100, planpb.OpType_GreaterThan, int64Value(12))))
+	// not the primary key column
+	assert.True(t, mayMatch(seg, unaryRange(100, planpb.OpType_Equal, int64Value(12))))
+}
+
+func TestSegmentPruner_pruneSegmentsByStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	replica, err := genSimpleReplicaWithSealSegment(ctx)
+	require.NoError(t, err)
+	seg, err := replica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	require.NoError(t, err)
+	seg.setFieldStats(&storage.FieldStats{FieldID: 100, Type: schemapb.DataType_Int64, Min: int64(10), Max: int64(20)})
+
+	miss := unaryRange(100, planpb.OpType_GreaterThan, int64Value(20))
+	hit := unaryRange(100, planpb.OpType_LessThan, int64Value(20))
+
+	segIDs := []UniqueID{defaultSegmentID}
+	assert.Empty(t, pruneSegmentsByStats(replica, segmentTypeSealed, miss, segIDs))
+	assert.Equal(t, segIDs, pruneSegmentsByStats(replica, segmentTypeSealed, hit, segIDs))
+	assert.Equal(t, segIDs, pruneSegmentsByStats(replica, segmentTypeSealed, nil, segIDs))
+	// growing segments are never pruned
+	assert.Equal(t, segIDs, pruneSegmentsByStats(replica, segmentTypeGrowing, miss, segIDs))
+	// missing segments are left to the caller
+	assert.Equal(t, []UniqueID{defaultSegmentID + 1}, pruneSegmentsByStats(replica, segmentTypeSealed, miss, []UniqueID{defaultSegmentID + 1}))
+}
+
+func TestSegmentPruner_getPlanPredicates(t *testing.T) {
+	predicates := unaryRange(100, planpb.OpType_Equal, int64Value(1))
+
+	plan, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_Predicates{Predicates: predicates}})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(predicates, getPlanPredicates(plan)))
+
+	plan, err = proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{Predicates: predicates}}})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(predicates, getPlanPredicates(plan)))
+
+	assert.Nil(t, getPlanPredicates([]byte{0xff}))
+}
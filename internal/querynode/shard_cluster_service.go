@@ -8,6 +8,8 @@ import (
 	"sync"
 
 	grpcquerynodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util"
@@ -29,6 +31,13 @@ type shardQueryNodeWrapper struct {
 // Stop overrides default close method
 func (w *shardQueryNodeWrapper) Stop() error { return nil }
 
+// shardDetectorKV lists and watches the replicas and segments for the detectors, the keys are full paths.
+// It's etcd, or the local rocksdb if the standalone keeps its meta there.
+type shardDetectorKV interface {
+	LoadWithRevisionAndVersions(key string) ([]string, []string, []int64, int64, error)
+	WatchWithRevisionCtx(ctx context.Context, key string, revision int64) clientv3.WatchChan
+}
+
 // ShardClusterService maintains the online ShardCluster(leader) in this querynode.
 type ShardClusterService struct {
 	client  *clientv3.Client // etcd client for detectors
@@ -50,7 +59,11 @@ func newShardClusterService(client *clientv3.Client, session *sessionutil.Sessio
 
 // addShardCluster adds shardCluster into service.
 func (s *ShardClusterService) addShardCluster(collectionID, replicaID int64, vchannelName string) {
-	nodeDetector := NewEtcdShardNodeDetector(s.client, path.Join(Params.EtcdCfg.MetaRootPath, ReplicaMetaPrefix),
+	var detectorKV shardDetectorKV = etcdkv.NewEtcdKV(s.client, "")
+	if metaKV := rocksdbkv.NewLocalMetaKV(""); metaKV != nil {
+		detectorKV = metaKV
+	}
+	nodeDetector := newShardNodeDetector(detectorKV, path.Join(Params.EtcdCfg.MetaRootPath, ReplicaMetaPrefix),
 		func() (map[int64]string, error) {
 			result := make(map[int64]string)
 			sessions, _, err := s.session.GetSessions(typeutil.QueryNodeRole)
@@ -63,7 +76,7 @@ func (s *ShardClusterService) addShardCluster(collectionID, replicaID int64, vch
 			return result, nil
 		})
 
-	segmentDetector := NewEtcdShardSegmentDetector(s.client, path.Join(Params.EtcdCfg.MetaRootPath, util.SegmentMetaPrefix, strconv.FormatInt(collectionID, 10)))
+	segmentDetector := newShardSegmentDetector(detectorKV, path.Join(Params.EtcdCfg.MetaRootPath, util.SegmentMetaPrefix, strconv.FormatInt(collectionID, 10)))
 
 	cs := NewShardCluster(collectionID, replicaID, vchannelName, nodeDetector, segmentDetector,
 		func(nodeID int64, addr string) shardQueryNode {
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -33,7 +34,7 @@ type addrResolver func() (map[int64]string, error)
 
 // etcdShardNodeDetector watches etcd prefix for node event.
 type etcdShardNodeDetector struct {
-	client *clientv3.Client
+	kv     shardDetectorKV
 	path   string
 	idAddr addrResolver
 	evtCh  chan nodeEvent
@@ -45,8 +46,13 @@ type etcdShardNodeDetector struct {
 
 // NewEtcdShardNodeDetector returns a etcdShardNodeDetector with provided etcd client and prefix path.
 func NewEtcdShardNodeDetector(client *clientv3.Client, rootPath string, resolver addrResolver) *etcdShardNodeDetector {
+	return newShardNodeDetector(etcdkv.NewEtcdKV(client, ""), rootPath, resolver)
+}
+
+// newShardNodeDetector returns a etcdShardNodeDetector watching the prefix path of the kv.
+func newShardNodeDetector(kv shardDetectorKV, rootPath string, resolver addrResolver) *etcdShardNodeDetector {
 	return &etcdShardNodeDetector{
-		client: client,
+		kv:     kv,
 		path:   rootPath,
 		idAddr: resolver,
 
@@ -66,7 +72,7 @@ func (nd *etcdShardNodeDetector) Close() {
 // watchNodes lists current online nodes and returns a channel for incoming events.
 func (nd *etcdShardNodeDetector) watchNodes(collectionID int64, replicaID int64, vchannelName string) ([]nodeEvent, <-chan nodeEvent) {
 	log.Info("nodeDetector watch", zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replicaID), zap.String("vchannelName", vchannelName))
-	resp, err := nd.client.Get(context.Background(), nd.path, clientv3.WithPrefix())
+	keys, values, _, revision, err := nd.kv.LoadWithRevisionAndVersions(nd.path)
 	if err != nil {
 		log.Warn("Etcd NodeDetector get replica info failed", zap.Error(err))
 		panic(err)
@@ -79,10 +85,10 @@ func (nd *etcdShardNodeDetector) watchNodes(collectionID int64, replicaID int64,
 	}
 
 	var nodes []nodeEvent
-	for _, kv := range resp.Kvs {
-		info, err := nd.parseReplicaInfo(kv.Value)
+	for i, value := range values {
+		info, err := nd.parseReplicaInfo([]byte(value))
 		if err != nil {
-			log.Warn("Etcd NodeDetector kv parse failed", zap.String("key", string(kv.Key)), zap.Error(err))
+			log.Warn("Etcd NodeDetector kv parse failed", zap.String("key", keys[i]), zap.Error(err))
 			continue
 		}
 		// skip replica not related
@@ -110,7 +116,7 @@ func (nd *etcdShardNodeDetector) watchNodes(collectionID int64, replicaID int64,
 
 	ctx, cancel := context.WithCancel(context.Background())
 	go nd.cancelClose(cancel)
-	watchCh := nd.client.Watch(ctx, nd.path, clientv3.WithRev(resp.Header.Revision+1), clientv3.WithPrefix(), clientv3.WithPrevKV())
+	watchCh := nd.kv.WatchWithRevisionCtx(ctx, nd.path, revision+1)
 
 	nd.wg.Add(1)
 	go nd.watch(watchCh, collectionID, replicaID)
@@ -138,7 +144,7 @@ func (nd *etcdShardNodeDetector) watch(ch clientv3.WatchChan, collectionID, repl
 			if err := evt.Err(); err != nil {
 				if err == v3rpc.ErrCompacted {
 					ctx, cancel := context.WithCancel(context.Background())
-					watchCh := nd.client.Watch(ctx, nd.path, clientv3.WithPrefix())
+					watchCh := nd.kv.WatchWithRevisionCtx(ctx, nd.path, 0)
 					go nd.cancelClose(cancel)
 					nd.wg.Add(1)
 					go nd.watch(watchCh, collectionID, replicaID)
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -31,9 +32,9 @@ import (
 
 // etcdShardSegmentDetector watch etcd prefix for segment event.
 type etcdShardSegmentDetector struct {
-	client *clientv3.Client
-	path   string
-	evtCh  chan segmentEvent
+	kv    shardDetectorKV
+	path  string
+	evtCh chan segmentEvent
 
 	wg        sync.WaitGroup
 	closeCh   chan struct{}
@@ -42,8 +43,13 @@ type etcdShardSegmentDetector struct {
 
 // NewEtcdShardSegmentDetector returns a segmentDetector with provided etcd client and root path.
 func NewEtcdShardSegmentDetector(client *clientv3.Client, rootPath string) *etcdShardSegmentDetector {
+	return newShardSegmentDetector(etcdkv.NewEtcdKV(client, ""), rootPath)
+}
+
+// newShardSegmentDetector returns a segmentDetector watching the root path of the kv.
+func newShardSegmentDetector(kv shardDetectorKV, rootPath string) *etcdShardSegmentDetector {
 	return &etcdShardSegmentDetector{
-		client:  client,
+		kv:      kv,
 		path:    rootPath,
 		evtCh:   make(chan segmentEvent, 32),
 		closeCh: make(chan struct{}),
@@ -75,15 +81,15 @@ func (sd *etcdShardSegmentDetector) watchSegments(collectionID int64, replicaID
 		zap.Int64("replicaID", replicaID),
 		zap.String("vchannelName", vchannelName),
 		zap.String("rootPath", sd.path))
-	resp, err := sd.client.Get(context.Background(), sd.path, clientv3.WithPrefix())
+	_, values, _, revision, err := sd.kv.LoadWithRevisionAndVersions(sd.path)
 	if err != nil {
 		log.Error("Etcd SegmentDetector get replica info failed", zap.Error(err))
 		panic(err)
 	}
 
 	var events []segmentEvent
-	for _, kv := range resp.Kvs {
-		info, err := sd.parseSegmentInfo(kv.Value)
+	for _, value := range values {
+		info, err := sd.parseSegmentInfo([]byte(value))
 		if err != nil {
 			log.Warn("SegmentDetector failed to parse segmentInfo", zap.Error(err))
 			continue
@@ -104,7 +110,7 @@ func (sd *etcdShardSegmentDetector) watchSegments(collectionID int64, replicaID
 	}
 
 	sd.wg.Add(1)
-	watchCh := sd.client.Watch(sd.getCtx(), sd.path, clientv3.WithRev(resp.Header.GetRevision()+1), clientv3.WithPrefix(), clientv3.WithPrevKV())
+	watchCh := sd.kv.WatchWithRevisionCtx(sd.getCtx(), sd.path, revision+1)
 	go sd.watch(watchCh, collectionID, replicaID, vchannelName)
 
 	return events, sd.evtCh
@@ -125,7 +131,7 @@ func (sd *etcdShardSegmentDetector) watch(ch clientv3.WatchChan, collectionID in
 			if err := evt.Err(); err != nil {
 				if err == v3rpc.ErrCompacted {
 					sd.wg.Add(1)
-					watchCh := sd.client.Watch(sd.getCtx(), sd.path, clientv3.WithPrefix())
+					watchCh := sd.kv.WatchWithRevisionCtx(sd.getCtx(), sd.path, 0)
 					go sd.watch(watchCh, collectionID, replicaID, vchannel)
 					return
 				}
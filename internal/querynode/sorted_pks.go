@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// sortedRuns are the primary keys of the binlogs of a segment, each binlog is sorted by primary key
+type sortedRuns[T int64 | string] [][]T
+
+// newSortedRuns splits @data into runs by @numRows, false if any run is not sorted
+func newSortedRuns[T int64 | string](data []T, numRows []int64) (sortedRuns[T], bool) {
+	runs := make(sortedRuns[T], 0, len(numRows))
+	offset := int64(0)
+	for _, num := range numRows {
+		if num < 0 || offset+num > int64(len(data)) {
+			return nil, false
+		}
+		run := data[offset : offset+num]
+		if !sort.SliceIsSorted(run, func(i, j int) bool { return run[i] < run[j] }) {
+			return nil, false
+		}
+		runs = append(runs, run)
+		offset += num
+	}
+	if offset != int64(len(data)) {
+		return nil, false
+	}
+	return runs, true
+}
+
+func (r sortedRuns[T]) contains(value T) bool {
+	for _, run := range r {
+		if len(run) == 0 || value < run[0] || value > run[len(run)-1] {
+			continue
+		}
+		i := sort.Search(len(run), func(i int) bool { return run[i] >= value })
+		if i < len(run) && run[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedPKs holds the primary keys of a sealed segment loaded from the binlogs sorted by primary key,
+// it answers exactly whether a primary key exists where the bloom filter only tells it may exist.
+type sortedPKs struct {
+	int64PKs   sortedRuns[int64]
+	varCharPKs sortedRuns[string]
+}
+
+// newSortedPKs returns nil if the primary keys are not sorted within every binlog
+func newSortedPKs(data storage.FieldData) *sortedPKs {
+	switch fieldData := data.(type) {
+	case *storage.Int64FieldData:
+		runs, ok := newSortedRuns(fieldData.Data, fieldData.NumRows)
+		if !ok {
+			return nil
+		}
+		return &sortedPKs{int64PKs: runs}
+	case *storage.StringFieldData:
+		runs, ok := newSortedRuns(fieldData.Data, fieldData.NumRows)
+		if !ok {
+			return nil
+		}
+		return &sortedPKs{varCharPKs: runs}
+	default:
+		return nil
+	}
+}
+
+// contains returns whether the primary key exists, true if the type of @pk doesn't match
+func (s *sortedPKs) contains(pk primaryKey) bool {
+	switch v := pk.(type) {
+	case *int64PrimaryKey:
+		return s.int64PKs == nil || s.int64PKs.contains(v.Value)
+	case *varCharPrimaryKey:
+		return s.varCharPKs == nil || s.varCharPKs.contains(v.Value)
+	default:
+		return true
+	}
+}
+
+// containsValue returns whether the primary key of the expression value exists, true if the type doesn't match
+func (s *sortedPKs) containsValue(value *planpb.GenericValue) bool {
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		return s.int64PKs == nil || s.int64PKs.contains(v.Int64Val)
+	case *planpb.GenericValue_StringVal:
+		return s.varCharPKs == nil || s.varCharPKs.contains(v.StringVal)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestSortedPKs_int64(t *testing.T) {
+	// two binlogs, each sorted by primary key
+	pks := newSortedPKs(&storage.Int64FieldData{
+		NumRows: []int64{4, 3},
+		Data:    []int64{1, 3, 3, 7, 2, 4, 9},
+	})
+	require.NotNil(t, pks)
+
+	for _, pk := range []int64{1, 2, 3, 4, 7, 9} {
+		assert.True(t, pks.contains(newInt64PrimaryKey(pk)), pk)
+		assert.True(t, pks.containsValue(int64Value(pk)), pk)
+	}
+	for _, pk := range []int64{0, 5, 6, 8, 10} {
+		assert.False(t, pks.contains(newInt64PrimaryKey(pk)), pk)
+		assert.False(t, pks.containsValue(int64Value(pk)), pk)
+	}
+
+	// mismatched types can't be decided
+	assert.True(t, pks.contains(newVarCharPrimaryKey("1")))
+	assert.True(t, pks.containsValue(stringValue("1")))
+	assert.True(t, pks.containsValue(&planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: true}}))
+}
+
+func TestSortedPKs_varChar(t *testing.T) {
+	pks := newSortedPKs(&storage.StringFieldData{
+		NumRows: []int64{2, 0, 2},
+		Data:    []string{"b", "d", "a", "c"},
+	})
+	require.NotNil(t, pks)
+
+	for _, pk := range []string{"a", "b", "c", "d"} {
+		assert.True(t, pks.contains(newVarCharPrimaryKey(pk)), pk)
+		assert.True(t, pks.containsValue(stringValue(pk)), pk)
+	}
+	for _, pk := range []string{"", "aa", "e"} {
+		assert.False(t, pks.contains(newVarCharPrimaryKey(pk)), pk)
+		assert.False(t, pks.containsValue(stringValue(pk)), pk)
+	}
+	assert.True(t, pks.contains(newInt64PrimaryKey(1)))
+}
+
+func TestSortedPKs_invalid(t *testing.T) {
+	// unsorted binlog
+	assert.Nil(t, newSortedPKs(&storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 3, 2}}))
+	// rows mismatch
+	assert.Nil(t, newSortedPKs(&storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2, 3}}))
+	assert.Nil(t, newSortedPKs(&storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3}}))
+	assert.Nil(t, newSortedPKs(&storage.StringFieldData{NumRows: []int64{-1}, Data: []string{"a"}}))
+	// unsupported type
+	assert.Nil(t, newSortedPKs(&storage.FloatFieldData{NumRows: []int64{1}, Data: []float32{1}}))
+}
@@ -0,0 +1,473 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	backupMetaPrefix = "meta"
+	backupDataPrefix = "data"
+
+	maxBackupNameLength = 255
+)
+
+// backupManager creates point-in-time backups of collections and restores them into new collections.
+//
+// Backups are stored under the backup root path of the persistent storage:
+//   - meta/{backup name} is the serialized BackupMeta of the backup.
+//   - data/{backup name} holds copies of the insert, delta and stats logs of the backed up segments,
+//     laid out like the original binlogs so that they can be restored by the binlog import.
+type backupManager struct {
+	ctx      context.Context
+	core     *Core
+	cm       storage.ChunkManager
+	rootPath string
+
+	mu        sync.Mutex
+	executing map[string]struct{} // names of the backups being copied
+	wg        sync.WaitGroup
+}
+
+func newBackupManager(ctx context.Context, core *Core, cm storage.ChunkManager) *backupManager {
+	return &backupManager{
+		ctx:       ctx,
+		core:      core,
+		cm:        cm,
+		rootPath:  path.Join(cm.RootPath(), Params.RootCoordCfg.BackupRootPath),
+		executing: make(map[string]struct{}),
+	}
+}
+
+// init marks backups left in executing state by a previous rootcoord as failed.
+func (m *backupManager) init(ctx context.Context) error {
+	metas, err := m.loadAllMeta(ctx)
+	if err != nil {
+		return err
+	}
+	for _, meta := range metas {
+		if meta.GetInfo().GetState() != milvuspb.BackupState_BackupExecuting {
+			continue
+		}
+		log.Warn("backup is interrupted by rootcoord restart", zap.String("backup name", meta.GetInfo().GetName()))
+		meta.Info.State = milvuspb.BackupState_BackupFailed
+		meta.Info.FailReason = "interrupted by rootcoord restart"
+		if err := m.saveMeta(ctx, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *backupManager) metaPath(name string) string {
+	return path.Join(m.rootPath, backupMetaPrefix, name)
+}
+
+func (m *backupManager) dataPath(name string) string {
+	return path.Join(m.rootPath, backupDataPrefix, name)
+}
+
+func (m *backupManager) saveMeta(ctx context.Context, meta *rootcoordpb.BackupMeta) error {
+	value, err := proto.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return m.cm.Write(ctx, m.metaPath(meta.GetInfo().GetName()), value)
+}
+
+func (m *backupManager) loadMeta(ctx context.Context, name string) (*rootcoordpb.BackupMeta, error) {
+	value, err := m.cm.Read(ctx, m.metaPath(name))
+	if err != nil {
+		return nil, err
+	}
+	meta := &rootcoordpb.BackupMeta{}
+	if err := proto.Unmarshal(value, meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+func (m *backupManager) loadAllMeta(ctx context.Context) ([]*rootcoordpb.BackupMeta, error) {
+	keys, _, err := m.cm.ListWithPrefix(ctx, path.Join(m.rootPath, backupMetaPrefix)+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	metas := make([]*rootcoordpb.BackupMeta, 0, len(keys))
+	for _, key := range keys {
+		meta, err := m.loadMeta(ctx, path.Base(key))
+		if err != nil {
+			return nil, err
+		}
+		metas = append(metas, meta)
+	}
+	return metas, nil
+}
+
+func validateBackupName(name string) error {
+	if name == "" {
+		return errors.New("backup name should not be empty")
+	}
+	if len(name) > maxBackupNameLength {
+		return fmt.Errorf("the length of backup name must be not greater than %d", maxBackupNameLength)
+	}
+	for _, c := range name {
+		if c != '_' && c != '-' && !isAlpha(c) && !isNumber(c) {
+			return fmt.Errorf("backup name %s can only contain numbers, letters, underscores and dashes", name)
+		}
+	}
+	return nil
+}
+
+func isAlpha(c rune) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isNumber(c rune) bool {
+	return '0' <= c && c <= '9'
+}
+
+// createBackup saves the meta of the backup and starts copying binlogs in background.
+// The returned info is in executing state, it turns into completed or failed once the copy is done.
+func (m *backupManager) createBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.BackupInfo, error) {
+	name := req.GetBackupName()
+	if err := validateBackupName(name); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	if _, ok := m.executing[name]; ok {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("backup %s is in progress", name)
+	}
+	m.executing[name] = struct{}{}
+	m.mu.Unlock()
+	started := false
+	defer func() {
+		if !started {
+			m.finishBackup(name)
+		}
+	}()
+
+	exist, err := m.cm.Exist(ctx, m.metaPath(name))
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, fmt.Errorf("backup %s already exists", name)
+	}
+
+	ts := req.GetBackupTimestamp()
+	if ts == 0 {
+		if ts, err = m.core.tsoAllocator.GenerateTSO(1); err != nil {
+			return nil, err
+		}
+	}
+	coll, err := m.core.meta.GetCollectionByName(ctx, req.GetCollectionName(), ts)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := describeIndexes(ctx, m.core.broker, coll.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := m.listSegments(ctx, coll.CollectionID, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &rootcoordpb.BackupMeta{
+		Info: &milvuspb.BackupInfo{
+			Name:            name,
+			CollectionName:  coll.Name,
+			CollectionID:    coll.CollectionID,
+			BackupTimestamp: ts,
+			State:           milvuspb.BackupState_BackupExecuting,
+		},
+		Schema: &schemapb.CollectionSchema{
+			Name:        coll.Name,
+			Description: coll.Description,
+			AutoID:      coll.AutoID,
+			Fields:      model.MarshalFieldModels(coll.Fields),
+		},
+		ShardsNum:        coll.ShardsNum,
+		ConsistencyLevel: coll.ConsistencyLevel,
+		Indexes:          indexes,
+		Segments:         segments,
+	}
+	for _, partition := range coll.Partitions {
+		meta.Partitions = append(meta.Partitions, &rootcoordpb.BackupPartition{
+			PartitionID:   partition.PartitionID,
+			PartitionName: partition.PartitionName,
+		})
+	}
+	segIDs := make([]UniqueID, 0, len(segments))
+	for _, segment := range segments {
+		segIDs = append(segIDs, segment.GetID())
+		meta.Info.NumRows += segment.GetNumOfRows()
+	}
+	meta.Info.NumSegments = int64(len(segments))
+
+	// Hold the segments during the copy, so that they are not garbage collected after compaction.
+	taskID, err := m.core.idAllocator.AllocOne()
+	if err != nil {
+		return nil, err
+	}
+	if err := m.core.broker.AddSegRefLock(ctx, taskID, segIDs); err != nil {
+		return nil, err
+	}
+	if err := m.saveMeta(ctx, meta); err != nil {
+		m.releaseSegments(taskID, segIDs)
+		return nil, err
+	}
+
+	log.Info("start to copy binlogs of backup",
+		zap.String("backup name", name),
+		zap.String("collection name", coll.Name),
+		zap.Uint64("backup ts", ts),
+		zap.Int("segment num", len(segments)))
+	info := proto.Clone(meta.GetInfo()).(*milvuspb.BackupInfo)
+	started = true
+	m.wg.Add(1)
+	go m.copyBackup(meta, taskID, segIDs)
+	return info, nil
+}
+
+func (m *backupManager) finishBackup(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.executing, name)
+}
+
+func (m *backupManager) releaseSegments(taskID UniqueID, segIDs []UniqueID) {
+	if err := m.core.broker.ReleaseSegRefLock(m.ctx, taskID, segIDs); err != nil {
+		log.Warn("failed to release segment lock of backup", zap.Int64("task ID", taskID), zap.Error(err))
+	}
+}
+
+// listSegments returns the flushed segments of the collection which may contain data written before ts.
+// Rows written after ts are filtered out when the backup is restored.
+func (m *backupManager) listSegments(ctx context.Context, collID UniqueID, ts Timestamp) ([]*datapb.SegmentInfo, error) {
+	segIDs, err := m.core.broker.GetFlushedSegments(ctx, collID)
+	if err != nil {
+		return nil, err
+	}
+	if len(segIDs) == 0 {
+		return nil, nil
+	}
+	infos, err := m.core.broker.GetSegmentInfo(ctx, segIDs)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*datapb.SegmentInfo, 0, len(infos))
+	for _, info := range infos {
+		if info.GetState() != commonpb.SegmentState_Flushed || info.GetIsImporting() {
+			continue
+		}
+		if info.GetStartPosition() != nil && info.GetStartPosition().GetTimestamp() > ts {
+			continue
+		}
+		segments = append(segments, proto.Clone(info).(*datapb.SegmentInfo))
+	}
+	return segments, nil
+}
+
+func (m *backupManager) copyBackup(meta *rootcoordpb.BackupMeta, taskID UniqueID, segIDs []UniqueID) {
+	defer m.wg.Done()
+	defer m.finishBackup(meta.GetInfo().GetName())
+	defer m.releaseSegments(taskID, segIDs)
+
+	name := meta.GetInfo().GetName()
+	size, err := m.copySegments(m.ctx, name, meta.GetSegments())
+	if err != nil {
+		log.Warn("failed to copy binlogs of backup", zap.String("backup name", name), zap.Error(err))
+		meta.Info.State = milvuspb.BackupState_BackupFailed
+		meta.Info.FailReason = err.Error()
+	} else {
+		meta.Info.State = milvuspb.BackupState_BackupCompleted
+		meta.Info.Size = size
+	}
+	if err := m.saveMeta(m.ctx, meta); err != nil {
+		log.Warn("failed to save backup meta", zap.String("backup name", name), zap.Error(err))
+		return
+	}
+	log.Info("backup done", zap.String("backup name", name), zap.String("state", meta.GetInfo().GetState().String()))
+}
+
+// copySegments copies all binlogs of the segments into the backup, and points the log paths at the copies.
+func (m *backupManager) copySegments(ctx context.Context, name string, segments []*datapb.SegmentInfo) (int64, error) {
+	var size int64
+	copyLogs := func(fieldBinlogs []*datapb.FieldBinlog) error {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+				src := binlog.GetLogPath()
+				dst := path.Join(m.dataPath(name), strings.TrimPrefix(src, m.cm.RootPath()))
+				value, err := m.cm.Read(ctx, src)
+				if err != nil {
+					return err
+				}
+				if err := m.cm.Write(ctx, dst, value); err != nil {
+					return err
+				}
+				binlog.LogPath = dst
+				size += int64(len(value))
+			}
+		}
+		return nil
+	}
+	for _, segment := range segments {
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+			if err := copyLogs(fieldBinlogs); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return size, nil
+}
+
+// listBackups returns the backups of a collection, or all backups if the collection name is empty.
+func (m *backupManager) listBackups(ctx context.Context, collectionName string) ([]*milvuspb.BackupInfo, error) {
+	metas, err := m.loadAllMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*milvuspb.BackupInfo, 0, len(metas))
+	for _, meta := range metas {
+		if collectionName != "" && meta.GetInfo().GetCollectionName() != collectionName {
+			continue
+		}
+		infos = append(infos, meta.GetInfo())
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].GetBackupTimestamp() < infos[j].GetBackupTimestamp()
+	})
+	return infos, nil
+}
+
+// restoreBackup creates a new collection with the schema, partitions and indexes of the backup,
+// then imports the backed up binlogs into it. It returns the new collection id and the import task ids.
+func (m *backupManager) restoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (UniqueID, []int64, error) {
+	meta, err := m.loadMeta(ctx, req.GetBackupName())
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to load backup %s: %w", req.GetBackupName(), err)
+	}
+	if meta.GetInfo().GetState() != milvuspb.BackupState_BackupCompleted {
+		return 0, nil, fmt.Errorf("backup %s is not completed, state: %s", req.GetBackupName(), meta.GetInfo().GetState())
+	}
+
+	collectionName := req.GetCollectionName()
+	if collectionName == "" {
+		collectionName = meta.GetInfo().GetCollectionName()
+	}
+	if _, err := m.core.meta.GetCollectionByName(ctx, collectionName, typeutil.MaxTimestamp); err == nil {
+		return 0, nil, fmt.Errorf("collection %s already exists", collectionName)
+	}
+
+	partitionNames := make([]string, 0, len(meta.GetPartitions()))
+	for _, partition := range meta.GetPartitions() {
+		partitionNames = append(partitionNames, partition.GetPartitionName())
+	}
+	coll, err := m.core.createCollectionWith(ctx, collectionName, meta.GetSchema(), meta.GetShardsNum(),
+		meta.GetConsistencyLevel(), partitionNames, meta.GetIndexes())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	tasks, err := m.importSegments(ctx, meta, collectionName)
+	if err != nil {
+		log.Warn("failed to import backup, drop the restored collection",
+			zap.String("backup name", req.GetBackupName()),
+			zap.String("collection name", collectionName), zap.Error(err))
+		m.core.dropCreatedCollection(ctx, collectionName)
+		return 0, nil, err
+	}
+	log.Info("backup is being restored",
+		zap.String("backup name", req.GetBackupName()),
+		zap.String("collection name", collectionName),
+		zap.Int64("collection ID", coll.CollectionID),
+		zap.Int64s("import tasks", tasks))
+	return coll.CollectionID, tasks, nil
+}
+
+// importSegments starts a binlog import for each partition having segments in the backup.
+func (m *backupManager) importSegments(ctx context.Context, meta *rootcoordpb.BackupMeta, collectionName string) ([]int64, error) {
+	partitionHasData := make(map[UniqueID]bool)
+	for _, segment := range meta.GetSegments() {
+		partitionHasData[segment.GetPartitionID()] = true
+	}
+	dataPath := m.dataPath(meta.GetInfo().GetName())
+	collID := strconv.FormatInt(meta.GetInfo().GetCollectionID(), 10)
+	tasks := make([]int64, 0)
+	for _, partition := range meta.GetPartitions() {
+		if !partitionHasData[partition.GetPartitionID()] {
+			continue
+		}
+		partID := strconv.FormatInt(partition.GetPartitionID(), 10)
+		resp, err := m.core.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: collectionName,
+			PartitionName:  partition.GetPartitionName(),
+			Files: []string{
+				path.Join(dataPath, common.SegmentInsertLogPath, collID, partID),
+				path.Join(dataPath, common.SegmentDeltaLogPath, collID, partID),
+			},
+			Options: []*commonpb.KeyValuePair{
+				{Key: EndTs, Value: strconv.FormatUint(meta.GetInfo().GetBackupTimestamp(), 10)},
+			},
+		})
+		if err = checkStatus(resp.GetStatus(), err); err != nil {
+			return nil, fmt.Errorf("failed to import partition %s: %w", partition.GetPartitionName(), err)
+		}
+		tasks = append(tasks, resp.GetTasks()...)
+	}
+	return tasks, nil
+}
+
+func checkStatus(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
@@ -0,0 +1,526 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const backupTestTs = Timestamp(1000)
+
+func newBackupTestCollection() *model.Collection {
+	return &model.Collection{
+		CollectionID: 1,
+		Name:         "coll",
+		ShardsNum:    2,
+		Fields: []*model.Field{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: Params.CommonCfg.DefaultPartitionName},
+			{PartitionID: 11, PartitionName: "p1"},
+		},
+	}
+}
+
+func newBackupTestBroker() *mockBroker {
+	broker := newMockBroker()
+	broker.DescribeIndexFunc = func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status:     succStatus(),
+			IndexInfos: []*indexpb.IndexInfo{{CollectionID: colID, FieldID: 101, IndexName: "idx"}},
+		}, nil
+	}
+	broker.GetFlushedSegmentsFunc = func(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+		return []UniqueID{1000, 1001, 1002}, nil
+	}
+	broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+		return nil
+	}
+	broker.ReleaseSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+		return nil
+	}
+	return broker
+}
+
+// writeBackupTestSegments writes the binlogs of a flushed segment, and returns it with a dropped segment
+// and a segment started after the backup timestamp.
+func writeBackupTestSegments(t *testing.T, cm storage.ChunkManager) []*datapb.SegmentInfo {
+	ctx := context.Background()
+	insertLog := metautil.BuildInsertLogPath(cm.RootPath(), 1, 11, 1000, 101, 1)
+	deltaLog := metautil.BuildDeltaLogPath(cm.RootPath(), 1, 11, 1000, 2)
+	statsLog := metautil.BuildStatsLogPath(cm.RootPath(), 1, 11, 1000, 100, 3)
+	for _, p := range []string{insertLog, deltaLog, statsLog} {
+		require.NoError(t, cm.Write(ctx, p, []byte("binlog")))
+	}
+	return []*datapb.SegmentInfo{
+		{
+			ID:            1000,
+			CollectionID:  1,
+			PartitionID:   11,
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     10,
+			StartPosition: &internalpb.MsgPosition{Timestamp: backupTestTs - 1},
+			Binlogs:       []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: insertLog, LogID: 1}}}},
+			Deltalogs:     []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: deltaLog, LogID: 2}}}},
+			Statslogs:     []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: statsLog, LogID: 3}}}},
+		},
+		{
+			ID:           1001,
+			CollectionID: 1,
+			PartitionID:  11,
+			State:        commonpb.SegmentState_Dropped,
+			NumOfRows:    10,
+		},
+		{
+			ID:            1002,
+			CollectionID:  1,
+			PartitionID:   10,
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     10,
+			StartPosition: &internalpb.MsgPosition{Timestamp: backupTestTs + 1},
+		},
+	}
+}
+
+func newBackupTestCore(t *testing.T, broker Broker, opts ...Opt) (*Core, storage.ChunkManager) {
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		if collectionName != "coll" {
+			return nil, errors.New("collection not found")
+		}
+		return newBackupTestCollection(), nil
+	}
+	opts = append([]Opt{withHealthyCode(), withMeta(meta), withBroker(broker), withValidIDAllocator(), withTsoAllocator(newMockTsoAllocator())}, opts...)
+	c := newTestCore(opts...)
+	c.backupManager = newBackupManager(context.Background(), c, cm)
+	return c, cm
+}
+
+func Test_validateBackupName(t *testing.T) {
+	assert.NoError(t, validateBackupName("backup_2022-10-01"))
+	assert.Error(t, validateBackupName(""))
+	assert.Error(t, validateBackupName("a/b"))
+	assert.Error(t, validateBackupName(strings.Repeat("a", maxBackupNameLength+1)))
+}
+
+func TestBackupManager_CreateBackup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid backup name", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker())
+		_, err := c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "coll", BackupName: "a/b"})
+		assert.Error(t, err)
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker())
+		_, err := c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "not_exist", BackupName: "b1"})
+		assert.Error(t, err)
+		// the name can be used again after a failure.
+		assert.Empty(t, c.backupManager.executing)
+	})
+
+	t.Run("failed to describe index", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.DescribeIndexFunc = func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
+			return &indexpb.DescribeIndexResponse{Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock")}, nil
+		}
+		c, _ := newBackupTestCore(t, broker)
+		_, err := c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "coll", BackupName: "b1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to lock segments", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.GetSegmentInfoFunc = func(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+			return []*datapb.SegmentInfo{{ID: 1000, State: commonpb.SegmentState_Flushed}}, nil
+		}
+		broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+			return errors.New("mock")
+		}
+		c, _ := newBackupTestCore(t, broker)
+		_, err := c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "coll", BackupName: "b1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		var segments []*datapb.SegmentInfo
+		broker.GetSegmentInfoFunc = func(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+			return segments, nil
+		}
+		var lockedSegments, releasedSegments []int64
+		broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+			lockedSegments = segIDs
+			return nil
+		}
+		broker.ReleaseSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+			releasedSegments = segIDs
+			return nil
+		}
+		c, cm := newBackupTestCore(t, broker)
+		segments = writeBackupTestSegments(t, cm)
+
+		info, err := c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{
+			CollectionName:  "coll",
+			BackupName:      "b1",
+			BackupTimestamp: backupTestTs,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, milvuspb.BackupState_BackupExecuting, info.GetState())
+		assert.Equal(t, int64(1), info.GetNumSegments())
+		assert.Equal(t, int64(10), info.GetNumRows())
+		assert.Equal(t, []int64{1000}, lockedSegments)
+
+		c.backupManager.wg.Wait()
+		assert.Equal(t, []int64{1000}, releasedSegments)
+
+		meta, err := c.backupManager.loadMeta(ctx, "b1")
+		require.NoError(t, err)
+		assert.Equal(t, milvuspb.BackupState_BackupCompleted, meta.GetInfo().GetState())
+		assert.Equal(t, int64(3*len("binlog")), meta.GetInfo().GetSize())
+		assert.Equal(t, backupTestTs, meta.GetInfo().GetBackupTimestamp())
+		assert.Equal(t, 2, len(meta.GetPartitions()))
+		assert.Equal(t, 1, len(meta.GetIndexes()))
+		require.Equal(t, 1, len(meta.GetSegments()))
+		copied := metautil.BuildInsertLogPath(c.backupManager.dataPath("b1"), 1, 11, 1000, 101, 1)
+		assert.Equal(t, copied, meta.GetSegments()[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		value, err := cm.Read(ctx, copied)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("binlog"), value)
+		// the segments given by datacoord are not modified.
+		assert.NotEqual(t, copied, segments[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+		_, err = c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "coll", BackupName: "b1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to copy binlogs", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.GetSegmentInfoFunc = func(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+			return []*datapb.SegmentInfo{
+				{
+					ID:      1000,
+					State:   commonpb.SegmentState_Flushed,
+					Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "not_exist"}}}},
+				},
+			}, nil
+		}
+		c, _ := newBackupTestCore(t, broker)
+		_, err := c.backupManager.createBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "coll", BackupName: "b1"})
+		require.NoError(t, err)
+		c.backupManager.wg.Wait()
+
+		meta, err := c.backupManager.loadMeta(ctx, "b1")
+		require.NoError(t, err)
+		assert.Equal(t, milvuspb.BackupState_BackupFailed, meta.GetInfo().GetState())
+		assert.NotEmpty(t, meta.GetInfo().GetFailReason())
+	})
+}
+
+func TestBackupManager_ListBackups(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newBackupTestCore(t, newBackupTestBroker())
+	m := c.backupManager
+
+	backups, err := m.listBackups(ctx, "")
+	assert.NoError(t, err)
+	assert.Empty(t, backups)
+
+	for _, info := range []*milvuspb.BackupInfo{
+		{Name: "b2", CollectionName: "coll2", BackupTimestamp: 2, State: milvuspb.BackupState_BackupCompleted},
+		{Name: "b1", CollectionName: "coll1", BackupTimestamp: 1, State: milvuspb.BackupState_BackupCompleted},
+		{Name: "b3", CollectionName: "coll1", BackupTimestamp: 3, State: milvuspb.BackupState_BackupExecuting},
+	} {
+		require.NoError(t, m.saveMeta(ctx, &rootcoordpb.BackupMeta{Info: info}))
+	}
+
+	backups, err = m.listBackups(ctx, "")
+	assert.NoError(t, err)
+	require.Equal(t, 3, len(backups))
+	assert.Equal(t, "b1", backups[0].GetName())
+	assert.Equal(t, "b2", backups[1].GetName())
+	assert.Equal(t, "b3", backups[2].GetName())
+
+	backups, err = m.listBackups(ctx, "coll1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(backups))
+
+	// executing backups are interrupted when rootcoord restarts.
+	assert.NoError(t, m.init(ctx))
+	meta, err := m.loadMeta(ctx, "b3")
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.BackupState_BackupFailed, meta.GetInfo().GetState())
+	meta, err = m.loadMeta(ctx, "b1")
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.BackupState_BackupCompleted, meta.GetInfo().GetState())
+}
+
+func TestBackupManager_RestoreBackup(t *testing.T) {
+	ctx := context.Background()
+	coll := newBackupTestCollection()
+	backup := &rootcoordpb.BackupMeta{
+		Info: &milvuspb.BackupInfo{
+			Name:            "b1",
+			CollectionName:  "coll",
+			CollectionID:    1,
+			BackupTimestamp: backupTestTs,
+			State:           milvuspb.BackupState_BackupCompleted,
+		},
+		Schema: &schemapb.CollectionSchema{
+			Name:   "coll",
+			Fields: model.MarshalFieldModels(coll.Fields),
+		},
+		ShardsNum: 2,
+		Partitions: []*rootcoordpb.BackupPartition{
+			{PartitionID: 10, PartitionName: Params.CommonCfg.DefaultPartitionName},
+			{PartitionID: 11, PartitionName: "p1"},
+		},
+		Indexes:  []*indexpb.IndexInfo{{CollectionID: 1, FieldID: 101, IndexName: "idx"}},
+		Segments: []*datapb.SegmentInfo{{ID: 1000, CollectionID: 1, PartitionID: 11}},
+	}
+
+	t.Run("backup not found", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker())
+		_, _, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll_restored"})
+		assert.Error(t, err)
+	})
+
+	t.Run("backup not completed", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker())
+		require.NoError(t, c.backupManager.saveMeta(ctx, &rootcoordpb.BackupMeta{
+			Info: &milvuspb.BackupInfo{Name: "b1", State: milvuspb.BackupState_BackupExecuting},
+		}))
+		_, _, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll_restored"})
+		assert.Error(t, err)
+	})
+
+	t.Run("collection already exists", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker())
+		require.NoError(t, c.backupManager.saveMeta(ctx, backup))
+		_, _, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to create collection", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker(), withInvalidScheduler())
+		require.NoError(t, c.backupManager.saveMeta(ctx, backup))
+		_, _, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll_restored"})
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		var createdIndexes []*indexpb.CreateIndexRequest
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			createdIndexes = append(createdIndexes, req)
+			return nil
+		}
+		c, _ := newBackupTestCore(t, broker, withValidScheduler())
+		require.NoError(t, c.backupManager.saveMeta(ctx, backup))
+
+		// the restored collection exists once it is created by the scheduler.
+		created := false
+		restored := newBackupTestCollection()
+		restored.CollectionID = 2
+		restored.Name = "coll_restored"
+		meta := c.meta.(*mockMetaTable)
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			if collectionName != "coll_restored" || !created {
+				return nil, errors.New("collection not found")
+			}
+			return restored.Clone(), nil
+		}
+		c.scheduler.(*mockScheduler).AddTaskFunc = func(t task) error {
+			created = true
+			t.NotifyDone(nil)
+			return nil
+		}
+		meta.GetCollectionVirtualChannelsFunc = func(colID int64) []string {
+			return []string{"ch-1", "ch-2"}
+		}
+		meta.GetPartitionByNameFunc = func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
+			return 21, nil
+		}
+		var importReq *datapb.ImportTaskRequest
+		importFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+			importReq = req
+			return &datapb.ImportTaskResponse{Status: succStatus()}, nil
+		}
+		idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+			return 300, 301, nil
+		}
+		mockKv := &kv.MockMetaKV{}
+		mockKv.InMemKv = sync.Map{}
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, importFunc, nil, nil, nil, nil, nil)
+
+		collID, tasks, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll_restored"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), collID)
+		assert.Equal(t, []int64{300}, tasks)
+
+		require.Equal(t, 1, len(createdIndexes))
+		assert.Equal(t, int64(2), createdIndexes[0].GetCollectionID())
+		assert.Equal(t, "idx", createdIndexes[0].GetIndexName())
+
+		require.NotNil(t, importReq)
+		dataPath := c.backupManager.dataPath("b1")
+		assert.Equal(t, []string{
+			path.Join(dataPath, "insert_log/1/11"),
+			path.Join(dataPath, "delta_log/1/11"),
+		}, importReq.GetImportTask().GetFiles())
+		endTs := ""
+		for _, kv := range importReq.GetImportTask().GetInfos() {
+			if kv.GetKey() == EndTs {
+				endTs = kv.GetValue()
+			}
+		}
+		assert.Equal(t, "1000", endTs)
+	})
+
+	t.Run("failed to import", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker(), withValidScheduler())
+		require.NoError(t, c.backupManager.saveMeta(ctx, backup))
+		created := false
+		restored := newBackupTestCollection()
+		restored.CollectionID = 2
+		restored.Name = "coll_restored"
+		meta := c.meta.(*mockMetaTable)
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			if collectionName != "coll_restored" || !created {
+				return nil, errors.New("collection not found")
+			}
+			return restored.Clone(), nil
+		}
+		meta.GetCollectionVirtualChannelsFunc = func(colID int64) []string {
+			return []string{"ch-1", "ch-2"}
+		}
+		meta.GetPartitionByNameFunc = func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
+			return 0, errors.New("partition not found")
+		}
+		var tasks []task
+		c.scheduler.(*mockScheduler).AddTaskFunc = func(t task) error {
+			if _, ok := t.(*createCollectionTask); ok {
+				created = true
+			}
+			tasks = append(tasks, t)
+			t.NotifyDone(nil)
+			return nil
+		}
+		_, _, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll_restored"})
+		assert.Error(t, err)
+		// the restored collection is dropped.
+		dropTask, ok := tasks[len(tasks)-1].(*dropCollectionTask)
+		assert.True(t, ok)
+		assert.True(t, dropTask.purge)
+	})
+
+	t.Run("field id changed", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker(), withValidScheduler())
+		require.NoError(t, c.backupManager.saveMeta(ctx, backup))
+		created := false
+		restored := newBackupTestCollection()
+		restored.Fields[3].FieldID = 102
+		meta := c.meta.(*mockMetaTable)
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			if !created {
+				return nil, errors.New("collection not found")
+			}
+			return restored, nil
+		}
+		c.scheduler.(*mockScheduler).AddTaskFunc = func(t task) error {
+			created = true
+			t.NotifyDone(nil)
+			return nil
+		}
+		_, _, err := c.backupManager.restoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll_restored"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCore_Backup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		createResp, err := c.CreateBackup(ctx, &milvuspb.CreateBackupRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		listResp, err := c.ListBackups(ctx, &milvuspb.ListBackupsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		restoreResp, err := c.RestoreBackup(ctx, &milvuspb.RestoreBackupRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, restoreResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		c, _ := newBackupTestCore(t, newBackupTestBroker())
+		createResp, err := c.CreateBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "not_exist", BackupName: "b1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		restoreResp, err := c.RestoreBackup(ctx, &milvuspb.RestoreBackupRequest{BackupName: "b1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, restoreResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.GetFlushedSegmentsFunc = func(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+			return nil, nil
+		}
+		c, _ := newBackupTestCore(t, broker)
+		createResp, err := c.CreateBackup(ctx, &milvuspb.CreateBackupRequest{CollectionName: "coll", BackupName: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		assert.Equal(t, "b1", createResp.GetInfo().GetName())
+		c.backupManager.wg.Wait()
+
+		listResp, err := c.ListBackups(ctx, &milvuspb.ListBackupsRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		require.Equal(t, 1, len(listResp.GetBackups()))
+		assert.Equal(t, milvuspb.BackupState_BackupCompleted, listResp.GetBackups()[0].GetState())
+	})
+}
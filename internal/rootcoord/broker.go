@@ -26,6 +26,7 @@ type watchInfo struct {
 // Broker communicates with other components.
 type Broker interface {
 	ReleaseCollection(ctx context.Context, collectionID UniqueID) error
+	ReleasePartitions(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error
 	GetQuerySegmentInfo(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error)
 
 	WatchChannels(ctx context.Context, info *watchInfo) error
@@ -36,10 +37,14 @@ type Broker interface {
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 	UnsetIsImportingState(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
 	MarkSegmentsDropped(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	GetFlushedSegments(ctx context.Context, collID UniqueID) ([]UniqueID, error)
+	GetSegmentInfo(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error)
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	GetSegmentIndexState(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 	DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
+	CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) error
+	CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error)
 }
 
 type ServerBroker struct {
@@ -70,6 +75,27 @@ func (b *ServerBroker) ReleaseCollection(ctx context.Context, collectionID Uniqu
 	return nil
 }
 
+func (b *ServerBroker) ReleasePartitions(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error {
+	log.Info("releasing partitions", zap.Int64("collection", collectionID), zap.Int64s("partitions", partitionIDs))
+
+	resp, err := b.s.queryCoord.ReleasePartitions(ctx, &querypb.ReleasePartitionsRequest{
+		Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_ReleasePartitions},
+		CollectionID: collectionID,
+		PartitionIDs: partitionIDs,
+		NodeID:       b.s.session.ServerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to release partitions, code: %s, reason: %s", resp.GetErrorCode(), resp.GetReason())
+	}
+
+	log.Info("done to release partitions", zap.Int64("collection", collectionID), zap.Int64s("partitions", partitionIDs))
+	return nil
+}
+
 func (b *ServerBroker) GetQuerySegmentInfo(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error) {
 	resp, err := b.s.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
 		Base: &commonpb.MsgBase{
@@ -192,6 +218,42 @@ func (b *ServerBroker) MarkSegmentsDropped(ctx context.Context, req *datapb.Mark
 	return b.s.dataCoord.MarkSegmentsDropped(ctx, req)
 }
 
+func (b *ServerBroker) GetFlushedSegments(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+	resp, err := b.s.dataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Flush,
+			SourceID: b.s.session.ServerID,
+		},
+		CollectionID: collID,
+		PartitionID:  -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetSegments(), nil
+}
+
+func (b *ServerBroker) GetSegmentInfo(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+	resp, err := b.s.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetSegmentState,
+			SourceID: b.s.session.ServerID,
+		},
+		SegmentIDs:       segIDs,
+		IncludeUnHealthy: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetInfos(), nil
+}
+
 func (b *ServerBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	rsp, err := b.s.indexCoord.DropIndex(ctx, &indexpb.DropIndexRequest{
 		CollectionID: collID,
@@ -228,3 +290,25 @@ func (b *ServerBroker) DescribeIndex(ctx context.Context, colID UniqueID) (*inde
 		CollectionID: colID,
 	})
 }
+
+func (b *ServerBroker) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+	rsp, err := b.s.indexCoord.CreateIndex(ctx, req)
+	if err != nil {
+		return err
+	}
+	if rsp.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(rsp.GetReason())
+	}
+	return nil
+}
+
+func (b *ServerBroker) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error) {
+	resp, err := b.s.dataCoord.CloneSegments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetSegmentIDs(), nil
+}
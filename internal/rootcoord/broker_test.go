@@ -37,6 +37,32 @@ func TestServerBroker_ReleaseCollection(t *testing.T) {
 	})
 }
 
+func TestServerBroker_ReleasePartitions(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidQueryCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		err := b.ReleasePartitions(ctx, 1, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedQueryCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		err := b.ReleasePartitions(ctx, 1, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidQueryCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		err := b.ReleasePartitions(ctx, 1, 2)
+		assert.NoError(t, err)
+	})
+}
+
 func TestServerBroker_GetSegmentInfo(t *testing.T) {
 	t.Run("failed to execute", func(t *testing.T) {
 		c := newTestCore(withInvalidQueryCoord())
@@ -276,3 +302,126 @@ func TestServerBroker_GetSegmentIndexState(t *testing.T) {
 		assert.Equal(t, commonpb.IndexState_Finished, states[0].GetState())
 	})
 }
+
+func TestServerBroker_GetFlushedSegments(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.GetFlushedSegments(ctx, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.GetFlushedSegments(ctx, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidDataCoord())
+		c.dataCoord.(*mockDataCoord).GetFlushedSegmentsFunc = func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, int64(-1), req.GetPartitionID())
+			return &datapb.GetFlushedSegmentsResponse{
+				Status:   succStatus(),
+				Segments: []int64{100, 101},
+			}, nil
+		}
+		b := newServerBroker(c)
+		ctx := context.Background()
+		segIDs, err := b.GetFlushedSegments(ctx, 1)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{100, 101}, segIDs)
+	})
+}
+
+func TestServerBroker_GetSegmentInfoFromDataCoord(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.GetSegmentInfo(ctx, []UniqueID{100})
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.GetSegmentInfo(ctx, []UniqueID{100})
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidDataCoord())
+		c.dataCoord.(*mockDataCoord).GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+			assert.True(t, req.GetIncludeUnHealthy())
+			return &datapb.GetSegmentInfoResponse{
+				Status: succStatus(),
+				Infos:  []*datapb.SegmentInfo{{ID: 100}},
+			}, nil
+		}
+		b := newServerBroker(c)
+		ctx := context.Background()
+		infos, err := b.GetSegmentInfo(ctx, []UniqueID{100})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(infos))
+		assert.Equal(t, int64(100), infos[0].GetID())
+	})
+}
+
+func TestServerBroker_CreateIndex(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidIndexCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		err := b.CreateIndex(ctx, &indexpb.CreateIndexRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedIndexCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		err := b.CreateIndex(ctx, &indexpb.CreateIndexRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidIndexCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		err := b.CreateIndex(ctx, &indexpb.CreateIndexRequest{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestServerBroker_CloneSegments(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.CloneSegments(ctx, &datapb.CloneSegmentsRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.CloneSegments(ctx, &datapb.CloneSegmentsRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		segIDs, err := b.CloneSegments(ctx, &datapb.CloneSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, []UniqueID{1000}, segIDs)
+	})
+}
@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// cloneCollection creates a new collection with the schema, partitions and indexes of the source collection,
+// then DataCoord clones the segments having data before the clone timestamp into the new collection. The data before
+// the timestamp must be flushed, otherwise the clone fails and the new collection is dropped.
+// The cloned segments share binlogs with the source segments, no data is copied.
+func (c *Core) cloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (UniqueID, []UniqueID, error) {
+	ts := req.GetTimestamp()
+	if ts == 0 {
+		var err error
+		if ts, err = c.tsoAllocator.GenerateTSO(1); err != nil {
+			return 0, nil, err
+		}
+	}
+	source, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), ts)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := c.meta.GetCollectionByName(ctx, req.GetNewCollectionName(), typeutil.MaxTimestamp); err == nil {
+		return 0, nil, fmt.Errorf("collection %s already exists", req.GetNewCollectionName())
+	}
+	indexes, err := describeIndexes(ctx, c.broker, source.CollectionID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        source.Name,
+		Description: source.Description,
+		AutoID:      source.AutoID,
+		Fields:      model.MarshalFieldModels(source.Fields),
+	}
+	partitionNames := make([]string, 0, len(source.Partitions))
+	for _, partition := range source.Partitions {
+		partitionNames = append(partitionNames, partition.PartitionName)
+	}
+	target, err := c.createCollectionWith(ctx, req.GetNewCollectionName(), schema, source.ShardsNum,
+		source.ConsistencyLevel, partitionNames, indexes)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	segIDs, err := c.cloneSegments(ctx, source, target, ts)
+	if err != nil {
+		log.Warn("failed to clone segments, drop the new collection",
+			zap.String("collection name", target.Name), zap.Error(err))
+		c.dropCreatedCollection(ctx, target.Name)
+		return 0, nil, err
+	}
+	log.Info("collection cloned",
+		zap.String("collection name", source.Name),
+		zap.String("new collection name", target.Name),
+		zap.Int64("new collection ID", target.CollectionID),
+		zap.Uint64("clone ts", ts),
+		zap.Int64s("segment IDs", segIDs))
+	return target.CollectionID, segIDs, nil
+}
+
+// cloneSegments maps the partitions by name and the channels by shard index, then asks DataCoord
+// to clone the segments of the source collection.
+func (c *Core) cloneSegments(ctx context.Context, source, target *model.Collection, ts Timestamp) ([]UniqueID, error) {
+	targetPartitions := make(map[string]UniqueID, len(target.Partitions))
+	for _, partition := range target.Partitions {
+		targetPartitions[partition.PartitionName] = partition.PartitionID
+	}
+	partitionMapping := make(map[int64]int64, len(source.Partitions))
+	for _, partition := range source.Partitions {
+		partitionID, ok := targetPartitions[partition.PartitionName]
+		if !ok {
+			return nil, fmt.Errorf("partition %s not found in collection %s", partition.PartitionName, target.Name)
+		}
+		partitionMapping[partition.PartitionID] = partitionID
+	}
+
+	if len(source.VirtualChannelNames) != len(target.VirtualChannelNames) {
+		return nil, fmt.Errorf("shards number mismatch, source: %d, target: %d",
+			len(source.VirtualChannelNames), len(target.VirtualChannelNames))
+	}
+	channelMapping := make(map[string]string, len(source.VirtualChannelNames))
+	for i, channel := range source.VirtualChannelNames {
+		channelMapping[channel] = target.VirtualChannelNames[i]
+	}
+
+	// Positions of the cloned segments are taken after the new collection is created.
+	now, err := c.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return nil, err
+	}
+	return c.broker.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			Timestamp: now,
+			SourceID:  c.session.ServerID,
+		},
+		CollectionID:       source.CollectionID,
+		TargetCollectionID: target.CollectionID,
+		PartitionMapping:   partitionMapping,
+		ChannelMapping:     channelMapping,
+		Timestamp:          ts,
+	})
+}
+
+// createCollectionWith creates a collection with the given schema, partitions and indexes.
+// Binlogs are located by field id, so the field ids of the schema are kept by the new collection.
+// If any step fails after the collection is created, the collection is dropped again.
+func (c *Core) createCollectionWith(ctx context.Context, collectionName string, schema *schemapb.CollectionSchema, shardsNum int32,
+	consistencyLevel commonpb.ConsistencyLevel, partitionNames []string, indexes []*indexpb.IndexInfo) (*model.Collection, error) {
+	// RowID and Timestamp fields are added again when the collection is created.
+	newSchema := &schemapb.CollectionSchema{
+		Name:        collectionName,
+		Description: schema.GetDescription(),
+		AutoID:      schema.GetAutoID(),
+	}
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			newSchema.Fields = append(newSchema.Fields, field)
+		}
+	}
+	schemaBytes, err := proto.Marshal(newSchema)
+	if err != nil {
+		return nil, err
+	}
+	status, err := c.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:             &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName:   collectionName,
+		Schema:           schemaBytes,
+		ShardsNum:        shardsNum,
+		ConsistencyLevel: consistencyLevel,
+	})
+	if err = checkStatus(status, err); err != nil {
+		return nil, fmt.Errorf("failed to create collection %s: %w", collectionName, err)
+	}
+
+	coll, err := c.setUpCreatedCollection(ctx, collectionName, newSchema, partitionNames, indexes)
+	if err != nil {
+		log.Warn("failed to set up the new collection, drop it",
+			zap.String("collection name", collectionName), zap.Error(err))
+		c.dropCreatedCollection(ctx, collectionName)
+		return nil, err
+	}
+	return coll, nil
+}
+
+// setUpCreatedCollection checks the field ids of the collection just created, then creates the partitions and indexes.
+func (c *Core) setUpCreatedCollection(ctx context.Context, collectionName string, schema *schemapb.CollectionSchema,
+	partitionNames []string, indexes []*indexpb.IndexInfo) (*model.Collection, error) {
+	coll, err := c.meta.GetCollectionByName(ctx, collectionName, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make(map[string]UniqueID, len(coll.Fields))
+	for _, field := range coll.Fields {
+		fieldIDs[field.Name] = field.FieldID
+	}
+	for _, field := range schema.GetFields() {
+		if fieldIDs[field.GetName()] != field.GetFieldID() {
+			return nil, fmt.Errorf("field id of %s changed from %d to %d", field.GetName(), field.GetFieldID(), fieldIDs[field.GetName()])
+		}
+	}
+
+	for _, partitionName := range partitionNames {
+		if partitionName == Params.CommonCfg.DefaultPartitionName {
+			continue
+		}
+		status, err := c.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+		})
+		if err = checkStatus(status, err); err != nil {
+			return nil, fmt.Errorf("failed to create partition %s: %w", partitionName, err)
+		}
+	}
+
+	for _, index := range indexes {
+		ts, err := c.tsoAllocator.GenerateTSO(1)
+		if err != nil {
+			return nil, err
+		}
+		err = c.broker.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID: coll.CollectionID,
+			FieldID:      index.GetFieldID(),
+			IndexName:    index.GetIndexName(),
+			TypeParams:   index.GetTypeParams(),
+			IndexParams:  index.GetIndexParams(),
+			Timestamp:    ts,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create index %s: %w", index.GetIndexName(), err)
+		}
+	}
+
+	// reload the collection to get the partitions just created
+	return c.meta.GetCollectionByName(ctx, collectionName, typeutil.MaxTimestamp)
+}
+
+// dropCreatedCollection drops a collection created by createCollectionWith when a later step fails,
+// the half-built collection skips the recycle bin. Errors are only logged since the caller already
+// fails with the original error.
+func (c *Core) dropCreatedCollection(ctx context.Context, collectionName string) {
+	t := &dropCollectionTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: &milvuspb.DropCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+			CollectionName: collectionName,
+		},
+		purge: true,
+	}
+	err := c.scheduler.AddTask(t)
+	if err == nil {
+		err = t.WaitToFinish()
+	}
+	if err != nil {
+		log.Warn("failed to drop the new collection", zap.String("collection name", collectionName), zap.Error(err))
+	}
+}
+
+func describeIndexes(ctx context.Context, broker Broker, collID UniqueID) ([]*indexpb.IndexInfo, error) {
+	resp, err := broker.DescribeIndex(ctx, collID)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.GetStatus().GetErrorCode() {
+	case commonpb.ErrorCode_Success:
+		return resp.GetIndexInfos(), nil
+	case commonpb.ErrorCode_IndexNotExist:
+		return nil, nil
+	default:
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+}
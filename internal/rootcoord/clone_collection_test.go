@@ -0,0 +1,258 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// newCloneTestCore returns a core whose scheduler creates the collection "coll_cloned" from the collection "coll".
+func newCloneTestCore(t *testing.T, broker *mockBroker) (*Core, *[]task) {
+	source := newBackupTestCollection()
+	source.VirtualChannelNames = []string{"ch-1", "ch-2"}
+	cloned := newBackupTestCollection()
+	cloned.CollectionID = 2
+	cloned.Name = "coll_cloned"
+	cloned.VirtualChannelNames = []string{"ch-3", "ch-4"}
+	cloned.Partitions = []*model.Partition{
+		{PartitionID: 20, PartitionName: Params.CommonCfg.DefaultPartitionName},
+		{PartitionID: 21, PartitionName: "p1"},
+	}
+
+	c, _ := newBackupTestCore(t, broker, withValidScheduler())
+	created := false
+	meta := c.meta.(*mockMetaTable)
+	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		switch {
+		case collectionName == "coll":
+			return source.Clone(), nil
+		case collectionName == "coll_cloned" && created:
+			return cloned.Clone(), nil
+		default:
+			return nil, errors.New("collection not found")
+		}
+	}
+	var tasks []task
+	c.scheduler.(*mockScheduler).AddTaskFunc = func(t task) error {
+		if _, ok := t.(*createCollectionTask); ok {
+			created = true
+		}
+		tasks = append(tasks, t)
+		t.NotifyDone(nil)
+		return nil
+	}
+	return c, &tasks
+}
+
+func TestCore_cloneCollection(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("source collection not found", func(t *testing.T) {
+		c, _ := newCloneTestCore(t, newBackupTestBroker())
+		_, _, err := c.cloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "not_exist", NewCollectionName: "coll_cloned"})
+		assert.Error(t, err)
+	})
+
+	t.Run("new collection already exists", func(t *testing.T) {
+		c, _ := newCloneTestCore(t, newBackupTestBroker())
+		_, _, err := c.cloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "coll"})
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to describe indexes", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.DescribeIndexFunc = func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
+			return nil, errors.New("mock")
+		}
+		c, _ := newCloneTestCore(t, broker)
+		_, _, err := c.cloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "coll_cloned"})
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to create index", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			return errors.New("mock")
+		}
+		cloned := false
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error) {
+			cloned = true
+			return nil, nil
+		}
+		c, tasks := newCloneTestCore(t, broker)
+		_, _, err := c.cloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "coll_cloned"})
+		assert.Error(t, err)
+		assert.False(t, cloned)
+		// the half created collection is dropped.
+		dropTask, ok := (*tasks)[len(*tasks)-1].(*dropCollectionTask)
+		assert.True(t, ok)
+		assert.True(t, dropTask.purge)
+	})
+
+	t.Run("failed to clone segments", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			return nil
+		}
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error) {
+			return nil, errors.New("mock")
+		}
+		c, tasks := newCloneTestCore(t, broker)
+		_, _, err := c.cloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "coll_cloned"})
+		assert.Error(t, err)
+		// the new collection is dropped.
+		dropTask, ok := (*tasks)[len(*tasks)-1].(*dropCollectionTask)
+		assert.True(t, ok)
+		assert.True(t, dropTask.purge)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		var createdIndexes []*indexpb.CreateIndexRequest
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			createdIndexes = append(createdIndexes, req)
+			return nil
+		}
+		var cloneReq *datapb.CloneSegmentsRequest
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error) {
+			cloneReq = req
+			return []UniqueID{2000}, nil
+		}
+		c, _ := newCloneTestCore(t, broker)
+		collID, segIDs, err := c.cloneCollection(ctx, &milvuspb.CloneCollectionRequest{
+			CollectionName:    "coll",
+			NewCollectionName: "coll_cloned",
+			Timestamp:         backupTestTs,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), collID)
+		assert.Equal(t, []int64{2000}, segIDs)
+
+		require.Equal(t, 1, len(createdIndexes))
+		assert.Equal(t, int64(2), createdIndexes[0].GetCollectionID())
+
+		require.NotNil(t, cloneReq)
+		assert.Equal(t, int64(1), cloneReq.GetCollectionID())
+		assert.Equal(t, int64(2), cloneReq.GetTargetCollectionID())
+		assert.Equal(t, backupTestTs, cloneReq.GetTimestamp())
+		assert.Equal(t, map[int64]int64{10: 20, 11: 21}, cloneReq.GetPartitionMapping())
+		assert.Equal(t, map[string]string{"ch-1": "ch-3", "ch-2": "ch-4"}, cloneReq.GetChannelMapping())
+	})
+}
+
+func TestCore_createCollectionWith(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{Name: "coll", Fields: model.MarshalFieldModels(newBackupTestCollection().Fields)}
+	indexes := []*indexpb.IndexInfo{{CollectionID: 1, FieldID: 101, IndexName: "idx"}}
+	isDropped := func(tasks []task) bool {
+		dropTask, ok := tasks[len(tasks)-1].(*dropCollectionTask)
+		return ok && dropTask.purge
+	}
+
+	t.Run("field id changed", func(t *testing.T) {
+		changed := proto.Clone(schema).(*schemapb.CollectionSchema)
+		changed.Fields[3].FieldID = 150
+		c, tasks := newCloneTestCore(t, newBackupTestBroker())
+		_, err := c.createCollectionWith(ctx, "coll_cloned", changed, 2, commonpb.ConsistencyLevel_Strong, nil, nil)
+		assert.Error(t, err)
+		assert.True(t, isDropped(*tasks))
+	})
+
+	t.Run("failed to create partition", func(t *testing.T) {
+		c, tasks := newCloneTestCore(t, newBackupTestBroker())
+		addTask := c.scheduler.(*mockScheduler).AddTaskFunc
+		c.scheduler.(*mockScheduler).AddTaskFunc = func(t task) error {
+			if _, ok := t.(*createPartitionTask); ok {
+				return errors.New("mock")
+			}
+			return addTask(t)
+		}
+		_, err := c.createCollectionWith(ctx, "coll_cloned", schema, 2, commonpb.ConsistencyLevel_Strong, []string{"p1"}, nil)
+		assert.Error(t, err)
+		assert.True(t, isDropped(*tasks))
+	})
+
+	t.Run("failed to create index", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			return errors.New("mock")
+		}
+		c, tasks := newCloneTestCore(t, broker)
+		_, err := c.createCollectionWith(ctx, "coll_cloned", schema, 2, commonpb.ConsistencyLevel_Strong, []string{"p1"}, indexes)
+		assert.Error(t, err)
+		assert.True(t, isDropped(*tasks))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			return nil
+		}
+		c, tasks := newCloneTestCore(t, broker)
+		coll, err := c.createCollectionWith(ctx, "coll_cloned", schema, 2, commonpb.ConsistencyLevel_Strong, []string{"p1"}, indexes)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), coll.CollectionID)
+		assert.False(t, isDropped(*tasks))
+	})
+}
+
+func TestCore_CloneCollection(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CloneCollection(ctx, &milvuspb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		c, _ := newCloneTestCore(t, newBackupTestBroker())
+		resp, err := c.CloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "not_exist", NewCollectionName: "coll_cloned"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newBackupTestBroker()
+		broker.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+			return nil
+		}
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error) {
+			return []UniqueID{2000}, nil
+		}
+		c, _ := newCloneTestCore(t, broker)
+		resp, err := c.CloneCollection(ctx, &milvuspb.CloneCollectionRequest{CollectionName: "coll", NewCollectionName: "coll_cloned"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(2), resp.GetCollectionID())
+		assert.Equal(t, []int64{2000}, resp.GetSegmentIDs())
+	})
+}
@@ -0,0 +1,445 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	// ddlJobPrefix is the prefix of the persisted DDL jobs.
+	ddlJobPrefix = "root-coord/ddl-job"
+
+	// ddlJobCleanupInterval is the interval to remove the expired DDL jobs.
+	ddlJobCleanupInterval = time.Minute
+
+	ddlJobInterruptedReason = "interrupted by the restart of rootcoord"
+)
+
+type ddlJobCtxKey struct{}
+
+// withDDLJob returns a context carrying the job, so that the steps executed with it can be tracked.
+func withDDLJob(ctx context.Context, job *ddlJob) context.Context {
+	if job == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ddlJobCtxKey{}, job)
+}
+
+// ddlJobFromContext returns the job carried by the context, nil if there is none.
+func ddlJobFromContext(ctx context.Context) *ddlJob {
+	if ctx == nil {
+		return nil
+	}
+	job, _ := ctx.Value(ddlJobCtxKey{}).(*ddlJob)
+	return job
+}
+
+func nowMillis() uint64 {
+	return uint64(time.Now().UnixMilli())
+}
+
+// ddlJobDesc returns the type of the job and the collection it works on.
+func ddlJobDesc(t task) (string, string) {
+	switch t := t.(type) {
+	case *createCollectionTask:
+		return "CreateCollection", t.Req.GetCollectionName()
+	case *dropCollectionTask:
+		return "DropCollection", t.Req.GetCollectionName()
+	case *createPartitionTask:
+		return "CreatePartition", t.Req.GetCollectionName()
+	case *dropPartitionTask:
+		return "DropPartition", t.Req.GetCollectionName()
+	case *createAliasTask:
+		return "CreateAlias", t.Req.GetCollectionName()
+	case *dropAliasTask:
+		return "DropAlias", ""
+	case *alterAliasTask:
+		return "AlterAlias", t.Req.GetCollectionName()
+	case *restoreCollectionTask:
+		return "RestoreCollection", t.Req.GetCollectionName()
+	case *purgeCollectionTask:
+		return "PurgeCollection", ""
+	case *restorePartitionTask:
+		return "RestorePartition", t.Req.GetCollectionName()
+	case *purgePartitionTask:
+		return "PurgePartition", ""
+	default:
+		return fmt.Sprintf("%T", t), ""
+	}
+}
+
+// ddlJob tracks a DDL task and the steps executed for it, every change is persisted.
+//
+// The job is running until the task returns and all of its async steps are done. Undo steps are tracked as well
+// when the task fails. All methods are no-op on a nil job, so that untracked steps can be executed as before.
+type ddlJob struct {
+	manager *ddlJobManager
+
+	mu       sync.Mutex
+	info     *rootcoordpb.DDLJobInfo
+	steps    map[nestedStep]*rootcoordpb.DDLStepInfo
+	taskDone bool
+}
+
+func newDDLJob(manager *ddlJobManager, info *rootcoordpb.DDLJobInfo) *ddlJob {
+	return &ddlJob{
+		manager: manager,
+		info:    info,
+		steps:   make(map[nestedStep]*rootcoordpb.DDLStepInfo),
+	}
+}
+
+func (j *ddlJob) start() {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.info.State = rootcoordpb.DDLJobState_DDLJobRunning
+	j.persist()
+}
+
+// finish is called when the task returns, the async steps may still be running.
+func (j *ddlJob) finish(err error) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.taskDone = true
+	if err != nil {
+		j.info.State = rootcoordpb.DDLJobState_DDLJobFailed
+		j.info.LastError = err.Error()
+	}
+	j.tryComplete()
+	j.persist()
+}
+
+// addSteps registers the steps to execute later.
+func (j *ddlJob) addSteps(steps []nestedStep, async bool, undo bool) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, step := range steps {
+		j.addStep(step, async, undo)
+	}
+	j.persist()
+}
+
+func (j *ddlJob) addStep(step nestedStep, async bool, undo bool) *rootcoordpb.DDLStepInfo {
+	if info, ok := j.steps[step]; ok {
+		return info
+	}
+	info := &rootcoordpb.DDLStepInfo{
+		Desc:  step.Desc(),
+		State: rootcoordpb.DDLStepState_DDLStepPending,
+		Async: async,
+		Undo:  undo,
+	}
+	j.steps[step] = info
+	j.info.Steps = append(j.info.Steps, info)
+	return info
+}
+
+func (j *ddlJob) startStep(step nestedStep) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	// steps executed without registering are the sync steps.
+	info := j.addStep(step, false, false)
+	if info.GetState() == rootcoordpb.DDLStepState_DDLStepRetrying {
+		info.RetryTimes++
+	}
+	if info.GetStartTime() == 0 {
+		info.StartTime = nowMillis()
+	}
+	info.State = rootcoordpb.DDLStepState_DDLStepRunning
+	j.persist()
+}
+
+// finishStep records the result of the step, the children steps inherit the kind of their parent.
+func (j *ddlJob) finishStep(step nestedStep, children []nestedStep, err error) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	info := j.addStep(step, false, false)
+	switch {
+	case err == nil:
+		info.State = rootcoordpb.DDLStepState_DDLStepDone
+		info.EndTime = nowMillis()
+		for _, child := range children {
+			j.addStep(child, info.GetAsync(), info.GetUndo())
+		}
+	case info.GetAsync() || info.GetUndo():
+		// steps executed in background are rescheduled unless the error is unrecoverable.
+		info.LastError = err.Error()
+		info.State = rootcoordpb.DDLStepState_DDLStepRetrying
+		if retry.IsUnRecoverable(err) {
+			info.State = rootcoordpb.DDLStepState_DDLStepFailed
+			info.EndTime = nowMillis()
+			j.info.State = rootcoordpb.DDLJobState_DDLJobFailed
+			j.info.LastError = err.Error()
+		}
+	default:
+		info.State = rootcoordpb.DDLStepState_DDLStepFailed
+		info.LastError = err.Error()
+		info.EndTime = nowMillis()
+	}
+	j.tryComplete()
+	j.persist()
+}
+
+// tryComplete marks the job as finished if the task returned and no step is left.
+func (j *ddlJob) tryComplete() {
+	if !j.taskDone || j.info.GetEndTime() != 0 {
+		return
+	}
+	for _, info := range j.info.GetSteps() {
+		switch info.GetState() {
+		case rootcoordpb.DDLStepState_DDLStepPending,
+			rootcoordpb.DDLStepState_DDLStepRunning,
+			rootcoordpb.DDLStepState_DDLStepRetrying:
+			if j.info.GetState() != rootcoordpb.DDLJobState_DDLJobFailed || info.GetUndo() {
+				return
+			}
+		}
+	}
+	if j.info.GetState() != rootcoordpb.DDLJobState_DDLJobFailed {
+		j.info.State = rootcoordpb.DDLJobState_DDLJobSucceeded
+	}
+	j.info.EndTime = nowMillis()
+}
+
+func (j *ddlJob) persist() {
+	if err := j.manager.save(j.info); err != nil {
+		log.Warn("failed to persist ddl job", zap.Int64("job id", j.info.GetJobID()), zap.Error(err))
+	}
+}
+
+// clone returns a copy of the job info, with or without its steps.
+func (j *ddlJob) clone(withSteps bool) *rootcoordpb.DDLJobInfo {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if withSteps {
+		return proto.Clone(j.info).(*rootcoordpb.DDLJobInfo)
+	}
+	steps := j.info.Steps
+	j.info.Steps = nil
+	info := proto.Clone(j.info).(*rootcoordpb.DDLJobInfo)
+	j.info.Steps = steps
+	return info
+}
+
+func (j *ddlJob) expired(now time.Time) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.info.GetEndTime() == 0 {
+		return false
+	}
+	endTime := time.UnixMilli(int64(j.info.GetEndTime()))
+	return now.Sub(endTime).Seconds() > Params.RootCoordCfg.DDLJobRetention
+}
+
+// ddlJobManager persists the DDL jobs scheduled by rootcoord, so that operators can inspect the jobs and their steps.
+type ddlJobManager struct {
+	store kv.MetaKv
+
+	mu   sync.RWMutex
+	jobs map[UniqueID]*ddlJob
+}
+
+func newDDLJobManager(store kv.MetaKv) *ddlJobManager {
+	return &ddlJobManager{
+		store: store,
+		jobs:  make(map[UniqueID]*ddlJob),
+	}
+}
+
+func buildDDLJobKey(jobID UniqueID) string {
+	return path.Join(ddlJobPrefix, strconv.FormatInt(jobID, 10))
+}
+
+// init loads the persisted jobs, the jobs not finished before the restart are marked as failed.
+func (m *ddlJobManager) init() error {
+	_, values, err := m.store.LoadWithPrefix(ddlJobPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		info := &rootcoordpb.DDLJobInfo{}
+		if err := proto.Unmarshal([]byte(value), info); err != nil {
+			log.Warn("failed to unmarshal ddl job", zap.Error(err))
+			continue
+		}
+		if info.GetEndTime() == 0 {
+			now := nowMillis()
+			for _, step := range info.GetSteps() {
+				if step.GetState() != rootcoordpb.DDLStepState_DDLStepDone &&
+					step.GetState() != rootcoordpb.DDLStepState_DDLStepFailed {
+					step.State = rootcoordpb.DDLStepState_DDLStepFailed
+					step.LastError = ddlJobInterruptedReason
+					step.EndTime = now
+				}
+			}
+			info.State = rootcoordpb.DDLJobState_DDLJobFailed
+			info.LastError = ddlJobInterruptedReason
+			info.EndTime = now
+			if err := m.save(info); err != nil {
+				return err
+			}
+		}
+		m.jobs[info.GetJobID()] = newDDLJob(m, info)
+	}
+	log.Info("ddl job manager loaded jobs", zap.Int("job num", len(m.jobs)))
+	return nil
+}
+
+func (m *ddlJobManager) save(info *rootcoordpb.DDLJobInfo) error {
+	value, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return m.store.Save(buildDDLJobKey(info.GetJobID()), string(value))
+}
+
+// addJob registers a pending job for the task, the id and ts of the task should have been set.
+func (m *ddlJobManager) addJob(t task) {
+	if m == nil {
+		return
+	}
+	jobType, collectionName := ddlJobDesc(t)
+	job := newDDLJob(m, &rootcoordpb.DDLJobInfo{
+		JobID:          t.GetID(),
+		JobType:        jobType,
+		CollectionName: collectionName,
+		State:          rootcoordpb.DDLJobState_DDLJobPending,
+		Timestamp:      t.GetTs(),
+		StartTime:      nowMillis(),
+	})
+	job.persist()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[t.GetID()] = job
+}
+
+func (m *ddlJobManager) getJob(jobID UniqueID) *ddlJob {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+// listJobs returns the jobs without their steps, the latest jobs come first.
+func (m *ddlJobManager) listJobs(collectionName string, states []rootcoordpb.DDLJobState, limit int64) []*rootcoordpb.DDLJobInfo {
+	m.mu.RLock()
+	jobs := make([]*rootcoordpb.DDLJobInfo, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		jobs = append(jobs, job.clone(false))
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() > jobs[j].GetJobID()
+	})
+	res := make([]*rootcoordpb.DDLJobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		if limit > 0 && int64(len(res)) >= limit {
+			break
+		}
+		if collectionName != "" && job.GetCollectionName() != collectionName {
+			continue
+		}
+		if len(states) > 0 && !containsDDLJobState(states, job.GetState()) {
+			continue
+		}
+		res = append(res, job)
+	}
+	return res
+}
+
+func containsDDLJobState(states []rootcoordpb.DDLJobState, state rootcoordpb.DDLJobState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// describeJob returns the job with its steps.
+func (m *ddlJobManager) describeJob(jobID UniqueID) (*rootcoordpb.DDLJobInfo, error) {
+	job := m.getJob(jobID)
+	if job == nil {
+		return nil, fmt.Errorf("ddl job %d not found", jobID)
+	}
+	return job.clone(true), nil
+}
+
+// removeExpiredJobs removes the jobs finished for longer than the retention.
+func (m *ddlJobManager) removeExpiredJobs() {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, job := range m.jobs {
+		if !job.expired(now) {
+			continue
+		}
+		if err := m.store.Remove(buildDDLJobKey(id)); err != nil {
+			log.Warn("failed to remove expired ddl job", zap.Int64("job id", id), zap.Error(err))
+			continue
+		}
+		delete(m.jobs, id)
+	}
+}
+
+func (c *Core) ddlJobCleanupLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(ddlJobCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.ddlJobManager.removeExpiredJobs()
+		}
+	}
+}
@@ -0,0 +1,371 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// mockRetryStep fails for the first failTimes executions.
+type mockRetryStep struct {
+	baseStep
+	desc      string
+	failTimes int
+	err       error
+	children  []nestedStep
+}
+
+func (m *mockRetryStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	if m.failTimes > 0 {
+		m.failTimes--
+		return nil, m.err
+	}
+	return m.children, nil
+}
+
+func (m *mockRetryStep) Desc() string {
+	return m.desc
+}
+
+func newMockRetryStep(desc string, failTimes int) *mockRetryStep {
+	return &mockRetryStep{desc: desc, failTimes: failTimes, err: errors.New("error mock Execute")}
+}
+
+func newTestDDLJobManager() (*ddlJobManager, *kv.MockMetaKV) {
+	store := &kv.MockMetaKV{}
+	store.InMemKv = sync.Map{}
+	return newDDLJobManager(store), store
+}
+
+// newTestDDLJob adds a running job, and returns the context carrying it.
+func newTestDDLJob(m *ddlJobManager, id UniqueID) (context.Context, *ddlJob) {
+	task := newMockNormalTask()
+	task.SetID(id)
+	m.addJob(task)
+	job := m.getJob(id)
+	job.start()
+	return withDDLJob(context.Background(), job), job
+}
+
+// newSyncStepExecutor executes the steps until all of them succeed or fail unrecoverably.
+func newSyncStepExecutor() *mockStepExecutor {
+	executor := newMockStepExecutor()
+	executor.AddStepsFunc = func(s *stepStack) {
+		for s != nil {
+			s = s.Execute(context.Background())
+		}
+	}
+	return executor
+}
+
+func loadPersistedDDLJob(t *testing.T, store *kv.MockMetaKV, id UniqueID) *rootcoordpb.DDLJobInfo {
+	value, err := store.Load(buildDDLJobKey(id))
+	require.NoError(t, err)
+	info := &rootcoordpb.DDLJobInfo{}
+	require.NoError(t, proto.Unmarshal([]byte(value), info))
+	return info
+}
+
+func Test_ddlJob_Nil(t *testing.T) {
+	var job *ddlJob
+	job.start()
+	job.addSteps([]nestedStep{newMockRetryStep("step", 0)}, true, false)
+	job.startStep(newMockRetryStep("step", 0))
+	job.finishStep(newMockRetryStep("step", 0), nil, nil)
+	job.finish(nil)
+	assert.Nil(t, ddlJobFromContext(withDDLJob(context.Background(), job)))
+
+	var m *ddlJobManager
+	m.addJob(newMockNormalTask())
+	assert.Nil(t, m.getJob(1))
+}
+
+func Test_ddlJob_RedoTask(t *testing.T) {
+	m, store := newTestDDLJobManager()
+	ctx, job := newTestDDLJob(m, 100)
+
+	child := newMockRetryStep("child", 0)
+	async := newMockRetryStep("async", 2)
+	async.children = []nestedStep{child}
+	asyncDone := make(chan struct{})
+	executor := newSyncStepExecutor()
+	executor.AddStepsFunc = func(s *stepStack) {
+		for s != nil {
+			s = s.Execute(context.Background())
+		}
+		close(asyncDone)
+	}
+
+	redo := newBaseRedoTask(executor)
+	redo.AddSyncStep(newMockRetryStep("sync", 0))
+	redo.AddAsyncStep(async)
+	err := redo.Execute(ctx)
+	assert.NoError(t, err)
+	<-asyncDone
+
+	info, err := m.describeJob(100)
+	assert.NoError(t, err)
+	// the task hasn't returned yet.
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobRunning, info.GetState())
+	job.finish(nil)
+
+	info, err = m.describeJob(100)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobSucceeded, info.GetState())
+	assert.NotZero(t, info.GetEndTime())
+	require.Equal(t, 3, len(info.GetSteps()))
+	assert.Equal(t, "sync", info.GetSteps()[0].GetDesc())
+	assert.False(t, info.GetSteps()[0].GetAsync())
+	assert.Equal(t, "async", info.GetSteps()[1].GetDesc())
+	assert.True(t, info.GetSteps()[1].GetAsync())
+	assert.Equal(t, int64(2), info.GetSteps()[1].GetRetryTimes())
+	assert.Equal(t, "child", info.GetSteps()[2].GetDesc())
+	assert.True(t, info.GetSteps()[2].GetAsync())
+	for _, step := range info.GetSteps() {
+		assert.Equal(t, rootcoordpb.DDLStepState_DDLStepDone, step.GetState())
+	}
+	assert.True(t, proto.Equal(info, loadPersistedDDLJob(t, store, 100)))
+
+	t.Run("async step failed unrecoverably", func(t *testing.T) {
+		ctx, job := newTestDDLJob(m, 101)
+		async := newMockRetryStep("async", 1)
+		async.err = retry.Unrecoverable(errors.New("error mock unrecoverable"))
+		redo := newBaseRedoTask(newSyncStepExecutor())
+		redo.AddAsyncStep(async)
+		redo.AddAsyncStep(newMockRetryStep("never executed", 0))
+		assert.NoError(t, redo.Execute(ctx))
+		job.finish(nil)
+
+		assert.Eventually(t, func() bool {
+			info, _ := m.describeJob(101)
+			return info.GetEndTime() != 0
+		}, time.Second, 10*time.Millisecond)
+		info, err := m.describeJob(101)
+		assert.NoError(t, err)
+		assert.Equal(t, rootcoordpb.DDLJobState_DDLJobFailed, info.GetState())
+		assert.Equal(t, rootcoordpb.DDLStepState_DDLStepFailed, info.GetSteps()[0].GetState())
+		assert.Equal(t, rootcoordpb.DDLStepState_DDLStepPending, info.GetSteps()[1].GetState())
+	})
+}
+
+func Test_ddlJob_UndoTask(t *testing.T) {
+	m, _ := newTestDDLJobManager()
+	ctx, job := newTestDDLJob(m, 100)
+
+	undoDone := make(chan struct{})
+	executor := newSyncStepExecutor()
+	executor.AddStepsFunc = func(s *stepStack) {
+		for s != nil {
+			s = s.Execute(context.Background())
+		}
+		close(undoDone)
+	}
+	undo := newBaseUndoTask(executor)
+	undo.AddStep(newMockRetryStep("todo1", 0), newMockRetryStep("undo1", 1))
+	undo.AddStep(newMockRetryStep("todo2", 1), newMockRetryStep("undo2", 0))
+	err := undo.Execute(ctx)
+	assert.Error(t, err)
+	job.finish(err)
+
+	info, err := m.describeJob(100)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobFailed, info.GetState())
+	assert.Equal(t, "error mock Execute", info.GetLastError())
+
+	<-undoDone
+	info, err = m.describeJob(100)
+	assert.NoError(t, err)
+	assert.NotZero(t, info.GetEndTime())
+	require.Equal(t, 3, len(info.GetSteps()))
+	assert.Equal(t, rootcoordpb.DDLStepState_DDLStepDone, info.GetSteps()[0].GetState())
+	assert.Equal(t, rootcoordpb.DDLStepState_DDLStepFailed, info.GetSteps()[1].GetState())
+	assert.Equal(t, "undo1", info.GetSteps()[2].GetDesc())
+	assert.True(t, info.GetSteps()[2].GetUndo())
+	assert.Equal(t, int64(1), info.GetSteps()[2].GetRetryTimes())
+	assert.Equal(t, rootcoordpb.DDLStepState_DDLStepDone, info.GetSteps()[2].GetState())
+}
+
+func Test_ddlJobManager_init(t *testing.T) {
+	m, store := newTestDDLJobManager()
+	_, job := newTestDDLJob(m, 100)
+	job.addSteps([]nestedStep{newMockRetryStep("async", 0)}, true, false)
+	_, job = newTestDDLJob(m, 101)
+	job.finish(nil)
+	store.Save(buildDDLJobKey(102), "invalid")
+
+	m = newDDLJobManager(store)
+	err := m.init()
+	assert.NoError(t, err)
+
+	info, err := m.describeJob(100)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobFailed, info.GetState())
+	assert.Equal(t, ddlJobInterruptedReason, info.GetLastError())
+	assert.Equal(t, rootcoordpb.DDLStepState_DDLStepFailed, info.GetSteps()[0].GetState())
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobFailed, loadPersistedDDLJob(t, store, 100).GetState())
+
+	info, err = m.describeJob(101)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobSucceeded, info.GetState())
+
+	_, err = m.describeJob(102)
+	assert.Error(t, err)
+
+	t.Run("failed to load", func(t *testing.T) {
+		store.LoadWithPrefixMockErr = true
+		defer func() { store.LoadWithPrefixMockErr = false }()
+		assert.Error(t, newDDLJobManager(store).init())
+	})
+}
+
+func Test_ddlJobManager_listJobs(t *testing.T) {
+	m, _ := newTestDDLJobManager()
+	for i, name := range []string{"coll1", "coll2", "coll1"} {
+		task := &dropCollectionTask{
+			baseTask: baseTask{id: UniqueID(100 + i)},
+			Req:      &milvuspb.DropCollectionRequest{CollectionName: name},
+		}
+		m.addJob(task)
+	}
+	m.getJob(100).start()
+	m.getJob(100).addSteps([]nestedStep{newMockRetryStep("async", 0)}, true, false)
+
+	jobs := m.listJobs("", nil, 0)
+	require.Equal(t, 3, len(jobs))
+	assert.Equal(t, int64(102), jobs[0].GetJobID())
+	assert.Equal(t, "DropCollection", jobs[0].GetJobType())
+	assert.Equal(t, int64(100), jobs[2].GetJobID())
+	assert.Nil(t, jobs[2].GetSteps())
+
+	jobs = m.listJobs("coll1", nil, 0)
+	assert.Equal(t, 2, len(jobs))
+	jobs = m.listJobs("coll1", nil, 1)
+	require.Equal(t, 1, len(jobs))
+	assert.Equal(t, int64(102), jobs[0].GetJobID())
+	jobs = m.listJobs("", []rootcoordpb.DDLJobState{rootcoordpb.DDLJobState_DDLJobRunning}, 0)
+	require.Equal(t, 1, len(jobs))
+	assert.Equal(t, int64(100), jobs[0].GetJobID())
+
+	info, err := m.describeJob(100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(info.GetSteps()))
+}
+
+func Test_ddlJobManager_removeExpiredJobs(t *testing.T) {
+	Params.InitOnce()
+	retention := Params.RootCoordCfg.DDLJobRetention
+	defer func() { Params.RootCoordCfg.DDLJobRetention = retention }()
+
+	m, store := newTestDDLJobManager()
+	_, job := newTestDDLJob(m, 100)
+	job.finish(nil)
+	newTestDDLJob(m, 101)
+
+	Params.RootCoordCfg.DDLJobRetention = 3600
+	m.removeExpiredJobs()
+	assert.NotNil(t, m.getJob(100))
+
+	Params.RootCoordCfg.DDLJobRetention = -1
+	m.removeExpiredJobs()
+	assert.Nil(t, m.getJob(100))
+	assert.NotNil(t, m.getJob(101))
+	value, err := store.Load(buildDDLJobKey(100))
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+}
+
+func Test_scheduler_DDLJob(t *testing.T) {
+	idAlloc := newMockIDAllocator()
+	tsoAlloc := newMockTsoAllocator()
+	idAlloc.AllocOneF = func() (UniqueID, error) {
+		return 100, nil
+	}
+	tsoAlloc.GenerateTSOF = func(count uint32) (uint64, error) {
+		return 101, nil
+	}
+	m, _ := newTestDDLJobManager()
+	s := newScheduler(context.Background(), idAlloc, tsoAlloc, withDDLJobManager(m))
+	s.Start()
+	defer s.Stop()
+
+	task := newMockNormalTask()
+	assert.NoError(t, s.AddTask(task))
+	assert.NoError(t, task.WaitToFinish())
+	info, err := m.describeJob(100)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobSucceeded, info.GetState())
+	assert.Equal(t, uint64(101), info.GetTimestamp())
+
+	idAlloc.AllocOneF = func() (UniqueID, error) {
+		return 200, nil
+	}
+	failTask := newMockPrepareFailTask()
+	assert.NoError(t, s.AddTask(failTask))
+	assert.Error(t, failTask.WaitToFinish())
+	info, err = m.describeJob(200)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.DDLJobState_DDLJobFailed, info.GetState())
+	assert.Equal(t, "error mock Prepare", info.GetLastError())
+}
+
+func TestCore_DDLJobs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		listResp, err := c.ListDDLJobs(ctx, &rootcoordpb.ListDDLJobsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		describeResp, err := c.DescribeDDLJob(ctx, &rootcoordpb.DescribeDDLJobRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, describeResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.ddlJobManager, _ = newTestDDLJobManager()
+		newTestDDLJob(c.ddlJobManager, 100)
+
+		listResp, err := c.ListDDLJobs(ctx, &rootcoordpb.ListDDLJobsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(listResp.GetJobs()))
+
+		describeResp, err := c.DescribeDDLJob(ctx, &rootcoordpb.DescribeDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, describeResp.GetStatus().GetErrorCode())
+		assert.Equal(t, rootcoordpb.DDLJobState_DDLJobRunning, describeResp.GetJob().GetState())
+
+		describeResp, err = c.DescribeDDLJob(ctx, &rootcoordpb.DescribeDDLJobRequest{JobID: 200})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, describeResp.GetStatus().GetErrorCode())
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"go.uber.org/zap"
 
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -21,6 +22,9 @@ import (
 type dropCollectionTask struct {
 	baseTask
 	Req *milvuspb.DropCollectionRequest
+	// purge drops the collection at once even if the recycle bin is enabled,
+	// it's used to roll back a collection that was never ready to serve.
+	purge bool
 }
 
 func (t *dropCollectionTask) validate() error {
@@ -58,6 +62,10 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 
 	ts := t.GetTs()
 
+	if Params.RootCoordCfg.DropRetention > 0 && !t.purge {
+		return t.recycle(ctx, collMeta, aliases)
+	}
+
 	redoTask := newBaseRedoTask(t.core.stepExecutor)
 
 	redoTask.AddSyncStep(&changeCollectionStateStep{
@@ -102,3 +110,31 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 
 	return redoTask.Execute(ctx)
 }
+
+// recycle moves the collection to the recycle bin, channels, binlogs and indexes are kept
+// so that it can be restored before the retention expires.
+func (t *dropCollectionTask) recycle(ctx context.Context, collMeta *model.Collection, aliases []string) error {
+	ts := t.GetTs()
+
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+
+	redoTask.AddSyncStep(&recycleCollectionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+		ts:           ts,
+	})
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		collectionNames: append(aliases, collMeta.Name),
+		collectionID:    collMeta.CollectionID,
+		ts:              ts,
+		opts:            []expireCacheOpt{expireCacheWithDropFlag()},
+	})
+
+	redoTask.AddAsyncStep(&releaseCollectionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+	})
+
+	return redoTask.Execute(ctx)
+}
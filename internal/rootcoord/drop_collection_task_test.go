@@ -66,6 +66,12 @@ func Test_dropCollectionTask_Prepare(t *testing.T) {
 }
 
 func Test_dropCollectionTask_Execute(t *testing.T) {
+	// collections are dropped immediately without the recycle bin.
+	Params.InitOnce()
+	dropRetention := Params.RootCoordCfg.DropRetention
+	Params.RootCoordCfg.DropRetention = 0
+	defer func() { Params.RootCoordCfg.DropRetention = dropRetention }()
+
 	t.Run("drop non-existent collection", func(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		meta := mockrootcoord.NewIMetaTable(t)
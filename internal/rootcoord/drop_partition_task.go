@@ -53,6 +53,10 @@ func (t *dropPartitionTask) Execute(ctx context.Context) error {
 		return nil
 	}
 
+	if Params.RootCoordCfg.DropRetention > 0 {
+		return t.recycle(ctx, partID)
+	}
+
 	redoTask := newBaseRedoTask(t.core.stepExecutor)
 	redoTask.AddSyncStep(&changePartitionStateStep{
 		baseStep:     baseStep{core: t.core},
@@ -96,3 +100,29 @@ func (t *dropPartitionTask) Execute(ctx context.Context) error {
 
 	return redoTask.Execute(ctx)
 }
+
+// recycle moves the partition to the recycle bin, its binlogs and indexes are kept
+// so that it can be restored before the retention expires.
+func (t *dropPartitionTask) recycle(ctx context.Context, partID UniqueID) error {
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&recyclePartitionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collMeta.CollectionID,
+		partitionID:  partID,
+		ts:           t.GetTs(),
+	})
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		collectionNames: []string{t.Req.GetCollectionName()},
+		collectionID:    t.collMeta.CollectionID,
+		ts:              t.GetTs(),
+	})
+
+	redoTask.AddAsyncStep(&releasePartitionsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collMeta.CollectionID,
+		partitionIDs: []UniqueID{partID},
+	})
+
+	return redoTask.Execute(ctx)
+}
@@ -76,6 +76,9 @@ func Test_dropPartitionTask_Prepare(t *testing.T) {
 }
 
 func Test_dropPartitionTask_Execute(t *testing.T) {
+	// partitions are dropped immediately without the recycle bin.
+	defer withDropRetention(0)()
+
 	t.Run("drop non-existent partition", func(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		partitionName := funcutil.GenRandomStr()
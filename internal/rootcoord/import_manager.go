@@ -34,12 +34,14 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
 const (
 	Bucket          = "bucket"
+	EndTs           = importutil.EndTs
 	FailedReason    = "failed_reason"
 	Files           = "files"
 	CollectionName  = "collection"
@@ -221,6 +223,12 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 				},
 			},
 		}
+		if task.GetEndTs() > 0 {
+			it.Infos = append(it.Infos, &commonpb.KeyValuePair{
+				Key:   EndTs,
+				Value: strconv.FormatUint(task.GetEndTs(), 10),
+			})
+		}
 
 		// Get all busy dataNodes for reference.
 		var busyNodeList []int64
@@ -439,10 +447,18 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 		}
 
 		bucket := ""
+		var endTs uint64
 		for _, kv := range req.Options {
-			if kv.Key == Bucket {
+			switch kv.Key {
+			case Bucket:
 				bucket = kv.Value
-				break
+			case EndTs:
+				ts, err := strconv.ParseUint(kv.Value, 10, 64)
+				if err != nil {
+					log.Error("invalid import end timestamp", zap.String("value", kv.Value), zap.Error(err))
+					return err
+				}
+				endTs = ts
 			}
 		}
 
@@ -461,6 +477,7 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 					PartitionId:  pID,
 					ChannelNames: req.ChannelNames,
 					Bucket:       bucket,
+					EndTs:        endTs,
 					RowBased:     req.GetRowBased(),
 					Files:        []string{req.GetFiles()[i]},
 					CreateTs:     time.Now().Unix(),
@@ -500,6 +517,7 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 				PartitionId:  pID,
 				ChannelNames: req.ChannelNames,
 				Bucket:       bucket,
+				EndTs:        endTs,
 				RowBased:     req.GetRowBased(),
 				Files:        req.GetFiles(),
 				CreateTs:     time.Now().Unix(),
@@ -1075,6 +1093,7 @@ func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo
 		State:          taskInfo.GetState(),
 		CollectionName: taskInfo.GetCollectionName(),
 		PartitionName:  taskInfo.GetPartitionName(),
+		EndTs:          taskInfo.GetEndTs(),
 	}
 	return cloned
 }
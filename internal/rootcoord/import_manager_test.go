@@ -665,6 +665,54 @@ func TestImportManager_ImportJob(t *testing.T) {
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 }
 
+func TestImportManager_ImportJobEndTs(t *testing.T) {
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		return 1, 0, nil
+	}
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	colID := int64(100)
+	mockKv := &kv.MockMetaKV{}
+	mockKv.InMemKv = sync.Map{}
+	var sent *datapb.ImportTask
+	fn := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		sent = req.GetImportTask()
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+
+	colReq := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"insert_log/1/2", "delta_log/1/2"},
+		Options: []*commonpb.KeyValuePair{
+			{
+				Key:   EndTs,
+				Value: "not a timestamp",
+			},
+		},
+	}
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, nil, nil, nil, nil, nil)
+	resp := mgr.importJob(context.TODO(), colReq, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	colReq.Options[0].Value = "1000"
+	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Equal(t, uint64(1000), mgr.workingTasks[1].GetEndTs())
+	assert.NotNil(t, sent)
+	found := false
+	for _, kv := range sent.GetInfos() {
+		if kv.GetKey() == EndTs {
+			assert.Equal(t, "1000", kv.GetValue())
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
 func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
@@ -73,6 +73,9 @@ type IMetaTable interface {
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	RecycleCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	RestoreCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	ListRecycledCollections(ctx context.Context) []*model.Collection
 	GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByID(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
 	ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
@@ -82,6 +85,9 @@ type IMetaTable interface {
 	AddPartition(ctx context.Context, partition *model.Partition) error
 	ChangePartitionState(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
+	RecyclePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
+	RestorePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
+	ListRecycledPartitions(ctx context.Context) []*model.Partition
 	CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
 	DropAlias(ctx context.Context, alias string, ts Timestamp) error
 	AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
@@ -150,9 +156,12 @@ func (mt *MetaTable) reload() error {
 	if err != nil {
 		return err
 	}
-	for name, collection := range collections {
+	for _, collection := range collections {
 		mt.collID2Meta[collection.CollectionID] = collection
-		mt.collName2ID[name] = collection.CollectionID
+		// the name of a recycled collection may be taken by a new collection.
+		if !collection.Recycled() {
+			mt.collName2ID[collection.Name] = collection.CollectionID
+		}
 	}
 
 	// max ts means listing latest resources, meta table should always cache the latest version of catalog.
@@ -227,7 +236,10 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	coll, ok := mt.collID2Meta[collectionID]
 	if ok && coll != nil {
 		name = coll.Name
-		delete(mt.collName2ID, name)
+		// the name may have been taken by a new collection if this one was recycled.
+		if id, ok := mt.collName2ID[name]; ok && id == collectionID {
+			delete(mt.collName2ID, name)
+		}
 	}
 
 	for _, alias := range aliases {
@@ -240,6 +252,79 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	return nil
 }
 
+// RecycleCollection moves the collection to the recycle bin, its name is released. The aliases are kept with
+// the recycled collection, they fail to resolve until the collection is restored and are dropped when it is purged.
+func (mt *MetaTable) RecycleCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok {
+		return common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %d", collectionID))
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+	aliases := mt.listAliasesByID(collectionID)
+
+	clone := coll.Clone()
+	clone.State = pb.CollectionState_CollectionRecycled
+	clone.DropTime = ts
+	if err := mt.catalog.AlterCollection(ctx1, coll, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID] = clone
+	if id, ok := mt.collName2ID[coll.Name]; ok && id == collectionID {
+		delete(mt.collName2ID, coll.Name)
+	}
+	log.Info("recycle collection", zap.String("name", coll.Name), zap.Int64("id", collectionID),
+		zap.Strings("aliases", aliases), zap.Uint64("ts", ts))
+	return nil
+}
+
+// RestoreCollection brings the collection back from the recycle bin along with its aliases,
+// the name must not be taken by another collection.
+func (mt *MetaTable) RestoreCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || !coll.Recycled() {
+		return fmt.Errorf("collection %d is not in the recycle bin", collectionID)
+	}
+	if _, ok := mt.collName2ID[coll.Name]; ok {
+		return fmt.Errorf("collection name %s is used by another collection", coll.Name)
+	}
+	if _, ok := mt.collAlias2ID[coll.Name]; ok {
+		return fmt.Errorf("collection name %s is used by an alias", coll.Name)
+	}
+
+	clone := coll.Clone()
+	clone.State = pb.CollectionState_CollectionCreated
+	clone.DropTime = 0
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+	if err := mt.catalog.AlterCollection(ctx1, coll, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID] = clone
+	mt.collName2ID[coll.Name] = collectionID
+	log.Info("restore collection", zap.String("name", coll.Name), zap.Int64("id", collectionID),
+		zap.Strings("aliases", mt.listAliasesByID(collectionID)), zap.Uint64("ts", ts))
+	return nil
+}
+
+// ListRecycledCollections lists the collections in the recycle bin.
+func (mt *MetaTable) ListRecycledCollections(ctx context.Context) []*model.Collection {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	colls := make([]*model.Collection, 0)
+	for _, coll := range mt.collID2Meta {
+		if coll.Recycled() {
+			colls = append(colls, coll.Clone())
+		}
+	}
+	return colls
+}
+
 // getCollectionByIDInternal get collection by collection id without lock.
 func (mt *MetaTable) getCollectionByIDInternal(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
 	var coll *model.Collection
@@ -450,6 +535,95 @@ func (mt *MetaTable) RemovePartition(ctx context.Context, collectionID UniqueID,
 	return nil
 }
 
+// RecyclePartition moves the partition to the recycle bin, its name is released.
+func (mt *MetaTable) RecyclePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok {
+		return common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %d", collectionID))
+	}
+	for idx, part := range coll.Partitions {
+		if part.PartitionID == partitionID {
+			clone := part.Clone()
+			clone.State = pb.PartitionState_PartitionRecycled
+			clone.DropTime = ts
+			ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+			if err := mt.catalog.AlterPartition(ctx1, part, clone, metastore.MODIFY, ts); err != nil {
+				return err
+			}
+			coll.Partitions[idx] = clone
+			log.Info("recycle partition", zap.Int64("collection", collectionID), zap.Int64("partition", partitionID),
+				zap.String("name", part.PartitionName), zap.Uint64("ts", ts))
+			return nil
+		}
+	}
+	return fmt.Errorf("partition not exist, collection: %d, partition: %d", collectionID, partitionID)
+}
+
+// RestorePartition brings the partition back from the recycle bin, the collection must be available and
+// the name must not be taken by another partition.
+func (mt *MetaTable) RestorePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || !coll.Available() {
+		return common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %d", collectionID))
+	}
+	idx := -1
+	for i, part := range coll.Partitions {
+		if part.PartitionID == partitionID && part.Recycled() {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return fmt.Errorf("partition %d of collection %d is not in the recycle bin", partitionID, collectionID)
+	}
+	part := coll.Partitions[idx]
+	for _, other := range coll.Partitions {
+		if other.PartitionName == part.PartitionName && !other.Recycled() {
+			return fmt.Errorf("partition name %s is used by another partition", part.PartitionName)
+		}
+	}
+
+	clone := part.Clone()
+	clone.State = pb.PartitionState_PartitionCreated
+	clone.DropTime = 0
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+	if err := mt.catalog.AlterPartition(ctx1, part, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	coll.Partitions[idx] = clone
+	log.Info("restore partition", zap.Int64("collection", collectionID), zap.Int64("partition", partitionID),
+		zap.String("name", part.PartitionName), zap.Uint64("ts", ts))
+	return nil
+}
+
+// ListRecycledPartitions lists the partitions in the recycle bin. The partitions of the collections which are
+// not available are skipped, they are restored or purged along with the collection.
+func (mt *MetaTable) ListRecycledPartitions(ctx context.Context) []*model.Partition {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	partitions := make([]*model.Partition, 0)
+	for _, coll := range mt.collID2Meta {
+		if !coll.Available() {
+			continue
+		}
+		for _, part := range coll.Partitions {
+			if part.Recycled() {
+				clone := part.Clone()
+				clone.CollectionID = coll.CollectionID
+				partitions = append(partitions, clone)
+			}
+		}
+	}
+	return partitions
+}
+
 func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -841,3 +842,287 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 		assert.Equal(t, Params.CommonCfg.DefaultPartitionName, coll.Partitions[0].PartitionName)
 	})
 }
+
+func TestMetaTable_RecycleCollection(t *testing.T) {
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := &MetaTable{collID2Meta: map[typeutil.UniqueID]*model.Collection{}}
+		err := meta.RecycleCollection(context.Background(), 100, 101)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to alter collection", func(t *testing.T) {
+		Params.InitOnce()
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Collection
+			mock.Anything, // *model.Collection
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(errors.New("error mock AlterCollection"))
+		meta := &MetaTable{
+			catalog:      catalog,
+			collID2Meta:  map[typeutil.UniqueID]*model.Collection{100: {CollectionID: 100, Name: "coll"}},
+			collName2ID:  map[string]typeutil.UniqueID{"coll": 100},
+			collAlias2ID: map[string]typeutil.UniqueID{},
+		}
+		err := meta.RecycleCollection(context.Background(), 100, 101)
+		assert.Error(t, err)
+		assert.True(t, meta.collID2Meta[100].Available())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		Params.InitOnce()
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Collection
+			mock.Anything, // *model.Collection
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := &MetaTable{
+			catalog:      catalog,
+			collID2Meta:  map[typeutil.UniqueID]*model.Collection{100: {CollectionID: 100, Name: "coll"}},
+			collName2ID:  map[string]typeutil.UniqueID{"coll": 100},
+			collAlias2ID: map[string]typeutil.UniqueID{"alias": 100},
+		}
+		ctx := context.Background()
+		err := meta.RecycleCollection(ctx, 100, 101)
+		assert.NoError(t, err)
+		assert.True(t, meta.collID2Meta[100].Recycled())
+		assert.Equal(t, uint64(101), meta.collID2Meta[100].DropTime)
+		assert.Empty(t, meta.collName2ID)
+		// the alias is kept with the recycled collection, but can't be resolved.
+		assert.Equal(t, []string{"alias"}, meta.ListAliasesByID(100))
+		catalog.On("GetCollectionByID",
+			mock.Anything, // context.Context
+			int64(100),
+			mock.AnythingOfType("uint64"),
+		).Return(meta.collID2Meta[100].Clone(), nil)
+		_, err = meta.GetCollectionByName(ctx, "alias", typeutil.MaxTimestamp)
+		assert.True(t, common.IsCollectionNotExistError(err))
+
+		recycled := meta.ListRecycledCollections(ctx)
+		require.Equal(t, 1, len(recycled))
+		assert.Equal(t, int64(100), recycled[0].CollectionID)
+
+		// the name is released.
+		catalog.On("GetCollectionByName",
+			mock.Anything, // context.Context
+			"coll",
+			mock.AnythingOfType("uint64"),
+		).Return(nil, common.NewCollectionNotExistError("collection not exist"))
+		_, err = meta.GetCollectionByName(ctx, "coll", typeutil.MaxTimestamp)
+		assert.True(t, common.IsCollectionNotExistError(err))
+	})
+}
+
+func TestMetaTable_RestoreCollection(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		return &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {CollectionID: 100, Name: "coll", State: pb.CollectionState_CollectionRecycled, DropTime: 101},
+				200: {CollectionID: 200, Name: "coll2"},
+			},
+			collName2ID:  map[string]typeutil.UniqueID{"coll2": 200},
+			collAlias2ID: map[string]typeutil.UniqueID{},
+		}
+	}
+
+	t.Run("not in recycle bin", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RestoreCollection(context.Background(), 200, 102)
+		assert.Error(t, err)
+		err = meta.RestoreCollection(context.Background(), 300, 102)
+		assert.Error(t, err)
+	})
+
+	t.Run("name is taken", func(t *testing.T) {
+		meta := newMeta(nil)
+		meta.collName2ID["coll"] = 300
+		err := meta.RestoreCollection(context.Background(), 100, 102)
+		assert.Error(t, err)
+
+		meta = newMeta(nil)
+		meta.collAlias2ID["coll"] = 200
+		err = meta.RestoreCollection(context.Background(), 100, 102)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		Params.InitOnce()
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Collection
+			mock.Anything, // *model.Collection
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newMeta(catalog)
+		meta.collAlias2ID["alias"] = 100
+		ctx := context.Background()
+		err := meta.RestoreCollection(ctx, 100, 102)
+		assert.NoError(t, err)
+		assert.True(t, meta.collID2Meta[100].Available())
+		assert.Equal(t, uint64(0), meta.collID2Meta[100].DropTime)
+		assert.Equal(t, int64(100), meta.collName2ID["coll"])
+		assert.Empty(t, meta.ListRecycledCollections(ctx))
+		// the alias resolves to the restored collection again.
+		coll, err := meta.GetCollectionByName(ctx, "alias", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), coll.CollectionID)
+	})
+}
+
+func TestMetaTable_RemoveRecycledCollection(t *testing.T) {
+	Params.InitOnce()
+	catalog := mocks.NewRootCoordCatalog(t)
+	catalog.On("DropCollection",
+		mock.Anything, // context.Context
+		mock.Anything, // *model.Collection
+		mock.AnythingOfType("uint64"),
+	).Return(nil)
+	meta := &MetaTable{
+		catalog: catalog,
+		collID2Meta: map[typeutil.UniqueID]*model.Collection{
+			100: {CollectionID: 100, Name: "coll", State: pb.CollectionState_CollectionDropping},
+			200: {CollectionID: 200, Name: "coll"},
+		},
+		collName2ID:  map[string]typeutil.UniqueID{"coll": 200},
+		collAlias2ID: map[string]typeutil.UniqueID{"alias": 100},
+	}
+	err := meta.RemoveCollection(context.Background(), 100, 101)
+	assert.NoError(t, err)
+	// the name is taken by the new collection.
+	assert.Equal(t, int64(200), meta.collName2ID["coll"])
+	// the aliases kept with the recycled collection are dropped.
+	assert.Empty(t, meta.collAlias2ID)
+}
+
+func TestMetaTable_RecyclePartition(t *testing.T) {
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := &MetaTable{collID2Meta: map[typeutil.UniqueID]*model.Collection{}}
+		err := meta.RecyclePartition(context.Background(), 100, 101, 102)
+		assert.Error(t, err)
+	})
+
+	t.Run("partition not exist", func(t *testing.T) {
+		meta := &MetaTable{collID2Meta: map[typeutil.UniqueID]*model.Collection{100: {CollectionID: 100}}}
+		err := meta.RecyclePartition(context.Background(), 100, 101, 102)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to alter partition", func(t *testing.T) {
+		Params.InitOnce()
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterPartition",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Partition
+			mock.Anything, // *model.Partition
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(errors.New("error mock AlterPartition"))
+		meta := &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {CollectionID: 100, Partitions: []*model.Partition{{PartitionID: 101, PartitionName: "part"}}},
+			},
+		}
+		err := meta.RecyclePartition(context.Background(), 100, 101, 102)
+		assert.Error(t, err)
+		assert.True(t, meta.collID2Meta[100].Partitions[0].Available())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		Params.InitOnce()
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterPartition",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Partition
+			mock.Anything, // *model.Partition
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {CollectionID: 100, Partitions: []*model.Partition{{PartitionID: 101, PartitionName: "part"}}},
+				200: {CollectionID: 200, State: pb.CollectionState_CollectionRecycled, Partitions: []*model.Partition{
+					{PartitionID: 201, PartitionName: "part", State: pb.PartitionState_PartitionRecycled},
+				}},
+			},
+		}
+		ctx := context.Background()
+		err := meta.RecyclePartition(ctx, 100, 101, 102)
+		assert.NoError(t, err)
+		assert.True(t, meta.collID2Meta[100].Partitions[0].Recycled())
+		assert.Equal(t, uint64(102), meta.collID2Meta[100].Partitions[0].DropTime)
+
+		// the partitions of the recycled collection are not listed.
+		recycled := meta.ListRecycledPartitions(ctx)
+		require.Equal(t, 1, len(recycled))
+		assert.Equal(t, int64(100), recycled[0].CollectionID)
+		assert.Equal(t, int64(101), recycled[0].PartitionID)
+	})
+}
+
+func TestMetaTable_RestorePartition(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		return &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {CollectionID: 100, Partitions: []*model.Partition{
+					{PartitionID: 101, PartitionName: "part", State: pb.PartitionState_PartitionRecycled, DropTime: 102},
+					{PartitionID: 103, PartitionName: "part2"},
+				}},
+				200: {CollectionID: 200, State: pb.CollectionState_CollectionRecycled, Partitions: []*model.Partition{
+					{PartitionID: 201, PartitionName: "part", State: pb.PartitionState_PartitionRecycled},
+				}},
+			},
+		}
+	}
+
+	t.Run("collection not available", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RestorePartition(context.Background(), 200, 201, 202)
+		assert.Error(t, err)
+		err = meta.RestorePartition(context.Background(), 300, 301, 302)
+		assert.Error(t, err)
+	})
+
+	t.Run("not in recycle bin", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RestorePartition(context.Background(), 100, 103, 104)
+		assert.Error(t, err)
+	})
+
+	t.Run("name is taken", func(t *testing.T) {
+		meta := newMeta(nil)
+		meta.collID2Meta[100].Partitions = append(meta.collID2Meta[100].Partitions,
+			&model.Partition{PartitionID: 105, PartitionName: "part"})
+		err := meta.RestorePartition(context.Background(), 100, 101, 106)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		Params.InitOnce()
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterPartition",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Partition
+			mock.Anything, // *model.Partition
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newMeta(catalog)
+		ctx := context.Background()
+		err := meta.RestorePartition(ctx, 100, 101, 104)
+		assert.NoError(t, err)
+		assert.True(t, meta.collID2Meta[100].Partitions[0].Available())
+		assert.Equal(t, uint64(0), meta.collID2Meta[100].Partitions[0].DropTime)
+		assert.Empty(t, meta.ListRecycledPartitions(ctx))
+	})
+}
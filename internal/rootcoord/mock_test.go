@@ -44,9 +44,15 @@ type mockMetaTable struct {
 	GetCollectionByIDFunc            func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
 	ChangeCollectionStateFunc        func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollectionFunc             func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	RecycleCollectionFunc            func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	RestoreCollectionFunc            func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	ListRecycledCollectionsFunc      func(ctx context.Context) []*model.Collection
 	AddPartitionFunc                 func(ctx context.Context, partition *model.Partition) error
 	ChangePartitionStateFunc         func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartitionFunc              func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
+	RecyclePartitionFunc             func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
+	RestorePartitionFunc             func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
+	ListRecycledPartitionsFunc       func(ctx context.Context) []*model.Partition
 	CreateAliasFunc                  func(ctx context.Context, alias string, collectionName string, ts Timestamp) error
 	AlterAliasFunc                   func(ctx context.Context, alias string, collectionName string, ts Timestamp) error
 	DropAliasFunc                    func(ctx context.Context, alias string, ts Timestamp) error
@@ -81,6 +87,18 @@ func (m mockMetaTable) RemoveCollection(ctx context.Context, collectionID Unique
 	return m.RemoveCollectionFunc(ctx, collectionID, ts)
 }
 
+func (m mockMetaTable) RecycleCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	return m.RecycleCollectionFunc(ctx, collectionID, ts)
+}
+
+func (m mockMetaTable) RestoreCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	return m.RestoreCollectionFunc(ctx, collectionID, ts)
+}
+
+func (m mockMetaTable) ListRecycledCollections(ctx context.Context) []*model.Collection {
+	return m.ListRecycledCollectionsFunc(ctx)
+}
+
 func (m mockMetaTable) AddPartition(ctx context.Context, partition *model.Partition) error {
 	return m.AddPartitionFunc(ctx, partition)
 }
@@ -93,6 +111,18 @@ func (m mockMetaTable) RemovePartition(ctx context.Context, collectionID UniqueI
 	return m.RemovePartitionFunc(ctx, collectionID, partitionID, ts)
 }
 
+func (m mockMetaTable) RecyclePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+	return m.RecyclePartitionFunc(ctx, collectionID, partitionID, ts)
+}
+
+func (m mockMetaTable) RestorePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+	return m.RestorePartitionFunc(ctx, collectionID, partitionID, ts)
+}
+
+func (m mockMetaTable) ListRecycledPartitions(ctx context.Context) []*model.Partition {
+	return m.ListRecycledPartitionsFunc(ctx)
+}
+
 func (m mockMetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
 	return m.CreateAliasFunc(ctx, alias, collectionName, ts)
 }
@@ -134,6 +164,7 @@ type mockIndexCoord struct {
 	GetComponentStatesFunc   func(ctx context.Context) (*internalpb.ComponentStates, error)
 	GetSegmentIndexStateFunc func(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)
 	DropIndexFunc            func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error)
+	CreateIndexFunc          func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
 }
 
 func newMockIndexCoord() *mockIndexCoord {
@@ -152,6 +183,10 @@ func (m mockIndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return m.DropIndexFunc(ctx, req)
 }
 
+func (m mockIndexCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	return m.CreateIndexFunc(ctx, req)
+}
+
 type mockDataCoord struct {
 	types.DataCoord
 	GetComponentStatesFunc    func(ctx context.Context) (*internalpb.ComponentStates, error)
@@ -161,6 +196,9 @@ type mockDataCoord struct {
 	FlushFunc                 func(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error)
 	ImportFunc                func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 	UnsetIsImportingStateFunc func(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	GetFlushedSegmentsFunc    func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
+	GetSegmentInfoFunc        func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)
+	CloneSegmentsFunc         func(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error)
 }
 
 func newMockDataCoord() *mockDataCoord {
@@ -195,11 +233,24 @@ func (m *mockDataCoord) UnsetIsImportingState(ctx context.Context, req *datapb.U
 	return m.UnsetIsImportingStateFunc(ctx, req)
 }
 
+func (m *mockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	return m.GetFlushedSegmentsFunc(ctx, req)
+}
+
+func (m *mockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	return m.GetSegmentInfoFunc(ctx, req)
+}
+
+func (m *mockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	return m.CloneSegmentsFunc(ctx, req)
+}
+
 type mockQueryCoord struct {
 	types.QueryCoord
 	GetSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	GetComponentStatesFunc func(ctx context.Context) (*internalpb.ComponentStates, error)
 	ReleaseCollectionFunc  func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error)
+	ReleasePartitionsFunc  func(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)
 }
 
 func (m mockQueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
@@ -214,6 +265,10 @@ func (m mockQueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Rele
 	return m.ReleaseCollectionFunc(ctx, req)
 }
 
+func (m mockQueryCoord) ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
+	return m.ReleasePartitionsFunc(ctx, req)
+}
+
 func newMockQueryCoord() *mockQueryCoord {
 	return &mockQueryCoord{}
 }
@@ -414,6 +469,9 @@ func withInvalidQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return nil, errors.New("error mock ReleaseCollection")
 	}
+	qc.ReleasePartitionsFunc = func(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock ReleasePartitions")
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return nil, errors.New("error mock GetSegmentInfo")
 	}
@@ -431,6 +489,9 @@ func withFailedQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock release collection error"), nil
 	}
+	qc.ReleasePartitionsFunc = func(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock release partitions error"), nil
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return &querypb.GetSegmentInfoResponse{
 			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock get segment info error"),
@@ -450,6 +511,9 @@ func withValidQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return succStatus(), nil
 	}
+	qc.ReleasePartitionsFunc = func(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return &querypb.GetSegmentInfoResponse{
 			Status: succStatus(),
@@ -489,6 +553,9 @@ func withInvalidIndexCoord() Opt {
 	ic.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return succStatus(), errors.New("error mock DropIndex")
 	}
+	ic.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock CreateIndex")
+	}
 	return withIndexCoord(ic)
 }
 
@@ -506,6 +573,9 @@ func withFailedIndexCoord() Opt {
 	ic.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "reason mock DropIndex"), nil
 	}
+	ic.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "reason mock CreateIndex"), nil
+	}
 	return withIndexCoord(ic)
 }
 
@@ -523,6 +593,9 @@ func withValidIndexCoord() Opt {
 	ic.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return succStatus(), nil
 	}
+	ic.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	}
 	return withIndexCoord(ic)
 }
 
@@ -603,6 +676,15 @@ func withInvalidDataCoord() Opt {
 	dc.UnsetIsImportingStateFunc = func(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
 		return nil, errors.New("error mock UnsetIsImportingState")
 	}
+	dc.GetFlushedSegmentsFunc = func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+		return nil, errors.New("error mock GetFlushedSegments")
+	}
+	dc.GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+		return nil, errors.New("error mock GetSegmentInfo")
+	}
+	dc.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+		return nil, errors.New("error mock CloneSegments")
+	}
 	return withDataCoord(dc)
 }
 
@@ -641,6 +723,21 @@ func withFailedDataCoord() Opt {
 			Reason:    "mock UnsetIsImportingState error",
 		}, nil
 	}
+	dc.GetFlushedSegmentsFunc = func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+		return &datapb.GetFlushedSegmentsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock get flushed segments error"),
+		}, nil
+	}
+	dc.GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+		return &datapb.GetSegmentInfoResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock get segment info error"),
+		}, nil
+	}
+	dc.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+		return &datapb.CloneSegmentsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock clone segments error"),
+		}, nil
+	}
 	return withDataCoord(dc)
 }
 
@@ -676,6 +773,22 @@ func withValidDataCoord() Opt {
 	dc.UnsetIsImportingStateFunc = func(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
 		return succStatus(), nil
 	}
+	dc.GetFlushedSegmentsFunc = func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+		return &datapb.GetFlushedSegmentsResponse{
+			Status: succStatus(),
+		}, nil
+	}
+	dc.GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+		return &datapb.GetSegmentInfoResponse{
+			Status: succStatus(),
+		}, nil
+	}
+	dc.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+		return &datapb.CloneSegmentsResponse{
+			Status:     succStatus(),
+			SegmentIDs: []int64{1000},
+		}, nil
+	}
 	return withDataCoord(dc)
 }
 
@@ -776,6 +889,7 @@ type mockBroker struct {
 	Broker
 
 	ReleaseCollectionFunc   func(ctx context.Context, collectionID UniqueID) error
+	ReleasePartitionsFunc   func(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error
 	GetQuerySegmentInfoFunc func(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error)
 
 	WatchChannelsFunc     func(ctx context.Context, info *watchInfo) error
@@ -785,9 +899,14 @@ type mockBroker struct {
 	FlushFunc             func(ctx context.Context, cID int64, segIDs []int64) error
 	ImportFunc            func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 
+	GetFlushedSegmentsFunc func(ctx context.Context, collID UniqueID) ([]UniqueID, error)
+	GetSegmentInfoFunc     func(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error)
+	CloneSegmentsFunc      func(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error)
+
 	DropCollectionIndexFunc  func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	DescribeIndexFunc        func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
+	CreateIndexFunc          func(ctx context.Context, req *indexpb.CreateIndexRequest) error
 }
 
 func newMockBroker() *mockBroker {
@@ -806,6 +925,18 @@ func (b mockBroker) ReleaseCollection(ctx context.Context, collectionID UniqueID
 	return b.ReleaseCollectionFunc(ctx, collectionID)
 }
 
+func (b mockBroker) ReleasePartitions(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error {
+	return b.ReleasePartitionsFunc(ctx, collectionID, partitionIDs...)
+}
+
+func (b mockBroker) AddSegRefLock(ctx context.Context, taskID int64, segIDs []int64) error {
+	return b.AddSegRefLockFunc(ctx, taskID, segIDs)
+}
+
+func (b mockBroker) ReleaseSegRefLock(ctx context.Context, taskID int64, segIDs []int64) error {
+	return b.ReleaseSegRefLockFunc(ctx, taskID, segIDs)
+}
+
 func (b mockBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	return b.DropCollectionIndexFunc(ctx, collID, partIDs)
 }
@@ -818,6 +949,22 @@ func (b mockBroker) GetSegmentIndexState(ctx context.Context, collID UniqueID, i
 	return b.GetSegmentIndexStateFunc(ctx, collID, indexName, segIDs)
 }
 
+func (b mockBroker) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) error {
+	return b.CreateIndexFunc(ctx, req)
+}
+
+func (b mockBroker) GetFlushedSegments(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+	return b.GetFlushedSegmentsFunc(ctx, collID)
+}
+
+func (b mockBroker) GetSegmentInfo(ctx context.Context, segIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+	return b.GetSegmentInfoFunc(ctx, segIDs)
+}
+
+func (b mockBroker) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]UniqueID, error) {
+	return b.CloneSegmentsFunc(ctx, req)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
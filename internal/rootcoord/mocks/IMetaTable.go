@@ -467,6 +467,38 @@ func (_m *IMetaTable) ListPolicy(tenant string) ([]string, error) {
 	return r0, r1
 }
 
+// ListRecycledCollections provides a mock function with given fields: ctx
+func (_m *IMetaTable) ListRecycledCollections(ctx context.Context) []*model.Collection {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Collection
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Collection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	return r0
+}
+
+// ListRecycledPartitions provides a mock function with given fields: ctx
+func (_m *IMetaTable) ListRecycledPartitions(ctx context.Context) []*model.Partition {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Partition
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Partition); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Partition)
+		}
+	}
+
+	return r0
+}
+
 // ListUserRole provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListUserRole(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -518,6 +550,34 @@ func (_m *IMetaTable) OperateUserRole(tenant string, userEntity *milvuspb.UserEn
 	return r0
 }
 
+// RecycleCollection provides a mock function with given fields: ctx, collectionID, ts
+func (_m *IMetaTable) RecycleCollection(ctx context.Context, collectionID int64, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) error); ok {
+		r0 = rf(ctx, collectionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecyclePartition provides a mock function with given fields: ctx, collectionID, partitionID, ts
+func (_m *IMetaTable) RecyclePartition(ctx context.Context, collectionID int64, partitionID int64, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, partitionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uint64) error); ok {
+		r0 = rf(ctx, collectionID, partitionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveCollection provides a mock function with given fields: ctx, collectionID, ts
 func (_m *IMetaTable) RemoveCollection(ctx context.Context, collectionID int64, ts uint64) error {
 	ret := _m.Called(ctx, collectionID, ts)
@@ -546,6 +606,34 @@ func (_m *IMetaTable) RemovePartition(ctx context.Context, collectionID int64, p
 	return r0
 }
 
+// RestoreCollection provides a mock function with given fields: ctx, collectionID, ts
+func (_m *IMetaTable) RestoreCollection(ctx context.Context, collectionID int64, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) error); ok {
+		r0 = rf(ctx, collectionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestorePartition provides a mock function with given fields: ctx, collectionID, partitionID, ts
+func (_m *IMetaTable) RestorePartition(ctx context.Context, collectionID int64, partitionID int64, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, partitionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uint64) error); ok {
+		r0 = rf(ctx, collectionID, partitionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SelectGrant provides a mock function with given fields: tenant, entity
 func (_m *IMetaTable) SelectGrant(tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
 	ret := _m.Called(tenant, entity)
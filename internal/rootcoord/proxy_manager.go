@@ -90,14 +90,19 @@ func (p *proxyManager) WatchProxy() error {
 		f(sessions)
 	}
 
-	eventCh := p.etcdCli.Watch(
-		p.ctx,
-		path.Join(Params.EtcdCfg.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithCreatedNotify(),
-		clientv3.WithPrevKV(),
-		clientv3.WithRev(rev+1),
-	)
+	var eventCh clientv3.WatchChan
+	if kv := sessionutil.GetSessionKV(); kv != nil {
+		eventCh = kv.WatchWithRevisionCtx(p.ctx, proxySessionPrefix(), rev+1)
+	} else {
+		eventCh = p.etcdCli.Watch(
+			p.ctx,
+			proxySessionPrefix(),
+			clientv3.WithPrefix(),
+			clientv3.WithCreatedNotify(),
+			clientv3.WithPrevKV(),
+			clientv3.WithRev(rev+1),
+		)
+	}
 	go p.startWatchEtcd(p.ctx, eventCh)
 	return nil
 }
@@ -180,19 +185,14 @@ func (p *proxyManager) parseSession(value []byte) (*sessionutil.Session, error)
 }
 
 func (p *proxyManager) getSessionsOnEtcd(ctx context.Context) ([]*sessionutil.Session, int64, error) {
-	resp, err := p.etcdCli.Get(
-		ctx,
-		path.Join(Params.EtcdCfg.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
-	)
+	values, revision, err := loadProxySessions(ctx, p.etcdCli)
 	if err != nil {
 		return nil, 0, fmt.Errorf("proxy manager failed to watch proxy with error %w", err)
 	}
 
 	var sessions []*sessionutil.Session
-	for _, v := range resp.Kvs {
-		session, err := p.parseSession(v.Value)
+	for _, v := range values {
+		session, err := p.parseSession(v)
 		if err != nil {
 			log.Debug("failed to unmarshal session", zap.Error(err))
 			return nil, 0, err
@@ -200,7 +200,7 @@ func (p *proxyManager) getSessionsOnEtcd(ctx context.Context) ([]*sessionutil.Se
 		sessions = append(sessions, session)
 	}
 
-	return sessions, resp.Header.Revision, nil
+	return sessions, revision, nil
 }
 
 // Stop stops the proxyManager
@@ -212,19 +212,14 @@ func (p *proxyManager) Stop() {
 func listProxyInEtcd(ctx context.Context, cli *clientv3.Client) (map[int64]*sessionutil.Session, error) {
 	ctx2, cancel := context.WithTimeout(ctx, rootcoord.RequestTimeout)
 	defer cancel()
-	resp, err := cli.Get(
-		ctx2,
-		path.Join(Params.EtcdCfg.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
-	)
+	values, _, err := loadProxySessions(ctx2, cli)
 	if err != nil {
 		return nil, fmt.Errorf("list proxy failed, etcd error = %w", err)
 	}
 	sess := make(map[int64]*sessionutil.Session)
-	for _, v := range resp.Kvs {
+	for _, v := range values {
 		var s sessionutil.Session
-		err := json.Unmarshal(v.Value, &s)
+		err := json.Unmarshal(v, &s)
 		if err != nil {
 			log.Debug("unmarshal SvrSession failed", zap.Error(err))
 			continue
@@ -233,3 +228,37 @@ func listProxyInEtcd(ctx context.Context, cli *clientv3.Client) (map[int64]*sess
 	}
 	return sess, nil
 }
+
+func proxySessionPrefix() string {
+	return path.Join(Params.EtcdCfg.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole)
+}
+
+// loadProxySessions returns the proxy sessions sorted by key and the revision,
+// they are loaded from the session kv instead of etcd if it's set.
+func loadProxySessions(ctx context.Context, cli *clientv3.Client) ([][]byte, int64, error) {
+	if kv := sessionutil.GetSessionKV(); kv != nil {
+		_, values, _, revision, err := kv.LoadWithRevisionAndVersions(proxySessionPrefix())
+		if err != nil {
+			return nil, 0, err
+		}
+		result := make([][]byte, 0, len(values))
+		for _, v := range values {
+			result = append(result, []byte(v))
+		}
+		return result, revision, nil
+	}
+	resp, err := cli.Get(
+		ctx,
+		proxySessionPrefix(),
+		clientv3.WithPrefix(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([][]byte, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		result = append(result, kv.Value)
+	}
+	return result, resp.Header.Revision, nil
+}
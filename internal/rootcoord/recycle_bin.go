@@ -0,0 +1,365 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// recycleBinCheckInterval is the interval to purge the expired collections and partitions in the recycle bin.
+var recycleBinCheckInterval = time.Minute
+
+// expireTime returns when the collection or partition recycled at dropTs should be purged.
+func expireTime(dropTs Timestamp) time.Time {
+	dropTime, _ := tsoutil.ParseTS(dropTs)
+	return dropTime.Add(time.Duration(Params.RootCoordCfg.DropRetention * float64(time.Second)))
+}
+
+// listDroppedCollections lists the collections in the recycle bin, the latest dropped comes first.
+func (c *Core) listDroppedCollections(ctx context.Context, collectionName string) []*milvuspb.DroppedCollectionInfo {
+	colls := c.meta.ListRecycledCollections(ctx)
+	sort.Slice(colls, func(i, j int) bool {
+		return colls[i].DropTime > colls[j].DropTime
+	})
+	infos := make([]*milvuspb.DroppedCollectionInfo, 0, len(colls))
+	for _, coll := range colls {
+		if collectionName != "" && coll.Name != collectionName {
+			continue
+		}
+		physical, _ := tsoutil.ParseHybridTs(coll.DropTime)
+		infos = append(infos, &milvuspb.DroppedCollectionInfo{
+			CollectionName:      coll.Name,
+			CollectionID:        coll.CollectionID,
+			DroppedTimestamp:    coll.DropTime,
+			DroppedUtcTimestamp: uint64(physical),
+			ExpireUtcTimestamp:  uint64(expireTime(coll.DropTime).UnixMilli()),
+		})
+	}
+	return infos
+}
+
+// listDroppedPartitions lists the partitions in the recycle bin, the latest dropped comes first.
+func (c *Core) listDroppedPartitions(ctx context.Context, collectionName string) []*milvuspb.DroppedPartitionInfo {
+	partitions := c.meta.ListRecycledPartitions(ctx)
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].DropTime > partitions[j].DropTime
+	})
+	infos := make([]*milvuspb.DroppedPartitionInfo, 0, len(partitions))
+	for _, part := range partitions {
+		coll, err := c.meta.GetCollectionByID(ctx, part.CollectionID, typeutil.MaxTimestamp)
+		if err != nil {
+			// the collection is dropped along with the partition.
+			continue
+		}
+		if collectionName != "" && coll.Name != collectionName {
+			continue
+		}
+		physical, _ := tsoutil.ParseHybridTs(part.DropTime)
+		infos = append(infos, &milvuspb.DroppedPartitionInfo{
+			CollectionName:      coll.Name,
+			CollectionID:        part.CollectionID,
+			PartitionName:       part.PartitionName,
+			PartitionID:         part.PartitionID,
+			DroppedTimestamp:    part.DropTime,
+			DroppedUtcTimestamp: uint64(physical),
+			ExpireUtcTimestamp:  uint64(expireTime(part.DropTime).UnixMilli()),
+		})
+	}
+	return infos
+}
+
+type restoreCollectionTask struct {
+	baseTask
+	Req *milvuspb.RestoreCollectionRequest
+}
+
+func (t *restoreCollectionTask) Execute(ctx context.Context) error {
+	// pick the collection to restore, the latest dropped one if the id is not specified.
+	var coll *model.Collection
+	for _, recycled := range t.core.meta.ListRecycledCollections(ctx) {
+		if recycled.Name != t.Req.GetCollectionName() {
+			continue
+		}
+		if t.Req.GetCollectionID() != 0 && recycled.CollectionID != t.Req.GetCollectionID() {
+			continue
+		}
+		if coll == nil || recycled.DropTime > coll.DropTime {
+			coll = recycled
+		}
+	}
+	if coll == nil {
+		return fmt.Errorf("collection %s is not in the recycle bin", t.Req.GetCollectionName())
+	}
+	if time.Now().After(expireTime(coll.DropTime)) {
+		return fmt.Errorf("retention of dropped collection %s has expired", coll.Name)
+	}
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&restoreCollectionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: coll.CollectionID,
+		ts:           ts,
+	})
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		collectionNames: append(t.core.meta.ListAliasesByID(coll.CollectionID), coll.Name),
+		collectionID:    coll.CollectionID,
+		ts:              ts,
+	})
+	return redoTask.Execute(ctx)
+}
+
+// restorePartitionTask brings a dropped partition back from the recycle bin.
+type restorePartitionTask struct {
+	baseTask
+	Req *milvuspb.RestoreCollectionRequest
+}
+
+func (t *restorePartitionTask) Execute(ctx context.Context) error {
+	coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	collID := coll.CollectionID
+
+	// pick the partition to restore, the latest dropped one if the id is not specified.
+	var part *model.Partition
+	for _, recycled := range t.core.meta.ListRecycledPartitions(ctx) {
+		if recycled.CollectionID != collID || recycled.PartitionName != t.Req.GetPartitionName() {
+			continue
+		}
+		if t.Req.GetPartitionID() != 0 && recycled.PartitionID != t.Req.GetPartitionID() {
+			continue
+		}
+		if part == nil || recycled.DropTime > part.DropTime {
+			part = recycled
+		}
+	}
+	if part == nil {
+		return fmt.Errorf("partition %s of collection %s is not in the recycle bin",
+			t.Req.GetPartitionName(), t.Req.GetCollectionName())
+	}
+	if time.Now().After(expireTime(part.DropTime)) {
+		return fmt.Errorf("retention of dropped partition %s has expired", part.PartitionName)
+	}
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&restorePartitionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		partitionID:  part.PartitionID,
+		ts:           ts,
+	})
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		collectionNames: []string{t.Req.GetCollectionName()},
+		collectionID:    collID,
+		ts:              ts,
+	})
+	return redoTask.Execute(ctx)
+}
+
+// purgeCollectionTask drops the data and meta of a recycled collection whose retention has expired.
+type purgeCollectionTask struct {
+	baseTask
+	collectionID UniqueID
+}
+
+func (t *purgeCollectionTask) Execute(ctx context.Context) error {
+	var coll *model.Collection
+	for _, recycled := range t.core.meta.ListRecycledCollections(ctx) {
+		if recycled.CollectionID == t.collectionID {
+			coll = recycled
+		}
+	}
+	if coll == nil {
+		// restored or purged already.
+		return nil
+	}
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&changeCollectionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: coll.CollectionID,
+		state:        pb.CollectionState_CollectionDropping,
+		ts:           ts,
+	})
+
+	// the collection has been released when it was moved to the recycle bin.
+	redoTask.AddAsyncStep(&dropIndexStep{
+		baseStep: baseStep{core: t.core},
+		collID:   coll.CollectionID,
+		partIDs:  nil,
+	})
+	redoTask.AddAsyncStep(&deleteCollectionDataStep{
+		baseStep: baseStep{core: t.core},
+		coll:     coll,
+	})
+	redoTask.AddAsyncStep(&removeDmlChannelsStep{
+		baseStep:  baseStep{core: t.core},
+		pChannels: coll.PhysicalChannelNames,
+	})
+	redoTask.AddAsyncStep(&deleteCollectionMetaStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: coll.CollectionID,
+		ts:           ts,
+	})
+	return redoTask.Execute(ctx)
+}
+
+// purgePartitionTask drops the data and meta of a recycled partition whose retention has expired.
+type purgePartitionTask struct {
+	baseTask
+	collectionID UniqueID
+	partitionID  UniqueID
+}
+
+func (t *purgePartitionTask) Execute(ctx context.Context) error {
+	var part *model.Partition
+	for _, recycled := range t.core.meta.ListRecycledPartitions(ctx) {
+		if recycled.CollectionID == t.collectionID && recycled.PartitionID == t.partitionID {
+			part = recycled
+		}
+	}
+	if part == nil {
+		// restored or purged already.
+		return nil
+	}
+	coll, err := t.core.meta.GetCollectionByID(ctx, t.collectionID, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&changePartitionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collectionID,
+		partitionID:  t.partitionID,
+		state:        pb.PartitionState_PartitionDropping,
+		ts:           ts,
+	})
+
+	// the partition has been released when it was moved to the recycle bin.
+	redoTask.AddAsyncStep(&dropIndexStep{
+		baseStep: baseStep{core: t.core},
+		collID:   t.collectionID,
+		partIDs:  []UniqueID{t.partitionID},
+	})
+	redoTask.AddAsyncStep(&deletePartitionDataStep{
+		baseStep:  baseStep{core: t.core},
+		pchans:    coll.PhysicalChannelNames,
+		partition: part,
+	})
+	redoTask.AddAsyncStep(&removePartitionMetaStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collectionID,
+		partitionID:  t.partitionID,
+		ts:           ts,
+	})
+	return redoTask.Execute(ctx)
+}
+
+// purgeExpiredCollections purges the collections whose retention has expired.
+func (c *Core) purgeExpiredCollections(ctx context.Context) {
+	now := time.Now()
+	for _, coll := range c.meta.ListRecycledCollections(ctx) {
+		if now.Before(expireTime(coll.DropTime)) {
+			continue
+		}
+		t := &purgeCollectionTask{
+			baseTask: baseTask{
+				ctx:  ctx,
+				core: c,
+				done: make(chan error, 1),
+			},
+			collectionID: coll.CollectionID,
+		}
+		err := c.scheduler.AddTask(t)
+		if err == nil {
+			err = t.WaitToFinish()
+		}
+		if err != nil {
+			log.Warn("failed to purge collection in recycle bin", zap.String("collection name", coll.Name),
+				zap.Int64("collection id", coll.CollectionID), zap.Error(err))
+			continue
+		}
+		log.Info("purged collection in recycle bin", zap.String("collection name", coll.Name),
+			zap.Int64("collection id", coll.CollectionID), zap.Uint64("drop ts", coll.DropTime))
+	}
+}
+
+// purgeExpiredPartitions purges the partitions whose retention has expired.
+func (c *Core) purgeExpiredPartitions(ctx context.Context) {
+	now := time.Now()
+	for _, part := range c.meta.ListRecycledPartitions(ctx) {
+		if now.Before(expireTime(part.DropTime)) {
+			continue
+		}
+		t := &purgePartitionTask{
+			baseTask: baseTask{
+				ctx:  ctx,
+				core: c,
+				done: make(chan error, 1),
+			},
+			collectionID: part.CollectionID,
+			partitionID:  part.PartitionID,
+		}
+		err := c.scheduler.AddTask(t)
+		if err == nil {
+			err = t.WaitToFinish()
+		}
+		if err != nil {
+			log.Warn("failed to purge partition in recycle bin", zap.Int64("collection id", part.CollectionID),
+				zap.String("partition name", part.PartitionName), zap.Int64("partition id", part.PartitionID), zap.Error(err))
+			continue
+		}
+		log.Info("purged partition in recycle bin", zap.Int64("collection id", part.CollectionID),
+			zap.String("partition name", part.PartitionName), zap.Int64("partition id", part.PartitionID),
+			zap.Uint64("drop ts", part.DropTime))
+	}
+}
+
+func (c *Core) recycleBinLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(recycleBinCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.purgeExpiredCollections(c.ctx)
+			c.purgeExpiredPartitions(c.ctx)
+		}
+	}
+}
@@ -0,0 +1,567 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/commonpb"
+	"github.com/milvus-io/milvus/api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// withDropRetention sets the retention of the recycle bin, and returns a function to reset it.
+func withDropRetention(retention time.Duration) func() {
+	Params.InitOnce()
+	old := Params.RootCoordCfg.DropRetention
+	Params.RootCoordCfg.DropRetention = retention.Seconds()
+	return func() {
+		Params.RootCoordCfg.DropRetention = old
+	}
+}
+
+func newRecycledCollection(id UniqueID, name string, dropTime time.Time) *model.Collection {
+	return &model.Collection{
+		CollectionID: id,
+		Name:         name,
+		State:        pb.CollectionState_CollectionRecycled,
+		DropTime:     tsoutil.ComposeTSByTime(dropTime, 0),
+	}
+}
+
+func newRecycledPartition(collID UniqueID, id UniqueID, name string, dropTime time.Time) *model.Partition {
+	return &model.Partition{
+		CollectionID:  collID,
+		PartitionID:   id,
+		PartitionName: name,
+		State:         pb.PartitionState_PartitionRecycled,
+		DropTime:      tsoutil.ComposeTSByTime(dropTime, 0),
+	}
+}
+
+func Test_dropCollectionTask_Recycle(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+
+	coll := &model.Collection{CollectionID: 100, Name: "coll"}
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		return coll.Clone(), nil
+	}
+	meta.ListAliasesByIDFunc = func(collID UniqueID) []string {
+		return []string{"alias"}
+	}
+	var recycledID UniqueID
+	meta.RecycleCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+		recycledID = collectionID
+		return nil
+	}
+
+	broker := newMockBroker()
+	releaseCollectionChan := make(chan struct{}, 1)
+	broker.ReleaseCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+		releaseCollectionChan <- struct{}{}
+		return nil
+	}
+
+	core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+	task := &dropCollectionTask{
+		baseTask: baseTask{core: core},
+		Req: &milvuspb.DropCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+			CollectionName: "coll",
+		},
+	}
+	// data, indexes and meta are kept, the broker and gc would panic otherwise.
+	err := task.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), recycledID)
+	<-releaseCollectionChan
+
+	t.Run("failed to recycle", func(t *testing.T) {
+		meta.RecycleCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			return errors.New("error mock RecycleCollection")
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		meta.RecycleCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			t.Error("the purged collection is recycled")
+			return nil
+		}
+		var dropping bool
+		meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error {
+			dropping = state == pb.CollectionState_CollectionDropping
+			return errors.New("error mock ChangeCollectionState")
+		}
+		purgeTask := &dropCollectionTask{baseTask: task.baseTask, Req: task.Req, purge: true}
+		err := purgeTask.Execute(context.Background())
+		assert.Error(t, err)
+		assert.True(t, dropping)
+	})
+}
+
+func Test_restoreCollectionTask_Execute(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+
+	now := time.Now()
+	newTask := func(name string, id UniqueID, recycled ...*model.Collection) (*restoreCollectionTask, *mockMetaTable) {
+		meta := newMockMetaTable()
+		meta.ListRecycledCollectionsFunc = func(ctx context.Context) []*model.Collection {
+			return recycled
+		}
+		meta.ListAliasesByIDFunc = func(collID UniqueID) []string {
+			return []string{"alias"}
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		return &restoreCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &milvuspb.RestoreCollectionRequest{CollectionName: name, CollectionID: id},
+		}, meta
+	}
+
+	t.Run("not in recycle bin", func(t *testing.T) {
+		task, _ := newTask("coll", 0, newRecycledCollection(100, "coll2", now))
+		assert.Error(t, task.Execute(context.Background()))
+		task, _ = newTask("coll", 200, newRecycledCollection(100, "coll", now))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("retention expired", func(t *testing.T) {
+		task, _ := newTask("coll", 0, newRecycledCollection(100, "coll", now.Add(-2*time.Hour)))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to restore", func(t *testing.T) {
+		task, meta := newTask("coll", 0, newRecycledCollection(100, "coll", now))
+		meta.RestoreCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			return errors.New("error mock RestoreCollection")
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("restore the latest dropped", func(t *testing.T) {
+		task, meta := newTask("coll", 0,
+			newRecycledCollection(100, "coll", now.Add(-time.Minute)),
+			newRecycledCollection(200, "coll", now),
+			newRecycledCollection(300, "coll", now.Add(-2*time.Minute)))
+		var restoredID UniqueID
+		meta.RestoreCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			restoredID = collectionID
+			return nil
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.Equal(t, int64(200), restoredID)
+	})
+
+	t.Run("restore by id", func(t *testing.T) {
+		task, meta := newTask("coll", 100,
+			newRecycledCollection(100, "coll", now.Add(-time.Minute)),
+			newRecycledCollection(200, "coll", now))
+		var restoredID UniqueID
+		meta.RestoreCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			restoredID = collectionID
+			return nil
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.Equal(t, int64(100), restoredID)
+	})
+}
+
+func Test_dropPartitionTask_Recycle(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+
+	coll := &model.Collection{
+		CollectionID: 100,
+		Name:         "coll",
+		Partitions:   []*model.Partition{{PartitionID: 101, PartitionName: "part"}},
+	}
+	meta := newMockMetaTable()
+	var recycledID UniqueID
+	meta.RecyclePartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+		recycledID = partitionID
+		return nil
+	}
+
+	broker := newMockBroker()
+	releasePartitionsChan := make(chan []UniqueID, 1)
+	broker.ReleasePartitionsFunc = func(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error {
+		releasePartitionsChan <- partitionIDs
+		return nil
+	}
+
+	core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+	task := &dropPartitionTask{
+		baseTask: baseTask{core: core},
+		Req: &milvuspb.DropPartitionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropPartition},
+			CollectionName: "coll",
+			PartitionName:  "part",
+		},
+		collMeta: coll.Clone(),
+	}
+	// data, indexes and meta are kept, the broker and gc would panic otherwise.
+	err := task.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), recycledID)
+	assert.Equal(t, []UniqueID{101}, <-releasePartitionsChan)
+
+	t.Run("failed to recycle", func(t *testing.T) {
+		meta.RecyclePartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+			return errors.New("error mock RecyclePartition")
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func Test_restorePartitionTask_Execute(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+
+	now := time.Now()
+	newTask := func(name string, id UniqueID, recycled ...*model.Partition) (*restorePartitionTask, *mockMetaTable) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			if collectionName != "coll" {
+				return nil, common.NewCollectionNotExistError("collection not exist")
+			}
+			return &model.Collection{CollectionID: 100, Name: "coll"}, nil
+		}
+		meta.ListRecycledPartitionsFunc = func(ctx context.Context) []*model.Partition {
+			return recycled
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		return &restorePartitionTask{
+			baseTask: baseTask{core: core},
+			Req:      &milvuspb.RestoreCollectionRequest{CollectionName: "coll", PartitionName: name, PartitionID: id},
+		}, meta
+	}
+
+	t.Run("collection not exist", func(t *testing.T) {
+		task, _ := newTask("part", 0, newRecycledPartition(100, 101, "part", now))
+		task.Req.CollectionName = "coll2"
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("not in recycle bin", func(t *testing.T) {
+		task, _ := newTask("part", 0, newRecycledPartition(100, 101, "part2", now))
+		assert.Error(t, task.Execute(context.Background()))
+		task, _ = newTask("part", 0, newRecycledPartition(200, 201, "part", now))
+		assert.Error(t, task.Execute(context.Background()))
+		task, _ = newTask("part", 102, newRecycledPartition(100, 101, "part", now))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("retention expired", func(t *testing.T) {
+		task, _ := newTask("part", 0, newRecycledPartition(100, 101, "part", now.Add(-2*time.Hour)))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to restore", func(t *testing.T) {
+		task, meta := newTask("part", 0, newRecycledPartition(100, 101, "part", now))
+		meta.RestorePartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+			return errors.New("error mock RestorePartition")
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("restore the latest dropped", func(t *testing.T) {
+		task, meta := newTask("part", 0,
+			newRecycledPartition(100, 101, "part", now.Add(-time.Minute)),
+			newRecycledPartition(100, 102, "part", now),
+			newRecycledPartition(100, 103, "part", now.Add(-2*time.Minute)))
+		var restoredID UniqueID
+		meta.RestorePartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+			restoredID = partitionID
+			return nil
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.Equal(t, int64(102), restoredID)
+	})
+
+	t.Run("restore by id", func(t *testing.T) {
+		task, meta := newTask("part", 101,
+			newRecycledPartition(100, 101, "part", now.Add(-time.Minute)),
+			newRecycledPartition(100, 102, "part", now))
+		var restoredID UniqueID
+		meta.RestorePartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+			restoredID = partitionID
+			return nil
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.Equal(t, int64(101), restoredID)
+	})
+}
+
+func TestCore_purgeExpiredCollections(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+
+	ticker := newTickerWithMockNormalStream()
+	pchans := ticker.getDmlChannelNames(2)
+	ticker.addDmlChannels(pchans...)
+
+	now := time.Now()
+	expired := newRecycledCollection(100, "coll", now.Add(-2*time.Hour))
+	expired.PhysicalChannelNames = pchans
+	recycled := []*model.Collection{expired, newRecycledCollection(200, "coll2", now)}
+
+	meta := newMockMetaTable()
+	meta.ListRecycledCollectionsFunc = func(ctx context.Context) []*model.Collection {
+		return recycled
+	}
+	var changedID UniqueID
+	meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error {
+		assert.Equal(t, pb.CollectionState_CollectionDropping, state)
+		changedID = collectionID
+		return nil
+	}
+	removeCollectionChan := make(chan UniqueID, 1)
+	meta.RemoveCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+		removeCollectionChan <- collectionID
+		return nil
+	}
+
+	broker := newMockBroker()
+	dropIndexChan := make(chan UniqueID, 1)
+	broker.DropCollectionIndexFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
+		dropIndexChan <- collID
+		return nil
+	}
+	gc := newMockGarbageCollector()
+	gcChan := make(chan UniqueID, 1)
+	gc.GcCollectionDataFunc = func(ctx context.Context, coll *model.Collection) (Timestamp, error) {
+		gcChan <- coll.CollectionID
+		return 0, nil
+	}
+
+	sched := newMockScheduler()
+	sched.AddTaskFunc = func(t task) error {
+		t.NotifyDone(t.Execute(context.Background()))
+		return nil
+	}
+	core := newTestCore(withMeta(meta), withBroker(broker), withGarbageCollector(gc),
+		withTtSynchronizer(ticker), withScheduler(sched))
+
+	core.purgeExpiredCollections(context.Background())
+	assert.Equal(t, int64(100), changedID)
+	assert.Equal(t, int64(100), <-dropIndexChan)
+	assert.Equal(t, int64(100), <-gcChan)
+	assert.Equal(t, int64(100), <-removeCollectionChan)
+
+	t.Run("restored before purge", func(t *testing.T) {
+		task := &purgeCollectionTask{
+			baseTask:     baseTask{core: core},
+			collectionID: 300,
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to change state", func(t *testing.T) {
+		meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error {
+			return errors.New("error mock ChangeCollectionState")
+		}
+		// errors are logged only.
+		core.purgeExpiredCollections(context.Background())
+	})
+}
+
+func TestCore_purgeExpiredPartitions(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+
+	now := time.Now()
+	recycled := []*model.Partition{
+		newRecycledPartition(100, 101, "part", now.Add(-2*time.Hour)),
+		newRecycledPartition(100, 102, "part2", now),
+	}
+
+	meta := newMockMetaTable()
+	meta.ListRecycledPartitionsFunc = func(ctx context.Context) []*model.Partition {
+		return recycled
+	}
+	meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+		return &model.Collection{CollectionID: collectionID, PhysicalChannelNames: []string{"ch"}}, nil
+	}
+	var changedID UniqueID
+	meta.ChangePartitionStateFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error {
+		assert.Equal(t, pb.PartitionState_PartitionDropping, state)
+		changedID = partitionID
+		return nil
+	}
+	removePartitionChan := make(chan UniqueID, 1)
+	meta.RemovePartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
+		removePartitionChan <- partitionID
+		return nil
+	}
+
+	broker := newMockBroker()
+	dropIndexChan := make(chan []UniqueID, 1)
+	broker.DropCollectionIndexFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
+		dropIndexChan <- partIDs
+		return nil
+	}
+	gc := newMockGarbageCollector()
+	gcChan := make(chan UniqueID, 1)
+	gc.GcPartitionDataFunc = func(ctx context.Context, pChannels []string, partition *model.Partition) (Timestamp, error) {
+		assert.Equal(t, []string{"ch"}, pChannels)
+		gcChan <- partition.PartitionID
+		return 0, nil
+	}
+
+	sched := newMockScheduler()
+	sched.AddTaskFunc = func(t task) error {
+		t.NotifyDone(t.Execute(context.Background()))
+		return nil
+	}
+	core := newTestCore(withMeta(meta), withBroker(broker), withGarbageCollector(gc), withScheduler(sched))
+
+	core.purgeExpiredPartitions(context.Background())
+	assert.Equal(t, int64(101), changedID)
+	assert.Equal(t, []UniqueID{101}, <-dropIndexChan)
+	assert.Equal(t, int64(101), <-gcChan)
+	assert.Equal(t, int64(101), <-removePartitionChan)
+
+	t.Run("restored before purge", func(t *testing.T) {
+		task := &purgePartitionTask{
+			baseTask:     baseTask{core: core},
+			collectionID: 100,
+			partitionID:  103,
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to change state", func(t *testing.T) {
+		meta.ChangePartitionStateFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error {
+			return errors.New("error mock ChangePartitionState")
+		}
+		// errors are logged only.
+		core.purgeExpiredPartitions(context.Background())
+	})
+}
+
+func TestCore_RecycleBin(t *testing.T) {
+	defer withDropRetention(time.Hour)()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		listResp, err := c.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		status, err := c.RestoreCollection(ctx, &milvuspb.RestoreCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("list dropped collections", func(t *testing.T) {
+		dropTime := time.UnixMilli(time.Now().UnixMilli())
+		meta := newMockMetaTable()
+		meta.ListRecycledCollectionsFunc = func(ctx context.Context) []*model.Collection {
+			return []*model.Collection{
+				newRecycledCollection(100, "coll", dropTime.Add(-time.Minute)),
+				newRecycledCollection(200, "coll", dropTime),
+				newRecycledCollection(300, "coll2", dropTime),
+			}
+		}
+		meta.ListRecycledPartitionsFunc = func(ctx context.Context) []*model.Partition {
+			return []*model.Partition{
+				newRecycledPartition(400, 401, "part", dropTime.Add(-time.Minute)),
+				newRecycledPartition(400, 402, "part", dropTime),
+				newRecycledPartition(500, 501, "part", dropTime),
+			}
+		}
+		meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+			if collectionID != 400 {
+				return nil, common.NewCollectionNotExistError("collection not exist")
+			}
+			return &model.Collection{CollectionID: 400, Name: "coll"}, nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		require.Equal(t, 2, len(resp.GetCollections()))
+		assert.Equal(t, int64(200), resp.GetCollections()[0].GetCollectionID())
+		assert.Equal(t, int64(100), resp.GetCollections()[1].GetCollectionID())
+		assert.Equal(t, uint64(dropTime.UnixMilli()), resp.GetCollections()[0].GetDroppedUtcTimestamp())
+		assert.Equal(t, uint64(dropTime.Add(time.Hour).UnixMilli()), resp.GetCollections()[0].GetExpireUtcTimestamp())
+		// the partitions of the dropped collection are skipped.
+		require.Equal(t, 2, len(resp.GetPartitions()))
+		assert.Equal(t, int64(402), resp.GetPartitions()[0].GetPartitionID())
+		assert.Equal(t, int64(401), resp.GetPartitions()[1].GetPartitionID())
+		assert.Equal(t, "coll", resp.GetPartitions()[0].GetCollectionName())
+		assert.Equal(t, uint64(dropTime.Add(time.Hour).UnixMilli()), resp.GetPartitions()[0].GetExpireUtcTimestamp())
+
+		resp, err = c.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{CollectionName: "coll2"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(resp.GetCollections()))
+		assert.Equal(t, 0, len(resp.GetPartitions()))
+
+		resp, err = c.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(resp.GetCollections()))
+		assert.Equal(t, 2, len(resp.GetPartitions()))
+	})
+
+	t.Run("failed to restore", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withInvalidScheduler())
+		status, err := c.RestoreCollection(ctx, &milvuspb.RestoreCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		c = newTestCore(withHealthyCode(), withTaskFailScheduler())
+		status, err = c.RestoreCollection(ctx, &milvuspb.RestoreCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("restore collection", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withValidScheduler())
+		status, err := c.RestoreCollection(ctx, &milvuspb.RestoreCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("failed to restore partition", func(t *testing.T) {
+		req := &milvuspb.RestoreCollectionRequest{CollectionName: "coll", PartitionName: "part"}
+		c := newTestCore(withHealthyCode(), withInvalidScheduler())
+		status, err := c.RestoreCollection(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		c = newTestCore(withHealthyCode(), withTaskFailScheduler())
+		status, err = c.RestoreCollection(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("restore partition", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withValidScheduler())
+		status, err := c.RestoreCollection(ctx, &milvuspb.RestoreCollectionRequest{CollectionName: "coll", PartitionName: "part"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+}
@@ -11,6 +11,7 @@ type baseRedoTask struct {
 	syncTodoStep  []nestedStep // steps to execute synchronously
 	asyncTodoStep []nestedStep // steps to execute asynchronously
 	stepExecutor  StepExecutor
+	job           *ddlJob
 }
 
 func newBaseRedoTask(stepExecutor StepExecutor) *baseRedoTask {
@@ -36,18 +37,24 @@ func (b *baseRedoTask) redoAsyncSteps() {
 		steps = append(steps, b.asyncTodoStep[i])
 	}
 	b.asyncTodoStep = nil // make baseRedoTask can be collected.
-	b.stepExecutor.AddSteps(&stepStack{steps: steps})
+	b.stepExecutor.AddSteps(&stepStack{steps: steps, job: b.job})
 }
 
 func (b *baseRedoTask) Execute(ctx context.Context) error {
+	b.job = ddlJobFromContext(ctx)
 	for i := 0; i < len(b.syncTodoStep); i++ {
 		todo := b.syncTodoStep[i]
 		// no children step in sync steps.
-		if _, err := todo.Execute(ctx); err != nil {
+		b.job.startStep(todo)
+		_, err := todo.Execute(ctx)
+		b.job.finishStep(todo, nil, err)
+		if err != nil {
 			log.Error("failed to execute step", zap.Error(err), zap.String("desc", todo.Desc()))
 			return err
 		}
 	}
+	// register the async steps before returning, so that the job won't be considered as finished.
+	b.job.addSteps(b.asyncTodoStep, true, false)
 	go b.redoAsyncSteps()
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/db/dao"
@@ -82,10 +84,21 @@ type metaKVCreator func(root string) (kv.MetaKv, error)
 
 func defaultMetaKVCreator(etcdCli *clientv3.Client) metaKVCreator {
 	return func(root string) (kv.MetaKv, error) {
+		if metaKV := rocksdbkv.NewLocalMetaKV(root); metaKV != nil {
+			return metaKV, nil
+		}
 		return etcdkv.NewEtcdKV(etcdCli, root), nil
 	}
 }
 
+// newTSOKV returns the kv of the id or tso allocator, it's kept in the local rocksdb of the standalone if it's set.
+func (c *Core) newTSOKV(subPath string) kv.TxnKV {
+	if metaKV := rocksdbkv.NewLocalMetaKV(path.Join(Params.EtcdCfg.KvRootPath, subPath)); metaKV != nil {
+		return metaKV
+	}
+	return tsoutil.NewTSOKVBase(c.etcdCli, Params.EtcdCfg.KvRootPath, subPath)
+}
+
 // Core root coordinator core
 type Core struct {
 	ctx              context.Context
@@ -93,6 +106,7 @@ type Core struct {
 	wg               sync.WaitGroup
 	etcdCli          *clientv3.Client
 	meta             IMetaTable
+	snapshot         *kvmetestore.SuffixSnapshot
 	scheduler        IScheduler
 	broker           Broker
 	ddlTsLockManager DdlTsLockManager
@@ -126,6 +140,8 @@ type Core struct {
 	factory dependency.Factory
 
 	importManager *importManager
+	backupManager *backupManager
+	ddlJobManager *ddlJobManager
 
 	enableActiveStandBy bool
 	activateFunc        func()
@@ -329,7 +345,8 @@ func (c *Core) initMetaTable() error {
 			}
 
 			catalog = &kvmetestore.Catalog{Txn: metaKV, Snapshot: ss}
-		case util.MetaStoreTypeMysql:
+			c.snapshot = ss
+		case util.MetaStoreTypeMysql, util.MetaStoreTypeSqlite:
 			// connect to database
 			err := dbcore.Connect(&Params.DBCfg)
 			if err != nil {
@@ -352,7 +369,7 @@ func (c *Core) initMetaTable() error {
 }
 
 func (c *Core) initIDAllocator() error {
-	tsoKV := tsoutil.NewTSOKVBase(c.etcdCli, Params.EtcdCfg.KvRootPath, globalIDAllocatorSubPath)
+	tsoKV := c.newTSOKV(globalIDAllocatorSubPath)
 	idAllocator := allocator.NewGlobalIDAllocator(globalIDAllocatorKey, tsoKV)
 	if err := idAllocator.Initialize(); err != nil {
 		return err
@@ -362,7 +379,7 @@ func (c *Core) initIDAllocator() error {
 }
 
 func (c *Core) initTSOAllocator() error {
-	tsoKV := tsoutil.NewTSOKVBase(c.etcdCli, Params.EtcdCfg.KvRootPath, globalTSOAllocatorSubPath)
+	tsoKV := c.newTSOKV(globalTSOAllocatorSubPath)
 	tsoAllocator := tso.NewGlobalTSOAllocator(globalTSOAllocatorKey, tsoKV)
 	if err := tsoAllocator.Initialize(); err != nil {
 		return err
@@ -395,6 +412,27 @@ func (c *Core) initImportManager() error {
 	return nil
 }
 
+func (c *Core) initBackupManager() error {
+	cm, err := c.factory.NewPersistentStorageChunkManager(c.ctx)
+	if err != nil {
+		return err
+	}
+	c.backupManager = newBackupManager(c.ctx, c, cm)
+	if err := c.backupManager.init(c.ctx); err != nil {
+		log.Warn("failed to recover backups", zap.Error(err))
+	}
+	return nil
+}
+
+func (c *Core) initDDLJobManager() error {
+	jobKv, err := c.metaKVCreator(Params.EtcdCfg.KvRootPath)
+	if err != nil {
+		return err
+	}
+	c.ddlJobManager = newDDLJobManager(jobKv)
+	return c.ddlJobManager.init()
+}
+
 func (c *Core) initInternal() error {
 	if err := c.initSession(); err != nil {
 		return err
@@ -414,7 +452,11 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
-	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
+	if err := c.initDDLJobManager(); err != nil {
+		return err
+	}
+
+	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator, withDDLJobManager(c.ddlJobManager))
 
 	c.factory.Init(&Params)
 
@@ -446,6 +488,10 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
+	if err := c.initBackupManager(); err != nil {
+		return err
+	}
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -588,13 +634,16 @@ func (c *Core) startInternal() error {
 		panic(err)
 	}
 
-	c.wg.Add(6)
+	c.wg.Add(9)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.recycleBinLoop()
+	go c.ddlJobCleanupLoop()
+	go c.snapshotGCLoop()
 	Params.RootCoordCfg.CreatedTime = time.Now()
 	Params.RootCoordCfg.UpdatedTime = time.Now()
 
@@ -1601,6 +1650,239 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	}, nil
 }
 
+// CreateBackup backs up the flushed segments of a collection, binlogs are copied in background.
+func (c *Core) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.CreateBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateBackup", metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("collection name", req.GetCollectionName()),
+		zap.String("backup name", req.GetBackupName()),
+		zap.Uint64("backup ts", req.GetBackupTimestamp()))
+	log.Info("received request to create backup")
+
+	info, err := c.backupManager.createBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to create backup", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateBackup", metrics.FailLabel).Inc()
+		return &milvuspb.CreateBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateBackup", metrics.SuccessLabel).Inc()
+	log.Info("done to create backup", zap.Int64("segment num", info.GetNumSegments()))
+	return &milvuspb.CreateBackupResponse{
+		Status: succStatus(),
+		Info:   info,
+	}, nil
+}
+
+// ListBackups lists backups of a collection, or all backups if the collection name is empty.
+func (c *Core) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListBackupsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	backups, err := c.backupManager.listBackups(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Ctx(ctx).Error("failed to list backups", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		return &milvuspb.ListBackupsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return &milvuspb.ListBackupsResponse{
+		Status:  succStatus(),
+		Backups: backups,
+	}, nil
+}
+
+// RestoreBackup creates a new collection from a backup and imports the backed up data into it.
+func (c *Core) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.RestoreBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreBackup", metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("backup name", req.GetBackupName()),
+		zap.String("collection name", req.GetCollectionName()))
+	log.Info("received request to restore backup")
+
+	collID, tasks, err := c.backupManager.restoreBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to restore backup", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreBackup", metrics.FailLabel).Inc()
+		return &milvuspb.RestoreBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreBackup", metrics.SuccessLabel).Inc()
+	log.Info("done to restore backup", zap.Int64("collection ID", collID), zap.Int64s("import tasks", tasks))
+	return &milvuspb.RestoreBackupResponse{
+		Status:       succStatus(),
+		CollectionID: collID,
+		Tasks:        tasks,
+	}, nil
+}
+
+// CloneCollection creates a new collection sharing the flushed binlogs of an existing collection
+func (c *Core) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.CloneCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("collection name", req.GetCollectionName()),
+		zap.String("new collection name", req.GetNewCollectionName()))
+	log.Info("received request to clone collection", zap.Uint64("timestamp", req.GetTimestamp()))
+
+	collID, segIDs, err := c.cloneCollection(ctx, req)
+	if err != nil {
+		log.Error("failed to clone collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return &milvuspb.CloneCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.SuccessLabel).Inc()
+	log.Info("done to clone collection", zap.Int64("collection ID", collID), zap.Int("segment num", len(segIDs)))
+	return &milvuspb.CloneCollectionResponse{
+		Status:       succStatus(),
+		CollectionID: collID,
+		SegmentIDs:   segIDs,
+	}, nil
+}
+
+// ListDroppedCollections lists the dropped collections and partitions kept in the recycle bin.
+func (c *Core) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	return &milvuspb.ListDroppedCollectionsResponse{
+		Status:      succStatus(),
+		Collections: c.listDroppedCollections(ctx, req.GetCollectionName()),
+		Partitions:  c.listDroppedPartitions(ctx, req.GetCollectionName()),
+	}, nil
+}
+
+// RestoreCollection brings a dropped collection back from the recycle bin, or a dropped partition if
+// the partition name is specified.
+func (c *Core) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	if req.GetPartitionName() != "" {
+		return c.restorePartition(ctx, req), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreCollection", metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("collection name", req.GetCollectionName()),
+		zap.Int64("collection id", req.GetCollectionID()))
+	log.Info("received request to restore collection")
+
+	t := &restoreCollectionTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: req,
+	}
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Error("failed to enqueue request to restore collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	if err := t.WaitToFinish(); err != nil {
+		log.Error("failed to restore collection", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordNumOfCollections.Inc()
+	log.Info("done to restore collection", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+func (c *Core) restorePartition(ctx context.Context, req *milvuspb.RestoreCollectionRequest) *commonpb.Status {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestorePartition", metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("collection name", req.GetCollectionName()),
+		zap.String("partition name", req.GetPartitionName()), zap.Int64("partition id", req.GetPartitionID()))
+	log.Info("received request to restore partition")
+
+	t := &restorePartitionTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: req,
+	}
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Error("failed to enqueue request to restore partition", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestorePartition", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error())
+	}
+	if err := t.WaitToFinish(); err != nil {
+		log.Error("failed to restore partition", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestorePartition", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error())
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestorePartition", metrics.SuccessLabel).Inc()
+	log.Info("done to restore partition", zap.Uint64("ts", t.GetTs()))
+	return succStatus()
+}
+
+// ListDDLJobs lists the DDL jobs scheduled by rootcoord, the latest jobs come first.
+func (c *Core) ListDDLJobs(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListDDLJobsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	return &rootcoordpb.ListDDLJobsResponse{
+		Status: succStatus(),
+		Jobs:   c.ddlJobManager.listJobs(req.GetCollectionName(), req.GetStates(), req.GetLimit()),
+	}, nil
+}
+
+// DescribeDDLJob returns a DDL job with its steps.
+func (c *Core) DescribeDDLJob(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.DescribeDDLJobResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	job, err := c.ddlJobManager.describeJob(req.GetJobID())
+	if err != nil {
+		return &rootcoordpb.DescribeDDLJobResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return &rootcoordpb.DescribeDDLJobResponse{
+		Status: succStatus(),
+		Job:    job,
+	}, nil
+}
+
 // ExpireCredCache will call invalidate credential cache
 func (c *Core) ExpireCredCache(ctx context.Context, username string) error {
 	req := proxypb.InvalidateCredCacheRequest{
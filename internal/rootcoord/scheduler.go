@@ -25,20 +25,35 @@ type scheduler struct {
 
 	taskChan chan task
 
+	// jobManager tracks the scheduled tasks if not nil.
+	jobManager *ddlJobManager
+
 	lock sync.Mutex
 }
 
-func newScheduler(ctx context.Context, idAllocator allocator.GIDAllocator, tsoAllocator tso.Allocator) *scheduler {
+type schedulerOpt func(*scheduler)
+
+func withDDLJobManager(jobManager *ddlJobManager) schedulerOpt {
+	return func(s *scheduler) {
+		s.jobManager = jobManager
+	}
+}
+
+func newScheduler(ctx context.Context, idAllocator allocator.GIDAllocator, tsoAllocator tso.Allocator, opts ...schedulerOpt) *scheduler {
 	ctx1, cancel := context.WithCancel(ctx)
 	// TODO
 	n := 1024 * 10
-	return &scheduler{
+	s := &scheduler{
 		ctx:          ctx1,
 		cancel:       cancel,
 		idAllocator:  idAllocator,
 		tsoAllocator: tsoAllocator,
 		taskChan:     make(chan task, n),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *scheduler) Start() {
@@ -52,11 +67,16 @@ func (s *scheduler) Stop() {
 }
 
 func (s *scheduler) execute(task task) {
-	if err := task.Prepare(task.GetCtx()); err != nil {
+	job := s.jobManager.getJob(task.GetID())
+	job.start()
+	ctx := withDDLJob(task.GetCtx(), job)
+	if err := task.Prepare(ctx); err != nil {
+		job.finish(err)
 		task.NotifyDone(err)
 		return
 	}
-	err := task.Execute(task.GetCtx())
+	err := task.Execute(ctx)
+	job.finish(err)
 	task.NotifyDone(err)
 }
 
@@ -105,6 +125,7 @@ func (s *scheduler) AddTask(task task) error {
 	if err := s.setTs(task); err != nil {
 		return err
 	}
+	s.jobManager.addJob(task)
 	s.enqueue(task)
 	return nil
 }
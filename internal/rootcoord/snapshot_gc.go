@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// snapshotGCInterval is the interval to remove the meta snapshots beyond the time travel retention.
+var snapshotGCInterval = 10 * time.Minute
+
+// CollectSnapshots removes the meta snapshots which can't be reached by time travel anymore,
+// it's only supported by the etcd meta store, the database meta store keeps no snapshot.
+func (c *Core) CollectSnapshots(ctx context.Context) (*kvmetestore.SnapshotGCStats, error) {
+	if c.snapshot == nil {
+		return nil, errors.New("snapshot gc is only supported by the etcd meta store")
+	}
+	ts, err := c.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return nil, err
+	}
+	retentionTs := tsoutil.AddPhysicalDurationOnTs(ts, -time.Duration(Params.CommonCfg.RetentionDuration)*time.Second)
+	stats, err := c.snapshot.RemoveExpiredSnapshots(retentionTs)
+	if err != nil {
+		return nil, err
+	}
+	metrics.RootCoordNumOfSnapshotKeys.Set(float64(stats.Retained))
+	metrics.RootCoordSnapshotKeysRemoved.Add(float64(stats.Removed))
+	log.Info("meta snapshots collected", zap.Uint64("retention ts", retentionTs),
+		zap.Int("removed", stats.Removed), zap.Int("retained", stats.Retained))
+	return stats, nil
+}
+
+func (c *Core) snapshotGCLoop() {
+	defer c.wg.Done()
+	if c.snapshot == nil {
+		return
+	}
+	ticker := time.NewTicker(snapshotGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.CollectSnapshots(c.ctx); err != nil {
+				log.Warn("failed to collect meta snapshots", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestCore_CollectSnapshots(t *testing.T) {
+	Params.InitOnce()
+
+	t.Run("no snapshot", func(t *testing.T) {
+		c := newTestCore()
+		_, err := c.CollectSnapshots(context.Background())
+		assert.Error(t, err)
+	})
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	require.NoError(t, err)
+	defer etcdCli.Close()
+	rootPath := fmt.Sprintf("/test/meta/snapshot-gc/%d", rand.Int())
+	metaKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	defer metaKV.RemoveWithPrefix("")
+	ss, err := kvmetestore.NewSuffixSnapshot(metaKV, snapshotsSep, rootPath, snapshotPrefix)
+	require.NoError(t, err)
+
+	t.Run("failed to allocate ts", func(t *testing.T) {
+		c := newTestCore(withInvalidTsoAllocator())
+		c.snapshot = ss
+		_, err := c.CollectSnapshots(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		now := time.Now()
+		retention := time.Duration(Params.CommonCfg.RetentionDuration) * time.Second
+		expired := tsoutil.ComposeTSByTime(now.Add(-2*retention), 0)
+		require.NoError(t, ss.Save("key", "v1", expired))
+		require.NoError(t, ss.Save("key", "v2", expired+1))
+		require.NoError(t, ss.Save("key", "v3", tsoutil.ComposeTSByTime(now, 0)))
+
+		alloc := newMockTsoAllocator()
+		alloc.GenerateTSOF = func(count uint32) (uint64, error) {
+			return tsoutil.ComposeTSByTime(now, 0), nil
+		}
+		c := newTestCore(withTsoAllocator(alloc))
+		c.snapshot = ss
+		stats, err := c.CollectSnapshots(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Removed)
+		assert.Equal(t, 2, stats.Retained)
+
+		value, err := ss.Load("key", expired+2)
+		assert.NoError(t, err)
+		assert.Equal(t, "v2", value)
+	})
+}
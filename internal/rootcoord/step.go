@@ -7,6 +7,7 @@ import (
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/retry"
 )
 
 type stepPriority int
@@ -144,6 +145,68 @@ func (s *changeCollectionStateStep) Desc() string {
 		s.collectionID, s.ts, s.state.String())
 }
 
+type recycleCollectionStep struct {
+	baseStep
+	collectionID UniqueID
+	ts           Timestamp
+}
+
+func (s *recycleCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RecycleCollection(ctx, s.collectionID, s.ts)
+	return nil, err
+}
+
+func (s *recycleCollectionStep) Desc() string {
+	return fmt.Sprintf("move collection to recycle bin, collection: %d, ts: %d", s.collectionID, s.ts)
+}
+
+type restoreCollectionStep struct {
+	baseStep
+	collectionID UniqueID
+	ts           Timestamp
+}
+
+func (s *restoreCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RestoreCollection(ctx, s.collectionID, s.ts)
+	return nil, err
+}
+
+func (s *restoreCollectionStep) Desc() string {
+	return fmt.Sprintf("restore collection from recycle bin, collection: %d, ts: %d", s.collectionID, s.ts)
+}
+
+type recyclePartitionStep struct {
+	baseStep
+	collectionID UniqueID
+	partitionID  UniqueID
+	ts           Timestamp
+}
+
+func (s *recyclePartitionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RecyclePartition(ctx, s.collectionID, s.partitionID, s.ts)
+	return nil, err
+}
+
+func (s *recyclePartitionStep) Desc() string {
+	return fmt.Sprintf("move partition to recycle bin, collection: %d, partition: %d, ts: %d", s.collectionID, s.partitionID, s.ts)
+}
+
+type restorePartitionStep struct {
+	baseStep
+	collectionID UniqueID
+	partitionID  UniqueID
+	ts           Timestamp
+}
+
+func (s *restorePartitionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RestorePartition(ctx, s.collectionID, s.partitionID, s.ts)
+	return nil, err
+}
+
+func (s *restorePartitionStep) Desc() string {
+	return fmt.Sprintf("restore partition from recycle bin, collection: %d, partition: %d, ts: %d", s.collectionID, s.partitionID, s.ts)
+}
+
 type expireCacheStep struct {
 	baseStep
 	collectionNames []string
@@ -254,6 +317,29 @@ func (s *releaseCollectionStep) Weight() stepPriority {
 	return stepPriorityUrgent
 }
 
+type releasePartitionsStep struct {
+	baseStep
+	collectionID UniqueID
+	partitionIDs []UniqueID
+}
+
+func (s *releasePartitionsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	// querycoord refuses to release a part of the collection loaded by LoadCollection,
+	// the partitions are released along with the collection then, so don't retry.
+	if err := s.core.broker.ReleasePartitions(ctx, s.collectionID, s.partitionIDs...); err != nil {
+		return nil, retry.Unrecoverable(err)
+	}
+	return nil, nil
+}
+
+func (s *releasePartitionsStep) Desc() string {
+	return fmt.Sprintf("release partitions, collection: %d, partitions: %v", s.collectionID, s.partitionIDs)
+}
+
+func (s *releasePartitionsStep) Weight() stepPriority {
+	return stepPriorityUrgent
+}
+
 type dropIndexStep struct {
 	baseStep
 	collID  UniqueID
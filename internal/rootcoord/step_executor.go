@@ -23,6 +23,7 @@ type StepExecutor interface {
 
 type stepStack struct {
 	steps []nestedStep
+	job   *ddlJob // the job which the steps belong to, nil if not tracked.
 }
 
 func (s *stepStack) Execute(ctx context.Context) *stepStack {
@@ -30,7 +31,9 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 	for len(steps) > 0 {
 		l := len(steps)
 		todo := steps[l-1]
+		s.job.startStep(todo)
 		childSteps, err := todo.Execute(ctx)
+		s.job.finishStep(todo, childSteps, err)
 		if retry.IsUnRecoverable(err) {
 			log.Warn("failed to execute step, not able to reschedule", zap.Error(err), zap.String("step", todo.Desc()))
 			return nil
@@ -38,7 +41,7 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 		if err != nil {
 			s.steps = nil // let s can be collected.
 			log.Warn("failed to execute step, wait for reschedule", zap.Error(err), zap.String("step", todo.Desc()))
-			return &stepStack{steps: steps}
+			return &stepStack{steps: steps, job: s.job}
 		}
 		// this step is done.
 		steps = steps[:l-1]
@@ -31,14 +31,19 @@ func (b *baseUndoTask) Execute(ctx context.Context) error {
 	if len(b.todoStep) != len(b.undoStep) {
 		return fmt.Errorf("todo step and undo step length not equal")
 	}
+	job := ddlJobFromContext(ctx)
 	for i := 0; i < len(b.todoStep); i++ {
 		todoStep := b.todoStep[i]
 		// no children step in normal case.
-		if _, err := todoStep.Execute(ctx); err != nil {
+		job.startStep(todoStep)
+		_, err := todoStep.Execute(ctx)
+		job.finishStep(todoStep, nil, err)
+		if err != nil {
 			log.Warn("failed to execute step, trying to undo", zap.Error(err), zap.String("desc", todoStep.Desc()))
 			undoSteps := b.undoStep[:i]
 			b.undoStep = nil // let baseUndoTask can be collected.
-			go b.stepExecutor.AddSteps(&stepStack{undoSteps})
+			job.addSteps(undoSteps, false, true)
+			go b.stepExecutor.AddSteps(&stepStack{steps: undoSteps, job: job})
 			return err
 		}
 	}
@@ -108,6 +108,15 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 	return v, nil
 }
 
+// RowNum returns the number of records in the iterator
+func (itr *InsertBinlogIterator) RowNum() int {
+	rowIDs, ok := itr.data.Data[common.RowIDField]
+	if !ok {
+		return 0
+	}
+	return rowIDs.RowNum()
+}
+
 // Dispose disposes the iterator
 func (itr *InsertBinlogIterator) Dispose() {
 	atomic.CompareAndSwapInt32(&itr.dispose, 0, 1)
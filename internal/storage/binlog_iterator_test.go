@@ -85,6 +85,7 @@ func TestInsertlogIterator(t *testing.T) {
 			data: &InsertData{},
 		}
 		assert.False(t, itr.HasNext())
+		assert.Equal(t, 0, itr.RowNum())
 		_, err := itr.Next()
 		assert.Equal(t, ErrNoMoreRecord, err)
 	})
@@ -104,6 +105,7 @@ func TestInsertlogIterator(t *testing.T) {
 		blobs := generateTestData(t, 3)
 		itr, err := NewInsertBinlogIterator(blobs, common.RowIDField, schemapb.DataType_Int64)
 		assert.Nil(t, err)
+		assert.Equal(t, 3, itr.RowNum())
 
 		for i := 1; i <= 3; i++ {
 			assert.True(t, itr.HasNext())
@@ -48,8 +48,15 @@ func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
 	if reader.eventReader != nil {
 		reader.eventReader.Close()
 	}
-	var err error
-	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer)
+	compression, err := compressionFromExtras(reader.descriptorEvent.Extras)
+	if err != nil {
+		return nil, err
+	}
+	withChecksum, err := hasChecksum(reader.descriptorEvent.Extras)
+	if err != nil {
+		return nil, err
+	}
+	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer, compression, withChecksum)
 	if err != nil {
 		return nil, err
 	}
@@ -1365,6 +1365,10 @@ func (e *testEvent) SetOffset(offset int32) {
 
 }
 
+func (e *testEvent) setChecksum(enable bool) {
+
+}
+
 var _ EventWriter = (*testEvent)(nil)
 
 func TestWriterListError(t *testing.T) {
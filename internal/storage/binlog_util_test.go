@@ -32,6 +32,13 @@ func TestParseSegmentIDByBinlog(t *testing.T) {
 			expectError: false,
 			expectID:    434828745294479362,
 		},
+		{
+			name:        "segment file of storage v2",
+			input:       "files/insertLog/123/456/1/segment/10000001",
+			rootPath:    "files",
+			expectError: false,
+			expectID:    1,
+		},
 		{
 			name:        "bad format",
 			input:       "files/123",
@@ -53,6 +53,7 @@ type baseBinlogWriter struct {
 	eventWriters []EventWriter
 	buffer       *bytes.Buffer
 	length       int32
+	compression  Compression
 }
 
 func (writer *baseBinlogWriter) isClosed() bool {
@@ -81,6 +82,26 @@ func (writer *baseBinlogWriter) GetRowNums() (int32, error) {
 	return int32(length), nil
 }
 
+// SetCompression sets the compression of the event payloads and records it in the descriptor event extras,
+// it should be called before any event writer is created.
+func (writer *baseBinlogWriter) SetCompression(compression Compression) error {
+	if len(writer.eventWriters) > 0 {
+		return fmt.Errorf("can't set compression after event writers are created")
+	}
+	writer.compression = compression
+	addCompressionExtras(&writer.descriptorEventData, compression)
+	return nil
+}
+
+// setEventCompression sets the compression of the binlog to the event, the payload
+// writer's default compression is used if the compression is not set.
+func (writer *baseBinlogWriter) setEventCompression(event EventWriter) error {
+	if writer.compression.IsEmpty() {
+		return nil
+	}
+	return event.SetCompression(writer.compression)
+}
+
 // GetBinlogType returns writer's binlogType
 func (writer *baseBinlogWriter) GetBinlogType() BinlogType {
 	return writer.binlogType
@@ -109,6 +130,11 @@ func (writer *baseBinlogWriter) Finish() error {
 		return err
 	}
 	offset += int32(binary.Size(MagicNumber))
+	// the event headers carry the checksums of the payloads
+	withChecksum := EnablePayloadChecksum
+	if withChecksum {
+		writer.AddExtra(checksumKey, checksumCRC32C)
+	}
 	if err := writer.descriptorEvent.Write(writer.buffer); err != nil {
 		return err
 	}
@@ -117,6 +143,7 @@ func (writer *baseBinlogWriter) Finish() error {
 	writer.length = 0
 	for _, w := range writer.eventWriters {
 		w.SetOffset(offset)
+		w.setChecksum(withChecksum)
 		if err := w.Finish(); err != nil {
 			return err
 		}
@@ -167,6 +194,10 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter(dim ...int) (*insertEven
 	if err != nil {
 		return nil, err
 	}
+	if err = writer.setEventCompression(event); err != nil {
+		event.Close()
+		return nil, err
+	}
 
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
@@ -186,6 +217,10 @@ func (writer *DeleteBinlogWriter) NextDeleteEventWriter() (*deleteEventWriter, e
 	if err != nil {
 		return nil, err
 	}
+	if err = writer.setEventCompression(event); err != nil {
+		event.Close()
+		return nil, err
+	}
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+const (
+	checksumKey    = "checksum"
+	checksumCRC32C = "crc32c"
+)
+
+// ErrBinlogCorrupted is returned if a binlog is truncated or its checksum mismatches
+var ErrBinlogCorrupted = errors.New("binlog is corrupted")
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// EnablePayloadChecksum makes the binlog writers carry the payload checksums in the event headers.
+// The binlogs with the checksums can't be read by the nodes of older versions, so it should only be
+// enabled after all the nodes are upgraded. It is set by common.payloadChecksum.enable.
+var EnablePayloadChecksum = false
+
+// Checksum returns the CRC32C of data, which is the checksum of the event payloads and the binlog files
+func Checksum(data []byte) uint32 {
+	return crc32.Checksum(data, crc32cTable)
+}
+
+// hasChecksum returns true if the event headers of a binlog carry the payload checksums,
+// which is recorded in the descriptor event extras.
+func hasChecksum(extras map[string]interface{}) (bool, error) {
+	v, ok := extras[checksumKey]
+	if !ok {
+		return false, nil
+	}
+	if v != checksumCRC32C {
+		return false, fmt.Errorf("unsupported checksum %v", v)
+	}
+	return true, nil
+}
+
+// VerifyBinlog verifies a binlog file by its CRC32C if checksum is not 0. Otherwise, the binlog
+// is verified by reading all the events, whose payloads are verified if they carry the checksums.
+// The segment files of storage v2 are only verified by the checksum.
+func VerifyBinlog(data []byte, checksum uint32) error {
+	if checksum != 0 {
+		if actual := Checksum(data); actual != checksum {
+			return fmt.Errorf("%w: checksum mismatch, expected %d, actual %d", ErrBinlogCorrupted, checksum, actual)
+		}
+		return nil
+	}
+	if IsSegmentFile(data) {
+		return nil
+	}
+
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBinlogCorrupted, err.Error())
+	}
+	defer reader.Close()
+	for {
+		event, err := reader.NextEventReader()
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrBinlogCorrupted, err.Error())
+		}
+		if event == nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+func TestBinlogChecksum(t *testing.T) {
+	EnablePayloadChecksum = true
+	defer func() { EnablePayloadChecksum = false }()
+	meta, data := genSegmentFileTestData()
+	insertCodec := NewInsertCodec(meta)
+	blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, data)
+	require.NoError(t, err)
+
+	t.Run("verify", func(t *testing.T) {
+		for _, blob := range blobs {
+			reader, err := NewBinlogReader(blob.Value)
+			require.NoError(t, err)
+			assert.Equal(t, checksumCRC32C, reader.Extras[checksumKey])
+			reader.Close()
+
+			assert.NoError(t, VerifyBinlog(blob.Value, Checksum(blob.Value)))
+			assert.NoError(t, VerifyBinlog(blob.Value, 0))
+		}
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		value := append([]byte{}, blobs[0].Value...)
+		// the last event payload
+		value[len(value)-10] ^= 0xff
+		err := VerifyBinlog(value, 0)
+		assert.True(t, errors.Is(err, ErrBinlogCorrupted))
+		err = VerifyBinlog(value, Checksum(blobs[0].Value))
+		assert.True(t, errors.Is(err, ErrBinlogCorrupted))
+
+		_, _, _, _, err = insertCodec.DeserializeAll([]*Blob{{Key: blobs[0].Key, Value: value}})
+		assert.True(t, errors.Is(err, ErrBinlogCorrupted))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		value := blobs[0].Value[:len(blobs[0].Value)-10]
+		err := VerifyBinlog(value, 0)
+		assert.True(t, errors.Is(err, ErrBinlogCorrupted))
+
+		_, _, _, _, err = insertCodec.DeserializeAll([]*Blob{{Key: blobs[0].Key, Value: value}})
+		assert.True(t, errors.Is(err, ErrBinlogCorrupted))
+	})
+
+	t.Run("segment file", func(t *testing.T) {
+		blob, err := insertCodec.SerializeSegmentFile(PartitionID, SegmentID, data)
+		require.NoError(t, err)
+		assert.NoError(t, VerifyBinlog(blob.Value, Checksum(blob.Value)))
+		assert.Error(t, VerifyBinlog(blob.Value, Checksum(blob.Value)+1))
+	})
+
+	t.Run("unsupported checksum", func(t *testing.T) {
+		_, err := hasChecksum(map[string]interface{}{checksumKey: "md5"})
+		assert.Error(t, err)
+	})
+}
+
+func TestBinlogChecksumDisabled(t *testing.T) {
+	meta, data := genSegmentFileTestData()
+	insertCodec := NewInsertCodec(meta)
+	blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, data)
+	require.NoError(t, err)
+
+	for _, blob := range blobs {
+		// the binlogs are readable by the nodes not knowing the payload checksum
+		reader, err := NewBinlogReader(blob.Value)
+		require.NoError(t, err)
+		_, ok := reader.Extras[checksumKey]
+		assert.False(t, ok)
+		reader.Close()
+
+		assert.NoError(t, VerifyBinlog(blob.Value, Checksum(blob.Value)))
+		assert.NoError(t, VerifyBinlog(blob.Value, 0))
+	}
+
+	value := append([]byte{}, blobs[0].Value...)
+	value[len(value)-10] ^= 0xff
+	err = VerifyBinlog(value, Checksum(blobs[0].Value))
+	assert.True(t, errors.Is(err, ErrBinlogCorrupted))
+}
+
+func TestReadBinlogWithoutChecksum(t *testing.T) {
+	// the binlogs written before the payload checksum is introduced
+	descriptor := newDescriptorEvent()
+	descriptor.PayloadDataType = schemapb.DataType_Int64
+	descriptor.AddExtra(originalSizeKey, fmt.Sprintf("%v", 24))
+
+	payloadWriter, err := NewPayloadWriter(schemapb.DataType_Int64)
+	require.NoError(t, err)
+	defer payloadWriter.Close()
+	require.NoError(t, payloadWriter.AddInt64ToPayload([]int64{1, 2, 3}))
+	require.NoError(t, payloadWriter.FinishPayloadWriter())
+	payload, err := payloadWriter.GetPayloadBufferFromWriter()
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, binary.Write(buf, common.Endian, MagicNumber))
+	require.NoError(t, descriptor.Write(buf))
+	header := baseEventHeader{
+		TypeCode:    InsertEventType,
+		EventLength: int32(binary.Size(baseEventHeader{})+binary.Size(insertEventData{})) + int32(len(payload)),
+	}
+	header.NextPosition = int32(buf.Len()) + header.EventLength
+	require.NoError(t, header.Write(buf))
+	require.NoError(t, binary.Write(buf, common.Endian, insertEventData{StartTimestamp: 100, EndTimestamp: 200}))
+	buf.Write(payload)
+
+	reader, err := NewBinlogReader(buf.Bytes())
+	require.NoError(t, err)
+	defer reader.Close()
+	eventReader, err := reader.NextEventReader()
+	require.NoError(t, err)
+	values, err := eventReader.GetInt64FromPayload()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, values)
+	eventReader, err = reader.NextEventReader()
+	assert.NoError(t, err)
+	assert.Nil(t, eventReader)
+
+	assert.NoError(t, VerifyBinlog(buf.Bytes(), 0))
+}
@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v8/parquet/compress"
+)
+
+// CompressionType is the codec to compress the payloads of binlogs
+type CompressionType string
+
+// CompressionType definitions
+const (
+	CompressionNone   CompressionType = "none"
+	CompressionSnappy CompressionType = "snappy"
+	CompressionZstd   CompressionType = "zstd"
+	CompressionLz4    CompressionType = "lz4"
+)
+
+const (
+	compressionKey      = "compression"
+	compressionLevelKey = "compression_level"
+
+	minZstdLevel = 1
+	maxZstdLevel = 22
+)
+
+// Compression is the codec and the level to compress the payloads of binlogs,
+// the level only works for zstd.
+type Compression struct {
+	Type  CompressionType
+	Level int
+}
+
+// DefaultCompression is the compression of the binlogs which don't record their compression
+// in the descriptor event extras, i.e. the binlogs written before the compression is configurable.
+var DefaultCompression = Compression{Type: CompressionZstd, Level: 3}
+
+// ParseCompression parses the compression in the format of "codec" or "codec:level", e.g. "zstd:3"
+func ParseCompression(s string) (Compression, error) {
+	codec, level, hasLevel := strings.Cut(strings.TrimSpace(s), ":")
+	c := Compression{Type: CompressionType(strings.ToLower(strings.TrimSpace(codec)))}
+	switch c.Type {
+	case CompressionNone, CompressionSnappy, CompressionLz4:
+		if hasLevel {
+			return Compression{}, fmt.Errorf("compression %s doesn't support level", c.Type)
+		}
+	case CompressionZstd:
+		c.Level = DefaultCompression.Level
+		if hasLevel {
+			l, err := strconv.Atoi(strings.TrimSpace(level))
+			if err != nil {
+				return Compression{}, fmt.Errorf("invalid compression level %s: %w", level, err)
+			}
+			if l < minZstdLevel || l > maxZstdLevel {
+				return Compression{}, fmt.Errorf("compression level of zstd should be in [%d, %d], got %d", minZstdLevel, maxZstdLevel, l)
+			}
+			c.Level = l
+		}
+	default:
+		return Compression{}, fmt.Errorf("unknown compression %s", codec)
+	}
+	return c, nil
+}
+
+// IsEmpty returns true if the compression is not specified
+func (c Compression) IsEmpty() bool {
+	return c.Type == ""
+}
+
+func (c Compression) String() string {
+	if c.Type == CompressionZstd {
+		return fmt.Sprintf("%s:%d", c.Type, c.Level)
+	}
+	return string(c.Type)
+}
+
+// addCompressionExtras records the compression in the descriptor event extras
+func addCompressionExtras(data *descriptorEventData, c Compression) {
+	data.AddExtra(compressionKey, string(c.Type))
+	data.AddExtra(compressionLevelKey, strconv.Itoa(c.Level))
+}
+
+// compressionFromExtras returns the compression recorded in the descriptor event extras,
+// DefaultCompression is returned if the binlog doesn't record it.
+func compressionFromExtras(extras map[string]interface{}) (Compression, error) {
+	v, ok := extras[compressionKey]
+	if !ok {
+		return DefaultCompression, nil
+	}
+	codec, ok := v.(string)
+	if !ok {
+		return Compression{}, fmt.Errorf("value of %v must in string format", compressionKey)
+	}
+	c := Compression{Type: CompressionType(codec)}
+	if v, ok := extras[compressionLevelKey]; ok {
+		level, ok := v.(string)
+		if !ok {
+			return Compression{}, fmt.Errorf("value of %v must in string format", compressionLevelKey)
+		}
+		var err error
+		if c.Level, err = strconv.Atoi(level); err != nil {
+			return Compression{}, fmt.Errorf("value of %v must be able to be converted into int format", compressionLevelKey)
+		}
+	}
+	return c, nil
+}
+
+// GetBinlogCompression returns the compression of the payloads of a binlog by its descriptor event
+func GetBinlogCompression(blob []byte) (Compression, error) {
+	reader, err := NewBinlogReader(blob)
+	if err != nil {
+		return Compression{}, err
+	}
+	defer reader.Close()
+	return compressionFromExtras(reader.Extras)
+}
+
+// segmentFileCompression returns the parquet codec of the segment files for the compression,
+// the parquet writer of go doesn't support lz4, so lz4 falls back to the default compression.
+func segmentFileCompression(c Compression) (compress.Compression, int) {
+	switch c.Type {
+	case CompressionNone:
+		return compress.Codecs.Uncompressed, compress.DefaultCompressionLevel
+	case CompressionSnappy:
+		return compress.Codecs.Snappy, compress.DefaultCompressionLevel
+	case CompressionZstd:
+		return compress.Codecs.Zstd, c.Level
+	default:
+		return compress.Codecs.Zstd, DefaultCompression.Level
+	}
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+)
+
+func TestParseCompression(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Compression
+		valid    bool
+	}{
+		{"none", Compression{Type: CompressionNone}, true},
+		{"snappy", Compression{Type: CompressionSnappy}, true},
+		{"LZ4", Compression{Type: CompressionLz4}, true},
+		{"zstd", DefaultCompression, true},
+		{" zstd : 9 ", Compression{Type: CompressionZstd, Level: 9}, true},
+		{"zstd:0", Compression{}, false},
+		{"zstd:23", Compression{}, false},
+		{"zstd:fast", Compression{}, false},
+		{"snappy:1", Compression{}, false},
+		{"gzip", Compression{}, false},
+		{"", Compression{}, false},
+	}
+	for _, c := range cases {
+		compression, err := ParseCompression(c.input)
+		if !c.valid {
+			assert.Error(t, err, c.input)
+			continue
+		}
+		assert.NoError(t, err, c.input)
+		assert.Equal(t, c.expected, compression, c.input)
+	}
+
+	assert.Equal(t, "zstd:3", DefaultCompression.String())
+	assert.Equal(t, "lz4", Compression{Type: CompressionLz4}.String())
+	assert.True(t, Compression{}.IsEmpty())
+}
+
+func TestCompressionExtras(t *testing.T) {
+	data := newDescriptorEventData()
+	compression, err := compressionFromExtras(data.Extras)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCompression, compression)
+
+	addCompressionExtras(data, Compression{Type: CompressionZstd, Level: 7})
+	compression, err = compressionFromExtras(data.Extras)
+	require.NoError(t, err)
+	assert.Equal(t, Compression{Type: CompressionZstd, Level: 7}, compression)
+
+	_, err = compressionFromExtras(map[string]interface{}{compressionKey: 1})
+	assert.Error(t, err)
+	_, err = compressionFromExtras(map[string]interface{}{compressionKey: "zstd", compressionLevelKey: 1})
+	assert.Error(t, err)
+	_, err = compressionFromExtras(map[string]interface{}{compressionKey: "zstd", compressionLevelKey: "high"})
+	assert.Error(t, err)
+}
+
+func TestInsertCodecCompression(t *testing.T) {
+	meta, data := genSegmentFileTestData()
+	for _, compression := range []Compression{
+		{Type: CompressionNone},
+		{Type: CompressionSnappy},
+		{Type: CompressionZstd, Level: 1},
+		{Type: CompressionLz4},
+	} {
+		t.Run(compression.String(), func(t *testing.T) {
+			insertCodec := NewInsertCodec(meta)
+			insertCodec.Compression = compression
+
+			blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, data)
+			require.NoError(t, err)
+			for _, blob := range blobs {
+				c, err := GetBinlogCompression(blob.Value)
+				require.NoError(t, err)
+				assert.Equal(t, compression, c)
+			}
+			_, _, _, result, err := insertCodec.DeserializeAll(blobs)
+			require.NoError(t, err)
+			assert.Equal(t, data.Data, result.Data)
+
+			blob, err := insertCodec.SerializeSegmentFile(PartitionID, SegmentID, data)
+			require.NoError(t, err)
+			_, _, _, result, err = insertCodec.DeserializeAll([]*Blob{blob})
+			require.NoError(t, err)
+			assert.Equal(t, data.Data, result.Data)
+		})
+	}
+
+	t.Run("default compression", func(t *testing.T) {
+		blobs, _, err := NewInsertCodec(meta).Serialize(PartitionID, SegmentID, data)
+		require.NoError(t, err)
+		reader, err := NewBinlogReader(blobs[0].Value)
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.NotContains(t, reader.Extras, compressionKey)
+		c, err := GetBinlogCompression(blobs[0].Value)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultCompression, c)
+	})
+
+	t.Run("set compression after event writers created", func(t *testing.T) {
+		writer := NewInsertBinlogWriter(schemapb.DataType_Int64, CollectionID, PartitionID, SegmentID, Int64Field)
+		defer writer.Close()
+		_, err := writer.NextInsertEventWriter()
+		require.NoError(t, err)
+		assert.Error(t, writer.SetCompression(DefaultCompression))
+	})
+}
@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
@@ -258,8 +259,14 @@ type InsertData struct {
 // InsertCodec serializes and deserializes the insert data
 // Blob key example:
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
+// or of a segment file in storage v2, see SerializeSegmentFile:
+// ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/segment/${log_idx}
 type InsertCodec struct {
 	Schema *etcdpb.CollectionMeta
+	// SortByPK sorts the insert data by primary key instead of row id when serializing
+	SortByPK bool
+	// Compression compresses the payloads of binlogs, the default compression is used if it's empty
+	Compression Compression
 }
 
 // NewInsertCodec creates an InsertCodec with provided collection meta
@@ -287,10 +294,11 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 	startTs := ts[0]
 	endTs := ts[len(ts)-1]
 
-	// sort insert data by rowID
+	// sort insert data by rowID, or by primary key if required
 	dataSorter := &DataSorter{
 		InsertCodec: insertCodec,
 		InsertData:  data,
+		SortByPK:    insertCodec.SortByPK,
 	}
 	sort.Sort(dataSorter)
 
@@ -299,6 +307,12 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// encode fields
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
+		if !insertCodec.Compression.IsEmpty() {
+			if err := writer.SetCompression(insertCodec.Compression); err != nil {
+				writer.Close()
+				return nil, nil, err
+			}
+		}
 		var eventWriter *insertEventWriter
 		var err error
 		if typeutil.IsVectorType(field.DataType) {
@@ -479,7 +493,20 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 	segmentID UniqueID,
 	err error,
 ) {
+	segmentFiles := make(map[string]struct{})
 	for _, blob := range fieldBinlogs {
+		if IsSegmentFile(blob.Value) {
+			// all the fields of a segment file share the same path, read it only once
+			if _, ok := segmentFiles[blob.Key]; ok && blob.Key != "" {
+				continue
+			}
+			segmentFiles[blob.Key] = struct{}{}
+			if collectionID, partitionID, segmentID, err = deserializeSegmentFile(blob.Value, insertData); err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+			continue
+		}
+
 		binlogReader, err := NewBinlogReader(blob.Value)
 		if err != nil {
 			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
@@ -752,6 +779,20 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 	return collectionID, partitionID, segmentID, nil
 }
 
+// deserializeSegmentFile appends all the fields of the segment file to the insert data
+func deserializeSegmentFile(data []byte, insertData *InsertData) (UniqueID, UniqueID, UniqueID, error) {
+	reader, err := NewSegmentFileReader(bytes.NewReader(data))
+	if err != nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+	}
+	defer reader.Close()
+	if err = reader.ReadAllInto(insertData); err != nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+	}
+	collectionID, partitionID, segmentID := reader.IDs()
+	return collectionID, partitionID, segmentID, nil
+}
+
 // Deserialize transfer blob back to insert data.
 // From schema, it get all fields.
 // For each field, it will create a binlog reader, and read all event to the buffer.
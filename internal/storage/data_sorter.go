@@ -25,6 +25,11 @@ import (
 type DataSorter struct {
 	InsertCodec *InsertCodec
 	InsertData  *InsertData
+	// SortByPK sorts by primary key and then row id if set, otherwise by row id
+	SortByPK bool
+
+	pkField  FieldData
+	pkLoaded bool
 }
 
 // getRowIDFieldData returns auto generated row id Field
@@ -35,6 +40,20 @@ func (ds *DataSorter) getRowIDFieldData() FieldData {
 	return nil
 }
 
+// getPKFieldData returns the primary key field
+func (ds *DataSorter) getPKFieldData() FieldData {
+	if !ds.pkLoaded && ds.InsertCodec != nil {
+		for _, field := range ds.InsertCodec.Schema.GetSchema().GetFields() {
+			if field.GetIsPrimaryKey() {
+				ds.pkField = ds.InsertData.Data[field.GetFieldID()]
+				break
+			}
+		}
+		ds.pkLoaded = true
+	}
+	return ds.pkField
+}
+
 // Len returns length of the insert data
 func (ds *DataSorter) Len() int {
 	idField := ds.getRowIDFieldData()
@@ -116,6 +135,18 @@ func (ds *DataSorter) Less(i, j int) bool {
 	if i < 0 || i >= l || j < 0 || j > l {
 		return true // to skip swap
 	}
+	if ds.SortByPK {
+		switch pks := ds.getPKFieldData().(type) {
+		case *Int64FieldData:
+			if pks.Data[i] != pks.Data[j] {
+				return pks.Data[i] < pks.Data[j]
+			}
+		case *StringFieldData:
+			if pks.Data[i] != pks.Data[j] {
+				return pks.Data[i] < pks.Data[j]
+			}
+		}
+	}
 	ids := data.Data
 	return ids[i] < ids[j]
 }
@@ -328,3 +328,56 @@ func TestDataSorter_Less(t *testing.T) {
 	res = dataSorter.Less(-1, -2)
 	assert.True(t, res)
 }
+
+func TestDataSorter_SortByPK(t *testing.T) {
+	newSorter := func(pkType schemapb.DataType, pks FieldData) *DataSorter {
+		schema := &etcdpb.CollectionMeta{
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 0, Name: "row_id", DataType: schemapb.DataType_Int64},
+					{FieldID: 1, Name: "Ts", DataType: schemapb.DataType_Int64},
+					{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: pkType},
+					{FieldID: 101, Name: "field_int32", DataType: schemapb.DataType_Int32},
+				},
+			},
+		}
+		return &DataSorter{
+			InsertCodec: NewInsertCodec(schema),
+			InsertData: &InsertData{
+				Data: map[int64]FieldData{
+					0:   &Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3, 4}},
+					1:   &Int64FieldData{NumRows: []int64{4}, Data: []int64{10, 20, 30, 40}},
+					100: pks,
+					101: &Int32FieldData{NumRows: []int64{4}, Data: []int32{1, 2, 3, 4}},
+				},
+			},
+			SortByPK: true,
+		}
+	}
+
+	t.Run("int64 pk", func(t *testing.T) {
+		sorter := newSorter(schemapb.DataType_Int64, &Int64FieldData{NumRows: []int64{4}, Data: []int64{7, 3, 7, 1}})
+		sort.Sort(sorter)
+		data := sorter.InsertData.Data
+		assert.Equal(t, []int64{1, 3, 7, 7}, data[100].(*Int64FieldData).Data)
+		// same pk is ordered by row id
+		assert.Equal(t, []int64{4, 2, 1, 3}, data[0].(*Int64FieldData).Data)
+		assert.Equal(t, []int64{40, 20, 10, 30}, data[1].(*Int64FieldData).Data)
+		assert.Equal(t, []int32{4, 2, 1, 3}, data[101].(*Int32FieldData).Data)
+	})
+
+	t.Run("varchar pk", func(t *testing.T) {
+		sorter := newSorter(schemapb.DataType_VarChar, &StringFieldData{NumRows: []int64{4}, Data: []string{"c", "a", "d", "b"}})
+		sort.Sort(sorter)
+		data := sorter.InsertData.Data
+		assert.Equal(t, []string{"a", "b", "c", "d"}, data[100].(*StringFieldData).Data)
+		assert.Equal(t, []int64{2, 4, 1, 3}, data[0].(*Int64FieldData).Data)
+	})
+
+	t.Run("by row id", func(t *testing.T) {
+		sorter := newSorter(schemapb.DataType_Int64, &Int64FieldData{NumRows: []int64{4}, Data: []int64{7, 3, 7, 1}})
+		sorter.SortByPK = false
+		sort.Sort(sorter)
+		assert.Equal(t, []int64{7, 3, 7, 1}, sorter.InsertData.Data[100].(*Int64FieldData).Data)
+	})
+}
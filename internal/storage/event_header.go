@@ -45,10 +45,29 @@ type descriptorEventHeader = baseEventHeader
 
 type eventHeader struct {
 	baseEventHeader
+	// PayloadChecksum is the CRC32C of the event payload
+	PayloadChecksum uint32
 }
 
-func readEventHeader(buffer io.Reader) (*eventHeader, error) {
+// GetMemoryUsageInBytes returns the size of the event header with the payload checksum
+func (header *eventHeader) GetMemoryUsageInBytes() int32 {
+	return int32(binary.Size(header))
+}
+
+func (header *eventHeader) Write(buffer io.Writer) error {
+	return binary.Write(buffer, common.Endian, header)
+}
+
+// readEventHeader reads an event header, the headers of the binlogs written before the payload checksum
+// is introduced don't have it, which is told by the descriptor event extras.
+func readEventHeader(buffer io.Reader, withChecksum bool) (*eventHeader, error) {
 	header := &eventHeader{}
+	if !withChecksum {
+		if err := binary.Read(buffer, common.Endian, &header.baseEventHeader); err != nil {
+			return nil, err
+		}
+		return header, nil
+	}
 	if err := binary.Read(buffer, common.Endian, header); err != nil {
 		return nil, err
 	}
@@ -32,11 +32,11 @@ type EventReader struct {
 	isClosed bool
 }
 
-func (reader *EventReader) readHeader() error {
+func (reader *EventReader) readHeader(withChecksum bool) error {
 	if reader.isClosed {
 		return fmt.Errorf("event reader is closed")
 	}
-	header, err := readEventHeader(reader.buffer)
+	header, err := readEventHeader(reader.buffer, withChecksum)
 	if err != nil {
 		return err
 	}
@@ -85,28 +85,50 @@ func (reader *EventReader) Close() {
 	}
 }
 
-func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventReader, error) {
+// newEventReader reads an event from buffer, the payload is verified by the checksum in the event header if withChecksum.
+func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, compression Compression, withChecksum bool) (*EventReader, error) {
 	reader := &EventReader{
 		eventHeader: eventHeader{
-			baseEventHeader{},
+			baseEventHeader: baseEventHeader{},
 		},
 		buffer:   buffer,
 		isClosed: false,
 	}
 
-	if err := reader.readHeader(); err != nil {
+	if err := reader.readHeader(withChecksum); err != nil {
 		return nil, err
 	}
 	if err := reader.readData(); err != nil {
 		return nil, err
 	}
 
-	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.GetEventDataFixPartSize())
+	headerSize := reader.eventHeader.GetMemoryUsageInBytes()
+	if !withChecksum {
+		headerSize = reader.baseEventHeader.GetMemoryUsageInBytes()
+	}
+	next := int(reader.EventLength - headerSize - reader.GetEventDataFixPartSize())
+	if next < 0 || next > buffer.Len() {
+		return nil, fmt.Errorf("%w: payload length %d, remaining length %d", ErrBinlogCorrupted, next, buffer.Len())
+	}
 	payloadBuffer := buffer.Next(next)
-	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
+	if withChecksum {
+		if checksum := Checksum(payloadBuffer); checksum != reader.PayloadChecksum {
+			return nil, fmt.Errorf("%w: payload checksum mismatch, expected %d, actual %d", ErrBinlogCorrupted, reader.PayloadChecksum, checksum)
+		}
+	}
+	payloadReader, err := newPayloadReader(datatype, payloadBuffer, compression)
 	if err != nil {
 		return nil, err
 	}
 	reader.PayloadReaderInterface = payloadReader
 	return reader, nil
 }
+
+// newPayloadReader creates the payload reader for the compression of the payload,
+// lz4 payloads are read by the cgo reader since the parquet reader of go doesn't support lz4.
+func newPayloadReader(datatype schemapb.DataType, buf []byte, compression Compression) (PayloadReaderInterface, error) {
+	if compression.Type == CompressionLz4 {
+		return NewPayloadReaderCgo(datatype, buf)
+	}
+	return NewPayloadReader(datatype, buf)
+}
@@ -166,7 +166,7 @@ func TestInsertEvent(t *testing.T) {
 		assert.Equal(t, values, ev)
 		pR.Close()
 
-		r, err := newEventReader(dt, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -357,7 +357,7 @@ func TestInsertEvent(t *testing.T) {
 		assert.Equal(t, s[2], "abcdefg")
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 
 		s, err = pR.GetStringFromPayload()
@@ -412,7 +412,7 @@ func TestDeleteEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 
 		s, err = pR.GetStringFromPayload()
@@ -466,7 +466,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -514,7 +514,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 
 		s, err = pR.GetStringFromPayload()
@@ -568,7 +568,7 @@ func TestDropCollectionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -616,7 +616,7 @@ func TestDropCollectionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 
 		s, err = r.GetStringFromPayload()
@@ -670,7 +670,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -718,7 +718,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 
 		s, err = pR.GetStringFromPayload()
@@ -772,7 +772,7 @@ func TestDropPartitionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -820,7 +820,7 @@ func TestDropPartitionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 		assert.Nil(t, err)
 
 		s, err = pR.GetStringFromPayload()
@@ -935,7 +935,7 @@ func TestDescriptorEventTsError(t *testing.T) {
 
 func TestReadFixPartError(t *testing.T) {
 	buf := new(bytes.Buffer)
-	_, err := readEventHeader(buf)
+	_, err := readEventHeader(buf, true)
 	assert.NotNil(t, err)
 
 	_, err = readInsertEventDataFixPart(buf)
@@ -971,7 +971,7 @@ func TestReadFixPartError(t *testing.T) {
 
 func TestEventReaderError(t *testing.T) {
 	buf := new(bytes.Buffer)
-	r, err := newEventReader(schemapb.DataType_Int64, buf)
+	r, err := newEventReader(schemapb.DataType_Int64, buf, DefaultCompression, true)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -979,7 +979,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, DefaultCompression, true)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -988,7 +988,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, DefaultCompression, true)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1005,7 +1005,7 @@ func TestEventReaderError(t *testing.T) {
 	err = binary.Write(buf, common.Endian, insertData)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, DefaultCompression, true)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1026,12 +1026,12 @@ func TestEventClose(t *testing.T) {
 	w.Close()
 
 	wBuf := buf.Bytes()
-	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), DefaultCompression, true)
 	assert.Nil(t, err)
 
 	r.Close()
 
-	err = r.readHeader()
+	err = r.readHeader(true)
 	assert.NotNil(t, err)
 	err = r.readData()
 	assert.NotNil(t, err)
@@ -101,6 +101,8 @@ type EventWriter interface {
 	Write(buffer *bytes.Buffer) error
 	GetMemoryUsageInBytes() (int32, error)
 	SetOffset(offset int32)
+	// setChecksum sets whether the event header carries the payload checksum, should call before Finish
+	setChecksum(enable bool)
 }
 
 type baseEventWriter struct {
@@ -108,6 +110,7 @@ type baseEventWriter struct {
 	PayloadWriterInterface
 	isClosed         bool
 	isFinish         bool
+	withChecksum     bool
 	offset           int32
 	getEventDataSize func() int32
 	writeEventData   func(buffer io.Writer) error
@@ -118,12 +121,28 @@ func (writer *baseEventWriter) GetMemoryUsageInBytes() (int32, error) {
 	if err != nil {
 		return -1, err
 	}
-	size := writer.getEventDataSize() + writer.eventHeader.GetMemoryUsageInBytes() + int32(len(data))
+	size := writer.getEventDataSize() + writer.headerSize() + int32(len(data))
 	return size, nil
 }
 
+// headerSize returns the size of the event header, which doesn't have the payload checksum if disabled
+func (writer *baseEventWriter) headerSize() int32 {
+	if !writer.withChecksum {
+		return writer.baseEventHeader.GetMemoryUsageInBytes()
+	}
+	return writer.eventHeader.GetMemoryUsageInBytes()
+}
+
+func (writer *baseEventWriter) setChecksum(enable bool) {
+	writer.withChecksum = enable
+}
+
 func (writer *baseEventWriter) Write(buffer *bytes.Buffer) error {
-	if err := writer.eventHeader.Write(buffer); err != nil {
+	if !writer.withChecksum {
+		if err := writer.baseEventHeader.Write(buffer); err != nil {
+			return err
+		}
+	} else if err := writer.eventHeader.Write(buffer); err != nil {
 		return err
 	}
 	if err := writer.writeEventData(buffer); err != nil {
@@ -145,6 +164,13 @@ func (writer *baseEventWriter) Finish() error {
 		if err := writer.FinishPayloadWriter(); err != nil {
 			return err
 		}
+		if writer.withChecksum {
+			payload, err := writer.GetPayloadBufferFromWriter()
+			if err != nil {
+				return err
+			}
+			writer.PayloadChecksum = Checksum(payload)
+		}
 		eventLength, err := writer.GetMemoryUsageInBytes()
 		if err != nil {
 			return err
@@ -234,6 +260,7 @@ func newInsertEventWriter(dataType schemapb.DataType, dim ...int) (*insertEventW
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		insertEventData: *data,
 	}
@@ -256,6 +283,7 @@ func newDeleteEventWriter(dataType schemapb.DataType) (*deleteEventWriter, error
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		deleteEventData: *data,
 	}
@@ -282,6 +310,7 @@ func newCreateCollectionEventWriter(dataType schemapb.DataType) (*createCollecti
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		createCollectionEventData: *data,
 	}
@@ -308,6 +337,7 @@ func newDropCollectionEventWriter(dataType schemapb.DataType) (*dropCollectionEv
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		dropCollectionEventData: *data,
 	}
@@ -334,6 +364,7 @@ func newCreatePartitionEventWriter(dataType schemapb.DataType) (*createPartition
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		createPartitionEventData: *data,
 	}
@@ -360,6 +391,7 @@ func newDropPartitionEventWriter(dataType schemapb.DataType) (*dropPartitionEven
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		dropPartitionEventData: *data,
 	}
@@ -382,6 +414,7 @@ func newIndexFileEventWriter() (*indexFileEventWriter, error) {
 			PayloadWriterInterface: payloadWriter,
 			isClosed:               false,
 			isFinish:               false,
+			withChecksum:           true,
 		},
 		indexFileEventData: *data,
 	}
@@ -172,6 +172,17 @@ func (mcm *MinioChunkManager) Write(ctx context.Context, filePath string, conten
 	return nil
 }
 
+// MultipartWrite uploads the object read from @reader to @filePath by multipart upload in parts of @partSize.
+func (mcm *MinioChunkManager) MultipartWrite(ctx context.Context, filePath string, reader io.Reader, size int64, partSize uint64) error {
+	_, err := mcm.Client.PutObject(ctx, mcm.bucketName, filePath, reader, size, minio.PutObjectOptions{PartSize: partSize})
+	if err != nil {
+		log.Warn("failed to put object by multipart", zap.String("path", filePath), zap.Int64("size", size), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 // MultiWrite saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (mcm *MinioChunkManager) MultiWrite(ctx context.Context, kvs map[string][]byte) error {
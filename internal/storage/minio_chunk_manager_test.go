@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"path"
 	"strconv"
@@ -229,6 +230,30 @@ func TestMinIOCM(t *testing.T) {
 		assert.Equal(t, []byte("123"), val)
 	})
 
+	t.Run("test MultipartWrite", func(t *testing.T) {
+		testMultipartRoot := path.Join(testMinIOKVRoot, "test_multipart")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newMinIOChunkManager(ctx, testBucket, testMultipartRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testMultipartRoot)
+
+		// 2 parts of the min part size 5 MB and a tail
+		content := make([]byte, 11*1024*1024)
+		for i := range content {
+			content[i] = byte(i)
+		}
+		key := path.Join(testMultipartRoot, "key_1")
+		err = testCM.MultipartWrite(ctx, key, bytes.NewReader(content), int64(len(content)), 5*1024*1024)
+		assert.NoError(t, err)
+
+		val, err := testCM.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, content, val)
+	})
+
 	t.Run("test Remove", func(t *testing.T) {
 		testRemoveRoot := path.Join(testMinIOKVRoot, "test_remove")
 		ctx, cancel := context.WithCancel(context.Background())
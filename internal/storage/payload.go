@@ -47,6 +47,7 @@ type PayloadWriterInterface interface {
 	AddOneStringToPayload(msgs string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	SetCompression(compression Compression) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -325,6 +326,25 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 	return HandleCStatus(&status, "AddFloatVectorToPayload failed")
 }
 
+// SetCompression sets the codec to compress the payload, it should be called before FinishPayloadWriter
+func (w *PayloadWriter) SetCompression(compression Compression) error {
+	var codec C.PayloadCompression
+	switch compression.Type {
+	case CompressionNone:
+		codec = C.PayloadUncompressed
+	case CompressionSnappy:
+		codec = C.PayloadSnappy
+	case CompressionZstd:
+		codec = C.PayloadZstd
+	case CompressionLz4:
+		codec = C.PayloadLz4
+	default:
+		return fmt.Errorf("unknown compression %s", compression.Type)
+	}
+	status := C.SetPayloadCompression(w.payloadWriterPtr, codec, C.int(compression.Level))
+	return HandleCStatus(&status, "SetPayloadCompression failed")
+}
+
 func (w *PayloadWriter) FinishPayloadWriter() error {
 	status := C.FinishPayloadWriter(w.payloadWriterPtr)
 	return HandleCStatus(&status, "FinishPayloadWriter failed")
@@ -0,0 +1,560 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/metadata"
+	"github.com/apache/arrow/go/v8/parquet/schema"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// StorageV1 stores the insert data of each field in separate binlog files
+	StorageV1 int64 = 1
+	// StorageV2 stores the insert data of all the fields in a single columnar segment file
+	StorageV2 int64 = 2
+)
+
+// SegmentFileDir is the directory of the segment files, it takes the place of the field id in the binlog path:
+// ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/segment/${log_idx}
+// all the fields of the segment share the same segment file.
+const SegmentFileDir = "segment"
+
+// segmentFileRowGroupRows is the number of rows of a row group in the segment file
+const segmentFileRowGroupRows = 64 * 1024
+
+const (
+	segmentFileVersionKey   = "milvus.storage_version"
+	segmentFileCollectionID = "milvus.collection_id"
+	segmentFilePartitionID  = "milvus.partition_id"
+	segmentFileSegmentID    = "milvus.segment_id"
+	// the data type of each field is kept since int8/int16/int32 and the vector types share the physical types
+	segmentFileFieldTypePrefix = "milvus.field_type."
+)
+
+var segmentFileMagic = []byte("PAR1")
+
+// IsSegmentFile returns whether the content is a segment file instead of a v1 binlog
+func IsSegmentFile(data []byte) bool {
+	return bytes.HasPrefix(data, segmentFileMagic)
+}
+
+// IsSegmentFilePath returns whether the insert log path is a segment file
+func IsSegmentFilePath(filePath string) bool {
+	return path.Base(path.Dir(filePath)) == SegmentFileDir
+}
+
+// SerializeSegmentFile writes the insert data of all the fields into a single segment file.
+// The data is sorted in the same way as Serialize, each field is a column, split into row groups.
+// The key of the returned blob is SegmentFileDir.
+func (insertCodec *InsertCodec) SerializeSegmentFile(partitionID UniqueID, segmentID UniqueID, data *InsertData) (*Blob, error) {
+	timeFieldData, ok := data.Data[common.TimeStampField]
+	if !ok {
+		return nil, fmt.Errorf("data doesn't contains timestamp field")
+	}
+	rowNum := timeFieldData.RowNum()
+	if rowNum <= 0 {
+		return nil, fmt.Errorf("there's no data in InsertData")
+	}
+
+	dataSorter := &DataSorter{
+		InsertCodec: insertCodec,
+		InsertData:  data,
+		SortByPK:    insertCodec.SortByPK,
+	}
+	sort.Sort(dataSorter)
+
+	fields := insertCodec.Schema.GetSchema().GetFields()
+	nodes := make(schema.FieldList, 0, len(fields))
+	kv := metadata.NewKeyValueMetadata()
+	kv.Append(segmentFileVersionKey, strconv.FormatInt(StorageV2, 10))
+	kv.Append(segmentFileCollectionID, strconv.FormatInt(insertCodec.Schema.GetID(), 10))
+	kv.Append(segmentFilePartitionID, strconv.FormatInt(partitionID, 10))
+	kv.Append(segmentFileSegmentID, strconv.FormatInt(segmentID, 10))
+	for _, field := range fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok || fieldData.RowNum() != rowNum {
+			return nil, fmt.Errorf("field %d doesn't have %d rows", field.GetFieldID(), rowNum)
+		}
+		node, err := segmentFileNode(field, fieldData)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		kv.Append(segmentFileFieldTypePrefix+strconv.FormatInt(field.GetFieldID(), 10), strconv.FormatInt(int64(field.GetDataType()), 10))
+	}
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, nodes, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	opts := []file.WriteOption{file.WithWriteMetadata(kv)}
+	if !insertCodec.Compression.IsEmpty() {
+		codec, level := segmentFileCompression(insertCodec.Compression)
+		opts = append(opts, file.WithWriterProps(parquet.NewWriterProperties(
+			parquet.WithCompression(codec), parquet.WithCompressionLevel(level))))
+	}
+	writer := file.NewParquetWriter(buf, root, opts...)
+	for start := 0; start < rowNum; start += segmentFileRowGroupRows {
+		end := start + segmentFileRowGroupRows
+		if end > rowNum {
+			end = rowNum
+		}
+		rgWriter := writer.AppendRowGroup()
+		for _, field := range fields {
+			columnWriter, err := rgWriter.NextColumn()
+			if err != nil {
+				writer.Close()
+				return nil, err
+			}
+			if err = writeSegmentFileColumn(columnWriter, data.Data[field.GetFieldID()], start, end); err != nil {
+				columnWriter.Close()
+				writer.Close()
+				return nil, err
+			}
+			if err = columnWriter.Close(); err != nil {
+				writer.Close()
+				return nil, err
+			}
+		}
+		if err = rgWriter.Close(); err != nil {
+			writer.Close()
+			return nil, err
+		}
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return &Blob{
+		Key:   SegmentFileDir,
+		Value: buf.Bytes(),
+	}, nil
+}
+
+func segmentFileNode(field *schemapb.FieldSchema, fieldData FieldData) (schema.Node, error) {
+	name := strconv.FormatInt(field.GetFieldID(), 10)
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return schema.NewBooleanNode(name, parquet.Repetitions.Required, -1), nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return schema.NewInt32Node(name, parquet.Repetitions.Required, -1), nil
+	case schemapb.DataType_Int64:
+		return schema.NewInt64Node(name, parquet.Repetitions.Required, -1), nil
+	case schemapb.DataType_Float:
+		return schema.NewFloat32Node(name, parquet.Repetitions.Required, -1), nil
+	case schemapb.DataType_Double:
+		return schema.NewFloat64Node(name, parquet.Repetitions.Required, -1), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return schema.NewByteArrayNode(name, parquet.Repetitions.Required, -1), nil
+	case schemapb.DataType_BinaryVector:
+		return schema.NewFixedLenByteArrayNode(name, parquet.Repetitions.Required, int32(fieldData.(*BinaryVectorFieldData).Dim/8), -1), nil
+	case schemapb.DataType_FloatVector:
+		return schema.NewFixedLenByteArrayNode(name, parquet.Repetitions.Required, int32(fieldData.(*FloatVectorFieldData).Dim*4), -1), nil
+	default:
+		return nil, fmt.Errorf("undefined data type %d", field.GetDataType())
+	}
+}
+
+// writeSegmentFileColumn writes the rows [start, end) of the field data to the column chunk
+func writeSegmentFileColumn(columnWriter file.ColumnChunkWriter, fieldData FieldData, start, end int) error {
+	var err error
+	switch data := fieldData.(type) {
+	case *BoolFieldData:
+		_, err = columnWriter.(*file.BooleanColumnChunkWriter).WriteBatch(data.Data[start:end], nil, nil)
+	case *Int8FieldData:
+		values := make([]int32, 0, end-start)
+		for _, v := range data.Data[start:end] {
+			values = append(values, int32(v))
+		}
+		_, err = columnWriter.(*file.Int32ColumnChunkWriter).WriteBatch(values, nil, nil)
+	case *Int16FieldData:
+		values := make([]int32, 0, end-start)
+		for _, v := range data.Data[start:end] {
+			values = append(values, int32(v))
+		}
+		_, err = columnWriter.(*file.Int32ColumnChunkWriter).WriteBatch(values, nil, nil)
+	case *Int32FieldData:
+		_, err = columnWriter.(*file.Int32ColumnChunkWriter).WriteBatch(data.Data[start:end], nil, nil)
+	case *Int64FieldData:
+		_, err = columnWriter.(*file.Int64ColumnChunkWriter).WriteBatch(data.Data[start:end], nil, nil)
+	case *FloatFieldData:
+		_, err = columnWriter.(*file.Float32ColumnChunkWriter).WriteBatch(data.Data[start:end], nil, nil)
+	case *DoubleFieldData:
+		_, err = columnWriter.(*file.Float64ColumnChunkWriter).WriteBatch(data.Data[start:end], nil, nil)
+	case *StringFieldData:
+		values := make([]parquet.ByteArray, 0, end-start)
+		for _, v := range data.Data[start:end] {
+			values = append(values, parquet.ByteArray(v))
+		}
+		_, err = columnWriter.(*file.ByteArrayColumnChunkWriter).WriteBatch(values, nil, nil)
+	case *BinaryVectorFieldData:
+		length := data.Dim / 8
+		values := make([]parquet.FixedLenByteArray, 0, end-start)
+		for i := start; i < end; i++ {
+			values = append(values, data.Data[i*length:(i+1)*length])
+		}
+		_, err = columnWriter.(*file.FixedLenByteArrayColumnChunkWriter).WriteBatch(values, nil, nil)
+	case *FloatVectorFieldData:
+		values := make([]parquet.FixedLenByteArray, 0, end-start)
+		for i := start; i < end; i++ {
+			values = append(values, arrow.Float32Traits.CastToBytes(data.Data[i*data.Dim:(i+1)*data.Dim]))
+		}
+		_, err = columnWriter.(*file.FixedLenByteArrayColumnChunkWriter).WriteBatch(values, nil, nil)
+	default:
+		return fmt.Errorf("unsupported field data %T", fieldData)
+	}
+	return err
+}
+
+// SegmentFileReader reads the insert data of a segment file column by column,
+// only the footer and the column chunks of the read fields are fetched.
+type SegmentFileReader struct {
+	reader       *file.Reader
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+	fieldTypes   map[FieldID]schemapb.DataType
+}
+
+// NewSegmentFileReader creates a SegmentFileReader with the footer of the segment file
+func NewSegmentFileReader(r parquet.ReaderAtSeeker) (*SegmentFileReader, error) {
+	parquetReader, err := file.NewParquetReader(r)
+	if err != nil {
+		return nil, err
+	}
+	reader := &SegmentFileReader{
+		reader:     parquetReader,
+		fieldTypes: make(map[FieldID]schemapb.DataType),
+	}
+	kv := parquetReader.MetaData().KeyValueMetadata()
+	if version := kv.FindValue(segmentFileVersionKey); version == nil || *version != strconv.FormatInt(StorageV2, 10) {
+		parquetReader.Close()
+		return nil, errors.New("not a segment file of storage v2")
+	}
+	for i, key := range kv.Keys() {
+		value, err := strconv.ParseInt(kv.Values()[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch {
+		case key == segmentFileCollectionID:
+			reader.collectionID = value
+		case key == segmentFilePartitionID:
+			reader.partitionID = value
+		case key == segmentFileSegmentID:
+			reader.segmentID = value
+		case strings.HasPrefix(key, segmentFileFieldTypePrefix):
+			fieldID, err := strconv.ParseInt(strings.TrimPrefix(key, segmentFileFieldTypePrefix), 10, 64)
+			if err == nil {
+				reader.fieldTypes[fieldID] = schemapb.DataType(value)
+			}
+		}
+	}
+	return reader, nil
+}
+
+// OpenSegmentFile opens the segment file in the chunk manager, the column chunks are read by ChunkManager.ReadAt
+func OpenSegmentFile(ctx context.Context, cm ChunkManager, filePath string) (*SegmentFileReader, error) {
+	size, err := cm.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewSegmentFileReader(&chunkReaderAt{ctx: ctx, cm: cm, filePath: filePath, size: size})
+}
+
+// IDs returns the collection, partition and segment id of the segment file
+func (r *SegmentFileReader) IDs() (UniqueID, UniqueID, UniqueID) {
+	return r.collectionID, r.partitionID, r.segmentID
+}
+
+// NumRows returns the number of rows of the segment file
+func (r *SegmentFileReader) NumRows() int64 {
+	return r.reader.NumRows()
+}
+
+// FieldIDs returns the ids of the fields in the segment file in column order
+func (r *SegmentFileReader) FieldIDs() []FieldID {
+	sc := r.reader.MetaData().Schema
+	fieldIDs := make([]FieldID, 0, sc.NumColumns())
+	for i := 0; i < sc.NumColumns(); i++ {
+		fieldID, err := strconv.ParseInt(sc.Column(i).Name(), 10, 64)
+		if err == nil {
+			fieldIDs = append(fieldIDs, fieldID)
+		}
+	}
+	return fieldIDs
+}
+
+// ReadFieldInto reads the column of the field and appends it to the insert data as a new binlog
+func (r *SegmentFileReader) ReadFieldInto(fieldID FieldID, insertData *InsertData) error {
+	dataType, ok := r.fieldTypes[fieldID]
+	if !ok {
+		return fmt.Errorf("field %d not found in segment file", fieldID)
+	}
+	columnIdx := r.reader.MetaData().Schema.ColumnIndexByName(strconv.FormatInt(fieldID, 10))
+	if columnIdx < 0 {
+		return fmt.Errorf("column of field %d not found in segment file", fieldID)
+	}
+	numRows := r.reader.NumRows()
+
+	switch dataType {
+	case schemapb.DataType_Bool:
+		values, err := readSegmentFileColumn[bool, *file.BooleanColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &BoolFieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*BoolFieldData)
+		fieldData.Data = append(fieldData.Data, values...)
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_Int8:
+		values, err := readSegmentFileColumn[int32, *file.Int32ColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &Int8FieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*Int8FieldData)
+		for _, v := range values {
+			fieldData.Data = append(fieldData.Data, int8(v))
+		}
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_Int16:
+		values, err := readSegmentFileColumn[int32, *file.Int32ColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &Int16FieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*Int16FieldData)
+		for _, v := range values {
+			fieldData.Data = append(fieldData.Data, int16(v))
+		}
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_Int32:
+		values, err := readSegmentFileColumn[int32, *file.Int32ColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &Int32FieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*Int32FieldData)
+		fieldData.Data = append(fieldData.Data, values...)
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_Int64:
+		values, err := readSegmentFileColumn[int64, *file.Int64ColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &Int64FieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*Int64FieldData)
+		fieldData.Data = append(fieldData.Data, values...)
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_Float:
+		values, err := readSegmentFileColumn[float32, *file.Float32ColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &FloatFieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*FloatFieldData)
+		fieldData.Data = append(fieldData.Data, values...)
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_Double:
+		values, err := readSegmentFileColumn[float64, *file.Float64ColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &DoubleFieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*DoubleFieldData)
+		fieldData.Data = append(fieldData.Data, values...)
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		values, err := readSegmentFileColumn[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &StringFieldData{}
+		}
+		fieldData := insertData.Data[fieldID].(*StringFieldData)
+		for _, v := range values {
+			fieldData.Data = append(fieldData.Data, v.String())
+		}
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_BinaryVector:
+		values, err := readSegmentFileColumn[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		dim := r.reader.MetaData().Schema.Column(columnIdx).TypeLength() * 8
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &BinaryVectorFieldData{Dim: dim}
+		}
+		fieldData := insertData.Data[fieldID].(*BinaryVectorFieldData)
+		for _, v := range values {
+			fieldData.Data = append(fieldData.Data, v...)
+		}
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	case schemapb.DataType_FloatVector:
+		values, err := readSegmentFileColumn[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](r.reader, columnIdx, numRows)
+		if err != nil {
+			return err
+		}
+		dim := r.reader.MetaData().Schema.Column(columnIdx).TypeLength() / 4
+		if insertData.Data[fieldID] == nil {
+			insertData.Data[fieldID] = &FloatVectorFieldData{Dim: dim}
+		}
+		fieldData := insertData.Data[fieldID].(*FloatVectorFieldData)
+		offset := len(fieldData.Data)
+		fieldData.Data = append(fieldData.Data, make([]float32, int64(dim)*numRows)...)
+		for i, v := range values {
+			copy(arrow.Float32Traits.CastToBytes(fieldData.Data[offset+i*dim:offset+(i+1)*dim]), v)
+		}
+		fieldData.NumRows = append(fieldData.NumRows, numRows)
+	default:
+		return fmt.Errorf("undefined data type %d", dataType)
+	}
+
+	if fieldID == common.TimeStampField {
+		insertData.Infos = append(insertData.Infos, BlobInfo{Length: int(numRows)})
+	}
+	return nil
+}
+
+// ReadAllInto reads all the fields and appends them to the insert data
+func (r *SegmentFileReader) ReadAllInto(insertData *InsertData) error {
+	for _, fieldID := range r.FieldIDs() {
+		if err := r.ReadFieldInto(fieldID, insertData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the segment file reader
+func (r *SegmentFileReader) Close() {
+	r.reader.Close()
+}
+
+// readSegmentFileVector returns the bytes of the vector field in the segment file,
+// like the v1 vector binlogs read by VectorChunkManager, a collection has only one vector field.
+func readSegmentFileVector(content []byte) ([]byte, error) {
+	reader, err := NewSegmentFileReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	for _, fieldID := range reader.FieldIDs() {
+		if !typeutil.IsVectorType(reader.fieldTypes[fieldID]) {
+			continue
+		}
+		data := &InsertData{Data: make(map[FieldID]FieldData)}
+		if err = reader.ReadFieldInto(fieldID, data); err != nil {
+			return nil, err
+		}
+		return FieldDataToBytes(common.Endian, data.Data[fieldID])
+	}
+	return nil, errors.New("no vector field in segment file")
+}
+
+func readSegmentFileColumn[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](reader *file.Reader, columnIdx int, numRows int64) ([]T, error) {
+	values := make([]T, numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[T, E](reader, values, columnIdx, numRows)
+	if err != nil {
+		return nil, err
+	}
+	if valuesRead != numRows {
+		return nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", numRows, valuesRead)
+	}
+	return values, nil
+}
+
+// chunkReaderAt reads the file in the chunk manager by range, so that a column is fetched without the whole file
+type chunkReaderAt struct {
+	ctx      context.Context
+	cm       ChunkManager
+	filePath string
+	size     int64
+	offset   int64
+}
+
+func (r *chunkReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	length := int64(len(p))
+	if off+length > r.size {
+		length = r.size - off
+	}
+	data, err := r.cm.ReadAt(r.ctx, r.filePath, off, length)
+	if err != nil {
+		return 0, err
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *chunkReaderAt) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func genSegmentFileTestData() (*etcdpb.CollectionMeta, *InsertData) {
+	fieldTypes := []struct {
+		fieldID  FieldID
+		dataType schemapb.DataType
+	}{
+		{RowIDField, schemapb.DataType_Int64},
+		{TimestampField, schemapb.DataType_Int64},
+		{BoolField, schemapb.DataType_Bool},
+		{Int8Field, schemapb.DataType_Int8},
+		{Int16Field, schemapb.DataType_Int16},
+		{Int32Field, schemapb.DataType_Int32},
+		{Int64Field, schemapb.DataType_Int64},
+		{FloatField, schemapb.DataType_Float},
+		{DoubleField, schemapb.DataType_Double},
+		{StringField, schemapb.DataType_VarChar},
+		{BinaryVectorField, schemapb.DataType_BinaryVector},
+		{FloatVectorField, schemapb.DataType_FloatVector},
+	}
+	fields := make([]*schemapb.FieldSchema, 0, len(fieldTypes))
+	for _, ft := range fieldTypes {
+		fields = append(fields, &schemapb.FieldSchema{FieldID: ft.fieldID, DataType: ft.dataType})
+	}
+	meta := &etcdpb.CollectionMeta{
+		ID:     CollectionID,
+		Schema: &schemapb.CollectionSchema{Fields: fields},
+	}
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			RowIDField:        &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			TimestampField:    &Int64FieldData{NumRows: []int64{3}, Data: []int64{10, 20, 30}},
+			BoolField:         &BoolFieldData{NumRows: []int64{3}, Data: []bool{true, false, true}},
+			Int8Field:         &Int8FieldData{NumRows: []int64{3}, Data: []int8{-1, 0, 1}},
+			Int16Field:        &Int16FieldData{NumRows: []int64{3}, Data: []int16{-2, 0, 2}},
+			Int32Field:        &Int32FieldData{NumRows: []int64{3}, Data: []int32{-3, 0, 3}},
+			Int64Field:        &Int64FieldData{NumRows: []int64{3}, Data: []int64{-4, 0, 4}},
+			FloatField:        &FloatFieldData{NumRows: []int64{3}, Data: []float32{-5, 0, 5}},
+			DoubleField:       &DoubleFieldData{NumRows: []int64{3}, Data: []float64{-6, 0, 6}},
+			StringField:       &StringFieldData{NumRows: []int64{3}, Data: []string{"a", "", "c"}},
+			BinaryVectorField: &BinaryVectorFieldData{NumRows: []int64{3}, Data: []byte{0, 1, 2, 3, 4, 5}, Dim: 16},
+			FloatVectorField:  &FloatVectorFieldData{NumRows: []int64{3}, Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2},
+		},
+	}
+	return meta, data
+}
+
+func TestSegmentFile(t *testing.T) {
+	meta, data := genSegmentFileTestData()
+	insertCodec := NewInsertCodec(meta)
+
+	blob, err := insertCodec.SerializeSegmentFile(PartitionID, SegmentID, data)
+	require.NoError(t, err)
+	assert.Equal(t, SegmentFileDir, blob.Key)
+	assert.True(t, IsSegmentFile(blob.Value))
+
+	t.Run("read all", func(t *testing.T) {
+		collectionID, partitionID, segmentID, result, err := insertCodec.DeserializeAll([]*Blob{blob})
+		require.NoError(t, err)
+		assert.EqualValues(t, CollectionID, collectionID)
+		assert.EqualValues(t, PartitionID, partitionID)
+		assert.EqualValues(t, SegmentID, segmentID)
+		assert.Equal(t, data.Data, result.Data)
+		assert.Equal(t, []BlobInfo{{Length: 3}}, result.Infos)
+	})
+
+	t.Run("read once for the same key", func(t *testing.T) {
+		result := &InsertData{Data: make(map[FieldID]FieldData)}
+		key := "insert_log/1/1/1/segment/1"
+		_, _, _, err := insertCodec.DeserializeInto([]*Blob{{Key: key, Value: blob.Value}, {Key: key, Value: blob.Value}}, 0, result)
+		require.NoError(t, err)
+		assert.Equal(t, data.Data, result.Data)
+	})
+
+	t.Run("read vector", func(t *testing.T) {
+		vectors, err := readSegmentFileVector(blob.Value)
+		require.NoError(t, err)
+		// the first vector field in the file
+		expected, err := FieldDataToBytes(common.Endian, data.Data[BinaryVectorField])
+		require.NoError(t, err)
+		assert.Equal(t, expected, vectors)
+	})
+
+	t.Run("read field by range", func(t *testing.T) {
+		ctx := context.Background()
+		cm := NewLocalChunkManager(RootPath(t.TempDir()))
+		filePath := path.Join(cm.RootPath(), "insert_log/1/1/1", SegmentFileDir, "1")
+		require.NoError(t, cm.Write(ctx, filePath, blob.Value))
+		assert.True(t, IsSegmentFilePath(filePath))
+
+		reader, err := OpenSegmentFile(ctx, cm, filePath)
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.EqualValues(t, 3, reader.NumRows())
+		assert.Len(t, reader.FieldIDs(), len(meta.Schema.Fields))
+
+		result := &InsertData{Data: make(map[FieldID]FieldData)}
+		require.NoError(t, reader.ReadFieldInto(FloatVectorField, result))
+		require.NoError(t, reader.ReadFieldInto(FloatVectorField, result))
+		assert.Equal(t, &FloatVectorFieldData{NumRows: []int64{3, 3}, Data: []float32{1, 2, 3, 4, 5, 6, 1, 2, 3, 4, 5, 6}, Dim: 2}, result.Data[FloatVectorField])
+
+		assert.Error(t, reader.ReadFieldInto(999, result))
+		_, err = OpenSegmentFile(ctx, cm, path.Join(cm.RootPath(), "not_exist"))
+		assert.Error(t, err)
+	})
+
+	t.Run("multiple row groups", func(t *testing.T) {
+		rowNum := 2*segmentFileRowGroupRows + 1
+		ids := make([]int64, rowNum)
+		for i := range ids {
+			ids[i] = int64(i)
+		}
+		meta := &etcdpb.CollectionMeta{
+			ID: CollectionID,
+			Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, DataType: schemapb.DataType_Int64},
+			}},
+		}
+		data := &InsertData{Data: map[FieldID]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{int64(rowNum)}, Data: ids},
+			TimestampField: &Int64FieldData{NumRows: []int64{int64(rowNum)}, Data: ids},
+		}}
+		blob, err := NewInsertCodec(meta).SerializeSegmentFile(PartitionID, SegmentID, data)
+		require.NoError(t, err)
+
+		reader, err := NewSegmentFileReader(bytes.NewReader(blob.Value))
+		require.NoError(t, err)
+		defer reader.Close()
+		result := &InsertData{Data: make(map[FieldID]FieldData)}
+		require.NoError(t, reader.ReadFieldInto(RowIDField, result))
+		assert.Equal(t, ids, result.Data[RowIDField].(*Int64FieldData).Data)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := insertCodec.SerializeSegmentFile(PartitionID, SegmentID, &InsertData{Data: map[FieldID]FieldData{}})
+		assert.Error(t, err)
+
+		_, data := genSegmentFileTestData()
+		delete(data.Data, StringField)
+		_, err = insertCodec.SerializeSegmentFile(PartitionID, SegmentID, data)
+		assert.Error(t, err)
+
+		_, err = NewSegmentFileReader(bytes.NewReader([]byte("PAR1")))
+		assert.Error(t, err)
+		assert.False(t, IsSegmentFile([]byte{0xbc, 0xfa, 0xff, 0x00}))
+		assert.False(t, IsSegmentFilePath("insert_log/1/1/1/100/1"))
+	})
+}
@@ -18,6 +18,9 @@ package storage
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/api/schemapb"
@@ -135,6 +138,282 @@ func (stats *PrimaryKeyStats) updatePk(pk PrimaryKey) {
 	}
 }
 
+// FieldStats contains the min/max statistics of a scalar field, the values are stored as
+// int64 for integer fields, float64 for float fields, bool for bool fields and string for string fields.
+type FieldStats struct {
+	FieldID int64             `json:"fieldID"`
+	Type    schemapb.DataType `json:"type"`
+	Max     interface{}       `json:"max"`
+	Min     interface{}       `json:"min"`
+	// RowNum is the number of rows the stats collected from, the stats of a segment are only valid
+	// if they cover all the rows of the segment.
+	RowNum int64 `json:"rowNum"`
+	// all the fields are not nullable for now, NullCount is kept for the nullable fields in the future
+	NullCount int64 `json:"nullCount"`
+
+	// NaN and Inf can't be ordered or marshaled, the stats are dropped if any of them is met
+	nonFinite bool
+}
+
+// SupportFieldStats returns whether the min/max statistics can be collected for the data type
+func SupportFieldStats(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewFieldStats returns an empty FieldStats for the field
+func NewFieldStats(fieldID int64, dataType schemapb.DataType) *FieldStats {
+	return &FieldStats{
+		FieldID: fieldID,
+		Type:    dataType,
+	}
+}
+
+// UnmarshalJSON unmarshal bytes to FieldStats
+func (stats *FieldStats) UnmarshalJSON(data []byte) error {
+	var messageMap map[string]*json.RawMessage
+	err := json.Unmarshal(data, &messageMap)
+	if err != nil {
+		return err
+	}
+
+	if value, ok := messageMap["fieldID"]; ok && value != nil {
+		if err = json.Unmarshal(*value, &stats.FieldID); err != nil {
+			return err
+		}
+	}
+	if value, ok := messageMap["type"]; ok && value != nil {
+		if err = json.Unmarshal(*value, &stats.Type); err != nil {
+			return err
+		}
+	}
+	if value, ok := messageMap["rowNum"]; ok && value != nil {
+		if err = json.Unmarshal(*value, &stats.RowNum); err != nil {
+			return err
+		}
+	}
+	if value, ok := messageMap["nullCount"]; ok && value != nil {
+		if err = json.Unmarshal(*value, &stats.NullCount); err != nil {
+			return err
+		}
+	}
+	if stats.Max, err = stats.unmarshalValue(messageMap["max"]); err != nil {
+		return err
+	}
+	if stats.Min, err = stats.unmarshalValue(messageMap["min"]); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (stats *FieldStats) unmarshalValue(message *json.RawMessage) (interface{}, error) {
+	if message == nil || string(*message) == "null" {
+		return nil, nil
+	}
+	var err error
+	switch stats.Type {
+	case schemapb.DataType_Bool:
+		var v bool
+		err = json.Unmarshal(*message, &v)
+		return v, err
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		var v int64
+		err = json.Unmarshal(*message, &v)
+		return v, err
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var v float64
+		err = json.Unmarshal(*message, &v)
+		return v, err
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		var v string
+		err = json.Unmarshal(*message, &v)
+		return v, err
+	default:
+		return nil, fmt.Errorf("unsupported field stats type %s", stats.Type.String())
+	}
+}
+
+// update updates min and max value, value must be of the normalized type of stats.Type
+func (stats *FieldStats) update(value interface{}) {
+	if v, ok := value.(float64); ok && (math.IsNaN(v) || math.IsInf(v, 0)) {
+		stats.nonFinite = true
+		return
+	}
+	if stats.Min == nil || compareStatsValue(value, stats.Min) < 0 {
+		stats.Min = value
+	}
+	if stats.Max == nil || compareStatsValue(value, stats.Max) > 0 {
+		stats.Max = value
+	}
+}
+
+// UpdateByFieldData updates min and max value with all the values of @data
+func (stats *FieldStats) UpdateByFieldData(data FieldData) {
+	stats.RowNum += int64(data.RowNum())
+	switch d := data.(type) {
+	case *BoolFieldData:
+		for _, v := range d.Data {
+			stats.update(v)
+		}
+	case *Int8FieldData:
+		for _, v := range d.Data {
+			stats.update(int64(v))
+		}
+	case *Int16FieldData:
+		for _, v := range d.Data {
+			stats.update(int64(v))
+		}
+	case *Int32FieldData:
+		for _, v := range d.Data {
+			stats.update(int64(v))
+		}
+	case *Int64FieldData:
+		for _, v := range d.Data {
+			stats.update(v)
+		}
+	case *FloatFieldData:
+		for _, v := range d.Data {
+			stats.update(float64(v))
+		}
+	case *DoubleFieldData:
+		for _, v := range d.Data {
+			stats.update(v)
+		}
+	case *StringFieldData:
+		for _, v := range d.Data {
+			stats.update(v)
+		}
+	}
+}
+
+// Merge merges the stats of another part of the same field
+func (stats *FieldStats) Merge(other *FieldStats) {
+	if other.Min != nil {
+		stats.update(other.Min)
+	}
+	if other.Max != nil {
+		stats.update(other.Max)
+	}
+	stats.RowNum += other.RowNum
+	stats.NullCount += other.NullCount
+	stats.nonFinite = stats.nonFinite || other.nonFinite
+}
+
+// IsValid returns whether the stats can be used, the stats with NaN or Inf values are invalid
+func (stats *FieldStats) IsValid() bool {
+	return !stats.nonFinite
+}
+
+// compareStatsValue compares the normalized values of the same type
+func compareStatsValue(a, b interface{}) int {
+	switch a := a.(type) {
+	case bool:
+		bv := b.(bool)
+		switch {
+		case a == bv:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case int64:
+		bv := b.(int64)
+		switch {
+		case a < bv:
+			return -1
+		case a > bv:
+			return 1
+		default:
+			return 0
+		}
+	case float64:
+		bv := b.(float64)
+		switch {
+		case a < bv:
+			return -1
+		case a > bv:
+			return 1
+		default:
+			return 0
+		}
+	case string:
+		bv := b.(string)
+		switch {
+		case a < bv:
+			return -1
+		case a > bv:
+			return 1
+		default:
+			return 0
+		}
+	default:
+		return 0
+	}
+}
+
+// GenerateFieldStats returns the min/max statistics of the user scalar fields in @data, primary key excluded
+// since its statistics are recorded by PrimaryKeyStats.
+func GenerateFieldStats(schema *schemapb.CollectionSchema, data *InsertData) []*FieldStats {
+	var results []*FieldStats
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() || !SupportFieldStats(field.GetDataType()) {
+			continue
+		}
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok || fieldData.RowNum() == 0 {
+			continue
+		}
+		stats := NewFieldStats(field.GetFieldID(), field.GetDataType())
+		stats.UpdateByFieldData(fieldData)
+		if stats.IsValid() {
+			results = append(results, stats)
+		}
+	}
+	return results
+}
+
+// SerializeFieldStats serializes @stats as blobs, the key of a blob is the field ID
+func SerializeFieldStats(stats []*FieldStats) ([]*Blob, error) {
+	blobs := make([]*Blob, 0, len(stats))
+	for _, s := range stats {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, &Blob{
+			Key:   strconv.FormatInt(s.FieldID, 10),
+			Value: b,
+		})
+	}
+	return blobs, nil
+}
+
+// DeserializeFieldStats deserializes @blobs and merges the stats of the same field
+func DeserializeFieldStats(blobs []*Blob) (map[int64]*FieldStats, error) {
+	results := make(map[int64]*FieldStats)
+	for _, blob := range blobs {
+		if blob.Value == nil {
+			continue
+		}
+		stats := &FieldStats{}
+		if err := json.Unmarshal(blob.Value, stats); err != nil {
+			return nil, err
+		}
+		if merged, ok := results[stats.FieldID]; ok {
+			merged.Merge(stats)
+		} else {
+			results[stats.FieldID] = stats
+		}
+	}
+	return results, nil
+}
+
 // StatsWriter writes stats to buffer
 type StatsWriter struct {
 	buffer []byte
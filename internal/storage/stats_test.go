@@ -18,6 +18,7 @@ package storage
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/bits-and-blooms/bloom/v3"
@@ -126,3 +127,66 @@ func TestStatsWriter_UpgradePrimaryKey(t *testing.T) {
 		assert.True(t, unmarshaledStats.BF.Test(buffer))
 	}
 }
+
+func TestFieldStats(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_Int32},
+			{FieldID: 102, DataType: schemapb.DataType_Float},
+			{FieldID: 103, DataType: schemapb.DataType_VarChar},
+			{FieldID: 104, DataType: schemapb.DataType_Bool},
+			{FieldID: 105, DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	newInsertData := func(i32 []int32, f32 []float32, strs []string, bools []bool) *InsertData {
+		return &InsertData{
+			Data: map[FieldID]FieldData{
+				common.RowIDField: &Int64FieldData{Data: []int64{1, 2, 3}},
+				100:               &Int64FieldData{Data: []int64{1, 2, 3}},
+				101:               &Int32FieldData{Data: i32},
+				102:               &FloatFieldData{Data: f32},
+				103:               &StringFieldData{Data: strs},
+				104:               &BoolFieldData{Data: bools},
+				105:               &FloatVectorFieldData{Data: []float32{1, 2, 3}, Dim: 1},
+			},
+		}
+	}
+
+	stats1 := GenerateFieldStats(schema, newInsertData([]int32{3, -1, 7}, []float32{0.5, 1.5, -2.5}, []string{"west", "east", "north"}, []bool{true, true, true}))
+	assert.Equal(t, 4, len(stats1))
+	stats2 := GenerateFieldStats(schema, newInsertData([]int32{10, 4, 5}, []float32{0, 0, 0}, []string{"apac", "emea", "east"}, []bool{false, true, true}))
+	assert.Equal(t, 4, len(stats2))
+
+	blobs1, err := SerializeFieldStats(stats1)
+	assert.NoError(t, err)
+	blobs2, err := SerializeFieldStats(stats2)
+	assert.NoError(t, err)
+	assert.Equal(t, "101", blobs1[0].Key)
+
+	merged, err := DeserializeFieldStats(append(blobs1, blobs2...))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(merged))
+
+	assert.Equal(t, schemapb.DataType_Int32, merged[101].Type)
+	assert.Equal(t, int64(-1), merged[101].Min)
+	assert.Equal(t, int64(10), merged[101].Max)
+	assert.Equal(t, float64(-2.5), merged[102].Min)
+	assert.Equal(t, float64(1.5), merged[102].Max)
+	assert.Equal(t, "apac", merged[103].Min)
+	assert.Equal(t, "west", merged[103].Max)
+	assert.Equal(t, false, merged[104].Min)
+	assert.Equal(t, true, merged[104].Max)
+	assert.Equal(t, int64(6), merged[101].RowNum)
+	assert.Zero(t, merged[101].NullCount)
+
+	nanStats := GenerateFieldStats(schema, newInsertData([]int32{1, 2, 3}, []float32{0, float32(math.NaN()), 1}, []string{"a", "b", "c"}, []bool{true, true, true}))
+	assert.Equal(t, 3, len(nanStats))
+	for _, s := range nanStats {
+		assert.NotEqual(t, int64(102), s.FieldID)
+	}
+
+	_, err = DeserializeFieldStats([]*Blob{{Value: []byte(`{"fieldID":101,"type":5,"max":"x"}`)}})
+	assert.Error(t, err)
+}
@@ -60,3 +60,9 @@ type ChunkManager interface {
 	// RemoveWithPrefix remove files with same @prefix.
 	RemoveWithPrefix(ctx context.Context, prefix string) error
 }
+
+// MultipartWriter is implemented by the ChunkManager supporting multipart upload.
+type MultipartWriter interface {
+	// MultipartWrite uploads @size bytes read from @reader to @filePath in parts of @partSize.
+	MultipartWrite(ctx context.Context, filePath string, reader io.Reader, size int64, partSize uint64) error
+}
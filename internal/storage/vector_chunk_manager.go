@@ -96,6 +96,10 @@ func NewVectorChunkManager(ctx context.Context, cacheStorage ChunkManager, vecto
 // deserialize the file for it has binlog style. At last we store pure vector
 // data to local storage as cache.
 func (vcm *VectorChunkManager) deserializeVectorFile(filePath string, content []byte) ([]byte, error) {
+	if IsSegmentFile(content) {
+		return readSegmentFileVector(content)
+	}
+
 	blob := &Blob{
 		Key:   filePath,
 		Value: content,
@@ -315,6 +315,17 @@ type DataCoord interface {
 
 	// MarkSegmentsDropped marks the given segments as `dropped` state.
 	MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+
+	// CloneSegments creates flushed segments in the target collection which share the binlogs of
+	// the source collection's flushed segments.
+	CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error)
+
+	// UpdateChannelCheckpoint updates the checkpoint of a vchannel reported by DataNode,
+	// all the data consumed before the checkpoint is durable and the channel is recovered from it.
+	UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error)
+
+	// GetChannelCheckpoints returns the checkpoints of the vchannels and how far they lag behind now.
+	GetChannelCheckpoints(ctx context.Context, req *datapb.GetChannelCheckpointsRequest) (*datapb.GetChannelCheckpointsResponse, error)
 }
 
 // DataCoordComponent defines the interface of DataCoord component.
@@ -326,6 +337,10 @@ type DataCoordComponent interface {
 	SetEtcdClient(etcdClient *clientv3.Client)
 
 	SetIndexCoord(indexCoord IndexCoord)
+
+	// SetQueryCoord set QueryCoord for DataCoord
+	// `queryCoord` is a client of query coordinator
+	SetQueryCoord(queryCoord QueryCoord)
 }
 
 // IndexNode is the interface `indexnode` package implements
@@ -714,6 +729,86 @@ type RootCoord interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// CreateBackup copies the flushed segments of a collection to the backup location
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, backup name and backup timestamp
+	//
+	// The `Status` in response struct `CreateBackupResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Info` in `CreateBackupResponse` return the state of the new backup.
+	// error is always nil
+	CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error)
+
+	// ListBackups lists backups of a collection, or all backups if the collection name is empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListBackupsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Backups` in `ListBackupsResponse` return the information of the backups.
+	// error is always nil
+	ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error)
+
+	// RestoreBackup creates a new collection from a backup and imports the backed up data into it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including backup name and the name of the new collection
+	//
+	// The `Status` in response struct `RestoreBackupResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Tasks` in `RestoreBackupResponse` return the id array of the import tasks restoring the data.
+	// error is always nil
+	RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error)
+
+	// CloneCollection creates a new collection sharing the flushed binlogs of an existing collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including source collection name and the name of the new collection
+	//
+	// The `Status` in response struct `CloneCollectionResponse` indicates if this operation is processed successfully or fail cause;
+	// the `SegmentIDs` in `CloneCollectionResponse` return the id array of the cloned segments.
+	// error is always nil
+	CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error)
+
+	// ListDroppedCollections lists the dropped collections kept in the recycle bin
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including an optional collection name to filter by
+	//
+	// The `Status` in response struct `ListDroppedCollectionsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Collections` in `ListDroppedCollectionsResponse` return the dropped collections and when they expire.
+	// error is always nil
+	ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error)
+
+	// RestoreCollection brings a dropped collection back from the recycle bin before its retention expires
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and an optional collection id
+	//
+	// The `ErrorCode` of `Status` is `Success` if the collection is restored;
+	// otherwise, the `ErrorCode` is `UnexpectedError`
+	// error is always nil
+	RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error)
+
+	// ListDDLJobs lists the DDL jobs kept by rootCoord, the latest jobs come first
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including optional collection name, job states and limit to filter by
+	//
+	// The `Status` in response struct `ListDDLJobsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Jobs` in `ListDDLJobsResponse` return the jobs without their steps.
+	// error is always nil
+	ListDDLJobs(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest) (*rootcoordpb.ListDDLJobsResponse, error)
+
+	// DescribeDDLJob returns a DDL job with the state of its steps
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including job id
+	//
+	// The `Status` in response struct `DescribeDDLJobResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Job` in `DescribeDDLJobResponse` return the job and its steps, including the undo steps.
+	// error is always nil
+	DescribeDDLJob(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest) (*rootcoordpb.DescribeDDLJobResponse, error)
+
 	// ReportImport reports import task state to rootCoord
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1242,6 +1337,66 @@ type ProxyComponent interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// CreateBackup copies the flushed segments of a collection to the backup location
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, backup name and backup timestamp
+	//
+	// The `Status` in response struct `CreateBackupResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Info` in `CreateBackupResponse` return the state of the new backup.
+	// error is always nil
+	CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest) (*milvuspb.CreateBackupResponse, error)
+
+	// ListBackups lists backups of a collection, or all backups if the collection name is empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListBackupsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Backups` in `ListBackupsResponse` return the information of the backups.
+	// error is always nil
+	ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest) (*milvuspb.ListBackupsResponse, error)
+
+	// RestoreBackup creates a new collection from a backup and imports the backed up data into it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including backup name and the name of the new collection
+	//
+	// The `Status` in response struct `RestoreBackupResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Tasks` in `RestoreBackupResponse` return the id array of the import tasks restoring the data.
+	// error is always nil
+	RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest) (*milvuspb.RestoreBackupResponse, error)
+
+	// CloneCollection creates a new collection sharing the flushed binlogs of an existing collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including source collection name and the name of the new collection
+	//
+	// The `Status` in response struct `CloneCollectionResponse` indicates if this operation is processed successfully or fail cause;
+	// the `SegmentIDs` in `CloneCollectionResponse` return the id array of the cloned segments.
+	// error is always nil
+	CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest) (*milvuspb.CloneCollectionResponse, error)
+
+	// ListDroppedCollections lists the dropped collections kept in the recycle bin
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including an optional collection name to filter by
+	//
+	// The `Status` in response struct `ListDroppedCollectionsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Collections` in `ListDroppedCollectionsResponse` return the dropped collections and when they expire.
+	// error is always nil
+	ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error)
+
+	// RestoreCollection brings a dropped collection back from the recycle bin before its retention expires
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and an optional collection id
+	//
+	// The `ErrorCode` of `Status` is `Success` if the collection is restored;
+	// otherwise, the `ErrorCode` is `UnexpectedError`
+	// error is always nil
+	RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest) (*commonpb.Status, error)
+
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
 	// CreateCredential create new user and password
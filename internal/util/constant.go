@@ -24,8 +24,9 @@ import (
 
 // Meta Prefix consts
 const (
-	MetaStoreTypeEtcd  = "etcd"
-	MetaStoreTypeMysql = "mysql"
+	MetaStoreTypeEtcd   = "etcd"
+	MetaStoreTypeMysql  = "mysql"
+	MetaStoreTypeSqlite = "sqlite"
 
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
@@ -77,6 +78,7 @@ var (
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeQuery.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeLoadBalance.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeImport.String()),
+			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeBackup.String()),
 		},
 		commonpb.ObjectType_Global.String(): {
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeAll.String()),
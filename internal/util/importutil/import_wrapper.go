@@ -45,6 +45,10 @@ const (
 	JSONFileExt  = ".json"
 	NumpyFileExt = ".npy"
 
+	// EndTs is the key of the import task info passing the end timestamp from rootcoord to datanode,
+	// the rows written after it are not imported
+	EndTs = "end_ts"
+
 	// this limitation is to avoid this OOM risk:
 	// for column-based file, we read all its data into memory, if user input a large file, the read() method may
 	// cost extra memory and lear to OOM.
@@ -74,6 +78,8 @@ type ImportWrapper struct {
 
 	importResult *rootcoordpb.ImportResult                 // import result
 	reportFunc   func(res *rootcoordpb.ImportResult) error // report import state to rootcoord
+
+	tsEndPoint uint64 // rows with a timestamp greater than tsEndPoint are skipped in binlog import
 }
 
 func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
@@ -112,11 +118,20 @@ func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.Collection
 		chunkManager:     cm,
 		importResult:     importResult,
 		reportFunc:       reportFunc,
+		tsEndPoint:       math.MaxUint64,
 	}
 
 	return wrapper
 }
 
+// SetTsEndPoint sets the timestamp end point of binlog import, 0 means no limit
+func (p *ImportWrapper) SetTsEndPoint(ts uint64) {
+	if ts == 0 {
+		ts = math.MaxUint64
+	}
+	p.tsEndPoint = ts
+}
+
 // this method can be used to cancel parse process
 func (p *ImportWrapper) Cancel() error {
 	p.cancel()
@@ -219,8 +234,7 @@ func (p *ImportWrapper) Import(filePaths []string, rowBased bool, onlyValidate b
 	// data restore function to import milvus native binlog files(for backup/restore tools)
 	// the backup/restore tool provide two paths for a partition, the first path is binlog path, the second is deltalog path
 	if p.isBinlogImport(filePaths) {
-		// TODO: handle the timestamp end point passed from client side, currently use math.MaxUint64
-		return p.doBinlogImport(filePaths, math.MaxUint64)
+		return p.doBinlogImport(filePaths, p.tsEndPoint)
 	}
 
 	// normal logic for import general data files
@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"strconv"
 	"testing"
 	"time"
@@ -164,6 +165,12 @@ func Test_NewImportWrapper(t *testing.T) {
 	})
 	wrapper = NewImportWrapper(ctx, schema, 2, 1, nil, cm, nil, nil, nil)
 	assert.NotNil(t, wrapper)
+	assert.Equal(t, uint64(math.MaxUint64), wrapper.tsEndPoint)
+
+	wrapper.SetTsEndPoint(1000)
+	assert.Equal(t, uint64(1000), wrapper.tsEndPoint)
+	wrapper.SetTsEndPoint(0)
+	assert.Equal(t, uint64(math.MaxUint64), wrapper.tsEndPoint)
 
 	err = wrapper.Cancel()
 	assert.Nil(t, err)
@@ -153,7 +153,8 @@ type IndexCoordInfos struct {
 
 // DataNodeConfiguration records the configuration of DataNode.
 type DataNodeConfiguration struct {
-	FlushInsertBufferSize int64 `json:"flush_insert_buffer_size"`
+	FlushInsertBufferSize int64   `json:"flush_insert_buffer_size"`
+	Capacity              float64 `json:"capacity"`
 }
 
 // DataNodeInfos implements ComponentInfos
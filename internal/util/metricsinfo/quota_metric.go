@@ -60,13 +60,23 @@ type QueryNodeQuotaMetrics struct {
 	Fgm         FlowGraphMetric
 	SearchQueue ReadInfoInQueue
 	QueryQueue  ReadInfoInQueue
+	// GrowingSegmentsSize is the memory size in bytes of each growing segment, keyed by segment ID
+	GrowingSegmentsSize map[int64]int64
+}
+
+// ChannelRateMetric contains a RateMetricLabel and a float rate of a DML channel.
+type ChannelRateMetric struct {
+	Channel string
+	Label   RateMetricLabel
+	Rate    float64
 }
 
 // DataNodeQuotaMetrics are metrics of DataNode.
 type DataNodeQuotaMetrics struct {
-	Hms HardwareMetrics
-	Rms []RateMetric
-	Fgm FlowGraphMetric
+	Hms  HardwareMetrics
+	Rms  []RateMetric
+	Crms []ChannelRateMetric
+	Fgm  FlowGraphMetric
 }
 
 // ProxyQuotaMetrics are metrics of Proxy.
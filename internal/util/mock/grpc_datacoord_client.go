@@ -156,3 +156,15 @@ func (m *GrpcDataCoordClient) UnsetIsImportingState(context.Context, *datapb.Uns
 func (m *GrpcDataCoordClient) MarkSegmentsDropped(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcDataCoordClient) CloneSegments(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*datapb.CloneSegmentsResponse, error) {
+	return &datapb.CloneSegmentsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) UpdateChannelCheckpoint(context.Context, *datapb.UpdateChannelCheckpointRequest, ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetChannelCheckpoints(context.Context, *datapb.GetChannelCheckpointsRequest, ...grpc.CallOption) (*datapb.GetChannelCheckpointsResponse, error) {
+	return &datapb.GetChannelCheckpointsResponse{}, m.Err
+}
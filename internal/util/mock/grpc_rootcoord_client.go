@@ -193,6 +193,38 @@ func (m *GrpcRootCoordClient) ListImportTasks(ctx context.Context, req *milvuspb
 	return &milvuspb.ListImportTasksResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CreateBackup(ctx context.Context, req *milvuspb.CreateBackupRequest, opts ...grpc.CallOption) (*milvuspb.CreateBackupResponse, error) {
+	return &milvuspb.CreateBackupResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListBackups(ctx context.Context, req *milvuspb.ListBackupsRequest, opts ...grpc.CallOption) (*milvuspb.ListBackupsResponse, error) {
+	return &milvuspb.ListBackupsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RestoreBackup(ctx context.Context, req *milvuspb.RestoreBackupRequest, opts ...grpc.CallOption) (*milvuspb.RestoreBackupResponse, error) {
+	return &milvuspb.RestoreBackupResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CloneCollection(ctx context.Context, req *milvuspb.CloneCollectionRequest, opts ...grpc.CallOption) (*milvuspb.CloneCollectionResponse, error) {
+	return &milvuspb.CloneCollectionResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return &milvuspb.ListDroppedCollectionsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RestoreCollection(ctx context.Context, req *milvuspb.RestoreCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDDLJobs(ctx context.Context, req *rootcoordpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListDDLJobsResponse, error) {
+	return &rootcoordpb.ListDDLJobsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DescribeDDLJob(ctx context.Context, req *rootcoordpb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*rootcoordpb.DescribeDDLJobResponse, error) {
+	return &rootcoordpb.DescribeDDLJobResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -128,6 +128,12 @@ func (gp *BaseTable) initConfigsFromLocal(formatter func(key string) string) {
 }
 
 func (gp *BaseTable) initConfigsFromRemote(formatter func(key string) string) {
+	if value, err := gp.mgr.GetConfig("etcd.rocksdb.enabled"); err == nil {
+		if enabled, _ := strconv.ParseBool(value); enabled {
+			log.Info("meta is kept in rocksdb, skip the configs in etcd")
+			return
+		}
+	}
 	endpoints, err := gp.mgr.GetConfig("etcd.endpoints")
 	if err != nil {
 		log.Info("cannot find etcd.endpoints")
@@ -14,6 +14,7 @@ package paramtable
 import (
 	"math"
 	"os"
+	"path"
 	"runtime"
 	"strconv"
 	"strings"
@@ -138,6 +139,8 @@ type commonConfig struct {
 	StorageType string
 	SimdType    string
 
+	EnablePayloadChecksum bool
+
 	AuthorizationEnabled bool
 
 	ClusterName string
@@ -179,6 +182,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.initIndexSliceSize()
 	p.initGracefulTime()
 	p.initStorageType()
+	p.initEnablePayloadChecksum()
 
 	p.initEnableAuthorization()
 
@@ -385,6 +389,12 @@ func (p *commonConfig) initStorageType() {
 	p.StorageType = p.Base.LoadWithDefault("common.storageType", "minio")
 }
 
+// the binlogs written with the payload checksums can't be read by the nodes of older versions,
+// enable it only after all the nodes are upgraded
+func (p *commonConfig) initEnablePayloadChecksum() {
+	p.EnablePayloadChecksum = p.Base.ParseBool("common.payloadChecksum.enable", false)
+}
+
 func (p *commonConfig) initEnableAuthorization() {
 	p.AuthorizationEnabled = p.Base.ParseBool("common.security.authorizationEnabled", false)
 }
@@ -414,6 +424,9 @@ type rootCoordConfig struct {
 	MinSegmentSizeToEnableIndex int64
 	ImportTaskExpiration        float64
 	ImportTaskRetention         float64
+	BackupRootPath              string
+	DropRetention               float64
+	DDLJobRetention             float64
 
 	// --- ETCD Path ---
 	ImportTaskSubPath string
@@ -432,6 +445,9 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	p.ImportTaskExpiration = p.Base.ParseFloatWithDefault("rootCoord.importTaskExpiration", 15*60)
 	p.ImportTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.importTaskRetention", 24*60*60)
 	p.ImportTaskSubPath = "importtask"
+	p.BackupRootPath = p.Base.LoadWithDefault("rootCoord.backupRootPath", "backup")
+	p.DropRetention = p.Base.ParseFloatWithDefault("rootCoord.dropRetention", 24*60*60)
+	p.DDLJobRetention = p.Base.ParseFloatWithDefault("rootCoord.ddlJobRetention", 24*60*60)
 	p.EnableActiveStandby = p.Base.ParseBool("rootCoord.enableActiveStandby", false)
 }
 
@@ -1064,6 +1080,11 @@ type dataCoordConfig struct {
 	// --- ETCD ---
 	ChannelWatchSubPath string
 
+	// --- CHANNELS ---
+	ChannelBalancePolicy    string
+	ChannelBalanceInterval  time.Duration
+	ChannelBalanceTolerance float64
+
 	// --- SEGMENTS ---
 	SegmentMaxSize                 float64
 	DiskSegmentMaxSize             float64
@@ -1072,6 +1093,11 @@ type dataCoordConfig struct {
 	SegmentMaxLifetime             time.Duration
 	SegmentMaxIdleTime             time.Duration
 	SegmentMinSizeFromIdleToSealed float64
+	SegmentSealPolicy              string
+	CollectionSegmentSealPolicies  map[string]string
+	SegmentTargetIndexSize         float64
+	SegmentMaxGrowingSize          float64
+	GrowingSizeSyncInterval        time.Duration
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -1090,18 +1116,33 @@ type dataCoordConfig struct {
 	SingleCompactionExpiredLogMaxSize int64
 	SingleCompactionBinlogMaxNum      int64
 	GlobalCompactionInterval          time.Duration
+	ClusteringCompactionFields        []string
+	ClusteringCompactionInterval      time.Duration
+	CompactionPolicy                  string
+	CollectionCompactionPolicies      map[string]string
+	CompactionTimeWindow              time.Duration
 
 	// Garbage Collection
 	EnableGarbageCollection bool
 	GCInterval              time.Duration
 	GCMissingTolerance      time.Duration
 	GCDropTolerance         time.Duration
-	EnableActiveStandby     bool
+	GCDryRun                bool
+	EnableManualGC          bool
+
+	// Scrubber
+	EnableScrubber           bool
+	ScrubberInterval         time.Duration
+	ScrubberMaxFilesPerRound int
+	EnableActiveStandby      bool
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
 	p.Base = base
 	p.initChannelWatchPrefix()
+	p.initChannelBalancePolicy()
+	p.initChannelBalanceInterval()
+	p.initChannelBalanceTolerance()
 
 	p.initSegmentMaxSize()
 	p.initDiskSegmentMaxSize()
@@ -1110,6 +1151,11 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	p.initSegmentMaxLifetime()
 	p.initSegmentMaxIdleTime()
 	p.initSegmentMinSizeFromIdleToSealed()
+	p.initSegmentSealPolicy()
+	p.initCollectionSegmentSealPolicies()
+	p.initSegmentTargetIndexSize()
+	p.initSegmentMaxGrowingSize()
+	p.initGrowingSizeSyncInterval()
 
 	p.initEnableCompaction()
 	p.initEnableAutoCompaction()
@@ -1124,11 +1170,22 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	p.initSingleCompactionExpiredLogMaxSize()
 	p.initSingleCompactionBinlogMaxNum()
 	p.initGlobalCompactionInterval()
+	p.initClusteringCompactionFields()
+	p.initClusteringCompactionInterval()
+	p.initCompactionPolicy()
+	p.initCollectionCompactionPolicies()
+	p.initCompactionTimeWindow()
 
 	p.initEnableGarbageCollection()
 	p.initGCInterval()
 	p.initGCMissingTolerance()
 	p.initGCDropTolerance()
+	p.initGCDryRun()
+	p.initEnableManualGC()
+
+	p.initEnableScrubber()
+	p.initScrubberInterval()
+	p.initScrubberMaxFilesPerRound()
 	p.initEnableActiveStandby()
 }
 
@@ -1162,12 +1219,53 @@ func (p *dataCoordConfig) initSegmentMinSizeFromIdleToSealed() {
 	log.Info("init segment min size from idle to sealed", zap.Float64("value", p.SegmentMinSizeFromIdleToSealed))
 }
 
+// the default policy to seal growing segments, static or adaptive
+func (p *dataCoordConfig) initSegmentSealPolicy() {
+	p.SegmentSealPolicy = p.Base.LoadWithDefault("dataCoord.segment.sealPolicy.default", "static")
+}
+
+// the seal policies of specific collections, in the format of "collection1:adaptive,collection2:static"
+func (p *dataCoordConfig) initCollectionSegmentSealPolicies() {
+	p.CollectionSegmentSealPolicies = parseCollectionPolicies(p.Base, "dataCoord.segment.sealPolicy.collections")
+}
+
+// the size in MB of the segments the adaptive seal policy seals for index building
+func (p *dataCoordConfig) initSegmentTargetIndexSize() {
+	p.SegmentTargetIndexSize = p.Base.ParseFloatWithDefault("dataCoord.segment.adaptive.targetIndexSize", 128.0)
+}
+
+// the max size in MB of the growing segments of a channel under the adaptive seal policy, the memory measured
+// on query nodes is used if reported, otherwise it's estimated from the allocated rows and the schema
+func (p *dataCoordConfig) initSegmentMaxGrowingSize() {
+	p.SegmentMaxGrowingSize = p.Base.ParseFloatWithDefault("dataCoord.segment.adaptive.maxGrowingSize", 512.0)
+}
+
+// the interval to sync the memory size of the growing segments from query nodes through querycoord
+func (p *dataCoordConfig) initGrowingSizeSyncInterval() {
+	p.GrowingSizeSyncInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.segment.adaptive.growingSizeSyncInterval", 10)) * time.Second
+}
+
 func (p *dataCoordConfig) initChannelWatchPrefix() {
 	// WARN: this value should not be put to milvus.yaml. It's a default value for channel watch path.
 	// This will be removed after we reconstruct our config module.
 	p.ChannelWatchSubPath = "channelwatch"
 }
 
+// the policy to assign DML channels to datanodes, "average" or "weighted"
+func (p *dataCoordConfig) initChannelBalancePolicy() {
+	p.ChannelBalancePolicy = p.Base.LoadWithDefault("dataCoord.channel.balancePolicy", "average")
+}
+
+// the interval to balance channels between datanodes with the weighted policy
+func (p *dataCoordConfig) initChannelBalanceInterval() {
+	p.ChannelBalanceInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.channel.balanceInterval", 300)) * time.Second
+}
+
+// the proportion a datanode may exceed the average load before its channels are moved
+func (p *dataCoordConfig) initChannelBalanceTolerance() {
+	p.ChannelBalanceTolerance = p.Base.ParseFloatWithDefault("dataCoord.channel.balanceTolerance", 0.2)
+}
+
 func (p *dataCoordConfig) initEnableCompaction() {
 	p.EnableCompaction = p.Base.ParseBool("dataCoord.enableCompaction", false)
 }
@@ -1222,6 +1320,56 @@ func (p *dataCoordConfig) initGlobalCompactionInterval() {
 	p.GlobalCompactionInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.compaction.global.interval", int64(60*time.Second)))
 }
 
+// the scalar fields to cluster segments on, the first field a collection has is used,
+// clustering compaction is disabled if no field is configured.
+func (p *dataCoordConfig) initClusteringCompactionFields() {
+	p.ClusteringCompactionFields = nil
+	fields := p.Base.LoadWithDefault("dataCoord.compaction.clustering.fields", "")
+	for _, field := range strings.Split(fields, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			p.ClusteringCompactionFields = append(p.ClusteringCompactionFields, field)
+		}
+	}
+}
+
+// interval we check and trigger clustering compaction
+func (p *dataCoordConfig) initClusteringCompactionInterval() {
+	p.ClusteringCompactionInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.compaction.clustering.interval", 60*60)) * time.Second
+}
+
+// the default policy to pick segments to compact together, greedy or timeWindow
+func (p *dataCoordConfig) initCompactionPolicy() {
+	p.CompactionPolicy = p.Base.LoadWithDefault("dataCoord.compaction.policy.default", "greedy")
+}
+
+// the compaction policies of specific collections, in the format of "collection1:timeWindow,collection2:greedy"
+func (p *dataCoordConfig) initCollectionCompactionPolicies() {
+	p.CollectionCompactionPolicies = parseCollectionPolicies(p.Base, "dataCoord.compaction.policy.collections")
+}
+
+// parseCollectionPolicies parses the policies of collections in the format of "collection1:policy1,collection2:policy2"
+func parseCollectionPolicies(base *BaseTable, key string) map[string]string {
+	result := make(map[string]string)
+	policies := base.LoadWithDefault(key, "")
+	for _, item := range strings.Split(policies, ",") {
+		kv := strings.SplitN(item, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		collection, policy := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		if collection != "" && policy != "" {
+			result[collection] = policy
+		}
+	}
+	return result
+}
+
+// the size of the time window of time window compaction policy
+func (p *dataCoordConfig) initCompactionTimeWindow() {
+	p.CompactionTimeWindow = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.compaction.timeWindow.size", 24*60*60)) * time.Second
+}
+
 // -- GC --
 func (p *dataCoordConfig) initEnableGarbageCollection() {
 	p.EnableGarbageCollection = p.Base.ParseBool("dataCoord.enableGarbageCollection", true)
@@ -1239,6 +1387,30 @@ func (p *dataCoordConfig) initGCDropTolerance() {
 	p.GCDropTolerance = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second
 }
 
+// in dry run mode gc only logs the files it would remove
+func (p *dataCoordConfig) initGCDryRun() {
+	p.GCDryRun = p.Base.ParseBool("dataCoord.gc.dryRun", false)
+}
+
+// manual gc removes files on an http request, so it's off unless explicitly enabled
+func (p *dataCoordConfig) initEnableManualGC() {
+	p.EnableManualGC = p.Base.ParseBool("dataCoord.gc.enableManualCollect", false)
+}
+
+// -- Scrubber --
+func (p *dataCoordConfig) initEnableScrubber() {
+	p.EnableScrubber = p.Base.ParseBool("dataCoord.scrubber.enable", true)
+}
+
+func (p *dataCoordConfig) initScrubberInterval() {
+	p.ScrubberInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.scrubber.interval", 60*60)) * time.Second
+}
+
+// the scrubber verifies at most this number of files each round and continues from there in the next round
+func (p *dataCoordConfig) initScrubberMaxFilesPerRound() {
+	p.ScrubberMaxFilesPerRound = p.Base.ParseIntWithDefault("dataCoord.scrubber.maxFilesPerRound", 1000)
+}
+
 func (p *dataCoordConfig) SetEnableAutoCompaction(enable bool) {
 	p.EnableAutoCompaction.Store(enable)
 }
@@ -1283,6 +1455,38 @@ type dataNodeConfig struct {
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
 	FlushInsertBufferSize   int64
+	Capacity                float64
+
+	// interval to report the channel checkpoints to datacoord, 0 to disable
+	ChannelCheckpointInterval time.Duration
+
+	// upload binlogs of the flushed buffers
+	UploadConcurrency        int
+	UploadRetryAttempts      uint
+	MultipartUploadThreshold int64
+	MultipartUploadPartSize  int64
+
+	// memory budget in bytes of a merge compaction for the prefetched binlogs and the merged rows
+	CompactionMemoryBudget int64
+
+	// sort the rows of the insert binlogs by primary key at flush and compaction
+	SortByPK bool
+
+	// storage version of the insert binlogs written at flush and compaction,
+	// 1 for a binlog file per field and 2 for a single columnar file per segment
+	StorageVersion int64
+
+	// compression of the insert binlog payloads in the format of "codec" or "codec:level",
+	// the codec is one of none, snappy, zstd and lz4
+	BinlogCompression string
+	// compressions of the insert binlog payloads of specific collections
+	CollectionBinlogCompressions map[string]string
+
+	// spill insert buffers to disk under memory pressure
+	SpillEnabled         bool
+	SpillPath            string
+	SpillMemoryWatermark float64
+	SpillMinSize         int64
 
 	Alias string // Different datanode in one machine
 
@@ -1303,6 +1507,21 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	p.initFlowGraphMaxParallelism()
 	p.initFlushInsertBufferSize()
 	p.initIOConcurrency()
+	p.initCapacity()
+	p.initSpillEnabled()
+	p.initSpillPath()
+	p.initSpillMemoryWatermark()
+	p.initSpillMinSize()
+	p.initChannelCheckpointInterval()
+	p.initUploadConcurrency()
+	p.initUploadRetryAttempts()
+	p.initMultipartUploadThreshold()
+	p.initMultipartUploadPartSize()
+	p.initCompactionMemoryBudget()
+	p.initSortByPK()
+	p.initStorageVersion()
+	p.initBinlogCompression()
+	p.initCollectionBinlogCompressions()
 
 	p.initChannelWatchPath()
 }
@@ -1337,6 +1556,98 @@ func (p *dataNodeConfig) initIOConcurrency() {
 	p.IOConcurrency = p.Base.ParseIntWithDefault("dataNode.dataSync.ioConcurrency", 10)
 }
 
+// the capacity of the datanode reported to datacoord to weight channel assignment, 0 means the number of CPU cores
+func (p *dataNodeConfig) initCapacity() {
+	p.Capacity = p.Base.ParseFloatWithDefault("dataNode.capacity", 0)
+}
+
+func (p *dataNodeConfig) initSpillEnabled() {
+	p.SpillEnabled = p.Base.ParseBool("dataNode.spill.enabled", false)
+}
+
+// the local directory to spill insert buffers, default to the spill directory under the local storage path
+func (p *dataNodeConfig) initSpillPath() {
+	localPath := p.Base.LoadWithDefault("localStorage.path", "/var/lib/milvus/data")
+	p.SpillPath = p.Base.LoadWithDefault("dataNode.spill.path", path.Join(localPath, "spill"))
+}
+
+// the ratio of used memory to total memory above which the largest insert buffers are spilled
+func (p *dataNodeConfig) initSpillMemoryWatermark() {
+	p.SpillMemoryWatermark = p.Base.ParseFloatWithDefault("dataNode.spill.memoryWatermark", 0.8)
+}
+
+// the insert buffers smaller than this size in bytes are not spilled
+func (p *dataNodeConfig) initSpillMinSize() {
+	p.SpillMinSize = p.Base.ParseInt64WithDefault("dataNode.spill.minSize", 1024*1024)
+}
+
+func (p *dataNodeConfig) initChannelCheckpointInterval() {
+	interval := p.Base.ParseInt64WithDefault("dataNode.dataSync.checkpointInterval", 10)
+	p.ChannelCheckpointInterval = time.Duration(interval) * time.Second
+}
+
+// the max number of binlogs uploaded concurrently by the datanode
+func (p *dataNodeConfig) initUploadConcurrency() {
+	p.UploadConcurrency = p.Base.ParseIntWithDefault("dataNode.flush.uploadConcurrency", 16)
+	if p.UploadConcurrency <= 0 {
+		p.UploadConcurrency = 1
+	}
+}
+
+// the max attempts to upload a single binlog
+func (p *dataNodeConfig) initUploadRetryAttempts() {
+	attempts := p.Base.ParseIntWithDefault("dataNode.flush.uploadRetryAttempts", 5)
+	if attempts <= 0 {
+		attempts = 1
+	}
+	p.UploadRetryAttempts = uint(attempts)
+}
+
+// the binlogs not smaller than this size in bytes are uploaded by multipart, 0 to disable
+func (p *dataNodeConfig) initMultipartUploadThreshold() {
+	p.MultipartUploadThreshold = p.Base.ParseInt64WithDefault("dataNode.flush.multipartThreshold", 64*1024*1024)
+}
+
+// the part size in bytes of multipart uploads, at least 5 MB as required by S3
+func (p *dataNodeConfig) initMultipartUploadPartSize() {
+	p.MultipartUploadPartSize = p.Base.ParseInt64WithDefault("dataNode.flush.multipartPartSize", 16*1024*1024)
+	if p.MultipartUploadPartSize < 5*1024*1024 {
+		p.MultipartUploadPartSize = 5 * 1024 * 1024
+	}
+}
+
+func (p *dataNodeConfig) initCompactionMemoryBudget() {
+	p.CompactionMemoryBudget = p.Base.ParseInt64WithDefault("dataNode.compaction.memoryBudget", 256*1024*1024)
+}
+
+func (p *dataNodeConfig) initSortByPK() {
+	p.SortByPK = p.Base.ParseBool("dataNode.segment.sortByPK", true)
+}
+
+func (p *dataNodeConfig) initStorageVersion() {
+	p.StorageVersion = p.Base.ParseInt64WithDefault("dataNode.segment.storageVersion", 1)
+	if p.StorageVersion != 1 && p.StorageVersion != 2 {
+		p.StorageVersion = 1
+	}
+}
+
+func (p *dataNodeConfig) initBinlogCompression() {
+	p.BinlogCompression = p.Base.LoadWithDefault("dataNode.binlog.compression.default", "zstd:3")
+}
+
+// the compressions of specific collections, in the format of "collection1:lz4,collection2:zstd:9"
+func (p *dataNodeConfig) initCollectionBinlogCompressions() {
+	p.CollectionBinlogCompressions = parseCollectionPolicies(p.Base, "dataNode.binlog.compression.collections")
+}
+
+// GetBinlogCompression returns the compression of the insert binlog payloads of the collection
+func (p *dataNodeConfig) GetBinlogCompression(collectionName string) string {
+	if compression, ok := p.CollectionBinlogCompressions[collectionName]; ok {
+		return compression
+	}
+	return p.BinlogCompression
+}
+
 func (p *dataNodeConfig) SetNodeID(id UniqueID) {
 	p.NodeID.Store(id)
 }
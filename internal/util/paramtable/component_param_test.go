@@ -68,6 +68,8 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.GracefulTime, int64(DefaultGracefulTime))
 		t.Logf("default grafeful time = %d", Params.GracefulTime)
 
+		assert.False(t, Params.EnablePayloadChecksum)
+
 		// -- proxy --
 		assert.Equal(t, Params.ProxySubName, "by-dev-proxy")
 		t.Logf("ProxySubName: %s", Params.ProxySubName)
@@ -130,6 +132,9 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex)
 		assert.NotEqual(t, Params.ImportTaskExpiration, 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention)
+		assert.Equal(t, "backup", Params.BackupRootPath)
+		assert.Equal(t, float64(24*60*60), Params.DropRetention)
+		assert.Equal(t, float64(24*60*60), Params.DDLJobRetention)
 		assert.Equal(t, Params.EnableActiveStandby, false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby)
 
@@ -289,8 +294,45 @@ func TestComponentParam(t *testing.T) {
 		Params := CParams.DataCoordCfg
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime)
 		assert.True(t, Params.EnableGarbageCollection)
+		assert.False(t, Params.GCDryRun)
+		assert.False(t, Params.EnableManualGC)
+		assert.True(t, Params.EnableScrubber)
+		assert.Equal(t, time.Hour, Params.ScrubberInterval)
+		assert.Equal(t, 1000, Params.ScrubberMaxFilesPerRound)
+
+		assert.Equal(t, "average", Params.ChannelBalancePolicy)
+		assert.Equal(t, 300*time.Second, Params.ChannelBalanceInterval)
+		assert.Equal(t, 0.2, Params.ChannelBalanceTolerance)
 		assert.Equal(t, Params.EnableActiveStandby, false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby)
+
+		assert.Empty(t, Params.ClusteringCompactionFields)
+		assert.Equal(t, time.Hour, Params.ClusteringCompactionInterval)
+		Params.Base.Save("dataCoord.compaction.clustering.fields", "region, created_day")
+		Params.initClusteringCompactionFields()
+		assert.Equal(t, []string{"region", "created_day"}, Params.ClusteringCompactionFields)
+		Params.Base.Remove("dataCoord.compaction.clustering.fields")
+		Params.initClusteringCompactionFields()
+
+		assert.Equal(t, "greedy", Params.CompactionPolicy)
+		assert.Empty(t, Params.CollectionCompactionPolicies)
+		assert.Equal(t, 24*time.Hour, Params.CompactionTimeWindow)
+		Params.Base.Save("dataCoord.compaction.policy.collections", "metrics:timeWindow, logs : greedy,invalid")
+		Params.initCollectionCompactionPolicies()
+		assert.Equal(t, map[string]string{"metrics": "timeWindow", "logs": "greedy"}, Params.CollectionCompactionPolicies)
+		Params.Base.Remove("dataCoord.compaction.policy.collections")
+		Params.initCollectionCompactionPolicies()
+
+		assert.Equal(t, "static", Params.SegmentSealPolicy)
+		assert.Empty(t, Params.CollectionSegmentSealPolicies)
+		assert.Equal(t, 128.0, Params.SegmentTargetIndexSize)
+		assert.Equal(t, 512.0, Params.SegmentMaxGrowingSize)
+		assert.Equal(t, 10*time.Second, Params.GrowingSizeSyncInterval)
+		Params.Base.Save("dataCoord.segment.sealPolicy.collections", "metrics:adaptive")
+		Params.initCollectionSegmentSealPolicies()
+		assert.Equal(t, map[string]string{"metrics": "adaptive"}, Params.CollectionSegmentSealPolicies)
+		Params.Base.Remove("dataCoord.segment.sealPolicy.collections")
+		Params.initCollectionSegmentSealPolicies()
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -313,6 +355,29 @@ func TestComponentParam(t *testing.T) {
 		size := Params.FlushInsertBufferSize
 		t.Logf("FlushInsertBufferSize: %d", size)
 
+		assert.Equal(t, float64(0), Params.Capacity)
+
+		assert.False(t, Params.SpillEnabled)
+		assert.Equal(t, "/var/lib/milvus/data/spill", Params.SpillPath)
+		assert.Equal(t, 0.8, Params.SpillMemoryWatermark)
+		assert.Equal(t, int64(1024*1024), Params.SpillMinSize)
+		assert.Equal(t, 10*time.Second, Params.ChannelCheckpointInterval)
+		assert.Equal(t, 16, Params.UploadConcurrency)
+		assert.Equal(t, uint(5), Params.UploadRetryAttempts)
+		assert.Equal(t, int64(64*1024*1024), Params.MultipartUploadThreshold)
+		assert.Equal(t, int64(16*1024*1024), Params.MultipartUploadPartSize)
+		assert.Equal(t, int64(256*1024*1024), Params.CompactionMemoryBudget)
+		assert.True(t, Params.SortByPK)
+		assert.Equal(t, int64(1), Params.StorageVersion)
+		assert.Equal(t, "zstd:3", Params.GetBinlogCompression("collection"))
+
+		Params.Base.Save("dataNode.binlog.compression.collections", "collection:lz4, other:zstd:9")
+		Params.initCollectionBinlogCompressions()
+		assert.Equal(t, "lz4", Params.GetBinlogCompression("collection"))
+		assert.Equal(t, "zstd:9", Params.GetBinlogCompression("other"))
+		Params.Base.Remove("dataNode.binlog.compression.collections")
+		Params.initCollectionBinlogCompressions()
+
 		Params.CreatedTime = time.Now()
 		t.Logf("CreatedTime: %v", Params.CreatedTime)
 
@@ -62,8 +62,9 @@ func (p *ServiceParam) Init() {
 	p.LocalStorageCfg.init(&p.BaseTable)
 	p.MetaStoreCfg.init(&p.BaseTable)
 	p.EtcdCfg.init(&p.BaseTable)
-	if p.MetaStoreCfg.MetaStoreType == util.MetaStoreTypeMysql {
-		log.Debug("Mysql protocol is used as meta store")
+	switch p.MetaStoreCfg.MetaStoreType {
+	case util.MetaStoreTypeMysql, util.MetaStoreTypeSqlite:
+		log.Debug("database is used as meta store", zap.String("driver", p.MetaStoreCfg.MetaStoreType))
 		p.DBCfg.init(&p.BaseTable)
 	}
 	p.PulsarCfg.init(&p.BaseTable)
@@ -93,6 +94,10 @@ type EtcdConfig struct {
 	UseEmbedEtcd bool
 	ConfigPath   string
 	DataDir      string
+
+	// --- Rocksdb Meta ---
+	UseRocksdbMeta  bool
+	RocksdbMetaPath string
 }
 
 func (p *EtcdConfig) init(base *BaseTable) {
@@ -108,6 +113,10 @@ func (p *EtcdConfig) LoadCfgToMemory() {
 	} else {
 		p.initEndpoints()
 	}
+	p.initUseRocksdbMeta()
+	if p.UseRocksdbMeta {
+		p.initRocksdbMetaPath()
+	}
 	p.initMetaRootPath()
 	p.initKvRootPath()
 	p.initEtcdLogLevel()
@@ -126,6 +135,17 @@ func (p *EtcdConfig) initUseEmbedEtcd() {
 	}
 }
 
+func (p *EtcdConfig) initUseRocksdbMeta() {
+	p.UseRocksdbMeta = p.Base.ParseBool("etcd.rocksdb.enabled", false)
+	if p.UseRocksdbMeta && (os.Getenv(metricsinfo.DeployModeEnvKey) != metricsinfo.StandaloneDeployMode) {
+		panic("rocksdb meta can not be used under distributed mode")
+	}
+}
+
+func (p *EtcdConfig) initRocksdbMetaPath() {
+	p.RocksdbMetaPath = p.Base.LoadWithDefault("etcd.rocksdb.path", "default.meta")
+}
+
 func (p *EtcdConfig) initConfigPath() {
 	addr := p.Base.LoadWithDefault("etcd.config.path", "")
 	p.ConfigPath = addr
@@ -231,6 +251,9 @@ func (p *MetaStoreConfig) initMetaStoreType() {
 type MetaDBConfig struct {
 	Base *BaseTable
 
+	// Driver is the sql dialect of the meta database, either mysql or sqlite.
+	Driver string
+
 	Username     string
 	Password     string
 	Address      string
@@ -238,6 +261,9 @@ type MetaDBConfig struct {
 	DBName       string
 	MaxOpenConns int
 	MaxIdleConns int
+
+	// SqlitePath is the database file of the sqlite driver, ":memory:" keeps the meta in memory only.
+	SqlitePath string
 }
 
 func (p *MetaDBConfig) init(base *BaseTable) {
@@ -246,6 +272,11 @@ func (p *MetaDBConfig) init(base *BaseTable) {
 }
 
 func (p *MetaDBConfig) LoadCfgToMemory() {
+	p.initDriver()
+	if p.Driver == util.MetaStoreTypeSqlite {
+		p.initSqlitePath()
+		return
+	}
 	p.initUsername()
 	p.initPassword()
 	p.initAddress()
@@ -255,6 +286,14 @@ func (p *MetaDBConfig) LoadCfgToMemory() {
 	p.initMaxIdleConns()
 }
 
+func (p *MetaDBConfig) initDriver() {
+	p.Driver = p.Base.LoadWithDefault("metastore.type", util.MetaStoreTypeMysql)
+}
+
+func (p *MetaDBConfig) initSqlitePath() {
+	p.SqlitePath = p.Base.LoadWithDefault("sqlite.path", "/var/lib/milvus/meta.db")
+}
+
 func (p *MetaDBConfig) initUsername() {
 	username, err := p.Base.Load("mysql.username")
 	if err != nil {
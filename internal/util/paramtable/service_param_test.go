@@ -15,6 +15,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/stretchr/testify/assert"
 )
@@ -57,6 +58,17 @@ func TestServiceParam(t *testing.T) {
 
 		assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode))
 		Params.LoadCfgToMemory()
+
+		// test UseRocksdbMeta
+		Params.Base.Save("etcd.rocksdb.enabled", "true")
+		assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode))
+		assert.Panics(t, func() { Params.initUseRocksdbMeta() })
+
+		assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode))
+		Params.LoadCfgToMemory()
+		assert.True(t, Params.UseRocksdbMeta)
+		assert.Equal(t, "default.meta", Params.RocksdbMetaPath)
+		Params.Base.Save("etcd.rocksdb.enabled", "false")
 	})
 
 	t.Run("test pulsarConfig", func(t *testing.T) {
@@ -113,6 +125,23 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("rocksmq path = %s", Params.Path)
 	})
 
+	t.Run("test metaDBConfig", func(t *testing.T) {
+		Params := MetaDBConfig{}
+		Params.init(&SParams.BaseTable)
+		defer SParams.BaseTable.Remove("metastore.type")
+
+		SParams.BaseTable.Save("metastore.type", util.MetaStoreTypeMysql)
+		Params.LoadCfgToMemory()
+		assert.Equal(t, util.MetaStoreTypeMysql, Params.Driver)
+		assert.Equal(t, "milvus_meta", Params.DBName)
+		assert.Equal(t, 3306, Params.Port)
+
+		SParams.BaseTable.Save("metastore.type", util.MetaStoreTypeSqlite)
+		Params.LoadCfgToMemory()
+		assert.Equal(t, util.MetaStoreTypeSqlite, Params.Driver)
+		assert.NotEqual(t, "", Params.SqlitePath)
+	})
+
 	t.Run("test minioConfig", func(t *testing.T) {
 		Params := SParams.MinioCfg
 
@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+// LeaseKV is a MetaKv which could keep the sessions instead of etcd,
+// the rocksdb meta kv implements it for the standalone deployment without etcd.
+type LeaseKV interface {
+	kv.MetaKv
+	// CompareVersionAndSwapWithLease acts like CompareVersionAndSwap, and binds the key to the lease.
+	CompareVersionAndSwapWithLease(key string, version int64, target string, id clientv3.LeaseID) (bool, error)
+	// Revoke revokes the lease and removes the keys bound to it.
+	Revoke(id clientv3.LeaseID) error
+	// WatchWithRevisionCtx acts like WatchWithRevision, and the watch is removed once ctx is done.
+	WatchWithRevisionCtx(ctx context.Context, key string, revision int64) clientv3.WatchChan
+}
+
+// sessionKV keeps the sessions instead of etcd if it's set.
+var sessionKV LeaseKV
+
+// SetSessionKV makes the sessions created afterwards kept in the LeaseKV rather than etcd,
+// the standalone deployment sets it before the components start, nil means etcd.
+func SetSessionKV(kv LeaseKV) {
+	sessionKV = kv
+}
+
+// GetSessionKV returns the LeaseKV keeping the sessions, nil if the sessions are kept in etcd.
+func GetSessionKV() LeaseKV {
+	return sessionKV
+}
+
+// sessionClient is the storage operations the session relies on, the keys are full paths.
+type sessionClient interface {
+	// get returns the value of the key, and whether the key exists.
+	get(ctx context.Context, key string) (string, bool, error)
+	// getWithPrefix returns the keys and values with the prefix sorted by key, and the current revision.
+	getWithPrefix(ctx context.Context, prefix string) ([]string, []string, int64, error)
+	compareValueAndSwap(ctx context.Context, key, value, target string) (bool, error)
+	// putIfAbsent puts the key bound to the lease if it doesn't exist, and returns the current revision.
+	putIfAbsent(ctx context.Context, key, value string, id clientv3.LeaseID) (bool, int64, error)
+	grant(ctx context.Context, ttl int64) (clientv3.LeaseID, error)
+	keepAlive(ctx context.Context, id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error)
+	revoke(ctx context.Context, id clientv3.LeaseID) error
+	// watch watches the keys with the prefix from the revision, the events carry the previous key-values.
+	watch(ctx context.Context, prefix string, revision int64) clientv3.WatchChan
+}
+
+// etcdSessionClient keeps the sessions in etcd.
+type etcdSessionClient struct {
+	cli *clientv3.Client
+}
+
+func (c *etcdSessionClient) get(ctx context.Context, key string) (string, bool, error) {
+	resp, err := c.cli.Get(ctx, key)
+	if err != nil {
+		return "", false, err
+	}
+	if resp.Count <= 0 {
+		return "", false, nil
+	}
+	return string(resp.Kvs[0].Value), true, nil
+}
+
+func (c *etcdSessionClient) getWithPrefix(ctx context.Context, prefix string) ([]string, []string, int64, error) {
+	resp, err := c.cli.Get(ctx, prefix, clientv3.WithPrefix(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	keys := make([]string, 0, len(resp.Kvs))
+	values := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+		values = append(values, string(kv.Value))
+	}
+	return keys, values, resp.Header.Revision, nil
+}
+
+func (c *etcdSessionClient) compareValueAndSwap(ctx context.Context, key, value, target string) (bool, error) {
+	resp, err := c.cli.Txn(ctx).If(
+		clientv3.Compare(
+			clientv3.Value(key),
+			"=",
+			value)).
+		Then(clientv3.OpPut(key, target)).Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+func (c *etcdSessionClient) putIfAbsent(ctx context.Context, key, value string, id clientv3.LeaseID) (bool, int64, error) {
+	resp, err := c.cli.Txn(ctx).If(
+		clientv3.Compare(
+			clientv3.Version(key),
+			"=",
+			0)).
+		Then(clientv3.OpPut(key, value, clientv3.WithLease(id))).Commit()
+	if err != nil {
+		return false, 0, err
+	}
+	return resp.Succeeded, resp.Header.GetRevision(), nil
+}
+
+func (c *etcdSessionClient) grant(ctx context.Context, ttl int64) (clientv3.LeaseID, error) {
+	resp, err := c.cli.Grant(ctx, ttl)
+	if err != nil {
+		return clientv3.NoLease, err
+	}
+	return resp.ID, nil
+}
+
+func (c *etcdSessionClient) keepAlive(ctx context.Context, id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	return c.cli.KeepAlive(ctx, id)
+}
+
+func (c *etcdSessionClient) revoke(ctx context.Context, id clientv3.LeaseID) error {
+	_, err := c.cli.Revoke(ctx, id)
+	return err
+}
+
+func (c *etcdSessionClient) watch(ctx context.Context, prefix string, revision int64) clientv3.WatchChan {
+	return c.cli.Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+}
+
+// kvSessionClient keeps the sessions in a LeaseKV, the keys are composed under the root path of the kv.
+// The kv doesn't take contexts for the leases, so a canceled keep alive only stops delivering the responses,
+// the lease is kept until it's revoked.
+type kvSessionClient struct {
+	kv LeaseKV
+}
+
+func (c *kvSessionClient) get(ctx context.Context, key string) (string, bool, error) {
+	value, err := c.kv.Load(key)
+	if common.IsKeyNotExistError(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+func (c *kvSessionClient) getWithPrefix(ctx context.Context, prefix string) ([]string, []string, int64, error) {
+	keys, values, _, revision, err := c.kv.LoadWithRevisionAndVersions(prefix)
+	return keys, values, revision, err
+}
+
+func (c *kvSessionClient) compareValueAndSwap(ctx context.Context, key, value, target string) (bool, error) {
+	return c.kv.CompareValueAndSwap(key, value, target)
+}
+
+func (c *kvSessionClient) putIfAbsent(ctx context.Context, key, value string, id clientv3.LeaseID) (bool, int64, error) {
+	ok, err := c.kv.CompareVersionAndSwapWithLease(key, 0, value, id)
+	if err != nil {
+		return false, 0, err
+	}
+	_, _, revision, err := c.kv.LoadWithRevision(key)
+	if err != nil {
+		return false, 0, err
+	}
+	return ok, revision, nil
+}
+
+func (c *kvSessionClient) grant(ctx context.Context, ttl int64) (clientv3.LeaseID, error) {
+	return c.kv.Grant(ttl)
+}
+
+func (c *kvSessionClient) keepAlive(ctx context.Context, id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	ch, err := c.kv.KeepAlive(id)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *clientv3.LeaseKeepAliveResponse, 1)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case resp, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case out <- resp:
+				default:
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (c *kvSessionClient) revoke(ctx context.Context, id clientv3.LeaseID) error {
+	return c.kv.Revoke(id)
+}
+
+func (c *kvSessionClient) watch(ctx context.Context, prefix string, revision int64) clientv3.WatchChan {
+	return c.kv.WatchWithRevisionCtx(ctx, prefix, revision)
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+)
+
+func TestSessionWithKV(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rocksdbKV, err := rocksdbkv.NewRocksdbKV(t.TempDir())
+	require.NoError(t, err)
+	defer rocksdbKV.Close()
+	metaKV, err := rocksdbkv.NewRocksdbMetaKV(rocksdbKV, "by-dev")
+	require.NoError(t, err)
+
+	s1 := NewSessionWithKV(ctx, "meta", metaKV)
+	s1.Init("kvtest", "s1", false, false)
+	s1.Register()
+	assert.Equal(t, int64(1), s1.ServerID)
+
+	sessions, rev, err := s1.GetSessions("kvtest")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(sessions))
+	assert.Equal(t, "s1", sessions["kvtest-1"].Address)
+
+	eventCh := s1.WatchServices("kvtest", rev+1, nil)
+
+	s2 := NewSessionWithKV(ctx, "meta", metaKV)
+	s2.Init("kvtest", "s2", false, false)
+	s2.Register()
+	assert.Equal(t, int64(2), s2.ServerID)
+
+	select {
+	case event := <-eventCh:
+		assert.Equal(t, SessionAddEvent, event.EventType)
+		assert.Equal(t, s2.ServerID, event.Session.ServerID)
+	case <-time.After(time.Second):
+		t.Fatal("no session add event received")
+	}
+
+	s2.Revoke(time.Second)
+	select {
+	case event := <-eventCh:
+		assert.Equal(t, SessionDelEvent, event.EventType)
+		assert.Equal(t, s2.ServerID, event.Session.ServerID)
+	case <-time.After(time.Second):
+		t.Fatal("no session del event received")
+	}
+
+	sessions, _, err = s1.GetSessions("kvtest")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(sessions))
+	s1.Revoke(time.Second)
+}
+
+func TestSetSessionKV(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rocksdbKV, err := rocksdbkv.NewRocksdbKV(t.TempDir())
+	require.NoError(t, err)
+	defer rocksdbKV.Close()
+	metaKV, err := rocksdbkv.NewRocksdbMetaKV(rocksdbKV, "")
+	require.NoError(t, err)
+
+	SetSessionKV(metaKV)
+	defer SetSessionKV(nil)
+	assert.Equal(t, LeaseKV(metaKV), GetSessionKV())
+
+	// no etcd client is needed once the sessions are kept in the kv
+	s := NewSession(ctx, "meta", nil)
+	require.NotNil(t, s)
+	s.Init("kvtest", "s", false, false)
+	s.Register()
+	_, err = metaKV.Load("meta/session/kvtest-1")
+	assert.NoError(t, err)
+	s.Revoke(time.Second)
+}
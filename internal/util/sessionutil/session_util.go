@@ -65,6 +65,7 @@ type Session struct {
 
 	liveCh  <-chan bool
 	etcdCli *clientv3.Client
+	client  sessionClient
 	leaseID *clientv3.LeaseID
 
 	metaRoot string
@@ -132,7 +133,11 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 // ServerID, ServerName, Address, Exclusive will be assigned after Init().
 // metaRoot is a path in etcd to save session information.
 // etcdEndpoints is to init etcdCli when NewSession
+// The session is kept in the LeaseKV instead of etcd if it's set by SetSessionKV.
 func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client) *Session {
+	if kv := GetSessionKV(); kv != nil {
+		return NewSessionWithKV(ctx, metaRoot, kv)
+	}
 	session := &Session{
 		ctx:      ctx,
 		metaRoot: metaRoot,
@@ -149,6 +154,7 @@ func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client) *
 			return err
 		}
 		session.etcdCli = client
+		session.client = &etcdSessionClient{cli: client}
 		return nil
 	}
 	err := retry.Do(ctx, connectEtcdFn, retry.Attempts(100))
@@ -161,6 +167,20 @@ func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client) *
 	return session
 }
 
+// NewSessionWithKV is a helper to build Session object kept in the LeaseKV rather than etcd,
+// so that a standalone deployment could run without etcd.
+// The session keys are composed with metaRoot under the root path of metaKV.
+func NewSessionWithKV(ctx context.Context, metaRoot string, metaKV LeaseKV) *Session {
+	session := &Session{
+		ctx:      ctx,
+		metaRoot: metaRoot,
+		Version:  common.Version,
+		client:   &kvSessionClient{kv: metaKV},
+	}
+	session.UpdateRegistered(false)
+	return session
+}
+
 // Init will initialize base struct of the Session, including ServerName, ServerID,
 // Address, Exclusive. ServerID is obtained in getServerID.
 func (s *Session) Init(serverName, address string, exclusive bool, triggerKill bool) {
@@ -197,43 +217,32 @@ func (s *Session) getServerID() (int64, error) {
 }
 
 func (s *Session) checkIDExist() {
-	s.etcdCli.Txn(s.ctx).If(
-		clientv3.Compare(
-			clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey)),
-			"=",
-			0)).
-		Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey), "1")).Commit()
+	s.client.putIfAbsent(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey), "1", clientv3.NoLease)
 }
 
 func (s *Session) getServerIDWithKey(key string) (int64, error) {
 	for {
-		getResp, err := s.etcdCli.Get(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, key))
+		value, ok, err := s.client.get(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, key))
 		if err != nil {
 			log.Warn("Session get etcd key error", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
-		if getResp.Count <= 0 {
+		if !ok {
 			log.Warn("Session there is no value", zap.String("key", key))
 			continue
 		}
-		value := string(getResp.Kvs[0].Value)
 		valueInt, err := strconv.ParseInt(value, 10, 64)
 		if err != nil {
 			log.Warn("Session ParseInt error", zap.String("value", value), zap.Error(err))
 			continue
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Value(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				value)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), strconv.FormatInt(valueInt+1, 10))).Commit()
+		succeeded, err := s.client.compareValueAndSwap(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, key), value, strconv.FormatInt(valueInt+1, 10))
 		if err != nil {
 			log.Warn("Session Txn failed", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
 
-		if !txnResp.Succeeded {
+		if !succeeded {
 			log.Warn("Session Txn unsuccessful", zap.String("key", key))
 			continue
 		}
@@ -267,38 +276,33 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 	var ch <-chan *clientv3.LeaseKeepAliveResponse
 	log.Debug("service begin to register to etcd", zap.String("serverName", s.ServerName), zap.Int64("ServerID", s.ServerID))
 	registerFn := func() error {
-		resp, err := s.etcdCli.Grant(s.ctx, GlobalParams.CommonCfg.SessionTTL)
+		leaseID, err := s.client.grant(s.ctx, GlobalParams.CommonCfg.SessionTTL)
 		if err != nil {
 			log.Error("register service", zap.Error(err))
 			return err
 		}
-		s.leaseID = &resp.ID
+		s.leaseID = &leaseID
 
 		sessionJSON, err := json.Marshal(s)
 		if err != nil {
 			return err
 		}
 
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(completeKey),
-				"=",
-				0)).
-			Then(clientv3.OpPut(completeKey, string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
+		succeeded, _, err := s.client.putIfAbsent(s.ctx, completeKey, string(sessionJSON), leaseID)
 
 		if err != nil {
 			log.Warn("compare and swap error, maybe the key has already been registered", zap.Error(err))
 			return err
 		}
 
-		if !txnResp.Succeeded {
+		if !succeeded {
 			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
 		}
 		log.Debug("put session key into etcd", zap.String("key", completeKey), zap.String("value", string(sessionJSON)))
 
 		keepAliveCtx, keepAliveCancel := context.WithCancel(context.Background())
 		s.keepAliveCancel = keepAliveCancel
-		ch, err = s.etcdCli.KeepAlive(keepAliveCtx, resp.ID)
+		ch, err = s.client.keepAlive(keepAliveCtx, leaseID)
 		if err != nil {
 			fmt.Printf("got error during keeping alive with etcd, err: %s\n", err)
 			return err
@@ -349,24 +353,23 @@ func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveRes
 func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error) {
 	res := make(map[string]*Session)
 	key := path.Join(s.metaRoot, DefaultServiceRoot, prefix)
-	resp, err := s.etcdCli.Get(s.ctx, key, clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	keys, values, revision, err := s.client.getWithPrefix(s.ctx, key)
 	if err != nil {
 		return nil, 0, err
 	}
-	for _, kv := range resp.Kvs {
+	for i, value := range values {
 		session := &Session{}
-		err = json.Unmarshal(kv.Value, session)
+		err = json.Unmarshal([]byte(value), session)
 		if err != nil {
 			return nil, 0, err
 		}
-		_, mapKey := path.Split(string(kv.Key))
+		_, mapKey := path.Split(keys[i])
 		log.Debug("SessionUtil GetSessions ", zap.Any("prefix", prefix),
 			zap.String("key", mapKey),
 			zap.Any("address", session.Address))
 		res[mapKey] = session
 	}
-	return res, resp.Header.Revision, nil
+	return res, revision, nil
 }
 
 // GetSessionsWithVersionRange will get all sessions with provided prefix and version range in etcd.
@@ -374,14 +377,13 @@ func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error)
 func (s *Session) GetSessionsWithVersionRange(prefix string, r semver.Range) (map[string]*Session, int64, error) {
 	res := make(map[string]*Session)
 	key := path.Join(s.metaRoot, DefaultServiceRoot, prefix)
-	resp, err := s.etcdCli.Get(s.ctx, key, clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	keys, values, revision, err := s.client.getWithPrefix(s.ctx, key)
 	if err != nil {
 		return nil, 0, err
 	}
-	for _, kv := range resp.Kvs {
+	for i, value := range values {
 		session := &Session{}
-		err = json.Unmarshal(kv.Value, session)
+		err = json.Unmarshal([]byte(value), session)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -389,13 +391,13 @@ func (s *Session) GetSessionsWithVersionRange(prefix string, r semver.Range) (ma
 			log.Debug("Session version out of range", zap.String("version", session.Version.String()), zap.Int64("serverID", session.ServerID))
 			continue
 		}
-		_, mapKey := path.Split(string(kv.Key))
+		_, mapKey := path.Split(keys[i])
 		log.Debug("SessionUtil GetSessions ", zap.String("prefix", prefix),
 			zap.String("key", mapKey),
 			zap.String("address", session.Address))
 		res[mapKey] = session
 	}
-	return res, resp.Header.Revision, nil
+	return res, revision, nil
 }
 
 // SessionEvent indicates the changes of other servers.
@@ -445,7 +447,7 @@ func (s *Session) WatchServices(prefix string, revision int64, rewatch Rewatch)
 	w := &sessionWatcher{
 		s:        s,
 		eventCh:  make(chan *SessionEvent, 100),
-		rch:      s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision)),
+		rch:      s.client.watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), revision),
 		prefix:   prefix,
 		rewatch:  rewatch,
 		validate: func(s *Session) bool { return true },
@@ -464,7 +466,7 @@ func (s *Session) WatchServicesWithVersionRange(prefix string, r semver.Range, r
 	w := &sessionWatcher{
 		s:        s,
 		eventCh:  make(chan *SessionEvent, 100),
-		rch:      s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision)),
+		rch:      s.client.watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), revision),
 		prefix:   prefix,
 		rewatch:  rewatch,
 		validate: func(s *Session) bool { return r(s.Version) },
@@ -546,7 +548,7 @@ func (w *sessionWatcher) handleWatchErr(err error) error {
 		return err
 	}
 
-	w.rch = w.s.etcdCli.Watch(w.s.ctx, path.Join(w.s.metaRoot, DefaultServiceRoot, w.prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+	w.rch = w.s.client.watch(w.s.ctx, path.Join(w.s.metaRoot, DefaultServiceRoot, w.prefix), revision)
 	return nil
 }
 
@@ -584,14 +586,14 @@ func (s *Session) Revoke(timeout time.Duration) {
 	if s == nil {
 		return
 	}
-	if s.etcdCli == nil || s.leaseID == nil {
+	if s.client == nil || s.leaseID == nil {
 		return
 	}
 	// can NOT use s.ctx, it may be Done here
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	// ignores resp & error, just do best effort to revoke
-	_, _ = s.etcdCli.Revoke(ctx, *s.leaseID)
+	_ = s.client.revoke(ctx, *s.leaseID)
 }
 
 // UpdateRegistered update the state of registered.
@@ -640,23 +642,16 @@ func (s *Session) ProcessActiveStandBy(activateFunc func()) error {
 			log.Error("json marshal error", zap.Error(err))
 			return false, -1, err
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(s.activeKey),
-				"=",
-				0)).
-			Then(clientv3.OpPut(s.activeKey, string(sessionJSON), clientv3.WithLease(*s.leaseID))).Commit()
+		doRegistered, revision, err := s.client.putIfAbsent(s.ctx, s.activeKey, string(sessionJSON), *s.leaseID)
 		if err != nil {
 			log.Error("register active key to etcd failed", zap.Error(err))
 			return false, -1, err
 		}
-		doRegistered := txnResp.Succeeded
 		if doRegistered {
 			log.Info(fmt.Sprintf("register ACTIVE %s", s.ServerName))
 		} else {
 			log.Info(fmt.Sprintf("ACTIVE %s has already been registered", s.ServerName))
 		}
-		revision := txnResp.Header.GetRevision()
 		return doRegistered, revision, nil
 	}
 	s.updateStandby(true)
@@ -679,7 +674,7 @@ func (s *Session) ProcessActiveStandBy(activateFunc func()) error {
 		}
 		log.Info(fmt.Sprintf("%s start to watch ACTIVE key", s.ServerName))
 		ctx, cancel := context.WithCancel(s.ctx)
-		watchChan := s.etcdCli.Watch(ctx, s.activeKey, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+		watchChan := s.client.watch(ctx, s.activeKey, revision)
 		select {
 		case <-ctx.Done():
 			cancel()
@@ -609,6 +609,11 @@ func IsPrimaryFieldType(dataType schemapb.DataType) bool {
 	return false
 }
 
+// IsClusteringFieldType returns true if a field of the input type could be used as the clustering field
+func IsClusteringFieldType(dataType schemapb.DataType) bool {
+	return IsArithmetic(dataType) || IsStringType(dataType)
+}
+
 func GetPK(data *schemapb.IDs, idx int64) interface{} {
 	if int64(GetSizeOfIDs(data)) <= idx {
 		return nil
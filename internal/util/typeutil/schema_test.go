@@ -541,6 +541,20 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestIsClusteringFieldType(t *testing.T) {
+	for _, dataType := range []schemapb.DataType{
+		schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar,
+	} {
+		assert.True(t, IsClusteringFieldType(dataType), dataType.String())
+	}
+	for _, dataType := range []schemapb.DataType{
+		schemapb.DataType_None, schemapb.DataType_Bool, schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector,
+	} {
+		assert.False(t, IsClusteringFieldType(dataType), dataType.String())
+	}
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs
@@ -22,6 +22,7 @@ CREATE TABLE if not exists milvus_meta.collections (
     consistency_level INT,
     status INT NOT NULL,
     ts BIGINT UNSIGNED DEFAULT 0,
+    drop_time BIGINT UNSIGNED DEFAULT 0,
     is_deleted BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
@@ -93,6 +94,7 @@ CREATE TABLE if not exists milvus_meta.`partitions` (
     collection_id     BIGINT NOT NULL,
     status INT NOT NULL,
     ts BIGINT UNSIGNED DEFAULT 0,
+    drop_time BIGINT UNSIGNED DEFAULT 0,
     is_deleted BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
@@ -264,4 +266,109 @@ CREATE TABLE if not exists milvus_meta.grant_id (
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     INDEX idx_grant_id_tenant_grantor (tenant_id, grant_id, grantor_id, is_deleted),
     PRIMARY KEY (id)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- segments
+CREATE TABLE if not exists milvus_meta.segments (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    insert_channel VARCHAR(256) NOT NULL,
+    state INT NOT NULL,
+    num_rows BIGINT,
+    segment_info MEDIUMBLOB,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id),
+    INDEX idx_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- segment binlogs
+CREATE TABLE if not exists milvus_meta.segment_binlogs (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    field_id BIGINT NOT NULL,
+    log_type INT NOT NULL,
+    binlogs MEDIUMBLOB,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id_log_type_field_id (tenant_id, segment_id, log_type, field_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- removed channels
+CREATE TABLE if not exists milvus_meta.removed_channels (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- channel checkpoints
+CREATE TABLE if not exists milvus_meta.channel_checkpoints (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    position BLOB,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- collection load infos
+CREATE TABLE if not exists milvus_meta.collection_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    released_partitions TEXT,
+    replica_number INT,
+    status INT,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- partition load infos
+CREATE TABLE if not exists milvus_meta.partition_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    replica_number INT,
+    status INT,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- replicas
+CREATE TABLE if not exists milvus_meta.replicas (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    replica_id BIGINT NOT NULL,
+    nodes TEXT,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_replica_id (tenant_id, collection_id, replica_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;